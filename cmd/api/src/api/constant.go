@@ -30,6 +30,9 @@ const (
 	UserLoginPath     = "/ui/login"
 	UserDisabledPath  = "/ui/user-disabled"
 
+	// SCIMBasePath is the root of the SCIM 2.0 provisioning service.
+	SCIMBasePath = "/scim/v2"
+
 	// Authorization schemes
 	AuthorizationSchemeBHESignature = "bhesignature"
 	AuthorizationSchemeBearer       = "bearer"
@@ -50,6 +53,9 @@ const (
 	QueryParameterEnvironments                = "environments"
 	QueryParameterSchemas                     = "schemas"
 	QueryParameterIncludeOnlyTraversableKinds = "only_traversable"
+	QueryParameterSince                       = "since"
+	QueryParameterUntil                       = "until"
+	QueryParameterFormat                      = "format"
 
 	// URI path parameters
 	URIPathVariableApplicationConfigurationParameter = "parameter"
@@ -62,6 +68,7 @@ const (
 	URIPathVariableAssetGroupTagMemberID             = "asset_group_tag_member_id"
 	URIPathVariableAttackPathID                      = "attack_path_id"
 	URIPathVariableClientID                          = "client_id"
+	URIPathVariableDataQualityThresholdID            = "data_quality_threshold_id"
 	URIPathVariableDataType                          = "data_type"
 	URIPathVariableDomainID                          = "domain_id"
 	URIPathVariableEventID                           = "event_id"
@@ -79,6 +86,8 @@ const (
 	URIPathVariableTokenID                           = "token_id"
 	URIPathVariableUserID                            = "user_id"
 	URIPathVariableSavedQueryID                      = "saved_query_id"
+	URIPathVariableSCIMResourceID                    = "scim_resource_id"
+	URIPathVariableSCIMTokenID                       = "scim_token_id"
 	URIPathVariableSSOProviderID                     = "sso_provider_id"
 	URIPathVariableSSOProviderSlug                   = "sso_provider_slug"
 )
@@ -368,11 +368,13 @@ func routeTemplateFor(muxRouter *mux.Router, r *http.Request) string {
 // the most important endpoints.
 var highValueHandlers = map[string]bool{
 	// Graph Query & Search - Core functionality
-	"/api/v2/graphs/cypher":        true, // Primary graph query endpoint
-	"/api/v2/graphs/shortest-path": true, // Pathfinding queries
-	"/api/v2/pathfinding":          true, // Alternative pathfinding
-	"/api/v2/search":               true, // Global search
-	"/api/v2/graph-search":         true, // Graph-specific search
+	"/api/v2/graphs/cypher":         true, // Primary graph query endpoint
+	"/api/v2/graphs/shortest-path":  true, // Pathfinding queries
+	"/api/v2/graphs/weighted-paths": true, // Weighted k-shortest pathfinding
+	"/api/v2/graphs/what-if-paths":  true, // Pathfinding over hypothetical graph changes
+	"/api/v2/pathfinding":           true, // Alternative pathfinding
+	"/api/v2/search":                true, // Global search
+	"/api/v2/graph-search":          true, // Graph-specific search
 
 	// Data Ingestion - Performance critical
 	"/api/v2/ingest":                           true, // Primary collector ingestion
@@ -20,10 +20,15 @@ import (
 	"log/slog"
 	"net"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/bhctx"
+	"github.com/specterops/bloodhound/cmd/api/src/config"
 	"github.com/specterops/bloodhound/cmd/api/src/database"
 	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
 	"github.com/specterops/bloodhound/packages/go/bhlog/attr"
@@ -35,79 +40,143 @@ import (
 // DefaultRateLimit is the default number of allowed requests per second
 const DefaultRateLimit = 55
 
-func rateLimitMiddleware(db database.Database, limiter *limiter.Limiter) mux.MiddlewareFunc {
-	ipGetter := stdlib.WithKeyGetter(
-		func(r *http.Request) string {
-			var remoteIP string
-			trustedProxies := appcfg.GetTrustedProxiesParameters(r.Context(), db)
-
-			if host, _, err := net.SplitHostPort(r.RemoteAddr); err != nil {
-				slog.WarnContext(
-					r.Context(),
-					"Error parsing remoteAddress",
-					slog.String("remote_addr", r.RemoteAddr),
-					attr.Error(err),
-				)
-				remoteIP = r.RemoteAddr
-			} else {
-				remoteIP = host
-			}
+const (
+	HeaderRateLimitLimit     = "X-RateLimit-Limit"
+	HeaderRateLimitRemaining = "X-RateLimit-Remaining"
+	HeaderRateLimitReset     = "X-RateLimit-Reset"
+)
 
-			if trustedProxies <= 0 {
-				slog.DebugContext(
-					r.Context(),
-					"Using direct remote IP Address for rate limiting",
-					slog.String("ip_address", remoteIP),
-				)
-				return remoteIP
-			} else if xff := r.Header.Get("X-Forwarded-For"); xff == "" {
-				slog.DebugContext(
-					r.Context(),
-					"Expected X-Forwarded-For header for rate limiting but none found. Defaulted to remote IP Address",
-					slog.String("ip_address", remoteIP),
-				)
-				return remoteIP
-			} else {
-				ips := strings.Split(xff, ",")
-
-				idxIP := len(ips) - trustedProxies
-				if idxIP < 0 {
-					slog.WarnContext(
-						r.Context(),
-						"Not enough IPs in X-Forwarded-For, defaulting to first IP",
-						slog.String("x_forwarded_for", xff),
-					)
-					idxIP = 0
-				}
+// clientIPAddress returns the IP address of the client that sent the request, honoring the configured number of
+// trusted proxies in front of BloodHound
+func clientIPAddress(request *http.Request, db database.Database) string {
+	var remoteIP string
+	trustedProxies := appcfg.GetTrustedProxiesParameters(request.Context(), db)
+
+	if host, _, err := net.SplitHostPort(request.RemoteAddr); err != nil {
+		slog.WarnContext(
+			request.Context(),
+			"Error parsing remoteAddress",
+			slog.String("remote_addr", request.RemoteAddr),
+			attr.Error(err),
+		)
+		remoteIP = request.RemoteAddr
+	} else {
+		remoteIP = host
+	}
 
-				finalIP := strings.TrimSpace(ips[idxIP])
+	if trustedProxies <= 0 {
+		slog.DebugContext(
+			request.Context(),
+			"Using direct remote IP Address for rate limiting",
+			slog.String("ip_address", remoteIP),
+		)
+		return remoteIP
+	} else if xff := request.Header.Get("X-Forwarded-For"); xff == "" {
+		slog.DebugContext(
+			request.Context(),
+			"Expected X-Forwarded-For header for rate limiting but none found. Defaulted to remote IP Address",
+			slog.String("ip_address", remoteIP),
+		)
+		return remoteIP
+	} else {
+		ips := strings.Split(xff, ",")
+
+		idxIP := len(ips) - trustedProxies
+		if idxIP < 0 {
+			slog.WarnContext(
+				request.Context(),
+				"Not enough IPs in X-Forwarded-For, defaulting to first IP",
+				slog.String("x_forwarded_for", xff),
+			)
+			idxIP = 0
+		}
+
+		finalIP := strings.TrimSpace(ips[idxIP])
+
+		slog.DebugContext(
+			request.Context(),
+			"Found client IP Address for rate limiting in XFF",
+			slog.String("ip_address", finalIP),
+			slog.String("x_forwarded_for", xff),
+		)
+		return finalIP
+	}
+}
 
-				slog.DebugContext(
-					r.Context(),
-					"Found client IP Address for rate limiting in XFF",
-					slog.String("ip_address", finalIP),
-					slog.String("x_forwarded_for", xff),
-				)
-				return finalIP
-			}
-		},
-	)
+// rateLimitPrincipal identifies who a request is counted against. Requests signed with an API token are counted
+// against the token, other authenticated requests against the user and anonymous requests against the client IP.
+func rateLimitPrincipal(request *http.Request, db database.Database) string {
+	authCtx := bhctx.Get(request.Context()).AuthCtx
+
+	if authCtx.Authenticated() {
+		if authScheme, schemeParameter, err := parseAuthorizationHeader(request); err == nil && authScheme == api.AuthorizationSchemeBHESignature {
+			return "token:" + schemeParameter
+		} else if user, isUser := auth.GetUserFromAuthCtx(authCtx); isUser {
+			return "user:" + user.ID.String()
+		}
+	}
 
-	middleware := stdlib.NewMiddleware(limiter, ipGetter)
+	return "ip:" + clientIPAddress(request, db)
+}
 
+// rateLimitRoute returns the path template of the matched route so that every request to a route shares one
+// counter regardless of its path parameters
+func rateLimitRoute(request *http.Request) string {
+	if route := mux.CurrentRoute(request); route != nil {
+		if pathTemplate, err := route.GetPathTemplate(); err == nil {
+			return pathTemplate
+		}
+	}
+
+	return request.URL.Path
+}
+
+func rateLimitMiddleware(cfg config.RateLimitConfiguration, db database.Database, store limiter.Store, rate limiter.Rate) mux.MiddlewareFunc {
 	return func(next http.Handler) http.Handler {
-		return middleware.Handler(removeRateLimitHeadersMiddleware(next))
+		return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+			var (
+				route     = rateLimitRoute(request)
+				routeRate = rate
+			)
+
+			if routeLimit, hasOverride := cfg.Routes[route]; hasOverride {
+				routeRate.Limit = routeLimit
+			}
+
+			key := strings.Join([]string{request.Method, route, rateLimitPrincipal(request, db)}, " ")
+
+			if limitCtx, err := store.Get(request.Context(), key, routeRate); err != nil {
+				// Fail open: an unavailable rate limit store must not take the API down with it
+				slog.ErrorContext(
+					request.Context(),
+					"Failed to update rate limit counter",
+					slog.String("rate_limit_key", key),
+					attr.Error(err),
+				)
+				next.ServeHTTP(response, request)
+			} else {
+				response.Header().Set(HeaderRateLimitLimit, strconv.FormatInt(limitCtx.Limit, 10))
+				response.Header().Set(HeaderRateLimitRemaining, strconv.FormatInt(limitCtx.Remaining, 10))
+				response.Header().Set(HeaderRateLimitReset, strconv.FormatInt(limitCtx.Reset, 10))
+
+				if limitCtx.Reached {
+					stdlib.DefaultLimitReachedHandler(response, request)
+				} else {
+					next.ServeHTTP(response, request)
+				}
+			}
+		})
 	}
 }
 
-// RemoveRateLimitHeadersMiddleware removes rate limit headers that we do not want appearing in our responses
-func removeRateLimitHeadersMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
-		response.Header().Del("X-RateLimit-Limit")
-		response.Header().Del("X-RateLimit-Remaining")
-		response.Header().Del("X-RateLimit-Reset")
-		next.ServeHTTP(response, request)
-	})
+// NewRateLimitStore returns the limiter.Store selected by the given configuration. The memory store is the default.
+func NewRateLimitStore(cfg config.RateLimitConfiguration, db database.RateLimitData) limiter.Store {
+	switch cfg.Store {
+	case config.RateLimitStorePostgres:
+		return NewPostgresRateLimitStore(db)
+	default:
+		return memory.NewStore()
+	}
 }
 
 // DefaultRateLimitMiddleware is a convenience function for creating the default rate limiting middleware
@@ -115,26 +184,23 @@ func removeRateLimitHeadersMiddleware(next http.Handler) http.Handler {
 //
 // Usage:
 //
-//	router.Use(DefaultRateLimitMiddleware(db))
-func DefaultRateLimitMiddleware(db database.Database) mux.MiddlewareFunc {
-	return RateLimitMiddleware(db, DefaultRateLimit)
+//	router.Use(DefaultRateLimitMiddleware(cfg, db))
+func DefaultRateLimitMiddleware(cfg config.Configuration, db database.Database) mux.MiddlewareFunc {
+	return RateLimitMiddleware(cfg, db, DefaultRateLimit)
 }
 
 // RateLimitMiddleware is a function for creating rate limiting middleware
-// with a particular limit for a router/route
+// with a particular limit for a router/route. Requests are counted per route
+// and per principal.
 //
 // Usage:
 //
-//	router.Use(RateLimitMiddleware(db, 1))
-func RateLimitMiddleware(db database.Database, limit int64) mux.MiddlewareFunc {
+//	router.Use(RateLimitMiddleware(cfg, db, 1))
+func RateLimitMiddleware(cfg config.Configuration, db database.Database, limit int64) mux.MiddlewareFunc {
 	rate := limiter.Rate{
 		Period: 1 * time.Second,
 		Limit:  limit,
 	}
 
-	store := memory.NewStore()
-
-	instance := limiter.New(store, rate)
-
-	return rateLimitMiddleware(db, instance)
+	return rateLimitMiddleware(cfg.RateLimit, db, NewRateLimitStore(cfg.RateLimit, db), rate)
 }
@@ -0,0 +1,73 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package middleware
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/ulule/limiter/v3"
+	"github.com/ulule/limiter/v3/drivers/store/common"
+)
+
+// postgresRateLimitStore is a limiter.Store that keeps its counters in the BloodHound database so that every API
+// replica shares the same limits
+type postgresRateLimitStore struct {
+	db database.RateLimitData
+}
+
+func NewPostgresRateLimitStore(db database.RateLimitData) limiter.Store {
+	return postgresRateLimitStore{
+		db: db,
+	}
+}
+
+func (s postgresRateLimitStore) Get(ctx context.Context, key string, rate limiter.Rate) (limiter.Context, error) {
+	return s.Increment(ctx, key, 1, rate)
+}
+
+func (s postgresRateLimitStore) Peek(ctx context.Context, key string, rate limiter.Rate) (limiter.Context, error) {
+	now := time.Now()
+
+	if counter, err := s.db.GetRateLimitCounter(ctx, key); errors.Is(err, database.ErrNotFound) {
+		return common.GetContextFromState(now, rate, now.Add(rate.Period), 0), nil
+	} else if err != nil {
+		return limiter.Context{}, err
+	} else {
+		return common.GetContextFromState(now, rate, counter.ExpiresAt, counter.Hits), nil
+	}
+}
+
+func (s postgresRateLimitStore) Reset(ctx context.Context, key string, rate limiter.Rate) (limiter.Context, error) {
+	now := time.Now()
+
+	if err := s.db.DeleteRateLimitCounter(ctx, key); err != nil {
+		return limiter.Context{}, err
+	}
+
+	return common.GetContextFromState(now, rate, now.Add(rate.Period), 0), nil
+}
+
+func (s postgresRateLimitStore) Increment(ctx context.Context, key string, count int64, rate limiter.Rate) (limiter.Context, error) {
+	if counter, err := s.db.IncrementRateLimitCounter(ctx, key, count, rate.Period); err != nil {
+		return limiter.Context{}, err
+	} else {
+		return common.GetContextFromState(time.Now(), rate, counter.ExpiresAt, counter.Hits), nil
+	}
+}
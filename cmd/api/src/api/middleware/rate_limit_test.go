@@ -17,14 +17,26 @@
 package middleware_test
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/gofrs/uuid"
 	"github.com/gorilla/mux"
 	"github.com/specterops/bloodhound/cmd/api/src/api/middleware"
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/bhctx"
+	"github.com/specterops/bloodhound/cmd/api/src/config"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
 	"github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
 	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ulule/limiter/v3"
 	"go.uber.org/mock/gomock"
 )
 
@@ -37,7 +49,7 @@ func TestRateLimitMiddleware(t *testing.T) {
 
 	testHandler := &CountingHandler{}
 	router := mux.NewRouter()
-	router.Use(middleware.RateLimitMiddleware(mockDB, int64(allowedReqsPerSecond)))
+	router.Use(middleware.RateLimitMiddleware(config.Configuration{}, mockDB, int64(allowedReqsPerSecond)))
 	router.Handle("/teapot", testHandler)
 
 	if req, err := http.NewRequest("GET", "/teapot", nil); err != nil {
@@ -63,7 +75,7 @@ func TestDefaultRateLimitMiddleware(t *testing.T) {
 	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.TrustedProxiesConfig).Return(appcfg.Parameter{}, nil).AnyTimes()
 
 	router := mux.NewRouter()
-	router.Use(middleware.DefaultRateLimitMiddleware(mockDB))
+	router.Use(middleware.DefaultRateLimitMiddleware(config.Configuration{}, mockDB))
 	router.Handle("/teapot", testHandler)
 
 	if req, err := http.NewRequest("GET", "/teapot", nil); err != nil {
@@ -81,6 +93,149 @@ func TestDefaultRateLimitMiddleware(t *testing.T) {
 	}
 }
 
+func TestRateLimitMiddleware_Headers(t *testing.T) {
+	mockCtl := gomock.NewController(t)
+	mockDB := mocks.NewMockDatabase(mockCtl)
+	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.TrustedProxiesConfig).Return(appcfg.Parameter{}, nil).AnyTimes()
+
+	router := mux.NewRouter()
+	router.Use(middleware.RateLimitMiddleware(config.Configuration{}, mockDB, 2))
+	router.Handle("/teapot", &CountingHandler{})
+
+	for _, expectedRemaining := range []string{"1", "0"} {
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/teapot", nil))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "2", rr.Header().Get(middleware.HeaderRateLimitLimit))
+		assert.Equal(t, expectedRemaining, rr.Header().Get(middleware.HeaderRateLimitRemaining))
+		assert.NotEmpty(t, rr.Header().Get(middleware.HeaderRateLimitReset))
+	}
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/teapot", nil))
+	assert.Equal(t, http.StatusTooManyRequests, rr.Code)
+}
+
+func TestRateLimitMiddleware_PerPrincipal(t *testing.T) {
+	var (
+		mockCtl     = gomock.NewController(t)
+		mockDB      = mocks.NewMockDatabase(mockCtl)
+		testHandler = &CountingHandler{}
+		router      = mux.NewRouter()
+	)
+
+	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.TrustedProxiesConfig).Return(appcfg.Parameter{}, nil).AnyTimes()
+
+	router.Use(middleware.RateLimitMiddleware(config.Configuration{}, mockDB, 1))
+	router.Handle("/teapot", testHandler)
+
+	// Both users share one IP address but are counted separately
+	for _, user := range []model.User{{Unique: model.Unique{ID: uuid.Must(uuid.NewV4())}}, {Unique: model.Unique{ID: uuid.Must(uuid.NewV4())}}} {
+		for range 2 {
+			req := httptest.NewRequest(http.MethodGet, "/teapot", nil)
+			req = req.WithContext(bhctx.Set(req.Context(), &bhctx.Context{AuthCtx: auth.Context{Owner: user}}))
+
+			router.ServeHTTP(httptest.NewRecorder(), req)
+		}
+	}
+
+	assert.Equal(t, 2, testHandler.Count)
+}
+
+func TestRateLimitMiddleware_RouteOverride(t *testing.T) {
+	var (
+		mockCtl       = gomock.NewController(t)
+		mockDB        = mocks.NewMockDatabase(mockCtl)
+		limitedRoute  = &CountingHandler{}
+		defaultRoute  = &CountingHandler{}
+		router        = mux.NewRouter()
+		configuration = config.Configuration{
+			RateLimit: config.RateLimitConfiguration{
+				Routes: map[string]int64{"/teapot/{id}": 1},
+			},
+		}
+	)
+
+	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.TrustedProxiesConfig).Return(appcfg.Parameter{}, nil).AnyTimes()
+
+	router.Use(middleware.RateLimitMiddleware(configuration, mockDB, 3))
+	router.Handle("/teapot/{id}", limitedRoute)
+	router.Handle("/kettle", defaultRoute)
+
+	// Path parameters do not split the route counter
+	for _, path := range []string{"/teapot/1", "/teapot/2", "/teapot/3", "/kettle", "/kettle", "/kettle", "/kettle"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	assert.Equal(t, 1, limitedRoute.Count)
+	assert.Equal(t, 3, defaultRoute.Count)
+}
+
+func TestRateLimitMiddleware_StoreErrorFailsOpen(t *testing.T) {
+	var (
+		mockCtl       = gomock.NewController(t)
+		mockDB        = mocks.NewMockDatabase(mockCtl)
+		testHandler   = &CountingHandler{}
+		router        = mux.NewRouter()
+		configuration = config.Configuration{
+			RateLimit: config.RateLimitConfiguration{
+				Store: config.RateLimitStorePostgres,
+			},
+		}
+	)
+
+	mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.TrustedProxiesConfig).Return(appcfg.Parameter{}, nil).AnyTimes()
+	mockDB.EXPECT().IncrementRateLimitCounter(gomock.Any(), gomock.Any(), int64(1), time.Second).Return(model.RateLimitCounter{}, errors.New("connection refused"))
+
+	router.Use(middleware.RateLimitMiddleware(configuration, mockDB, 1))
+	router.Handle("/teapot", testHandler)
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/teapot", nil))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, 1, testHandler.Count)
+}
+
+func TestPostgresRateLimitStore(t *testing.T) {
+	var (
+		mockCtl   = gomock.NewController(t)
+		mockDB    = mocks.NewMockDatabase(mockCtl)
+		store     = middleware.NewPostgresRateLimitStore(mockDB)
+		ctx       = context.Background()
+		rate      = limiter.Rate{Period: time.Second, Limit: 2}
+		expiresAt = time.Now().Add(time.Second)
+	)
+
+	t.Run("Get increments the shared counter", func(t *testing.T) {
+		mockDB.EXPECT().IncrementRateLimitCounter(ctx, "key", int64(1), time.Second).Return(model.RateLimitCounter{Key: "key", Hits: 3, ExpiresAt: expiresAt}, nil)
+
+		limitCtx, err := store.Get(ctx, "key", rate)
+		require.NoError(t, err)
+		assert.True(t, limitCtx.Reached)
+		assert.Equal(t, int64(0), limitCtx.Remaining)
+		assert.Equal(t, expiresAt.Unix(), limitCtx.Reset)
+	})
+
+	t.Run("Peek of a missing counter has the full limit remaining", func(t *testing.T) {
+		mockDB.EXPECT().GetRateLimitCounter(ctx, "key").Return(model.RateLimitCounter{}, database.ErrNotFound)
+
+		limitCtx, err := store.Peek(ctx, "key", rate)
+		require.NoError(t, err)
+		assert.False(t, limitCtx.Reached)
+		assert.Equal(t, int64(2), limitCtx.Remaining)
+	})
+
+	t.Run("Reset deletes the counter", func(t *testing.T) {
+		mockDB.EXPECT().DeleteRateLimitCounter(ctx, "key").Return(nil)
+
+		limitCtx, err := store.Reset(ctx, "key", rate)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), limitCtx.Remaining)
+	})
+}
+
 type CountingHandler struct {
 	Count int
 }
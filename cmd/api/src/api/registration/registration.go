@@ -52,11 +52,9 @@ func RegisterFossGlobalMiddleware(routerInst *router.Router, cfg config.Configur
 		routerInst.UsePrerouting(middleware.LoggingMiddleware(identityResolver, bypassLimitsParam))
 	}
 
-	routerInst.UsePostrouting(
-		middleware.PanicHandler,
-		middleware.AuthMiddleware(authenticator),
-		middleware.CompressionMiddleware,
-	)
+	routerInst.UsePostrouting(middleware.PanicHandler)
+	routerInst.UseAuthentication(middleware.AuthMiddleware(authenticator))
+	routerInst.UsePostrouting(middleware.CompressionMiddleware)
 }
 
 func RegisterFossRoutes(
@@ -76,7 +74,7 @@ func RegisterFossRoutes(
 	alertPublisher alerts.Publisher,
 ) {
 	router.With(func() mux.MiddlewareFunc {
-		return middleware.DefaultRateLimitMiddleware(rdms)
+		return middleware.DefaultRateLimitMiddleware(cfg, rdms)
 	},
 		// Health Endpoint
 		routerInst.GET("/health", func(response http.ResponseWriter, _ *http.Request) {
@@ -40,7 +40,7 @@ func registerV2Auth(resources v2.Resources, routerInst *router.Router, permissio
 	)
 
 	router.With(func() mux.MiddlewareFunc {
-		return middleware.RateLimitMiddleware(resources.DB, 1)
+		return middleware.RateLimitMiddleware(resources.Config, resources.DB, 1)
 	},
 		// Login resource
 		routerInst.POST("/api/v2/login", func(response http.ResponseWriter, request *http.Request) {
@@ -49,7 +49,7 @@ func registerV2Auth(resources v2.Resources, routerInst *router.Router, permissio
 	)
 
 	router.With(func() mux.MiddlewareFunc {
-		return middleware.DefaultRateLimitMiddleware(resources.DB)
+		return middleware.DefaultRateLimitMiddleware(resources.Config, resources.DB)
 	},
 		// Login resources
 		routerInst.GET("/api/v2/self", managementResource.GetSelf),
@@ -127,7 +127,7 @@ func NewV2API(resources v2.Resources, routerInst *router.Router) {
 	routerInst.POST(fmt.Sprintf("/api/v2/file-upload/{%s}/end", v2.FileUploadJobIdPathParameterName), resources.EndIngestJob).RequirePermissions(permissions.GraphDBIngestManage)
 
 	router.With(func() mux.MiddlewareFunc {
-		return middleware.DefaultRateLimitMiddleware(resources.DB)
+		return middleware.DefaultRateLimitMiddleware(resources.Config, resources.DB)
 	},
 		// Version API
 		routerInst.GET("/api/version", v2.GetVersion).RequireAuth(),
@@ -190,14 +190,22 @@ func NewV2API(resources v2.Resources, routerInst *router.Router) {
 		// history
 		routerInst.GET("/api/v2/asset-group-tags-history", resources.GetAssetGroupTagHistory).CheckFeatureFlag(resources.DB, appcfg.FeatureTierManagement).RequirePermissions(permissions.GraphDBRead).RequireAllEnvironmentAccess(resources.DogTags),
 		routerInst.POST("/api/v2/asset-group-tags-history", resources.SearchAssetGroupTagHistory).CheckFeatureFlag(resources.DB, appcfg.FeatureTierManagement).RequirePermissions(permissions.GraphDBRead).RequireAllEnvironmentAccess(resources.DogTags),
+		routerInst.GET("/api/v2/asset-group-tags-history/export", resources.ExportAssetGroupTagHistory).CheckFeatureFlag(resources.DB, appcfg.FeatureTierManagement).RequirePermissions(permissions.GraphDBRead).RequireAllEnvironmentAccess(resources.DogTags),
 
 		// QA API
 		routerInst.GET("/api/v2/completeness", resources.GetDatabaseCompleteness).RequirePermissions(permissions.GraphDBRead),
 
+		routerInst.GET("/api/v2/attack-paths/choke-points", resources.GetChokePoints).RequirePermissions(permissions.GraphDBRead),
+		routerInst.GET("/api/v2/attack-paths/principal-exposures", resources.GetPrincipalExposures).RequirePermissions(permissions.GraphDBRead),
+		routerInst.GET("/api/v2/attack-paths/principal-exposures/history", resources.GetPrincipalExposureHistory).RequirePermissions(permissions.GraphDBRead),
 		routerInst.GET("/api/v2/pathfinding", resources.GetPathfindingResult).Queries("start_node", "{start_node}", "end_node", "{end_node}").RequirePermissions(permissions.GraphDBRead).RequireAllEnvironmentAccess(resources.DogTags),
 		routerInst.GET("/api/v2/graphs/kinds", resources.ListKinds).RequirePermissions(permissions.GraphDBRead),
+		routerInst.GET("/api/v2/graph/changes", resources.GetGraphChanges).RequirePermissions(permissions.GraphDBRead).RequireAllEnvironmentAccess(resources.DogTags),
+		routerInst.GET(fmt.Sprintf("/api/v2/graph/changes/{%s}", api.URIPathVariableObjectID), resources.GetGraphObjectChanges).RequirePermissions(permissions.GraphDBRead).RequireAllEnvironmentAccess(resources.DogTags),
 		routerInst.GET("/api/v2/graphs/source-kinds", resources.ListSourceKinds).RequirePermissions(permissions.GraphDBRead),
 		routerInst.GET("/api/v2/graphs/shortest-path", resources.GetShortestPath).Queries(params.StartNode.String(), params.StartNode.RouteMatcher(), params.EndNode.String(), params.EndNode.RouteMatcher()).RequirePermissions(permissions.GraphDBRead),
+		routerInst.POST("/api/v2/graphs/weighted-paths", resources.GetWeightedPaths).RequirePermissions(permissions.GraphDBRead),
+		routerInst.POST("/api/v2/graphs/what-if-paths", resources.GetWhatIfPaths).RequirePermissions(permissions.GraphDBRead),
 		routerInst.GET("/api/v2/graphs/edge-composition", resources.GetEdgeComposition).RequirePermissions(permissions.GraphDBRead).RequireAllEnvironmentAccess(resources.DogTags),
 		routerInst.GET("/api/v2/graphs/relay-targets", resources.GetEdgeRelayTargets).RequirePermissions(permissions.GraphDBRead),
 		routerInst.GET("/api/v2/graphs/acl-inheritance", resources.GetEdgeACLInheritancePath).RequirePermissions(permissions.GraphDBRead),
@@ -345,6 +353,11 @@ func NewV2API(resources v2.Resources, routerInst *router.Router) {
 		routerInst.GET(fmt.Sprintf("/api/v2/platform/{%s}/data-quality-stats", api.URIPathVariablePlatformID), resources.GetPlatformAggregateStats).RequirePermissions(permissions.GraphDBRead),
 		routerInst.GET("/api/v2/data-quality-stats", resources.GetDataQualityStats).RequirePermissions(permissions.GraphDBRead).CheckFeatureFlag(resources.DB, appcfg.FeatureOpenGraphExtensionManagement),
 		routerInst.GET("/api/v2/data-quality-stats-aggregations", resources.GetDataQualityAggregations).RequirePermissions(permissions.GraphDBRead).CheckFeatureFlag(resources.DB, appcfg.FeatureOpenGraphExtensionManagement),
+		routerInst.GET("/api/v2/data-quality-thresholds", resources.GetDataQualityThresholds).RequirePermissions(permissions.AppReadApplicationConfiguration),
+		routerInst.POST("/api/v2/data-quality-thresholds", resources.CreateDataQualityThreshold).RequirePermissions(permissions.AppWriteApplicationConfiguration),
+		routerInst.PUT(fmt.Sprintf("/api/v2/data-quality-thresholds/{%s}", api.URIPathVariableDataQualityThresholdID), resources.UpdateDataQualityThreshold).RequirePermissions(permissions.AppWriteApplicationConfiguration),
+		routerInst.DELETE(fmt.Sprintf("/api/v2/data-quality-thresholds/{%s}", api.URIPathVariableDataQualityThresholdID), resources.DeleteDataQualityThreshold).RequirePermissions(permissions.AppWriteApplicationConfiguration),
+		routerInst.GET("/api/v2/data-quality-violations", resources.GetDataQualityViolations).RequirePermissions(permissions.GraphDBRead),
 
 		// Custom Node Management
 		routerInst.GET("/api/v2/custom-nodes", resources.GetCustomNodeKinds).RequirePermissions(permissions.OpenGraphRead),
@@ -357,6 +370,7 @@ func NewV2API(resources v2.Resources, routerInst *router.Router) {
 		routerInst.PUT("/api/v2/extensions", resources.OpenGraphSchemaIngest).CheckFeatureFlag(resources.DB, appcfg.FeatureOpenGraphExtensionManagement).RequirePermissions(permissions.OpenGraphWrite),
 		routerInst.GET("/api/v2/extensions", resources.ListExtensions).CheckFeatureFlag(resources.DB, appcfg.FeatureOpenGraphExtensionManagement).RequirePermissions(permissions.OpenGraphRead),
 		routerInst.DELETE(fmt.Sprintf("/api/v2/extensions/{%s}", api.URIPathVariableExtensionID), resources.DeleteExtension).CheckFeatureFlag(resources.DB, appcfg.FeatureOpenGraphExtensionManagement).RequirePermissions(permissions.OpenGraphWrite),
+		routerInst.POST(fmt.Sprintf("/api/v2/extensions/{%s}/rollback", api.URIPathVariableExtensionID), resources.RollbackExtension).CheckFeatureFlag(resources.DB, appcfg.FeatureOpenGraphExtensionManagement).RequirePermissions(permissions.OpenGraphWrite),
 
 		// Graph Schema API
 		routerInst.GET("/api/v2/extensions-edges", resources.ListEdgeTypes).CheckFeatureFlag(resources.DB, appcfg.FeatureOpenGraphExtensionManagement).RequirePermissions(permissions.GraphDBRead).RequirePermissions(permissions.OpenGraphRead),
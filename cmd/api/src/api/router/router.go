@@ -40,17 +40,19 @@ func With(limiterFactory func() mux.MiddlewareFunc, routes ...*Route) {
 
 // Router is a wrapper for the mux.Router type. It adds service-specific functionality to HTTP handler routes created.
 type Router struct {
-	globalMiddleware []mux.MiddlewareFunc
-	mux              *mux.Router
-	authorizer       auth.Authorizer
+	globalMiddleware  []mux.MiddlewareFunc
+	mux               *mux.Router
+	authorizer        auth.Authorizer
+	selfAuthenticated map[*mux.Route]struct{}
 }
 
 // Route represents a route to a http.Handler. The handler is stored, wrapped by a middleware.Wrapper struct to allow
 // for easier middleware registration that may be unique for each route.
 type Route struct {
-	handler    *middleware.Wrapper
-	mux        *mux.Route
-	authorizer auth.Authorizer
+	handler           *middleware.Wrapper
+	mux               *mux.Route
+	authorizer        auth.Authorizer
+	selfAuthenticated map[*mux.Route]struct{}
 }
 
 func (s *Route) Queries(pairs ...string) *Route {
@@ -67,6 +69,15 @@ func (s *Route) Use(middleware ...mux.MiddlewareFunc) {
 	s.handler.Use(middleware...)
 }
 
+// AuthenticateWith authenticates requests to this route with the given middleware instead of the router's
+// authentication middleware. It is intended for protocols such as SCIM whose clients present credentials that are
+// not BloodHound sessions or API tokens.
+func (s *Route) AuthenticateWith(authentication mux.MiddlewareFunc) *Route {
+	s.selfAuthenticated[s.mux] = struct{}{}
+	s.handler.Use(authentication)
+	return s
+}
+
 func (s *Route) RequireAuth() *Route {
 	return s.RequirePermissions()
 }
@@ -140,7 +151,7 @@ func NewRouter(cfg config.Configuration, authorizer auth.Authorizer, contentSecu
 	muxRouter.Use(middleware.EnsureRequestBodyClosed())
 	muxRouter.Use(middleware.SecureHandlerMiddleware(cfg, contentSecurityPolicy))
 
-	return Router{mux: muxRouter, authorizer: authorizer}
+	return Router{mux: muxRouter, authorizer: authorizer, selfAuthenticated: map[*mux.Route]struct{}{}}
 }
 
 // UsePostrouting appends all of the given mux.MiddlewareFunc instances to this router's post-route middleware execution
@@ -150,6 +161,22 @@ func (s Router) UsePostrouting(middleware ...mux.MiddlewareFunc) {
 	s.mux.Use(middleware...)
 }
 
+// UseAuthentication appends the given authentication middleware to this router's post-route middleware execution
+// chain. Routes registered with Route.AuthenticateWith bypass it and are authenticated by their own middleware.
+func (s Router) UseAuthentication(authentication mux.MiddlewareFunc) {
+	s.mux.Use(func(next http.Handler) http.Handler {
+		authenticated := authentication(next)
+
+		return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+			if _, found := s.selfAuthenticated[mux.CurrentRoute(request)]; found {
+				next.ServeHTTP(response, request)
+			} else {
+				authenticated.ServeHTTP(response, request)
+			}
+		})
+	})
+}
+
 // UsePrerouting appends all of the given mux.MiddlewareFunc instances to this router's pre-route middleware execution
 // chain. Pre-route means that this middleware will only be executed for each request regardless of whether or not it
 // matches to a valid route.
@@ -178,8 +205,9 @@ func (s Router) PathPrefix(template string, handler http.Handler) *Route {
 	middlewareWrapper := middleware.NewWrapper(handler)
 
 	return &Route{
-		handler: middlewareWrapper,
-		mux:     s.mux.PathPrefix(template).Handler(middlewareWrapper),
+		handler:           middlewareWrapper,
+		mux:               s.mux.PathPrefix(template).Handler(middlewareWrapper),
+		selfAuthenticated: s.selfAuthenticated,
 	}
 }
 
@@ -187,9 +215,10 @@ func (s Router) HandleFunc(template string, handlerFunc func(http.ResponseWriter
 	middlewareWrapper := middleware.NewWrapper(http.HandlerFunc(handlerFunc))
 
 	return &Route{
-		handler:    middlewareWrapper,
-		mux:        s.mux.Handle(template, middlewareWrapper),
-		authorizer: s.authorizer,
+		handler:           middlewareWrapper,
+		mux:               s.mux.Handle(template, middlewareWrapper),
+		authorizer:        s.authorizer,
+		selfAuthenticated: s.selfAuthenticated,
 	}
 }
 
@@ -18,9 +18,11 @@ package v2
 
 import (
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"regexp"
@@ -41,6 +43,7 @@ import (
 	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
 	"github.com/specterops/bloodhound/cmd/api/src/queries"
 	"github.com/specterops/bloodhound/cmd/api/src/services/dogtags"
+	"github.com/specterops/bloodhound/cmd/api/src/utils"
 	"github.com/specterops/bloodhound/cmd/api/src/utils/validation"
 	"github.com/specterops/bloodhound/packages/go/analysis"
 	"github.com/specterops/bloodhound/packages/go/bhlog/attr"
@@ -49,11 +52,14 @@ import (
 	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
 	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
 	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/bloodhound/packages/go/headers"
+	"github.com/specterops/bloodhound/packages/go/mediatypes"
 	"github.com/specterops/dawgs/graph"
 	"github.com/specterops/dawgs/query"
 )
 
 const (
+	assetGroupHistoryExportFlushInterval  = 500
 	assetGroupPreviewSelectorDefaultLimit = 200
 	AssetGroupTagDefaultLimit             = 50
 	assetGroupTagQueryLimitMin            = 3
@@ -155,14 +161,14 @@ func (s Resources) GetAssetGroupTags(response http.ResponseWriter, request *http
 }
 
 // Checks that the selector seeds are valid.
-func validateSelectorSeeds(graph queries.Graph, seeds []model.SelectorSeed) error {
+func validateSelectorSeeds(ctx context.Context, db database.Database, graph queries.Graph, seeds []model.SelectorSeed) error {
 	if len(seeds) <= 0 {
 		return fmt.Errorf("seeds are required")
 	}
 	// all seeds must be of the same type
 	seedType := seeds[0].Type
 
-	if seedType != model.SelectorTypeObjectId && seedType != model.SelectorTypeCypher {
+	if seedType != model.SelectorTypeObjectId && seedType != model.SelectorTypeCypher && seedType != model.SelectorTypeProperty {
 		return fmt.Errorf("invalid seed type %v", seedType)
 	}
 
@@ -175,7 +181,69 @@ func validateSelectorSeeds(graph queries.Graph, seeds []model.SelectorSeed) erro
 				return fmt.Errorf("cypher is invalid: %v", err)
 			}
 		}
+		if seed.Type == model.SelectorTypeProperty {
+			if selector, err := model.ParsePropertySelector(seed.Value); err != nil {
+				return err
+			} else if err := validatePropertySelectorSchema(ctx, db, selector); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validatePropertySelectorSchema checks that the kind of a property selector is a built-in node kind or a node kind
+// registered by a graph schema extension, and that every predicate refers to a property defined for that kind.
+// Built-in kinds accept the properties of their graph schema and extension kinds the properties registered by their
+// extension, along with the common properties shared by every node.
+func validatePropertySelectorSchema(ctx context.Context, db database.Database, selector model.PropertySelector) error {
+	var (
+		kind       = graph.StringKind(selector.Kind)
+		properties = map[string]struct{}{}
+	)
+
+	for _, property := range common.AllProperties() {
+		properties[property.String()] = struct{}{}
+	}
+
+	switch {
+	case kind.Is(ad.Entity) || ad.NodeKinds().ContainsOneOf(kind):
+		for _, property := range ad.AllProperties() {
+			properties[property.String()] = struct{}{}
+		}
+
+	case kind.Is(azure.Entity) || azure.NodeKinds().ContainsOneOf(kind):
+		for _, property := range azure.AllProperties() {
+			properties[property.String()] = struct{}{}
+		}
+
+	case common.NodeKinds().ContainsOneOf(kind):
+		// Common kinds only carry the common properties
+
+	default:
+		if nodeKinds, _, err := db.GetGraphSchemaNodeKinds(ctx, model.Filters{
+			"name": []model.Filter{{Operator: model.Equals, Value: selector.Kind, SetOperator: model.FilterAnd}},
+		}, model.Sort{}, 0, 1); err != nil {
+			return fmt.Errorf("unable to look up node kind %s: %w", selector.Kind, err)
+		} else if len(nodeKinds) == 0 {
+			return fmt.Errorf("%w: unknown node kind %s", model.ErrInvalidPropertySelector, selector.Kind)
+		} else if extensionProperties, _, err := db.GetGraphSchemaProperties(ctx, model.Filters{
+			"schema_extension_id": []model.Filter{{Operator: model.Equals, Value: strconv.Itoa(int(nodeKinds[0].SchemaExtensionId)), SetOperator: model.FilterAnd}},
+		}, model.Sort{}, 0, 0); err != nil {
+			return fmt.Errorf("unable to look up properties of node kind %s: %w", selector.Kind, err)
+		} else {
+			for _, property := range extensionProperties {
+				properties[property.Name] = struct{}{}
+			}
+		}
 	}
+
+	for _, predicate := range selector.Predicates {
+		if _, defined := properties[predicate.Property]; !defined {
+			return fmt.Errorf("%w: property %s is not defined for node kind %s", model.ErrInvalidPropertySelector, predicate.Property, selector.Kind)
+		}
+	}
+
 	return nil
 }
 
@@ -214,7 +282,7 @@ func (s *Resources) CreateAssetGroupTagSelector(response http.ResponseWriter, re
 	} else if actor, isUser := auth.GetUserFromAuthCtx(bhctx.FromRequest(request).AuthCtx); !isUser {
 		slog.Error("Unable to get user from auth context")
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, "unknown user", request), response)
-	} else if err := validateSelectorSeeds(s.GraphQuery, createSelectorRequest.Seeds); err != nil {
+	} else if err := validateSelectorSeeds(request.Context(), s.DB, s.GraphQuery, createSelectorRequest.Seeds); err != nil {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
 	} else {
 		// defaults for optional pointer field request values
@@ -315,7 +383,7 @@ func (s *Resources) UpdateAssetGroupTagSelector(response http.ResponseWriter, re
 		// if seeds are not included, call the DB update with them set to nil
 		var seedsTemp []model.SelectorSeed
 		if len(selUpdateReq.Seeds) > 0 {
-			if err := validateSelectorSeeds(s.GraphQuery, selUpdateReq.Seeds); err != nil {
+			if err := validateSelectorSeeds(request.Context(), s.DB, s.GraphQuery, selUpdateReq.Seeds); err != nil {
 				api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
 				return
 			}
@@ -1200,7 +1268,7 @@ func (s *Resources) PreviewSelectors(response http.ResponseWriter, request *http
 	} else if _, isUser := auth.GetUserFromAuthCtx(bhctx.FromRequest(request).AuthCtx); !isUser {
 		slog.Error("Unable to get user from auth context")
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, "unknown user", request), response)
-	} else if err := validateSelectorSeeds(s.GraphQuery, body.Seeds); err != nil {
+	} else if err := validateSelectorSeeds(request.Context(), s.DB, s.GraphQuery, body.Seeds); err != nil {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
 	} else if expansion, err := validateAssetGroupExpansionMethodWithFallback(body.Expansion); err != nil {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
@@ -1332,47 +1400,61 @@ type AssetGroupHistoryResp struct {
 	Records []model.AssetGroupHistory `json:"records"`
 }
 
+// parseAssetGroupHistoryQueryParameters validates the filter and sort query parameters shared by the asset group
+// history endpoints and builds the SQL filter and sort order for them. Records are sorted newest first by default.
+func parseAssetGroupHistoryQueryParameters(request *http.Request) (model.SQLFilter, model.Sort, *api.ErrorWrapper) {
+	queryFilters, err := model.NewQueryParameterFilterParser().ParseQueryParameterFilters(request)
+	if err != nil {
+		return model.SQLFilter{}, nil, api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsBadQueryParameterFilters, request)
+	}
+
+	sort, err := api.ParseSortParameters(model.AssetGroupHistory{}, request.URL.Query())
+	if err != nil {
+		return model.SQLFilter{}, nil, api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsNotSortable, request)
+	}
+
+	for name, filters := range queryFilters {
+		validPredicates, err := api.GetValidFilterPredicatesAsStrings(model.AssetGroupHistory{}, name)
+		if err != nil {
+			return model.SQLFilter{}, nil, api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("%s: %s", api.ErrorResponseDetailsColumnNotFilterable, name), request)
+		}
+
+		for i, filter := range filters {
+			if !slices.Contains(validPredicates, string(filter.Operator)) {
+				return model.SQLFilter{}, nil, api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("%s: %s %s", api.ErrorResponseDetailsFilterPredicateNotSupported, filter.Name, filter.Operator), request)
+			}
+			queryFilters[name][i].IsStringData = model.AssetGroupHistory{}.IsStringColumn(filter.Name)
+		}
+	}
+
+	if len(sort) == 0 {
+		sort = model.Sort{{Column: "created_at", Direction: model.DescendingSortDirection}}
+	}
+
+	sqlFilter, err := queryFilters.BuildSQLFilter()
+	if err != nil {
+		return model.SQLFilter{}, nil, api.BuildErrorResponse(http.StatusBadRequest, "error building SQL for filter", request)
+	}
+
+	return sqlFilter, sort, nil
+}
+
 func (s *Resources) assetGroupTagHistoryImplementation(response http.ResponseWriter, request *http.Request, query string) {
 	var (
 		rCtx        = request.Context()
 		queryParams = request.URL.Query()
 		sort        model.Sort
 		sqlFilter   model.SQLFilter
+		errResponse *api.ErrorWrapper
 	)
 
-	if queryFilters, err := model.NewQueryParameterFilterParser().ParseQueryParameterFilters(request); err != nil {
-		api.WriteErrorResponse(rCtx, api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsBadQueryParameterFilters, request), response)
-	} else if skip, err := ParseSkipQueryParameter(queryParams, 0); err != nil {
+	if skip, err := ParseSkipQueryParameter(queryParams, 0); err != nil {
 		api.WriteErrorResponse(rCtx, ErrBadQueryParameter(request, model.PaginationQueryParameterSkip, err), response)
 	} else if limit, err := ParseOptionalLimitQueryParameter(queryParams, AssetGroupTagDefaultLimit); err != nil {
 		api.WriteErrorResponse(rCtx, ErrBadQueryParameter(request, model.PaginationQueryParameterLimit, err), response)
-	} else if sort, err = api.ParseSortParameters(model.AssetGroupHistory{}, queryParams); err != nil {
-		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsNotSortable, request), response)
+	} else if sqlFilter, sort, errResponse = parseAssetGroupHistoryQueryParameters(request); errResponse != nil {
+		api.WriteErrorResponse(rCtx, errResponse, response)
 	} else {
-		for name, filters := range queryFilters {
-			if validPredicates, err := api.GetValidFilterPredicatesAsStrings(model.AssetGroupHistory{}, name); err != nil {
-				api.WriteErrorResponse(rCtx, api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("%s: %s", api.ErrorResponseDetailsColumnNotFilterable, name), request), response)
-				return
-			} else {
-				for i, filter := range filters {
-					if !slices.Contains(validPredicates, string(filter.Operator)) {
-						api.WriteErrorResponse(rCtx, api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("%s: %s %s", api.ErrorResponseDetailsFilterPredicateNotSupported, filter.Name, filter.Operator), request), response)
-						return
-					}
-					queryFilters[name][i].IsStringData = model.AssetGroupHistory{}.IsStringColumn(filter.Name)
-				}
-			}
-		}
-
-		if len(sort) == 0 {
-			sort = model.Sort{{Column: "created_at", Direction: model.DescendingSortDirection}}
-		}
-
-		if sqlFilter, err = queryFilters.BuildSQLFilter(); err != nil {
-			api.WriteErrorResponse(rCtx, api.BuildErrorResponse(http.StatusBadRequest, "error building SQL for filter", request), response)
-			return
-		}
-
 		if query != "" {
 			var (
 				queryableColumns  = []string{"actor", "email", "action", "target"}
@@ -1423,6 +1505,144 @@ func (s *Resources) GetAssetGroupTagHistory(response http.ResponseWriter, reques
 	s.assetGroupTagHistoryImplementation(response, request, "")
 }
 
+const (
+	AssetGroupHistoryExportFormatCSV  = "csv"
+	AssetGroupHistoryExportFormatJSON = "json"
+)
+
+// ExportAssetGroupTagHistory streams every asset group history record matching the request's filters as a CSV or JSON
+// attachment. Unlike GetAssetGroupTagHistory the export is not paginated; records are written as they are read from
+// the database.
+func (s *Resources) ExportAssetGroupTagHistory(response http.ResponseWriter, request *http.Request) {
+	var (
+		rCtx   = request.Context()
+		format = strings.ToLower(request.URL.Query().Get(api.QueryParameterFormat))
+	)
+
+	defer measure.ContextMeasureWithThreshold(rCtx, slog.LevelDebug, "Asset Group Tag Export History Records")()
+
+	if format == "" {
+		format = AssetGroupHistoryExportFormatCSV
+	}
+
+	if format != AssetGroupHistoryExportFormatCSV && format != AssetGroupHistoryExportFormatJSON {
+		api.WriteErrorResponse(rCtx, ErrBadQueryParameter(request, api.QueryParameterFormat, fmt.Errorf("unsupported export format %q", format)), response)
+	} else if sqlFilter, sort, errResponse := parseAssetGroupHistoryQueryParameters(request); errResponse != nil {
+		api.WriteErrorResponse(rCtx, errResponse, response)
+	} else {
+		writer := newAssetGroupHistoryExportWriter(response, format)
+
+		if err := s.DB.StreamAssetGroupHistoryRecords(rCtx, sqlFilter, sort, writer.Write); err != nil {
+			if !writer.Started() {
+				api.HandleDatabaseError(request, response, err)
+				return
+			}
+
+			// Headers and part of the body have already been sent so the only option left is to cut the export short
+			slog.ErrorContext(rCtx, "Failed to stream asset group history export", attr.Error(err))
+			return
+		}
+
+		if err := writer.Close(); err != nil {
+			slog.ErrorContext(rCtx, "Failed to finish asset group history export", attr.Error(err))
+		}
+	}
+}
+
+// assetGroupHistoryExportWriter lazily writes the response headers on the first record so that errors encountered
+// before any data is streamed can still be reported with a proper error response.
+type assetGroupHistoryExportWriter struct {
+	response  http.ResponseWriter
+	format    string
+	started   bool
+	count     int
+	csvWriter *csv.Writer
+}
+
+func newAssetGroupHistoryExportWriter(response http.ResponseWriter, format string) *assetGroupHistoryExportWriter {
+	return &assetGroupHistoryExportWriter{
+		response: response,
+		format:   format,
+	}
+}
+
+func (s *assetGroupHistoryExportWriter) Started() bool {
+	return s.started
+}
+
+func (s *assetGroupHistoryExportWriter) start() error {
+	s.started = true
+
+	var (
+		filename    = fmt.Sprintf("asset-group-history-%s.%s", time.Now().UTC().Format("20060102T150405Z"), s.format)
+		contentType = mediatypes.ApplicationJson.String()
+	)
+
+	if s.format == AssetGroupHistoryExportFormatCSV {
+		contentType = mediatypes.TextCsv.String()
+	}
+
+	s.response.Header().Set(headers.ContentType.String(), contentType)
+	s.response.Header().Set(headers.ContentDisposition.String(), fmt.Sprintf(utils.ContentDispositionAttachmentTemplate, filename))
+	s.response.WriteHeader(http.StatusOK)
+
+	if s.format == AssetGroupHistoryExportFormatCSV {
+		s.csvWriter = csv.NewWriter(s.response)
+		return s.csvWriter.Write(model.AssetGroupHistoryCSVHeader)
+	}
+
+	_, err := io.WriteString(s.response, "[")
+	return err
+}
+
+// Write appends a single record to the export, writing the response headers first if this is the first record
+func (s *assetGroupHistoryExportWriter) Write(record model.AssetGroupHistory) error {
+	if !s.started {
+		if err := s.start(); err != nil {
+			return err
+		}
+	}
+
+	s.count++
+
+	if s.format == AssetGroupHistoryExportFormatCSV {
+		if err := s.csvWriter.Write(record.CSVRecord()); err != nil {
+			return err
+		} else if s.count%assetGroupHistoryExportFlushInterval == 0 {
+			// Flush periodically so that large exports are streamed rather than buffered
+			s.csvWriter.Flush()
+			return s.csvWriter.Error()
+		}
+
+		return nil
+	}
+
+	if s.count > 1 {
+		if _, err := io.WriteString(s.response, ","); err != nil {
+			return err
+		}
+	}
+
+	return json.NewEncoder(s.response).Encode(record)
+}
+
+// Close terminates the export. An export without any records still produces a well formed, empty document.
+func (s *assetGroupHistoryExportWriter) Close() error {
+	if !s.started {
+		if err := s.start(); err != nil {
+			return err
+		}
+	}
+
+	if s.format == AssetGroupHistoryExportFormatCSV {
+		s.csvWriter.Flush()
+		return s.csvWriter.Error()
+	}
+
+	_, err := io.WriteString(s.response, "]")
+	return err
+}
+
 func (s *Resources) GetAssetGroupSelectorMemberCountsByKind(response http.ResponseWriter, request *http.Request) {
 	var (
 		environmentIds = request.URL.Query()[api.QueryParameterEnvironments]
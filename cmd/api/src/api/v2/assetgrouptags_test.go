@@ -17,6 +17,7 @@
 package v2_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -3327,6 +3328,90 @@ func TestResources_PreviewSelectors(t *testing.T) {
 					apitest.StatusCode(output, http.StatusInternalServerError)
 				},
 			},
+			{
+				Name: "Bad Request - Invalid Property Selector",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.BodyStruct(input, v2.PreviewSelectorBody{
+						Seeds: model.SelectorSeeds{{Type: model.SelectorTypeProperty, Value: `{"kind":"User","predicates":[]}`}},
+					})
+				},
+				Setup: func() {},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "at least one predicate is required")
+				},
+			},
+			{
+				Name: "Bad Request - Unknown Property Selector Kind",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.BodyStruct(input, v2.PreviewSelectorBody{
+						Seeds: model.SelectorSeeds{{Type: model.SelectorTypeProperty, Value: `{"kind":"Unicorn","predicates":[{"property":"horns","operator":"eq","value":1}]}`}},
+					})
+				},
+				Setup: func() {
+					mockDB.EXPECT().
+						GetGraphSchemaNodeKinds(gomock.Any(), model.Filters{"name": []model.Filter{{Operator: model.Equals, Value: "Unicorn", SetOperator: model.FilterAnd}}}, model.Sort{}, 0, 1).
+						Return(model.GraphSchemaNodeKinds{}, 0, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "unknown node kind Unicorn")
+				},
+			},
+			{
+				Name: "Bad Request - Undefined Property Selector Property",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.BodyStruct(input, v2.PreviewSelectorBody{
+						Seeds: model.SelectorSeeds{{Type: model.SelectorTypeProperty, Value: `{"kind":"User","predicates":[{"property":"horns","operator":"eq","value":1}]}`}},
+					})
+				},
+				Setup: func() {},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "property horns is not defined for node kind User")
+				},
+			},
+			{
+				Name: "Bad Request - Undefined Extension Property Selector Property",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.BodyStruct(input, v2.PreviewSelectorBody{
+						Seeds: model.SelectorSeeds{{Type: model.SelectorTypeProperty, Value: `{"kind":"Unicorn","predicates":[{"property":"wings","operator":"eq","value":2}]}`}},
+					})
+				},
+				Setup: func() {
+					mockDB.EXPECT().
+						GetGraphSchemaNodeKinds(gomock.Any(), model.Filters{"name": []model.Filter{{Operator: model.Equals, Value: "Unicorn", SetOperator: model.FilterAnd}}}, model.Sort{}, 0, 1).
+						Return(model.GraphSchemaNodeKinds{{Name: "Unicorn", SchemaExtensionId: 4}}, 1, nil)
+					mockDB.EXPECT().
+						GetGraphSchemaProperties(gomock.Any(), model.Filters{"schema_extension_id": []model.Filter{{Operator: model.Equals, Value: "4", SetOperator: model.FilterAnd}}}, model.Sort{}, 0, 0).
+						Return(model.GraphSchemaProperties{{SchemaExtensionId: 4, Name: "horns"}}, 1, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "property wings is not defined for node kind Unicorn")
+				},
+			},
+			{
+				Name: "Success - Property Selector",
+				Input: func(input *apitest.Input) {
+					apitest.SetContext(input, userCtx)
+					apitest.BodyStruct(input, v2.PreviewSelectorBody{
+						Seeds: model.SelectorSeeds{{Type: model.SelectorTypeProperty, Value: `{"kind":"User","predicates":[{"property":"admincount","operator":"eq","value":true}]}`}},
+					})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetPrimaryDisplayKinds(gomock.Any())
+					mockGraphDb.EXPECT().ReadTransaction(gomock.Any(), gomock.Any()).Times(1)
+					mockDB.EXPECT().GetConfigurationParameter(gomock.Any(), appcfg.AGTParameterKey).Return(appcfg.Parameter{}, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+				},
+			},
 			{
 				Name: "Bad Request - validateSelectorSeeds",
 				Input: func(input *apitest.Input) {
@@ -4132,6 +4217,161 @@ func TestResources_GetAssetGroupTagHistory(t *testing.T) {
 		})
 }
 
+func TestResources_ExportAssetGroupTagHistory(t *testing.T) {
+	var (
+		mockCtrl      = gomock.NewController(t)
+		mockDB        = mocks_db.NewMockDatabase(mockCtrl)
+		resourcesInst = v2.Resources{
+			DB:      mockDB,
+			DogTags: dogtags.NewDefaultService(),
+		}
+
+		expectedHistoryRecs = []model.AssetGroupHistory{
+			{ID: 1, CreatedAt: time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC), Actor: "UUID1", Email: null.StringFrom("user1@domain.com"), Action: model.AssetGroupHistoryActionCreateTag, Target: "tag, one", AssetGroupTagId: 1},
+			{ID: 2, CreatedAt: time.Date(2025, 6, 11, 0, 0, 0, 0, time.UTC), Actor: "UUID2", Action: model.AssetGroupHistoryActionUpdateTag, Target: "tag", AssetGroupTagId: 2, Note: null.StringFrom("note")},
+		}
+		defaultSort   = model.Sort{{Column: "created_at", Direction: model.DescendingSortDirection}}
+		streamRecords = func(records []model.AssetGroupHistory) func(context.Context, model.SQLFilter, model.Sort, func(model.AssetGroupHistory) error) error {
+			return func(_ context.Context, _ model.SQLFilter, _ model.Sort, delegate func(model.AssetGroupHistory) error) error {
+				for _, record := range records {
+					if err := delegate(record); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+		}
+	)
+
+	defer mockCtrl.Finish()
+
+	apitest.
+		NewHarness(t, resourcesInst.ExportAssetGroupTagHistory).
+		Run([]apitest.Case{
+			{
+				Name: "Unsupported format",
+				Input: func(input *apitest.Input) {
+					apitest.AddQueryParam(input, api.QueryParameterFormat, "xml")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "unsupported export format")
+				},
+			},
+			{
+				Name: "Invalid Filter Column",
+				Input: func(input *apitest.Input) {
+					apitest.AddQueryParam(input, "invalid_column", "eq:2")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, api.ErrorResponseDetailsColumnNotFilterable)
+				},
+			},
+			{
+				Name: "Invalid Filter Predicate",
+				Input: func(input *apitest.Input) {
+					apitest.AddQueryParam(input, "asset_group_tag_id", "gt:2")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, api.ErrorResponseDetailsFilterPredicateNotSupported)
+				},
+			},
+			{
+				Name: "Database error before streaming",
+				Setup: func() {
+					mockDB.EXPECT().
+						StreamAssetGroupHistoryRecords(gomock.Any(), model.SQLFilter{}, defaultSort, gomock.Any()).
+						Return(errors.New("database error"))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
+			{
+				Name: "Success CSV with date range and tag filters",
+				Input: func(input *apitest.Input) {
+					apitest.AddQueryParam(input, "created_at", "gte:2025-06-01T00:00:00Z")
+					apitest.AddQueryParam(input, "asset_group_tag_id", "eq:1")
+				},
+				Setup: func() {
+					mockDB.EXPECT().
+						StreamAssetGroupHistoryRecords(gomock.Any(), gomock.Any(), defaultSort, gomock.Any()).
+						DoAndReturn(func(ctx context.Context, sqlFilter model.SQLFilter, sort model.Sort, delegate func(model.AssetGroupHistory) error) error {
+							require.Contains(t, sqlFilter.SQLString, "created_at >= '2025-06-01T00:00:00Z'")
+							require.Contains(t, sqlFilter.SQLString, "asset_group_tag_id = 1")
+							return streamRecords(expectedHistoryRecs)(ctx, sqlFilter, sort, delegate)
+						})
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.BodyContains(output, "id,created_at,actor,email,action,target,asset_group_tag_id,environment_id,note\n")
+					apitest.BodyContains(output, "1,2025-06-10T00:00:00Z,UUID1,user1@domain.com,CreateTag,\"tag, one\",1,,\n")
+					apitest.BodyContains(output, "2,2025-06-11T00:00:00Z,UUID2,,UpdateTag,tag,2,,note\n")
+				},
+			},
+			{
+				Name:  "Success CSV escapes formula fields",
+				Input: func(input *apitest.Input) {},
+				Setup: func() {
+					mockDB.EXPECT().
+						StreamAssetGroupHistoryRecords(gomock.Any(), model.SQLFilter{}, defaultSort, gomock.Any()).
+						DoAndReturn(streamRecords([]model.AssetGroupHistory{{
+							ID:              3,
+							CreatedAt:       time.Date(2025, 6, 12, 0, 0, 0, 0, time.UTC),
+							Actor:           "UUID3",
+							Email:           null.StringFrom("@user3@domain.com"),
+							Action:          model.AssetGroupHistoryActionUpdateTag,
+							Target:          "-tag",
+							AssetGroupTagId: 3,
+							Note:            null.StringFrom("=HYPERLINK(\"https://example.com\")"),
+						}}))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+					apitest.BodyContains(output, "3,2025-06-12T00:00:00Z,UUID3,'@user3@domain.com,UpdateTag,'-tag,3,,\"'=HYPERLINK(\"\"https://example.com\"\")\"\n")
+				},
+			},
+			{
+				Name: "Success JSON",
+				Input: func(input *apitest.Input) {
+					apitest.AddQueryParam(input, api.QueryParameterFormat, "json")
+				},
+				Setup: func() {
+					mockDB.EXPECT().
+						StreamAssetGroupHistoryRecords(gomock.Any(), model.SQLFilter{}, defaultSort, gomock.Any()).
+						DoAndReturn(streamRecords(expectedHistoryRecs))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+
+					var records []model.AssetGroupHistory
+					apitest.UnmarshalBody(output, &records)
+					require.Equal(t, expectedHistoryRecs, records)
+				},
+			},
+			{
+				Name: "Success JSON with no records",
+				Input: func(input *apitest.Input) {
+					apitest.AddQueryParam(input, api.QueryParameterFormat, "JSON")
+				},
+				Setup: func() {
+					mockDB.EXPECT().
+						StreamAssetGroupHistoryRecords(gomock.Any(), model.SQLFilter{}, defaultSort, gomock.Any()).
+						DoAndReturn(streamRecords(nil))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+
+					var records []model.AssetGroupHistory
+					apitest.UnmarshalBody(output, &records)
+					require.Empty(t, records)
+				},
+			},
+		})
+}
+
 func TestResources_SearchAssetGroupTagHistory(t *testing.T) {
 	t.Parallel()
 
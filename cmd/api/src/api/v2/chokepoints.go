@@ -0,0 +1,73 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/bhctx"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/utils"
+)
+
+const (
+	QueryParameterChokePointType = "type"
+
+	ErrInvalidChokePointType = "type must be one of node or relationship"
+)
+
+// GetChokePoints returns the attack path choke points saved for an environment by the last analysis that scored it
+func (s *Resources) GetChokePoints(response http.ResponseWriter, request *http.Request) {
+	var (
+		ctx         = request.Context()
+		chokePoints model.ChokePoints
+		queryParams = request.URL.Query()
+	)
+
+	if environmentID := queryParams.Get(api.QueryParameterEnvironmentId); environmentID == "" {
+		api.WriteErrorResponse(ctx, api.BuildErrorResponse(http.StatusBadRequest, ErrNoEnvironmentId, request), response)
+	} else if user, found := auth.GetUserFromAuthCtx(bhctx.FromRequest(request).AuthCtx); !found {
+		api.WriteErrorResponse(ctx, api.BuildErrorResponse(http.StatusInternalServerError, ErrUnknownUser, request), response)
+	} else if chokePointType := model.ChokePointType(queryParams.Get(QueryParameterChokePointType)); chokePointType != "" && chokePointType != model.ChokePointTypeNode && chokePointType != model.ChokePointTypeRelationship {
+		api.WriteErrorResponse(ctx, api.BuildErrorResponse(http.StatusBadRequest, ErrInvalidChokePointType, request), response)
+	} else if order, _, err := parseOrder(queryParams, chokePoints); err != nil {
+		api.WriteErrorResponse(ctx, api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	} else if limit, err := ParseLimitQueryParameter(queryParams, 100); err != nil {
+		api.WriteErrorResponse(ctx, api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf(utils.ErrorInvalidLimit, queryParams["limit"]), request), response)
+	} else if skip, err := ParseSkipQueryParameter(queryParams, 0); err != nil {
+		api.WriteErrorResponse(ctx, api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf(utils.ErrorInvalidSkip, queryParams["skip"]), request), response)
+	} else {
+		if ShouldFilterForETAC(s.DogTags, user) {
+			if hasAccess, err := CheckUserAccessToEnvironments(ctx, s.DB, user, environmentID); err != nil {
+				api.WriteErrorResponse(ctx, api.BuildErrorResponse(http.StatusInternalServerError, err.Error(), request), response)
+				return
+			} else if !hasAccess {
+				api.WriteErrorResponse(ctx, api.BuildErrorResponse(http.StatusForbidden, ErrNoAccess, request), response)
+				return
+			}
+		}
+
+		if chokePoints, count, err := s.DB.GetChokePoints(ctx, environmentID, chokePointType, order, limit, skip); err != nil {
+			api.HandleDatabaseError(request, response, err)
+		} else {
+			api.WriteResponseWrapperWithPagination(ctx, chokePoints, limit, skip, count, http.StatusOK, response)
+		}
+	}
+}
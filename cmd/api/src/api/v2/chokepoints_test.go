@@ -0,0 +1,132 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	v2 "github.com/specterops/bloodhound/cmd/api/src/api/v2"
+	"github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/services/dogtags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResources_GetChokePoints(t *testing.T) {
+	const environmentID = "S-1-5-21-1"
+
+	var (
+		chokePoints = model.ChokePoints{{
+			EnvironmentID:     environmentID,
+			Rank:              1,
+			Type:              model.ChokePointTypeRelationship,
+			Kind:              "GenericAll",
+			StartObjectID:     "S-1-5-21-1-1104",
+			EndObjectID:       "S-1-5-21-1-512",
+			PrincipalsRemoved: 12,
+			Principals:        40,
+			Betweenness:       18.5,
+		}}
+		etacEnabled = dogtags.TestOverrides{
+			Bools: map[dogtags.BoolDogTag]bool{
+				dogtags.ETAC_ENABLED: true,
+			},
+		}
+	)
+
+	for _, testCase := range []struct {
+		name         string
+		params       url.Values
+		dogTags      dogtags.TestOverrides
+		setup        func(mockDB *mocks.MockDatabase)
+		expectedCode int
+	}{
+		{
+			name:         "missing environment",
+			params:       url.Values{},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "invalid type",
+			params:       url.Values{api.QueryParameterEnvironmentId: {environmentID}, "type": {"path"}},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "unsortable column",
+			params:       url.Values{api.QueryParameterEnvironmentId: {environmentID}, "sort_by": {"name"}},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:   "defaults",
+			params: url.Values{api.QueryParameterEnvironmentId: {environmentID}},
+			setup: func(mockDB *mocks.MockDatabase) {
+				mockDB.EXPECT().GetChokePoints(gomock.Any(), environmentID, model.ChokePointType(""), "", 100, 0).Return(chokePoints, 1, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:   "filtered and sorted",
+			params: url.Values{api.QueryParameterEnvironmentId: {environmentID}, "type": {"relationship"}, "sort_by": {"-betweenness"}, "limit": {"10"}, "skip": {"5"}},
+			setup: func(mockDB *mocks.MockDatabase) {
+				mockDB.EXPECT().GetChokePoints(gomock.Any(), environmentID, model.ChokePointTypeRelationship, "betweenness desc", 10, 5).Return(chokePoints, 1, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:    "environment access denied",
+			params:  url.Values{api.QueryParameterEnvironmentId: {environmentID}},
+			dogTags: etacEnabled,
+			setup: func(mockDB *mocks.MockDatabase) {
+				mockDB.EXPECT().GetEnvironmentTargetedAccessControlForUser(gomock.Any(), gomock.Any()).Return([]model.EnvironmentTargetedAccessControl{{EnvironmentID: "S-1-5-21-2"}}, nil)
+			},
+			expectedCode: http.StatusForbidden,
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			var (
+				mockCtrl  = gomock.NewController(t)
+				mockDB    = mocks.NewMockDatabase(mockCtrl)
+				resources = v2.Resources{DB: mockDB, DogTags: dogtags.NewTestService(testCase.dogTags)}
+			)
+
+			if testCase.setup != nil {
+				testCase.setup(mockDB)
+			}
+
+			request, err := http.NewRequest(http.MethodGet, "/api/v2/attack-paths/choke-points?"+testCase.params.Encode(), nil)
+			require.NoError(t, err)
+			request = request.WithContext(setupUserCtx(setupUser()))
+
+			recorder := httptest.NewRecorder()
+			http.HandlerFunc(resources.GetChokePoints).ServeHTTP(recorder, request)
+			require.Equal(t, testCase.expectedCode, recorder.Code)
+
+			if testCase.expectedCode == http.StatusOK {
+				var body api.ResponseWrapper
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+				assert.Equal(t, 1, body.Count)
+			}
+		})
+	}
+}
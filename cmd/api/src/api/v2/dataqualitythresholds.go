@@ -0,0 +1,105 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/utils"
+)
+
+func (s Resources) GetDataQualityThresholds(response http.ResponseWriter, request *http.Request) {
+	if thresholds, err := s.DB.GetDataQualityThresholds(request.Context()); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		api.WriteBasicResponse(request.Context(), thresholds, http.StatusOK, response)
+	}
+}
+
+func (s Resources) CreateDataQualityThreshold(response http.ResponseWriter, request *http.Request) {
+	var threshold model.DataQualityThreshold
+
+	if err := api.ReadJSONRequestPayloadLimited(&threshold, request); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponsePayloadUnmarshalError, request), response)
+	} else if err := threshold.WithDefaults().Validate(); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	} else if created, err := s.DB.CreateDataQualityThreshold(request.Context(), threshold.WithDefaults()); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		api.WriteBasicResponse(request.Context(), created, http.StatusCreated, response)
+	}
+}
+
+func (s Resources) UpdateDataQualityThreshold(response http.ResponseWriter, request *http.Request) {
+	var threshold model.DataQualityThreshold
+
+	if thresholdID, err := strconv.ParseInt(mux.Vars(request)[api.URIPathVariableDataQualityThresholdID], 10, 32); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if err := api.ReadJSONRequestPayloadLimited(&threshold, request); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponsePayloadUnmarshalError, request), response)
+	} else if err := threshold.WithDefaults().Validate(); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	} else {
+		threshold = threshold.WithDefaults()
+		threshold.ID = int32(thresholdID)
+
+		if updated, err := s.DB.UpdateDataQualityThreshold(request.Context(), threshold); err != nil {
+			api.HandleDatabaseError(request, response, err)
+		} else {
+			api.WriteBasicResponse(request.Context(), updated, http.StatusOK, response)
+		}
+	}
+}
+
+func (s Resources) DeleteDataQualityThreshold(response http.ResponseWriter, request *http.Request) {
+	if thresholdID, err := strconv.ParseInt(mux.Vars(request)[api.URIPathVariableDataQualityThresholdID], 10, 32); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if err := s.DB.DeleteDataQualityThreshold(request.Context(), int32(thresholdID)); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		response.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s Resources) GetDataQualityViolations(response http.ResponseWriter, request *http.Request) {
+	var (
+		violations               model.DataQualityViolations
+		queryParams              = request.URL.Query()
+		defaultEnd, defaultStart = DefaultTimeRange()
+	)
+
+	if order, _, err := parseOrder(queryParams, violations); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	} else if start, err := ParseTimeQueryParameter(queryParams, "start", defaultStart); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf(api.ErrorInvalidRFC3339, queryParams["start"]), request), response)
+	} else if end, err := ParseTimeQueryParameter(queryParams, "end", defaultEnd); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf(api.ErrorInvalidRFC3339, queryParams["end"]), request), response)
+	} else if limit, err := ParseLimitQueryParameter(queryParams, 1000); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf(utils.ErrorInvalidLimit, queryParams["limit"]), request), response)
+	} else if skip, err := ParseSkipQueryParameter(queryParams, 0); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf(utils.ErrorInvalidSkip, queryParams["skip"]), request), response)
+	} else if violations, count, err := s.DB.GetDataQualityViolations(request.Context(), start, end, order, limit, skip); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		api.WriteResponseWrapperWithTimeWindowAndPagination(request.Context(), violations, start, end, limit, skip, count, http.StatusOK, response)
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	v2 "github.com/specterops/bloodhound/cmd/api/src/api/v2"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/packages/go/headers"
+	"github.com/specterops/bloodhound/packages/go/mediatypes"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResources_DataQualityThresholds(t *testing.T) {
+	const (
+		thresholdsEndpoint = "/api/v2/data-quality-thresholds"
+		thresholdEndpoint  = "/api/v2/data-quality-thresholds/{data_quality_threshold_id}"
+		validPayload       = `{"source":"ad","stat":"users","threshold_type":"percent","threshold":20,"pause_tagging":true,"enabled":true}`
+	)
+
+	var (
+		expectedThreshold = model.DataQualityThreshold{
+			Source:        model.DataQualitySourceAD,
+			Stat:          "users",
+			ThresholdType: model.DataQualityThresholdTypePercent,
+			Direction:     model.DataQualityThresholdDirectionDecrease,
+			Threshold:     20,
+			BaselineRuns:  model.DefaultDataQualityBaselineRuns,
+			PauseTagging:  true,
+			Enabled:       true,
+		}
+	)
+
+	tests := []struct {
+		name         string
+		method       string
+		url          string
+		payload      string
+		setupMocks   func(mockDB *mocks.MockDatabase)
+		expectedCode int
+	}{
+		{
+			name:   "lists thresholds",
+			method: http.MethodGet,
+			url:    thresholdsEndpoint,
+			setupMocks: func(mockDB *mocks.MockDatabase) {
+				mockDB.EXPECT().GetDataQualityThresholds(gomock.Any()).Return(model.DataQualityThresholds{expectedThreshold}, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:    "creates a threshold with defaults",
+			method:  http.MethodPost,
+			url:     thresholdsEndpoint,
+			payload: validPayload,
+			setupMocks: func(mockDB *mocks.MockDatabase) {
+				mockDB.EXPECT().CreateDataQualityThreshold(gomock.Any(), expectedThreshold).Return(expectedThreshold, nil)
+			},
+			expectedCode: http.StatusCreated,
+		},
+		{
+			name:         "rejects a malformed payload",
+			method:       http.MethodPost,
+			url:          thresholdsEndpoint,
+			payload:      `{"threshold":"twenty"}`,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "rejects an unknown stat",
+			method:       http.MethodPost,
+			url:          thresholdsEndpoint,
+			payload:      `{"source":"ad","stat":"tenants","threshold_type":"percent","threshold":20}`,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:    "updates a threshold",
+			method:  http.MethodPut,
+			url:     "/api/v2/data-quality-thresholds/7",
+			payload: validPayload,
+			setupMocks: func(mockDB *mocks.MockDatabase) {
+				updated := expectedThreshold
+				updated.ID = 7
+
+				mockDB.EXPECT().UpdateDataQualityThreshold(gomock.Any(), updated).Return(updated, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "rejects a malformed threshold id",
+			method:       http.MethodPut,
+			url:          "/api/v2/data-quality-thresholds/seven",
+			payload:      validPayload,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:    "returns not found when updating a missing threshold",
+			method:  http.MethodPut,
+			url:     "/api/v2/data-quality-thresholds/7",
+			payload: validPayload,
+			setupMocks: func(mockDB *mocks.MockDatabase) {
+				mockDB.EXPECT().UpdateDataQualityThreshold(gomock.Any(), gomock.Any()).Return(model.DataQualityThreshold{}, database.ErrNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:   "deletes a threshold",
+			method: http.MethodDelete,
+			url:    "/api/v2/data-quality-thresholds/7",
+			setupMocks: func(mockDB *mocks.MockDatabase) {
+				mockDB.EXPECT().DeleteDataQualityThreshold(gomock.Any(), int32(7)).Return(nil)
+			},
+			expectedCode: http.StatusNoContent,
+		},
+		{
+			name:   "returns database errors when deleting",
+			method: http.MethodDelete,
+			url:    "/api/v2/data-quality-thresholds/7",
+			setupMocks: func(mockDB *mocks.MockDatabase) {
+				mockDB.EXPECT().DeleteDataQualityThreshold(gomock.Any(), int32(7)).Return(errors.New("db error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mockCtrl  = gomock.NewController(t)
+				mockDB    = mocks.NewMockDatabase(mockCtrl)
+				resources = v2.Resources{DB: mockDB}
+				router    = mux.NewRouter()
+				recorder  = httptest.NewRecorder()
+			)
+
+			if tt.setupMocks != nil {
+				tt.setupMocks(mockDB)
+			}
+
+			router.HandleFunc(thresholdsEndpoint, resources.GetDataQualityThresholds).Methods(http.MethodGet)
+			router.HandleFunc(thresholdsEndpoint, resources.CreateDataQualityThreshold).Methods(http.MethodPost)
+			router.HandleFunc(thresholdEndpoint, resources.UpdateDataQualityThreshold).Methods(http.MethodPut)
+			router.HandleFunc(thresholdEndpoint, resources.DeleteDataQualityThreshold).Methods(http.MethodDelete)
+
+			request, err := http.NewRequest(tt.method, tt.url, strings.NewReader(tt.payload))
+			require.NoError(t, err)
+			request.Header.Set(headers.ContentType.String(), mediatypes.ApplicationJson.String())
+
+			router.ServeHTTP(recorder, request)
+			require.Equal(t, tt.expectedCode, recorder.Code, recorder.Body.String())
+		})
+	}
+}
+
+func TestResources_GetDataQualityViolations(t *testing.T) {
+	var (
+		mockCtrl   = gomock.NewController(t)
+		mockDB     = mocks.NewMockDatabase(mockCtrl)
+		resources  = v2.Resources{DB: mockDB}
+		violations = model.DataQualityViolations{{RunID: "run-1", ThresholdID: 1, Source: model.DataQualitySourceAD, Stat: "users"}}
+	)
+
+	mockDB.EXPECT().GetDataQualityViolations(gomock.Any(), gomock.Any(), gomock.Any(), "created_at desc", 10, 0).Return(violations, 1, nil)
+
+	request, err := http.NewRequest(http.MethodGet, "/api/v2/data-quality-violations?sort_by=-created_at&limit=10", nil)
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	http.HandlerFunc(resources.GetDataQualityViolations).ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var body api.ResponseWrapper
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+	require.Equal(t, 1, body.Count)
+	require.Len(t, body.Data, 1)
+
+	request, err = http.NewRequest(http.MethodGet, "/api/v2/data-quality-violations?sort_by=stat", nil)
+	require.NoError(t, err)
+
+	recorder = httptest.NewRecorder()
+	http.HandlerFunc(resources.GetDataQualityViolations).ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+}
@@ -17,6 +17,7 @@
 package v2
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -27,6 +28,7 @@ import (
 
 	"github.com/specterops/bloodhound/cmd/api/src/api"
 	"github.com/specterops/bloodhound/packages/go/analysis/ad"
+	"github.com/specterops/bloodhound/packages/go/analysis/post"
 	"github.com/specterops/dawgs/graph"
 )
 
@@ -101,15 +103,38 @@ func (s *Resources) GetEdgeComposition(response http.ResponseWriter, request *ht
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("Could not find edge matching criteria: %v", err), request), response)
 	} else if pathSet, err := ad.GetEdgeCompositionPath(request.Context(), s.Graph, edge); err != nil {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, fmt.Sprintf("Error getting composition for edge: %v", err), request), response)
+	} else if nodes, relationships, err := s.fetchRecordedEdgeComposition(request.Context(), edge, pathSet); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusInternalServerError, fmt.Sprintf("Error getting composition for edge: %v", err), request), response)
 	} else if primaryDisplayKinds, err := s.DB.GetPrimaryDisplayKinds(request.Context()); err != nil {
 		api.HandleDatabaseError(request, response, err)
 	} else {
 		unifiedGraph := model.NewUnifiedGraph()
 		unifiedGraph.AddPathSet(primaryDisplayKinds, pathSet, true)
+
+		for _, node := range nodes {
+			unifiedGraph.AddNode(primaryDisplayKinds, node, true)
+		}
+
+		for _, relationship := range relationships {
+			unifiedGraph.AddRelationship(relationship, true)
+		}
+
 		api.WriteBasicResponse(request.Context(), unifiedGraph, http.StatusOK, response)
 	}
 }
 
+// fetchRecordedEdgeComposition returns the contributing nodes and edges recorded on a post-processed edge during
+// analysis. It is only consulted for edge kinds that have no path composition.
+func (s *Resources) fetchRecordedEdgeComposition(ctx context.Context, edge *graph.Relationship, pathSet graph.PathSet) (graph.NodeSet, []*graph.Relationship, error) {
+	if pathSet.Len() > 0 || edge == nil {
+		return graph.NewNodeSet(), nil, nil
+	} else if composition, found := post.ParseEdgeComposition(edge.Properties); !found {
+		return graph.NewNodeSet(), nil, nil
+	} else {
+		return post.FetchEdgeCompositionGraph(ctx, s.Graph, composition)
+	}
+}
+
 func (s *Resources) GetEdgeACLInheritancePath(response http.ResponseWriter, request *http.Request) {
 	var (
 		params = request.URL.Query()
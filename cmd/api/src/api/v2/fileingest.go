@@ -142,7 +142,7 @@ func (s Resources) ProcessIngestTask(response http.ResponseWriter, request *http
 	}
 
 	if !IsValidContentTypeForUpload(request.Header) {
-		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "Content type must be application/json or application/zip", request), response)
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, "Content type must be application/json, application/zip, application/x-ndjson or multipart/form-data", request), response)
 	} else if jobID, err := strconv.Atoi(jobIdString); err != nil {
 		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, request), response)
 	} else if ingestJob, err := job.GetIngestJobByID(request.Context(), s.DB, int64(jobID)); err != nil {
@@ -191,9 +191,16 @@ func (s Resources) ProcessIngestTask(response http.ResponseWriter, request *http
 func checkFileName(filename string, fileType model.FileType) string {
 	if filename != "" {
 		return filename
-	} else if fileType == model.FileTypeJson {
+	}
+
+	switch fileType {
+	case model.FileTypeJson:
 		return "UnknownFileName.json"
-	} else {
+	case model.FileTypeNDJSON:
+		return "UnknownFileName.ndjson"
+	case model.FileTypeCSV:
+		return "UnknownFileName.csv"
+	default:
 		return "UnknownFileName.zip"
 	}
 }
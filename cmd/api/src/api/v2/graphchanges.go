@@ -0,0 +1,75 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/utils"
+)
+
+// GetGraphChanges lists the nodes and edges added, modified or removed by ingest and graph data deletion within the
+// requested time window.
+func (s Resources) GetGraphChanges(response http.ResponseWriter, request *http.Request) {
+	var (
+		changes                    model.GraphChanges
+		queryParams                = request.URL.Query()
+		defaultUntil, defaultSince = DefaultTimeRange()
+	)
+
+	if order, _, err := parseOrder(queryParams, changes); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	} else if since, err := ParseTimeQueryParameter(queryParams, api.QueryParameterSince, defaultSince); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf(api.ErrorInvalidRFC3339, queryParams[api.QueryParameterSince]), request), response)
+	} else if until, err := ParseTimeQueryParameter(queryParams, api.QueryParameterUntil, defaultUntil); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf(api.ErrorInvalidRFC3339, queryParams[api.QueryParameterUntil]), request), response)
+	} else if limit, err := ParseLimitQueryParameter(queryParams, 1000); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf(utils.ErrorInvalidLimit, queryParams["limit"]), request), response)
+	} else if skip, err := ParseSkipQueryParameter(queryParams, 0); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf(utils.ErrorInvalidSkip, queryParams["skip"]), request), response)
+	} else if changes, count, err := s.DB.GetGraphChanges(request.Context(), since, until, order, limit, skip); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		api.WriteResponseWrapperWithTimeWindowAndPagination(request.Context(), changes, since, until, limit, skip, count, http.StatusOK, response)
+	}
+}
+
+// GetGraphObjectChanges lists the history of a node, including the edges that start or end at the node.
+func (s Resources) GetGraphObjectChanges(response http.ResponseWriter, request *http.Request) {
+	var (
+		changes     model.GraphChanges
+		queryParams = request.URL.Query()
+	)
+
+	if objectID, hasObjectID := mux.Vars(request)[api.URIPathVariableObjectID]; !hasObjectID || objectID == "" {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if order, _, err := parseOrder(queryParams, changes); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	} else if limit, err := ParseLimitQueryParameter(queryParams, 1000); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf(utils.ErrorInvalidLimit, queryParams["limit"]), request), response)
+	} else if skip, err := ParseSkipQueryParameter(queryParams, 0); err != nil {
+		api.WriteErrorResponse(request.Context(), api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf(utils.ErrorInvalidSkip, queryParams["skip"]), request), response)
+	} else if changes, count, err := s.DB.GetGraphObjectChanges(request.Context(), objectID, order, limit, skip); err != nil {
+		api.HandleDatabaseError(request, response, err)
+	} else {
+		api.WriteResponseWrapperWithPagination(request.Context(), changes, limit, skip, count, http.StatusOK, response)
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	v2 "github.com/specterops/bloodhound/cmd/api/src/api/v2"
+	"github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResources_GetGraphChanges(t *testing.T) {
+	const endpoint = "/api/v2/graph/changes"
+
+	changes := model.GraphChanges{{ObjectType: model.GraphObjectTypeNode, ChangeType: model.GraphChangeTypeAdded, ObjectID: "ABC"}}
+
+	tests := []struct {
+		name         string
+		url          string
+		setupMocks   func(mockDB *mocks.MockDatabase)
+		expectedCode int
+	}{
+		{
+			name: "lists changes since a point in time",
+			url:  endpoint + "?since=2026-10-01T00:00:00Z&sort_by=-created_at&limit=10",
+			setupMocks: func(mockDB *mocks.MockDatabase) {
+				mockDB.EXPECT().GetGraphChanges(gomock.Any(), gomock.Any(), gomock.Any(), "created_at desc", 10, 0).Return(changes, 1, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "rejects a malformed since",
+			url:          endpoint + "?since=yesterday",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "rejects an unsortable column",
+			url:          endpoint + "?sort_by=kind",
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "returns database errors",
+			url:  endpoint,
+			setupMocks: func(mockDB *mocks.MockDatabase) {
+				mockDB.EXPECT().GetGraphChanges(gomock.Any(), gomock.Any(), gomock.Any(), "", 1000, 0).Return(nil, 0, errors.New("db error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mockCtrl  = gomock.NewController(t)
+				mockDB    = mocks.NewMockDatabase(mockCtrl)
+				resources = v2.Resources{DB: mockDB}
+				recorder  = httptest.NewRecorder()
+			)
+
+			if tt.setupMocks != nil {
+				tt.setupMocks(mockDB)
+			}
+
+			request, err := http.NewRequest(http.MethodGet, tt.url, nil)
+			require.NoError(t, err)
+
+			http.HandlerFunc(resources.GetGraphChanges).ServeHTTP(recorder, request)
+			require.Equal(t, tt.expectedCode, recorder.Code, recorder.Body.String())
+		})
+	}
+}
+
+func TestResources_GetGraphObjectChanges(t *testing.T) {
+	var (
+		mockCtrl  = gomock.NewController(t)
+		mockDB    = mocks.NewMockDatabase(mockCtrl)
+		resources = v2.Resources{DB: mockDB}
+		router    = mux.NewRouter()
+		changes   = model.GraphChanges{
+			{ObjectType: model.GraphObjectTypeNode, ChangeType: model.GraphChangeTypeModified, ObjectID: "ABC"},
+			{ObjectType: model.GraphObjectTypeEdge, ChangeType: model.GraphChangeTypeAdded, SourceObjectID: "ABC", TargetObjectID: "DEF", Kind: "GenericAll"},
+		}
+	)
+
+	router.HandleFunc("/api/v2/graph/changes/{object_id}", resources.GetGraphObjectChanges).Methods(http.MethodGet)
+
+	mockDB.EXPECT().GetGraphObjectChanges(gomock.Any(), "ABC", "", 1000, 0).Return(changes, 2, nil)
+
+	request, err := http.NewRequest(http.MethodGet, "/api/v2/graph/changes/ABC", nil)
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var body api.ResponseWrapper
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+	require.Equal(t, 2, body.Count)
+	require.Len(t, body.Data, 2)
+}
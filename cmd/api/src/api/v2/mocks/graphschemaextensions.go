@@ -119,6 +119,21 @@ func (mr *MockOpenGraphSchemaServiceMockRecorder) ListExtensions(ctx any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExtensions", reflect.TypeOf((*MockOpenGraphSchemaService)(nil).ListExtensions), ctx)
 }
 
+// RollbackOpenGraphExtension mocks base method.
+func (m *MockOpenGraphSchemaService) RollbackOpenGraphExtension(ctx context.Context, extensionID int32) (model.GraphSchemaExtension, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RollbackOpenGraphExtension", ctx, extensionID)
+	ret0, _ := ret[0].(model.GraphSchemaExtension)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RollbackOpenGraphExtension indicates an expected call of RollbackOpenGraphExtension.
+func (mr *MockOpenGraphSchemaServiceMockRecorder) RollbackOpenGraphExtension(ctx, extensionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackOpenGraphExtension", reflect.TypeOf((*MockOpenGraphSchemaService)(nil).RollbackOpenGraphExtension), ctx, extensionID)
+}
+
 // UpsertOpenGraphExtension mocks base method.
 func (m *MockOpenGraphSchemaService) UpsertOpenGraphExtension(ctx context.Context, openGraphExtension model.GraphExtensionInput) (bool, error) {
 	m.ctrl.T.Helper()
@@ -45,6 +45,7 @@ type OpenGraphSchemaService interface {
 	UpsertOpenGraphExtension(ctx context.Context, openGraphExtension model.GraphExtensionInput) (bool, error)
 	ListExtensions(ctx context.Context) (model.GraphSchemaExtensions, error)
 	DeleteExtension(ctx context.Context, extensionID int32) error
+	RollbackOpenGraphExtension(ctx context.Context, extensionID int32) (model.GraphSchemaExtension, error)
 	GetEnvironmentKindsAndSchemaEnvironmentData(ctx context.Context, onlyBuiltin bool) (graph.Kinds, model.EnvironmentKindsToEnvironment, error)
 	GetSchemaFindings(ctx context.Context, filters model.Filters, sort model.Sort, skip, limit int) ([]model.SchemaFinding, int, error)
 }
@@ -182,3 +183,39 @@ func (s Resources) DeleteExtension(response http.ResponseWriter, request *http.R
 		response.WriteHeader(http.StatusNoContent)
 	}
 }
+
+// RollbackExtension - restores the version of an extension that was installed before the active one
+func (s Resources) RollbackExtension(response http.ResponseWriter, request *http.Request) {
+	var (
+		ctx         = request.Context()
+		extensionID = mux.Vars(request)[api.URIPathVariableExtensionID]
+	)
+
+	if extID, err := strconv.ParseInt(extensionID, 10, 32); err != nil {
+		api.WriteErrorResponse(ctx, api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, request), response)
+	} else if extension, err := s.OpenGraphSchemaService.RollbackOpenGraphExtension(ctx, int32(extID)); err != nil {
+		switch {
+		case errors.Is(err, database.ErrNotFound):
+			api.WriteErrorResponse(ctx, api.BuildErrorResponse(http.StatusNotFound, fmt.Sprintf("no extension found matching extension id: %s", extensionID), request), response)
+		case errors.Is(err, model.ErrGraphExtensionBuiltIn):
+			api.WriteErrorResponse(ctx, api.BuildErrorResponse(http.StatusBadRequest, "built-in extensions cannot be rolled back", request), response)
+		case errors.Is(err, model.ErrGraphExtensionNoPriorVersion), errors.Is(err, model.ErrGraphExtensionValidation):
+			api.WriteErrorResponse(ctx, api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+		default:
+			slog.WarnContext(
+				ctx,
+				"Error rolling back open graph extension",
+				attr.Error(err),
+			)
+			api.WriteErrorResponse(ctx, api.BuildErrorResponse(http.StatusInternalServerError, api.ErrorResponseDetailsInternalServerError, request), response)
+		}
+	} else {
+		api.WriteBasicResponse(ctx, ExtensionInfo{
+			ID:        extension.ID,
+			Name:      extension.DisplayName,
+			Version:   extension.Version,
+			IsBuiltIn: extension.IsBuiltin,
+			Namespace: extension.Namespace,
+		}, http.StatusOK, response)
+	}
+}
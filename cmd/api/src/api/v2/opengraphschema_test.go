@@ -794,3 +794,166 @@ func TestResources_DeleteExtension(t *testing.T) {
 		})
 	}
 }
+
+func TestResources_RollbackExtension(t *testing.T) {
+	t.Parallel()
+
+	type mock struct {
+		mockOpenGraphSchemaService *schemamocks.MockOpenGraphSchemaService
+	}
+	type expected struct {
+		responseBody   string
+		responseCode   int
+		responseHeader http.Header
+	}
+	type testData struct {
+		name         string
+		buildRequest func() *http.Request
+		setupMocks   func(t *testing.T, mock *mock)
+		expected     expected
+	}
+
+	buildRequest := func(path string) func() *http.Request {
+		return func() *http.Request {
+			request := &http.Request{
+				URL: &url.URL{
+					Path: path,
+				},
+				Method: http.MethodPost,
+			}
+
+			requestCtx := bhctx.Context{
+				RequestID: "id",
+				AuthCtx: auth.Context{
+					Owner: model.User{
+						Roles: model.Roles{
+							{
+								Name: auth.RoleAdministrator,
+								Permissions: model.Permissions{
+									auth.Permissions().AuthManageSelf,
+								},
+							},
+						},
+					},
+					Session: model.UserSession{},
+				},
+			}
+
+			return request.WithContext(context.WithValue(context.Background(), bhctx.ValueKey, requestCtx.WithRequestID("id")))
+		}
+	}
+
+	tt := []testData{
+		{
+			name:         "Error: invalid extension id",
+			buildRequest: buildRequest("/api/v2/extensions/id/rollback"),
+			setupMocks:   func(t *testing.T, mock *mock) {},
+			expected: expected{
+				responseCode:   http.StatusBadRequest,
+				responseHeader: http.Header{"Content-Type": []string{"application/json"}},
+				responseBody:   `{"errors":[{"context":"","message":"id is malformed"}],"http_status":400,"request_id":"id","timestamp":"0001-01-01T00:00:00Z"}`,
+			},
+		},
+		{
+			name:         "Error: extension id not found in database",
+			buildRequest: buildRequest("/api/v2/extensions/1/rollback"),
+			setupMocks: func(t *testing.T, mock *mock) {
+				t.Helper()
+				mock.mockOpenGraphSchemaService.EXPECT().RollbackOpenGraphExtension(gomock.Any(), int32(1)).Return(model.GraphSchemaExtension{}, fmt.Errorf("error retrieving graph extension: %w", database.ErrNotFound))
+			},
+			expected: expected{
+				responseCode:   http.StatusNotFound,
+				responseHeader: http.Header{"Content-Type": []string{"application/json"}},
+				responseBody:   `{"errors":[{"context":"","message": "no extension found matching extension id: 1"}],"http_status":404,"request_id":"id","timestamp":"0001-01-01T00:00:00Z"}`,
+			},
+		},
+		{
+			name:         "Error: built-in extension",
+			buildRequest: buildRequest("/api/v2/extensions/1/rollback"),
+			setupMocks: func(t *testing.T, mock *mock) {
+				t.Helper()
+				mock.mockOpenGraphSchemaService.EXPECT().RollbackOpenGraphExtension(gomock.Any(), int32(1)).Return(model.GraphSchemaExtension{}, model.ErrGraphExtensionBuiltIn)
+			},
+			expected: expected{
+				responseCode:   http.StatusBadRequest,
+				responseHeader: http.Header{"Content-Type": []string{"application/json"}},
+				responseBody:   `{"errors":[{"context":"","message": "built-in extensions cannot be rolled back"}],"http_status":400,"request_id":"id","timestamp":"0001-01-01T00:00:00Z"}`,
+			},
+		},
+		{
+			name:         "Error: no prior version",
+			buildRequest: buildRequest("/api/v2/extensions/1/rollback"),
+			setupMocks: func(t *testing.T, mock *mock) {
+				t.Helper()
+				mock.mockOpenGraphSchemaService.EXPECT().RollbackOpenGraphExtension(gomock.Any(), int32(1)).Return(model.GraphSchemaExtension{}, model.ErrGraphExtensionNoPriorVersion)
+			},
+			expected: expected{
+				responseCode:   http.StatusBadRequest,
+				responseHeader: http.Header{"Content-Type": []string{"application/json"}},
+				responseBody:   `{"errors":[{"context":"","message": "graph extension has no prior version to roll back to"}],"http_status":400,"request_id":"id","timestamp":"0001-01-01T00:00:00Z"}`,
+			},
+		},
+		{
+			name:         "Error: failed to roll back extension",
+			buildRequest: buildRequest("/api/v2/extensions/1/rollback"),
+			setupMocks: func(t *testing.T, mock *mock) {
+				t.Helper()
+				mock.mockOpenGraphSchemaService.EXPECT().RollbackOpenGraphExtension(gomock.Any(), int32(1)).Return(model.GraphSchemaExtension{}, errors.New("error"))
+			},
+			expected: expected{
+				responseCode:   http.StatusInternalServerError,
+				responseHeader: http.Header{"Content-Type": []string{"application/json"}},
+				responseBody:   `{"errors":[{"context":"","message": "an internal error has occurred that is preventing the service from servicing this request"}],"http_status":500,"request_id":"id","timestamp":"0001-01-01T00:00:00Z"}`,
+			},
+		},
+		{
+			name:         "Success",
+			buildRequest: buildRequest("/api/v2/extensions/1/rollback"),
+			setupMocks: func(t *testing.T, mock *mock) {
+				t.Helper()
+				mock.mockOpenGraphSchemaService.EXPECT().RollbackOpenGraphExtension(gomock.Any(), int32(1)).Return(model.GraphSchemaExtension{
+					Serial:      model.Serial{ID: 1},
+					Name:        "test_extension",
+					DisplayName: "Test Extension",
+					Version:     "v1.0.0",
+					Namespace:   "TEST",
+				}, nil)
+			},
+			expected: expected{
+				responseCode:   http.StatusOK,
+				responseHeader: http.Header{"Content-Type": []string{"application/json"}},
+				responseBody:   `{"data":{"id":1,"name":"Test Extension","version":"v1.0.0","is_builtin":false,"namespace":"TEST"}}`,
+			},
+		},
+	}
+	for _, testCase := range tt {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mocks := &mock{
+				mockOpenGraphSchemaService: schemamocks.NewMockOpenGraphSchemaService(ctrl),
+			}
+
+			request := testCase.buildRequest()
+			testCase.setupMocks(t, mocks)
+
+			resources := v2.Resources{
+				OpenGraphSchemaService: mocks.mockOpenGraphSchemaService,
+			}
+
+			response := httptest.NewRecorder()
+
+			router := mux.NewRouter()
+			router.HandleFunc(fmt.Sprintf("/api/v2/extensions/{%s}/rollback", api.URIPathVariableExtensionID), resources.RollbackExtension).Methods(request.Method)
+
+			router.ServeHTTP(response, request)
+
+			status, header, body := test.ProcessResponse(t, response)
+
+			assert.Equal(t, testCase.expected.responseCode, status)
+			assert.Equal(t, testCase.expected.responseHeader, header)
+			assert.JSONEq(t, testCase.expected.responseBody, body)
+		})
+	}
+}
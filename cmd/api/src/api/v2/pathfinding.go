@@ -220,18 +220,27 @@ func (s Resources) getAllShortestPaths(ctx context.Context, relationshipKindsPar
 	}
 }
 
-func (s Resources) getAllShortestPathsWithOpenGraph(ctx context.Context, relationshipKindsParam, startNode, endNode string, onlyIncludeTraversableKinds bool, validKinds graph.Kinds, request *http.Request) (graph.PathSet, *api.ErrorWrapper) {
+// fetchOpenGraphRelationshipKinds returns the relationship kinds registered by OpenGraph extensions
+func (s Resources) fetchOpenGraphRelationshipKinds(ctx context.Context, onlyIncludeTraversableKinds bool) (graph.Kinds, error) {
 	relationshipKindFilters := model.Filters{}
 	if onlyIncludeTraversableKinds {
 		relationshipKindFilters["is_traversable"] = append(relationshipKindFilters["is_traversable"], model.Filter{Operator: model.Equals, Value: "true"})
 	}
 	if openGraphRelationships, _, err := s.DB.GetGraphSchemaRelationshipKinds(ctx, relationshipKindFilters, model.Sort{}, 0, 0); err != nil {
-		return nil, api.BuildErrorResponse(http.StatusInternalServerError, api.FormatDatabaseError(err).Error(), request)
+		return nil, err
 	} else {
 		openGraphRelationshipKinds := make(graph.Kinds, 0, len(openGraphRelationships))
 		for _, relationship := range openGraphRelationships {
 			openGraphRelationshipKinds = append(openGraphRelationshipKinds, graph.StringKind(relationship.Name))
 		}
+		return openGraphRelationshipKinds, nil
+	}
+}
+
+func (s Resources) getAllShortestPathsWithOpenGraph(ctx context.Context, relationshipKindsParam, startNode, endNode string, onlyIncludeTraversableKinds bool, validKinds graph.Kinds, request *http.Request) (graph.PathSet, *api.ErrorWrapper) {
+	if openGraphRelationshipKinds, err := s.fetchOpenGraphRelationshipKinds(ctx, onlyIncludeTraversableKinds); err != nil {
+		return nil, api.BuildErrorResponse(http.StatusInternalServerError, api.FormatDatabaseError(err).Error(), request)
+	} else {
 		validKinds = validKinds.Concatenate(openGraphRelationshipKinds)
 		if kindFilter, err := createRelationshipKindFilterCriteria(relationshipKindsParam, onlyIncludeTraversableKinds, validKinds); err != nil {
 			return nil, api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request)
@@ -0,0 +1,114 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/bhctx"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/utils"
+)
+
+// parsePrincipalExposureQueryParameters validates the filter and sort query parameters shared by the principal
+// exposure endpoints and builds the SQL filter and sort order for them
+func parsePrincipalExposureQueryParameters(request *http.Request) (model.SQLFilter, model.Sort, *api.ErrorWrapper) {
+	queryFilters, err := model.NewQueryParameterFilterParser().ParseQueryParameterFilters(request)
+	if err != nil {
+		return model.SQLFilter{}, nil, api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsBadQueryParameterFilters, request)
+	}
+
+	sort, err := api.ParseSortParameters(model.PrincipalExposures{}, request.URL.Query())
+	if err != nil {
+		return model.SQLFilter{}, nil, api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponseDetailsNotSortable, request)
+	}
+
+	for name, filters := range queryFilters {
+		validPredicates, err := api.GetValidFilterPredicatesAsStrings(model.PrincipalExposures{}, name)
+		if err != nil {
+			return model.SQLFilter{}, nil, api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("%s: %s", api.ErrorResponseDetailsColumnNotFilterable, name), request)
+		}
+
+		for i, filter := range filters {
+			if !slices.Contains(validPredicates, string(filter.Operator)) {
+				return model.SQLFilter{}, nil, api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("%s: %s %s", api.ErrorResponseDetailsFilterPredicateNotSupported, filter.Name, filter.Operator), request)
+			}
+			queryFilters[name][i].IsStringData = model.PrincipalExposures{}.IsStringColumn(filter.Name)
+		}
+	}
+
+	sqlFilter, err := queryFilters.BuildSQLFilter()
+	if err != nil {
+		return model.SQLFilter{}, nil, api.BuildErrorResponse(http.StatusBadRequest, "error building SQL for filter", request)
+	}
+
+	return sqlFilter, sort, nil
+}
+
+func (s *Resources) principalExposuresImplementation(response http.ResponseWriter, request *http.Request, latestOnly bool) {
+	var (
+		ctx         = request.Context()
+		queryParams = request.URL.Query()
+		sort        model.Sort
+		sqlFilter   model.SQLFilter
+		errResponse *api.ErrorWrapper
+	)
+
+	if environmentID := queryParams.Get(api.QueryParameterEnvironmentId); environmentID == "" {
+		api.WriteErrorResponse(ctx, api.BuildErrorResponse(http.StatusBadRequest, ErrNoEnvironmentId, request), response)
+	} else if user, found := auth.GetUserFromAuthCtx(bhctx.FromRequest(request).AuthCtx); !found {
+		api.WriteErrorResponse(ctx, api.BuildErrorResponse(http.StatusInternalServerError, ErrUnknownUser, request), response)
+	} else if sqlFilter, sort, errResponse = parsePrincipalExposureQueryParameters(request); errResponse != nil {
+		api.WriteErrorResponse(ctx, errResponse, response)
+	} else if limit, err := ParseLimitQueryParameter(queryParams, 100); err != nil {
+		api.WriteErrorResponse(ctx, api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf(utils.ErrorInvalidLimit, queryParams["limit"]), request), response)
+	} else if skip, err := ParseSkipQueryParameter(queryParams, 0); err != nil {
+		api.WriteErrorResponse(ctx, api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf(utils.ErrorInvalidSkip, queryParams["skip"]), request), response)
+	} else {
+		if ShouldFilterForETAC(s.DogTags, user) {
+			if hasAccess, err := CheckUserAccessToEnvironments(ctx, s.DB, user, environmentID); err != nil {
+				api.WriteErrorResponse(ctx, api.BuildErrorResponse(http.StatusInternalServerError, err.Error(), request), response)
+				return
+			} else if !hasAccess {
+				api.WriteErrorResponse(ctx, api.BuildErrorResponse(http.StatusForbidden, ErrNoAccess, request), response)
+				return
+			}
+		}
+
+		if exposures, count, err := s.DB.GetPrincipalExposures(ctx, environmentID, latestOnly, sqlFilter, sort, skip, limit); err != nil {
+			api.HandleDatabaseError(request, response, err)
+		} else {
+			api.WriteResponseWrapperWithPagination(ctx, exposures, limit, skip, count, http.StatusOK, response)
+		}
+	}
+}
+
+// GetPrincipalExposures returns the impact and exposure of the users and computers of an environment as of its most
+// recent snapshot, ordered by impact unless another order is given
+func (s *Resources) GetPrincipalExposures(response http.ResponseWriter, request *http.Request) {
+	s.principalExposuresImplementation(response, request, true)
+}
+
+// GetPrincipalExposureHistory returns the daily snapshots of the impact and exposure of the users and computers of an
+// environment, newest first unless another order is given
+func (s *Resources) GetPrincipalExposureHistory(response http.ResponseWriter, request *http.Request) {
+	s.principalExposuresImplementation(response, request, false)
+}
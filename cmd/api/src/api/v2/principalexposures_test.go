@@ -0,0 +1,169 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	v2 "github.com/specterops/bloodhound/cmd/api/src/api/v2"
+	"github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/services/dogtags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResources_GetPrincipalExposures(t *testing.T) {
+	const environmentID = "S-1-5-21-1"
+
+	var (
+		exposures = model.PrincipalExposures{{
+			EnvironmentID: environmentID,
+			SnapshotDate:  time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+			ObjectID:      "S-1-5-21-1-1105",
+			Name:          "ALICE@TESTLAB.LOCAL",
+			Kind:          "User",
+			Impact:        12,
+			Exposure:      40,
+		}}
+		etacEnabled = dogtags.TestOverrides{
+			Bools: map[dogtags.BoolDogTag]bool{
+				dogtags.ETAC_ENABLED: true,
+			},
+		}
+	)
+
+	for _, testCase := range []struct {
+		name         string
+		params       url.Values
+		dogTags      dogtags.TestOverrides
+		setup        func(mockDB *mocks.MockDatabase)
+		expectedCode int
+	}{
+		{
+			name:         "missing environment",
+			params:       url.Values{},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "unsortable column",
+			params:       url.Values{api.QueryParameterEnvironmentId: {environmentID}, "sort_by": {"environment_id"}},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "unfilterable column",
+			params:       url.Values{api.QueryParameterEnvironmentId: {environmentID}, "created_at": {"gt:2026-10-01"}},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "unsupported predicate",
+			params:       url.Values{api.QueryParameterEnvironmentId: {environmentID}, "kind": {"gt:User"}},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:   "defaults",
+			params: url.Values{api.QueryParameterEnvironmentId: {environmentID}},
+			setup: func(mockDB *mocks.MockDatabase) {
+				mockDB.EXPECT().GetPrincipalExposures(gomock.Any(), environmentID, true, model.SQLFilter{}, model.Sort{}, 0, 100).Return(exposures, 1, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:   "filtered and sorted",
+			params: url.Values{api.QueryParameterEnvironmentId: {environmentID}, "impact": {"gte:10"}, "sort_by": {"-exposure"}, "limit": {"10"}, "skip": {"5"}},
+			setup: func(mockDB *mocks.MockDatabase) {
+				mockDB.EXPECT().GetPrincipalExposures(gomock.Any(), environmentID, true, model.SQLFilter{SQLString: "impact >= 10"}, model.Sort{{Column: "exposure", Direction: model.DescendingSortDirection}}, 5, 10).Return(exposures, 1, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:    "environment access denied",
+			params:  url.Values{api.QueryParameterEnvironmentId: {environmentID}},
+			dogTags: etacEnabled,
+			setup: func(mockDB *mocks.MockDatabase) {
+				mockDB.EXPECT().GetEnvironmentTargetedAccessControlForUser(gomock.Any(), gomock.Any()).Return([]model.EnvironmentTargetedAccessControl{{EnvironmentID: "S-1-5-21-2"}}, nil)
+			},
+			expectedCode: http.StatusForbidden,
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			var (
+				mockCtrl  = gomock.NewController(t)
+				mockDB    = mocks.NewMockDatabase(mockCtrl)
+				resources = v2.Resources{DB: mockDB, DogTags: dogtags.NewTestService(testCase.dogTags)}
+			)
+
+			if testCase.setup != nil {
+				testCase.setup(mockDB)
+			}
+
+			request, err := http.NewRequest(http.MethodGet, "/api/v2/attack-paths/principal-exposures?"+testCase.params.Encode(), nil)
+			require.NoError(t, err)
+			request = request.WithContext(setupUserCtx(setupUser()))
+
+			recorder := httptest.NewRecorder()
+			http.HandlerFunc(resources.GetPrincipalExposures).ServeHTTP(recorder, request)
+			require.Equal(t, testCase.expectedCode, recorder.Code)
+
+			if testCase.expectedCode == http.StatusOK {
+				var body api.ResponseWrapper
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+				assert.Equal(t, 1, body.Count)
+			}
+		})
+	}
+}
+
+func TestResources_GetPrincipalExposureHistory(t *testing.T) {
+	const (
+		environmentID = "S-1-5-21-1"
+		objectID      = "S-1-5-21-1-1105"
+	)
+
+	var (
+		mockCtrl  = gomock.NewController(t)
+		mockDB    = mocks.NewMockDatabase(mockCtrl)
+		resources = v2.Resources{DB: mockDB, DogTags: dogtags.NewTestService(dogtags.TestOverrides{})}
+		exposures = model.PrincipalExposures{
+			{EnvironmentID: environmentID, SnapshotDate: time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC), ObjectID: objectID, Impact: 12, Exposure: 40},
+			{EnvironmentID: environmentID, SnapshotDate: time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC), ObjectID: objectID, Impact: 9, Exposure: 38},
+		}
+		params = url.Values{api.QueryParameterEnvironmentId: {environmentID}, "object_id": {"eq:" + objectID}}
+	)
+
+	// History spans every snapshot of the environment rather than only the latest one
+	mockDB.EXPECT().GetPrincipalExposures(gomock.Any(), environmentID, false, model.SQLFilter{SQLString: "object_id = '" + objectID + "'"}, model.Sort{}, 0, 100).Return(exposures, 2, nil)
+
+	request, err := http.NewRequest(http.MethodGet, "/api/v2/attack-paths/principal-exposures/history?"+params.Encode(), nil)
+	require.NoError(t, err)
+	request = request.WithContext(setupUserCtx(setupUser()))
+
+	recorder := httptest.NewRecorder()
+	http.HandlerFunc(resources.GetPrincipalExposureHistory).ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var body api.ResponseWrapper
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+	assert.Equal(t, 2, body.Count)
+}
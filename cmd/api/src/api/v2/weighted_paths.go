@@ -0,0 +1,285 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/bhctx"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
+	"github.com/specterops/bloodhound/cmd/api/src/services/dogtags"
+	adAnalysis "github.com/specterops/bloodhound/packages/go/analysis/ad"
+	"github.com/specterops/bloodhound/packages/go/analysis/pathfinding"
+	"github.com/specterops/bloodhound/packages/go/graphschema"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/dawgs/graph"
+)
+
+const (
+	defaultWeightedPathCount = 3
+	defaultWeightedPathCost  = 1
+)
+
+// WeightedPathsRequest asks for the k cheapest loopless paths between two nodes. EdgeCosts assigns a cost to
+// traversing each relationship kind; kinds without an entry cost DefaultCost, which defaults to 1. With no costs
+// given, paths are ranked by their length. UseCrackability additionally divides the cost of roasting relationships by
+// their crackability score.
+type WeightedPathsRequest struct {
+	StartNode                   string             `json:"start_node"`
+	EndNode                     string             `json:"end_node"`
+	K                           int                `json:"k"`
+	RelationshipKinds           string             `json:"relationship_kinds"`
+	OnlyIncludeTraversableKinds bool               `json:"only_traversable"`
+	EdgeCosts                   map[string]float64 `json:"edge_costs"`
+	DefaultCost                 *float64           `json:"default_cost"`
+	UseCrackability             bool               `json:"use_crackability"`
+}
+
+// WeightedPath is a single path of a WeightedPathsResponse. Nodes lists the path's node IDs in order and Edges lists
+// the indexes of the path's relationships within the response's edges.
+type WeightedPath struct {
+	Cost  float64  `json:"cost"`
+	Nodes []string `json:"nodes"`
+	Edges []int    `json:"edges"`
+}
+
+type WeightedPathsResponse struct {
+	model.UnifiedGraph
+	Paths []WeightedPath `json:"paths"`
+}
+
+// weightedPathSearch holds the validated parameters of a WeightedPathsRequest
+type weightedPathSearch struct {
+	filter              graph.Criteria
+	costs               pathfinding.CostTable
+	k                   int
+	validKinds          graph.Kinds
+	includeOpenGraph    bool
+	primaryDisplayKinds graphschema.PrimaryDisplayKinds
+}
+
+// prepareWeightedPathSearch validates a WeightedPathsRequest and resolves the relationship kinds it may traverse
+func (s Resources) prepareWeightedPathSearch(request *http.Request, pathsRequest WeightedPathsRequest) (weightedPathSearch, *api.ErrorWrapper) {
+	var (
+		requestContext = request.Context()
+		search         = weightedPathSearch{
+			k: pathsRequest.K,
+			// note: this uses relationships from cue files, not from schema database
+			validKinds: graph.Kinds(ad.Relationships()).Concatenate(azure.Relationships()),
+		}
+	)
+
+	if pathsRequest.StartNode == "" {
+		return search, api.BuildErrorResponse(http.StatusBadRequest, "Missing field: start_node", request)
+	} else if pathsRequest.EndNode == "" {
+		return search, api.BuildErrorResponse(http.StatusBadRequest, "Missing field: end_node", request)
+	}
+
+	if search.k == 0 {
+		search.k = defaultWeightedPathCount
+	} else if search.k < 0 || search.k > pathfinding.MaximumK {
+		return search, api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("k must be between 1 and %d", pathfinding.MaximumK), request)
+	}
+
+	defaultCost := float64(defaultWeightedPathCost)
+	if pathsRequest.DefaultCost != nil {
+		defaultCost = *pathsRequest.DefaultCost
+	}
+
+	if pathsRequest.OnlyIncludeTraversableKinds {
+		search.validKinds = graph.Kinds(ad.PathfindingRelationshipsMatchFrontend()).Concatenate(azure.PathfindingRelationships())
+	}
+
+	var costModifiers []pathfinding.CostModifier
+	if pathsRequest.UseCrackability {
+		costModifiers = append(costModifiers, adAnalysis.CrackabilityCost)
+	}
+
+	if costs, err := pathfinding.NewCostTable(defaultCost, pathsRequest.EdgeCosts, costModifiers...); err != nil {
+		return search, api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request)
+	} else {
+		search.costs = costs
+	}
+
+	if ogExtensionManagementFeatureFlag, err := s.DB.GetFlagByKey(requestContext, appcfg.FeatureOpenGraphExtensionManagement); err != nil {
+		return search, api.BuildErrorResponse(http.StatusInternalServerError, api.FormatDatabaseError(err).Error(), request)
+	} else if primaryDisplayKinds, err := s.DB.GetPrimaryDisplayKinds(requestContext); err != nil {
+		return search, api.BuildErrorResponse(http.StatusInternalServerError, api.FormatDatabaseError(err).Error(), request)
+	} else {
+		search.includeOpenGraph = ogExtensionManagementFeatureFlag.Enabled
+		search.primaryDisplayKinds = primaryDisplayKinds
+	}
+
+	if search.includeOpenGraph {
+		if openGraphRelationshipKinds, err := s.fetchOpenGraphRelationshipKinds(requestContext, pathsRequest.OnlyIncludeTraversableKinds); err != nil {
+			return search, api.BuildErrorResponse(http.StatusInternalServerError, api.FormatDatabaseError(err).Error(), request)
+		} else {
+			search.validKinds = search.validKinds.Concatenate(openGraphRelationshipKinds)
+		}
+	}
+
+	for kind := range pathsRequest.EdgeCosts {
+		if !search.validKinds.ContainsOneOf(graph.StringKind(kind)) {
+			return search, api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("invalid edge_costs: %s is not a traversable relationship kind", kind), request)
+		}
+	}
+
+	if kindFilter, err := createRelationshipKindFilterCriteria(pathsRequest.RelationshipKinds, pathsRequest.OnlyIncludeTraversableKinds, search.validKinds); err != nil {
+		return search, api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request)
+	} else {
+		search.filter = kindFilter
+	}
+
+	return search, nil
+}
+
+// weightedPathSearchErrorResponse maps an error from a weighted path search to a response. A start or end node that
+// does not exist is not found, and a search that exhausts its limits is rejected as too broad.
+func weightedPathSearchErrorResponse(request *http.Request, err error) *api.ErrorWrapper {
+	switch {
+	case graph.IsErrNotFound(err):
+		return api.BuildErrorResponse(http.StatusNotFound, err.Error(), request)
+	case errors.Is(err, pathfinding.ErrSearchLimitExceeded):
+		return api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("%s; narrow relationship_kinds or lower k", err.Error()), request)
+	default:
+		return api.BuildErrorResponse(http.StatusInternalServerError, err.Error(), request)
+	}
+}
+
+func (s Resources) GetWeightedPaths(response http.ResponseWriter, request *http.Request) {
+	var (
+		requestContext = request.Context()
+		pathsRequest   WeightedPathsRequest
+	)
+
+	if err := api.ReadJSONRequestPayloadLimited(&pathsRequest, request); err != nil {
+		api.WriteErrorResponse(requestContext, api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponsePayloadUnmarshalError, request), response)
+	} else if search, errWrapper := s.prepareWeightedPathSearch(request, pathsRequest); errWrapper != nil {
+		api.WriteErrorResponse(requestContext, errWrapper, response)
+	} else if paths, err := s.GraphQuery.GetKShortestPaths(requestContext, pathsRequest.StartNode, pathsRequest.EndNode, search.filter, search.costs, search.k, search.includeOpenGraph); err != nil {
+		api.WriteErrorResponse(requestContext, weightedPathSearchErrorResponse(request, err), response)
+	} else if user, isUser := auth.GetUserFromAuthCtx(bhctx.FromRequest(request).AuthCtx); !isUser {
+		slog.Error("Unable to get user from auth context")
+		api.WriteErrorResponse(requestContext, api.BuildErrorResponse(http.StatusInternalServerError, "unknown user", request), response)
+	} else if len(paths) == 0 {
+		api.WriteErrorResponse(requestContext, api.BuildErrorResponse(http.StatusNotFound, "path not found", request), response)
+	} else {
+		var (
+			builder       = newWeightedPathGraphBuilder(search.primaryDisplayKinds)
+			pathsResponse = WeightedPathsResponse{
+				Paths: builder.addPaths(paths),
+			}
+		)
+
+		if unifiedGraph, err := builder.build(s.DogTags, user); err != nil {
+			api.WriteErrorResponse(requestContext, api.BuildErrorResponse(http.StatusInternalServerError, "error filtering graph for ETAC", request), response)
+		} else {
+			pathsResponse.UnifiedGraph = unifiedGraph
+			api.WriteBasicResponse(requestContext, pathsResponse, http.StatusOK, response)
+		}
+	}
+}
+
+// weightedPathGraphBuilder collects the nodes and relationships of weighted paths into a single graph, indexing each
+// relationship once no matter how many paths traverse it
+type weightedPathGraphBuilder struct {
+	unifiedGraph        model.UnifiedGraph
+	edgeIndexes         map[graph.ID]int
+	primaryDisplayKinds graphschema.PrimaryDisplayKinds
+	toUnifiedEdge       func(*graph.Relationship) model.UnifiedEdge
+}
+
+func newWeightedPathGraphBuilder(primaryDisplayKinds graphschema.PrimaryDisplayKinds) *weightedPathGraphBuilder {
+	return &weightedPathGraphBuilder{
+		unifiedGraph:        model.NewUnifiedGraph(),
+		edgeIndexes:         map[graph.ID]int{},
+		primaryDisplayKinds: primaryDisplayKinds,
+		toUnifiedEdge:       model.FromDAWGSRelationship(false),
+	}
+}
+
+// addNode adds the given node to the graph and returns its ID
+func (s *weightedPathGraphBuilder) addNode(node *graph.Node) string {
+	// ETAC filtering requires pulling the node's properties
+	s.unifiedGraph.Nodes[node.ID.String()] = model.FromDAWGSNode(s.primaryDisplayKinds, node, true)
+	return node.ID.String()
+}
+
+// addEdge adds the given relationship to the graph if it is not already present and returns its index
+func (s *weightedPathGraphBuilder) addEdge(edge *graph.Relationship) int {
+	edgeIndex, seen := s.edgeIndexes[edge.ID]
+	if !seen {
+		edgeIndex = len(s.unifiedGraph.Edges)
+		s.edgeIndexes[edge.ID] = edgeIndex
+		s.unifiedGraph.Edges = append(s.unifiedGraph.Edges, s.toUnifiedEdge(edge))
+	}
+
+	return edgeIndex
+}
+
+func (s *weightedPathGraphBuilder) addPaths(paths []pathfinding.WeightedPath) []WeightedPath {
+	weightedPaths := make([]WeightedPath, 0, len(paths))
+
+	for _, path := range paths {
+		weightedPath := WeightedPath{
+			Cost:  path.Cost,
+			Nodes: make([]string, 0, len(path.Path.Nodes)),
+			Edges: make([]int, 0, len(path.Path.Edges)),
+		}
+
+		for _, node := range path.Path.Nodes {
+			weightedPath.Nodes = append(weightedPath.Nodes, s.addNode(node))
+		}
+
+		for _, edge := range path.Path.Edges {
+			weightedPath.Edges = append(weightedPath.Edges, s.addEdge(edge))
+		}
+
+		weightedPaths = append(weightedPaths, weightedPath)
+	}
+
+	return weightedPaths
+}
+
+// build returns the collected graph after applying ETAC filtering for the given user and stripping node properties
+func (s *weightedPathGraphBuilder) build(dogTags dogtags.Service, user model.User) (model.UnifiedGraph, error) {
+	unifiedGraph := s.unifiedGraph
+
+	if ShouldFilterForETAC(dogTags, user) {
+		// ETAC filtering replaces hidden edges in place, so the path edge indexes remain valid
+		if filteredGraph, err := filterETACGraph(unifiedGraph, user); err != nil {
+			return model.UnifiedGraph{}, err
+		} else {
+			unifiedGraph = filteredGraph
+		}
+	}
+
+	// Like the shortest path endpoint, properties are only pulled for ETAC filtering and are not returned
+	for key, node := range unifiedGraph.Nodes {
+		node.Properties = make(map[string]any)
+		unifiedGraph.Nodes[key] = node
+	}
+
+	return unifiedGraph, nil
+}
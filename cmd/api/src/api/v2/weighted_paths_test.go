@@ -0,0 +1,278 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	v2 "github.com/specterops/bloodhound/cmd/api/src/api/v2"
+	"github.com/specterops/bloodhound/cmd/api/src/api/v2/apitest"
+	"github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
+	mocks_graph "github.com/specterops/bloodhound/cmd/api/src/queries/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/services/dogtags"
+	"github.com/specterops/bloodhound/packages/go/analysis/pathfinding"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/query"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResources_GetWeightedPaths(t *testing.T) {
+	var (
+		mockCtrl       = gomock.NewController(t)
+		mockGraph      = mocks_graph.NewMockGraph(mockCtrl)
+		mockDB         = mocks.NewMockDatabase(mockCtrl)
+		dogTagsService = dogtags.NewTestService(dogtags.TestOverrides{})
+		resources      = v2.Resources{GraphQuery: mockGraph, DB: mockDB, DogTags: dogTagsService}
+
+		user = setupUser()
+
+		computer = &graph.Node{ID: 0, Kinds: graph.Kinds{ad.Entity, ad.Computer}, Properties: graph.NewProperties()}
+		userNode = &graph.Node{ID: 1, Kinds: graph.Kinds{ad.Entity, ad.User}, Properties: graph.NewProperties()}
+		group    = &graph.Node{ID: 2, Kinds: graph.Kinds{ad.Entity, ad.Group}, Properties: graph.NewProperties()}
+
+		adminTo    = &graph.Relationship{ID: 10, StartID: 0, EndID: 1, Kind: ad.AdminTo, Properties: graph.NewProperties()}
+		genericAll = &graph.Relationship{ID: 11, StartID: 1, EndID: 2, Kind: ad.GenericAll, Properties: graph.NewProperties()}
+		owns       = &graph.Relationship{ID: 12, StartID: 0, EndID: 2, Kind: ad.Owns, Properties: graph.NewProperties()}
+
+		paths = []pathfinding.WeightedPath{
+			{Path: graph.Path{Nodes: []*graph.Node{computer, userNode, group}, Edges: []*graph.Relationship{adminTo, genericAll}}, Cost: 2},
+			{Path: graph.Path{Nodes: []*graph.Node{computer, group}, Edges: []*graph.Relationship{owns}}, Cost: 5},
+		}
+
+		expectedCosts = pathfinding.CostTable{Costs: map[string]float64{ad.Owns.String(): 5}, DefaultCost: 1}
+		allKinds      = graph.Kinds(ad.Relationships()).Concatenate(azure.Relationships())
+	)
+	defer mockCtrl.Finish()
+
+	apitest.NewHarness(t, resources.GetWeightedPaths).
+		WithCommonRequest(func(input *apitest.Input) {
+			apitest.SetContext(input, setupUserCtx(user))
+		}).
+		Run([]apitest.Case{
+			{
+				Name: "InvalidBody",
+				Input: func(input *apitest.Input) {
+					apitest.BodyString(input, "{")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, api.ErrorResponsePayloadUnmarshalError)
+				},
+			},
+			{
+				Name: "MissingStartNode",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.WeightedPathsRequest{EndNode: "someOtherID"})
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "Missing field: start_node")
+				},
+			},
+			{
+				Name: "MissingEndNode",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.WeightedPathsRequest{StartNode: "someID"})
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "Missing field: end_node")
+				},
+			},
+			{
+				Name: "KOutOfRange",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.WeightedPathsRequest{StartNode: "someID", EndNode: "someOtherID", K: pathfinding.MaximumK + 1})
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "k must be between 1 and 25")
+				},
+			},
+			{
+				Name: "NegativeCost",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.WeightedPathsRequest{StartNode: "someID", EndNode: "someOtherID", EdgeCosts: map[string]float64{ad.Owns.String(): -1}})
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "cost for Owns: invalid edge cost")
+				},
+			},
+			{
+				Name: "GetFlagByKeyError",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.WeightedPathsRequest{StartNode: "someID", EndNode: "someOtherID"})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetFlagByKey(gomock.Any(), appcfg.FeatureOpenGraphExtensionManagement).Return(appcfg.FeatureFlag{}, errors.New("database error"))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+				},
+			},
+			{
+				Name: "UnknownCostKind",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.WeightedPathsRequest{StartNode: "someID", EndNode: "someOtherID", EdgeCosts: map[string]float64{"NotAKind": 3}})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetFlagByKey(gomock.Any(), appcfg.FeatureOpenGraphExtensionManagement).Return(appcfg.FeatureFlag{Enabled: false}, nil)
+					mockDB.EXPECT().GetPrimaryDisplayKinds(gomock.Any())
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "invalid edge_costs: NotAKind is not a traversable relationship kind")
+				},
+			},
+			{
+				Name: "GetKShortestPathsError",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.WeightedPathsRequest{StartNode: "someID", EndNode: "someOtherID"})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetFlagByKey(gomock.Any(), appcfg.FeatureOpenGraphExtensionManagement).Return(appcfg.FeatureFlag{Enabled: false}, nil)
+					mockDB.EXPECT().GetPrimaryDisplayKinds(gomock.Any())
+					mockGraph.EXPECT().
+						GetKShortestPaths(gomock.Any(), "someID", "someOtherID", query.KindIn(query.Relationship(), allKinds...), pathfinding.HopCostTable(), 3, false).
+						Return(nil, errors.New("graph error"))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+					apitest.BodyContains(output, "graph error")
+				},
+			},
+			{
+				Name: "NodeNotFound",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.WeightedPathsRequest{StartNode: "someID", EndNode: "someOtherID"})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetFlagByKey(gomock.Any(), appcfg.FeatureOpenGraphExtensionManagement).Return(appcfg.FeatureFlag{Enabled: false}, nil)
+					mockDB.EXPECT().GetPrimaryDisplayKinds(gomock.Any())
+					mockGraph.EXPECT().GetKShortestPaths(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(nil, fmt.Errorf("fetching node someID: %w", graph.ErrNoResultsFound))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+					apitest.BodyContains(output, "fetching node someID")
+				},
+			},
+			{
+				Name: "SearchLimitExceeded",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.WeightedPathsRequest{StartNode: "someID", EndNode: "someOtherID"})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetFlagByKey(gomock.Any(), appcfg.FeatureOpenGraphExtensionManagement).Return(appcfg.FeatureFlag{Enabled: false}, nil)
+					mockDB.EXPECT().GetPrimaryDisplayKinds(gomock.Any())
+					mockGraph.EXPECT().GetKShortestPaths(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(nil, fmt.Errorf("%w: more than 250000 nodes expanded", pathfinding.ErrSearchLimitExceeded))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "narrow relationship_kinds or lower k")
+				},
+			},
+			{
+				Name: "PathNotFound",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.WeightedPathsRequest{StartNode: "someID", EndNode: "someOtherID"})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetFlagByKey(gomock.Any(), appcfg.FeatureOpenGraphExtensionManagement).Return(appcfg.FeatureFlag{Enabled: false}, nil)
+					mockDB.EXPECT().GetPrimaryDisplayKinds(gomock.Any())
+					mockGraph.EXPECT().GetKShortestPaths(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+					apitest.BodyContains(output, "path not found")
+				},
+			},
+			{
+				Name: "Success",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.WeightedPathsRequest{StartNode: "someID", EndNode: "someOtherID", K: 2, EdgeCosts: map[string]float64{ad.Owns.String(): 5}})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetFlagByKey(gomock.Any(), appcfg.FeatureOpenGraphExtensionManagement).Return(appcfg.FeatureFlag{Enabled: false}, nil)
+					mockDB.EXPECT().GetPrimaryDisplayKinds(gomock.Any())
+					mockGraph.EXPECT().
+						GetKShortestPaths(gomock.Any(), "someID", "someOtherID", query.KindIn(query.Relationship(), allKinds...), expectedCosts, 2, false).
+						Return(paths, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+
+					var actual v2.WeightedPathsResponse
+					apitest.UnmarshalData(output, &actual)
+
+					require.Len(t, actual.Edges, 3)
+					require.Len(t, actual.Nodes, 3)
+					apitest.Equal(output, []v2.WeightedPath{
+						{Cost: 2, Nodes: []string{"0", "1", "2"}, Edges: []int{0, 1}},
+						{Cost: 5, Nodes: []string{"0", "2"}, Edges: []int{2}},
+					}, actual.Paths)
+				},
+			},
+			{
+				Name: "SuccessWithCrackability",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.WeightedPathsRequest{StartNode: "someID", EndNode: "someOtherID", UseCrackability: true})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetFlagByKey(gomock.Any(), appcfg.FeatureOpenGraphExtensionManagement).Return(appcfg.FeatureFlag{Enabled: false}, nil)
+					mockDB.EXPECT().GetPrimaryDisplayKinds(gomock.Any())
+					mockGraph.EXPECT().
+						GetKShortestPaths(gomock.Any(), "someID", "someOtherID", gomock.Any(), gomock.Cond(func(costs pathfinding.CostTable) bool {
+							return len(costs.Modifiers) == 1
+						}), 3, false).
+						Return(paths[:1], nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+				},
+			},
+			{
+				Name: "SuccessWithOpenGraph",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.WeightedPathsRequest{StartNode: "someID", EndNode: "someOtherID", EdgeCosts: map[string]float64{"OpenGraphKindA": 2}})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetFlagByKey(gomock.Any(), appcfg.FeatureOpenGraphExtensionManagement).Return(appcfg.FeatureFlag{Enabled: true}, nil)
+					mockDB.EXPECT().GetPrimaryDisplayKinds(gomock.Any())
+					mockDB.EXPECT().GetGraphSchemaRelationshipKinds(gomock.Any(), model.Filters{}, model.Sort{}, 0, 0).
+						Return(model.GraphSchemaRelationshipKinds{{Name: "OpenGraphKindA", IsTraversable: true}}, 1, nil)
+					mockGraph.EXPECT().
+						GetKShortestPaths(gomock.Any(), "someID", "someOtherID", gomock.Any(), gomock.Any(), 3, true).
+						Return(paths[:1], nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+				},
+			},
+		})
+}
@@ -0,0 +1,156 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/bhctx"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/queries"
+	"github.com/specterops/dawgs/graph"
+)
+
+// maximumWhatIfChanges bounds the number of relationships a what-if request may exclude or include
+const maximumWhatIfChanges = 100
+
+// WhatIfRelationship identifies a relationship by the object IDs of the nodes it joins and its kind
+type WhatIfRelationship struct {
+	SourceNode string `json:"source_node"`
+	TargetNode string `json:"target_node"`
+	Kind       string `json:"kind"`
+}
+
+// WhatIfPathsRequest is a WeightedPathsRequest evaluated twice: once against the graph as it is, and once with
+// ExcludeEdges removed and IncludeEdges added. Neither change is written to the graph.
+type WhatIfPathsRequest struct {
+	WeightedPathsRequest
+	ExcludeEdges []WhatIfRelationship `json:"exclude_edges"`
+	IncludeEdges []WhatIfRelationship `json:"include_edges"`
+}
+
+// WhatIfExposure counts the principals with a path to the end node before and after the requested changes
+type WhatIfExposure struct {
+	Before int `json:"before"`
+	After  int `json:"after"`
+	Delta  int `json:"delta"`
+}
+
+// WhatIfPathsResponse holds the paths found before and after the requested changes. Paths of both searches index
+// into the same edges, and SyntheticEdges lists the indexes of the edges that only exist in the what-if graph.
+// Exposure is omitted when counting the principals would exceed the search limits.
+type WhatIfPathsResponse struct {
+	model.UnifiedGraph
+	Before         []WeightedPath  `json:"before"`
+	After          []WeightedPath  `json:"after"`
+	SyntheticEdges []int           `json:"synthetic_edges"`
+	Exposure       *WhatIfExposure `json:"exposure,omitempty"`
+}
+
+// parseWhatIfRelationships validates the named list of relationships against the kinds the search may traverse
+func parseWhatIfRelationships(field string, relationships []WhatIfRelationship, validKinds graph.Kinds) ([]queries.WhatIfRelationship, error) {
+	if len(relationships) > maximumWhatIfChanges {
+		return nil, fmt.Errorf("invalid %s: at most %d relationships may be given", field, maximumWhatIfChanges)
+	}
+
+	parsed := make([]queries.WhatIfRelationship, 0, len(relationships))
+
+	for _, relationship := range relationships {
+		if relationship.SourceNode == "" || relationship.TargetNode == "" {
+			return nil, fmt.Errorf("invalid %s: source_node and target_node are required", field)
+		} else if kind := graph.StringKind(relationship.Kind); !validKinds.ContainsOneOf(kind) {
+			return nil, fmt.Errorf("invalid %s: %s is not a traversable relationship kind", field, relationship.Kind)
+		} else {
+			parsed = append(parsed, queries.WhatIfRelationship{
+				StartNodeID: relationship.SourceNode,
+				EndNodeID:   relationship.TargetNode,
+				Kind:        kind,
+			})
+		}
+	}
+
+	return parsed, nil
+}
+
+func (s Resources) GetWhatIfPaths(response http.ResponseWriter, request *http.Request) {
+	var (
+		requestContext = request.Context()
+		pathsRequest   WhatIfPathsRequest
+	)
+
+	if err := api.ReadJSONRequestPayloadLimited(&pathsRequest, request); err != nil {
+		api.WriteErrorResponse(requestContext, api.BuildErrorResponse(http.StatusBadRequest, api.ErrorResponsePayloadUnmarshalError, request), response)
+	} else if len(pathsRequest.ExcludeEdges) == 0 && len(pathsRequest.IncludeEdges) == 0 {
+		api.WriteErrorResponse(requestContext, api.BuildErrorResponse(http.StatusBadRequest, "at least one of exclude_edges or include_edges is required", request), response)
+	} else if search, errWrapper := s.prepareWeightedPathSearch(request, pathsRequest.WeightedPathsRequest); errWrapper != nil {
+		api.WriteErrorResponse(requestContext, errWrapper, response)
+	} else if excluded, err := parseWhatIfRelationships("exclude_edges", pathsRequest.ExcludeEdges, search.validKinds); err != nil {
+		api.WriteErrorResponse(requestContext, api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	} else if included, err := parseWhatIfRelationships("include_edges", pathsRequest.IncludeEdges, search.validKinds); err != nil {
+		api.WriteErrorResponse(requestContext, api.BuildErrorResponse(http.StatusBadRequest, err.Error(), request), response)
+	} else if user, isUser := auth.GetUserFromAuthCtx(bhctx.FromRequest(request).AuthCtx); !isUser {
+		slog.Error("Unable to get user from auth context")
+		api.WriteErrorResponse(requestContext, api.BuildErrorResponse(http.StatusInternalServerError, "unknown user", request), response)
+	} else if paths, err := s.GraphQuery.GetWhatIfPaths(requestContext, pathsRequest.StartNode, pathsRequest.EndNode, search.filter, search.costs, search.k, search.includeOpenGraph, queries.WhatIfChanges{
+		Exclude: excluded,
+		Include: included,
+	}); errors.Is(err, queries.ErrWhatIfUnmatchedExclusion) {
+		api.WriteErrorResponse(requestContext, api.BuildErrorResponse(http.StatusBadRequest, fmt.Sprintf("invalid exclude_edges: %s", err.Error()), request), response)
+	} else if err != nil {
+		api.WriteErrorResponse(requestContext, weightedPathSearchErrorResponse(request, err), response)
+	} else {
+		var (
+			builder       = newWeightedPathGraphBuilder(search.primaryDisplayKinds)
+			pathsResponse = WhatIfPathsResponse{
+				Before:         builder.addPaths(paths.Before),
+				After:          builder.addPaths(paths.After),
+				SyntheticEdges: []int{},
+			}
+		)
+
+		if paths.Exposure != nil {
+			pathsResponse.Exposure = &WhatIfExposure{
+				Before: paths.Exposure.Before,
+				After:  paths.Exposure.After,
+				Delta:  paths.Exposure.After - paths.Exposure.Before,
+			}
+		}
+
+		// Synthetic edges are reported even when no path traverses them so that callers can render the change
+		for _, synthetic := range paths.Synthetic {
+			for _, node := range synthetic.Nodes {
+				builder.addNode(node)
+			}
+
+			for _, edge := range synthetic.Edges {
+				pathsResponse.SyntheticEdges = append(pathsResponse.SyntheticEdges, builder.addEdge(edge))
+			}
+		}
+
+		if unifiedGraph, err := builder.build(s.DogTags, user); err != nil {
+			api.WriteErrorResponse(requestContext, api.BuildErrorResponse(http.StatusInternalServerError, "error filtering graph for ETAC", request), response)
+		} else {
+			pathsResponse.UnifiedGraph = unifiedGraph
+			api.WriteBasicResponse(requestContext, pathsResponse, http.StatusOK, response)
+		}
+	}
+}
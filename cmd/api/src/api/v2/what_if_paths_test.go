@@ -0,0 +1,257 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	v2 "github.com/specterops/bloodhound/cmd/api/src/api/v2"
+	"github.com/specterops/bloodhound/cmd/api/src/api/v2/apitest"
+	"github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
+	"github.com/specterops/bloodhound/cmd/api/src/queries"
+	mocks_graph "github.com/specterops/bloodhound/cmd/api/src/queries/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/services/dogtags"
+	"github.com/specterops/bloodhound/packages/go/analysis/pathfinding"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/query"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResources_GetWhatIfPaths(t *testing.T) {
+	var (
+		mockCtrl       = gomock.NewController(t)
+		mockGraph      = mocks_graph.NewMockGraph(mockCtrl)
+		mockDB         = mocks.NewMockDatabase(mockCtrl)
+		dogTagsService = dogtags.NewTestService(dogtags.TestOverrides{})
+		resources      = v2.Resources{GraphQuery: mockGraph, DB: mockDB, DogTags: dogTagsService}
+
+		user = setupUser()
+
+		helpdesk    = &graph.Node{ID: 0, Kinds: graph.Kinds{ad.Entity, ad.Group}, Properties: graph.NewProperties()}
+		ou          = &graph.Node{ID: 1, Kinds: graph.Kinds{ad.Entity, ad.OU}, Properties: graph.NewProperties()}
+		domainAdmin = &graph.Node{ID: 2, Kinds: graph.Kinds{ad.Entity, ad.Group}, Properties: graph.NewProperties()}
+		computer    = &graph.Node{ID: 3, Kinds: graph.Kinds{ad.Entity, ad.Computer}, Properties: graph.NewProperties()}
+
+		genericAll = &graph.Relationship{ID: 10, StartID: 0, EndID: 1, Kind: ad.GenericAll, Properties: graph.NewProperties()}
+		contains   = &graph.Relationship{ID: 11, StartID: 1, EndID: 2, Kind: ad.Contains, Properties: graph.NewProperties()}
+		synthetic  = &graph.Relationship{ID: 12, StartID: 3, EndID: 1, Kind: ad.AdminTo, Properties: graph.NewProperties()}
+
+		before = []pathfinding.WeightedPath{
+			{Path: graph.Path{Nodes: []*graph.Node{helpdesk, ou, domainAdmin}, Edges: []*graph.Relationship{genericAll, contains}}, Cost: 2},
+		}
+
+		request = v2.WhatIfPathsRequest{
+			WeightedPathsRequest: v2.WeightedPathsRequest{StartNode: "helpdesk", EndNode: "domainadmins"},
+			ExcludeEdges:         []v2.WhatIfRelationship{{SourceNode: "helpdesk", TargetNode: "ou", Kind: ad.GenericAll.String()}},
+			IncludeEdges:         []v2.WhatIfRelationship{{SourceNode: "computer", TargetNode: "ou", Kind: ad.AdminTo.String()}},
+		}
+
+		expectedChanges = queries.WhatIfChanges{
+			Exclude: []queries.WhatIfRelationship{{StartNodeID: "helpdesk", EndNodeID: "ou", Kind: ad.GenericAll}},
+			Include: []queries.WhatIfRelationship{{StartNodeID: "computer", EndNodeID: "ou", Kind: ad.AdminTo}},
+		}
+
+		allKinds = graph.Kinds(ad.Relationships()).Concatenate(azure.Relationships())
+
+		tooManyChanges = make([]v2.WhatIfRelationship, 101)
+	)
+	defer mockCtrl.Finish()
+
+	for idx := range tooManyChanges {
+		tooManyChanges[idx] = v2.WhatIfRelationship{SourceNode: fmt.Sprintf("node-%d", idx), TargetNode: "ou", Kind: ad.GenericAll.String()}
+	}
+
+	apitest.NewHarness(t, resources.GetWhatIfPaths).
+		WithCommonRequest(func(input *apitest.Input) {
+			apitest.SetContext(input, setupUserCtx(user))
+		}).
+		Run([]apitest.Case{
+			{
+				Name: "InvalidBody",
+				Input: func(input *apitest.Input) {
+					apitest.BodyString(input, "{")
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, api.ErrorResponsePayloadUnmarshalError)
+				},
+			},
+			{
+				Name: "NoChanges",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.WhatIfPathsRequest{WeightedPathsRequest: request.WeightedPathsRequest})
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "at least one of exclude_edges or include_edges is required")
+				},
+			},
+			{
+				Name: "MissingStartNode",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.WhatIfPathsRequest{
+						WeightedPathsRequest: v2.WeightedPathsRequest{EndNode: "domainadmins"},
+						ExcludeEdges:         request.ExcludeEdges,
+					})
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "Missing field: start_node")
+				},
+			},
+			{
+				Name: "MissingRelationshipNode",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.WhatIfPathsRequest{
+						WeightedPathsRequest: request.WeightedPathsRequest,
+						ExcludeEdges:         []v2.WhatIfRelationship{{SourceNode: "helpdesk", Kind: ad.GenericAll.String()}},
+					})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetFlagByKey(gomock.Any(), appcfg.FeatureOpenGraphExtensionManagement).Return(appcfg.FeatureFlag{Enabled: false}, nil)
+					mockDB.EXPECT().GetPrimaryDisplayKinds(gomock.Any())
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "invalid exclude_edges: source_node and target_node are required")
+				},
+			},
+			{
+				Name: "UnknownRelationshipKind",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.WhatIfPathsRequest{
+						WeightedPathsRequest: request.WeightedPathsRequest,
+						IncludeEdges:         []v2.WhatIfRelationship{{SourceNode: "computer", TargetNode: "ou", Kind: "NotAKind"}},
+					})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetFlagByKey(gomock.Any(), appcfg.FeatureOpenGraphExtensionManagement).Return(appcfg.FeatureFlag{Enabled: false}, nil)
+					mockDB.EXPECT().GetPrimaryDisplayKinds(gomock.Any())
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "invalid include_edges: NotAKind is not a traversable relationship kind")
+				},
+			},
+			{
+				Name: "TooManyChanges",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, v2.WhatIfPathsRequest{
+						WeightedPathsRequest: request.WeightedPathsRequest,
+						ExcludeEdges:         tooManyChanges,
+					})
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetFlagByKey(gomock.Any(), appcfg.FeatureOpenGraphExtensionManagement).Return(appcfg.FeatureFlag{Enabled: false}, nil)
+					mockDB.EXPECT().GetPrimaryDisplayKinds(gomock.Any())
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "invalid exclude_edges: at most 100 relationships may be given")
+				},
+			},
+			{
+				Name: "NodeNotFound",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, request)
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetFlagByKey(gomock.Any(), appcfg.FeatureOpenGraphExtensionManagement).Return(appcfg.FeatureFlag{Enabled: false}, nil)
+					mockDB.EXPECT().GetPrimaryDisplayKinds(gomock.Any())
+					mockGraph.EXPECT().
+						GetWhatIfPaths(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(queries.WhatIfPaths{}, fmt.Errorf("fetching node computer: %w", graph.ErrNoResultsFound))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusNotFound)
+					apitest.BodyContains(output, "fetching node computer")
+				},
+			},
+			{
+				Name: "UnmatchedExclusion",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, request)
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetFlagByKey(gomock.Any(), appcfg.FeatureOpenGraphExtensionManagement).Return(appcfg.FeatureFlag{Enabled: false}, nil)
+					mockDB.EXPECT().GetPrimaryDisplayKinds(gomock.Any())
+					mockGraph.EXPECT().
+						GetWhatIfPaths(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(queries.WhatIfPaths{}, fmt.Errorf("%w: GenericAll from helpdesk to ou", queries.ErrWhatIfUnmatchedExclusion))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusBadRequest)
+					apitest.BodyContains(output, "invalid exclude_edges: no traversable relationship matches the exclusion")
+				},
+			},
+			{
+				Name: "GetWhatIfPathsError",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, request)
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetFlagByKey(gomock.Any(), appcfg.FeatureOpenGraphExtensionManagement).Return(appcfg.FeatureFlag{Enabled: false}, nil)
+					mockDB.EXPECT().GetPrimaryDisplayKinds(gomock.Any())
+					mockGraph.EXPECT().
+						GetWhatIfPaths(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+						Return(queries.WhatIfPaths{}, errors.New("graph error"))
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusInternalServerError)
+					apitest.BodyContains(output, "graph error")
+				},
+			},
+			{
+				Name: "Success",
+				Input: func(input *apitest.Input) {
+					apitest.BodyStruct(input, request)
+				},
+				Setup: func() {
+					mockDB.EXPECT().GetFlagByKey(gomock.Any(), appcfg.FeatureOpenGraphExtensionManagement).Return(appcfg.FeatureFlag{Enabled: false}, nil)
+					mockDB.EXPECT().GetPrimaryDisplayKinds(gomock.Any())
+					mockGraph.EXPECT().
+						GetWhatIfPaths(gomock.Any(), "helpdesk", "domainadmins", query.KindIn(query.Relationship(), allKinds...), pathfinding.HopCostTable(), 3, false, expectedChanges).
+						Return(queries.WhatIfPaths{
+							Before:    before,
+							Synthetic: []graph.Path{{Nodes: []*graph.Node{computer, ou}, Edges: []*graph.Relationship{synthetic}}},
+							Exposure:  &queries.WhatIfExposure{Before: 3, After: 1},
+						}, nil)
+				},
+				Test: func(output apitest.Output) {
+					apitest.StatusCode(output, http.StatusOK)
+
+					var actual v2.WhatIfPathsResponse
+					apitest.UnmarshalData(output, &actual)
+
+					require.Len(t, actual.Nodes, 4)
+					require.Len(t, actual.Edges, 3)
+					apitest.Equal(output, []v2.WeightedPath{{Cost: 2, Nodes: []string{"0", "1", "2"}, Edges: []int{0, 1}}}, actual.Before)
+					apitest.Equal(output, []v2.WeightedPath{}, actual.After)
+					apitest.Equal(output, []int{2}, actual.SyntheticEdges)
+					apitest.Equal(output, &v2.WhatIfExposure{Before: 3, After: 1, Delta: -2}, actual.Exposure)
+				},
+			},
+		})
+}
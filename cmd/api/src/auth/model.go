@@ -52,6 +52,15 @@ type SimpleIdentity struct {
 	Key   string
 }
 
+// CLIActorNamePrefix is prepended to the name of a CLIActor when it is recorded as the actor of an audit log entry.
+const CLIActorNamePrefix = "cli:"
+
+// CLIActor identifies an operator running administrative subcommands of the bhapi binary. It is only used to
+// attribute audit log entries and grants no permissions.
+type CLIActor struct {
+	Name string
+}
+
 type IdentityResolver interface {
 	GetIdentity(ctx Context) (SimpleIdentity, error)
 }
@@ -63,15 +72,21 @@ func NewIdentityResolver() IdentityResolver {
 }
 
 func (s idResolver) GetIdentity(ctx Context) (SimpleIdentity, error) {
-	if user, ok := GetUserFromAuthCtx(ctx); !ok {
-		return SimpleIdentity{}, errors.New("error retrieving user from auth context")
-	} else {
+	if user, ok := GetUserFromAuthCtx(ctx); ok {
 		return SimpleIdentity{
 			ID:    user.ID,
 			Name:  user.PrincipalName,
 			Email: user.EmailAddress.ValueOrZero(),
 			Key:   "user_id",
 		}, nil
+	} else if actor, ok := ctx.Owner.(CLIActor); ok {
+		return SimpleIdentity{
+			ID:   uuid.Nil,
+			Name: CLIActorNamePrefix + actor.Name,
+			Key:  "cli_actor",
+		}, nil
+	} else {
+		return SimpleIdentity{}, errors.New("error retrieving user from auth context")
 	}
 }
 
@@ -0,0 +1,88 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+)
+
+type analysisRequestOutput struct {
+	RequestedBy string             `json:"requested_by"`
+	Mode        model.AnalysisMode `json:"mode"`
+}
+
+type datapipeStatusOutput struct {
+	model.DatapipeStatusWrapper
+	AnalysisRequested bool `json:"analysis_requested"`
+}
+
+// requestAnalysis queues an analysis request that the datapipe daemon of a running server picks up on its next
+// iteration.
+func requestAnalysis(ctx context.Context, env environment, args []string) (any, error) {
+	var (
+		mode    string
+		flagSet = newCommandFlagSet("analysis request")
+	)
+
+	flagSet.StringVar(&mode, "mode", string(model.AnalysisModeFull), "Analysis mode to request.")
+
+	if err := flagSet.Parse(args); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidArguments, err)
+	} else if flagSet.NArg() > 0 {
+		return nil, fmt.Errorf("%w: unexpected argument %s", ErrInvalidArguments, flagSet.Arg(0))
+	}
+
+	analysisMode := model.AnalysisMode(mode)
+	switch analysisMode {
+	case model.AnalysisModeFull, model.AnalysisModeNoPostProcessing:
+	default:
+		return nil, fmt.Errorf("%w: unknown analysis mode %s", ErrInvalidArguments, mode)
+	}
+
+	var (
+		requestedBy = auth.CLIActorNamePrefix + env.actor.Name
+		auditData   = model.AuditData{"mode": analysisMode}
+	)
+
+	if err := env.db.RequestAnalysis(ctx, requestedBy, analysisMode); err != nil {
+		return nil, fmt.Errorf("error requesting analysis: %w", err)
+	} else if err := appendAuditEntry(ctx, env.db, model.AuditLogActionRequestAnalysis, model.AuditLogStatusSuccess, auditData); err != nil {
+		return nil, err
+	}
+
+	return analysisRequestOutput{
+		RequestedBy: requestedBy,
+		Mode:        analysisMode,
+	}, nil
+}
+
+func datapipeStatus(ctx context.Context, env environment, args []string) (any, error) {
+	if len(args) > 0 {
+		return nil, fmt.Errorf("%w: unexpected argument %s", ErrInvalidArguments, args[0])
+	} else if status, err := env.db.GetDatapipeStatus(ctx); err != nil {
+		return nil, fmt.Errorf("error fetching datapipe status: %w", err)
+	} else {
+		return datapipeStatusOutput{
+			DatapipeStatusWrapper: status,
+			AnalysisRequested:     env.db.HasAnalysisRequest(ctx),
+		}, nil
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os/user"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/bhctx"
+	"github.com/specterops/bloodhound/cmd/api/src/config"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/dawgs/graph"
+)
+
+var (
+	ErrUnknownCommand   = errors.New("unknown command")
+	ErrInvalidArguments = errors.New("invalid arguments")
+)
+
+// environment carries the dependencies shared by all administrative subcommands. The graph connection is opened
+// lazily since only the migration commands require it.
+type environment struct {
+	cfg          config.Configuration
+	db           database.Database
+	connectGraph func(ctx context.Context) (graph.Database, error)
+	actor        auth.CLIActor
+}
+
+// command is an administrative subcommand of the bhapi binary. The value returned by run is written to stdout as a
+// single JSON document.
+type command struct {
+	name        string
+	usage       string
+	description string
+	run         func(ctx context.Context, env environment, args []string) (any, error)
+}
+
+type errorOutput struct {
+	Error string `json:"error"`
+}
+
+func commands() []command {
+	return []command{
+		{name: "user reset-password", usage: "<principal_name>", description: "Replace a user's password with a generated one that must be changed at next login.", run: resetUserPassword},
+		{name: "user reset-mfa", usage: "<principal_name>", description: "Deactivate multi-factor authentication for a user.", run: resetUserMFA},
+		{name: "token create", usage: "[-name <token_name>] <principal_name>", description: "Create an API token owned by a user.", run: createAuthToken},
+		{name: "token revoke", usage: "<token_id>", description: "Delete an API token.", run: revokeAuthToken},
+		{name: "migrate", usage: "[-dry-run]", description: "Run pending SQL and graph migrations.", run: migrate},
+		{name: "flag list", description: "List all feature flags.", run: listFeatureFlags},
+		{name: "flag set", usage: "<key> <true|false>", description: "Enable or disable a feature flag.", run: setFeatureFlag},
+		{name: "analysis request", usage: "[-mode full|no_post_processing]", description: "Request that the datapipe run analysis.", run: requestAnalysis},
+		{name: "datapipe status", description: "Print the current datapipe status.", run: datapipeStatus},
+		{name: "storage rotate-keys", description: "Re-wrap the data keys of encrypted files with the configured storage master key.", run: rotateStorageKeys},
+	}
+}
+
+// findCommand matches the leading arguments against the registered command names and returns the matched command
+// along with the remaining arguments.
+func findCommand(args []string) (command, []string, error) {
+	for _, candidate := range commands() {
+		nameParts := strings.Fields(candidate.name)
+
+		if len(args) >= len(nameParts) && slices.Equal(args[:len(nameParts)], nameParts) {
+			return candidate, args[len(nameParts):], nil
+		}
+	}
+
+	return command{}, nil, fmt.Errorf("%w: %s", ErrUnknownCommand, strings.Join(args, " "))
+}
+
+// printCommandUsage writes the list of available subcommands, sorted by name, to the given writer.
+func printCommandUsage(output io.Writer) {
+	available := commands()
+
+	sort.Slice(available, func(i, j int) bool {
+		return available[i].name < available[j].name
+	})
+
+	fmt.Fprintf(output, "\nAdministrative subcommands:\n")
+	for _, next := range available {
+		fmt.Fprintf(output, "  %s %s\n    \t%s\n", next.name, next.usage, next.description)
+	}
+}
+
+// newCommandFlagSet returns a flag set for parsing subcommand specific flags. Parse errors are returned rather than
+// terminating the process so they can be reported as JSON.
+func newCommandFlagSet(name string) *flag.FlagSet {
+	flagSet := flag.NewFlagSet(name, flag.ContinueOnError)
+	flagSet.SetOutput(io.Discard)
+
+	return flagSet
+}
+
+// defaultActorName returns the name of the operating system user running the binary so that audit entries can be
+// traced back to an operator even when no actor is given explicitly.
+func defaultActorName() string {
+	if currentUser, err := user.Current(); err != nil || currentUser.Username == "" {
+		return "unknown"
+	} else {
+		return currentUser.Username
+	}
+}
+
+// newCommandContext builds a context whose auth context is owned by the CLI actor. Database operations that write
+// audit log entries attribute them to this actor.
+func newCommandContext(parentCtx context.Context, actor auth.CLIActor) context.Context {
+	requestID, err := uuid.NewV4()
+	if err != nil {
+		requestID = uuid.Nil
+	}
+
+	return bhctx.Set(parentCtx, &bhctx.Context{
+		RequestID: requestID.String(),
+		AuthCtx: auth.Context{
+			Owner: actor,
+		},
+	})
+}
+
+// appendAuditEntry records an audit log entry for operations that the database layer does not audit on its own.
+func appendAuditEntry(ctx context.Context, db database.Database, action model.AuditLogAction, status model.AuditLogEntryStatus, data model.AuditData) error {
+	if auditEntry, err := model.NewAuditEntry(action, status, data); err != nil {
+		return fmt.Errorf("error creating audit log entry: %w", err)
+	} else if err := db.AppendAuditLog(ctx, auditEntry); err != nil {
+		return fmt.Errorf("error appending audit log entry: %w", err)
+	}
+
+	return nil
+}
+
+// runCommand executes the subcommand named by args and writes either its result or the error that occurred as JSON.
+// The returned value is the process exit code.
+func runCommand(ctx context.Context, env environment, args []string, output io.Writer) int {
+	var (
+		encoder = json.NewEncoder(output)
+		result  any
+	)
+
+	encoder.SetIndent("", "  ")
+
+	if selected, commandArgs, err := findCommand(args); err != nil {
+		result = errorOutput{Error: err.Error()}
+	} else if commandResult, err := selected.run(newCommandContext(ctx, env.actor), env, commandArgs); err != nil {
+		result = errorOutput{Error: err.Error()}
+	} else {
+		result = commandResult
+	}
+
+	if err := encoder.Encode(result); err != nil {
+		return 1
+	} else if _, failed := result.(errorOutput); failed {
+		return 1
+	}
+
+	return 0
+}
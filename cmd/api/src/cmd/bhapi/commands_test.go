@@ -0,0 +1,269 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/bhctx"
+	"github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFindCommand(t *testing.T) {
+	t.Parallel()
+
+	type testData struct {
+		name         string
+		args         []string
+		expectedName string
+		expectedArgs []string
+		expectedErr  error
+	}
+
+	tt := []testData{
+		{
+			name:         "Success: Multi-word command",
+			args:         []string{"user", "reset-mfa", "admin"},
+			expectedName: "user reset-mfa",
+			expectedArgs: []string{"admin"},
+		},
+		{
+			name:         "Success: Single word command with flags",
+			args:         []string{"migrate", "-dry-run"},
+			expectedName: "migrate",
+			expectedArgs: []string{"-dry-run"},
+		},
+		{
+			name:        "Error: Unknown command",
+			args:        []string{"user", "delete"},
+			expectedErr: ErrUnknownCommand,
+		},
+	}
+
+	for _, testCase := range tt {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			selected, args, err := findCommand(testCase.args)
+			if testCase.expectedErr != nil {
+				assert.ErrorIs(t, err, testCase.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedName, selected.name)
+				assert.Equal(t, testCase.expectedArgs, args)
+			}
+		})
+	}
+}
+
+func TestRunCommand(t *testing.T) {
+	t.Parallel()
+
+	type mock struct {
+		mockDatabase *mocks.MockDatabase
+	}
+	type expected struct {
+		exitCode int
+		output   map[string]any
+	}
+	type testData struct {
+		name       string
+		args       []string
+		setupMocks func(t *testing.T, mock *mock)
+		expected   expected
+	}
+
+	var (
+		tokenID  = uuid.Must(uuid.NewV4())
+		userID   = uuid.Must(uuid.NewV4())
+		cliActor = auth.CLIActor{Name: "operator"}
+	)
+
+	tt := []testData{
+		{
+			name:       "Error: Unknown command",
+			args:       []string{"does", "not", "exist"},
+			setupMocks: func(t *testing.T, mock *mock) {},
+			expected: expected{
+				exitCode: 1,
+				output:   map[string]any{"error": "unknown command: does not exist"},
+			},
+		},
+		{
+			name: "Success: Reset MFA",
+			args: []string{"user", "reset-mfa", "admin"},
+			setupMocks: func(t *testing.T, mock *mock) {
+				t.Helper()
+				mock.mockDatabase.EXPECT().LookupUser(gomock.Any(), "admin").Return(model.User{
+					Unique:        model.Unique{ID: userID},
+					PrincipalName: "admin",
+					AuthSecret:    &model.AuthSecret{UserID: userID, TOTPSecret: "secret", TOTPActivated: true},
+				}, nil)
+				mock.mockDatabase.EXPECT().UpdateAuthSecret(gomock.Any(), model.AuthSecret{UserID: userID}).DoAndReturn(func(ctx context.Context, _ model.AuthSecret) error {
+					// Audit entries written by the database layer must be attributed to the CLI actor
+					owner, isCLIActor := bhctx.Get(ctx).AuthCtx.Owner.(auth.CLIActor)
+					assert.True(t, isCLIActor)
+					assert.Equal(t, cliActor, owner)
+					return nil
+				})
+			},
+			expected: expected{
+				output: map[string]any{"user_id": userID.String(), "principal_name": "admin", "mfa_activated": false},
+			},
+		},
+		{
+			name:       "Error: Rotate storage keys without encryption",
+			args:       []string{"storage", "rotate-keys"},
+			setupMocks: func(t *testing.T, mock *mock) {},
+			expected: expected{
+				exitCode: 1,
+				output:   map[string]any{"error": ErrStorageEncryptionDisabled.Error()},
+			},
+		},
+		{
+			name: "Error: Reset password for SSO user",
+			args: []string{"user", "reset-password", "sso-user"},
+			setupMocks: func(t *testing.T, mock *mock) {
+				t.Helper()
+				user := model.User{PrincipalName: "sso-user"}
+				user.SSOProviderID.Valid = true
+				mock.mockDatabase.EXPECT().LookupUser(gomock.Any(), "sso-user").Return(user, nil)
+			},
+			expected: expected{
+				exitCode: 1,
+				output:   map[string]any{"error": ErrSSOUser.Error()},
+			},
+		},
+		{
+			name: "Success: Revoke token",
+			args: []string{"token", "revoke", tokenID.String()},
+			setupMocks: func(t *testing.T, mock *mock) {
+				t.Helper()
+				token := model.AuthToken{Unique: model.Unique{ID: tokenID}}
+				mock.mockDatabase.EXPECT().GetAuthToken(gomock.Any(), tokenID).Return(token, nil)
+				mock.mockDatabase.EXPECT().DeleteAuthToken(gomock.Any(), token).Return(nil)
+				mock.mockDatabase.EXPECT().AppendAuditLog(gomock.Any(), gomock.Any()).Times(2).Return(nil)
+			},
+			expected: expected{
+				output: map[string]any{"id": tokenID.String(), "revoked": true},
+			},
+		},
+		{
+			name: "Error: Revoke token fails",
+			args: []string{"token", "revoke", tokenID.String()},
+			setupMocks: func(t *testing.T, mock *mock) {
+				t.Helper()
+				token := model.AuthToken{Unique: model.Unique{ID: tokenID}}
+				mock.mockDatabase.EXPECT().GetAuthToken(gomock.Any(), tokenID).Return(token, nil)
+				mock.mockDatabase.EXPECT().DeleteAuthToken(gomock.Any(), token).Return(errors.New("delete failed"))
+				mock.mockDatabase.EXPECT().AppendAuditLog(gomock.Any(), gomock.Any()).Times(2).Return(nil)
+			},
+			expected: expected{
+				exitCode: 1,
+				output:   map[string]any{"error": "error deleting token " + tokenID.String() + ": delete failed"},
+			},
+		},
+		{
+			name:       "Error: Invalid flag value",
+			args:       []string{"flag", "set", "dark_mode", "maybe"},
+			setupMocks: func(t *testing.T, mock *mock) {},
+			expected: expected{
+				exitCode: 1,
+				output:   map[string]any{"error": "invalid arguments: invalid boolean value maybe"},
+			},
+		},
+		{
+			name: "Success: Set non user updatable flag is audited",
+			args: []string{"flag", "set", "changelog", "true"},
+			setupMocks: func(t *testing.T, mock *mock) {
+				t.Helper()
+				mock.mockDatabase.EXPECT().GetFlagByKey(gomock.Any(), "changelog").Return(appcfg.FeatureFlag{Key: "changelog", Name: "Changelog"}, nil)
+				mock.mockDatabase.EXPECT().SetFlag(gomock.Any(), appcfg.FeatureFlag{Key: "changelog", Name: "Changelog", Enabled: true}).Return(nil)
+				mock.mockDatabase.EXPECT().AppendAuditLog(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, entry model.AuditEntry) error {
+					assert.Equal(t, model.AuditLogActionToggleEarlyAccessFeatureFlag, entry.Action)
+					return nil
+				})
+			},
+			expected: expected{
+				output: map[string]any{
+					"id":             float64(0),
+					"created_at":     "0001-01-01T00:00:00Z",
+					"updated_at":     "0001-01-01T00:00:00Z",
+					"deleted_at":     map[string]any{"Time": "0001-01-01T00:00:00Z", "Valid": false},
+					"key":            "changelog",
+					"name":           "Changelog",
+					"description":    "",
+					"enabled":        true,
+					"user_updatable": false,
+				},
+			},
+		},
+		{
+			name: "Success: Request analysis",
+			args: []string{"analysis", "request", "-mode", "no_post_processing"},
+			setupMocks: func(t *testing.T, mock *mock) {
+				t.Helper()
+				mock.mockDatabase.EXPECT().RequestAnalysis(gomock.Any(), "cli:operator", model.AnalysisModeNoPostProcessing).Return(nil)
+				mock.mockDatabase.EXPECT().AppendAuditLog(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expected: expected{
+				output: map[string]any{"requested_by": "cli:operator", "mode": "no_post_processing"},
+			},
+		},
+		{
+			name:       "Error: Unknown analysis mode",
+			args:       []string{"analysis", "request", "-mode", "partial"},
+			setupMocks: func(t *testing.T, mock *mock) {},
+			expected: expected{
+				exitCode: 1,
+				output:   map[string]any{"error": "invalid arguments: unknown analysis mode partial"},
+			},
+		},
+	}
+
+	for _, testCase := range tt {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				ctrl      = gomock.NewController(t)
+				testMocks = &mock{mockDatabase: mocks.NewMockDatabase(ctrl)}
+				output    = &bytes.Buffer{}
+				actual    map[string]any
+			)
+
+			testCase.setupMocks(t, testMocks)
+
+			exitCode := runCommand(context.Background(), environment{db: testMocks.mockDatabase, actor: cliActor}, testCase.args, output)
+
+			require.NoError(t, json.Unmarshal(output.Bytes(), &actual))
+			assert.Equal(t, testCase.expected.exitCode, exitCode)
+			assert.Equal(t, testCase.expected.output, actual)
+		})
+	}
+}
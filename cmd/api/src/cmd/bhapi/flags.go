@@ -0,0 +1,65 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+)
+
+func listFeatureFlags(ctx context.Context, env environment, args []string) (any, error) {
+	if len(args) > 0 {
+		return nil, fmt.Errorf("%w: unexpected argument %s", ErrInvalidArguments, args[0])
+	} else if flags, err := env.db.GetAllFlags(ctx); err != nil {
+		return nil, fmt.Errorf("error listing feature flags: %w", err)
+	} else {
+		return flags, nil
+	}
+}
+
+// setFeatureFlag changes the enablement of a feature flag. Unlike the API, operators may also change flags that are
+// not user updatable. The database layer only audits user updatable flags so all other changes are audited here.
+func setFeatureFlag(ctx context.Context, env environment, args []string) (any, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("%w: expected a feature flag key and a boolean value", ErrInvalidArguments)
+	}
+
+	enabled, err := strconv.ParseBool(args[1])
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid boolean value %s", ErrInvalidArguments, args[1])
+	}
+
+	flag, err := env.db.GetFlagByKey(ctx, args[0])
+	if err != nil {
+		return nil, fmt.Errorf("error fetching feature flag %s: %w", args[0], err)
+	}
+
+	flag.Enabled = enabled
+
+	if err := env.db.SetFlag(ctx, flag); err != nil {
+		return nil, fmt.Errorf("error updating feature flag %s: %w", flag.Key, err)
+	} else if !flag.UserUpdatable {
+		if err := appendAuditEntry(ctx, env.db, model.AuditLogActionToggleEarlyAccessFeatureFlag, model.AuditLogStatusSuccess, flag.AuditData()); err != nil {
+			return nil, err
+		}
+	}
+
+	return flag, nil
+}
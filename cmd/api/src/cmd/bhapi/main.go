@@ -24,6 +24,7 @@ import (
 	"log/slog"
 	"os"
 
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
 	"github.com/specterops/bloodhound/cmd/api/src/bootstrap"
 	"github.com/specterops/bloodhound/cmd/api/src/config"
 	"github.com/specterops/bloodhound/cmd/api/src/database"
@@ -43,6 +44,7 @@ func printVersion() {
 func main() {
 	var (
 		configFilePath string
+		actorName      string
 		versionFlag    bool
 	)
 
@@ -53,12 +55,14 @@ func main() {
 	}
 
 	flag.Usage = func() {
-		fmt.Fprintf(flag.CommandLine.Output(), "BloodHound Community Edition API Server\n\nUsage of %s\n", os.Args[0])
+		fmt.Fprintf(flag.CommandLine.Output(), "BloodHound Community Edition API Server\n\nUsage of %s [flags] [subcommand]\n", os.Args[0])
 		flag.PrintDefaults()
+		printCommandUsage(flag.CommandLine.Output())
 	}
 
 	flag.BoolVar(&versionFlag, "version", false, "Get binary version.")
 	flag.StringVar(&configFilePath, "configfile", bootstrap.DefaultConfigFilePath(), "Configuration file to load.")
+	flag.StringVar(&actorName, "actor", defaultActorName(), "Operator name recorded in audit logs by administrative subcommands.")
 	flag.Parse()
 
 	if versionFlag {
@@ -83,6 +87,11 @@ func main() {
 		logWriter io.Writer = os.Stdout
 	)
 
+	// Administrative subcommands reserve stdout for their JSON output
+	if flag.NArg() > 0 {
+		logWriter = os.Stderr
+	}
+
 	if cfg.LogPath != "" {
 		logFile, err = os.OpenFile(cfg.LogPath, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0666)
 		if err != nil {
@@ -125,6 +134,10 @@ func main() {
 		slog.String("log_level", logLevel.String()),
 	)
 
+	if flag.NArg() > 0 {
+		os.Exit(runAdministrativeCommand(cfg, auth.CLIActor{Name: actorName}, flag.Args()))
+	}
+
 	initializer := bootstrap.Initializer[*database.BloodhoundDB, *graph.DatabaseSwitch]{
 		Configuration:       cfg,
 		DBConnector:         services.ConnectDatabases,
@@ -141,3 +154,28 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// runAdministrativeCommand connects to the configured databases and executes a single administrative subcommand,
+// returning the process exit code.
+func runAdministrativeCommand(cfg config.Configuration, actor auth.CLIActor, args []string) int {
+	ctx := context.Background()
+
+	db, err := services.ConnectPostgres(cfg)
+	if err != nil {
+		slog.Error(
+			"Failed connecting to the database",
+			attr.Error(err),
+		)
+		return 1
+	}
+	defer db.Close(ctx)
+
+	return runCommand(ctx, environment{
+		cfg: cfg,
+		db:  db,
+		connectGraph: func(ctx context.Context) (graph.Database, error) {
+			return bootstrap.ConnectGraph(ctx, cfg)
+		},
+		actor: actor,
+	}, args, os.Stdout)
+}
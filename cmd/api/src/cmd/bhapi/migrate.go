@@ -0,0 +1,99 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/specterops/bloodhound/cmd/api/src/bootstrap"
+	"github.com/specterops/bloodhound/cmd/api/src/config"
+	"github.com/specterops/bloodhound/cmd/api/src/migrations"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/version"
+	"github.com/specterops/bloodhound/packages/go/bhlog/attr"
+)
+
+type migrateOutput struct {
+	DryRun bool              `json:"dry_run"`
+	SQL    []string          `json:"sql"`
+	Graph  []version.Version `json:"graph"`
+}
+
+// migrate reports the pending SQL and graph migrations and, unless -dry-run is given, applies them using the same
+// bootstrap steps as the server entrypoint.
+func migrate(ctx context.Context, env environment, args []string) (any, error) {
+	var (
+		dryRun  bool
+		flagSet = newCommandFlagSet("migrate")
+	)
+
+	flagSet.BoolVar(&dryRun, "dry-run", false, "List pending migrations without applying them.")
+
+	if err := flagSet.Parse(args); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidArguments, err)
+	} else if flagSet.NArg() > 0 {
+		return nil, fmt.Errorf("%w: unexpected argument %s", ErrInvalidArguments, flagSet.Arg(0))
+	}
+
+	graphDB, err := env.connectGraph(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to graph: %w", err)
+	}
+
+	defer graphDB.Close(ctx)
+
+	var (
+		graphMigrator = migrations.NewGraphMigrator(graphDB, migrations.WithSchemalessKindBackfill(env.db))
+		output        = migrateOutput{DryRun: dryRun}
+	)
+
+	if output.SQL, err = env.db.PendingMigrations(ctx); err != nil {
+		return nil, fmt.Errorf("error listing pending sql migrations: %w", err)
+	} else if output.Graph, err = graphMigrator.PendingMigrations(ctx); err != nil {
+		return nil, fmt.Errorf("error listing pending graph migrations: %w", err)
+	} else if dryRun {
+		return output, nil
+	}
+
+	// The audit_logs table may not exist before the first migration so the outcome is only recorded afterwards
+	auditData := model.AuditData{"sql": output.SQL, "graph": output.Graph}
+	if err := applyMigrations(ctx, env, graphMigrator); err != nil {
+		if auditErr := appendAuditEntry(ctx, env.db, model.AuditLogActionMigrateDatabases, model.AuditLogStatusFailure, auditData); auditErr != nil {
+			slog.WarnContext(ctx, "Unable to record failed migration in audit log", attr.Error(auditErr))
+		}
+
+		return nil, err
+	} else if err := appendAuditEntry(ctx, env.db, model.AuditLogActionMigrateDatabases, model.AuditLogStatusSuccess, auditData); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func applyMigrations(ctx context.Context, env environment, graphMigrator *migrations.GraphMigrator) error {
+	if err := bootstrap.MigrateDB(ctx, env.cfg, env.db, config.NewDefaultAdminConfiguration); err != nil {
+		return fmt.Errorf("rdms migration error: %w", err)
+	} else if err := graphMigrator.Migrate(ctx); err != nil {
+		return fmt.Errorf("graph migration error: %w", err)
+	} else if err := bootstrap.PopulateExtensionData(ctx, env.db); err != nil {
+		return fmt.Errorf("extensions data population error: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	storageService "github.com/specterops/bloodhound/cmd/api/src/services/storage"
+	"github.com/specterops/bloodhound/packages/go/storage"
+)
+
+var ErrStorageEncryptionDisabled = errors.New("storage encryption is not enabled")
+
+type rotateStorageKeysOutput struct {
+	MasterKeyID string                          `json:"master_key_id"`
+	Rotated     map[storage.FileServiceName]int `json:"rotated"`
+}
+
+// rotateStorageKeys re-wraps the data key of every encrypted file with the configured master key and encrypts files
+// written before encryption was enabled. Retired keys must remain configured until this has completed.
+func rotateStorageKeys(ctx context.Context, env environment, args []string) (_ any, err error) {
+	if len(args) > 0 {
+		return nil, fmt.Errorf("%w: unexpected argument %s", ErrInvalidArguments, args[0])
+	} else if !env.cfg.Storage.Encryption.Enabled() {
+		return nil, ErrStorageEncryptionDisabled
+	}
+
+	fileServices, err := storageService.NewDefaultFileServices(ctx, env.cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error opening file services: %w", err)
+	}
+	defer func() {
+		if closeErr := storageService.CloseFileServices(fileServices); closeErr != nil {
+			err = errors.Join(err, fmt.Errorf("error closing file services: %w", closeErr))
+		}
+	}()
+
+	var (
+		output    = rotateStorageKeysOutput{MasterKeyID: env.cfg.Storage.Encryption.MasterKeyID}
+		rotateErr error
+	)
+
+	output.Rotated, rotateErr = storageService.RotateEncryptionKeys(ctx, fileServices)
+	auditData := model.AuditData{"master_key_id": output.MasterKeyID, "rotated": output.Rotated}
+
+	if rotateErr != nil {
+		if auditErr := appendAuditEntry(ctx, env.db, model.AuditLogActionRotateStorageKeys, model.AuditLogStatusFailure, auditData); auditErr != nil {
+			return nil, errors.Join(rotateErr, auditErr)
+		}
+
+		return nil, rotateErr
+	} else if err = appendAuditEntry(ctx, env.db, model.AuditLogActionRotateStorageKeys, model.AuditLogStatusSuccess, auditData); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gofrs/uuid"
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+)
+
+const defaultTokenName = "bhapi"
+
+type tokenRevokeOutput struct {
+	ID      uuid.UUID `json:"id"`
+	Revoked bool      `json:"revoked"`
+}
+
+// createAuthToken creates an API token owned by the named user. Tokens created through the CLI have no creating user
+// so their created_by value is the nil UUID, matching the identity recorded for the CLI actor in audit logs.
+func createAuthToken(ctx context.Context, env environment, args []string) (any, error) {
+	var (
+		tokenName string
+		flagSet   = newCommandFlagSet("token create")
+	)
+
+	flagSet.StringVar(&tokenName, "name", defaultTokenName, "Name of the token.")
+
+	if err := flagSet.Parse(args); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidArguments, err)
+	} else if user, err := lookupUserArgument(ctx, env, flagSet.Args()); err != nil {
+		return nil, err
+	} else if authToken, err := auth.NewUserAuthToken(user.ID.String(), tokenName, auth.HMAC_SHA2_256, uuid.Nil); err != nil {
+		return nil, fmt.Errorf("error generating token: %w", err)
+	} else if newAuthToken, err := env.db.CreateAuthToken(ctx, authToken); err != nil {
+		return nil, fmt.Errorf("error creating token: %w", err)
+	} else {
+		return newAuthToken, nil
+	}
+}
+
+// revokeAuthToken deletes an API token. The database layer does not audit token deletion so the intent and outcome
+// are recorded here, mirroring the API handler.
+func revokeAuthToken(ctx context.Context, env environment, args []string) (any, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("%w: expected exactly one token ID", ErrInvalidArguments)
+	}
+
+	tokenID, err := uuid.FromString(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed token ID: %w", ErrInvalidArguments, err)
+	}
+
+	token, err := env.db.GetAuthToken(ctx, tokenID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching token %s: %w", tokenID, err)
+	}
+
+	auditData := model.AuditData{"target_user_id": token.UserID.UUID, "id": token.ID.String()}
+	if err := appendAuditEntry(ctx, env.db, model.AuditLogActionDeleteAuthToken, model.AuditLogStatusIntent, auditData); err != nil {
+		return nil, err
+	}
+
+	if err := env.db.DeleteAuthToken(ctx, token); err != nil {
+		if auditErr := appendAuditEntry(ctx, env.db, model.AuditLogActionDeleteAuthToken, model.AuditLogStatusFailure, auditData); auditErr != nil {
+			return nil, auditErr
+		}
+
+		return nil, fmt.Errorf("error deleting token %s: %w", tokenID, err)
+	} else if err := appendAuditEntry(ctx, env.db, model.AuditLogActionDeleteAuthToken, model.AuditLogStatusSuccess, auditData); err != nil {
+		return nil, err
+	}
+
+	return tokenRevokeOutput{
+		ID:      token.ID,
+		Revoked: true,
+	}, nil
+}
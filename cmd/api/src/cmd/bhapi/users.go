@@ -0,0 +1,131 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/specterops/bloodhound/cmd/api/src/config"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+)
+
+const generatedPasswordLength = 32
+
+var (
+	ErrSSOUser      = errors.New("user authenticates through an SSO provider")
+	ErrNoUserSecret = errors.New("user has no password configured")
+)
+
+type passwordResetOutput struct {
+	UserID                uuid.UUID `json:"user_id"`
+	PrincipalName         string    `json:"principal_name"`
+	Password              string    `json:"password"`
+	PasswordResetRequired bool      `json:"password_reset_required"`
+}
+
+type mfaResetOutput struct {
+	UserID        uuid.UUID `json:"user_id"`
+	PrincipalName string    `json:"principal_name"`
+	MFAActivated  bool      `json:"mfa_activated"`
+}
+
+func lookupUserArgument(ctx context.Context, env environment, args []string) (model.User, error) {
+	if len(args) != 1 {
+		return model.User{}, fmt.Errorf("%w: expected exactly one principal name", ErrInvalidArguments)
+	} else if user, err := env.db.LookupUser(ctx, args[0]); err != nil {
+		return model.User{}, fmt.Errorf("error looking up user %s: %w", args[0], err)
+	} else {
+		return user, nil
+	}
+}
+
+// resetUserPassword replaces the password of a local user with a generated one. The new password is expired
+// immediately so the user is forced to choose their own at next login.
+func resetUserPassword(ctx context.Context, env environment, args []string) (any, error) {
+	user, err := lookupUserArgument(ctx, env, args)
+	if err != nil {
+		return nil, err
+	} else if user.SSOProviderID.Valid {
+		return nil, ErrSSOUser
+	}
+
+	var (
+		secretDigester = env.cfg.Crypto.Argon2.NewDigester()
+		authSecret     = model.AuthSecret{
+			UserID:    user.ID,
+			ExpiresAt: time.Time{},
+		}
+	)
+
+	if user.AuthSecret != nil {
+		authSecret = *user.AuthSecret
+		authSecret.ExpiresAt = time.Time{}
+	}
+
+	if password, err := config.GenerateSecureRandomString(generatedPasswordLength); err != nil {
+		return nil, fmt.Errorf("error generating password: %w", err)
+	} else if secretDigest, err := secretDigester.Digest(password); err != nil {
+		return nil, fmt.Errorf("error digesting password: %w", err)
+	} else {
+		authSecret.Digest = secretDigest.String()
+		authSecret.DigestMethod = secretDigester.Method()
+
+		if user.AuthSecret != nil {
+			err = env.db.UpdateAuthSecret(ctx, authSecret)
+		} else {
+			_, err = env.db.CreateAuthSecret(ctx, authSecret)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("error saving password: %w", err)
+		}
+
+		return passwordResetOutput{
+			UserID:                user.ID,
+			PrincipalName:         user.PrincipalName,
+			Password:              password,
+			PasswordResetRequired: true,
+		}, nil
+	}
+}
+
+// resetUserMFA deactivates TOTP based multi-factor authentication for a local user.
+func resetUserMFA(ctx context.Context, env environment, args []string) (any, error) {
+	user, err := lookupUserArgument(ctx, env, args)
+	if err != nil {
+		return nil, err
+	} else if user.AuthSecret == nil {
+		return nil, ErrNoUserSecret
+	}
+
+	user.AuthSecret.TOTPSecret = ""
+	user.AuthSecret.TOTPActivated = false
+
+	if err := env.db.UpdateAuthSecret(ctx, *user.AuthSecret); err != nil {
+		return nil, fmt.Errorf("error deactivating MFA: %w", err)
+	}
+
+	return mfaResetOutput{
+		UserID:        user.ID,
+		PrincipalName: user.PrincipalName,
+		MFAActivated:  false,
+	}, nil
+}
@@ -228,9 +228,38 @@ type BucketConfiguration struct {
 	Region string `json:"region"`
 }
 
+// StorageEncryptionConfiguration enables envelope encryption of stored files. Encryption is enabled when a master
+// key is set. MasterKey and the values of RetiredKeys are base64 encoded 32 byte keys. Retired keys are only used to
+// unwrap data keys that have not yet been rotated to the current master key. FileServices names the file services
+// that are encrypted and defaults to ingest, retained and job_logs.
+type StorageEncryptionConfiguration struct {
+	MasterKeyID  string            `json:"master_key_id"`
+	MasterKey    string            `json:"master_key"`
+	RetiredKeys  map[string]string `json:"retired_keys"`
+	FileServices []string          `json:"file_services"`
+}
+
+func (s StorageEncryptionConfiguration) Enabled() bool {
+	return s.MasterKey != ""
+}
+
 type StorageConfiguration struct {
 	InstanceBucket BucketConfiguration                 `json:"instance_bucket"`
 	FileServices   map[string]FileServiceConfiguration `json:"file_services"`
+	Encryption     StorageEncryptionConfiguration      `json:"encryption"`
+}
+
+const (
+	RateLimitStoreMemory   = "memory"
+	RateLimitStorePostgres = "postgres"
+)
+
+// RateLimitConfiguration selects where API rate limit counters are kept. The memory store is local to each API
+// process while the postgres store shares counters between every replica connected to the same database. Routes
+// overrides the per second limit of individual routes, keyed by their path template (e.g. /api/v2/login).
+type RateLimitConfiguration struct {
+	Store  string           `json:"store"`
+	Routes map[string]int64 `json:"routes"`
 }
 
 type Configuration struct {
@@ -270,6 +299,7 @@ type Configuration struct {
 	EmbeddedExtensionsBasePath      string                    `json:"embedded_extensions_base_path"`
 	Teleport                        TeleportConfiguration     `json:"teleport"`
 	Storage                         StorageConfiguration      `json:"storage"`
+	RateLimit                       RateLimitConfiguration    `json:"rate_limit"`
 }
 
 func (s Configuration) ScratchDirectory() string {
@@ -114,6 +114,7 @@ func TestSetValuesFromEnv(t *testing.T) {
 			DEFADMINEMAIL     = "bhe_default_admin_email_address"
 			DEFADMINFIRST     = "bhe_default_admin_first_name"
 			DEFADMINLAST      = "bhe_default_admin_last_name"
+			STORAGEMASTERKEY  = "bhe_storage_encryption_master_key"
 		)
 
 		var (
@@ -141,6 +142,7 @@ func TestSetValuesFromEnv(t *testing.T) {
 				DEFADMINEMAIL:     "defaultadminemailaddress",
 				DEFADMINFIRST:     "defaultadminfirstname",
 				DEFADMINLAST:      "defaultadminlastname",
+				STORAGEMASTERKEY:  "storagemasterkey",
 			}
 		)
 
@@ -189,6 +191,10 @@ func TestSetValuesFromEnv(t *testing.T) {
 			assert.Equal(t, options[DEFADMINFIRST], cfg.DefaultAdmin.FirstName)
 			assert.Equal(t, options[DEFADMINLAST], cfg.DefaultAdmin.LastName)
 		})
+
+		t.Run("storage encryption", func(t *testing.T) {
+			assert.Equal(t, options[STORAGEMASTERKEY], cfg.Storage.Encryption.MasterKey)
+		})
 	})
 }
 
@@ -208,6 +214,14 @@ func TestParseConfiguration_Storage(t *testing.T) {
 					"provider": "local",
 					"prefix": ""
 				}
+			},
+			"encryption": {
+				"master_key_id": "2026-10",
+				"master_key": "a2V5",
+				"retired_keys": {
+					"2026-01": "b2xk"
+				},
+				"file_services": ["ingest"]
 			}
 		}
 	}`))
@@ -222,6 +236,28 @@ func TestParseConfiguration_Storage(t *testing.T) {
 	assert.Equal(t, config.FileServiceConfiguration{
 		Provider: "local",
 	}, configuration.Storage.FileServices["work"])
+	assert.Equal(t, config.StorageEncryptionConfiguration{
+		MasterKeyID:  "2026-10",
+		MasterKey:    "a2V5",
+		RetiredKeys:  map[string]string{"2026-01": "b2xk"},
+		FileServices: []string{"ingest"},
+	}, configuration.Storage.Encryption)
+	assert.True(t, configuration.Storage.Encryption.Enabled())
+}
+
+func TestParseConfiguration_RateLimit(t *testing.T) {
+	configuration, err := config.ParseConfiguration([]byte(`{
+		"rate_limit": {
+			"store": "postgres",
+			"routes": {
+				"/api/v2/login": 2
+			}
+		}
+	}`))
+
+	require.NoError(t, err)
+	assert.Equal(t, config.RateLimitStorePostgres, configuration.RateLimit.Store)
+	assert.Equal(t, map[string]int64{"/api/v2/login": 2}, configuration.RateLimit.Routes)
 }
 
 func TestParseConfiguration_DefaultAdminEnabled(t *testing.T) {
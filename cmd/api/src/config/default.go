@@ -111,6 +111,9 @@ func NewDefaultConfiguration() (Configuration, error) {
 				DialAddress: "teleport:3080",
 				WebAddress:  "localhost:3080",
 			},
+			RateLimit: RateLimitConfiguration{
+				Store: RateLimitStoreMemory,
+			},
 		}, nil
 	}
 }
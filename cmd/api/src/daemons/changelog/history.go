@@ -0,0 +1,242 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package changelog
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/lib/pq"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/dawgs/graph"
+)
+
+const defaultChangeSetBatchSize = 1_000
+
+// HistoryRecorder persists ingested object states to the graph change history.
+type HistoryRecorder interface {
+	RecordGraphChanges(ctx context.Context, jobID null.Int64, states model.GraphObjectStates) (int, error)
+	RecordGraphEdgeRemovals(ctx context.Context, jobID null.Int64, families []model.GraphEdgeFamily, ingestedKeys []string) (int, error)
+}
+
+// trackedProperties returns the properties recorded in the change history. Properties excluded from content hashing
+// are excluded from the history as well.
+func trackedProperties(properties *graph.Properties) types.JSONUntypedObject {
+	tracked := types.JSONUntypedObject{}
+
+	if properties == nil {
+		return tracked
+	}
+
+	for key, value := range properties.Map {
+		if _, ignored := ignoredPropertiesKeys[key]; !ignored {
+			tracked[key] = value
+		}
+	}
+
+	return tracked
+}
+
+// State returns the snapshot of the node recorded in the change history.
+func (s NodeChange) State() model.GraphObjectState {
+	return model.GraphObjectState{
+		ObjectType: model.GraphObjectTypeNode,
+		ObjectKey:  s.NodeID,
+		ObjectID:   s.NodeID,
+		Kinds:      pq.StringArray(s.Kinds.Strings()),
+		Properties: trackedProperties(s.Properties),
+	}
+}
+
+func edgeObjectKey(sourceNodeID, targetNodeID string, kind graph.Kind) string {
+	return sourceNodeID + "|" + targetNodeID + "|" + kind.String()
+}
+
+// State returns the snapshot of the edge recorded in the change history.
+func (s EdgeChange) State() model.GraphObjectState {
+	return model.GraphObjectState{
+		ObjectType:     model.GraphObjectTypeEdge,
+		ObjectKey:      edgeObjectKey(s.SourceNodeID, s.TargetNodeID, s.Kind),
+		SourceObjectID: s.SourceNodeID,
+		TargetObjectID: s.TargetNodeID,
+		Kind:           s.Kind.String(),
+		Properties:     trackedProperties(s.Properties),
+	}
+}
+
+// ChangeSet collects the states of the new and modified objects of a single ingest and records them in the change
+// history in batches. It also tracks every ingested node and edge, changed or not, along with the data type that
+// produced each edge, so that edges removed since the previous ingest can be recorded once the ingest completes.
+type ChangeSet struct {
+	recorder  HistoryRecorder
+	jobID     null.Int64
+	batchSize int
+
+	mu            sync.Mutex
+	states        model.GraphObjectStates
+	recorded      int
+	ingestedNodes map[string]struct{}
+	ingestedEdges map[model.GraphEdgeFamily][]string
+}
+
+func NewChangeSet(recorder HistoryRecorder, jobID int64, batchSize int) *ChangeSet {
+	if batchSize <= 0 {
+		batchSize = defaultChangeSetBatchSize
+	}
+
+	changeSet := &ChangeSet{
+		recorder:      recorder,
+		batchSize:     batchSize,
+		states:        make(model.GraphObjectStates, 0, batchSize),
+		ingestedNodes: map[string]struct{}{},
+		ingestedEdges: map[model.GraphEdgeFamily][]string{},
+	}
+
+	if jobID > 0 {
+		changeSet.jobID = null.Int64From(jobID)
+	}
+
+	return changeSet
+}
+
+// Add buffers the state of a new or modified object and records the buffer once it reaches the batch size.
+func (s *ChangeSet) Add(ctx context.Context, state model.GraphObjectState) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if state.ObjectType == model.GraphObjectTypeEdge {
+		s.trackEdge(state.SourceObjectID, state.TargetObjectID, graph.StringKind(state.Kind), state.Producer)
+	} else {
+		s.ingestedNodes[state.ObjectID] = struct{}{}
+	}
+
+	s.states = append(s.states, state)
+
+	if len(s.states) >= s.batchSize {
+		return s.flush(ctx)
+	}
+
+	return nil
+}
+
+// TrackNode records that an unchanged node was ingested.
+func (s *ChangeSet) TrackNode(change NodeChange) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.ingestedNodes[change.NodeID] = struct{}{}
+}
+
+// TrackEdge records that an unchanged edge was ingested from a payload of the given data type.
+func (s *ChangeSet) TrackEdge(change EdgeChange, producer string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.trackEdge(change.SourceNodeID, change.TargetNodeID, change.Kind, producer)
+}
+
+func (s *ChangeSet) trackEdge(sourceNodeID, targetNodeID string, kind graph.Kind, producer string) {
+	family := model.GraphEdgeFamily{TargetObjectID: targetNodeID, Kind: kind.String(), Producer: producer}
+	s.ingestedEdges[family] = append(s.ingestedEdges[family], edgeObjectKey(sourceNodeID, targetNodeID, kind))
+}
+
+// Complete records any buffered states followed by the edges removed since the previous ingest. An edge is considered
+// removed when its target node was ingested along with edges of the same kind and producer ending at it, but the edge
+// itself was not. Complete should only be called once the whole ingest has succeeded, since a partial ingest would report the
+// edges it did not get to as removed.
+func (s *ChangeSet) Complete(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.flush(ctx); err != nil {
+		return err
+	}
+
+	var (
+		families     = make([]model.GraphEdgeFamily, 0, s.batchSize)
+		ingestedKeys []string
+	)
+
+	for family, keys := range s.ingestedEdges {
+		if _, ingested := s.ingestedNodes[family.TargetObjectID]; !ingested {
+			continue
+		}
+
+		families = append(families, family)
+		ingestedKeys = append(ingestedKeys, keys...)
+
+		if len(families) >= s.batchSize {
+			if err := s.recordEdgeRemovals(ctx, families, ingestedKeys); err != nil {
+				return err
+			}
+
+			families, ingestedKeys = families[:0], ingestedKeys[:0]
+		}
+	}
+
+	return s.recordEdgeRemovals(ctx, families, ingestedKeys)
+}
+
+func (s *ChangeSet) recordEdgeRemovals(ctx context.Context, families []model.GraphEdgeFamily, ingestedKeys []string) error {
+	if len(families) == 0 {
+		return nil
+	}
+
+	if recorded, err := s.recorder.RecordGraphEdgeRemovals(ctx, s.jobID, families, ingestedKeys); err != nil {
+		return fmt.Errorf("recording graph edge removals: %w", err)
+	} else {
+		s.recorded += recorded
+		return nil
+	}
+}
+
+// Flush records any buffered states.
+func (s *ChangeSet) Flush(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.flush(ctx)
+}
+
+// Recorded returns the number of changes recorded so far.
+func (s *ChangeSet) Recorded() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.recorded
+}
+
+func (s *ChangeSet) flush(ctx context.Context) error {
+	if len(s.states) == 0 {
+		return nil
+	}
+
+	// The buffer is cleared regardless of success to prevent unbounded growth
+	defer func() {
+		s.states = s.states[:0]
+	}()
+
+	if recorded, err := s.recorder.RecordGraphChanges(ctx, s.jobID, s.states); err != nil {
+		return fmt.Errorf("recording graph changes: %w", err)
+	} else {
+		s.recorded += recorded
+		return nil
+	}
+}
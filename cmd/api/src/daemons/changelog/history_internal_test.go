@@ -0,0 +1,183 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package changelog
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/specterops/bloodhound/cmd/api/src/database/types"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/dawgs/graph"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeHistoryRecorder struct {
+	calls        int
+	jobID        null.Int64
+	states       model.GraphObjectStates
+	families     []model.GraphEdgeFamily
+	ingestedKeys []string
+	err          error
+}
+
+func (s *fakeHistoryRecorder) RecordGraphChanges(_ context.Context, jobID null.Int64, states model.GraphObjectStates) (int, error) {
+	s.calls++
+
+	if s.err != nil {
+		return 0, s.err
+	}
+
+	s.jobID = jobID
+	s.states = append(s.states, states...)
+	return len(states), nil
+}
+
+func (s *fakeHistoryRecorder) RecordGraphEdgeRemovals(_ context.Context, jobID null.Int64, families []model.GraphEdgeFamily, ingestedKeys []string) (int, error) {
+	s.calls++
+
+	if s.err != nil {
+		return 0, s.err
+	}
+
+	s.jobID = jobID
+	s.families = append(s.families, families...)
+	s.ingestedKeys = append(s.ingestedKeys, ingestedKeys...)
+	return 1, nil
+}
+
+func TestNodeChange_State(t *testing.T) {
+	properties := graph.NewProperties().
+		Set("objectid", "ABC").
+		Set("lastseen", "2026-10-18T00:00:00Z").
+		Set("name", "USER@TESTLAB.LOCAL")
+
+	state := NewNodeChange("ABC", graph.Kinds{graph.StringKind("Base"), graph.StringKind("User")}, properties).State()
+
+	require.Equal(t, model.GraphObjectTypeNode, state.ObjectType)
+	require.Equal(t, "ABC", state.ObjectKey)
+	require.Equal(t, "ABC", state.ObjectID)
+	require.Equal(t, []string{"Base", "User"}, []string(state.Kinds))
+	require.Equal(t, types.JSONUntypedObject{"name": "USER@TESTLAB.LOCAL"}, state.Properties)
+}
+
+func TestEdgeChange_State(t *testing.T) {
+	properties := graph.NewProperties().
+		Set("lastseen", "2026-10-18T00:00:00Z").
+		Set("isacl", true)
+
+	state := NewEdgeChange("A", "B", graph.StringKind("GenericAll"), properties).State()
+
+	require.Equal(t, model.GraphObjectTypeEdge, state.ObjectType)
+	require.Equal(t, "A|B|GenericAll", state.ObjectKey)
+	require.Equal(t, "A", state.SourceObjectID)
+	require.Equal(t, "B", state.TargetObjectID)
+	require.Equal(t, "GenericAll", state.Kind)
+	require.Equal(t, types.JSONUntypedObject{"isacl": true}, state.Properties)
+}
+
+func TestChangeSet(t *testing.T) {
+	t.Run("records once the batch size is reached", func(t *testing.T) {
+		var (
+			ctx       = context.Background()
+			recorder  = &fakeHistoryRecorder{}
+			changeSet = NewChangeSet(recorder, 42, 2)
+		)
+
+		require.NoError(t, changeSet.Add(ctx, model.GraphObjectState{ObjectKey: "1"}))
+		require.Equal(t, 0, recorder.calls)
+
+		require.NoError(t, changeSet.Add(ctx, model.GraphObjectState{ObjectKey: "2"}))
+		require.Equal(t, 1, recorder.calls)
+		require.Equal(t, null.Int64From(42), recorder.jobID)
+
+		require.NoError(t, changeSet.Add(ctx, model.GraphObjectState{ObjectKey: "3"}))
+		require.NoError(t, changeSet.Flush(ctx))
+		require.Equal(t, 2, recorder.calls)
+		require.Equal(t, 3, changeSet.Recorded())
+		require.Len(t, recorder.states, 3)
+	})
+
+	t.Run("flush without buffered states does not record", func(t *testing.T) {
+		var (
+			recorder  = &fakeHistoryRecorder{}
+			changeSet = NewChangeSet(recorder, 0, 10)
+		)
+
+		require.NoError(t, changeSet.Flush(context.Background()))
+		require.Equal(t, 0, recorder.calls)
+		require.False(t, changeSet.jobID.Valid)
+	})
+
+	t.Run("failed recording clears the buffer", func(t *testing.T) {
+		var (
+			ctx       = context.Background()
+			recorder  = &fakeHistoryRecorder{err: errors.New("boom")}
+			changeSet = NewChangeSet(recorder, 1, 10)
+		)
+
+		require.NoError(t, changeSet.Add(ctx, model.GraphObjectState{ObjectKey: "1"}))
+		require.ErrorContains(t, changeSet.Flush(ctx), "recording graph changes: boom")
+		require.Empty(t, changeSet.states)
+		require.Equal(t, 0, changeSet.Recorded())
+	})
+	t.Run("complete records removals for edges ending at ingested nodes", func(t *testing.T) {
+		var (
+			ctx        = context.Background()
+			recorder   = &fakeHistoryRecorder{}
+			changeSet  = NewChangeSet(recorder, 3, 10)
+			memberOf   = graph.StringKind("MemberOf")
+			genericAll = graph.StringKind("GenericAll")
+		)
+
+		state := NewEdgeChange("A", "G", memberOf, graph.NewProperties()).State()
+		state.Producer = "groups"
+
+		require.NoError(t, changeSet.Add(ctx, state))
+		changeSet.TrackNode(*NewNodeChange("G", nil, graph.NewProperties()))
+		changeSet.TrackEdge(*NewEdgeChange("B", "G", memberOf, graph.NewProperties()), "groups")
+		changeSet.TrackEdge(*NewEdgeChange("A", "X", genericAll, graph.NewProperties()), "groups")
+
+		require.NoError(t, changeSet.Complete(ctx))
+		require.Equal(t, 2, recorder.calls)
+		require.Equal(t, null.Int64From(3), recorder.jobID)
+		require.Equal(t, []model.GraphEdgeFamily{{TargetObjectID: "G", Kind: "MemberOf", Producer: "groups"}}, recorder.families)
+		require.Equal(t, []string{"A|G|MemberOf", "B|G|MemberOf"}, recorder.ingestedKeys)
+		require.Equal(t, 2, changeSet.Recorded())
+	})
+
+	t.Run("complete scopes removals to the producer of the ingested edges", func(t *testing.T) {
+		var (
+			ctx       = context.Background()
+			recorder  = &fakeHistoryRecorder{}
+			changeSet = NewChangeSet(recorder, 4, 10)
+			memberOf  = graph.StringKind("MemberOf")
+		)
+
+		changeSet.TrackNode(*NewNodeChange("G", nil, graph.NewProperties()))
+		changeSet.TrackEdge(*NewEdgeChange("A", "G", memberOf, graph.NewProperties()), "users")
+		changeSet.TrackEdge(*NewEdgeChange("B", "G", memberOf, graph.NewProperties()), "groups")
+
+		require.NoError(t, changeSet.Complete(ctx))
+		require.ElementsMatch(t, []model.GraphEdgeFamily{
+			{TargetObjectID: "G", Kind: "MemberOf", Producer: "users"},
+			{TargetObjectID: "G", Kind: "MemberOf", Producer: "groups"},
+		}, recorder.families)
+	})
+}
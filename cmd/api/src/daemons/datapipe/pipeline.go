@@ -109,6 +109,16 @@ func (s *BHCEPipeline) DeleteData(ctx context.Context) error {
 		return fmt.Errorf("purging graph data failed: %w", err)
 	}
 
+	// Record the removed entities in the graph change history. The history is informational, so failures do not fail
+	// the deletion.
+	if sourceKinds, err := s.db.GetSourceKinds(ctx); err != nil {
+		slog.WarnContext(ctx, "Getting source kinds for graph change history failed", attr.Error(err))
+	} else if removed, err := s.db.RecordGraphRemovals(ctx, deleteRequest, extractKindNames(sourceKinds).Strings()); err != nil {
+		slog.WarnContext(ctx, "Recording graph removals failed", attr.Error(err))
+	} else if removed > 0 {
+		slog.InfoContext(ctx, "Recorded graph removals", slog.Int("count", removed))
+	}
+
 	// Clear changelog cache to ensure consistency after graph data deletion
 	if s.changelog != nil {
 		s.changelog.ClearCache(ctx)
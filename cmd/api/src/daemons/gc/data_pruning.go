@@ -49,9 +49,11 @@ func (s *Daemon) Start(ctx context.Context) {
 	defer close(s.exitC)
 	defer ticker.Stop()
 
-	// prune sessions and collections once when the daemon starts up
+	// prune sessions, collections, rate limit counters and asset group history once when the daemon starts up
 	s.db.SweepSessions(ctx)
 	s.db.SweepAssetGroupCollections(ctx)
+	s.db.SweepRateLimitCounters(ctx)
+	s.db.SweepAssetGroupHistory(ctx)
 
 	// thereafter, prune conditionally once a day
 	for {
@@ -59,6 +61,8 @@ func (s *Daemon) Start(ctx context.Context) {
 		case <-ticker.C:
 			s.db.SweepSessions(ctx)
 			s.db.SweepAssetGroupCollections(ctx)
+			s.db.SweepRateLimitCounters(ctx)
+			s.db.SweepAssetGroupHistory(ctx)
 
 		case <-s.exitC:
 			return
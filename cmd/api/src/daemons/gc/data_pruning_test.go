@@ -58,6 +58,12 @@ func TestGC_Start(t *testing.T) {
 	mockDB.EXPECT().SweepAssetGroupCollections(gomock.Any()).Do(func(ctx context.Context) {
 		time.Sleep(1 * time.Millisecond)
 	})
+	mockDB.EXPECT().SweepRateLimitCounters(gomock.Any()).Do(func(ctx context.Context) {
+		time.Sleep(1 * time.Millisecond)
+	})
+	mockDB.EXPECT().SweepAssetGroupHistory(gomock.Any()).Do(func(ctx context.Context) {
+		time.Sleep(1 * time.Millisecond)
+	})
 
 	daemon := NewDataPruningDaemon(mockDB)
 	require.NotNil(t, daemon)
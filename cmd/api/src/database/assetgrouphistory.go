@@ -19,18 +19,25 @@ package database
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"strings"
 	"time"
 
 	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
 	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
+	"github.com/specterops/bloodhound/packages/go/bhlog/attr"
 )
 
+const assetGroupHistoryColumns = "id, actor, email, target, action, asset_group_tag_id, environment_id, note, created_at"
+
 // AssetGroupHistoryData defines the methods required to interact with the asset_group_history table
 type AssetGroupHistoryData interface {
 	CreateAssetGroupHistoryRecord(ctx context.Context, actorId, email string, target string, action model.AssetGroupHistoryAction, assetGroupTagId int, environmentId, note null.String) error
 	GetAssetGroupHistoryRecords(ctx context.Context, sqlFilter model.SQLFilter, sortItems model.Sort, skip, limit int) ([]model.AssetGroupHistory, int, error)
+	StreamAssetGroupHistoryRecords(ctx context.Context, sqlFilter model.SQLFilter, sortItems model.Sort, delegate func(record model.AssetGroupHistory) error) error
 	DeleteAssetGroupHistoryRecordsByCreatedDate(ctx context.Context, createdAt time.Time) (int64, error)
+	SweepAssetGroupHistory(ctx context.Context)
 }
 
 func (s *BloodhoundDB) CreateAssetGroupHistoryRecord(ctx context.Context, actorId, emailAddress string, target string, action model.AssetGroupHistoryAction, assetGroupTagId int, environmentId, note null.String) error {
@@ -43,30 +50,52 @@ func (s *BloodhoundDB) DeleteAssetGroupHistoryRecordsByCreatedDate(ctx context.C
 	return result.RowsAffected, CheckError(result)
 }
 
+// SweepAssetGroupHistory deletes all asset group history records older than the configured retention window
+func (s *BloodhoundDB) SweepAssetGroupHistory(ctx context.Context) {
+	retention := appcfg.GetAssetGroupHistoryRetentionParameter(ctx, s)
+
+	if recordsDeletedCount, err := s.DeleteAssetGroupHistoryRecordsByCreatedDate(ctx, time.Now().UTC().AddDate(0, 0, -1*retention.RetentionDays)); err != nil {
+		slog.WarnContext(ctx, "Failed to sweep asset group history records",
+			slog.Int("retention_days", retention.RetentionDays),
+			attr.Error(err))
+	} else if recordsDeletedCount > 0 {
+		slog.InfoContext(ctx, "Swept asset group history records",
+			slog.Int("retention_days", retention.RetentionDays),
+			slog.Int64("count_deleted", recordsDeletedCount))
+	}
+}
+
+// assetGroupHistoryOrderBy builds the ORDER BY clause for the given sort items. Columns are expected to have been
+// validated against model.AssetGroupHistory.IsSortable by the caller.
+func assetGroupHistoryOrderBy(sortItems model.Sort) string {
+	if len(sortItems) == 0 {
+		return ""
+	}
+
+	var sortColumns []string
+	for _, item := range sortItems {
+		dirString := "ASC"
+		if item.Direction == model.DescendingSortDirection {
+			dirString = "DESC"
+		}
+		sortColumns = append(sortColumns, fmt.Sprintf("%s %s", item.Column, dirString))
+	}
+
+	return "ORDER BY " + strings.Join(sortColumns, ", ")
+}
+
 func (s *BloodhoundDB) GetAssetGroupHistoryRecords(ctx context.Context, sqlFilter model.SQLFilter, sortItems model.Sort, skip, limit int) ([]model.AssetGroupHistory, int, error) {
 	var (
 		historyRecs     []model.AssetGroupHistory
 		skipLimitString string
 		rowCount        int
-		sortString      string
+		sortString      = assetGroupHistoryOrderBy(sortItems)
 	)
 
 	if sqlFilter.SQLString != "" {
 		sqlFilter.SQLString = " WHERE " + sqlFilter.SQLString
 	}
 
-	if len(sortItems) > 0 {
-		var sortColumns []string
-		for _, item := range sortItems {
-			dirString := "ASC"
-			if item.Direction == model.DescendingSortDirection {
-				dirString = "DESC"
-			}
-			sortColumns = append(sortColumns, fmt.Sprintf("%s %s", item.Column, dirString))
-		}
-		sortString = "ORDER BY " + strings.Join(sortColumns, ", ")
-	}
-
 	if limit > 0 {
 		skipLimitString += fmt.Sprintf(" LIMIT %d", limit)
 	}
@@ -76,7 +105,8 @@ func (s *BloodhoundDB) GetAssetGroupHistoryRecords(ctx context.Context, sqlFilte
 	}
 
 	sqlStr := fmt.Sprintf(
-		"SELECT id, actor, email, target, action, asset_group_tag_id, environment_id, note, created_at FROM %s%s %s %s",
+		"SELECT %s FROM %s%s %s %s",
+		assetGroupHistoryColumns,
 		(model.AssetGroupHistory{}).TableName(),
 		sqlFilter.SQLString,
 		sortString,
@@ -100,3 +130,36 @@ func (s *BloodhoundDB) GetAssetGroupHistoryRecords(ctx context.Context, sqlFilte
 
 	return historyRecs, rowCount, nil
 }
+
+// StreamAssetGroupHistoryRecords iterates over every asset group history record matching the given filter, in the
+// given order, and hands each one to the delegate without buffering the full result set. Iteration stops at the
+// first error returned by the delegate.
+func (s *BloodhoundDB) StreamAssetGroupHistoryRecords(ctx context.Context, sqlFilter model.SQLFilter, sortItems model.Sort, delegate func(record model.AssetGroupHistory) error) error {
+	if sqlFilter.SQLString != "" {
+		sqlFilter.SQLString = " WHERE " + sqlFilter.SQLString
+	}
+
+	sqlStr := fmt.Sprintf(
+		"SELECT %s FROM %s%s %s",
+		assetGroupHistoryColumns,
+		(model.AssetGroupHistory{}).TableName(),
+		sqlFilter.SQLString,
+		assetGroupHistoryOrderBy(sortItems))
+
+	if rows, err := s.db.WithContext(ctx).Raw(sqlStr, sqlFilter.Params...).Rows(); err != nil {
+		return err
+	} else {
+		defer rows.Close()
+
+		for rows.Next() {
+			var record model.AssetGroupHistory
+			if err := s.db.ScanRows(rows, &record); err != nil {
+				return err
+			} else if err := delegate(record); err != nil {
+				return err
+			}
+		}
+
+		return rows.Err()
+	}
+}
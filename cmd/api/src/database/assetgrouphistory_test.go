@@ -20,12 +20,15 @@ package database_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types"
 	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
 	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
 	"github.com/specterops/bloodhound/cmd/api/src/test/integration"
 	"github.com/stretchr/testify/require"
 )
@@ -156,3 +159,81 @@ func TestDatabase_CreateAndGetAssetGroupHistory(t *testing.T) {
 		require.Equal(t, model.AssetGroupHistoryActionCreateTag, records[0].Action)
 	})
 }
+
+func TestDatabase_SweepAssetGroupHistory(t *testing.T) {
+	var (
+		testCtx   = context.Background()
+		testSuite = setupIntegrationTestSuite(t)
+	)
+
+	defer teardownIntegrationTestSuite(t, &testSuite)
+
+	for range 3 {
+		require.NoError(t, testSuite.BHDatabase.CreateAssetGroupHistoryRecord(testCtx, "actor", "user@example.com", "target", model.AssetGroupHistoryActionCreateTag, 1, null.String{}, null.String{}))
+	}
+
+	// Backdate two records so that they fall on either side of a 30 day retention window
+	require.NoError(t, testSuite.DB.Exec("UPDATE asset_group_history SET created_at = NOW() - INTERVAL '45 DAYS' WHERE id = (SELECT MIN(id) FROM asset_group_history)").Error)
+	require.NoError(t, testSuite.DB.Exec("UPDATE asset_group_history SET created_at = NOW() - INTERVAL '15 DAYS' WHERE id = (SELECT MAX(id) FROM asset_group_history)").Error)
+
+	newVal, err := types.NewJSONBObject(map[string]any{"retention_days": 30})
+	require.NoError(t, err)
+	require.NoError(t, testSuite.BHDatabase.SetConfigurationParameter(testCtx, appcfg.Parameter{
+		Key:   appcfg.AssetGroupHistoryRetention,
+		Value: newVal,
+	}))
+
+	testSuite.BHDatabase.SweepAssetGroupHistory(testCtx)
+
+	records, _, err := testSuite.BHDatabase.GetAssetGroupHistoryRecords(testCtx, model.SQLFilter{}, model.Sort{}, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+}
+
+func TestDatabase_StreamAssetGroupHistoryRecords(t *testing.T) {
+	var (
+		testCtx   = context.Background()
+		testSuite = setupIntegrationTestSuite(t)
+	)
+
+	defer teardownIntegrationTestSuite(t, &testSuite)
+
+	for tagId := range 4 {
+		require.NoError(t, testSuite.BHDatabase.CreateAssetGroupHistoryRecord(testCtx, "actor", "user@example.com", "target", model.AssetGroupHistoryActionCreateTag, tagId%2+1, null.String{}, null.StringFrom("note")))
+	}
+
+	t.Run("streams filtered records in order", func(t *testing.T) {
+		var streamed []model.AssetGroupHistory
+
+		err := testSuite.BHDatabase.StreamAssetGroupHistoryRecords(testCtx,
+			model.SQLFilter{SQLString: "asset_group_tag_id = ?", Params: []any{1}},
+			model.Sort{{Column: "created_at", Direction: model.DescendingSortDirection}},
+			func(record model.AssetGroupHistory) error {
+				streamed = append(streamed, record)
+				return nil
+			})
+		require.NoError(t, err)
+		require.Len(t, streamed, 2)
+
+		for _, record := range streamed {
+			require.Equal(t, 1, record.AssetGroupTagId)
+			require.Equal(t, null.StringFrom("note"), record.Note)
+			require.False(t, record.CreatedAt.IsZero())
+		}
+		require.False(t, streamed[0].CreatedAt.Before(streamed[1].CreatedAt))
+	})
+
+	t.Run("stops at the first delegate error", func(t *testing.T) {
+		var (
+			streamed    int
+			expectedErr = errors.New("stop")
+		)
+
+		err := testSuite.BHDatabase.StreamAssetGroupHistoryRecords(testCtx, model.SQLFilter{}, model.Sort{}, func(record model.AssetGroupHistory) error {
+			streamed++
+			return expectedErr
+		})
+		require.ErrorIs(t, err, expectedErr)
+		require.Equal(t, 1, streamed)
+	})
+}
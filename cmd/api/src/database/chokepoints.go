@@ -0,0 +1,76 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"gorm.io/gorm"
+)
+
+type ChokePointData interface {
+	ReplaceChokePoints(ctx context.Context, environmentID string, chokePoints model.ChokePoints) error
+	GetChokePoints(ctx context.Context, environmentID string, chokePointType model.ChokePointType, order string, limit int, skip int) (model.ChokePoints, int, error)
+}
+
+// ReplaceChokePoints replaces the saved choke points of an environment with those of the latest analysis. An empty
+// slice clears the environment's choke points.
+func (s *BloodhoundDB) ReplaceChokePoints(ctx context.Context, environmentID string, chokePoints model.ChokePoints) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := CheckError(tx.Where("environment_id = ?", environmentID).Delete(&model.ChokePoint{})); err != nil {
+			return err
+		} else if len(chokePoints) == 0 {
+			return nil
+		}
+
+		return CheckError(tx.CreateInBatches(&chokePoints, batchSize))
+	})
+}
+
+// GetChokePoints returns the saved choke points of an environment, ordered by rank unless another order is given. An
+// empty chokePointType returns both node and relationship choke points.
+func (s *BloodhoundDB) GetChokePoints(ctx context.Context, environmentID string, chokePointType model.ChokePointType, order string, limit int, skip int) (model.ChokePoints, int, error) {
+	var (
+		chokePoints model.ChokePoints
+		count       int64
+	)
+
+	filtered := func() *gorm.DB {
+		query := s.db.WithContext(ctx).Model(&model.ChokePoint{}).Where("environment_id = ?", environmentID)
+
+		if chokePointType != "" {
+			query = query.Where("type = ?", chokePointType)
+		}
+
+		return query
+	}
+
+	if err := CheckError(filtered().Count(&count)); err != nil {
+		return chokePoints, 0, err
+	}
+
+	if order == "" {
+		order = "rank"
+	}
+
+	if err := CheckError(filtered().Scopes(Paginate(skip, limit)).Order(order).Order("id").Find(&chokePoints)); err != nil {
+		return chokePoints, 0, err
+	}
+
+	return chokePoints, int(count), nil
+}
@@ -0,0 +1,167 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type DataQualityThresholdData interface {
+	GetDataQualityThresholds(ctx context.Context) (model.DataQualityThresholds, error)
+	GetDataQualityThreshold(ctx context.Context, id int32) (model.DataQualityThreshold, error)
+	CreateDataQualityThreshold(ctx context.Context, threshold model.DataQualityThreshold) (model.DataQualityThreshold, error)
+	UpdateDataQualityThreshold(ctx context.Context, threshold model.DataQualityThreshold) (model.DataQualityThreshold, error)
+	DeleteDataQualityThreshold(ctx context.Context, id int32) error
+
+	CreateDataQualityViolations(ctx context.Context, violations model.DataQualityViolations) (model.DataQualityViolations, error)
+	GetDataQualityViolations(ctx context.Context, start time.Time, end time.Time, order string, limit int, skip int) (model.DataQualityViolations, int, error)
+}
+
+func (s *BloodhoundDB) GetDataQualityThresholds(ctx context.Context) (model.DataQualityThresholds, error) {
+	var thresholds model.DataQualityThresholds
+
+	result := s.db.WithContext(ctx).Order("id").Find(&thresholds)
+	return thresholds, CheckError(result)
+}
+
+func (s *BloodhoundDB) GetDataQualityThreshold(ctx context.Context, id int32) (model.DataQualityThreshold, error) {
+	var threshold model.DataQualityThreshold
+
+	result := s.db.WithContext(ctx).First(&threshold, id)
+	return threshold, CheckError(result)
+}
+
+func (s *BloodhoundDB) CreateDataQualityThreshold(ctx context.Context, threshold model.DataQualityThreshold) (model.DataQualityThreshold, error) {
+	auditEntry := model.AuditEntry{
+		Action: model.AuditLogActionCreateDataQualityThreshold,
+		Model:  &threshold,
+	}
+
+	err := s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
+		return CheckError(tx.WithContext(ctx).Create(&threshold))
+	})
+
+	return threshold, err
+}
+
+func (s *BloodhoundDB) UpdateDataQualityThreshold(ctx context.Context, threshold model.DataQualityThreshold) (model.DataQualityThreshold, error) {
+	auditEntry := model.AuditEntry{
+		Action: model.AuditLogActionUpdateDataQualityThreshold,
+		Model:  &threshold,
+	}
+
+	err := s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
+		result := tx.WithContext(ctx).Model(&threshold).Select(
+			"source", "stat", "threshold_type", "direction", "threshold", "baseline_runs", "pause_tagging", "enabled", "updated_at",
+		).Updates(&threshold)
+
+		if result.Error != nil {
+			return CheckError(result)
+		} else if result.RowsAffected == 0 {
+			return ErrNotFound
+		}
+
+		return nil
+	})
+
+	return threshold, err
+}
+
+func (s *BloodhoundDB) DeleteDataQualityThreshold(ctx context.Context, id int32) error {
+	var (
+		threshold  = model.DataQualityThreshold{Serial: model.Serial{ID: id}}
+		auditEntry = model.AuditEntry{
+			Action: model.AuditLogActionDeleteDataQualityThreshold,
+			Model:  &threshold,
+		}
+	)
+
+	return s.AuditableTransaction(ctx, auditEntry, func(tx *gorm.DB) error {
+		result := tx.WithContext(ctx).Delete(&threshold)
+
+		if result.Error != nil {
+			return CheckError(result)
+		} else if result.RowsAffected == 0 {
+			return ErrNotFound
+		}
+
+		return nil
+	})
+}
+
+// CreateDataQualityViolations saves the given violations and records each newly flagged run in the audit log.
+// Violations that were already recorded for the same run and threshold are skipped, so evaluating a run more than
+// once is safe. Only the newly saved violations are returned.
+func (s *BloodhoundDB) CreateDataQualityViolations(ctx context.Context, violations model.DataQualityViolations) (model.DataQualityViolations, error) {
+	created := make(model.DataQualityViolations, 0, len(violations))
+
+	for _, violation := range violations {
+		result := s.db.WithContext(ctx).Clauses(
+			clause.OnConflict{Columns: []clause.Column{{Name: "run_id"}, {Name: "threshold_id"}}, DoNothing: true},
+		).Create(&violation)
+
+		if err := CheckError(result); err != nil {
+			return created, err
+		} else if result.RowsAffected == 0 {
+			continue
+		}
+
+		if auditEntry, err := model.NewAuditEntry(model.AuditLogActionDataQualityRegression, model.AuditLogStatusSuccess, violation); err != nil {
+			return created, err
+		} else if err := s.AppendAuditLog(ctx, auditEntry); err != nil {
+			return created, fmt.Errorf("could not append data quality violation to audit log: %w", err)
+		}
+
+		created = append(created, violation)
+	}
+
+	return created, nil
+}
+
+func (s *BloodhoundDB) GetDataQualityViolations(ctx context.Context, start time.Time, end time.Time, order string, limit int, skip int) (model.DataQualityViolations, int, error) {
+	const (
+		defaultWhere = "created_at between ? and ?"
+	)
+
+	var (
+		violations model.DataQualityViolations
+		count      int64
+		result     *gorm.DB
+	)
+
+	result = s.db.Model(model.DataQualityViolations{}).WithContext(ctx).Where(defaultWhere, start, end).Count(&count)
+	if CheckError(result) != nil {
+		return violations, 0, result.Error
+	}
+
+	if order == "" {
+		order = "created_at desc"
+	}
+
+	result = s.Scope(Paginate(skip, limit)).WithContext(ctx).Where(defaultWhere, start, end).Order(order).Find(&violations)
+	if CheckError(result) != nil {
+		return violations, 0, result.Error
+	}
+
+	return violations, int(count), nil
+}
@@ -85,6 +85,7 @@ type Database interface {
 
 	Wipe(ctx context.Context) error
 	Migrate(ctx context.Context) error
+	PendingMigrations(ctx context.Context) ([]string, error)
 	PopulateExtensionData(ctx context.Context) error
 	CreateInstallation(ctx context.Context) (model.Installation, error)
 	GetInstallation(ctx context.Context) (model.Installation, error)
@@ -143,6 +144,16 @@ type Database interface {
 
 	// Data Quality
 	DataQualityData
+	DataQualityThresholdData
+
+	// Graph Change History
+	GraphChangeData
+
+	// Attack Path Choke Points
+	ChokePointData
+
+	// Principal Exposures
+	PrincipalExposureData
 
 	// Saved Queries
 	SavedQueriesData
@@ -176,6 +187,9 @@ type Database interface {
 
 	// Kind
 	Kind
+
+	// Rate Limiting
+	RateLimitData
 }
 
 type BloodhoundDB struct {
@@ -309,6 +323,15 @@ func (s *BloodhoundDB) Migrate(ctx context.Context) error {
 	return nil
 }
 
+// PendingMigrations returns the filenames of SQL migrations that Migrate would apply without executing them.
+func (s *BloodhoundDB) PendingMigrations(ctx context.Context) ([]string, error) {
+	if migrator, err := migration.NewMigrator(s.db.WithContext(ctx)); err != nil {
+		return nil, fmt.Errorf("failed to create migrator: %w", err)
+	} else {
+		return migrator.PendingMigrations(ctx)
+	}
+}
+
 func (s *BloodhoundDB) PopulateExtensionData(ctx context.Context) error {
 	migrator, err := migration.NewMigrator(s.db.WithContext(ctx))
 	if err != nil {
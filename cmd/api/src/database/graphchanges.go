@@ -0,0 +1,276 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type GraphChangeData interface {
+	RecordGraphChanges(ctx context.Context, jobID null.Int64, states model.GraphObjectStates) (int, error)
+	RecordGraphEdgeRemovals(ctx context.Context, jobID null.Int64, families []model.GraphEdgeFamily, ingestedKeys []string) (int, error)
+	RecordGraphRemovals(ctx context.Context, deleteRequest model.AnalysisRequest, sourceKinds []string) (int, error)
+	GetGraphChanges(ctx context.Context, since time.Time, until time.Time, order string, limit int, skip int) (model.GraphChanges, int, error)
+	GetGraphObjectChanges(ctx context.Context, objectID string, order string, limit int, skip int) (model.GraphChanges, int, error)
+}
+
+// RecordGraphChanges diffs the given ingested states against their stored snapshots. Ingested states are merged onto
+// the snapshots first since ingest only adds to the properties and kinds of an object. A change is recorded for every
+// object that is new, was previously removed or has different tracked properties, and the snapshots are replaced with
+// the merged states. The number of recorded changes is returned.
+func (s *BloodhoundDB) RecordGraphChanges(ctx context.Context, jobID null.Int64, states model.GraphObjectStates) (int, error) {
+	if len(states) == 0 {
+		return 0, nil
+	}
+
+	var (
+		changes      = make(model.GraphChanges, 0, len(states))
+		current      = make(model.GraphObjectStates, 0, len(states))
+		changed      = make(model.GraphObjectStates, 0, len(states))
+		currentByKey = make(map[string]int, len(states))
+		keys         = make([][]any, 0, len(states))
+	)
+
+	// An object may be ingested more than once in the same batch; its states are merged in ingest order
+	for _, state := range states {
+		if normalized, err := state.Normalized(); err != nil {
+			return 0, err
+		} else if idx, seen := currentByKey[graphObjectStateKey(normalized)]; seen {
+			current[idx] = normalized.MergedOnto(&current[idx])
+		} else {
+			currentByKey[graphObjectStateKey(normalized)] = len(current)
+			current = append(current, normalized)
+			keys = append(keys, []any{normalized.ObjectType, normalized.ObjectKey})
+		}
+	}
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var (
+			previousStates model.GraphObjectStates
+			previousByKey  = make(map[string]*model.GraphObjectState, len(current))
+		)
+
+		if err := CheckError(tx.Where("(object_type, object_key) IN ?", keys).Find(&previousStates)); err != nil {
+			return err
+		}
+
+		for idx := range previousStates {
+			previousByKey[graphObjectStateKey(previousStates[idx])] = &previousStates[idx]
+		}
+
+		for _, state := range current {
+			var (
+				previous = previousByKey[graphObjectStateKey(state)]
+				merged   = state.MergedOnto(previous)
+			)
+
+			if change, isChanged := model.NewGraphChange(jobID, previous, merged); isChanged {
+				changes = append(changes, change)
+				changed = append(changed, merged)
+			}
+		}
+
+		if len(changes) == 0 {
+			return nil
+		}
+
+		if err := CheckError(tx.CreateInBatches(&changes, batchSize)); err != nil {
+			return err
+		}
+
+		return CheckError(tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "object_type"}, {Name: "object_key"}},
+			DoUpdates: clause.AssignmentColumns([]string{"object_id", "source_object_id", "target_object_id", "kind", "kinds", "properties", "producer", "removed_at", "updated_at"}),
+		}).CreateInBatches(&changed, batchSize))
+	})
+
+	return len(changes), err
+}
+
+func graphObjectStateKey(state model.GraphObjectState) string {
+	return string(state.ObjectType) + ":" + state.ObjectKey
+}
+
+// RecordGraphEdgeRemovals records the edges of the given families that were not ingested along with them, identified by
+// the object keys of the ingested edges. Only edges last reported by the producer of their family are considered. The
+// number of recorded changes is returned.
+func (s *BloodhoundDB) RecordGraphEdgeRemovals(ctx context.Context, jobID null.Int64, families []model.GraphEdgeFamily, ingestedKeys []string) (int, error) {
+	const recordEdgeRemovalsSQL = `
+		with removed as (
+			update graph_object_states set removed_at = @removed_at, updated_at = @removed_at
+			where object_type = @object_type and removed_at is null
+			  and (target_object_id, kind, producer) in (select * from unnest(@target_object_ids::text[], @kinds::text[], @producers::text[]))
+			  and not (object_key = any(@ingested_keys::text[]))
+			returning object_type, object_id, source_object_id, target_object_id, kind, kinds
+		)
+		insert into graph_changes (job_id, object_type, change_type, object_id, source_object_id, target_object_id, kind, kinds, created_at, updated_at)
+		select @job_id, object_type, @change_type, object_id, source_object_id, target_object_id, kind, kinds, @removed_at, @removed_at from removed`
+
+	if len(families) == 0 {
+		return 0, nil
+	}
+
+	var (
+		targetObjectIDs = make(pq.StringArray, 0, len(families))
+		kinds           = make(pq.StringArray, 0, len(families))
+		producers       = make(pq.StringArray, 0, len(families))
+	)
+
+	for _, family := range families {
+		targetObjectIDs = append(targetObjectIDs, family.TargetObjectID)
+		kinds = append(kinds, family.Kind)
+		producers = append(producers, family.Producer)
+	}
+
+	result := s.db.WithContext(ctx).Exec(recordEdgeRemovalsSQL, map[string]any{
+		"removed_at":        time.Now().UTC(),
+		"object_type":       model.GraphObjectTypeEdge,
+		"change_type":       model.GraphChangeTypeRemoved,
+		"job_id":            jobID,
+		"target_object_ids": targetObjectIDs,
+		"kinds":             kinds,
+		"producers":         producers,
+		"ingested_keys":     pq.StringArray(ingestedKeys),
+	})
+
+	return int(result.RowsAffected), CheckError(result)
+}
+
+// RecordGraphRemovals records the nodes and edges removed by a graph data deletion request. Edges attached to removed
+// nodes are recorded as removed along with them. The number of recorded changes is returned.
+func (s *BloodhoundDB) RecordGraphRemovals(ctx context.Context, deleteRequest model.AnalysisRequest, sourceKinds []string) (int, error) {
+	const (
+		recordRemovalsSQL = `
+			with removed as (
+				update graph_object_states set removed_at = @removed_at, updated_at = @removed_at
+				where removed_at is null and object_type = @object_type and (%s)
+				returning object_type, object_id, source_object_id, target_object_id, kind, kinds
+			)
+			insert into graph_changes (object_type, change_type, object_id, source_object_id, target_object_id, kind, kinds, created_at, updated_at)
+			select object_type, @change_type, object_id, source_object_id, target_object_id, kind, kinds, @removed_at, @removed_at from removed`
+		removedEndpointsCondition = `source_object_id in (select object_id from graph_object_states where object_type = @node_type and removed_at = @removed_at)
+			or target_object_id in (select object_id from graph_object_states where object_type = @node_type and removed_at = @removed_at)`
+	)
+
+	var (
+		nodeConditions []string
+		edgeConditions = []string{removedEndpointsCondition}
+		recorded       int64
+		args           = map[string]any{
+			"removed_at":           time.Now().UTC(),
+			"change_type":          model.GraphChangeTypeRemoved,
+			"node_type":            model.GraphObjectTypeNode,
+			"source_kinds":         pq.StringArray(sourceKinds),
+			"delete_source_kinds":  pq.StringArray(deleteRequest.DeleteSourceKinds),
+			"delete_relationships": pq.StringArray(deleteRequest.DeleteRelationships),
+		}
+	)
+
+	if deleteRequest.DeleteAllGraph {
+		nodeConditions = append(nodeConditions, "true")
+	} else {
+		if len(deleteRequest.DeleteSourceKinds) > 0 {
+			nodeConditions = append(nodeConditions, "kinds && @delete_source_kinds")
+		}
+
+		if deleteRequest.DeleteSourcelessGraph {
+			nodeConditions = append(nodeConditions, "not (kinds && @source_kinds)")
+		}
+	}
+
+	if len(deleteRequest.DeleteRelationships) > 0 {
+		edgeConditions = append(edgeConditions, "kind = any(@delete_relationships)")
+	}
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(nodeConditions) > 0 {
+			args["object_type"] = model.GraphObjectTypeNode
+
+			if result := tx.Exec(strings.Replace(recordRemovalsSQL, "%s", strings.Join(nodeConditions, " or "), 1), args); result.Error != nil {
+				return CheckError(result)
+			} else {
+				recorded += result.RowsAffected
+			}
+		}
+
+		args["object_type"] = model.GraphObjectTypeEdge
+
+		if result := tx.Exec(strings.Replace(recordRemovalsSQL, "%s", strings.Join(edgeConditions, " or "), 1), args); result.Error != nil {
+			return CheckError(result)
+		} else {
+			recorded += result.RowsAffected
+		}
+
+		return nil
+	})
+
+	return int(recorded), err
+}
+
+func (s *BloodhoundDB) GetGraphChanges(ctx context.Context, since time.Time, until time.Time, order string, limit int, skip int) (model.GraphChanges, int, error) {
+	const (
+		defaultWhere = "created_at between ? and ?"
+	)
+
+	var (
+		changes model.GraphChanges
+		count   int64
+	)
+
+	if result := s.db.Model(model.GraphChanges{}).WithContext(ctx).Where(defaultWhere, since, until).Count(&count); CheckError(result) != nil {
+		return changes, 0, result.Error
+	}
+
+	if order == "" {
+		order = "created_at desc, id desc"
+	}
+
+	result := s.Scope(Paginate(skip, limit)).WithContext(ctx).Where(defaultWhere, since, until).Order(order).Find(&changes)
+	return changes, int(count), CheckError(result)
+}
+
+// GetGraphObjectChanges returns the history of a node, including the edges that start or end at the node.
+func (s *BloodhoundDB) GetGraphObjectChanges(ctx context.Context, objectID string, order string, limit int, skip int) (model.GraphChanges, int, error) {
+	const (
+		defaultWhere = "object_id = @object_id or source_object_id = @object_id or target_object_id = @object_id"
+	)
+
+	var (
+		changes model.GraphChanges
+		count   int64
+		args    = map[string]any{"object_id": objectID}
+	)
+
+	if result := s.db.Model(model.GraphChanges{}).WithContext(ctx).Where(defaultWhere, args).Count(&count); CheckError(result) != nil {
+		return changes, 0, result.Error
+	}
+
+	if order == "" {
+		order = "created_at desc, id desc"
+	}
+
+	result := s.Scope(Paginate(skip, limit)).WithContext(ctx).Where(defaultWhere, args).Order(order).Find(&changes)
+	return changes, int(count), CheckError(result)
+}
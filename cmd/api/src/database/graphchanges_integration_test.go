@@ -0,0 +1,81 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build integration
+
+package database_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/test/integration"
+	"github.com/stretchr/testify/require"
+)
+
+func memberOfState(sourceObjectID, targetObjectID, producer string) model.GraphObjectState {
+	return model.GraphObjectState{
+		ObjectType:     model.GraphObjectTypeEdge,
+		ObjectKey:      sourceObjectID + "|" + targetObjectID + "|MemberOf",
+		SourceObjectID: sourceObjectID,
+		TargetObjectID: targetObjectID,
+		Kind:           "MemberOf",
+		Producer:       producer,
+	}
+}
+
+func TestBloodhoundDB_RecordGraphEdgeRemovals(t *testing.T) {
+	var (
+		dbInst = integration.SetupDB(t)
+		ctx    = context.Background()
+		since  = time.Now().Add(-time.Minute)
+	)
+
+	// users.json reports the primary group membership of USER-A while groups.json reports the members of the group
+	recorded, err := dbInst.RecordGraphChanges(ctx, null.Int64From(1), model.GraphObjectStates{memberOfState("USER-A", "GROUP", "users")})
+	require.NoError(t, err)
+	require.Equal(t, 1, recorded)
+
+	recorded, err = dbInst.RecordGraphChanges(ctx, null.Int64From(2), model.GraphObjectStates{
+		memberOfState("USER-B", "GROUP", "groups"),
+		memberOfState("USER-C", "GROUP", "groups"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, recorded)
+
+	// Ingesting groups.json again without USER-A removes nothing
+	removed, err := dbInst.RecordGraphEdgeRemovals(ctx, null.Int64From(3),
+		[]model.GraphEdgeFamily{{TargetObjectID: "GROUP", Kind: "MemberOf", Producer: "groups"}},
+		[]string{"USER-B|GROUP|MemberOf", "USER-C|GROUP|MemberOf"})
+	require.NoError(t, err)
+	require.Zero(t, removed)
+
+	// Dropping USER-C from groups.json removes its membership only
+	removed, err = dbInst.RecordGraphEdgeRemovals(ctx, null.Int64From(4),
+		[]model.GraphEdgeFamily{{TargetObjectID: "GROUP", Kind: "MemberOf", Producer: "groups"}},
+		[]string{"USER-B|GROUP|MemberOf"})
+	require.NoError(t, err)
+	require.Equal(t, 1, removed)
+
+	changes, count, err := dbInst.GetGraphChanges(ctx, since, time.Now().Add(time.Minute), "", 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, 4, count)
+	require.Equal(t, model.GraphChangeTypeRemoved, changes[0].ChangeType)
+	require.Equal(t, "USER-C", changes[0].SourceObjectID)
+}
@@ -38,6 +38,7 @@ type OpenGraphSchema interface {
 	GetGraphSchemaExtensions(ctx context.Context, extensionFilters model.Filters, sort model.Sort, skip, limit int) (model.GraphSchemaExtensions, int, error)
 	UpdateGraphSchemaExtension(ctx context.Context, extension model.GraphSchemaExtension) (model.GraphSchemaExtension, error)
 	DeleteGraphSchemaExtension(ctx context.Context, extensionId int32) error
+	GetGraphSchemaExtensionVersions(ctx context.Context, extensionId int32) ([]model.GraphSchemaExtensionVersion, error)
 
 	CreateGraphSchemaNodeKind(ctx context.Context, name string, extensionId int32, displayName string, description string, isDisplayKind bool, icon, iconColor string) (model.GraphSchemaNodeKind, error)
 	GetGraphSchemaNodeKindById(ctx context.Context, schemaNodeKindID int32) (model.GraphSchemaNodeKind, error)
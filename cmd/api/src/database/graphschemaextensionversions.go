@@ -0,0 +1,73 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+)
+
+// GetGraphSchemaExtensionVersions - returns the recorded versions of the given extension that have not been rolled back,
+// newest first. The first entry is the active version.
+func (s *BloodhoundDB) GetGraphSchemaExtensionVersions(ctx context.Context, extensionId int32) ([]model.GraphSchemaExtensionVersion, error) {
+	var versions []model.GraphSchemaExtensionVersion
+
+	if result := s.db.WithContext(ctx).Raw(fmt.Sprintf(`
+		SELECT id, schema_extension_id, sequence, version, payload, created_at, updated_at, deleted_at
+		FROM %s WHERE schema_extension_id = ? AND deleted_at IS NULL ORDER BY sequence DESC`,
+		model.GraphSchemaExtensionVersion{}.TableName()),
+		extensionId).Scan(&versions); result.Error != nil {
+		return nil, CheckError(result)
+	}
+
+	return versions, nil
+}
+
+// recordGraphSchemaExtensionVersion records the installed extension definition. Installing appends the definition to
+// the extension's history under the next sequence, whatever version string it declares. Reinstalling a recorded version,
+// as a rollback does, reactivates it as it was recorded and soft deletes every version recorded after it, so the
+// history is kept and sequences are never reused.
+func (s *BloodhoundDB) recordGraphSchemaExtensionVersion(ctx context.Context, extensionId int32, graphExtensionInput model.GraphExtensionInput) error {
+	tableName := model.GraphSchemaExtensionVersion{}.TableName()
+
+	if graphExtensionInput.RestoresSequence > 0 {
+		if result := s.db.WithContext(ctx).Exec(fmt.Sprintf(`
+			UPDATE %s SET deleted_at = NULL, updated_at = NOW() WHERE schema_extension_id = ? AND sequence = ?`, tableName),
+			extensionId, graphExtensionInput.RestoresSequence); result.Error != nil {
+			return CheckError(result)
+		} else if result.RowsAffected == 0 {
+			return fmt.Errorf("extension version %d: %w", graphExtensionInput.RestoresSequence, ErrNotFound)
+		}
+
+		return CheckError(s.db.WithContext(ctx).Exec(fmt.Sprintf(`
+			UPDATE %s SET deleted_at = NOW(), updated_at = NOW() WHERE schema_extension_id = ? AND sequence > ? AND deleted_at IS NULL`, tableName),
+			extensionId, graphExtensionInput.RestoresSequence))
+	}
+
+	payload, err := json.Marshal(graphExtensionInput)
+	if err != nil {
+		return fmt.Errorf("error encoding extension version: %w", err)
+	}
+
+	return CheckError(s.db.WithContext(ctx).Exec(fmt.Sprintf(`
+		INSERT INTO %[1]s (schema_extension_id, sequence, version, payload, created_at, updated_at)
+		SELECT ?, COALESCE(MAX(sequence), 0) + 1, ?, ?::jsonb, NOW(), NOW() FROM %[1]s WHERE schema_extension_id = ?`, tableName),
+		extensionId, graphExtensionInput.ExtensionInput.Version, string(payload), extensionId))
+}
@@ -171,6 +171,10 @@ BEGIN
 	PERFORM genscript_upsert_kind('NTAuthStore');
 	PERFORM genscript_upsert_kind('CertTemplate');
 	PERFORM genscript_upsert_kind('IssuancePolicy');
+	PERFORM genscript_upsert_kind('Site');
+	PERFORM genscript_upsert_kind('Subnet');
+	PERFORM genscript_upsert_kind('DNSZone');
+	PERFORM genscript_upsert_kind('DNSNode');
 
 	-- Insert Relationship Kinds
 	PERFORM genscript_upsert_kind('Owns');
@@ -261,6 +265,16 @@ BEGIN
 	PERFORM genscript_upsert_kind('WriteAltSecurityIdentities');
 	PERFORM genscript_upsert_kind('WritePublicInformation');
 	PERFORM genscript_upsert_kind('ProtectAdminGroups');
+	PERFORM genscript_upsert_kind('CreateDMSA');
+	PERFORM genscript_upsert_kind('BadSuccessor');
+	PERFORM genscript_upsert_kind('HasSubnet');
+	PERFORM genscript_upsert_kind('InSubnet');
+	PERFORM genscript_upsert_kind('CreateDNSRecord');
+	PERFORM genscript_upsert_kind('CanSpoofDNS');
+	PERFORM genscript_upsert_kind('Kerberoastable');
+	PERFORM genscript_upsert_kind('ASREPRoastable');
+	PERFORM genscript_upsert_kind('AllowedToAuthenticate');
+	PERFORM genscript_upsert_kind('ForeignMemberOf');
 
 	PERFORM genscript_upsert_schema_node_kind(extension_id, 'Base', 'Base', '', false, '', '');
 	PERFORM genscript_upsert_schema_node_kind(extension_id, 'User', 'User', '', true, 'user', '#17E625');
@@ -278,6 +292,10 @@ BEGIN
 	PERFORM genscript_upsert_schema_node_kind(extension_id, 'NTAuthStore', 'NTAuthStore', '', true, 'store', '#D575F5');
 	PERFORM genscript_upsert_schema_node_kind(extension_id, 'CertTemplate', 'CertTemplate', '', true, 'id-card', '#B153F3');
 	PERFORM genscript_upsert_schema_node_kind(extension_id, 'IssuancePolicy', 'IssuancePolicy', '', true, 'clipboard-check', '#99B2DD');
+	PERFORM genscript_upsert_schema_node_kind(extension_id, 'Site', 'Site', '', true, 'map-location-dot', '#7FB77E');
+	PERFORM genscript_upsert_schema_node_kind(extension_id, 'Subnet', 'Subnet', '', true, 'network-wired', '#A8C5E8');
+	PERFORM genscript_upsert_schema_node_kind(extension_id, 'DNSZone', 'DNSZone', '', true, 'folder-tree', '#8FB3D9');
+	PERFORM genscript_upsert_schema_node_kind(extension_id, 'DNSNode', 'DNSNode', '', true, 'file-lines', '#B7C9DE');
 
 	-- Keep custom_node_kinds in sync with node kinds
 	PERFORM genscript_upsert_custom_node_kind('User', '{"icon": {"name": "user", "type": "font-awesome", "color": "#17E625"}}');
@@ -293,6 +311,10 @@ BEGIN
 	PERFORM genscript_upsert_custom_node_kind('NTAuthStore', '{"icon": {"name": "store", "type": "font-awesome", "color": "#D575F5"}}');
 	PERFORM genscript_upsert_custom_node_kind('CertTemplate', '{"icon": {"name": "id-card", "type": "font-awesome", "color": "#B153F3"}}');
 	PERFORM genscript_upsert_custom_node_kind('IssuancePolicy', '{"icon": {"name": "clipboard-check", "type": "font-awesome", "color": "#99B2DD"}}');
+	PERFORM genscript_upsert_custom_node_kind('Site', '{"icon": {"name": "map-location-dot", "type": "font-awesome", "color": "#7FB77E"}}');
+	PERFORM genscript_upsert_custom_node_kind('Subnet', '{"icon": {"name": "network-wired", "type": "font-awesome", "color": "#A8C5E8"}}');
+	PERFORM genscript_upsert_custom_node_kind('DNSZone', '{"icon": {"name": "folder-tree", "type": "font-awesome", "color": "#8FB3D9"}}');
+	PERFORM genscript_upsert_custom_node_kind('DNSNode', '{"icon": {"name": "file-lines", "type": "font-awesome", "color": "#B7C9DE"}}');
 
 	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'Owns', '', true);
 	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'GenericAll', '', true);
@@ -382,6 +404,16 @@ BEGIN
 	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'WriteAltSecurityIdentities', '', true);
 	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'WritePublicInformation', '', true);
 	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'ProtectAdminGroups', '', false);
+	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'CreateDMSA', '', false);
+	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'BadSuccessor', '', true);
+	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'HasSubnet', '', false);
+	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'InSubnet', '', false);
+	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'CreateDNSRecord', '', false);
+	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'CanSpoofDNS', '', false);
+	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'Kerberoastable', '', true);
+	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'ASREPRoastable', '', true);
+	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'AllowedToAuthenticate', '', false);
+	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'ForeignMemberOf', '', true);
 
 	PERFORM genscript_upsert_source_kind('Base');
 	PERFORM genscript_upsert_kind('Domain');
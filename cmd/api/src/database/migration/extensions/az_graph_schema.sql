@@ -176,6 +176,8 @@ BEGIN
 	PERFORM genscript_upsert_kind('AZLogicApp');
 	PERFORM genscript_upsert_kind('AZAutomationAccount');
 	PERFORM genscript_upsert_kind('AZFederatedIdentityCredential');
+	PERFORM genscript_upsert_kind('AZAdministrativeUnit');
+	PERFORM genscript_upsert_kind('AZConditionalAccessPolicy');
 
 	-- Insert Relationship Kinds
 	PERFORM genscript_upsert_kind('AZAvereContributor');
@@ -228,6 +230,9 @@ BEGIN
 	PERFORM genscript_upsert_kind('AZRoleEligible');
 	PERFORM genscript_upsert_kind('AZRoleApprover');
 	PERFORM genscript_upsert_kind('AZAuthenticatesTo');
+	PERFORM genscript_upsert_kind('AZHasScopedRole');
+	PERFORM genscript_upsert_kind('AZConditionalAccessIncludes');
+	PERFORM genscript_upsert_kind('AZConditionalAccessExcludes');
 
 	PERFORM genscript_upsert_schema_node_kind(extension_id, 'AZBase', 'AZBase', '', false, '', '');
 	PERFORM genscript_upsert_schema_node_kind(extension_id, 'AZVMScaleSet', 'AZVMScaleSet', '', true, 'server', '#007CD0');
@@ -250,6 +255,8 @@ BEGIN
 	PERFORM genscript_upsert_schema_node_kind(extension_id, 'AZLogicApp', 'AZLogicApp', '', true, 'sitemap', '#9EE047');
 	PERFORM genscript_upsert_schema_node_kind(extension_id, 'AZAutomationAccount', 'AZAutomationAccount', '', true, 'cog', '#F4BA44');
 	PERFORM genscript_upsert_schema_node_kind(extension_id, 'AZFederatedIdentityCredential', 'AZFederatedIdentityCredential', '', true, 'key', '#FFEE8C');
+	PERFORM genscript_upsert_schema_node_kind(extension_id, 'AZAdministrativeUnit', 'AZAdministrativeUnit', '', true, 'users-rectangle', '#C8D8F0');
+	PERFORM genscript_upsert_schema_node_kind(extension_id, 'AZConditionalAccessPolicy', 'AZConditionalAccessPolicy', '', true, 'shield-halved', '#9EC9C1');
 
 	-- Keep custom_node_kinds in sync with node kinds
 	PERFORM genscript_upsert_custom_node_kind('AZVMScaleSet', '{"icon": {"name": "server", "type": "font-awesome", "color": "#007CD0"}}');
@@ -272,6 +279,8 @@ BEGIN
 	PERFORM genscript_upsert_custom_node_kind('AZLogicApp', '{"icon": {"name": "sitemap", "type": "font-awesome", "color": "#9EE047"}}');
 	PERFORM genscript_upsert_custom_node_kind('AZAutomationAccount', '{"icon": {"name": "cog", "type": "font-awesome", "color": "#F4BA44"}}');
 	PERFORM genscript_upsert_custom_node_kind('AZFederatedIdentityCredential', '{"icon": {"name": "key", "type": "font-awesome", "color": "#FFEE8C"}}');
+	PERFORM genscript_upsert_custom_node_kind('AZAdministrativeUnit', '{"icon": {"name": "users-rectangle", "type": "font-awesome", "color": "#C8D8F0"}}');
+	PERFORM genscript_upsert_custom_node_kind('AZConditionalAccessPolicy', '{"icon": {"name": "shield-halved", "type": "font-awesome", "color": "#9EC9C1"}}');
 
 	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'AZAvereContributor', '', true);
 	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'AZContains', '', true);
@@ -323,6 +332,9 @@ BEGIN
 	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'AZRoleEligible', '', true);
 	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'AZRoleApprover', '', true);
 	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'AZAuthenticatesTo', '', true);
+	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'AZHasScopedRole', '', false);
+	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'AZConditionalAccessIncludes', '', false);
+	PERFORM genscript_upsert_schema_relationship_kind(extension_id, 'AZConditionalAccessExcludes', '', false);
 
 	PERFORM genscript_upsert_source_kind('AZBase');
 	PERFORM genscript_upsert_kind('AZTenant');
@@ -45,6 +45,38 @@ func (s *Migrator) HasPendingMigrations(ctx context.Context) (bool, error) {
 	}
 }
 
+// PendingMigrations returns the filenames of goose migrations that have not been applied yet. When goose has not been
+// bootstrapped, the result mirrors what ExecuteGooseMigrations would run: every source for a new installation, or
+// every source after the baseline migrations for an installation still tracked by the legacy migrations table.
+func (s *Migrator) PendingMigrations(ctx context.Context) ([]string, error) {
+	var pending []string
+
+	if hasGooseDbTable, err := s.hasGooseDbTable(); err != nil {
+		return nil, fmt.Errorf("failed to check if goose migration table exists: %w", err)
+	} else if !hasGooseDbTable {
+		hasLegacyTable, err := s.HasMigrationTable()
+		if err != nil {
+			return nil, fmt.Errorf("failed to check if legacy migration table exists: %w", err)
+		}
+
+		for _, source := range s.GooseProvider.ListSources() {
+			if !hasLegacyTable || source.Version > gooseBaselineVersion {
+				pending = append(pending, filepath.Base(source.Path))
+			}
+		}
+	} else if statuses, err := s.GooseProvider.Status(ctx); err != nil {
+		return nil, fmt.Errorf("failed to retrieve migration status: %w", err)
+	} else {
+		for _, status := range statuses {
+			if status.State == goose.StatePending {
+				pending = append(pending, filepath.Base(status.Source.Path))
+			}
+		}
+	}
+
+	return pending, nil
+}
+
 // HasMigrationTable is a utility for checking if migration schema is initialized. We assume that
 // if the `migrations` table exists, the schema must be initialized, and vice versa.
 func (s *Migrator) HasMigrationTable() (bool, error) {
@@ -187,6 +219,9 @@ func (s *Migrator) runLegacyMigrationsIfNeeded() error {
 	return nil
 }
 
+// gooseBaselineVersion is the highest goose version marked as applied by bootstrapGoose.
+const gooseBaselineVersion = 2
+
 func (s *Migrator) bootstrapGoose() error {
 	// Use goose's actual table schema and mark baseline migrations as applied
 	// Version 1 = BHCE baseline (00000000000001_init.sql)
@@ -0,0 +1,38 @@
+-- Copyright 2026 Specter Ops, Inc.
+--
+-- Licensed under the Apache License, Version 2.0
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--     http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- +goose Up
+-- Distinguish the roles shipped with BloodHound from custom roles created by administrators.
+-- Built-in roles are read-only through the API; custom roles may be created, updated and deleted.
+ALTER TABLE roles ADD COLUMN IF NOT EXISTS built_in BOOLEAN NOT NULL DEFAULT false;
+
+UPDATE roles
+SET built_in = true
+WHERE name IN ('Administrator', 'Auditor', 'Power User', 'Read-Only', 'Upload-Only', 'User');
+
+-- Deleting a custom role must also remove its permission grants
+ALTER TABLE ONLY roles_permissions DROP CONSTRAINT IF EXISTS fk_roles_permissions_role;
+ALTER TABLE ONLY roles_permissions ADD CONSTRAINT fk_roles_permissions_role FOREIGN KEY (role_id) REFERENCES roles(id) ON DELETE CASCADE;
+
+-- +goose Down
+ALTER TABLE ONLY roles_permissions DROP CONSTRAINT IF EXISTS fk_roles_permissions_role;
+ALTER TABLE ONLY roles_permissions ADD CONSTRAINT fk_roles_permissions_role FOREIGN KEY (role_id) REFERENCES roles(id);
+
+DELETE FROM users_roles WHERE role_id IN (SELECT id FROM roles WHERE built_in = false);
+DELETE FROM roles_permissions WHERE role_id IN (SELECT id FROM roles WHERE built_in = false);
+DELETE FROM roles WHERE built_in = false;
+
+ALTER TABLE roles DROP COLUMN IF EXISTS built_in;
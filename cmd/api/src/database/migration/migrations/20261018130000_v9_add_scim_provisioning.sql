@@ -0,0 +1,88 @@
+-- Copyright 2026 Specter Ops, Inc.
+--
+-- Licensed under the Apache License, Version 2.0
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--     http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- +goose Up
+-- Bearer tokens presented by identity providers to the SCIM 2.0 endpoints. Only the SHA-256 digest of a token is
+-- stored. Users provisioned with a token are bound to the token's SSO provider, if any.
+CREATE TABLE IF NOT EXISTS scim_tokens
+(
+    id              TEXT PRIMARY KEY,
+    name            TEXT                     NOT NULL,
+    digest          TEXT                     NOT NULL UNIQUE,
+    sso_provider_id INTEGER                  NULL REFERENCES sso_providers (id) ON DELETE SET NULL,
+    last_used_at    TIMESTAMP WITH TIME ZONE NULL,
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp,
+    updated_at      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp
+);
+
+-- Users provisioned through SCIM. An identity provider can only read and change the users it provisioned, which are
+-- scoped to the SSO provider of the token that created them. Users created locally or just in time at SSO login have
+-- no row here and are never visible to SCIM.
+CREATE TABLE IF NOT EXISTS scim_users
+(
+    user_id         TEXT PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    sso_provider_id INTEGER                  NULL REFERENCES sso_providers (id) ON DELETE SET NULL,
+    external_id     TEXT                     NULL,
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp,
+    updated_at      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp
+);
+
+CREATE TABLE IF NOT EXISTS scim_groups
+(
+    id              TEXT PRIMARY KEY,
+    sso_provider_id INTEGER                  NULL REFERENCES sso_providers (id) ON DELETE SET NULL,
+    display_name    TEXT                     NOT NULL UNIQUE,
+    external_id     TEXT                     NULL,
+    created_at      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp,
+    updated_at      TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp
+);
+
+CREATE TABLE IF NOT EXISTS scim_group_members
+(
+    group_id TEXT NOT NULL REFERENCES scim_groups (id) ON DELETE CASCADE,
+    user_id  TEXT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    PRIMARY KEY (group_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_scim_group_members_user_id ON scim_group_members USING btree (user_id);
+
+-- Roles granted to the members of a SCIM group. Mappings are configured by BloodHound administrators only; identity
+-- providers cannot create or change them.
+CREATE TABLE IF NOT EXISTS scim_group_roles
+(
+    group_id   TEXT PRIMARY KEY REFERENCES scim_groups (id) ON DELETE CASCADE,
+    role_id    INTEGER                  NOT NULL REFERENCES roles (id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp
+);
+
+-- Role assignments that were created by SCIM group role mappings. SCIM only ever revokes the assignments recorded
+-- here so that roles assigned locally are left untouched. Removing the assignment locally removes the record.
+CREATE TABLE IF NOT EXISTS scim_user_roles
+(
+    user_id TEXT    NOT NULL,
+    role_id INTEGER NOT NULL,
+    PRIMARY KEY (user_id, role_id),
+    FOREIGN KEY (user_id, role_id) REFERENCES users_roles (user_id, role_id) ON DELETE CASCADE
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS scim_user_roles;
+DROP TABLE IF EXISTS scim_group_roles;
+DROP TABLE IF EXISTS scim_group_members;
+DROP TABLE IF EXISTS scim_groups;
+DROP TABLE IF EXISTS scim_users;
+DROP TABLE IF EXISTS scim_tokens;
@@ -0,0 +1,33 @@
+-- Copyright 2026 Specter Ops, Inc.
+--
+-- Licensed under the Apache License, Version 2.0
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--     http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- +goose Up
+ALTER TABLE IF EXISTS azure_data_quality_stats
+    ADD COLUMN IF NOT EXISTS conditional_access_policies bigint DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS enforcing_conditional_access_policies bigint DEFAULT 0;
+
+ALTER TABLE IF EXISTS azure_data_quality_aggregations
+    ADD COLUMN IF NOT EXISTS conditional_access_policies bigint DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS enforcing_conditional_access_policies bigint DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE IF EXISTS azure_data_quality_stats
+    DROP COLUMN IF EXISTS conditional_access_policies,
+    DROP COLUMN IF EXISTS enforcing_conditional_access_policies;
+
+ALTER TABLE IF EXISTS azure_data_quality_aggregations
+    DROP COLUMN IF EXISTS conditional_access_policies,
+    DROP COLUMN IF EXISTS enforcing_conditional_access_policies;
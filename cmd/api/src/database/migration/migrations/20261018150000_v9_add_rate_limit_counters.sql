@@ -0,0 +1,30 @@
+-- Copyright 2026 Specter Ops, Inc.
+--
+-- Licensed under the Apache License, Version 2.0
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--     http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- +goose Up
+-- Fixed window request counters for the postgres rate limit store. Rows are reset in place once their window expires
+-- and swept periodically by the data pruning daemon.
+CREATE TABLE IF NOT EXISTS rate_limit_counters
+(
+    key        TEXT PRIMARY KEY,
+    hits       BIGINT                   NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_rate_limit_counters_expires_at ON rate_limit_counters (expires_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS rate_limit_counters;
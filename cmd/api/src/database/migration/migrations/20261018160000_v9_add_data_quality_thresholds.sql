@@ -0,0 +1,59 @@
+-- Copyright 2026 Specter Ops, Inc.
+--
+-- Licensed under the Apache License, Version 2.0
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--     http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- +goose Up
+-- Regression thresholds evaluated against the AD and Azure data quality aggregations after each analysis. A threshold
+-- compares one aggregation stat of the latest run with the mean of the preceding baseline_runs runs.
+CREATE TABLE IF NOT EXISTS data_quality_thresholds
+(
+    id             SERIAL PRIMARY KEY,
+    source         TEXT                     NOT NULL,
+    stat           TEXT                     NOT NULL,
+    threshold_type TEXT                     NOT NULL,
+    direction      TEXT                     NOT NULL DEFAULT 'decrease',
+    threshold      DOUBLE PRECISION         NOT NULL,
+    baseline_runs  INTEGER                  NOT NULL DEFAULT 5,
+    pause_tagging  BOOLEAN                  NOT NULL DEFAULT FALSE,
+    enabled        BOOLEAN                  NOT NULL DEFAULT TRUE,
+    created_at     TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp,
+    updated_at     TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp
+);
+
+-- Violations are kept after their threshold is deleted so that the history of flagged runs is preserved.
+CREATE TABLE IF NOT EXISTS data_quality_violations
+(
+    id             SERIAL PRIMARY KEY,
+    run_id         TEXT                     NOT NULL,
+    threshold_id   INTEGER                  NOT NULL,
+    source         TEXT                     NOT NULL,
+    stat           TEXT                     NOT NULL,
+    threshold_type TEXT                     NOT NULL,
+    direction      TEXT                     NOT NULL,
+    threshold      DOUBLE PRECISION         NOT NULL,
+    baseline       DOUBLE PRECISION         NOT NULL,
+    value          DOUBLE PRECISION         NOT NULL,
+    change         DOUBLE PRECISION         NOT NULL,
+    pause_tagging  BOOLEAN                  NOT NULL DEFAULT FALSE,
+    created_at     TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp,
+    updated_at     TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp,
+    UNIQUE (run_id, threshold_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_data_quality_violations_created_at ON data_quality_violations USING btree (created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS data_quality_violations;
+DROP TABLE IF EXISTS data_quality_thresholds;
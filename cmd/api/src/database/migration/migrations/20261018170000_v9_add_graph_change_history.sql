@@ -0,0 +1,64 @@
+-- Copyright 2026 Specter Ops, Inc.
+--
+-- Licensed under the Apache License, Version 2.0
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--     http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- +goose Up
+-- The last ingested snapshot of every node and edge routed through the changelog. Ingested objects are diffed against
+-- their snapshot to build the change history. The producer of an edge is the ingest data type that last reported it;
+-- only ingests of the same data type may record the edge as removed.
+CREATE TABLE IF NOT EXISTS graph_object_states
+(
+    object_type      TEXT                     NOT NULL,
+    object_key       TEXT                     NOT NULL,
+    object_id        TEXT                     NOT NULL DEFAULT '',
+    source_object_id TEXT                     NOT NULL DEFAULT '',
+    target_object_id TEXT                     NOT NULL DEFAULT '',
+    kind             TEXT                     NOT NULL DEFAULT '',
+    kinds            TEXT[]                   NOT NULL DEFAULT '{}',
+    properties       JSONB                    NOT NULL DEFAULT '{}',
+    producer         TEXT                     NOT NULL DEFAULT '',
+    removed_at       TIMESTAMP WITH TIME ZONE,
+    created_at       TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp,
+    updated_at       TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp,
+    PRIMARY KEY (object_type, object_key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_graph_object_states_source_object_id ON graph_object_states USING btree (source_object_id);
+CREATE INDEX IF NOT EXISTS idx_graph_object_states_target_object_id ON graph_object_states USING btree (target_object_id);
+
+CREATE TABLE IF NOT EXISTS graph_changes
+(
+    id               BIGSERIAL PRIMARY KEY,
+    job_id           BIGINT,
+    object_type      TEXT                     NOT NULL,
+    change_type      TEXT                     NOT NULL,
+    object_id        TEXT                     NOT NULL DEFAULT '',
+    source_object_id TEXT                     NOT NULL DEFAULT '',
+    target_object_id TEXT                     NOT NULL DEFAULT '',
+    kind             TEXT                     NOT NULL DEFAULT '',
+    kinds            TEXT[]                   NOT NULL DEFAULT '{}',
+    diff             JSONB                    NOT NULL DEFAULT '{}',
+    created_at       TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp,
+    updated_at       TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_graph_changes_created_at ON graph_changes USING btree (created_at);
+CREATE INDEX IF NOT EXISTS idx_graph_changes_object_id ON graph_changes USING btree (object_id);
+CREATE INDEX IF NOT EXISTS idx_graph_changes_source_object_id ON graph_changes USING btree (source_object_id);
+CREATE INDEX IF NOT EXISTS idx_graph_changes_target_object_id ON graph_changes USING btree (target_object_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS graph_changes;
+DROP TABLE IF EXISTS graph_object_states;
@@ -0,0 +1,30 @@
+-- Copyright 2026 Specter Ops, Inc.
+--
+-- Licensed under the Apache License, Version 2.0
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--     http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- +goose Up
+INSERT INTO parameters (key, name, description, value, created_at, updated_at)
+VALUES (
+    'analysis.asset_group_history_retention',
+    'Asset Group History Retention',
+    'This configuration parameter sets the number of days asset group history records are retained for before they are pruned.',
+    '{"retention_days": 90}',
+    current_timestamp,
+    current_timestamp
+)
+ON CONFLICT DO NOTHING;
+
+-- +goose Down
+DELETE FROM parameters WHERE key = 'analysis.asset_group_history_retention';
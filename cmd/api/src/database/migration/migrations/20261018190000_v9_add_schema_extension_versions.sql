@@ -0,0 +1,38 @@
+-- Copyright 2026 Specter Ops, Inc.
+--
+-- Licensed under the Apache License, Version 2.0
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--     http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- +goose Up
+-- Each installed definition of an OpenGraph extension. The latest row is the active version; earlier rows allow an
+-- upgrade to be rolled back. Payload holds the extension definition, including any kind migrations it declared.
+-- Sequence numbers the versions of each extension in install order. Rolled back versions are soft deleted so the
+-- history of an extension is never rewritten and a sequence is never reused.
+CREATE TABLE IF NOT EXISTS schema_extension_versions
+(
+    id                  SERIAL PRIMARY KEY,
+    schema_extension_id INTEGER                  NOT NULL REFERENCES schema_extensions (id) ON DELETE CASCADE,
+    version             TEXT                     NOT NULL,
+    sequence            INTEGER                  NOT NULL,
+    payload             JSONB                    NOT NULL,
+    created_at          TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp,
+    updated_at          TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp,
+    deleted_at          TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_schema_extension_versions_schema_extension_id ON schema_extension_versions USING btree (schema_extension_id, id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_schema_extension_versions_sequence ON schema_extension_versions USING btree (schema_extension_id, sequence);
+
+-- +goose Down
+DROP TABLE IF EXISTS schema_extension_versions;
@@ -0,0 +1,44 @@
+-- Copyright 2026 Specter Ops, Inc.
+--
+-- Licensed under the Apache License, Version 2.0
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--     http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- +goose Up
+-- Choke points of the attack paths into the Tier Zero members of each environment. Every analysis replaces the rows of
+-- the environments it scored, so an environment keeps the results of the last run that saw it.
+CREATE TABLE IF NOT EXISTS attack_path_choke_points
+(
+    id                 SERIAL PRIMARY KEY,
+    run_id             TEXT                     NOT NULL,
+    environment_id     TEXT                     NOT NULL,
+    rank               INTEGER                  NOT NULL,
+    type               TEXT                     NOT NULL,
+    kind               TEXT                     NOT NULL,
+    object_id          TEXT                     NOT NULL DEFAULT '',
+    name               TEXT                     NOT NULL DEFAULT '',
+    start_object_id    TEXT                     NOT NULL DEFAULT '',
+    start_name         TEXT                     NOT NULL DEFAULT '',
+    end_object_id      TEXT                     NOT NULL DEFAULT '',
+    end_name           TEXT                     NOT NULL DEFAULT '',
+    principals_removed BIGINT                   NOT NULL DEFAULT 0,
+    principals         BIGINT                   NOT NULL DEFAULT 0,
+    betweenness        DOUBLE PRECISION         NOT NULL DEFAULT 0,
+    created_at         TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp,
+    updated_at         TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_attack_path_choke_points_environment_id ON attack_path_choke_points USING btree (environment_id, rank);
+
+-- +goose Down
+DROP TABLE IF EXISTS attack_path_choke_points;
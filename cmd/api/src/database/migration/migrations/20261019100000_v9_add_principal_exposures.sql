@@ -0,0 +1,39 @@
+-- Copyright 2026 Specter Ops, Inc.
+--
+-- Licensed under the Apache License, Version 2.0
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--     http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- +goose Up
+-- Daily snapshots of the impact and exposure of each user and computer. Analysis replaces the snapshot of the current
+-- day for every environment it scores, so a day keeps the results of the last run on that day.
+CREATE TABLE IF NOT EXISTS principal_exposures
+(
+    id             BIGSERIAL PRIMARY KEY,
+    environment_id TEXT                     NOT NULL,
+    snapshot_date  DATE                     NOT NULL,
+    object_id      TEXT                     NOT NULL,
+    name           TEXT                     NOT NULL DEFAULT '',
+    kind           TEXT                     NOT NULL DEFAULT '',
+    impact         BIGINT                   NOT NULL DEFAULT 0,
+    exposure       BIGINT                   NOT NULL DEFAULT 0,
+    estimated      BOOLEAN                  NOT NULL DEFAULT false,
+    created_at     TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp,
+    updated_at     TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT current_timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_principal_exposures_environment_id ON principal_exposures USING btree (environment_id, snapshot_date);
+CREATE INDEX IF NOT EXISTS idx_principal_exposures_object_id ON principal_exposures USING btree (object_id, snapshot_date);
+
+-- +goose Down
+DROP TABLE IF EXISTS principal_exposures;
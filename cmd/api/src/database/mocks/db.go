@@ -372,6 +372,36 @@ func (mr *MockDatabaseMockRecorder) CreateDataQualityStats(ctx, stats any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDataQualityStats", reflect.TypeOf((*MockDatabase)(nil).CreateDataQualityStats), ctx, stats)
 }
 
+// CreateDataQualityThreshold mocks base method.
+func (m *MockDatabase) CreateDataQualityThreshold(ctx context.Context, threshold model.DataQualityThreshold) (model.DataQualityThreshold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDataQualityThreshold", ctx, threshold)
+	ret0, _ := ret[0].(model.DataQualityThreshold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDataQualityThreshold indicates an expected call of CreateDataQualityThreshold.
+func (mr *MockDatabaseMockRecorder) CreateDataQualityThreshold(ctx, threshold any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDataQualityThreshold", reflect.TypeOf((*MockDatabase)(nil).CreateDataQualityThreshold), ctx, threshold)
+}
+
+// CreateDataQualityViolations mocks base method.
+func (m *MockDatabase) CreateDataQualityViolations(ctx context.Context, violations model.DataQualityViolations) (model.DataQualityViolations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDataQualityViolations", ctx, violations)
+	ret0, _ := ret[0].(model.DataQualityViolations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDataQualityViolations indicates an expected call of CreateDataQualityViolations.
+func (mr *MockDatabaseMockRecorder) CreateDataQualityViolations(ctx, violations any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDataQualityViolations", reflect.TypeOf((*MockDatabase)(nil).CreateDataQualityViolations), ctx, violations)
+}
+
 // CreateEnvironment mocks base method.
 func (m *MockDatabase) CreateEnvironment(ctx context.Context, extensionId, environmentKindId, sourceKindId int32) (model.SchemaEnvironment, error) {
 	m.ctrl.T.Helper()
@@ -902,6 +932,20 @@ func (mr *MockDatabaseMockRecorder) DeleteCustomNodeKind(ctx, kindName any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomNodeKind", reflect.TypeOf((*MockDatabase)(nil).DeleteCustomNodeKind), ctx, kindName)
 }
 
+// DeleteDataQualityThreshold mocks base method.
+func (m *MockDatabase) DeleteDataQualityThreshold(ctx context.Context, id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDataQualityThreshold", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDataQualityThreshold indicates an expected call of DeleteDataQualityThreshold.
+func (mr *MockDatabaseMockRecorder) DeleteDataQualityThreshold(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDataQualityThreshold", reflect.TypeOf((*MockDatabase)(nil).DeleteDataQualityThreshold), ctx, id)
+}
+
 // DeleteEnvironment mocks base method.
 func (m *MockDatabase) DeleteEnvironment(ctx context.Context, environmentId int32) error {
 	m.ctrl.T.Helper()
@@ -1028,6 +1072,21 @@ func (mr *MockDatabaseMockRecorder) DeleteKindInfo(ctx, kindInfoID any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKindInfo", reflect.TypeOf((*MockDatabase)(nil).DeleteKindInfo), ctx, kindInfoID)
 }
 
+// DeletePrincipalExposuresBySnapshotDate mocks base method.
+func (m *MockDatabase) DeletePrincipalExposuresBySnapshotDate(ctx context.Context, snapshotDate time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePrincipalExposuresBySnapshotDate", ctx, snapshotDate)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePrincipalExposuresBySnapshotDate indicates an expected call of DeletePrincipalExposuresBySnapshotDate.
+func (mr *MockDatabaseMockRecorder) DeletePrincipalExposuresBySnapshotDate(ctx, snapshotDate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrincipalExposuresBySnapshotDate", reflect.TypeOf((*MockDatabase)(nil).DeletePrincipalExposuresBySnapshotDate), ctx, snapshotDate)
+}
+
 // DeletePrincipalKind mocks base method.
 func (m *MockDatabase) DeletePrincipalKind(ctx context.Context, environmentId, principalKind int32) error {
 	m.ctrl.T.Helper()
@@ -1042,6 +1101,20 @@ func (mr *MockDatabaseMockRecorder) DeletePrincipalKind(ctx, environmentId, prin
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrincipalKind", reflect.TypeOf((*MockDatabase)(nil).DeletePrincipalKind), ctx, environmentId, principalKind)
 }
 
+// DeleteRateLimitCounter mocks base method.
+func (m *MockDatabase) DeleteRateLimitCounter(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRateLimitCounter", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRateLimitCounter indicates an expected call of DeleteRateLimitCounter.
+func (mr *MockDatabaseMockRecorder) DeleteRateLimitCounter(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRateLimitCounter", reflect.TypeOf((*MockDatabase)(nil).DeleteRateLimitCounter), ctx, key)
+}
+
 // DeleteRemediation mocks base method.
 func (m *MockDatabase) DeleteRemediation(ctx context.Context, findingId int32) error {
 	m.ctrl.T.Helper()
@@ -1733,6 +1806,22 @@ func (mr *MockDatabaseMockRecorder) GetAzureDataQualityStats(ctx, tenantId, star
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAzureDataQualityStats", reflect.TypeOf((*MockDatabase)(nil).GetAzureDataQualityStats), ctx, tenantId, start, end, sort_by, limit, skip)
 }
 
+// GetChokePoints mocks base method.
+func (m *MockDatabase) GetChokePoints(ctx context.Context, environmentID string, chokePointType model.ChokePointType, order string, limit int, skip int) (model.ChokePoints, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChokePoints", ctx, environmentID, chokePointType, order, limit, skip)
+	ret0, _ := ret[0].(model.ChokePoints)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetChokePoints indicates an expected call of GetChokePoints.
+func (mr *MockDatabaseMockRecorder) GetChokePoints(ctx, environmentID, chokePointType, order, limit, skip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChokePoints", reflect.TypeOf((*MockDatabase)(nil).GetChokePoints), ctx, environmentID, chokePointType, order, limit, skip)
+}
+
 // GetCompletedTasks mocks base method.
 func (m *MockDatabase) GetCompletedTasks(ctx context.Context, ingestJobId int64) ([]model.CompletedTask, error) {
 	m.ctrl.T.Helper()
@@ -1840,6 +1929,52 @@ func (mr *MockDatabaseMockRecorder) GetDataQualityStats(ctx, filters, sort, skip
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataQualityStats", reflect.TypeOf((*MockDatabase)(nil).GetDataQualityStats), ctx, filters, sort, skip, limit)
 }
 
+// GetDataQualityThreshold mocks base method.
+func (m *MockDatabase) GetDataQualityThreshold(ctx context.Context, id int32) (model.DataQualityThreshold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDataQualityThreshold", ctx, id)
+	ret0, _ := ret[0].(model.DataQualityThreshold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDataQualityThreshold indicates an expected call of GetDataQualityThreshold.
+func (mr *MockDatabaseMockRecorder) GetDataQualityThreshold(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataQualityThreshold", reflect.TypeOf((*MockDatabase)(nil).GetDataQualityThreshold), ctx, id)
+}
+
+// GetDataQualityThresholds mocks base method.
+func (m *MockDatabase) GetDataQualityThresholds(ctx context.Context) (model.DataQualityThresholds, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDataQualityThresholds", ctx)
+	ret0, _ := ret[0].(model.DataQualityThresholds)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDataQualityThresholds indicates an expected call of GetDataQualityThresholds.
+func (mr *MockDatabaseMockRecorder) GetDataQualityThresholds(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataQualityThresholds", reflect.TypeOf((*MockDatabase)(nil).GetDataQualityThresholds), ctx)
+}
+
+// GetDataQualityViolations mocks base method.
+func (m *MockDatabase) GetDataQualityViolations(ctx context.Context, start time.Time, end time.Time, order string, limit int, skip int) (model.DataQualityViolations, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDataQualityViolations", ctx, start, end, order, limit, skip)
+	ret0, _ := ret[0].(model.DataQualityViolations)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDataQualityViolations indicates an expected call of GetDataQualityViolations.
+func (mr *MockDatabaseMockRecorder) GetDataQualityViolations(ctx, start, end, order, limit, skip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataQualityViolations", reflect.TypeOf((*MockDatabase)(nil).GetDataQualityViolations), ctx, start, end, order, limit, skip)
+}
+
 // GetDatapipeStatus mocks base method.
 func (m *MockDatabase) GetDatapipeStatus(ctx context.Context) (model.DatapipeStatusWrapper, error) {
 	m.ctrl.T.Helper()
@@ -1975,6 +2110,38 @@ func (mr *MockDatabaseMockRecorder) GetFlagByKey(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFlagByKey", reflect.TypeOf((*MockDatabase)(nil).GetFlagByKey), arg0, arg1)
 }
 
+// GetGraphChanges mocks base method.
+func (m *MockDatabase) GetGraphChanges(ctx context.Context, since time.Time, until time.Time, order string, limit int, skip int) (model.GraphChanges, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGraphChanges", ctx, since, until, order, limit, skip)
+	ret0, _ := ret[0].(model.GraphChanges)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGraphChanges indicates an expected call of GetGraphChanges.
+func (mr *MockDatabaseMockRecorder) GetGraphChanges(ctx, since, until, order, limit, skip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGraphChanges", reflect.TypeOf((*MockDatabase)(nil).GetGraphChanges), ctx, since, until, order, limit, skip)
+}
+
+// GetGraphObjectChanges mocks base method.
+func (m *MockDatabase) GetGraphObjectChanges(ctx context.Context, objectID string, order string, limit int, skip int) (model.GraphChanges, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGraphObjectChanges", ctx, objectID, order, limit, skip)
+	ret0, _ := ret[0].(model.GraphChanges)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGraphObjectChanges indicates an expected call of GetGraphObjectChanges.
+func (mr *MockDatabaseMockRecorder) GetGraphObjectChanges(ctx, objectID, order, limit, skip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGraphObjectChanges", reflect.TypeOf((*MockDatabase)(nil).GetGraphObjectChanges), ctx, objectID, order, limit, skip)
+}
+
 // GetGraphSchemaExtensionById mocks base method.
 func (m *MockDatabase) GetGraphSchemaExtensionById(ctx context.Context, extensionId int32) (model.GraphSchemaExtension, error) {
 	m.ctrl.T.Helper()
@@ -1990,6 +2157,21 @@ func (mr *MockDatabaseMockRecorder) GetGraphSchemaExtensionById(ctx, extensionId
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGraphSchemaExtensionById", reflect.TypeOf((*MockDatabase)(nil).GetGraphSchemaExtensionById), ctx, extensionId)
 }
 
+// GetGraphSchemaExtensionVersions mocks base method.
+func (m *MockDatabase) GetGraphSchemaExtensionVersions(ctx context.Context, extensionId int32) ([]model.GraphSchemaExtensionVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGraphSchemaExtensionVersions", ctx, extensionId)
+	ret0, _ := ret[0].([]model.GraphSchemaExtensionVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGraphSchemaExtensionVersions indicates an expected call of GetGraphSchemaExtensionVersions.
+func (mr *MockDatabaseMockRecorder) GetGraphSchemaExtensionVersions(ctx, extensionId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGraphSchemaExtensionVersions", reflect.TypeOf((*MockDatabase)(nil).GetGraphSchemaExtensionVersions), ctx, extensionId)
+}
+
 // GetGraphSchemaExtensions mocks base method.
 func (m *MockDatabase) GetGraphSchemaExtensions(ctx context.Context, extensionFilters model.Filters, sort model.Sort, skip, limit int) (model.GraphSchemaExtensions, int, error) {
 	m.ctrl.T.Helper()
@@ -2305,6 +2487,22 @@ func (mr *MockDatabaseMockRecorder) GetPrimaryDisplayKinds(ctx any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrimaryDisplayKinds", reflect.TypeOf((*MockDatabase)(nil).GetPrimaryDisplayKinds), ctx)
 }
 
+// GetPrincipalExposures mocks base method.
+func (m *MockDatabase) GetPrincipalExposures(ctx context.Context, environmentID string, latestOnly bool, sqlFilter model.SQLFilter, sortItems model.Sort, skip, limit int) (model.PrincipalExposures, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrincipalExposures", ctx, environmentID, latestOnly, sqlFilter, sortItems, skip, limit)
+	ret0, _ := ret[0].(model.PrincipalExposures)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPrincipalExposures indicates an expected call of GetPrincipalExposures.
+func (mr *MockDatabaseMockRecorder) GetPrincipalExposures(ctx, environmentID, latestOnly, sqlFilter, sortItems, skip, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrincipalExposures", reflect.TypeOf((*MockDatabase)(nil).GetPrincipalExposures), ctx, environmentID, latestOnly, sqlFilter, sortItems, skip, limit)
+}
+
 // GetPrincipalKindsByEnvironmentId mocks base method.
 func (m *MockDatabase) GetPrincipalKindsByEnvironmentId(ctx context.Context, environmentId int32) (model.SchemaEnvironmentPrincipalKinds, error) {
 	m.ctrl.T.Helper()
@@ -2350,6 +2548,21 @@ func (mr *MockDatabaseMockRecorder) GetPublicSavedQueries(ctx any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicSavedQueries", reflect.TypeOf((*MockDatabase)(nil).GetPublicSavedQueries), ctx)
 }
 
+// GetRateLimitCounter mocks base method.
+func (m *MockDatabase) GetRateLimitCounter(ctx context.Context, key string) (model.RateLimitCounter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRateLimitCounter", ctx, key)
+	ret0, _ := ret[0].(model.RateLimitCounter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRateLimitCounter indicates an expected call of GetRateLimitCounter.
+func (mr *MockDatabaseMockRecorder) GetRateLimitCounter(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRateLimitCounter", reflect.TypeOf((*MockDatabase)(nil).GetRateLimitCounter), ctx, key)
+}
+
 // GetRemediationByFindingId mocks base method.
 func (m *MockDatabase) GetRemediationByFindingId(ctx context.Context, findingId int32) (model.Remediation, error) {
 	m.ctrl.T.Helper()
@@ -2861,6 +3074,21 @@ func (mr *MockDatabaseMockRecorder) HasInstallation(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasInstallation", reflect.TypeOf((*MockDatabase)(nil).HasInstallation), ctx)
 }
 
+// IncrementRateLimitCounter mocks base method.
+func (m *MockDatabase) IncrementRateLimitCounter(ctx context.Context, key string, count int64, period time.Duration) (model.RateLimitCounter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementRateLimitCounter", ctx, key, count, period)
+	ret0, _ := ret[0].(model.RateLimitCounter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrementRateLimitCounter indicates an expected call of IncrementRateLimitCounter.
+func (mr *MockDatabaseMockRecorder) IncrementRateLimitCounter(ctx, key, count, period any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementRateLimitCounter", reflect.TypeOf((*MockDatabase)(nil).IncrementRateLimitCounter), ctx, key, count, period)
+}
+
 // InitializeSecretAuth mocks base method.
 func (m *MockDatabase) InitializeSecretAuth(ctx context.Context, adminUser model.User, authSecret model.AuthSecret) (model.Installation, error) {
 	m.ctrl.T.Helper()
@@ -3026,6 +3254,21 @@ func (mr *MockDatabaseMockRecorder) Migrate(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Migrate", reflect.TypeOf((*MockDatabase)(nil).Migrate), ctx)
 }
 
+// PendingMigrations mocks base method.
+func (m *MockDatabase) PendingMigrations(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PendingMigrations", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PendingMigrations indicates an expected call of PendingMigrations.
+func (mr *MockDatabaseMockRecorder) PendingMigrations(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingMigrations", reflect.TypeOf((*MockDatabase)(nil).PendingMigrations), ctx)
+}
+
 // PopulateExtensionData mocks base method.
 func (m *MockDatabase) PopulateExtensionData(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -3040,6 +3283,51 @@ func (mr *MockDatabaseMockRecorder) PopulateExtensionData(ctx any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PopulateExtensionData", reflect.TypeOf((*MockDatabase)(nil).PopulateExtensionData), ctx)
 }
 
+// RecordGraphChanges mocks base method.
+func (m *MockDatabase) RecordGraphChanges(ctx context.Context, jobID null.Int64, states model.GraphObjectStates) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordGraphChanges", ctx, jobID, states)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordGraphChanges indicates an expected call of RecordGraphChanges.
+func (mr *MockDatabaseMockRecorder) RecordGraphChanges(ctx, jobID, states any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordGraphChanges", reflect.TypeOf((*MockDatabase)(nil).RecordGraphChanges), ctx, jobID, states)
+}
+
+// RecordGraphEdgeRemovals mocks base method.
+func (m *MockDatabase) RecordGraphEdgeRemovals(ctx context.Context, jobID null.Int64, families []model.GraphEdgeFamily, ingestedKeys []string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordGraphEdgeRemovals", ctx, jobID, families, ingestedKeys)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordGraphEdgeRemovals indicates an expected call of RecordGraphEdgeRemovals.
+func (mr *MockDatabaseMockRecorder) RecordGraphEdgeRemovals(ctx, jobID, families, ingestedKeys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordGraphEdgeRemovals", reflect.TypeOf((*MockDatabase)(nil).RecordGraphEdgeRemovals), ctx, jobID, families, ingestedKeys)
+}
+
+// RecordGraphRemovals mocks base method.
+func (m *MockDatabase) RecordGraphRemovals(ctx context.Context, deleteRequest model.AnalysisRequest, sourceKinds []string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordGraphRemovals", ctx, deleteRequest, sourceKinds)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordGraphRemovals indicates an expected call of RecordGraphRemovals.
+func (mr *MockDatabaseMockRecorder) RecordGraphRemovals(ctx, deleteRequest, sourceKinds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordGraphRemovals", reflect.TypeOf((*MockDatabase)(nil).RecordGraphRemovals), ctx, deleteRequest, sourceKinds)
+}
+
 // RegisterSourceKind mocks base method.
 func (m *MockDatabase) RegisterSourceKind(ctx context.Context) func(graph.Kind) error {
 	m.ctrl.T.Helper()
@@ -3054,6 +3342,34 @@ func (mr *MockDatabaseMockRecorder) RegisterSourceKind(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterSourceKind", reflect.TypeOf((*MockDatabase)(nil).RegisterSourceKind), ctx)
 }
 
+// ReplaceChokePoints mocks base method.
+func (m *MockDatabase) ReplaceChokePoints(ctx context.Context, environmentID string, chokePoints model.ChokePoints) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceChokePoints", ctx, environmentID, chokePoints)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceChokePoints indicates an expected call of ReplaceChokePoints.
+func (mr *MockDatabaseMockRecorder) ReplaceChokePoints(ctx, environmentID, chokePoints any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceChokePoints", reflect.TypeOf((*MockDatabase)(nil).ReplaceChokePoints), ctx, environmentID, chokePoints)
+}
+
+// ReplacePrincipalExposures mocks base method.
+func (m *MockDatabase) ReplacePrincipalExposures(ctx context.Context, environmentID string, snapshotDate time.Time, exposures model.PrincipalExposures) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplacePrincipalExposures", ctx, environmentID, snapshotDate, exposures)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplacePrincipalExposures indicates an expected call of ReplacePrincipalExposures.
+func (mr *MockDatabaseMockRecorder) ReplacePrincipalExposures(ctx, environmentID, snapshotDate, exposures any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplacePrincipalExposures", reflect.TypeOf((*MockDatabase)(nil).ReplacePrincipalExposures), ctx, environmentID, snapshotDate, exposures)
+}
+
 // RequestAnalysis mocks base method.
 func (m *MockDatabase) RequestAnalysis(ctx context.Context, requester string, analysisMode model.AnalysisMode) error {
 	m.ctrl.T.Helper()
@@ -3221,6 +3537,20 @@ func (mr *MockDatabaseMockRecorder) SetUserSessionFlag(ctx, userSession, key, st
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserSessionFlag", reflect.TypeOf((*MockDatabase)(nil).SetUserSessionFlag), ctx, userSession, key, state)
 }
 
+// StreamAssetGroupHistoryRecords mocks base method.
+func (m *MockDatabase) StreamAssetGroupHistoryRecords(ctx context.Context, sqlFilter model.SQLFilter, sortItems model.Sort, delegate func(model.AssetGroupHistory) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamAssetGroupHistoryRecords", ctx, sqlFilter, sortItems, delegate)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamAssetGroupHistoryRecords indicates an expected call of StreamAssetGroupHistoryRecords.
+func (mr *MockDatabaseMockRecorder) StreamAssetGroupHistoryRecords(ctx, sqlFilter, sortItems, delegate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamAssetGroupHistoryRecords", reflect.TypeOf((*MockDatabase)(nil).StreamAssetGroupHistoryRecords), ctx, sqlFilter, sortItems, delegate)
+}
+
 // SweepAssetGroupCollections mocks base method.
 func (m *MockDatabase) SweepAssetGroupCollections(ctx context.Context) {
 	m.ctrl.T.Helper()
@@ -3233,6 +3563,30 @@ func (mr *MockDatabaseMockRecorder) SweepAssetGroupCollections(ctx any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SweepAssetGroupCollections", reflect.TypeOf((*MockDatabase)(nil).SweepAssetGroupCollections), ctx)
 }
 
+// SweepAssetGroupHistory mocks base method.
+func (m *MockDatabase) SweepAssetGroupHistory(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SweepAssetGroupHistory", ctx)
+}
+
+// SweepAssetGroupHistory indicates an expected call of SweepAssetGroupHistory.
+func (mr *MockDatabaseMockRecorder) SweepAssetGroupHistory(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SweepAssetGroupHistory", reflect.TypeOf((*MockDatabase)(nil).SweepAssetGroupHistory), ctx)
+}
+
+// SweepRateLimitCounters mocks base method.
+func (m *MockDatabase) SweepRateLimitCounters(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SweepRateLimitCounters", ctx)
+}
+
+// SweepRateLimitCounters indicates an expected call of SweepRateLimitCounters.
+func (mr *MockDatabaseMockRecorder) SweepRateLimitCounters(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SweepRateLimitCounters", reflect.TypeOf((*MockDatabase)(nil).SweepRateLimitCounters), ctx)
+}
+
 // SweepSessions mocks base method.
 func (m *MockDatabase) SweepSessions(ctx context.Context) {
 	m.ctrl.T.Helper()
@@ -3389,6 +3743,21 @@ func (mr *MockDatabaseMockRecorder) UpdateCustomNodeKind(ctx, customNodeKind any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomNodeKind", reflect.TypeOf((*MockDatabase)(nil).UpdateCustomNodeKind), ctx, customNodeKind)
 }
 
+// UpdateDataQualityThreshold mocks base method.
+func (m *MockDatabase) UpdateDataQualityThreshold(ctx context.Context, threshold model.DataQualityThreshold) (model.DataQualityThreshold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDataQualityThreshold", ctx, threshold)
+	ret0, _ := ret[0].(model.DataQualityThreshold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDataQualityThreshold indicates an expected call of UpdateDataQualityThreshold.
+func (mr *MockDatabaseMockRecorder) UpdateDataQualityThreshold(ctx, threshold any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDataQualityThreshold", reflect.TypeOf((*MockDatabase)(nil).UpdateDataQualityThreshold), ctx, threshold)
+}
+
 // UpdateGraphSchemaExtension mocks base method.
 func (m *MockDatabase) UpdateGraphSchemaExtension(ctx context.Context, extension model.GraphSchemaExtension) (model.GraphSchemaExtension, error) {
 	m.ctrl.T.Helper()
@@ -282,3 +282,53 @@ func TestParameters_GetGraphStorageOptimizationParameter(t *testing.T) {
 		})
 	}
 }
+
+func TestParameters_GetAssetGroupHistoryRetentionParameter(t *testing.T) {
+	var testCtx = context.Background()
+
+	t.Run("defaults to the migrated value", func(t *testing.T) {
+		db := integration.SetupDB(t)
+
+		require.Equal(t, appcfg.DefaultAssetGroupHistoryRetentionDays, appcfg.GetAssetGroupHistoryRetentionParameter(testCtx, db).RetentionDays)
+	})
+
+	type testData struct {
+		name     string
+		value    map[string]any
+		expected int
+	}
+
+	tt := []testData{
+		{
+			name:     "configured retention is returned",
+			value:    map[string]any{"retention_days": 365},
+			expected: 365,
+		},
+		{
+			name:     "zero retention is rejected and defaults to 90",
+			value:    map[string]any{"retention_days": 0},
+			expected: appcfg.DefaultAssetGroupHistoryRetentionDays,
+		},
+		{
+			name:     "retention above the maximum is rejected and defaults to 90",
+			value:    map[string]any{"retention_days": appcfg.MaxAssetGroupHistoryRetentionDays + 1},
+			expected: appcfg.DefaultAssetGroupHistoryRetentionDays,
+		},
+	}
+
+	for _, testCase := range tt {
+		t.Run(testCase.name, func(t *testing.T) {
+			db := integration.SetupDB(t)
+
+			newVal, err := types.NewJSONBObject(testCase.value)
+			require.Nil(t, err)
+
+			require.Nil(t, db.SetConfigurationParameter(testCtx, appcfg.Parameter{
+				Key:   appcfg.AssetGroupHistoryRetention,
+				Value: newVal,
+			}))
+
+			require.Equal(t, testCase.expected, appcfg.GetAssetGroupHistoryRetentionParameter(testCtx, db).RetentionDays)
+		})
+	}
+}
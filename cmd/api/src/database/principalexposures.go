@@ -0,0 +1,100 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"gorm.io/gorm"
+)
+
+type PrincipalExposureData interface {
+	ReplacePrincipalExposures(ctx context.Context, environmentID string, snapshotDate time.Time, exposures model.PrincipalExposures) error
+	GetPrincipalExposures(ctx context.Context, environmentID string, latestOnly bool, sqlFilter model.SQLFilter, sortItems model.Sort, skip, limit int) (model.PrincipalExposures, int, error)
+	DeletePrincipalExposuresBySnapshotDate(ctx context.Context, snapshotDate time.Time) (int64, error)
+}
+
+// ReplacePrincipalExposures replaces the snapshot of an environment taken on the given date. Snapshots of other dates
+// are left untouched so the table keeps a daily history of each environment.
+func (s *BloodhoundDB) ReplacePrincipalExposures(ctx context.Context, environmentID string, snapshotDate time.Time, exposures model.PrincipalExposures) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := CheckError(tx.Where("environment_id = ? AND snapshot_date = ?", environmentID, snapshotDate).Delete(&model.PrincipalExposure{})); err != nil {
+			return err
+		} else if len(exposures) == 0 {
+			return nil
+		}
+
+		return CheckError(tx.CreateInBatches(&exposures, batchSize))
+	})
+}
+
+// GetPrincipalExposures returns the saved principal exposures of an environment. When latestOnly is set only the most
+// recent snapshot of the environment is considered. Rows are ordered by snapshot date and impact unless another order
+// is given. Columns of the filter and sort items are expected to have been validated by the caller.
+func (s *BloodhoundDB) GetPrincipalExposures(ctx context.Context, environmentID string, latestOnly bool, sqlFilter model.SQLFilter, sortItems model.Sort, skip, limit int) (model.PrincipalExposures, int, error) {
+	var (
+		exposures model.PrincipalExposures
+		count     int64
+	)
+
+	filtered := func() *gorm.DB {
+		query := s.db.WithContext(ctx).Model(&model.PrincipalExposure{}).Where("environment_id = ?", environmentID)
+
+		if latestOnly {
+			query = query.Where(fmt.Sprintf("snapshot_date = (SELECT max(snapshot_date) FROM %s WHERE environment_id = ?)", model.PrincipalExposure{}.TableName()), environmentID)
+		}
+
+		if sqlFilter.SQLString != "" {
+			query = query.Where(sqlFilter.SQLString, sqlFilter.Params...)
+		}
+
+		return query
+	}
+
+	if err := CheckError(filtered().Count(&count)); err != nil {
+		return exposures, 0, err
+	}
+
+	query := filtered().Scopes(Paginate(skip, limit))
+
+	if len(sortItems) == 0 {
+		query = query.Order("snapshot_date DESC").Order("impact DESC").Order("exposure DESC")
+	}
+
+	for _, item := range sortItems {
+		if item.Direction == model.DescendingSortDirection {
+			query = query.Order(item.Column + " DESC")
+		} else {
+			query = query.Order(item.Column + " ASC")
+		}
+	}
+
+	if err := CheckError(query.Order("id").Find(&exposures)); err != nil {
+		return exposures, 0, err
+	}
+
+	return exposures, int(count), nil
+}
+
+// DeletePrincipalExposuresBySnapshotDate deletes the snapshots of every environment taken before the given date.
+func (s *BloodhoundDB) DeletePrincipalExposuresBySnapshotDate(ctx context.Context, snapshotDate time.Time) (int64, error) {
+	result := s.db.WithContext(ctx).Where("snapshot_date < ?", snapshotDate).Delete(&model.PrincipalExposure{})
+	return result.RowsAffected, CheckError(result)
+}
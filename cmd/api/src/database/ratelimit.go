@@ -0,0 +1,67 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+)
+
+type RateLimitData interface {
+	IncrementRateLimitCounter(ctx context.Context, key string, count int64, period time.Duration) (model.RateLimitCounter, error)
+	GetRateLimitCounter(ctx context.Context, key string) (model.RateLimitCounter, error)
+	DeleteRateLimitCounter(ctx context.Context, key string) error
+	SweepRateLimitCounters(ctx context.Context)
+}
+
+// IncrementRateLimitCounter adds count hits to the counter for the given key and returns its new state. A counter whose
+// window has expired is restarted with a fresh window of the given period. The upsert is atomic so concurrent API
+// instances never lose increments.
+func (s *BloodhoundDB) IncrementRateLimitCounter(ctx context.Context, key string, count int64, period time.Duration) (model.RateLimitCounter, error) {
+	const sql = `
+		INSERT INTO rate_limit_counters (key, hits, expires_at)
+		VALUES (?, ?, clock_timestamp() + ? * interval '1 microsecond')
+		ON CONFLICT (key) DO UPDATE SET
+			hits = CASE WHEN rate_limit_counters.expires_at <= clock_timestamp() THEN excluded.hits ELSE rate_limit_counters.hits + excluded.hits END,
+			expires_at = CASE WHEN rate_limit_counters.expires_at <= clock_timestamp() THEN excluded.expires_at ELSE rate_limit_counters.expires_at END
+		RETURNING key, hits, expires_at;`
+
+	var counter model.RateLimitCounter
+	result := s.db.WithContext(ctx).Raw(sql, key, count, period.Microseconds()).Scan(&counter)
+
+	return counter, CheckError(result)
+}
+
+// GetRateLimitCounter returns the counter for the given key without modifying it. ErrNotFound is returned if the key
+// has no counter or its window has expired.
+func (s *BloodhoundDB) GetRateLimitCounter(ctx context.Context, key string) (model.RateLimitCounter, error) {
+	var counter model.RateLimitCounter
+	result := s.db.WithContext(ctx).Where("key = ? AND expires_at > clock_timestamp()", key).First(&counter)
+
+	return counter, CheckError(result)
+}
+
+func (s *BloodhoundDB) DeleteRateLimitCounter(ctx context.Context, key string) error {
+	return CheckError(s.db.WithContext(ctx).Where("key = ?", key).Delete(&model.RateLimitCounter{}))
+}
+
+// SweepRateLimitCounters deletes all counters whose window has expired
+func (s *BloodhoundDB) SweepRateLimitCounters(ctx context.Context) {
+	s.db.WithContext(ctx).Where("expires_at <= clock_timestamp()").Delete(&model.RateLimitCounter{})
+}
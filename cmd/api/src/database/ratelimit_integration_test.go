@@ -0,0 +1,142 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build integration
+
+package database_test
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/api/middleware"
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/config"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ulule/limiter/v3"
+)
+
+func TestBloodhoundDB_RateLimitCounters(t *testing.T) {
+	testSuite := setupIntegrationTestSuite(t)
+	defer teardownIntegrationTestSuite(t, &testSuite)
+
+	const key = "GET /api/v2/self user:test"
+
+	_, err := testSuite.BHDatabase.GetRateLimitCounter(testSuite.Context, key)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	first, err := testSuite.BHDatabase.IncrementRateLimitCounter(testSuite.Context, key, 1, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), first.Hits)
+
+	second, err := testSuite.BHDatabase.IncrementRateLimitCounter(testSuite.Context, key, 2, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), second.Hits)
+	assert.True(t, first.ExpiresAt.Equal(second.ExpiresAt), "increments within a window must not extend it")
+
+	counter, err := testSuite.BHDatabase.GetRateLimitCounter(testSuite.Context, key)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), counter.Hits)
+
+	require.NoError(t, testSuite.BHDatabase.DeleteRateLimitCounter(testSuite.Context, key))
+
+	_, err = testSuite.BHDatabase.GetRateLimitCounter(testSuite.Context, key)
+	require.ErrorIs(t, err, database.ErrNotFound)
+}
+
+func TestBloodhoundDB_RateLimitCounterWindowExpiry(t *testing.T) {
+	testSuite := setupIntegrationTestSuite(t)
+	defer teardownIntegrationTestSuite(t, &testSuite)
+
+	const key = "POST /api/v2/login ip:192.0.2.1"
+
+	_, err := testSuite.BHDatabase.IncrementRateLimitCounter(testSuite.Context, key, 5, 50*time.Millisecond)
+	require.NoError(t, err)
+
+	time.Sleep(100 * time.Millisecond)
+
+	// An expired counter is invisible to reads and restarts on the next increment
+	_, err = testSuite.BHDatabase.GetRateLimitCounter(testSuite.Context, key)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	counter, err := testSuite.BHDatabase.IncrementRateLimitCounter(testSuite.Context, key, 1, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), counter.Hits)
+
+	_, err = testSuite.BHDatabase.IncrementRateLimitCounter(testSuite.Context, "expired", 1, time.Millisecond)
+	require.NoError(t, err)
+
+	time.Sleep(10 * time.Millisecond)
+	testSuite.BHDatabase.SweepRateLimitCounters(testSuite.Context)
+
+	var remaining int64
+	require.NoError(t, testSuite.DB.Table("rate_limit_counters").Count(&remaining).Error)
+	assert.Equal(t, int64(1), remaining)
+}
+
+func TestPostgresRateLimitStore_ConcurrentReplicas(t *testing.T) {
+	testSuite := setupIntegrationTestSuite(t)
+	defer teardownIntegrationTestSuite(t, &testSuite)
+
+	const (
+		key                = "GET /api/v2/available-domains user:test"
+		limit              = 10
+		numReplicas        = 4
+		requestsPerReplica = 25
+	)
+
+	var (
+		rate     = limiter.Rate{Period: time.Minute, Limit: limit}
+		allowed  atomic.Int64
+		rejected atomic.Int64
+		wg       sync.WaitGroup
+	)
+
+	// Each replica gets its own database handle and store so the only state they share is the database
+	for range numReplicas {
+		store := middleware.NewPostgresRateLimitStore(database.NewBloodhoundDB(testSuite.DB, nil, auth.NewIdentityResolver(), config.Configuration{}))
+
+		for range requestsPerReplica {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				if limitCtx, err := store.Get(testSuite.Context, key, rate); !assert.NoError(t, err) {
+					return
+				} else if limitCtx.Reached {
+					rejected.Add(1)
+				} else {
+					allowed.Add(1)
+				}
+			}()
+		}
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, int64(limit), allowed.Load())
+	assert.Equal(t, int64(numReplicas*requestsPerReplica-limit), rejected.Load())
+
+	peek, err := middleware.NewPostgresRateLimitStore(testSuite.BHDatabase).Peek(testSuite.Context, key, rate)
+	require.NoError(t, err)
+	assert.True(t, peek.Reached)
+	assert.Equal(t, int64(0), peek.Remaining)
+}
@@ -34,6 +34,10 @@ const CustomNodeIconType = "font-awesome"
 // in place with their IDs preserved, and new rows are created. All mutations run inside a single
 // transaction that rolls back on any error.
 //
+// Upgrading an existing extension that removes node or relationship kinds is rejected with
+// ErrGraphExtensionBreakingChange unless the input declares a migration for each removed kind. The
+// installed definition is recorded as a version of the extension, see recordGraphSchemaExtensionVersion.
+//
 // Returns true if the extension already existed before this call, false if it was newly created.
 // Returns ErrGraphExtensionBuiltIn if the named extension is a built-in and cannot be modified.
 func (s *BloodhoundDB) UpsertOpenGraphExtension(ctx context.Context, graphExtensionInput model.GraphExtensionInput) (bool, error) {
@@ -56,12 +60,14 @@ func (s *BloodhoundDB) UpsertOpenGraphExtension(ctx context.Context, graphExtens
 		return schemaExists, err
 	} else if existingNodeKinds, err := bloodhoundDBTransaction.GetGraphSchemaNodeKindsByExtensionId(ctx, extension.ID); err != nil {
 		return schemaExists, fmt.Errorf("failed to fetch existing node kinds: %w", err)
+	} else if existingRelationshipKinds, err := bloodhoundDBTransaction.GetGraphSchemaRelationshipKindsByExtensionId(ctx, extension.ID); err != nil {
+		return schemaExists, fmt.Errorf("failed to fetch existing relationship kinds: %w", err)
+	} else if err := checkGraphExtensionUpgrade(existingNodeKinds, existingRelationshipKinds, graphExtensionInput); err != nil {
+		return schemaExists, err
 	} else if reconciledNodeKinds, err := reconcile(ctx, graphExtensionInput.NodeKindsInput, existingNodeKinds, bloodhoundDBTransaction.nodeKindReconcileConfig(extension.ID)); err != nil {
 		return schemaExists, fmt.Errorf("failed to reconcile node kinds: %w", err)
 	} else if err := bloodhoundDBTransaction.upsertCustomIcons(ctx, reconciledNodeKinds); err != nil {
 		return schemaExists, fmt.Errorf("failed to upsert custom node icons: %w", err)
-	} else if existingRelationshipKinds, err := bloodhoundDBTransaction.GetGraphSchemaRelationshipKindsByExtensionId(ctx, extension.ID); err != nil {
-		return schemaExists, fmt.Errorf("failed to fetch existing relationship kinds: %w", err)
 	} else if _, err := reconcile(ctx, graphExtensionInput.RelationshipKindsInput, existingRelationshipKinds, bloodhoundDBTransaction.relationshipKindReconcileConfig(extension.ID)); err != nil {
 		return schemaExists, fmt.Errorf("failed to reconcile relationship kinds: %w", err)
 	} else if existingEnvironments, err := bloodhoundDBTransaction.GetEnvironmentsByExtensionId(ctx, extension.ID); err != nil {
@@ -72,6 +78,8 @@ func (s *BloodhoundDB) UpsertOpenGraphExtension(ctx context.Context, graphExtens
 		return schemaExists, fmt.Errorf("failed to fetch existing findings: %w", err)
 	} else if _, err := reconcile(ctx, graphExtensionInput.RelationshipFindingsInput, existingFindings, bloodhoundDBTransaction.findingReconcileConfig(extension.ID)); err != nil {
 		return schemaExists, fmt.Errorf("failed to reconcile findings: %w", err)
+	} else if err := bloodhoundDBTransaction.recordGraphSchemaExtensionVersion(ctx, extension.ID, graphExtensionInput); err != nil {
+		return schemaExists, fmt.Errorf("failed to record extension version: %w", err)
 	} else if err = tx.Commit().Error; err != nil {
 		return schemaExists, err
 	} else {
@@ -79,6 +87,25 @@ func (s *BloodhoundDB) UpsertOpenGraphExtension(ctx context.Context, graphExtens
 	}
 }
 
+// checkGraphExtensionUpgrade rejects an upgrade that removes installed kinds without declaring a migration for them.
+// A newly created extension has no installed kinds and always passes.
+func checkGraphExtensionUpgrade(existingNodeKinds model.GraphSchemaNodeKinds, existingRelationshipKinds model.GraphSchemaRelationshipKinds, graphExtensionInput model.GraphExtensionInput) error {
+	var (
+		nodeKindNames         = make([]string, 0, len(existingNodeKinds))
+		relationshipKindNames = make([]string, 0, len(existingRelationshipKinds))
+	)
+
+	for _, nodeKind := range existingNodeKinds {
+		nodeKindNames = append(nodeKindNames, nodeKind.Name)
+	}
+
+	for _, relationshipKind := range existingRelationshipKinds {
+		relationshipKindNames = append(relationshipKindNames, relationshipKind.Name)
+	}
+
+	return model.DiffGraphExtension(nodeKindNames, relationshipKindNames, graphExtensionInput).CheckMigrations(graphExtensionInput.Migrations)
+}
+
 // findOrCreateExtension looks up an extension by name. If one exists, its mutable metadata is
 // updated and returned with existed=true. Otherwise a new row is created and returned with existed=false.
 func (s *BloodhoundDB) findOrCreateExtension(ctx context.Context, extensionInput model.ExtensionInput) (model.GraphSchemaExtension, bool, error) {
@@ -457,6 +457,9 @@ func TestBloodhoundDB_UpsertOpenGraphExtension(t *testing.T) {
 						RelationshipFindingsInput: model.RelationshipFindingsInput{
 							{Name: "UpdFull_NewFinding2", DisplayName: "New Finding 2", RelationshipKindName: "UpdFull_NewRK2", EnvironmentKindName: "UpdFull_ExEnvKind1", RemediationInput: model.RemediationInput{ShortDescription: "sd2", LongDescription: "ld2", ShortRemediation: "sr2", LongRemediation: "lr2"}},
 						},
+						Migrations: model.ExtensionMigrationsInput{
+							NodeKinds: model.KindMigrationsInput{{From: "UpdFull_ExNK2"}},
+						},
 					},
 				}
 			},
@@ -767,6 +770,7 @@ func TestBloodhoundDB_UpsertOpenGraphExtension(t *testing.T) {
 					input: model.GraphExtensionInput{
 						ExtensionInput: extensionInput,
 						NodeKindsInput: model.NodesInput{{Name: "NonDisplayKindToKeep", DisplayName: "Non-Display Kind 1", Description: "test", IsDisplayKind: false}},
+						Migrations:     model.ExtensionMigrationsInput{NodeKinds: model.KindMigrationsInput{{From: "NonDisplayKindToDrop"}}},
 					},
 				}
 			},
@@ -803,6 +807,7 @@ func TestBloodhoundDB_UpsertOpenGraphExtension(t *testing.T) {
 					input: model.GraphExtensionInput{
 						ExtensionInput: extensionInput,
 						NodeKindsInput: model.NodesInput{{Name: "NodeKindToKeep", DisplayName: "Node Kind 1", Description: "test", IsDisplayKind: true, Icon: "user", IconColor: "#2779F5"}},
+						Migrations:     model.ExtensionMigrationsInput{NodeKinds: model.KindMigrationsInput{{From: "NodeKindToRemove"}}},
 					},
 				}
 			},
@@ -833,6 +838,135 @@ func TestBloodhoundDB_UpsertOpenGraphExtension(t *testing.T) {
 	}
 }
 
+func TestBloodhoundDB_UpsertOpenGraphExtension_Versions(t *testing.T) {
+	t.Parallel()
+
+	var (
+		extensionInput = model.ExtensionInput{Name: "VersionedExt", DisplayName: "Versioned", Version: "v1.0.0", Namespace: "VER"}
+		v1             = model.GraphExtensionInput{
+			ExtensionInput:         extensionInput,
+			NodeKindsInput:         model.NodesInput{{Name: "VER_Host"}, {Name: "VER_Account"}},
+			RelationshipKindsInput: model.RelationshipsInput{{Name: "VER_LinkedTo"}},
+		}
+		v2 = model.GraphExtensionInput{
+			ExtensionInput:         model.ExtensionInput{Name: "VersionedExt", DisplayName: "Versioned", Version: "v2.0.0", Namespace: "VER"},
+			NodeKindsInput:         model.NodesInput{{Name: "VER_Computer"}, {Name: "VER_Account"}},
+			RelationshipKindsInput: model.RelationshipsInput{{Name: "VER_LinkedTo"}},
+			Migrations: model.ExtensionMigrationsInput{
+				NodeKinds: model.KindMigrationsInput{{From: "VER_Host", To: "VER_Computer"}},
+			},
+		}
+	)
+
+	getExtensionId := func(t *testing.T, testSuite IntegrationTestSuite) int32 {
+		t.Helper()
+		extensions, _, err := testSuite.BHDatabase.GetGraphSchemaExtensions(testSuite.Context,
+			model.Filters{"name": []model.Filter{{Operator: model.Equals, Value: extensionInput.Name, SetOperator: model.FilterAnd}}},
+			model.Sort{}, 0, 1)
+		require.NoError(t, err)
+		require.Len(t, extensions, 1)
+		return extensions[0].ID
+	}
+
+	t.Run("error_-_breaking_change_without_migration_is_rejected", func(t *testing.T) {
+		t.Parallel()
+		testSuite := setupIntegrationTestSuite(t)
+		defer teardownIntegrationTestSuite(t, &testSuite)
+
+		_, err := testSuite.BHDatabase.UpsertOpenGraphExtension(testSuite.Context, v1)
+		require.NoError(t, err)
+
+		breaking := v2
+		breaking.Migrations = model.ExtensionMigrationsInput{}
+		_, err = testSuite.BHDatabase.UpsertOpenGraphExtension(testSuite.Context, breaking)
+		require.ErrorIs(t, err, model.ErrGraphExtensionBreakingChange)
+
+		// The rejected upgrade must leave the installed version untouched
+		assertGraphExtension(t, testSuite, v1)
+		versions, err := testSuite.BHDatabase.GetGraphSchemaExtensionVersions(testSuite.Context, getExtensionId(t, testSuite))
+		require.NoError(t, err)
+		require.Len(t, versions, 1)
+		assert.Equal(t, "v1.0.0", versions[0].Version)
+	})
+
+	t.Run("success_-_upgrade_records_version_and_rollback_discards_it", func(t *testing.T) {
+		t.Parallel()
+		testSuite := setupIntegrationTestSuite(t)
+		defer teardownIntegrationTestSuite(t, &testSuite)
+
+		_, err := testSuite.BHDatabase.UpsertOpenGraphExtension(testSuite.Context, v1)
+		require.NoError(t, err)
+		updated, err := testSuite.BHDatabase.UpsertOpenGraphExtension(testSuite.Context, v2)
+		require.NoError(t, err)
+		assert.True(t, updated)
+		assertGraphExtension(t, testSuite, v2)
+
+		extensionId := getExtensionId(t, testSuite)
+		versions, err := testSuite.BHDatabase.GetGraphSchemaExtensionVersions(testSuite.Context, extensionId)
+		require.NoError(t, err)
+		require.Len(t, versions, 2)
+		assert.Equal(t, "v2.0.0", versions[0].Version)
+		assert.Equal(t, int32(2), versions[0].Sequence)
+		assert.Equal(t, "v1.0.0", versions[1].Version)
+		assert.Equal(t, int32(1), versions[1].Sequence)
+
+		current, err := versions[0].Input()
+		require.NoError(t, err)
+		assert.Equal(t, v2.Migrations, current.Migrations)
+
+		// Reinstall v1 the way a rollback does
+		previous, err := versions[1].Input()
+		require.NoError(t, err)
+		previous.Migrations = model.RollbackMigrations(current, previous)
+		previous.RestoresSequence = versions[1].Sequence
+		_, err = testSuite.BHDatabase.UpsertOpenGraphExtension(testSuite.Context, previous)
+		require.NoError(t, err)
+		assertGraphExtension(t, testSuite, v1)
+
+		versions, err = testSuite.BHDatabase.GetGraphSchemaExtensionVersions(testSuite.Context, extensionId)
+		require.NoError(t, err)
+		require.Len(t, versions, 1)
+		assert.Equal(t, "v1.0.0", versions[0].Version)
+		assert.Equal(t, int32(1), versions[0].Sequence)
+
+		// Installing again continues the sequence past the rolled back version
+		_, err = testSuite.BHDatabase.UpsertOpenGraphExtension(testSuite.Context, v2)
+		require.NoError(t, err)
+
+		versions, err = testSuite.BHDatabase.GetGraphSchemaExtensionVersions(testSuite.Context, extensionId)
+		require.NoError(t, err)
+		require.Len(t, versions, 2)
+		assert.Equal(t, int32(3), versions[0].Sequence)
+	})
+
+	t.Run("success_-_reinstalling_an_earlier_version_string_appends_to_the_history", func(t *testing.T) {
+		t.Parallel()
+		testSuite := setupIntegrationTestSuite(t)
+		defer teardownIntegrationTestSuite(t, &testSuite)
+
+		_, err := testSuite.BHDatabase.UpsertOpenGraphExtension(testSuite.Context, v1)
+		require.NoError(t, err)
+		_, err = testSuite.BHDatabase.UpsertOpenGraphExtension(testSuite.Context, v2)
+		require.NoError(t, err)
+
+		// Uploading a definition that reuses the v1 version string is a new install, not a rollback
+		reuploaded := v2
+		reuploaded.ExtensionInput.Version = "v1.0.0"
+		reuploaded.Migrations = model.ExtensionMigrationsInput{}
+		_, err = testSuite.BHDatabase.UpsertOpenGraphExtension(testSuite.Context, reuploaded)
+		require.NoError(t, err)
+
+		versions, err := testSuite.BHDatabase.GetGraphSchemaExtensionVersions(testSuite.Context, getExtensionId(t, testSuite))
+		require.NoError(t, err)
+		require.Len(t, versions, 3)
+		assert.Equal(t, []int32{3, 2, 1}, []int32{versions[0].Sequence, versions[1].Sequence, versions[2].Sequence})
+
+		active, err := versions[0].Input()
+		require.NoError(t, err)
+		assert.Equal(t, reuploaded.NodeKindsInput, active.NodeKindsInput)
+	})
+}
+
 // assertGraphExtension retrieves and validates the full extension state against the expected input.
 func assertGraphExtension(t *testing.T, testSuite IntegrationTestSuite, want model.GraphExtensionInput) {
 	t.Helper()
@@ -254,6 +254,32 @@ func (s *GraphMigrator) GetMigrationsByVersion() MigrationsByVersion {
 	return migrationsByVersion
 }
 
+// PendingMigrations returns, in ascending order, the versions of the stepwise graph migrations that Migrate would
+// execute. A new graph database has no pending migrations since only its migration data entry is created.
+func (s *GraphMigrator) PendingMigrations(ctx context.Context) ([]version.Version, error) {
+	var pending []version.Version
+
+	if currentMigration, err := GetMigrationData(ctx, s.db); err != nil {
+		if errors.Is(err, ErrNoMigrationData) {
+			return pending, nil
+		}
+
+		return nil, fmt.Errorf("unable to get graph db migration data: %w", err)
+	} else {
+		for migrationVersion := range s.GetMigrationsByVersion() {
+			if migrationVersion.GreaterThan(currentMigration) {
+				pending = append(pending, migrationVersion)
+			}
+		}
+	}
+
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].LessThan(pending[j])
+	})
+
+	return pending, nil
+}
+
 func (s *GraphMigrator) ExecuteStepwiseMigrations(ctx context.Context, migrationsByVersion MigrationsByVersion) error {
 	if currentMigration, err := GetMigrationData(ctx, s.db); err != nil {
 		if errors.Is(err, ErrNoMigrationData) {
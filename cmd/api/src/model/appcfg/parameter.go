@@ -37,15 +37,16 @@ import (
 type ParameterKey string
 
 const (
-	PasswordExpirationWindow ParameterKey = "auth.password_expiration_window"
-	SessionTTLHours          ParameterKey = "auth.session_ttl_hours"
-	Neo4jConfigs             ParameterKey = "neo4j.configuration"
-	CitrixRDPSupportKey      ParameterKey = "analysis.citrix_rdp_support"
-	PruneTTL                 ParameterKey = "prune.ttl"
-	ReconciliationKey        ParameterKey = "analysis.reconciliation"
-	ScheduledAnalysis        ParameterKey = "analysis.scheduled"
-	ClientMetricsKey         ParameterKey = "pipeline.client_metrics"
-	APITokenExpiration       ParameterKey = "auth.api_token_expiration"
+	PasswordExpirationWindow   ParameterKey = "auth.password_expiration_window"
+	SessionTTLHours            ParameterKey = "auth.session_ttl_hours"
+	Neo4jConfigs               ParameterKey = "neo4j.configuration"
+	CitrixRDPSupportKey        ParameterKey = "analysis.citrix_rdp_support"
+	PruneTTL                   ParameterKey = "prune.ttl"
+	ReconciliationKey          ParameterKey = "analysis.reconciliation"
+	ScheduledAnalysis          ParameterKey = "analysis.scheduled"
+	ClientMetricsKey           ParameterKey = "pipeline.client_metrics"
+	APITokenExpiration         ParameterKey = "auth.api_token_expiration"
+	AssetGroupHistoryRetention ParameterKey = "analysis.asset_group_history_retention"
 
 	// The below keys are not intended to be user updatable, so should not be added to IsValidKey
 	TrustedProxiesConfig                ParameterKey = "http.trusted_proxies"
@@ -66,6 +67,9 @@ const (
 
 	DefaultSessionTTLHours = 8
 
+	DefaultAssetGroupHistoryRetentionDays = 90
+	MaxAssetGroupHistoryRetentionDays     = 3650
+
 	DefaultPruneBaseTTL           = time.Hour * 24 * 7
 	DefaultPruneHasSessionEdgeTTL = time.Hour * 24 * 3
 
@@ -95,7 +99,7 @@ func (s *Parameter) Map(value any) error {
 
 func (s *Parameter) IsValidKey(parameterKey ParameterKey) bool {
 	switch parameterKey {
-	case PasswordExpirationWindow, Neo4jConfigs, PruneTTL, CitrixRDPSupportKey, ReconciliationKey, ScheduledAnalysis, ClientMetricsKey, APITokenExpiration:
+	case PasswordExpirationWindow, Neo4jConfigs, PruneTTL, CitrixRDPSupportKey, ReconciliationKey, ScheduledAnalysis, ClientMetricsKey, APITokenExpiration, AssetGroupHistoryRetention:
 		return true
 	default:
 		return false
@@ -164,6 +168,8 @@ func (s *Parameter) Validate() utils.Errors {
 		v = &APITokenExpirationParameter{}
 	case GraphStorageOptimizationKey:
 		v = &GraphStorageOptimizationParameter{}
+	case AssetGroupHistoryRetention:
+		v = &AssetGroupHistoryRetentionParameter{}
 	default:
 		return utils.Errors{errors.New("invalid key")}
 	}
@@ -706,3 +712,30 @@ func GetGraphStorageOptimizationParameter(ctx context.Context, service Parameter
 
 	return result
 }
+
+// AssetGroupHistoryRetention
+
+type AssetGroupHistoryRetentionParameter struct {
+	RetentionDays int `json:"retention_days" validate:"integer,min=1,max=3650"`
+}
+
+// GetAssetGroupHistoryRetentionParameter returns the number of days asset group history records are retained for
+// before they are swept.
+func GetAssetGroupHistoryRetentionParameter(ctx context.Context, service ParameterService) AssetGroupHistoryRetentionParameter {
+	result := AssetGroupHistoryRetentionParameter{RetentionDays: DefaultAssetGroupHistoryRetentionDays}
+
+	if cfg, err := service.GetConfigurationParameter(ctx, AssetGroupHistoryRetention); err != nil {
+		slog.WarnContext(ctx, "Failed to fetch asset group history retention configuration; returning default values")
+	} else if err := cfg.Map(&result); err != nil {
+		slog.WarnContext(ctx, "Invalid asset group history retention configuration supplied; returning default values",
+			attr.Error(err),
+			slog.String("parameter_key", string(AssetGroupHistoryRetention)))
+	} else if result.RetentionDays <= 0 || result.RetentionDays > MaxAssetGroupHistoryRetentionDays {
+		slog.WarnContext(ctx, "Invalid asset group history retention period supplied, returning default value.",
+			slog.Int("invalid_retention_days", result.RetentionDays),
+			slog.String("parameter_key", string(AssetGroupHistoryRetention)))
+		result.RetentionDays = DefaultAssetGroupHistoryRetentionDays
+	}
+
+	return result
+}
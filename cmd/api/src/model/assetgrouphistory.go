@@ -17,14 +17,13 @@
 package model
 
 import (
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
 )
 
-// AssetGroupHistoryRecordRollingWindow This defines the rolling window in days that the asset group history table will be truncated down to at the end of a successful analysis run
-const AssetGroupHistoryRecordRollingWindow = 90
-
 type AssetGroupHistoryAction string
 
 const (
@@ -70,6 +69,36 @@ type AssetGroupHistory struct {
 	Note            null.String             `json:"note"`
 }
 
+// AssetGroupHistoryCSVHeader is the header row of an asset group history CSV export, in the column order of
+// AssetGroupHistory.CSVRecord
+var AssetGroupHistoryCSVHeader = []string{"id", "created_at", "actor", "email", "action", "target", "asset_group_tag_id", "environment_id", "note"}
+
+// CSVRecord returns the record as a row of an asset group history CSV export. Null values are written as empty fields
+// and text fields are escaped with csvSafeField.
+func (s AssetGroupHistory) CSVRecord() []string {
+	return []string{
+		strconv.FormatInt(s.ID, 10),
+		s.CreatedAt.UTC().Format(time.RFC3339Nano),
+		csvSafeField(s.Actor),
+		csvSafeField(s.Email.ValueOrZero()),
+		string(s.Action),
+		csvSafeField(s.Target),
+		strconv.Itoa(s.AssetGroupTagId),
+		csvSafeField(s.EnvironmentId.ValueOrZero()),
+		csvSafeField(s.Note.ValueOrZero()),
+	}
+}
+
+// csvSafeField prefixes fields that a spreadsheet would evaluate as a formula with a single quote so that user supplied
+// text, such as a note or a tag name, is displayed rather than executed when the export is opened.
+func csvSafeField(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+
+	return value
+}
+
 func (AssetGroupHistory) TableName() string {
 	return "asset_group_history"
 }
@@ -40,6 +40,7 @@ type SelectorType int
 const (
 	SelectorTypeObjectId SelectorType = 1
 	SelectorTypeCypher   SelectorType = 2
+	SelectorTypeProperty SelectorType = 3
 )
 
 type AssetGroupTagType int
@@ -49,6 +49,10 @@ const (
 	AuditLogActionUpdateUser AuditLogAction = "UpdateUser"
 	AuditLogActionDeleteUser AuditLogAction = "DeleteUser"
 
+	AuditLogActionCreateRole AuditLogAction = "CreateRole"
+	AuditLogActionUpdateRole AuditLogAction = "UpdateRole"
+	AuditLogActionDeleteRole AuditLogAction = "DeleteRole"
+
 	AuditLogActionCreateAssetGroup AuditLogAction = "CreateAssetGroup"
 	AuditLogActionUpdateAssetGroup AuditLogAction = "UpdateAssetGroup"
 	AuditLogActionDeleteAssetGroup AuditLogAction = "DeleteAssetGroup"
@@ -128,6 +132,28 @@ const (
 	AuditLogActionDeleteAlertWebhook AuditLogAction = "DeleteAlertWebhook"
 
 	AuditLogActionRotateAlertWebhookSecret AuditLogAction = "RotateAlertWebhookSecret"
+
+	AuditLogActionRequestAnalysis  AuditLogAction = "RequestAnalysis"
+	AuditLogActionMigrateDatabases AuditLogAction = "MigrateDatabases"
+
+	AuditLogActionRotateStorageKeys AuditLogAction = "RotateStorageKeys"
+
+	AuditLogActionCreateDataQualityThreshold AuditLogAction = "CreateDataQualityThreshold"
+	AuditLogActionUpdateDataQualityThreshold AuditLogAction = "UpdateDataQualityThreshold"
+	AuditLogActionDeleteDataQualityThreshold AuditLogAction = "DeleteDataQualityThreshold"
+	AuditLogActionDataQualityRegression      AuditLogAction = "DataQualityRegression"
+
+	AuditLogActionCreateSCIMToken     AuditLogAction = "CreateSCIMToken"
+	AuditLogActionDeleteSCIMToken     AuditLogAction = "DeleteSCIMToken"
+	AuditLogActionSetSCIMGroupRole    AuditLogAction = "SetSCIMGroupRole"
+	AuditLogActionDeleteSCIMGroupRole AuditLogAction = "DeleteSCIMGroupRole"
+
+	AuditLogActionSCIMCreateUser      AuditLogAction = "SCIMCreateUser"
+	AuditLogActionSCIMUpdateUser      AuditLogAction = "SCIMUpdateUser"
+	AuditLogActionSCIMDeprovisionUser AuditLogAction = "SCIMDeprovisionUser"
+	AuditLogActionSCIMCreateGroup     AuditLogAction = "SCIMCreateGroup"
+	AuditLogActionSCIMUpdateGroup     AuditLogAction = "SCIMUpdateGroup"
+	AuditLogActionSCIMDeleteGroup     AuditLogAction = "SCIMDeleteGroup"
 )
 
 // TODO embed Basic into this struct instead of declaring the ID and CreatedAt fields. This will require a migration
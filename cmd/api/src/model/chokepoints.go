@@ -0,0 +1,68 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+type ChokePointType string
+
+const (
+	ChokePointTypeNode         ChokePointType = "node"
+	ChokePointTypeRelationship ChokePointType = "relationship"
+)
+
+// ChokePoint is a node or relationship that attack paths into the Tier Zero members of an environment depend on.
+// PrincipalsRemoved counts the principals that would lose every path into Tier Zero if the choke point were removed,
+// and Betweenness sums, over every principal with a path, the fraction of its shortest paths that pass through it.
+// Node choke points identify the node with ObjectID and Name while relationship choke points identify the nodes at
+// either end of the relationship.
+type ChokePoint struct {
+	RunID             string         `json:"run_id"`
+	EnvironmentID     string         `json:"environment_id"`
+	Rank              int32          `json:"rank"`
+	Type              ChokePointType `json:"type"`
+	Kind              string         `json:"kind"`
+	ObjectID          string         `json:"object_id,omitempty"`
+	Name              string         `json:"name,omitempty"`
+	StartObjectID     string         `json:"start_object_id,omitempty"`
+	StartName         string         `json:"start_name,omitempty"`
+	EndObjectID       string         `json:"end_object_id,omitempty"`
+	EndName           string         `json:"end_name,omitempty"`
+	PrincipalsRemoved int64          `json:"principals_removed"`
+	Principals        int64          `json:"principals"`
+	Betweenness       float64        `json:"betweenness"`
+
+	Serial
+}
+
+func (ChokePoint) TableName() string {
+	return "attack_path_choke_points"
+}
+
+type ChokePoints []ChokePoint
+
+func (s ChokePoints) IsSortable(column string) bool {
+	switch column {
+	case "rank",
+		"principals_removed",
+		"betweenness",
+		"type",
+		"kind",
+		"created_at":
+		return true
+	default:
+		return false
+	}
+}
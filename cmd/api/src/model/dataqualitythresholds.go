@@ -0,0 +1,286 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const DefaultDataQualityBaselineRuns = 5
+
+type DataQualitySource string
+
+const (
+	DataQualitySourceAD        DataQualitySource = "ad"
+	DataQualitySourceAzure     DataQualitySource = "azure"
+	DataQualitySourceOpenGraph DataQualitySource = "opengraph"
+)
+
+type DataQualityThresholdType string
+
+const (
+	DataQualityThresholdTypeAbsolute DataQualityThresholdType = "absolute"
+	DataQualityThresholdTypePercent  DataQualityThresholdType = "percent"
+)
+
+type DataQualityThresholdDirection string
+
+const (
+	DataQualityThresholdDirectionDecrease DataQualityThresholdDirection = "decrease"
+	DataQualityThresholdDirectionIncrease DataQualityThresholdDirection = "increase"
+	DataQualityThresholdDirectionAny      DataQualityThresholdDirection = "any"
+)
+
+// dataQualityNonStatFields are the aggregation fields that are not collection stats and can not be thresholded.
+var dataQualityNonStatFields = []string{"id", "run_id", "created_at", "updated_at", "deleted_at"}
+
+// DataQualityStatValues returns the numeric stats of a data quality aggregation keyed by their JSON field name.
+func DataQualityStatValues(aggregation any) (map[string]float64, error) {
+	var (
+		fields = map[string]any{}
+		values = map[string]float64{}
+	)
+
+	if content, err := json.Marshal(aggregation); err != nil {
+		return nil, err
+	} else if err := json.Unmarshal(content, &fields); err != nil {
+		return nil, err
+	}
+
+	for name, value := range fields {
+		if numericValue, isNumeric := value.(float64); isNumeric && !slices.Contains(dataQualityNonStatFields, name) {
+			values[name] = numericValue
+		}
+	}
+
+	return values, nil
+}
+
+// OpenGraphDataQualityStatName returns the name of the OpenGraph stat counting the nodes or relationships of a kind,
+// for example "node:Dog" or "relationship:Bites". OpenGraph stats are summed across schema extensions and
+// environments.
+func OpenGraphDataQualityStatName(metricType DataQualityMetricType, kindName string) string {
+	return string(metricType) + ":" + kindName
+}
+
+func isOpenGraphDataQualityStatName(stat string) bool {
+	metricType, kindName, found := strings.Cut(stat, ":")
+
+	switch DataQualityMetricType(metricType) {
+	case DataQualityMetricTypeNode, DataQualityMetricTypeRelationship:
+		return found && kindName != ""
+	default:
+		return false
+	}
+}
+
+// DataQualityStatNames returns the names of the stats that may be thresholded for the given source. OpenGraph stats
+// depend on the kinds of the loaded schema extensions and are not listed; see OpenGraphDataQualityStatName.
+func DataQualityStatNames(source DataQualitySource) []string {
+	var aggregation any
+
+	switch source {
+	case DataQualitySourceAD:
+		aggregation = ADDataQualityAggregation{}
+	case DataQualitySourceAzure:
+		aggregation = AzureDataQualityAggregation{}
+	default:
+		return nil
+	}
+
+	if values, err := DataQualityStatValues(aggregation); err != nil {
+		return nil
+	} else {
+		names := make([]string, 0, len(values))
+		for name := range values {
+			names = append(names, name)
+		}
+
+		slices.Sort(names)
+		return names
+	}
+}
+
+// DataQualityThreshold flags a collection run when one of its aggregated stats deviates from the mean of the
+// preceding BaselineRuns runs by more than Threshold. Threshold is a count for absolute thresholds and a percentage
+// of the baseline for percent thresholds. Violations of thresholds with PauseTagging set stop tagging, and the steps
+// that depend on the tagged Tier Zero members, for the run.
+type DataQualityThreshold struct {
+	Source        DataQualitySource             `json:"source"`
+	Stat          string                        `json:"stat"`
+	ThresholdType DataQualityThresholdType      `json:"threshold_type"`
+	Direction     DataQualityThresholdDirection `json:"direction"`
+	Threshold     float64                       `json:"threshold"`
+	BaselineRuns  int                           `json:"baseline_runs"`
+	PauseTagging  bool                          `json:"pause_tagging"`
+	Enabled       bool                          `json:"enabled"`
+
+	Serial
+}
+
+func (DataQualityThreshold) TableName() string {
+	return "data_quality_thresholds"
+}
+
+func (s DataQualityThreshold) AuditData() AuditData {
+	return AuditData{
+		"id":             s.ID,
+		"source":         s.Source,
+		"stat":           s.Stat,
+		"threshold_type": s.ThresholdType,
+		"direction":      s.Direction,
+		"threshold":      s.Threshold,
+		"baseline_runs":  s.BaselineRuns,
+		"pause_tagging":  s.PauseTagging,
+		"enabled":        s.Enabled,
+	}
+}
+
+// WithDefaults returns a copy of the threshold with an unset direction and baseline replaced by their defaults.
+func (s DataQualityThreshold) WithDefaults() DataQualityThreshold {
+	if s.Direction == "" {
+		s.Direction = DataQualityThresholdDirectionDecrease
+	}
+
+	if s.BaselineRuns == 0 {
+		s.BaselineRuns = DefaultDataQualityBaselineRuns
+	}
+
+	return s
+}
+
+func (s DataQualityThreshold) Validate() error {
+	switch s.Source {
+	case DataQualitySourceAD, DataQualitySourceAzure:
+		if !slices.Contains(DataQualityStatNames(s.Source), s.Stat) {
+			return fmt.Errorf("invalid stat %q for source %s", s.Stat, s.Source)
+		}
+	case DataQualitySourceOpenGraph:
+		if !isOpenGraphDataQualityStatName(s.Stat) {
+			return fmt.Errorf("invalid stat %q for source %s: must be node:<kind> or relationship:<kind>", s.Stat, s.Source)
+		}
+	default:
+		return fmt.Errorf("invalid source %q: must be one of %s, %s, %s", s.Source, DataQualitySourceAD, DataQualitySourceAzure, DataQualitySourceOpenGraph)
+	}
+
+	switch s.ThresholdType {
+	case DataQualityThresholdTypeAbsolute, DataQualityThresholdTypePercent:
+	default:
+		return fmt.Errorf("invalid threshold_type %q: must be one of %s, %s", s.ThresholdType, DataQualityThresholdTypeAbsolute, DataQualityThresholdTypePercent)
+	}
+
+	switch s.Direction {
+	case DataQualityThresholdDirectionDecrease, DataQualityThresholdDirectionIncrease, DataQualityThresholdDirectionAny:
+	default:
+		return fmt.Errorf("invalid direction %q: must be one of %s, %s, %s", s.Direction, DataQualityThresholdDirectionDecrease, DataQualityThresholdDirectionIncrease, DataQualityThresholdDirectionAny)
+	}
+
+	if s.Threshold <= 0 {
+		return errors.New("threshold must be greater than 0")
+	} else if s.BaselineRuns < 1 || s.BaselineRuns > 100 {
+		return errors.New("baseline_runs must be between 1 and 100")
+	}
+
+	return nil
+}
+
+// Evaluate compares a stat value against its baseline. The signed change, in the unit of the threshold type, is
+// returned along with whether it exceeds the threshold in the configured direction. Percent thresholds can not be
+// evaluated against a zero baseline and are never violated by one.
+func (s DataQualityThreshold) Evaluate(baseline, value float64) (float64, bool) {
+	change := value - baseline
+
+	if s.ThresholdType == DataQualityThresholdTypePercent {
+		if baseline == 0 {
+			return 0, false
+		}
+
+		change = change / baseline * 100
+	}
+
+	switch s.Direction {
+	case DataQualityThresholdDirectionIncrease:
+		return change, change > s.Threshold
+	case DataQualityThresholdDirectionAny:
+		return change, change > s.Threshold || -change > s.Threshold
+	default:
+		return change, -change > s.Threshold
+	}
+}
+
+type DataQualityThresholds []DataQualityThreshold
+
+// DataQualityViolation records a run whose stat exceeded a DataQualityThreshold. The threshold settings are copied so
+// that the violation remains meaningful after the threshold is changed or deleted.
+type DataQualityViolation struct {
+	RunID         string                        `json:"run_id"`
+	ThresholdID   int32                         `json:"threshold_id"`
+	Source        DataQualitySource             `json:"source"`
+	Stat          string                        `json:"stat"`
+	ThresholdType DataQualityThresholdType      `json:"threshold_type"`
+	Direction     DataQualityThresholdDirection `json:"direction"`
+	Threshold     float64                       `json:"threshold"`
+	Baseline      float64                       `json:"baseline"`
+	Value         float64                       `json:"value"`
+	Change        float64                       `json:"change"`
+	PauseTagging  bool                          `json:"pause_tagging"`
+
+	Serial
+}
+
+func (DataQualityViolation) TableName() string {
+	return "data_quality_violations"
+}
+
+func (s DataQualityViolation) AuditData() AuditData {
+	return AuditData{
+		"run_id":         s.RunID,
+		"threshold_id":   s.ThresholdID,
+		"source":         s.Source,
+		"stat":           s.Stat,
+		"threshold_type": s.ThresholdType,
+		"direction":      s.Direction,
+		"threshold":      s.Threshold,
+		"baseline":       s.Baseline,
+		"value":          s.Value,
+		"change":         s.Change,
+		"pause_tagging":  s.PauseTagging,
+	}
+}
+
+type DataQualityViolations []DataQualityViolation
+
+// PausesTagging reports whether any of the violations requires tagging to be paused.
+func (s DataQualityViolations) PausesTagging() bool {
+	return slices.ContainsFunc(s, func(violation DataQualityViolation) bool {
+		return violation.PauseTagging
+	})
+}
+
+func (s DataQualityViolations) IsSortable(column string) bool {
+	switch column {
+	case "created_at",
+		"updated_at":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataQualityStatNames(t *testing.T) {
+	adStats := DataQualityStatNames(DataQualitySourceAD)
+	require.Contains(t, adStats, "users")
+	require.Contains(t, adStats, "session_completeness")
+	require.NotContains(t, adStats, "id")
+	require.NotContains(t, adStats, "run_id")
+
+	azureStats := DataQualityStatNames(DataQualitySourceAzure)
+	require.Contains(t, azureStats, "tenants")
+	require.NotContains(t, azureStats, "domains")
+
+	require.Empty(t, DataQualityStatNames(DataQualitySourceOpenGraph))
+	require.Empty(t, DataQualityStatNames("unknown"))
+}
+
+func TestDataQualityThreshold_Validate(t *testing.T) {
+	valid := DataQualityThreshold{
+		Source:        DataQualitySourceAD,
+		Stat:          "users",
+		ThresholdType: DataQualityThresholdTypePercent,
+		Threshold:     10,
+	}.WithDefaults()
+
+	require.Equal(t, DataQualityThresholdDirectionDecrease, valid.Direction)
+	require.Equal(t, DefaultDataQualityBaselineRuns, valid.BaselineRuns)
+	require.NoError(t, valid.Validate())
+
+	invalidSource := valid
+	invalidSource.Source = "okta"
+	require.ErrorContains(t, invalidSource.Validate(), "invalid source")
+
+	invalidStat := valid
+	invalidStat.Stat = "tenants"
+	require.ErrorContains(t, invalidStat.Validate(), "invalid stat")
+
+	openGraph := valid
+	openGraph.Source = DataQualitySourceOpenGraph
+	openGraph.Stat = OpenGraphDataQualityStatName(DataQualityMetricTypeNode, "Dog")
+	require.NoError(t, openGraph.Validate())
+
+	invalidOpenGraphStat := openGraph
+	invalidOpenGraphStat.Stat = "users"
+	require.ErrorContains(t, invalidOpenGraphStat.Validate(), "invalid stat")
+
+	invalidOpenGraphKind := openGraph
+	invalidOpenGraphKind.Stat = "relationship:"
+	require.ErrorContains(t, invalidOpenGraphKind.Validate(), "invalid stat")
+
+	invalidType := valid
+	invalidType.ThresholdType = "relative"
+	require.ErrorContains(t, invalidType.Validate(), "invalid threshold_type")
+
+	invalidDirection := valid
+	invalidDirection.Direction = "sideways"
+	require.ErrorContains(t, invalidDirection.Validate(), "invalid direction")
+
+	invalidThreshold := valid
+	invalidThreshold.Threshold = 0
+	require.ErrorContains(t, invalidThreshold.Validate(), "threshold must be greater than 0")
+
+	invalidBaseline := valid
+	invalidBaseline.BaselineRuns = 101
+	require.ErrorContains(t, invalidBaseline.Validate(), "baseline_runs must be between 1 and 100")
+}
+
+func TestDataQualityThreshold_Evaluate(t *testing.T) {
+	tests := []struct {
+		name           string
+		threshold      DataQualityThreshold
+		baseline       float64
+		value          float64
+		expectedChange float64
+		expectedResult bool
+	}{
+		{
+			name:           "absolute decrease beyond threshold",
+			threshold:      DataQualityThreshold{ThresholdType: DataQualityThresholdTypeAbsolute, Direction: DataQualityThresholdDirectionDecrease, Threshold: 10},
+			baseline:       100,
+			value:          80,
+			expectedChange: -20,
+			expectedResult: true,
+		},
+		{
+			name:           "absolute increase ignored by decrease threshold",
+			threshold:      DataQualityThreshold{ThresholdType: DataQualityThresholdTypeAbsolute, Direction: DataQualityThresholdDirectionDecrease, Threshold: 10},
+			baseline:       100,
+			value:          150,
+			expectedChange: 50,
+		},
+		{
+			name:           "percent increase beyond threshold",
+			threshold:      DataQualityThreshold{ThresholdType: DataQualityThresholdTypePercent, Direction: DataQualityThresholdDirectionIncrease, Threshold: 25},
+			baseline:       200,
+			value:          300,
+			expectedChange: 50,
+			expectedResult: true,
+		},
+		{
+			name:           "percent change within threshold",
+			threshold:      DataQualityThreshold{ThresholdType: DataQualityThresholdTypePercent, Direction: DataQualityThresholdDirectionAny, Threshold: 25},
+			baseline:       200,
+			value:          180,
+			expectedChange: -10,
+		},
+		{
+			name:           "any direction flags decrease",
+			threshold:      DataQualityThreshold{ThresholdType: DataQualityThresholdTypePercent, Direction: DataQualityThresholdDirectionAny, Threshold: 25},
+			baseline:       200,
+			value:          100,
+			expectedChange: -50,
+			expectedResult: true,
+		},
+		{
+			name:      "percent threshold ignores zero baseline",
+			threshold: DataQualityThreshold{ThresholdType: DataQualityThresholdTypePercent, Direction: DataQualityThresholdDirectionAny, Threshold: 25},
+			baseline:  0,
+			value:     100,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			change, violated := tt.threshold.Evaluate(tt.baseline, tt.value)
+			require.Equal(t, tt.expectedChange, change)
+			require.Equal(t, tt.expectedResult, violated)
+		})
+	}
+}
+
+func TestDataQualityViolations_PausesTagging(t *testing.T) {
+	require.False(t, DataQualityViolations{}.PausesTagging())
+	require.False(t, DataQualityViolations{{PauseTagging: false}}.PausesTagging())
+	require.True(t, DataQualityViolations{{PauseTagging: false}, {PauseTagging: true}}.PausesTagging())
+}
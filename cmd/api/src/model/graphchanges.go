@@ -0,0 +1,263 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+)
+
+type GraphObjectType string
+
+const (
+	GraphObjectTypeNode GraphObjectType = "node"
+	GraphObjectTypeEdge GraphObjectType = "edge"
+)
+
+type GraphChangeType string
+
+const (
+	GraphChangeTypeAdded    GraphChangeType = "added"
+	GraphChangeTypeModified GraphChangeType = "modified"
+	GraphChangeTypeRemoved  GraphChangeType = "removed"
+)
+
+// GraphChangeKindsProperty is the diff key used to record changes to the kinds of a node.
+const GraphChangeKindsProperty = "kinds"
+
+// GraphObjectState is the last ingested snapshot of a node or edge. Nodes are keyed by their object ID and edges by
+// their source object ID, target object ID and kind. Properties excluded from changelog hashing are not tracked. The
+// producer of an edge is the ingest data type that last reported it.
+type GraphObjectState struct {
+	ObjectType     GraphObjectType         `json:"object_type" gorm:"primaryKey"`
+	ObjectKey      string                  `json:"object_key" gorm:"primaryKey"`
+	ObjectID       string                  `json:"object_id"`
+	SourceObjectID string                  `json:"source_object_id"`
+	TargetObjectID string                  `json:"target_object_id"`
+	Kind           string                  `json:"kind"`
+	Kinds          pq.StringArray          `json:"kinds" gorm:"type:text[]"`
+	Properties     types.JSONUntypedObject `json:"properties"`
+	Producer       string                  `json:"producer"`
+	RemovedAt      null.Time               `json:"removed_at"`
+	CreatedAt      time.Time               `json:"created_at"`
+	UpdatedAt      time.Time               `json:"updated_at"`
+}
+
+func (GraphObjectState) TableName() string {
+	return "graph_object_states"
+}
+
+// Normalized returns a copy of the state with its properties round-tripped through JSON so that they compare equal
+// to the properties of a state read back from the database.
+func (s GraphObjectState) Normalized() (GraphObjectState, error) {
+	normalized := types.JSONUntypedObject{}
+
+	if s.Kinds == nil {
+		s.Kinds = pq.StringArray{}
+	}
+
+	if content, err := json.Marshal(s.Properties); err != nil {
+		return s, err
+	} else if err := json.Unmarshal(content, &normalized); err != nil {
+		return s, err
+	}
+
+	s.Properties = normalized
+	return s, nil
+}
+
+// MergedOnto returns the state of the object after this ingested state has been applied to the previous state.
+// Ingest merges properties and kinds into the existing graph object, so an ingested state that omits a property does
+// not remove it. States of previously removed objects are returned unchanged.
+func (s GraphObjectState) MergedOnto(previous *GraphObjectState) GraphObjectState {
+	if previous == nil || previous.RemovedAt.Valid {
+		return s
+	}
+
+	merged := make(types.JSONUntypedObject, len(previous.Properties)+len(s.Properties))
+
+	for key, value := range previous.Properties {
+		merged[key] = value
+	}
+
+	for key, value := range s.Properties {
+		merged[key] = value
+	}
+
+	s.Properties = merged
+
+	if s.ObjectType == GraphObjectTypeNode {
+		kinds := slices.Clone(previous.Kinds)
+
+		for _, kind := range s.Kinds {
+			if !slices.Contains(kinds, kind) {
+				kinds = append(kinds, kind)
+			}
+		}
+
+		s.Kinds = kinds
+	}
+
+	return s
+}
+
+type GraphObjectStates []GraphObjectState
+
+// GraphEdgeFamily identifies the edges of a kind that end at an object and were produced by one ingest data type.
+// Ingesting an object ingests every edge of a family it reports, so edges of the family that were not ingested with it
+// have been removed. Edges of the same kind produced by other data types, such as the primary group memberships of
+// users.json alongside the members of groups.json, are not part of the family.
+type GraphEdgeFamily struct {
+	TargetObjectID string
+	Kind           string
+	Producer       string
+}
+
+// GraphPropertyDiff holds the previous and current value of a changed property. A nil value means the property was
+// absent.
+type GraphPropertyDiff struct {
+	Old any `json:"old"`
+	New any `json:"new"`
+}
+
+type GraphPropertyDiffs map[string]GraphPropertyDiff
+
+func (s *GraphPropertyDiffs) Scan(value any) error {
+	if value == nil {
+		*s = GraphPropertyDiffs{}
+		return nil
+	}
+
+	if bytes, ok := value.([]byte); !ok {
+		return errors.New("type assertion to []byte failed for GraphPropertyDiffs")
+	} else {
+		return json.Unmarshal(bytes, s)
+	}
+}
+
+func (s GraphPropertyDiffs) Value() (driver.Value, error) {
+	return json.Marshal(s)
+}
+
+// DiffGraphProperties returns the properties that differ between previous and current.
+func DiffGraphProperties(previous, current map[string]any) GraphPropertyDiffs {
+	diffs := GraphPropertyDiffs{}
+
+	for key, previousValue := range previous {
+		if currentValue, present := current[key]; !present {
+			diffs[key] = GraphPropertyDiff{Old: previousValue}
+		} else if !reflect.DeepEqual(previousValue, currentValue) {
+			diffs[key] = GraphPropertyDiff{Old: previousValue, New: currentValue}
+		}
+	}
+
+	for key, currentValue := range current {
+		if _, present := previous[key]; !present {
+			diffs[key] = GraphPropertyDiff{New: currentValue}
+		}
+	}
+
+	return diffs
+}
+
+// GraphChange records a node or edge that was added, modified or removed. Added and modified changes carry the diff of
+// their tracked properties. Changes to the kinds of a node are recorded in the diff under GraphChangeKindsProperty.
+type GraphChange struct {
+	JobID          null.Int64         `json:"job_id"`
+	ObjectType     GraphObjectType    `json:"object_type"`
+	ChangeType     GraphChangeType    `json:"change_type"`
+	ObjectID       string             `json:"object_id"`
+	SourceObjectID string             `json:"source_object_id"`
+	TargetObjectID string             `json:"target_object_id"`
+	Kind           string             `json:"kind"`
+	Kinds          pq.StringArray     `json:"kinds" gorm:"type:text[]"`
+	Diff           GraphPropertyDiffs `json:"diff" gorm:"type:jsonb"`
+
+	BigSerial
+}
+
+func (GraphChange) TableName() string {
+	return "graph_changes"
+}
+
+// NewGraphChange compares a freshly ingested state against its previously stored state, which is nil when the object
+// has never been seen. It returns false when the object is unchanged.
+func NewGraphChange(jobID null.Int64, previous *GraphObjectState, current GraphObjectState) (GraphChange, bool) {
+	change := GraphChange{
+		JobID:          jobID,
+		ObjectType:     current.ObjectType,
+		ChangeType:     GraphChangeTypeModified,
+		ObjectID:       current.ObjectID,
+		SourceObjectID: current.SourceObjectID,
+		TargetObjectID: current.TargetObjectID,
+		Kind:           current.Kind,
+		Kinds:          current.Kinds,
+	}
+
+	if previous == nil || previous.RemovedAt.Valid {
+		change.ChangeType = GraphChangeTypeAdded
+		change.Diff = DiffGraphProperties(nil, current.Properties)
+
+		if current.ObjectType == GraphObjectTypeNode {
+			change.Diff[GraphChangeKindsProperty] = GraphPropertyDiff{New: []string(current.Kinds)}
+		}
+
+		return change, true
+	}
+
+	change.Diff = DiffGraphProperties(previous.Properties, current.Properties)
+
+	if current.ObjectType == GraphObjectTypeNode && !sameKinds(previous.Kinds, current.Kinds) {
+		change.Diff[GraphChangeKindsProperty] = GraphPropertyDiff{Old: []string(previous.Kinds), New: []string(current.Kinds)}
+	}
+
+	return change, len(change.Diff) > 0
+}
+
+func sameKinds(previous, current []string) bool {
+	if len(previous) != len(current) {
+		return false
+	}
+
+	for _, kind := range current {
+		if !slices.Contains(previous, kind) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type GraphChanges []GraphChange
+
+func (s GraphChanges) IsSortable(column string) bool {
+	switch column {
+	case "id",
+		"created_at":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffGraphProperties(t *testing.T) {
+	diffs := DiffGraphProperties(
+		map[string]any{"name": "A", "enabled": true, "removed": "x"},
+		map[string]any{"name": "B", "enabled": true, "added": float64(1)},
+	)
+
+	require.Equal(t, GraphPropertyDiffs{
+		"name":    {Old: "A", New: "B"},
+		"removed": {Old: "x"},
+		"added":   {New: float64(1)},
+	}, diffs)
+
+	require.Empty(t, DiffGraphProperties(map[string]any{"list": []any{"a"}}, map[string]any{"list": []any{"a"}}))
+}
+
+func TestNewGraphChange(t *testing.T) {
+	var (
+		jobID   = null.Int64From(7)
+		current = GraphObjectState{
+			ObjectType: GraphObjectTypeNode,
+			ObjectKey:  "ABC",
+			ObjectID:   "ABC",
+			Kinds:      pq.StringArray{"Base", "User"},
+			Properties: types.JSONUntypedObject{"name": "USER"},
+		}
+	)
+
+	t.Run("new object is added", func(t *testing.T) {
+		change, changed := NewGraphChange(jobID, nil, current)
+
+		require.True(t, changed)
+		require.Equal(t, GraphChangeTypeAdded, change.ChangeType)
+		require.Equal(t, jobID, change.JobID)
+		require.Equal(t, GraphPropertyDiffs{
+			"name":                   {New: "USER"},
+			GraphChangeKindsProperty: {New: []string{"Base", "User"}},
+		}, change.Diff)
+	})
+
+	t.Run("removed object is added again", func(t *testing.T) {
+		previous := current
+		previous.RemovedAt = null.TimeFrom(previous.UpdatedAt)
+
+		change, changed := NewGraphChange(jobID, &previous, current)
+
+		require.True(t, changed)
+		require.Equal(t, GraphChangeTypeAdded, change.ChangeType)
+	})
+
+	t.Run("unchanged object", func(t *testing.T) {
+		previous := current
+		previous.Kinds = pq.StringArray{"User", "Base"}
+
+		_, changed := NewGraphChange(jobID, &previous, current)
+
+		require.False(t, changed)
+	})
+
+	t.Run("modified properties and kinds", func(t *testing.T) {
+		previous := current
+		previous.Kinds = pq.StringArray{"Base"}
+		previous.Properties = types.JSONUntypedObject{"name": "OLD"}
+
+		change, changed := NewGraphChange(jobID, &previous, current)
+
+		require.True(t, changed)
+		require.Equal(t, GraphChangeTypeModified, change.ChangeType)
+		require.Equal(t, GraphPropertyDiffs{
+			"name":                   {Old: "OLD", New: "USER"},
+			GraphChangeKindsProperty: {Old: []string{"Base"}, New: []string{"Base", "User"}},
+		}, change.Diff)
+	})
+
+	t.Run("edge kinds are not diffed", func(t *testing.T) {
+		edge := GraphObjectState{
+			ObjectType:     GraphObjectTypeEdge,
+			ObjectKey:      "A|B|GenericAll",
+			SourceObjectID: "A",
+			TargetObjectID: "B",
+			Kind:           "GenericAll",
+			Properties:     types.JSONUntypedObject{"isacl": true},
+		}
+
+		change, changed := NewGraphChange(jobID, nil, edge)
+
+		require.True(t, changed)
+		require.Equal(t, GraphPropertyDiffs{"isacl": {New: true}}, change.Diff)
+	})
+}
+
+func TestGraphObjectState_Normalized(t *testing.T) {
+	normalized, err := GraphObjectState{Properties: types.JSONUntypedObject{"count": 3, "tags": []string{"a"}}}.Normalized()
+
+	require.NoError(t, err)
+	require.Equal(t, pq.StringArray{}, normalized.Kinds)
+	require.Equal(t, types.JSONUntypedObject{"count": float64(3), "tags": []any{"a"}}, normalized.Properties)
+}
+
+func TestGraphObjectState_MergedOnto(t *testing.T) {
+	var (
+		previous = GraphObjectState{
+			ObjectType: GraphObjectTypeNode,
+			Kinds:      pq.StringArray{"Base", "User"},
+			Properties: types.JSONUntypedObject{"name": "USER@TESTLAB.LOCAL", "enabled": true},
+		}
+		ingested = GraphObjectState{
+			ObjectType: GraphObjectTypeNode,
+			Kinds:      pq.StringArray{"Base", "Tag"},
+			Properties: types.JSONUntypedObject{"enabled": false},
+		}
+	)
+
+	t.Run("merges properties and kinds onto the previous state", func(t *testing.T) {
+		merged := ingested.MergedOnto(&previous)
+
+		require.Equal(t, pq.StringArray{"Base", "User", "Tag"}, merged.Kinds)
+		require.Equal(t, types.JSONUntypedObject{"name": "USER@TESTLAB.LOCAL", "enabled": false}, merged.Properties)
+
+		change, isChanged := NewGraphChange(null.Int64{}, &previous, merged)
+		require.True(t, isChanged)
+		require.Equal(t, GraphPropertyDiffs{
+			"enabled":                {Old: true, New: false},
+			GraphChangeKindsProperty: {Old: []string{"Base", "User"}, New: []string{"Base", "User", "Tag"}},
+		}, change.Diff)
+	})
+
+	t.Run("does not merge onto removed objects", func(t *testing.T) {
+		removed := previous
+		removed.RemovedAt = null.TimeFrom(time.Now())
+
+		require.Equal(t, ingested, ingested.MergedOnto(&removed))
+		require.Equal(t, ingested, ingested.MergedOnto(nil))
+	})
+}
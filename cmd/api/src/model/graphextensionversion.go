@@ -0,0 +1,261 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrGraphExtensionBreakingChange   = errors.New("breaking graph extension change")
+	ErrGraphExtensionNoPriorVersion   = errors.New("graph extension has no prior version to roll back to")
+	ErrGraphExtensionInvalidMigration = errors.New("invalid graph extension kind migration")
+	ErrGraphExtensionMigrateGraph     = errors.New("error migrating graph data for graph extension")
+)
+
+// KindMigrationInput declares how graph data of a kind that an extension upgrade no longer defines is carried
+// forward. Data is moved to the To kind; an empty To drops it instead. Dropping a node kind removes the kind from
+// nodes without deleting the nodes, while dropping a relationship kind deletes the relationships.
+type KindMigrationInput struct {
+	From string
+	To   string
+}
+
+// IsDrop reports whether the migration drops the From kind rather than renaming it
+func (s KindMigrationInput) IsDrop() bool {
+	return s.To == ""
+}
+
+type KindMigrationsInput []KindMigrationInput
+
+// ExtensionMigrationsInput groups the kind migrations declared alongside an extension upgrade
+type ExtensionMigrationsInput struct {
+	NodeKinds         KindMigrationsInput
+	RelationshipKinds KindMigrationsInput
+}
+
+// IsEmpty reports whether no migrations are declared
+func (s ExtensionMigrationsInput) IsEmpty() bool {
+	return len(s.NodeKinds) == 0 && len(s.RelationshipKinds) == 0
+}
+
+// validate checks the migrations against the kinds declared by the extension they accompany. A migration may not
+// source a kind the extension still declares and may only target a kind of the same type that it declares.
+func (s ExtensionMigrationsInput) validate(nodeKinds, relationshipKinds map[string]any) error {
+	if err := s.NodeKinds.validate("node", nodeKinds); err != nil {
+		return err
+	}
+
+	return s.RelationshipKinds.validate("relationship", relationshipKinds)
+}
+
+func (s KindMigrationsInput) validate(kindType string, declaredKinds map[string]any) error {
+	sources := make(map[string]struct{}, len(s))
+
+	for _, migration := range s {
+		if strings.TrimSpace(migration.From) == "" {
+			return fmt.Errorf("%w: %s kind migration source is required", ErrGraphExtensionInvalidMigration, kindType)
+		} else if _, ok := sources[migration.From]; ok {
+			return fmt.Errorf("%w: duplicate %s kind migration for %s", ErrGraphExtensionInvalidMigration, kindType, migration.From)
+		} else if _, ok := declaredKinds[migration.From]; ok {
+			return fmt.Errorf("%w: %s kind %s is still declared by the extension and cannot be migrated", ErrGraphExtensionInvalidMigration, kindType, migration.From)
+		} else if _, ok := declaredKinds[migration.To]; !migration.IsDrop() && !ok {
+			return fmt.Errorf("%w: %s kind migration target %s is not declared as a %s kind", ErrGraphExtensionInvalidMigration, kindType, migration.To, kindType)
+		}
+
+		sources[migration.From] = struct{}{}
+	}
+
+	return nil
+}
+
+func (s KindMigrationsInput) covers(kind string) bool {
+	return slices.ContainsFunc(s, func(migration KindMigrationInput) bool {
+		return migration.From == kind
+	})
+}
+
+// ExtensionMigrationsPayload is the JSON shape of the kind migrations in an extension payload
+type ExtensionMigrationsPayload struct {
+	NodeKinds         []KindMigrationPayload `json:"node_kinds"`
+	RelationshipKinds []KindMigrationPayload `json:"relationship_kinds"`
+}
+
+type KindMigrationPayload struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+func (s ExtensionMigrationsPayload) toInput() ExtensionMigrationsInput {
+	var migrations ExtensionMigrationsInput
+
+	for _, migration := range s.NodeKinds {
+		migrations.NodeKinds = append(migrations.NodeKinds, KindMigrationInput{From: migration.From, To: migration.To})
+	}
+
+	for _, migration := range s.RelationshipKinds {
+		migrations.RelationshipKinds = append(migrations.RelationshipKinds, KindMigrationInput{From: migration.From, To: migration.To})
+	}
+
+	return migrations
+}
+
+// GraphExtensionDiff describes the kinds an extension upgrade adds and removes relative to the installed version
+type GraphExtensionDiff struct {
+	AddedNodeKinds           []string
+	RemovedNodeKinds         []string
+	AddedRelationshipKinds   []string
+	RemovedRelationshipKinds []string
+}
+
+// DiffGraphExtension computes the kinds added and removed by upgrading an extension with the installed node and
+// relationship kinds to the given input.
+func DiffGraphExtension(previousNodeKinds, previousRelationshipKinds []string, next GraphExtensionInput) GraphExtensionDiff {
+	var (
+		nextNodeKinds         = make([]string, 0, len(next.NodeKindsInput))
+		nextRelationshipKinds = make([]string, 0, len(next.RelationshipKindsInput))
+	)
+
+	for _, kind := range next.NodeKindsInput {
+		nextNodeKinds = append(nextNodeKinds, kind.Name)
+	}
+
+	for _, kind := range next.RelationshipKindsInput {
+		nextRelationshipKinds = append(nextRelationshipKinds, kind.Name)
+	}
+
+	return GraphExtensionDiff{
+		AddedNodeKinds:           kindsMissingFrom(nextNodeKinds, previousNodeKinds),
+		RemovedNodeKinds:         kindsMissingFrom(previousNodeKinds, nextNodeKinds),
+		AddedRelationshipKinds:   kindsMissingFrom(nextRelationshipKinds, previousRelationshipKinds),
+		RemovedRelationshipKinds: kindsMissingFrom(previousRelationshipKinds, nextRelationshipKinds),
+	}
+}
+
+// kindsMissingFrom returns the kinds in source that are not in other, preserving the order of source
+func kindsMissingFrom(source, other []string) []string {
+	var missing []string
+
+	for _, kind := range source {
+		if !slices.Contains(other, kind) {
+			missing = append(missing, kind)
+		}
+	}
+
+	return missing
+}
+
+// CheckMigrations returns ErrGraphExtensionBreakingChange if the diff removes a kind that the given migrations do not
+// carry forward or drop.
+func (s GraphExtensionDiff) CheckMigrations(migrations ExtensionMigrationsInput) error {
+	for _, kind := range s.RemovedNodeKinds {
+		if !migrations.NodeKinds.covers(kind) {
+			return fmt.Errorf("%w: node kind %s is removed without a declared migration", ErrGraphExtensionBreakingChange, kind)
+		}
+	}
+
+	for _, kind := range s.RemovedRelationshipKinds {
+		if !migrations.RelationshipKinds.covers(kind) {
+			return fmt.Errorf("%w: relationship kind %s is removed without a declared migration", ErrGraphExtensionBreakingChange, kind)
+		}
+	}
+
+	return nil
+}
+
+// GraphSchemaExtensionVersion records an extension definition as it was installed. Every install is numbered with the
+// next sequence of its extension, regardless of the version string it declares. Only the version with the highest
+// sequence that has not been rolled back is active; earlier versions are kept so that an upgrade can be rolled back.
+type GraphSchemaExtensionVersion struct {
+	Serial
+
+	SchemaExtensionId int32
+	Sequence          int32
+	Version           string
+	Payload           json.RawMessage
+}
+
+func (GraphSchemaExtensionVersion) TableName() string {
+	return "schema_extension_versions"
+}
+
+// Input decodes the recorded extension definition
+func (s GraphSchemaExtensionVersion) Input() (GraphExtensionInput, error) {
+	var input GraphExtensionInput
+
+	if err := json.Unmarshal(s.Payload, &input); err != nil {
+		return GraphExtensionInput{}, fmt.Errorf("error decoding graph extension version %s: %w", s.Version, err)
+	}
+
+	return input, nil
+}
+
+// RollbackMigrations returns the migrations that restore graph data from the current extension definition to the
+// previous one. Kinds the current version renamed are renamed back and kinds the current version introduced are
+// dropped. Data dropped by the current version cannot be restored.
+func RollbackMigrations(current, previous GraphExtensionInput) ExtensionMigrationsInput {
+	var (
+		previousNodeKinds         = make([]string, 0, len(previous.NodeKindsInput))
+		previousRelationshipKinds = make([]string, 0, len(previous.RelationshipKindsInput))
+		currentNodeKinds          = make([]string, 0, len(current.NodeKindsInput))
+		currentRelationshipKinds  = make([]string, 0, len(current.RelationshipKindsInput))
+	)
+
+	for _, kind := range previous.NodeKindsInput {
+		previousNodeKinds = append(previousNodeKinds, kind.Name)
+	}
+
+	for _, kind := range previous.RelationshipKindsInput {
+		previousRelationshipKinds = append(previousRelationshipKinds, kind.Name)
+	}
+
+	for _, kind := range current.NodeKindsInput {
+		currentNodeKinds = append(currentNodeKinds, kind.Name)
+	}
+
+	for _, kind := range current.RelationshipKindsInput {
+		currentRelationshipKinds = append(currentRelationshipKinds, kind.Name)
+	}
+
+	return ExtensionMigrationsInput{
+		NodeKinds:         rollbackKindMigrations(kindsMissingFrom(currentNodeKinds, previousNodeKinds), previousNodeKinds, current.Migrations.NodeKinds),
+		RelationshipKinds: rollbackKindMigrations(kindsMissingFrom(currentRelationshipKinds, previousRelationshipKinds), previousRelationshipKinds, current.Migrations.RelationshipKinds),
+	}
+}
+
+func rollbackKindMigrations(removedKinds, previousKinds []string, currentMigrations KindMigrationsInput) KindMigrationsInput {
+	var rollback KindMigrationsInput
+
+	for _, kind := range removedKinds {
+		migration := KindMigrationInput{From: kind}
+
+		for _, currentMigration := range currentMigrations {
+			if currentMigration.To == kind && slices.Contains(previousKinds, currentMigration.From) {
+				migration.To = currentMigration.From
+				break
+			}
+		}
+
+		rollback = append(rollback, migration)
+	}
+
+	return rollback
+}
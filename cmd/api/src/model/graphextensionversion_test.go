@@ -0,0 +1,184 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func baseVersionedExtensionInput() GraphExtensionInput {
+	return GraphExtensionInput{
+		ExtensionInput: baseExtensionInput(),
+		NodeKindsInput: NodesInput{
+			{Name: "AD_Host"},
+			{Name: "AD_Account"},
+		},
+		RelationshipKindsInput: RelationshipsInput{
+			{Name: "AD_LinkedTo"},
+			{Name: "AD_Owns"},
+		},
+	}
+}
+
+func TestGraphExtensionInput_Validate_Migrations(t *testing.T) {
+	tests := []struct {
+		name       string
+		migrations ExtensionMigrationsInput
+		wantErr    string
+	}{
+		{
+			name: "success - rename and drop",
+			migrations: ExtensionMigrationsInput{
+				NodeKinds:         KindMigrationsInput{{From: "AD_Server", To: "AD_Host"}},
+				RelationshipKinds: KindMigrationsInput{{From: "AD_ConnectedTo"}},
+			},
+		},
+		{
+			name: "fail - missing source",
+			migrations: ExtensionMigrationsInput{
+				NodeKinds: KindMigrationsInput{{To: "AD_Host"}},
+			},
+			wantErr: "invalid graph extension kind migration: node kind migration source is required",
+		},
+		{
+			name: "fail - duplicate source",
+			migrations: ExtensionMigrationsInput{
+				NodeKinds: KindMigrationsInput{{From: "AD_Server", To: "AD_Host"}, {From: "AD_Server"}},
+			},
+			wantErr: "invalid graph extension kind migration: duplicate node kind migration for AD_Server",
+		},
+		{
+			name: "fail - source still declared",
+			migrations: ExtensionMigrationsInput{
+				RelationshipKinds: KindMigrationsInput{{From: "AD_Owns", To: "AD_LinkedTo"}},
+			},
+			wantErr: "invalid graph extension kind migration: relationship kind AD_Owns is still declared by the extension and cannot be migrated",
+		},
+		{
+			name: "fail - target not declared as the same kind type",
+			migrations: ExtensionMigrationsInput{
+				RelationshipKinds: KindMigrationsInput{{From: "AD_ConnectedTo", To: "AD_Host"}},
+			},
+			wantErr: "invalid graph extension kind migration: relationship kind migration target AD_Host is not declared as a relationship kind",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := baseVersionedExtensionInput()
+			input.Migrations = tt.migrations
+
+			err := input.Validate()
+			if tt.wantErr != "" {
+				require.ErrorIs(t, err, ErrGraphExtensionInvalidMigration)
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDiffGraphExtension(t *testing.T) {
+	diff := DiffGraphExtension([]string{"AD_Server", "AD_Account"}, []string{"AD_ConnectedTo", "AD_Owns"}, baseVersionedExtensionInput())
+
+	assert.Equal(t, GraphExtensionDiff{
+		AddedNodeKinds:           []string{"AD_Host"},
+		RemovedNodeKinds:         []string{"AD_Server"},
+		AddedRelationshipKinds:   []string{"AD_LinkedTo"},
+		RemovedRelationshipKinds: []string{"AD_ConnectedTo"},
+	}, diff)
+}
+
+func TestGraphExtensionDiff_CheckMigrations(t *testing.T) {
+	diff := GraphExtensionDiff{
+		RemovedNodeKinds:         []string{"AD_Server"},
+		RemovedRelationshipKinds: []string{"AD_ConnectedTo"},
+	}
+
+	t.Run("fail - node kind removed without a migration", func(t *testing.T) {
+		err := diff.CheckMigrations(ExtensionMigrationsInput{})
+		require.ErrorIs(t, err, ErrGraphExtensionBreakingChange)
+		assert.EqualError(t, err, "breaking graph extension change: node kind AD_Server is removed without a declared migration")
+	})
+
+	t.Run("fail - relationship kind removed without a migration", func(t *testing.T) {
+		err := diff.CheckMigrations(ExtensionMigrationsInput{
+			NodeKinds: KindMigrationsInput{{From: "AD_Server", To: "AD_Host"}},
+		})
+		require.ErrorIs(t, err, ErrGraphExtensionBreakingChange)
+		assert.EqualError(t, err, "breaking graph extension change: relationship kind AD_ConnectedTo is removed without a declared migration")
+	})
+
+	t.Run("success - all removed kinds migrated", func(t *testing.T) {
+		require.NoError(t, diff.CheckMigrations(ExtensionMigrationsInput{
+			NodeKinds:         KindMigrationsInput{{From: "AD_Server", To: "AD_Host"}},
+			RelationshipKinds: KindMigrationsInput{{From: "AD_ConnectedTo"}},
+		}))
+	})
+
+	t.Run("success - additive change", func(t *testing.T) {
+		require.NoError(t, GraphExtensionDiff{AddedNodeKinds: []string{"AD_Host"}}.CheckMigrations(ExtensionMigrationsInput{}))
+	})
+}
+
+func TestGraphSchemaExtensionVersion_Input(t *testing.T) {
+	input := baseVersionedExtensionInput()
+	input.Migrations = ExtensionMigrationsInput{NodeKinds: KindMigrationsInput{{From: "AD_Server", To: "AD_Host"}}}
+
+	payload, err := json.Marshal(input)
+	require.NoError(t, err)
+
+	decoded, err := GraphSchemaExtensionVersion{Version: "v1.0.0", Payload: payload}.Input()
+	require.NoError(t, err)
+	assert.Equal(t, input, decoded)
+
+	_, err = GraphSchemaExtensionVersion{Version: "v1.0.0", Payload: json.RawMessage("{")}.Input()
+	assert.ErrorContains(t, err, "error decoding graph extension version v1.0.0")
+}
+
+func TestRollbackMigrations(t *testing.T) {
+	var (
+		previous = GraphExtensionInput{
+			ExtensionInput:         baseExtensionInput(),
+			NodeKindsInput:         NodesInput{{Name: "AD_Server"}, {Name: "AD_Account"}},
+			RelationshipKindsInput: RelationshipsInput{{Name: "AD_ConnectedTo"}, {Name: "AD_Owns"}},
+		}
+		current = baseVersionedExtensionInput()
+	)
+
+	current.Migrations = ExtensionMigrationsInput{
+		NodeKinds:         KindMigrationsInput{{From: "AD_Server", To: "AD_Host"}},
+		RelationshipKinds: KindMigrationsInput{{From: "AD_ConnectedTo"}},
+	}
+
+	migrations := RollbackMigrations(current, previous)
+
+	// The renamed node kind is renamed back while the relationship kind introduced by the current version is dropped
+	assert.Equal(t, ExtensionMigrationsInput{
+		NodeKinds:         KindMigrationsInput{{From: "AD_Host", To: "AD_Server"}},
+		RelationshipKinds: KindMigrationsInput{{From: "AD_LinkedTo"}},
+	}, migrations)
+
+	previous.Migrations = migrations
+	require.NoError(t, previous.Validate())
+	require.NoError(t, DiffGraphExtension([]string{"AD_Host", "AD_Account"}, []string{"AD_LinkedTo", "AD_Owns"}, previous).CheckMigrations(migrations))
+}
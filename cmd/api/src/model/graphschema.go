@@ -480,6 +480,11 @@ type GraphExtensionInput struct {
 	NodeKindsInput            NodesInput
 	EnvironmentsInput         EnvironmentsInput
 	RelationshipFindingsInput RelationshipFindingsInput
+	Migrations                ExtensionMigrationsInput
+
+	// RestoresSequence is the sequence of the recorded version this input reinstalls, as a rollback does. It is zero
+	// for a new install and is not part of the recorded definition.
+	RestoresSequence int32 `json:"-"`
 }
 
 // Validate performs comprehensive validation on a GraphExtensionInput
@@ -591,7 +596,8 @@ func (s GraphExtensionInput) Validate() error {
 		}
 		findings[relationshipFindingInput.Name] = struct{}{}
 	}
-	return nil
+
+	return s.Migrations.validate(nodeKinds, relationshipKinds)
 }
 
 type RelationshipFindingsInput []RelationshipFindingInput
@@ -685,6 +691,7 @@ type GraphExtensionPayload struct {
 	GraphSchemaNodeKinds         []GraphSchemaNodeKindsPayload         `json:"node_kinds"`
 	GraphEnvironments            []EnvironmentPayload                  `json:"environments"`
 	GraphRelationshipFindings    []RelationshipFindingsPayload         `json:"relationship_findings"`
+	Migrations                   ExtensionMigrationsPayload            `json:"migrations"`
 }
 
 type GraphSchemaExtensionPayload struct {
@@ -799,6 +806,7 @@ func (s GraphExtensionPayload) ToGraphExtensionInput() (GraphExtensionInput, err
 			NodeKindsInput:         make(NodesInput, 0),
 			RelationshipKindsInput: make(RelationshipsInput, 0),
 			EnvironmentsInput:      make(EnvironmentsInput, 0),
+			Migrations:             s.Migrations.toInput(),
 		}
 		infoInputs KindInfoInputs
 		err        error
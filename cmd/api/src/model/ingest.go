@@ -37,6 +37,8 @@ type FileType int
 const (
 	FileTypeJson FileType = iota
 	FileTypeZip
+	FileTypeNDJSON
+	FileTypeCSV
 )
 
 func (s FileType) String() string {
@@ -45,6 +47,10 @@ func (s FileType) String() string {
 		return "json"
 	case FileTypeZip:
 		return "zip"
+	case FileTypeNDJSON:
+		return "ndjson"
+	case FileTypeCSV:
+		return "csv"
 	default:
 		return "unknown"
 	}
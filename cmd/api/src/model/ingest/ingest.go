@@ -19,6 +19,7 @@ package ingest
 import (
 	"encoding/json"
 	"errors"
+	"slices"
 
 	"github.com/specterops/bloodhound/packages/go/mediatypes"
 )
@@ -29,7 +30,17 @@ var AllowedZipFileUploadTypes = []string{
 	"application/zip-compressed",   // Not currently available in mediatypes
 }
 
-var AllowedFileUploadTypes = append([]string{mediatypes.ApplicationJson.String()}, AllowedZipFileUploadTypes...)
+// AllowedNDJSONFileUploadTypes accepts OpenGraph payloads with one node or edge per line
+var AllowedNDJSONFileUploadTypes = []string{
+	"application/x-ndjson", // Not currently available in mediatypes
+}
+
+// AllowedCSVFileUploadTypes accepts OpenGraph CSV payloads sent as a form with the CSV file and its header mapping file
+var AllowedCSVFileUploadTypes = []string{
+	mediatypes.MultipartFormData.String(),
+}
+
+var AllowedFileUploadTypes = slices.Concat([]string{mediatypes.ApplicationJson.String()}, AllowedZipFileUploadTypes, AllowedNDJSONFileUploadTypes, AllowedCSVFileUploadTypes)
 
 type OpengraphMetadata struct {
 	SourceKind string `json:"source_kind"`
@@ -61,6 +72,10 @@ const (
 	DataTypeCertTemplate   DataType = "certtemplates"
 	DataTypeAzure          DataType = "azure"
 	DataTypeIssuancePolicy DataType = "issuancepolicies"
+	DataTypeSite           DataType = "sites"
+	DataTypeSubnet         DataType = "subnets"
+	DataTypeDNSZone        DataType = "dnszones"
+	DataTypeDNSNode        DataType = "dnsnodes"
 	DataTypeOpenGraph      DataType = "opengraph"
 )
 
@@ -83,6 +98,10 @@ func AllOriginalIngestDataTypes() []DataType {
 		DataTypeCertTemplate,
 		DataTypeAzure,
 		DataTypeIssuancePolicy,
+		DataTypeSite,
+		DataTypeSubnet,
+		DataTypeDNSZone,
+		DataTypeDNSNode,
 	}
 }
 
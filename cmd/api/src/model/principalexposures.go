@@ -0,0 +1,72 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import "time"
+
+// PrincipalExposure is a daily snapshot of how far the attack paths of a user or computer reach. Impact counts the Tier
+// Zero objects of the environment that the principal has a path to, and Exposure counts the principals of the
+// environment with a path to it. Counts too large to compute exactly are estimated, which is recorded by Estimated.
+type PrincipalExposure struct {
+	EnvironmentID string    `json:"environment_id"`
+	SnapshotDate  time.Time `json:"snapshot_date"`
+	ObjectID      string    `json:"object_id"`
+	Name          string    `json:"name"`
+	Kind          string    `json:"kind"`
+	Impact        int64     `json:"impact"`
+	Exposure      int64     `json:"exposure"`
+	Estimated     bool      `json:"estimated"`
+
+	BigSerial
+}
+
+func (PrincipalExposure) TableName() string {
+	return "principal_exposures"
+}
+
+type PrincipalExposures []PrincipalExposure
+
+func (s PrincipalExposures) IsSortable(column string) bool {
+	switch column {
+	case "snapshot_date",
+		"object_id",
+		"name",
+		"kind",
+		"impact",
+		"exposure",
+		"created_at":
+		return true
+	default:
+		return false
+	}
+}
+
+func (s PrincipalExposures) IsStringColumn(filter string) bool {
+	return filter == "object_id" || filter == "name" || filter == "kind"
+}
+
+func (s PrincipalExposures) ValidFilters() map[string][]FilterOperator {
+	return map[string][]FilterOperator{
+		"snapshot_date": {Equals, GreaterThan, GreaterThanOrEquals, LessThan, LessThanOrEquals, NotEquals},
+		"object_id":     {Equals, NotEquals},
+		"name":          {Equals, NotEquals, ApproximatelyEquals},
+		"kind":          {Equals, NotEquals},
+		"impact":        {Equals, GreaterThan, GreaterThanOrEquals, LessThan, LessThanOrEquals, NotEquals},
+		"exposure":      {Equals, GreaterThan, GreaterThanOrEquals, LessThan, LessThanOrEquals, NotEquals},
+		"estimated":     {Equals, NotEquals},
+	}
+}
@@ -0,0 +1,259 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/specterops/dawgs/cypher/models/cypher"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/query"
+)
+
+var (
+	ErrInvalidPropertySelector = errors.New("invalid property selector")
+
+	propertySelectorPropertyPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]{0,127}$`)
+)
+
+// PropertySelector is the structured form of a SelectorTypeProperty seed. It selects the nodes of a single kind whose
+// properties match every predicate, e.g. the User nodes where admincount is true. The seed value holds the selector
+// encoded as JSON.
+type PropertySelector struct {
+	Kind       string              `json:"kind"`
+	Predicates []PropertyPredicate `json:"predicates"`
+}
+
+// PropertyPredicate compares a node property against a value. Equals and NotEquals accept a string, number, boolean,
+// an array of these to match any of the values, or null to match the absence of the property. The range operators
+// accept numbers and ApproximatelyEquals performs a case-insensitive substring match against a string.
+type PropertyPredicate struct {
+	Property string         `json:"property"`
+	Operator FilterOperator `json:"operator"`
+	Value    any            `json:"value"`
+}
+
+// ParsePropertySelector decodes and validates the value of a SelectorTypeProperty seed.
+func ParsePropertySelector(value string) (PropertySelector, error) {
+	var (
+		selector PropertySelector
+		decoder  = json.NewDecoder(strings.NewReader(value))
+	)
+
+	decoder.DisallowUnknownFields()
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&selector); err != nil {
+		return selector, fmt.Errorf("%w: %v", ErrInvalidPropertySelector, err)
+	}
+
+	return selector, selector.Validate()
+}
+
+func (s PropertySelector) Validate() error {
+	if s.Kind == "" {
+		return fmt.Errorf("%w: kind is required", ErrInvalidPropertySelector)
+	} else if len(s.Predicates) == 0 {
+		return fmt.Errorf("%w: at least one predicate is required", ErrInvalidPropertySelector)
+	}
+
+	for _, predicate := range s.Predicates {
+		if err := predicate.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Criteria compiles the selector into a Cypher expression that matches the selected nodes.
+func (s PropertySelector) Criteria() graph.Criteria {
+	expressions := []cypher.Expression{query.Kind(query.Node(), graph.StringKind(s.Kind))}
+
+	for _, predicate := range s.Predicates {
+		expressions = append(expressions, predicate.Criteria())
+	}
+
+	return cypher.NewConjunction(expressions...)
+}
+
+func (s PropertyPredicate) Validate() error {
+	if !propertySelectorPropertyPattern.MatchString(s.Property) {
+		return fmt.Errorf("%w: invalid property name %q", ErrInvalidPropertySelector, s.Property)
+	}
+
+	switch s.Operator {
+	case Equals, NotEquals:
+		if values, isList := s.Value.([]any); isList {
+			if len(values) == 0 {
+				return fmt.Errorf("%w: property %s must be compared against at least one value", ErrInvalidPropertySelector, s.Property)
+			}
+
+			for _, value := range values {
+				if value == nil || !isPropertySelectorScalar(value) {
+					return fmt.Errorf("%w: property %s may only be compared against strings, numbers and booleans", ErrInvalidPropertySelector, s.Property)
+				}
+			}
+		} else if s.Value != nil && !isPropertySelectorScalar(s.Value) {
+			return fmt.Errorf("%w: property %s may only be compared against strings, numbers and booleans", ErrInvalidPropertySelector, s.Property)
+		}
+
+	case GreaterThan, GreaterThanOrEquals, LessThan, LessThanOrEquals:
+		if !isPropertySelectorNumber(s.Value) {
+			return fmt.Errorf("%w: operator %s on property %s requires a number", ErrInvalidPropertySelector, s.Operator, s.Property)
+		}
+
+	case ApproximatelyEquals:
+		if value, isString := s.Value.(string); !isString || value == "" {
+			return fmt.Errorf("%w: operator %s on property %s requires a non-empty string", ErrInvalidPropertySelector, s.Operator, s.Property)
+		}
+
+	default:
+		return fmt.Errorf("%w: unsupported operator %q on property %s", ErrInvalidPropertySelector, s.Operator, s.Property)
+	}
+
+	return nil
+}
+
+// Criteria compiles the predicate into a Cypher comparison against the node property. The predicate must be valid.
+func (s PropertyPredicate) Criteria() cypher.Expression {
+	propertyRef := query.NodeProperty(s.Property)
+
+	switch s.Operator {
+	case Equals:
+		if s.Value == nil {
+			return cypher.NewComparison(propertyRef, cypher.OperatorIs, cypher.NewLiteral(nil, true))
+		} else if values, isList := s.Value.([]any); isList {
+			return cypher.NewComparison(propertyRef, cypher.OperatorIn, query.Parameter(propertySelectorValues(values)))
+		}
+
+		return cypher.NewComparison(propertyRef, cypher.OperatorEquals, query.Parameter(propertySelectorValue(s.Value)))
+
+	case NotEquals:
+		if s.Value == nil {
+			return cypher.NewComparison(propertyRef, cypher.OperatorIsNot, cypher.NewLiteral(nil, true))
+		} else if values, isList := s.Value.([]any); isList {
+			return negatePropertyPredicate(cypher.NewComparison(propertyRef, cypher.OperatorIn, query.Parameter(propertySelectorValues(values))))
+		}
+
+		return negatePropertyPredicate(cypher.NewComparison(propertyRef, cypher.OperatorEquals, query.Parameter(propertySelectorValue(s.Value))))
+
+	case GreaterThan:
+		return cypher.NewComparison(propertyRef, cypher.OperatorGreaterThan, query.Parameter(propertySelectorValue(s.Value)))
+
+	case GreaterThanOrEquals:
+		return cypher.NewComparison(propertyRef, cypher.OperatorGreaterThanOrEqualTo, query.Parameter(propertySelectorValue(s.Value)))
+
+	case LessThan:
+		return cypher.NewComparison(propertyRef, cypher.OperatorLessThan, query.Parameter(propertySelectorValue(s.Value)))
+
+	case LessThanOrEquals:
+		return cypher.NewComparison(propertyRef, cypher.OperatorLessThanOrEqualTo, query.Parameter(propertySelectorValue(s.Value)))
+
+	case ApproximatelyEquals:
+		return cypher.NewComparison(
+			cypher.NewSimpleFunctionInvocation(cypher.ToLowerFunction, propertyRef),
+			cypher.OperatorContains,
+			query.Parameter(strings.ToLower(s.Value.(string))),
+		)
+
+	default:
+		return nil
+	}
+}
+
+// negatePropertyPredicate wraps a comparison in a parenthesized negation. Nodes without the property do not match the
+// negated comparison, in line with Cypher's null semantics.
+func negatePropertyPredicate(comparison *cypher.Comparison) *cypher.Negation {
+	return &cypher.Negation{Expression: &cypher.Parenthetical{Expression: comparison}}
+}
+
+func isPropertySelectorScalar(value any) bool {
+	switch value.(type) {
+	case string, bool:
+		return true
+	default:
+		return isPropertySelectorNumber(value)
+	}
+}
+
+func isPropertySelectorNumber(value any) bool {
+	switch value.(type) {
+	case json.Number, float64, int, int64:
+		return true
+	default:
+		return false
+	}
+}
+
+// propertySelectorValue converts decoded JSON numbers to int64 when they are integral and float64 otherwise so that
+// they compare equal to the numeric properties written by ingest.
+func propertySelectorValue(value any) any {
+	if number, isNumber := value.(json.Number); !isNumber {
+		return value
+	} else if intValue, err := number.Int64(); err == nil {
+		return intValue
+	} else if floatValue, err := number.Float64(); err == nil {
+		return floatValue
+	} else {
+		return value
+	}
+}
+
+// propertySelectorValues converts a list of decoded JSON values into a typed slice when every value has the same type
+// so that the list is translated into a typed array.
+func propertySelectorValues(values []any) any {
+	var (
+		stringValues = make([]string, 0, len(values))
+		intValues    = make([]int64, 0, len(values))
+		floatValues  = make([]float64, 0, len(values))
+		boolValues   = make([]bool, 0, len(values))
+		anyValues    = make([]any, 0, len(values))
+	)
+
+	for _, value := range values {
+		switch typedValue := propertySelectorValue(value).(type) {
+		case string:
+			stringValues = append(stringValues, typedValue)
+		case int64:
+			intValues = append(intValues, typedValue)
+		case float64:
+			floatValues = append(floatValues, typedValue)
+		case bool:
+			boolValues = append(boolValues, typedValue)
+		}
+
+		anyValues = append(anyValues, propertySelectorValue(value))
+	}
+
+	switch len(values) {
+	case len(stringValues):
+		return stringValues
+	case len(intValues):
+		return intValues
+	case len(floatValues):
+		return floatValues
+	case len(boolValues):
+		return boolValues
+	default:
+		return anyValues
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model_test
+
+import (
+	"testing"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/query"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePropertySelector(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{
+			name:  "equals boolean and string",
+			input: `{"kind":"User","predicates":[{"property":"admincount","operator":"eq","value":true},{"property":"domainsid","operator":"eq","value":"S-1-5-21-1"}]}`,
+		},
+		{
+			name:  "equals list",
+			input: `{"kind":"User","predicates":[{"property":"domainsid","operator":"eq","value":["S-1-5-21-1","S-1-5-21-2"]}]}`,
+		},
+		{
+			name:  "missing property",
+			input: `{"kind":"User","predicates":[{"property":"description","operator":"eq","value":null}]}`,
+		},
+		{
+			name:  "range",
+			input: `{"kind":"Computer","predicates":[{"property":"lastlogontimestamp","operator":"gte","value":1700000000}]}`,
+		},
+		{
+			name:  "contains",
+			input: `{"kind":"Computer","predicates":[{"property":"operatingsystem","operator":"~eq","value":"server"}]}`,
+		},
+		{
+			name:    "malformed json",
+			input:   `{"kind":`,
+			wantErr: "invalid property selector",
+		},
+		{
+			name:    "unknown field",
+			input:   `{"kind":"User","cypher":"MATCH (n) RETURN n","predicates":[{"property":"admincount","operator":"eq","value":true}]}`,
+			wantErr: "unknown field",
+		},
+		{
+			name:    "missing kind",
+			input:   `{"predicates":[{"property":"admincount","operator":"eq","value":true}]}`,
+			wantErr: "kind is required",
+		},
+		{
+			name:    "missing predicates",
+			input:   `{"kind":"User"}`,
+			wantErr: "at least one predicate is required",
+		},
+		{
+			name:    "invalid property name",
+			input:   `{"kind":"User","predicates":[{"property":"n.admincount","operator":"eq","value":true}]}`,
+			wantErr: "invalid property name",
+		},
+		{
+			name:    "unsupported operator",
+			input:   `{"kind":"User","predicates":[{"property":"admincount","operator":"like","value":true}]}`,
+			wantErr: "unsupported operator",
+		},
+		{
+			name:    "range requires a number",
+			input:   `{"kind":"User","predicates":[{"property":"pwdlastset","operator":"gt","value":"yesterday"}]}`,
+			wantErr: "requires a number",
+		},
+		{
+			name:    "contains requires a string",
+			input:   `{"kind":"User","predicates":[{"property":"name","operator":"~eq","value":1}]}`,
+			wantErr: "requires a non-empty string",
+		},
+		{
+			name:    "empty list",
+			input:   `{"kind":"User","predicates":[{"property":"domainsid","operator":"eq","value":[]}]}`,
+			wantErr: "at least one value",
+		},
+		{
+			name:    "nested value",
+			input:   `{"kind":"User","predicates":[{"property":"domainsid","operator":"eq","value":{"a":1}}]}`,
+			wantErr: "may only be compared against",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := model.ParsePropertySelector(testCase.input)
+
+			if testCase.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, model.ErrInvalidPropertySelector)
+				require.ErrorContains(t, err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestPropertySelector_Criteria(t *testing.T) {
+	selector, err := model.ParsePropertySelector(`{"kind":"User","predicates":[
+		{"property":"admincount","operator":"eq","value":true},
+		{"property":"domainsid","operator":"eq","value":["S-1-5-21-1","S-1-5-21-2"]},
+		{"property":"pwdlastset","operator":"lt","value":1700000000},
+		{"property":"description","operator":"neq","value":null},
+		{"property":"name","operator":"~eq","value":"svc"}
+	]}`)
+	require.NoError(t, err)
+
+	require.Equal(t, query.And(
+		query.Kind(query.Node(), graph.StringKind("User")),
+		query.Equals(query.NodeProperty("admincount"), true),
+		query.In(query.NodeProperty("domainsid"), []string{"S-1-5-21-1", "S-1-5-21-2"}),
+		query.LessThan(query.NodeProperty("pwdlastset"), int64(1700000000)),
+		query.Exists(query.NodeProperty("description")),
+		query.CaseInsensitiveStringContains(query.NodeProperty("name"), "svc"),
+	), selector.Criteria())
+}
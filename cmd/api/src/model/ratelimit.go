@@ -0,0 +1,30 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import "time"
+
+// RateLimitCounter is a fixed window request counter shared by every API instance connected to the same database
+type RateLimitCounter struct {
+	Key       string    `json:"key" gorm:"primaryKey"`
+	Hits      int64     `json:"hits"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (RateLimitCounter) TableName() string {
+	return "rate_limit_counters"
+}
@@ -26,6 +26,7 @@ import (
 	"log/slog"
 	"net/http"
 	"net/url"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -41,6 +42,8 @@ import (
 	"github.com/specterops/bloodhound/cmd/api/src/utils"
 	"github.com/specterops/bloodhound/packages/go/analysis"
 	adAnalysis "github.com/specterops/bloodhound/packages/go/analysis/ad"
+	"github.com/specterops/bloodhound/packages/go/analysis/exposure"
+	"github.com/specterops/bloodhound/packages/go/analysis/pathfinding"
 	"github.com/specterops/bloodhound/packages/go/bhlog/attr"
 	"github.com/specterops/bloodhound/packages/go/bhlog/measure"
 	"github.com/specterops/bloodhound/packages/go/cache"
@@ -69,9 +72,10 @@ const (
 )
 
 var (
-	ErrUnsupportedDataType   = errors.New("unsupported result type for this query")
-	ErrGraphUnsupported      = errors.New("type 'graph' is not supported for this endpoint")
-	ErrCypherQueryTooComplex = errors.New("cypher query is too complex and is likely to result in poor or unstable database performance")
+	ErrUnsupportedDataType      = errors.New("unsupported result type for this query")
+	ErrGraphUnsupported         = errors.New("type 'graph' is not supported for this endpoint")
+	ErrCypherQueryTooComplex    = errors.New("cypher query is too complex and is likely to result in poor or unstable database performance")
+	ErrWhatIfUnmatchedExclusion = errors.New("no traversable relationship matches the exclusion")
 )
 
 type ParallelPathDelegate = func(ctx context.Context, db graph.Database, node *graph.Node) (graph.PathSet, error)
@@ -140,6 +144,8 @@ type Graph interface {
 	GetAssetGroupNodes(ctx context.Context, assetGroupTag string, isSystemGroup bool) (graph.NodeSet, error)
 	GetAllShortestPaths(ctx context.Context, startNodeID string, endNodeID string, filter graph.Criteria) (graph.PathSet, error)
 	GetAllShortestPathsWithOpenGraph(ctx context.Context, startNodeID string, endNodeID string, filter graph.Criteria) (graph.PathSet, error)
+	GetKShortestPaths(ctx context.Context, startNodeID string, endNodeID string, filter graph.Criteria, costs pathfinding.CostTable, k int, includeOpenGraph bool) ([]pathfinding.WeightedPath, error)
+	GetWhatIfPaths(ctx context.Context, startNodeID string, endNodeID string, filter graph.Criteria, costs pathfinding.CostTable, k int, includeOpenGraph bool, changes WhatIfChanges) (WhatIfPaths, error)
 	SearchNodesByNameOrObjectId(ctx context.Context, nodeKinds graph.Kinds, nameOrObjectIdQuery string, skip int, limit int, useRawObjectID bool) ([]*graph.Node, error)
 	SearchByNameOrObjectID(ctx context.Context, includeOpenGraphNodes bool, useRawObjectID bool, searchValue string, searchType string) (graph.NodeSet, error)
 	GetADEntityQueryResult(ctx context.Context, primaryNodeKinds graphschema.PrimaryDisplayKinds, params EntityQueryParameters, cacheEnabled bool) (any, int, error)
@@ -291,6 +297,184 @@ func (s *GraphQuery) GetAllShortestPathsWithOpenGraph(ctx context.Context, start
 	return s.getAllShortestPathsInternal(ctx, startNodeID, endNodeID, filter, analysis.FetchNodeByObjectIDIncludeOpenGraph)
 }
 
+// GetKShortestPaths returns up to k loopless paths between two nodes ordered by their total cost under the given cost
+// table. Only relationships matching filter are traversed and the search is bounded by the default search limits.
+func (s *GraphQuery) GetKShortestPaths(ctx context.Context, startNodeID string, endNodeID string, filter graph.Criteria, costs pathfinding.CostTable, k int, includeOpenGraph bool) ([]pathfinding.WeightedPath, error) {
+	defer measure.ContextMeasureWithThreshold(ctx, slog.LevelInfo, "GetKShortestPaths")()
+
+	var (
+		nodeFetcher = analysis.FetchNodeByObjectID
+		paths       []pathfinding.WeightedPath
+	)
+
+	if includeOpenGraph {
+		nodeFetcher = analysis.FetchNodeByObjectIDIncludeOpenGraph
+	}
+
+	return paths, s.Graph.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		if startNode, err := nodeFetcher(tx, startNodeID); err != nil {
+			return fmt.Errorf("fetching node %s: %w", startNodeID, err)
+		} else if endNode, err := nodeFetcher(tx, endNodeID); err != nil {
+			return fmt.Errorf("fetching node %s: %w", endNodeID, err)
+		} else {
+			paths, err = pathfinding.KShortestPaths(ctx, pathfinding.NewTransactionExpander(tx, filter), costs, startNode, endNode, k, pathfinding.DefaultSearchLimits())
+			return err
+		}
+	})
+}
+
+// WhatIfRelationship identifies a relationship by the object IDs of the nodes it joins and its kind
+type WhatIfRelationship struct {
+	StartNodeID string
+	EndNodeID   string
+	Kind        graph.Kind
+}
+
+// WhatIfChanges lists the relationships to hide from, and the synthetic relationships to add to, a what-if search
+type WhatIfChanges struct {
+	Exclude []WhatIfRelationship
+	Include []WhatIfRelationship
+}
+
+// WhatIfExposure counts the principals with a path to the end node of a what-if search before and after applying
+// WhatIfChanges
+type WhatIfExposure struct {
+	Before int
+	After  int
+}
+
+// WhatIfPaths holds the paths found before and after applying WhatIfChanges. Synthetic holds a single relationship
+// path for each relationship created for WhatIfChanges.Include, any of which may appear in After. Exposure is nil when
+// counting the principals reaching the end node would exceed the search limits left after the path searches.
+type WhatIfPaths struct {
+	Before    []pathfinding.WeightedPath
+	After     []pathfinding.WeightedPath
+	Synthetic []graph.Path
+	Exposure  *WhatIfExposure
+}
+
+// countPrincipals returns the number of nodes that are scored as principals
+func countPrincipals(nodes graph.NodeSet) int {
+	return nodes.ContainingNodeKinds(exposure.PrincipalKinds...).Len()
+}
+
+// GetWhatIfPaths returns up to k loopless paths between two nodes as they exist in the graph, and again with the
+// given changes applied, along with the number of principals that reach the end node in each case. Changes are
+// layered over the graph for the duration of the search and are never written. Synthetic relationships are traversed
+// regardless of filter. Every search shares a single set of the default search limits, and an exclusion that matches
+// no relationship traversable under filter fails with ErrWhatIfUnmatchedExclusion.
+func (s *GraphQuery) GetWhatIfPaths(ctx context.Context, startNodeID string, endNodeID string, filter graph.Criteria, costs pathfinding.CostTable, k int, includeOpenGraph bool, changes WhatIfChanges) (WhatIfPaths, error) {
+	defer measure.ContextMeasureWithThreshold(ctx, slog.LevelInfo, "GetWhatIfPaths")()
+
+	var (
+		nodeFetcher = analysis.FetchNodeByObjectID
+		result      WhatIfPaths
+	)
+
+	if includeOpenGraph {
+		nodeFetcher = analysis.FetchNodeByObjectIDIncludeOpenGraph
+	}
+
+	return result, s.Graph.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		var (
+			fetchedNodes = map[string]*graph.Node{}
+			fetchNode    = func(objectID string) (*graph.Node, error) {
+				if node, fetched := fetchedNodes[objectID]; fetched {
+					return node, nil
+				} else if node, err := nodeFetcher(tx, objectID); err != nil {
+					return nil, fmt.Errorf("fetching node %s: %w", objectID, err)
+				} else {
+					fetchedNodes[objectID] = node
+					return node, nil
+				}
+			}
+		)
+
+		startNode, err := fetchNode(startNodeID)
+		if err != nil {
+			return err
+		}
+
+		endNode, err := fetchNode(endNodeID)
+		if err != nil {
+			return err
+		}
+
+		// Both searches share the expander so that each node is only fetched once
+		var (
+			search   = pathfinding.NewLimitedSearch(pathfinding.DefaultSearchLimits())
+			expander = pathfinding.NewTransactionExpander(tx, filter)
+			overlay  = pathfinding.NewOverlay(expander)
+		)
+
+		for _, relationship := range changes.Exclude {
+			if relationshipStart, err := fetchNode(relationship.StartNodeID); err != nil {
+				return err
+			} else if relationshipEnd, err := fetchNode(relationship.EndNodeID); err != nil {
+				return err
+			} else if edges, err := expander.Expand(ctx, relationshipStart); err != nil {
+				return err
+			} else {
+				ref := pathfinding.RelationshipRef{
+					StartID: relationshipStart.ID,
+					EndID:   relationshipEnd.ID,
+					Kind:    relationship.Kind,
+				}
+
+				if !slices.ContainsFunc(edges, func(edge pathfinding.Edge) bool {
+					return edge.Relationship.EndID == ref.EndID && edge.Relationship.Kind.Is(ref.Kind)
+				}) {
+					return fmt.Errorf("%w: %s from %s to %s", ErrWhatIfUnmatchedExclusion, relationship.Kind, relationship.StartNodeID, relationship.EndNodeID)
+				}
+
+				overlay.Exclude(ref)
+			}
+		}
+
+		for _, relationship := range changes.Include {
+			if relationshipStart, err := fetchNode(relationship.StartNodeID); err != nil {
+				return err
+			} else if relationshipEnd, err := fetchNode(relationship.EndNodeID); err != nil {
+				return err
+			} else {
+				result.Synthetic = append(result.Synthetic, graph.Path{
+					Nodes: []*graph.Node{relationshipStart, relationshipEnd},
+					Edges: []*graph.Relationship{overlay.Include(relationshipStart, relationshipEnd, relationship.Kind)},
+				})
+			}
+		}
+
+		if result.Before, err = search.KShortestPaths(ctx, expander, costs, startNode, endNode, k); err != nil {
+			return err
+		} else if result.After, err = search.KShortestPaths(ctx, overlay, costs, startNode, endNode, k); err != nil {
+			return err
+		}
+
+		// The exposure delta is reported on a best effort basis from whatever limits the path searches left over
+		var (
+			inbound        = pathfinding.NewInboundTransactionExpander(tx, filter)
+			inboundOverlay = overlay.Reverse(inbound)
+		)
+
+		if reachingBefore, err := search.Reach(ctx, inbound, endNode); errors.Is(err, pathfinding.ErrSearchLimitExceeded) {
+			return nil
+		} else if err != nil {
+			return err
+		} else if reachingAfter, err := search.Reach(ctx, inboundOverlay, endNode); errors.Is(err, pathfinding.ErrSearchLimitExceeded) {
+			return nil
+		} else if err != nil {
+			return err
+		} else {
+			result.Exposure = &WhatIfExposure{
+				Before: countPrincipals(reachingBefore),
+				After:  countPrincipals(reachingAfter),
+			}
+
+			return nil
+		}
+	})
+}
+
 // the following negation clause matches nodes that have both ADLocalGroup and Group labels, but excludes nodes that only have the ADLocalGroup label.
 // equivalent cypher: MATCH (n) WHERE NOT (n:ADLocalGroup AND NOT n:Group)
 var groupFilter = query.Not(
@@ -32,6 +32,7 @@ import (
 	database "github.com/specterops/bloodhound/cmd/api/src/database"
 	model "github.com/specterops/bloodhound/cmd/api/src/model"
 	queries "github.com/specterops/bloodhound/cmd/api/src/queries"
+	pathfinding "github.com/specterops/bloodhound/packages/go/analysis/pathfinding"
 	graphschema "github.com/specterops/bloodhound/packages/go/graphschema"
 	graph "github.com/specterops/dawgs/graph"
 	query "github.com/specterops/dawgs/query"
@@ -306,6 +307,21 @@ func (mr *MockGraphMockRecorder) GetFilteredAndSortedNodesPaginated(sortItems, f
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilteredAndSortedNodesPaginated", reflect.TypeOf((*MockGraph)(nil).GetFilteredAndSortedNodesPaginated), sortItems, filterCriteria, offset, limit)
 }
 
+// GetKShortestPaths mocks base method.
+func (m *MockGraph) GetKShortestPaths(ctx context.Context, startNodeID, endNodeID string, filter graph.Criteria, costs pathfinding.CostTable, k int, includeOpenGraph bool) ([]pathfinding.WeightedPath, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKShortestPaths", ctx, startNodeID, endNodeID, filter, costs, k, includeOpenGraph)
+	ret0, _ := ret[0].([]pathfinding.WeightedPath)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKShortestPaths indicates an expected call of GetKShortestPaths.
+func (mr *MockGraphMockRecorder) GetKShortestPaths(ctx, startNodeID, endNodeID, filter, costs, k, includeOpenGraph any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKShortestPaths", reflect.TypeOf((*MockGraph)(nil).GetKShortestPaths), ctx, startNodeID, endNodeID, filter, costs, k, includeOpenGraph)
+}
+
 // GetNodesByKind mocks base method.
 func (m *MockGraph) GetNodesByKind(ctx context.Context, kinds ...graph.Kind) (graph.NodeSet, error) {
 	m.ctrl.T.Helper()
@@ -346,6 +362,21 @@ func (mr *MockGraphMockRecorder) GetPrimaryNodeKindCounts(ctx, primaryDisplayKin
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrimaryNodeKindCounts", reflect.TypeOf((*MockGraph)(nil).GetPrimaryNodeKindCounts), varargs...)
 }
 
+// GetWhatIfPaths mocks base method.
+func (m *MockGraph) GetWhatIfPaths(ctx context.Context, startNodeID, endNodeID string, filter graph.Criteria, costs pathfinding.CostTable, k int, includeOpenGraph bool, changes queries.WhatIfChanges) (queries.WhatIfPaths, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWhatIfPaths", ctx, startNodeID, endNodeID, filter, costs, k, includeOpenGraph, changes)
+	ret0, _ := ret[0].(queries.WhatIfPaths)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWhatIfPaths indicates an expected call of GetWhatIfPaths.
+func (mr *MockGraphMockRecorder) GetWhatIfPaths(ctx, startNodeID, endNodeID, filter, costs, k, includeOpenGraph, changes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWhatIfPaths", reflect.TypeOf((*MockGraph)(nil).GetWhatIfPaths), ctx, startNodeID, endNodeID, filter, costs, k, includeOpenGraph, changes)
+}
+
 // PrepareCypherQuery mocks base method.
 func (m *MockGraph) PrepareCypherQuery(rawCypher string, queryComplexityLimit int64) (queries.PreparedQuery, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,208 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package chokepoint saves the choke points of the attack paths into the Tier Zero members of each environment
+package chokepoint
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/gofrs/uuid"
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
+	"github.com/specterops/bloodhound/packages/go/analysis/chokepoint"
+	"github.com/specterops/bloodhound/packages/go/analysis/environment"
+	"github.com/specterops/bloodhound/packages/go/bhlog/attr"
+	"github.com/specterops/bloodhound/packages/go/bhlog/measure"
+	"github.com/specterops/bloodhound/packages/go/graphschema"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/ops"
+	"github.com/specterops/dawgs/query"
+)
+
+// MaximumChokePoints bounds the number of choke points saved for each environment
+const MaximumChokePoints = 250
+
+func nodeIdentity(node *graph.Node) (string, string) {
+	// Nodes without an objectid or name are still ranked; they are saved with empty identifiers
+	objectID, _ := node.Properties.Get(common.ObjectID.String()).String()
+	name, _ := node.Properties.Get(common.Name.String()).String()
+
+	return objectID, name
+}
+
+// fetchIdentities sets the properties of the loaded nodes that may be named by the saved choke points. The first
+// MaximumChokePoints choke points are drawn from the first MaximumChokePoints nodes and relationships of the result,
+// since both are ordered the same way as the merged ranking.
+func fetchIdentities(ctx context.Context, graphDB graph.Database, loaded *chokepoint.Graph, result chokepoint.Result) error {
+	var nodeIDs []graph.ID
+
+	for _, score := range result.Nodes[:min(len(result.Nodes), MaximumChokePoints)] {
+		nodeIDs = append(nodeIDs, score.Node.ID)
+	}
+
+	for _, score := range result.Relationships[:min(len(result.Relationships), MaximumChokePoints)] {
+		nodeIDs = append(nodeIDs, score.Relationship.StartID, score.Relationship.EndID)
+	}
+
+	if len(nodeIDs) == 0 {
+		return nil
+	}
+
+	return graphDB.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		if nodes, err := ops.FetchNodes(tx.Nodes().Filter(query.InIDs(query.NodeID(), nodeIDs...))); err != nil {
+			return err
+		} else {
+			for _, node := range nodes {
+				if loadedNode, found := loaded.Node(node.ID); found {
+					loadedNode.Properties = node.Properties
+				}
+			}
+
+			return nil
+		}
+	})
+}
+
+// rankChokePoints merges the node and relationship choke points of an analysis into a single ranking and keeps the
+// first MaximumChokePoints of them
+func rankChokePoints(runID, environmentID string, loaded *chokepoint.Graph, result chokepoint.Result) model.ChokePoints {
+	chokePoints := make(model.ChokePoints, 0, len(result.Nodes)+len(result.Relationships))
+
+	for _, score := range result.Nodes {
+		objectID, name := nodeIdentity(score.Node)
+
+		chokePoints = append(chokePoints, model.ChokePoint{
+			Type:              model.ChokePointTypeNode,
+			Kind:              graphschema.PrimaryDisplayKind(nil, score.Node.Kinds).String(),
+			ObjectID:          objectID,
+			Name:              name,
+			PrincipalsRemoved: int64(score.PrincipalsRemoved),
+			Betweenness:       score.Betweenness,
+		})
+	}
+
+	for _, score := range result.Relationships {
+		chokePoint := model.ChokePoint{
+			Type:              model.ChokePointTypeRelationship,
+			Kind:              score.Relationship.Kind.String(),
+			PrincipalsRemoved: int64(score.PrincipalsRemoved),
+			Betweenness:       score.Betweenness,
+		}
+
+		if start, found := loaded.Node(score.Relationship.StartID); found {
+			chokePoint.StartObjectID, chokePoint.StartName = nodeIdentity(start)
+		}
+
+		if end, found := loaded.Node(score.Relationship.EndID); found {
+			chokePoint.EndObjectID, chokePoint.EndName = nodeIdentity(end)
+		}
+
+		chokePoints = append(chokePoints, chokePoint)
+	}
+
+	// Stable so that nodes, already ordered among themselves, rank ahead of relationships with the same scores
+	slices.SortStableFunc(chokePoints, func(a, b model.ChokePoint) int {
+		if byRemoved := cmp.Compare(b.PrincipalsRemoved, a.PrincipalsRemoved); byRemoved != 0 {
+			return byRemoved
+		}
+
+		return cmp.Compare(b.Betweenness, a.Betweenness)
+	})
+
+	if len(chokePoints) > MaximumChokePoints {
+		chokePoints = chokePoints[:MaximumChokePoints]
+	}
+
+	for idx := range chokePoints {
+		chokePoints[idx].RunID = runID
+		chokePoints[idx].EnvironmentID = environmentID
+		chokePoints[idx].Rank = int32(idx + 1)
+		chokePoints[idx].Principals = int64(result.Principals)
+	}
+
+	return chokePoints
+}
+
+// SaveChokePoints scores the choke points of the attack paths into the Tier Zero members of every collected domain
+// and tenant and replaces the saved choke points of each of them
+func SaveChokePoints(ctx context.Context, db database.Database, graphDB graph.Database) error {
+	defer measure.ContextLogAndMeasure(
+		ctx,
+		slog.LevelInfo,
+		"Attack Path Choke Point Analysis",
+		attr.Namespace("analysis"),
+		attr.Function("SaveChokePoints"),
+		attr.Scope("process"),
+	)()
+
+	var (
+		traversalKinds = append(ad.PathfindingRelationships(), azure.PathfindingRelationships()...)
+		runID          string
+	)
+
+	if newUUID, err := uuid.NewV4(); err != nil {
+		return fmt.Errorf("could not generate new UUID: %w", err)
+	} else {
+		runID = newUUID.String()
+	}
+
+	environments, err := environment.Fetch(ctx, graphDB, appcfg.GetTieringEnabled(ctx, db))
+	if err != nil {
+		return err
+	}
+
+	for _, nextEnvironment := range environments {
+		var chokePoints model.ChokePoints
+
+		if nextEnvironment.TierZero.Len() > 0 {
+			if loaded, err := chokepoint.Load(ctx, graphDB, nextEnvironment.TierZero, traversalKinds); errors.Is(err, chokepoint.ErrGraphTooLarge) {
+				// Choke points of a previous run no longer describe the graph, so the environment is saved without any
+				slog.WarnContext(
+					ctx,
+					"Skipping choke point analysis of environment",
+					slog.String("environment_id", nextEnvironment.ObjectID),
+					attr.Error(err),
+				)
+			} else if err != nil {
+				return fmt.Errorf("loading attack paths into environment %s: %w", nextEnvironment.ObjectID, err)
+			} else {
+				result := loaded.Analyze()
+
+				if err := fetchIdentities(ctx, graphDB, loaded, result); err != nil {
+					return fmt.Errorf("fetching choke points of environment %s: %w", nextEnvironment.ObjectID, err)
+				}
+
+				chokePoints = rankChokePoints(runID, nextEnvironment.ObjectID, loaded, result)
+			}
+		}
+
+		if err := db.ReplaceChokePoints(ctx, nextEnvironment.ObjectID, chokePoints); err != nil {
+			return fmt.Errorf("saving choke points of environment %s: %w", nextEnvironment.ObjectID, err)
+		}
+	}
+
+	return nil
+}
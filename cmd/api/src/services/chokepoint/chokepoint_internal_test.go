@@ -0,0 +1,89 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package chokepoint
+
+import (
+	"testing"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/packages/go/analysis/chokepoint"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testNode(id graph.ID, objectID, name string, kinds ...graph.Kind) *graph.Node {
+	return graph.NewNode(id, graph.NewProperties().Set(common.ObjectID.String(), objectID).Set(common.Name.String(), name), kinds...)
+}
+
+func TestRankChokePoints(t *testing.T) {
+	var (
+		domainAdmins = testNode(1, "S-1-5-21-1-512", "DOMAIN ADMINS@TESTLAB.LOCAL", ad.Entity, ad.Group)
+		helpdesk     = testNode(2, "S-1-5-21-1-1104", "HELPDESK@TESTLAB.LOCAL", ad.Entity, ad.Group)
+		alice        = testNode(3, "S-1-5-21-1-1105", "ALICE@TESTLAB.LOCAL", ad.Entity, ad.User)
+		bob          = testNode(4, "S-1-5-21-1-1106", "BOB@TESTLAB.LOCAL", ad.Entity, ad.User)
+		loaded       = chokepoint.NewGraph(graph.NewNodeSet(domainAdmins))
+	)
+
+	loaded.Relate(helpdesk, &graph.Relationship{ID: 10, StartID: helpdesk.ID, EndID: domainAdmins.ID, Kind: ad.GenericAll})
+	loaded.Relate(alice, &graph.Relationship{ID: 11, StartID: alice.ID, EndID: helpdesk.ID, Kind: ad.MemberOf})
+	loaded.Relate(bob, &graph.Relationship{ID: 12, StartID: bob.ID, EndID: helpdesk.ID, Kind: ad.MemberOf})
+
+	chokePoints := rankChokePoints("run", "S-1-5-21-1", loaded, loaded.Analyze())
+	require.Len(t, chokePoints, 4)
+
+	// Removing the GenericAll relationship cuts off every principal, while removing the group spares the group itself
+	assert.Equal(t, model.ChokePoint{
+		RunID:             "run",
+		EnvironmentID:     "S-1-5-21-1",
+		Rank:              1,
+		Type:              model.ChokePointTypeRelationship,
+		Kind:              ad.GenericAll.String(),
+		StartObjectID:     "S-1-5-21-1-1104",
+		StartName:         "HELPDESK@TESTLAB.LOCAL",
+		EndObjectID:       "S-1-5-21-1-512",
+		EndName:           "DOMAIN ADMINS@TESTLAB.LOCAL",
+		PrincipalsRemoved: 3,
+		Principals:        3,
+		Betweenness:       3,
+	}, chokePoints[0])
+
+	assert.Equal(t, model.ChokePointTypeNode, chokePoints[1].Type)
+	assert.Equal(t, ad.Group.String(), chokePoints[1].Kind)
+	assert.Equal(t, "S-1-5-21-1-1104", chokePoints[1].ObjectID)
+	assert.Equal(t, int64(2), chokePoints[1].PrincipalsRemoved)
+
+	for idx, chokePoint := range chokePoints {
+		assert.Equal(t, int32(idx+1), chokePoint.Rank)
+	}
+}
+
+func TestRankChokePoints_Maximum(t *testing.T) {
+	var (
+		root   = testNode(1, "S-1-5-21-1-512", "DOMAIN ADMINS@TESTLAB.LOCAL", ad.Entity, ad.Group)
+		loaded = chokepoint.NewGraph(graph.NewNodeSet(root))
+	)
+
+	for idx := graph.ID(0); idx < MaximumChokePoints+10; idx++ {
+		user := testNode(100+idx, "user", "user", ad.Entity, ad.User)
+		loaded.Relate(user, &graph.Relationship{ID: 1000 + idx, StartID: user.ID, EndID: root.ID, Kind: ad.GenericAll})
+	}
+
+	assert.Len(t, rankChokePoints("run", "S-1-5-21-1", loaded, loaded.Analyze()), MaximumChokePoints)
+}
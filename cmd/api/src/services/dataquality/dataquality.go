@@ -409,6 +409,10 @@ func azureGraphStats(ctx context.Context, db graph.Database) (model.AzureDataQua
 									stat.WebApps = int(count)
 									aggregation.WebApps += int(count)
 
+								case azure.ConditionalAccessPolicy:
+									stat.ConditionalAccessPolicies = int(count)
+									aggregation.ConditionalAccessPolicies += int(count)
+
 								case azure.Tenant:
 									// Do nothing. Only AzureDataQualityAggregation stats have tenant stats and the tenants stats are handled in the outer tenant loop
 								}
@@ -440,6 +444,26 @@ func azureGraphStats(ctx context.Context, db graph.Database) (model.AzureDataQua
 						return fmt.Errorf("failed while submitting reader for relationship counts in tenant %s: %w", tenantObjectID, err)
 					}
 
+					if err := operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, _ chan<- any) error {
+						if count, err := tx.Nodes().Filterf(func() graph.Criteria {
+							return query.And(
+								query.Kind(query.Node(), azure.ConditionalAccessPolicy),
+								query.Equals(query.NodeProperty(azure.TenantID.String()), tenantObjectID),
+								query.Equals(query.NodeProperty(azure.Enforcing.String()), true),
+							)
+						}).Count(); err != nil {
+							return err
+						} else {
+							mutex.Lock()
+							stat.EnforcingConditionalAccessPolicies = int(count)
+							aggregation.EnforcingConditionalAccessPolicies += int(count)
+							mutex.Unlock()
+							return nil
+						}
+					}); err != nil {
+						return fmt.Errorf("failed while submitting reader for enforcing conditional access policy counts in tenant %s: %w", tenantObjectID, err)
+					}
+
 					if err := operation.Done(); err != nil {
 						return err
 					}
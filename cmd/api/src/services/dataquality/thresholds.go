@@ -0,0 +1,221 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dataquality
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+)
+
+// aggregationRun holds the thresholdable stat values of one data quality aggregation.
+type aggregationRun struct {
+	runID  string
+	values map[string]float64
+}
+
+// fetchAggregationRuns returns the stat values of the most recent aggregations of the given source, newest first.
+func fetchAggregationRuns(ctx context.Context, db database.Database, source model.DataQualitySource, limit int) ([]aggregationRun, error) {
+	var (
+		aggregations []any
+		runIDs       []string
+		end          = time.Now().UTC()
+	)
+
+	switch source {
+	case model.DataQualitySourceAD:
+		if adAggregations, _, err := db.GetADDataQualityAggregations(ctx, time.Time{}, end, "created_at desc", limit, 0); err != nil {
+			return nil, err
+		} else {
+			for _, aggregation := range adAggregations {
+				aggregations = append(aggregations, aggregation)
+				runIDs = append(runIDs, aggregation.RunID)
+			}
+		}
+
+	case model.DataQualitySourceAzure:
+		if azureAggregations, _, err := db.GetAzureDataQualityAggregations(ctx, time.Time{}, end, "created_at desc", limit, 0); err != nil {
+			return nil, err
+		} else {
+			for _, aggregation := range azureAggregations {
+				aggregations = append(aggregations, aggregation)
+				runIDs = append(runIDs, aggregation.RunID)
+			}
+		}
+
+	case model.DataQualitySourceOpenGraph:
+		return fetchOpenGraphAggregationRuns(ctx, db, limit)
+
+	default:
+		return nil, fmt.Errorf("unsupported data quality source %q", source)
+	}
+
+	runs := make([]aggregationRun, 0, len(aggregations))
+	for idx, aggregation := range aggregations {
+		if values, err := model.DataQualityStatValues(aggregation); err != nil {
+			return nil, err
+		} else {
+			runs = append(runs, aggregationRun{runID: runIDs[idx], values: values})
+		}
+	}
+
+	return runs, nil
+}
+
+// openGraphAggregationPageSize is the number of OpenGraph aggregation rows read at a time. OpenGraph aggregations are
+// stored as one row per kind so a run spans as many rows as there are counted kinds.
+const openGraphAggregationPageSize = 1000
+
+// fetchOpenGraphAggregationRuns returns the stat values of the most recent OpenGraph data quality runs, newest first.
+// Counts of the same kind from different schema extensions and environments are summed.
+func fetchOpenGraphAggregationRuns(ctx context.Context, db database.Database, limit int) ([]aggregationRun, error) {
+	var (
+		runs        []aggregationRun
+		runIndexes  = map[string]int{}
+		completed   bool
+		nextPageRow int
+	)
+
+	for !completed {
+		// Without a sort the aggregations are returned newest first
+		aggregations, _, err := db.GetDataQualityAggregations(ctx, model.Filters{}, model.Sort{}, nextPageRow, openGraphAggregationPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, aggregation := range aggregations {
+			runIndex, seen := runIndexes[aggregation.RunID]
+
+			if !seen {
+				if len(runs) == limit {
+					completed = true
+					break
+				}
+
+				runIndex = len(runs)
+				runIndexes[aggregation.RunID] = runIndex
+				runs = append(runs, aggregationRun{runID: aggregation.RunID, values: map[string]float64{}})
+			}
+
+			runs[runIndex].values[model.OpenGraphDataQualityStatName(aggregation.MetricType, aggregation.MetricName)] += aggregation.MetricValue
+		}
+
+		nextPageRow += len(aggregations)
+		completed = completed || len(aggregations) < openGraphAggregationPageSize
+	}
+
+	return runs, nil
+}
+
+// evaluateThreshold compares the latest run against the mean of the preceding runs, up to the threshold's baseline
+// size. A violation is returned when the change exceeds the threshold.
+func evaluateThreshold(threshold model.DataQualityThreshold, runs []aggregationRun) (model.DataQualityViolation, bool) {
+	if len(runs) < 2 {
+		return model.DataQualityViolation{}, false
+	}
+
+	var (
+		current      = runs[0]
+		baselineRuns = runs[1:min(len(runs), threshold.BaselineRuns+1)]
+		baseline     float64
+	)
+
+	for _, run := range baselineRuns {
+		baseline += run.values[threshold.Stat]
+	}
+
+	baseline /= float64(len(baselineRuns))
+
+	if change, violated := threshold.Evaluate(baseline, current.values[threshold.Stat]); !violated {
+		return model.DataQualityViolation{}, false
+	} else {
+		return model.DataQualityViolation{
+			RunID:         current.runID,
+			ThresholdID:   threshold.ID,
+			Source:        threshold.Source,
+			Stat:          threshold.Stat,
+			ThresholdType: threshold.ThresholdType,
+			Direction:     threshold.Direction,
+			Threshold:     threshold.Threshold,
+			Baseline:      baseline,
+			Value:         current.values[threshold.Stat],
+			Change:        change,
+			PauseTagging:  threshold.PauseTagging,
+		}, true
+	}
+}
+
+// EvaluateThresholds compares the latest AD, Azure and OpenGraph data quality aggregations against the rolling baseline of every
+// enabled threshold. Violations are saved, and newly flagged runs are recorded in the audit log. All violations of the
+// latest runs are returned, including those recorded by an earlier evaluation of the same run.
+func EvaluateThresholds(ctx context.Context, db database.Database) (model.DataQualityViolations, error) {
+	var (
+		violations       model.DataQualityViolations
+		runLimitBySource = map[model.DataQualitySource]int{}
+		runsBySource     = map[model.DataQualitySource][]aggregationRun{}
+	)
+
+	thresholds, err := db.GetDataQualityThresholds(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get data quality thresholds: %w", err)
+	}
+
+	for _, threshold := range thresholds {
+		if threshold.Enabled {
+			runLimitBySource[threshold.Source] = max(runLimitBySource[threshold.Source], threshold.BaselineRuns+1)
+		}
+	}
+
+	for source, runLimit := range runLimitBySource {
+		if runs, err := fetchAggregationRuns(ctx, db, source, runLimit); err != nil {
+			return nil, fmt.Errorf("could not get %s data quality aggregations: %w", source, err)
+		} else {
+			runsBySource[source] = runs
+		}
+	}
+
+	for _, threshold := range thresholds {
+		if !threshold.Enabled {
+			continue
+		}
+
+		if violation, violated := evaluateThreshold(threshold, runsBySource[threshold.Source]); violated {
+			slog.WarnContext(
+				ctx,
+				"Data quality regression detected",
+				slog.String("run_id", violation.RunID),
+				slog.String("source", string(violation.Source)),
+				slog.String("stat", violation.Stat),
+				slog.Float64("baseline", violation.Baseline),
+				slog.Float64("value", violation.Value),
+				slog.Float64("change", violation.Change),
+			)
+
+			violations = append(violations, violation)
+		}
+	}
+
+	if _, err := db.CreateDataQualityViolations(ctx, violations); err != nil {
+		return nil, fmt.Errorf("could not save data quality violations: %w", err)
+	}
+
+	return violations, nil
+}
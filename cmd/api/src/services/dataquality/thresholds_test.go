@@ -0,0 +1,205 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dataquality_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/specterops/bloodhound/cmd/api/src/database/mocks"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/services/dataquality"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestEvaluateThresholds(t *testing.T) {
+	var (
+		usersThreshold = model.DataQualityThreshold{
+			Serial:        model.Serial{ID: 1},
+			Source:        model.DataQualitySourceAD,
+			Stat:          "users",
+			ThresholdType: model.DataQualityThresholdTypePercent,
+			Direction:     model.DataQualityThresholdDirectionDecrease,
+			Threshold:     25,
+			BaselineRuns:  2,
+			PauseTagging:  true,
+			Enabled:       true,
+		}
+		tenantsThreshold = model.DataQualityThreshold{
+			Serial:        model.Serial{ID: 2},
+			Source:        model.DataQualitySourceAzure,
+			Stat:          "tenants",
+			ThresholdType: model.DataQualityThresholdTypeAbsolute,
+			Direction:     model.DataQualityThresholdDirectionAny,
+			Threshold:     1,
+			BaselineRuns:  3,
+			Enabled:       true,
+		}
+		dogsThreshold = model.DataQualityThreshold{
+			Serial:        model.Serial{ID: 3},
+			Source:        model.DataQualitySourceOpenGraph,
+			Stat:          "node:Dog",
+			ThresholdType: model.DataQualityThresholdTypeAbsolute,
+			Direction:     model.DataQualityThresholdDirectionDecrease,
+			Threshold:     10,
+			BaselineRuns:  1,
+			Enabled:       true,
+		}
+		adAggregations = model.ADDataQualityAggregations{
+			{RunID: "run-3", Users: 50},
+			{RunID: "run-2", Users: 100},
+			{RunID: "run-1", Users: 120},
+		}
+		expectedError = errors.New("expected error")
+
+		usersBaseline float64 = 110
+		usersValue    float64 = 50
+	)
+
+	tests := []struct {
+		name               string
+		setupMocks         func(mockDB *mocks.MockDatabase)
+		expectedViolations model.DataQualityViolations
+		expectedError      string
+	}{
+		{
+			name: "flags a percent decrease against the rolling baseline",
+			setupMocks: func(mockDB *mocks.MockDatabase) {
+				mockDB.EXPECT().GetDataQualityThresholds(gomock.Any()).Return(model.DataQualityThresholds{usersThreshold}, nil)
+				mockDB.EXPECT().GetADDataQualityAggregations(gomock.Any(), gomock.Any(), gomock.Any(), "created_at desc", 3, 0).Return(adAggregations, len(adAggregations), nil)
+				mockDB.EXPECT().CreateDataQualityViolations(gomock.Any(), gomock.Len(1)).DoAndReturn(func(_ context.Context, violations model.DataQualityViolations) (model.DataQualityViolations, error) {
+					return violations, nil
+				})
+			},
+			expectedViolations: model.DataQualityViolations{{
+				RunID:         "run-3",
+				ThresholdID:   1,
+				Source:        model.DataQualitySourceAD,
+				Stat:          "users",
+				ThresholdType: model.DataQualityThresholdTypePercent,
+				Direction:     model.DataQualityThresholdDirectionDecrease,
+				Threshold:     25,
+				Baseline:      usersBaseline,
+				Value:         usersValue,
+				Change:        (usersValue - usersBaseline) / usersBaseline * 100,
+				PauseTagging:  true,
+			}},
+		},
+		{
+			name: "does not flag changes within the threshold",
+			setupMocks: func(mockDB *mocks.MockDatabase) {
+				mockDB.EXPECT().GetDataQualityThresholds(gomock.Any()).Return(model.DataQualityThresholds{tenantsThreshold}, nil)
+				mockDB.EXPECT().GetAzureDataQualityAggregations(gomock.Any(), gomock.Any(), gomock.Any(), "created_at desc", 4, 0).Return(model.AzureDataQualityAggregations{
+					{RunID: "run-2", Tenants: 2},
+					{RunID: "run-1", Tenants: 1},
+				}, 2, nil)
+				mockDB.EXPECT().CreateDataQualityViolations(gomock.Any(), gomock.Len(0)).Return(nil, nil)
+			},
+		},
+		{
+			name: "skips evaluation without a previous run",
+			setupMocks: func(mockDB *mocks.MockDatabase) {
+				mockDB.EXPECT().GetDataQualityThresholds(gomock.Any()).Return(model.DataQualityThresholds{usersThreshold}, nil)
+				mockDB.EXPECT().GetADDataQualityAggregations(gomock.Any(), gomock.Any(), gomock.Any(), "created_at desc", 3, 0).Return(adAggregations[:1], 1, nil)
+				mockDB.EXPECT().CreateDataQualityViolations(gomock.Any(), gomock.Len(0)).Return(nil, nil)
+			},
+		},
+		{
+			name: "sums opengraph counts across extensions and environments",
+			setupMocks: func(mockDB *mocks.MockDatabase) {
+				mockDB.EXPECT().GetDataQualityThresholds(gomock.Any()).Return(model.DataQualityThresholds{dogsThreshold}, nil)
+				mockDB.EXPECT().GetDataQualityAggregations(gomock.Any(), model.Filters{}, model.Sort{}, 0, 1000).Return(model.DataQualityAggregations{
+					{RunID: "run-2", MetricType: model.DataQualityMetricTypeNode, MetricName: "Dog", MetricValue: 3},
+					{RunID: "run-2", MetricType: model.DataQualityMetricTypeNode, MetricName: "Dog", MetricValue: 2},
+					{RunID: "run-2", MetricType: model.DataQualityMetricTypeRelationship, MetricName: "Bites", MetricValue: 40},
+					{RunID: "run-1", MetricType: model.DataQualityMetricTypeNode, MetricName: "Dog", MetricValue: 20},
+					{RunID: "run-0", MetricType: model.DataQualityMetricTypeNode, MetricName: "Dog", MetricValue: 100},
+				}, 5, nil)
+				mockDB.EXPECT().CreateDataQualityViolations(gomock.Any(), gomock.Len(1)).DoAndReturn(func(_ context.Context, violations model.DataQualityViolations) (model.DataQualityViolations, error) {
+					return violations, nil
+				})
+			},
+			expectedViolations: model.DataQualityViolations{{
+				RunID:         "run-2",
+				ThresholdID:   3,
+				Source:        model.DataQualitySourceOpenGraph,
+				Stat:          "node:Dog",
+				ThresholdType: model.DataQualityThresholdTypeAbsolute,
+				Direction:     model.DataQualityThresholdDirectionDecrease,
+				Threshold:     10,
+				Baseline:      20,
+				Value:         5,
+				Change:        -15,
+			}},
+		},
+		{
+			name: "ignores disabled thresholds",
+			setupMocks: func(mockDB *mocks.MockDatabase) {
+				disabled := usersThreshold
+				disabled.Enabled = false
+
+				mockDB.EXPECT().GetDataQualityThresholds(gomock.Any()).Return(model.DataQualityThresholds{disabled}, nil)
+				mockDB.EXPECT().CreateDataQualityViolations(gomock.Any(), gomock.Len(0)).Return(nil, nil)
+			},
+		},
+		{
+			name: "returns threshold lookup errors",
+			setupMocks: func(mockDB *mocks.MockDatabase) {
+				mockDB.EXPECT().GetDataQualityThresholds(gomock.Any()).Return(nil, expectedError)
+			},
+			expectedError: "could not get data quality thresholds: expected error",
+		},
+		{
+			name: "returns aggregation lookup errors",
+			setupMocks: func(mockDB *mocks.MockDatabase) {
+				mockDB.EXPECT().GetDataQualityThresholds(gomock.Any()).Return(model.DataQualityThresholds{usersThreshold}, nil)
+				mockDB.EXPECT().GetADDataQualityAggregations(gomock.Any(), gomock.Any(), gomock.Any(), "created_at desc", 3, 0).Return(nil, 0, expectedError)
+			},
+			expectedError: "could not get ad data quality aggregations: expected error",
+		},
+		{
+			name: "returns violation save errors",
+			setupMocks: func(mockDB *mocks.MockDatabase) {
+				mockDB.EXPECT().GetDataQualityThresholds(gomock.Any()).Return(model.DataQualityThresholds{usersThreshold}, nil)
+				mockDB.EXPECT().GetADDataQualityAggregations(gomock.Any(), gomock.Any(), gomock.Any(), "created_at desc", 3, 0).Return(adAggregations, len(adAggregations), nil)
+				mockDB.EXPECT().CreateDataQualityViolations(gomock.Any(), gomock.Len(1)).Return(nil, expectedError)
+			},
+			expectedError: "could not save data quality violations: expected error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mockCtrl = gomock.NewController(t)
+				mockDB   = mocks.NewMockDatabase(mockCtrl)
+			)
+
+			tt.setupMocks(mockDB)
+
+			violations, err := dataquality.EvaluateThresholds(context.Background(), mockDB)
+			if tt.expectedError != "" {
+				require.EqualError(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expectedViolations, violations)
+			}
+		})
+	}
+}
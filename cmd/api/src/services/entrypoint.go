@@ -192,7 +192,7 @@ func Entrypoint(ctx context.Context, cfg config.Configuration, connections boots
 			Pool:   connections.RDMS.Pool(),
 			Graph:  connections.Graph,
 			RateLimitMiddleware: func() mux.MiddlewareFunc {
-				return middleware.DefaultRateLimitMiddleware(connections.RDMS)
+				return middleware.DefaultRateLimitMiddleware(cfg, connections.RDMS)
 			},
 			DogTags: dogtagsService,
 		})
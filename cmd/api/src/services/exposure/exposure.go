@@ -0,0 +1,265 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package exposure saves the impact and exposure of the users and computers of each environment
+package exposure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/database"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
+	"github.com/specterops/bloodhound/packages/go/analysis/environment"
+	"github.com/specterops/bloodhound/packages/go/analysis/exposure"
+	"github.com/specterops/bloodhound/packages/go/bhlog/attr"
+	"github.com/specterops/bloodhound/packages/go/bhlog/measure"
+	"github.com/specterops/bloodhound/packages/go/graphschema"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/cardinality"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/ops"
+	"github.com/specterops/dawgs/query"
+)
+
+const (
+	// RetentionDays bounds how long the daily snapshots of each environment are kept
+	RetentionDays = 365
+
+	nodeUpdateBatchSize = 10000
+)
+
+// snapshotDate truncates the time of an analysis to the UTC date of the snapshot it contributes to
+func snapshotDate(now time.Time) time.Time {
+	year, month, day := now.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func setScoreProperty(node *graph.Node, property common.Property, value int) bool {
+	if existing, err := node.Properties.Get(property.String()).Int64(); err == nil && existing == int64(value) {
+		return false
+	}
+
+	node.Properties.Set(property.String(), value)
+	return true
+}
+
+func setEstimatedProperty(node *graph.Node, value bool) bool {
+	if existing, err := node.Properties.Get(common.ExposureEstimated.String()).Bool(); err == nil && existing == value {
+		return false
+	}
+
+	node.Properties.Set(common.ExposureEstimated.String(), value)
+	return true
+}
+
+// scoredNodes writes the scores onto their nodes as properties and returns the nodes whose properties changed
+func scoredNodes(scores []exposure.Score) []*graph.Node {
+	var updated []*graph.Node
+
+	for _, score := range scores {
+		// Every property is evaluated so that no change is lost to short-circuiting
+		impactChanged := setScoreProperty(score.Node, common.TierZeroImpact, score.Impact)
+		exposureChanged := setScoreProperty(score.Node, common.PrincipalExposure, score.Exposure)
+		estimatedChanged := setEstimatedProperty(score.Node, score.Estimated)
+
+		if impactChanged || exposureChanged || estimatedChanged {
+			updated = append(updated, score.Node)
+		}
+	}
+
+	return updated
+}
+
+// clearScores removes the score properties of nodes that were not scored by the current run
+func clearScores(nodes graph.NodeSet) []*graph.Node {
+	cleared := make([]*graph.Node, 0, nodes.Len())
+
+	for _, node := range nodes {
+		node.Properties.Delete(common.TierZeroImpact.String())
+		node.Properties.Delete(common.PrincipalExposure.String())
+		node.Properties.Delete(common.ExposureEstimated.String())
+
+		cleared = append(cleared, node)
+	}
+
+	return cleared
+}
+
+// fetchUnscoredNodes returns the nodes that still hold score properties from a previous run but were not scored by
+// the current one, such as principals that left their environment or whose environment was not scored
+func fetchUnscoredNodes(ctx context.Context, graphDB graph.Database, scored cardinality.Duplex[uint64]) (graph.NodeSet, error) {
+	unscored := graph.NewNodeSet()
+
+	return unscored, graphDB.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		var staleIDs []graph.ID
+
+		if nodeIDs, err := ops.FetchNodeIDs(tx.Nodes().Filterf(func() graph.Criteria {
+			return query.Or(
+				query.IsNotNull(query.NodeProperty(common.TierZeroImpact.String())),
+				query.IsNotNull(query.NodeProperty(common.PrincipalExposure.String())),
+				query.IsNotNull(query.NodeProperty(common.ExposureEstimated.String())),
+			)
+		})); err != nil {
+			return err
+		} else {
+			for _, nodeID := range nodeIDs {
+				if !scored.Contains(nodeID.Uint64()) {
+					staleIDs = append(staleIDs, nodeID)
+				}
+			}
+		}
+
+		for batch := range slices.Chunk(staleIDs, nodeUpdateBatchSize) {
+			if nodes, err := ops.FetchNodeSet(tx.Nodes().Filter(query.InIDs(query.NodeID(), batch...))); err != nil {
+				return err
+			} else {
+				unscored.AddSet(nodes)
+			}
+		}
+
+		return nil
+	})
+}
+
+// principalExposures converts the scores of an environment into the rows of its snapshot. Principals that can neither
+// reach Tier Zero nor be reached by another principal are left out; their absence from a snapshot means both are zero.
+func principalExposures(environmentID string, date time.Time, scores []exposure.Score) model.PrincipalExposures {
+	exposures := make(model.PrincipalExposures, 0, len(scores))
+
+	for _, score := range scores {
+		if score.Impact == 0 && score.Exposure == 0 {
+			continue
+		}
+
+		// Nodes without an objectid or name are still scored; they are saved with empty identifiers
+		objectID, _ := score.Node.Properties.Get(common.ObjectID.String()).String()
+		name, _ := score.Node.Properties.Get(common.Name.String()).String()
+
+		exposures = append(exposures, model.PrincipalExposure{
+			EnvironmentID: environmentID,
+			SnapshotDate:  date,
+			ObjectID:      objectID,
+			Name:          name,
+			Kind:          graphschema.PrimaryDisplayKind(nil, score.Node.Kinds).String(),
+			Impact:        int64(score.Impact),
+			Exposure:      int64(score.Exposure),
+			Estimated:     score.Estimated,
+		})
+	}
+
+	return exposures
+}
+
+func fetchPrincipals(ctx context.Context, graphDB graph.Database, nextEnvironment environment.Environment) (graph.NodeSet, error) {
+	var principals graph.NodeSet
+
+	return principals, graphDB.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		var err error
+
+		principals, err = ops.FetchNodeSet(tx.Nodes().Filterf(func() graph.Criteria {
+			return query.And(
+				nextEnvironment.Members(),
+				query.KindIn(query.Node(), exposure.PrincipalKinds...),
+			)
+		}))
+
+		return err
+	})
+}
+
+// SavePrincipalExposures scores the users and computers of every collected domain and tenant, writes their impact and
+// exposure onto their nodes and saves them as the environment's snapshot for the current day. The properties are
+// removed from nodes that are no longer scored and snapshots older than RetentionDays are deleted afterwards.
+func SavePrincipalExposures(ctx context.Context, db database.Database, graphDB graph.Database) error {
+	defer measure.ContextLogAndMeasure(
+		ctx,
+		slog.LevelInfo,
+		"Principal Exposure Analysis",
+		attr.Namespace("analysis"),
+		attr.Function("SavePrincipalExposures"),
+		attr.Scope("process"),
+	)()
+
+	var (
+		traversalKinds = append(ad.PathfindingRelationships(), azure.PathfindingRelationships()...)
+		date           = snapshotDate(time.Now())
+		scored         = cardinality.NewBitmap64()
+	)
+
+	environments, err := environment.Fetch(ctx, graphDB, appcfg.GetTieringEnabled(ctx, db))
+	if err != nil {
+		return err
+	}
+
+	for _, nextEnvironment := range environments {
+		var scores []exposure.Score
+
+		if principals, err := fetchPrincipals(ctx, graphDB, nextEnvironment); err != nil {
+			return fmt.Errorf("fetching principals of environment %s: %w", nextEnvironment.ObjectID, err)
+		} else if loaded, err := exposure.Load(ctx, graphDB, principals, nextEnvironment.TierZero, traversalKinds); errors.Is(err, exposure.ErrGraphTooLarge) {
+			// No snapshot is saved so that the history does not record the environment as unexposed
+			slog.WarnContext(
+				ctx,
+				"Skipping principal exposure analysis of environment",
+				slog.String("environment_id", nextEnvironment.ObjectID),
+				attr.Error(err),
+			)
+			continue
+		} else if err != nil {
+			return fmt.Errorf("loading attack paths of environment %s: %w", nextEnvironment.ObjectID, err)
+		} else {
+			scores = loaded.Score()
+		}
+
+		for _, score := range scores {
+			scored.Add(score.Node.ID.Uint64())
+		}
+
+		if updated := scoredNodes(scores); len(updated) > 0 {
+			if err := ops.UpdateNodes(ctx, graphDB, updated, nodeUpdateBatchSize); err != nil {
+				return fmt.Errorf("updating principal exposure properties of environment %s: %w", nextEnvironment.ObjectID, err)
+			}
+		}
+
+		if err := db.ReplacePrincipalExposures(ctx, nextEnvironment.ObjectID, date, principalExposures(nextEnvironment.ObjectID, date, scores)); err != nil {
+			return fmt.Errorf("saving principal exposures of environment %s: %w", nextEnvironment.ObjectID, err)
+		}
+	}
+
+	if unscored, err := fetchUnscoredNodes(ctx, graphDB, scored); err != nil {
+		return fmt.Errorf("fetching unscored principals: %w", err)
+	} else if unscored.Len() > 0 {
+		if err := ops.UpdateNodes(ctx, graphDB, clearScores(unscored), nodeUpdateBatchSize); err != nil {
+			return fmt.Errorf("clearing principal exposure properties: %w", err)
+		}
+	}
+
+	if deleted, err := db.DeletePrincipalExposuresBySnapshotDate(ctx, date.AddDate(0, 0, -RetentionDays)); err != nil {
+		slog.WarnContext(ctx, "Failed to sweep principal exposure snapshots", slog.Int("retention_days", RetentionDays), attr.Error(err))
+	} else if deleted > 0 {
+		slog.InfoContext(ctx, "Swept principal exposure snapshots", slog.Int("retention_days", RetentionDays), slog.Int64("count_deleted", deleted))
+	}
+
+	return nil
+}
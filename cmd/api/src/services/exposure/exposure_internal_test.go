@@ -0,0 +1,131 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package exposure
+
+import (
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/packages/go/analysis/exposure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testNode(id graph.ID, objectID, name string, kinds ...graph.Kind) *graph.Node {
+	return graph.NewNode(id, graph.NewProperties().Set(common.ObjectID.String(), objectID).Set(common.Name.String(), name), kinds...)
+}
+
+func TestSnapshotDate(t *testing.T) {
+	eastern := time.FixedZone("EST", -5*60*60)
+
+	assert.Equal(t, time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC), snapshotDate(time.Date(2026, 10, 19, 22, 30, 0, 0, eastern)))
+	assert.Equal(t, time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC), snapshotDate(time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestScoredNodes(t *testing.T) {
+	var (
+		alice = testNode(1, "S-1-5-21-1-1105", "ALICE@TESTLAB.LOCAL", ad.Entity, ad.User)
+		bob   = testNode(2, "S-1-5-21-1-1106", "BOB@TESTLAB.LOCAL", ad.Entity, ad.User)
+		carol = testNode(3, "S-1-5-21-1-1107", "CAROL@TESTLAB.LOCAL", ad.Entity, ad.User)
+	)
+
+	bob.Properties.Set(common.TierZeroImpact.String(), int64(2))
+	bob.Properties.Set(common.PrincipalExposure.String(), int64(1))
+	bob.Properties.Set(common.ExposureEstimated.String(), false)
+	carol.Properties.Set(common.TierZeroImpact.String(), int64(2))
+	carol.Properties.Set(common.PrincipalExposure.String(), int64(1))
+
+	updated := scoredNodes([]exposure.Score{
+		{Node: alice, Impact: 3},
+		{Node: bob, Impact: 2, Exposure: 1},
+		{Node: carol, Impact: 2, Exposure: 4, Estimated: true},
+	})
+
+	// Bob's properties already hold the scores so only alice and carol need to be written back
+	require.Equal(t, []*graph.Node{alice, carol}, updated)
+
+	impact, err := alice.Properties.Get(common.TierZeroImpact.String()).Int64()
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), impact)
+
+	exposed, err := alice.Properties.Get(common.PrincipalExposure.String()).Int64()
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), exposed)
+
+	exposed, err = carol.Properties.Get(common.PrincipalExposure.String()).Int64()
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), exposed)
+
+	estimated, err := carol.Properties.Get(common.ExposureEstimated.String()).Bool()
+	require.NoError(t, err)
+	assert.True(t, estimated)
+}
+
+func TestClearScores(t *testing.T) {
+	alice := testNode(1, "S-1-5-21-1-1105", "ALICE@TESTLAB.LOCAL", ad.Entity, ad.User)
+	alice.Properties.Set(common.TierZeroImpact.String(), int64(3))
+	alice.Properties.Set(common.PrincipalExposure.String(), int64(1))
+	alice.Properties.Set(common.ExposureEstimated.String(), false)
+
+	cleared := clearScores(graph.NewNodeSet(alice))
+	require.Equal(t, []*graph.Node{alice}, cleared)
+
+	for _, property := range []common.Property{common.TierZeroImpact, common.PrincipalExposure, common.ExposureEstimated} {
+		assert.False(t, alice.Properties.Exists(property.String()), property.String())
+	}
+
+	assert.True(t, alice.Properties.Exists(common.ObjectID.String()))
+}
+
+func TestPrincipalExposures(t *testing.T) {
+	var (
+		date   = time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+		alice  = testNode(1, "S-1-5-21-1-1105", "ALICE@TESTLAB.LOCAL", ad.Entity, ad.User)
+		bob    = testNode(2, "S-1-5-21-1-1106", "BOB@TESTLAB.LOCAL", ad.Entity, ad.User)
+		server = testNode(3, "S-1-5-21-1-1108", "SERVER.TESTLAB.LOCAL", ad.Entity, ad.Computer)
+	)
+
+	exposures := principalExposures("S-1-5-21-1", date, []exposure.Score{
+		{Node: alice, Impact: 120, Exposure: 3, Estimated: true},
+		{Node: server, Exposure: 2},
+		{Node: bob},
+	})
+
+	// Bob neither reaches Tier Zero nor is reachable, so the snapshot leaves bob out
+	require.Len(t, exposures, 2)
+
+	assert.Equal(t, model.PrincipalExposure{
+		EnvironmentID: "S-1-5-21-1",
+		SnapshotDate:  date,
+		ObjectID:      "S-1-5-21-1-1105",
+		Name:          "ALICE@TESTLAB.LOCAL",
+		Kind:          ad.User.String(),
+		Impact:        120,
+		Exposure:      3,
+		Estimated:     true,
+	}, exposures[0])
+
+	assert.Equal(t, "S-1-5-21-1-1108", exposures[1].ObjectID)
+	assert.Equal(t, ad.Computer.String(), exposures[1].Kind)
+	assert.Equal(t, int64(0), exposures[1].Impact)
+	assert.Equal(t, int64(2), exposures[1].Exposure)
+	assert.False(t, exposures[1].Estimated)
+}
@@ -39,6 +39,15 @@ const (
 	PrincipalTypeUser             = "User"
 )
 
+// AzureHound does not define kinds for administrative units, RBAC role definitions or Conditional Access policies so
+// they are declared here
+const (
+	KindAZAdministrativeUnit       enums.Kind = "AZAdministrativeUnit"
+	KindAZAdministrativeUnitMember enums.Kind = "AZAdministrativeUnitMember"
+	KindAZRBACRoleAssignment       enums.Kind = "AZRBACRoleAssignment"
+	KindAZConditionalAccessPolicy  enums.Kind = "AZConditionalAccessPolicy"
+)
+
 func getKindConverter(kind enums.Kind) func(json.RawMessage, *ConvertedAzureData, time.Time) {
 	switch kind {
 	case enums.KindAZApp:
@@ -61,6 +70,12 @@ func getKindConverter(kind enums.Kind) func(json.RawMessage, *ConvertedAzureData
 		return convertAzureGroupMember
 	case enums.KindAZGroupOwner:
 		return convertAzureGroupOwner
+	case KindAZAdministrativeUnit:
+		return convertAzureAdministrativeUnit
+	case KindAZAdministrativeUnitMember:
+		return convertAzureAdministrativeUnitMember
+	case KindAZConditionalAccessPolicy:
+		return convertAzureConditionalAccessPolicy
 	case enums.KindAZKeyVault:
 		return convertAzureKeyVault
 	case enums.KindAZKeyVaultAccessPolicy:
@@ -153,6 +168,8 @@ func getKindConverter(kind enums.Kind) func(json.RawMessage, *ConvertedAzureData
 		return convertAzureRoleManagementPolicyAssignment
 	case enums.KindAZRoleEligibilityScheduleInstance:
 		return convertAzureRoleEligibilityScheduleInstance
+	case KindAZRBACRoleAssignment:
+		return convertAzureRBACRoleAssignment
 	default:
 		// TODO: we should probably have a hook or something to log the unknown type
 		return func(rm json.RawMessage, cd *ConvertedAzureData, now time.Time) {}
@@ -377,6 +394,52 @@ func convertAzureGroupOwner(raw json.RawMessage, converted *ConvertedAzureData,
 	}
 }
 
+func convertAzureAdministrativeUnit(raw json.RawMessage, converted *ConvertedAzureData, ingestTime time.Time) {
+	var data ein.AzureAdministrativeUnit
+	if err := json.Unmarshal(raw, &data); err != nil {
+		slog.Error(
+			SerialError,
+			slog.String("type", "administrative unit"),
+			attr.Error(err),
+		)
+	} else {
+		node, rel := ein.ConvertAzureAdministrativeUnit(data, ingestTime)
+		converted.NodeProps = append(converted.NodeProps, node)
+		converted.RelProps = append(converted.RelProps, rel)
+	}
+}
+
+func convertAzureAdministrativeUnitMember(raw json.RawMessage, converted *ConvertedAzureData, ingestTime time.Time) {
+	var (
+		data ein.AzureAdministrativeUnitMembers
+	)
+
+	if err := json.Unmarshal(raw, &data); err != nil {
+		slog.Error(
+			SerialError,
+			slog.String("type", "administrative unit members"),
+			attr.Error(err),
+		)
+	} else {
+		converted.RelProps = append(converted.RelProps, ein.ConvertAzureAdministrativeUnitMembersToRels(data)...)
+	}
+}
+
+func convertAzureConditionalAccessPolicy(raw json.RawMessage, converted *ConvertedAzureData, ingestTime time.Time) {
+	var data ein.AzureConditionalAccessPolicy
+	if err := json.Unmarshal(raw, &data); err != nil {
+		slog.Error(
+			SerialError,
+			slog.String("type", "conditional access policy"),
+			attr.Error(err),
+		)
+	} else {
+		node, rels := ein.ConvertAzureConditionalAccessPolicy(data, ingestTime)
+		converted.NodeProps = append(converted.NodeProps, node)
+		converted.RelProps = append(converted.RelProps, rels...)
+	}
+}
+
 func convertAzureKeyVault(raw json.RawMessage, converted *ConvertedAzureData, ingestTime time.Time) {
 	var data models.KeyVault
 	if err := json.Unmarshal(raw, &data); err != nil {
@@ -627,6 +690,19 @@ func convertAzureRoleAssignment(raw json.RawMessage, converted *ConvertedAzureDa
 	}
 }
 
+func convertAzureRBACRoleAssignment(raw json.RawMessage, converted *ConvertedAzureData, ingestTime time.Time) {
+	var data ein.AzureRBACRoleAssignments
+	if err := json.Unmarshal(raw, &data); err != nil {
+		slog.Error(
+			SerialError,
+			slog.String("type", "rbac role assignment"),
+			attr.Error(err),
+		)
+	} else {
+		converted.RelProps = append(converted.RelProps, ein.ConvertAzureRBACRoleAssignmentsToRels(data)...)
+	}
+}
+
 func convertAzureServicePrincipal(raw json.RawMessage, converted *ConvertedAzureData, ingestTime time.Time) {
 	var data models.ServicePrincipal
 	if err := json.Unmarshal(raw, &data); err != nil {
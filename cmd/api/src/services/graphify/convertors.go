@@ -204,7 +204,7 @@ func convertDomainData(domain ein.Domain, converted *ConvertedData, ingestTime t
 }
 
 func convertGPOData(gpo ein.GPO, converted *ConvertedData, ingestTime time.Time) {
-	baseNodeProp := ein.ConvertObjectToNode(ein.IngestBase(gpo), ad.GPO, ingestTime)
+	baseNodeProp := ein.ConvertObjectToNode(gpo.IngestBase, ad.GPO, ingestTime)
 	converted.NodeProps = append(converted.NodeProps, baseNodeProp)
 	converted.RelProps = append(converted.RelProps, ein.ParseACEData(baseNodeProp, gpo.Aces, gpo.ObjectIdentifier, ad.GPO)...)
 	converted.NodeProps = append(converted.NodeProps, ein.ParseGPOData(gpo))
@@ -228,6 +228,47 @@ func convertOUData(ou ein.OU, converted *ConvertedData, ingestTime time.Time) {
 	converted.NodeProps = append(converted.NodeProps, parsedLocalGroupData.Nodes...)
 }
 
+func convertSiteData(site ein.Site, converted *ConvertedData, ingestTime time.Time) {
+	baseNodeProp := ein.ConvertObjectToNode(site.IngestBase, ad.Site, ingestTime)
+	converted.NodeProps = append(converted.NodeProps, baseNodeProp)
+	converted.RelProps = append(converted.RelProps, ein.ParseACEData(baseNodeProp, site.Aces, site.ObjectIdentifier, ad.Site)...)
+	if container := ein.ParseObjectContainer(site.IngestBase, ad.Site); container.IsValid() {
+		converted.RelProps = append(converted.RelProps, container)
+	}
+
+	converted.RelProps = append(converted.RelProps, ein.ParseGpLinks(site.Links, site.ObjectIdentifier, ad.Site)...)
+}
+
+func convertSubnetData(subnet ein.Subnet, converted *ConvertedData, ingestTime time.Time) {
+	baseNodeProp := ein.ConvertObjectToNode(subnet.IngestBase, ad.Subnet, ingestTime)
+	converted.NodeProps = append(converted.NodeProps, baseNodeProp)
+	converted.RelProps = append(converted.RelProps, ein.ParseACEData(baseNodeProp, subnet.Aces, subnet.ObjectIdentifier, ad.Subnet)...)
+	converted.RelProps = append(converted.RelProps, ein.ParseSubnetData(subnet)...)
+}
+
+func convertDNSZoneData(zone ein.DNSZone, converted *ConvertedData, ingestTime time.Time) {
+	baseNodeProp := ein.ConvertObjectToNode(zone.IngestBase, ad.DNSZone, ingestTime)
+	converted.NodeProps = append(converted.NodeProps, baseNodeProp)
+	converted.RelProps = append(converted.RelProps, ein.ParseACEData(baseNodeProp, zone.Aces, zone.ObjectIdentifier, ad.DNSZone)...)
+	if container := ein.ParseObjectContainer(zone.IngestBase, ad.DNSZone); container.IsValid() {
+		converted.RelProps = append(converted.RelProps, container)
+	}
+
+	if len(zone.ChildObjects) > 0 {
+		converted.RelProps = append(converted.RelProps, ein.ParseChildObjects(zone.ChildObjects, zone.ObjectIdentifier, ad.DNSZone)...)
+	}
+}
+
+func convertDNSNodeData(node ein.DNSNode, converted *ConvertedData, ingestTime time.Time) {
+	baseNodeProp := ein.ConvertObjectToNode(ein.IngestBase(node), ad.DNSNode, ingestTime)
+	converted.NodeProps = append(converted.NodeProps, baseNodeProp)
+	converted.RelProps = append(converted.RelProps, ein.ParseACEData(baseNodeProp, node.Aces, node.ObjectIdentifier, ad.DNSNode)...)
+
+	if rel := ein.ParseObjectContainer(ein.IngestBase(node), ad.DNSNode); rel.IsValid() {
+		converted.RelProps = append(converted.RelProps, rel)
+	}
+}
+
 func convertSessionData(session ein.Session, converted *ConvertedSessionData) {
 	converted.SessionProps = append(converted.SessionProps, ein.ConvertSessionObject(session))
 }
@@ -31,6 +31,7 @@ import (
 	"github.com/specterops/bloodhound/cmd/api/src/model/ingest"
 	"github.com/specterops/bloodhound/cmd/api/src/services/graphify/endpoint"
 	"github.com/specterops/bloodhound/cmd/api/src/services/upload"
+	"github.com/specterops/bloodhound/packages/go/bhlog/attr"
 	"github.com/specterops/bloodhound/packages/go/bhlog/measure"
 	"github.com/specterops/bloodhound/packages/go/ein"
 	"github.com/specterops/bloodhound/packages/go/errorlist"
@@ -48,7 +49,7 @@ const (
 type registrationFn func(kind graph.Kind) error
 
 type ReadOptions struct {
-	FileType           model.FileType // JSON or ZIP
+	FileType           model.FileType // JSON, ZIP, NDJSON or CSV
 	IngestSchema       upload.IngestSchema
 	RegisterSourceKind registrationFn
 }
@@ -62,6 +63,12 @@ type IngestContext struct {
 	IngestTime time.Time
 	// Manager is the caching layer that deduplicates ingest payloads across ingest runs
 	Manager ChangeManager
+	// ChangeSet collects the entities resolved by Manager for the graph change history. The history is only recorded
+	// when the changelog is enabled since it relies on Manager to find the new and modified entities.
+	ChangeSet *changelog.ChangeSet
+	// Producer is the data type of the payload being ingested. Edges are attributed to it in the change history so that
+	// one data type never records the edges reported by another as removed.
+	Producer string
 	// Stats tracks the number of nodes and relationships processed during ingestion
 	Stats *IngestStats
 	// ID of the Job that is being ingested
@@ -119,6 +126,12 @@ func WithChangeManager(manager ChangeManager) IngestOption {
 	}
 }
 
+func WithChangeSet(changeSet *changelog.ChangeSet) IngestOption {
+	return func(s *IngestContext) {
+		s.ChangeSet = changeSet
+	}
+}
+
 func WithEndpointResolver(resolver *endpoint.Resolver) IngestOption {
 	return func(s *IngestContext) {
 		s.EndpointResolver = resolver
@@ -158,6 +171,36 @@ func (s *IngestContext) HasChangelog() bool {
 	return s.Manager != nil
 }
 
+// recordChange adds the state of a new or modified entity to the change history. History failures are logged rather
+// than returned so that they never fail an ingest.
+func (s *IngestContext) recordChange(state model.GraphObjectState) {
+	if s.ChangeSet == nil {
+		return
+	}
+
+	if state.ObjectType == model.GraphObjectTypeEdge {
+		state.Producer = s.Producer
+	}
+
+	if err := s.ChangeSet.Add(s.Ctx, state); err != nil {
+		slog.WarnContext(s.Ctx, "Changelog history record failed", attr.Error(err))
+	}
+}
+
+// trackUnchangedNode notes an unchanged node so that the change history can tell which edges ending at it were removed.
+func (s *IngestContext) trackUnchangedNode(change *changelog.NodeChange) {
+	if s.ChangeSet != nil {
+		s.ChangeSet.TrackNode(*change)
+	}
+}
+
+// trackUnchangedEdge notes an unchanged edge so that the change history does not consider it removed.
+func (s *IngestContext) trackUnchangedEdge(change *changelog.EdgeChange) {
+	if s.ChangeSet != nil {
+		s.ChangeSet.TrackEdge(*change, s.Producer)
+	}
+}
+
 // ChangeManager represents the ingestion-facing API for the changelog daemon.
 //
 // It provides three responsibilities:
@@ -203,6 +246,16 @@ func ReadFileForIngest(batch *IngestContext, reader io.ReadSeeker, options ReadO
 		shouldValidateGraph = false
 	)
 
+	// NDJSON and CSV payloads are validated and ingested line by line so that a bad line does not fail the whole file
+	switch options.FileType {
+	case model.FileTypeNDJSON:
+		batch.Producer = options.FileType.String()
+		return IngestNDJSON(batch, reader, options)
+	case model.FileTypeCSV:
+		batch.Producer = options.FileType.String()
+		return IngestCSV(batch, reader, options)
+	}
+
 	// TODO: Should this be moved into the upload service. The comment here is helpful, but more
 	// discovery required.
 	// if filetype == ZIP, we need to validate against jsonschema because
@@ -226,6 +279,8 @@ func ReadFileForIngest(batch *IngestContext, reader io.ReadSeeker, options ReadO
 
 // IngestWrapper dispatches the ingest process based on the metadata's type.
 func IngestWrapper(batch *IngestContext, reader io.ReadSeeker, meta ingest.OriginalMetadata, readOpts ReadOptions) error {
+	batch.Producer = string(meta.Type)
+
 	// Source-kind-aware handler
 	if handler, ok := sourceKindHandlers[meta.Type]; ok {
 		if readOpts.RegisterSourceKind == nil {
@@ -408,6 +463,10 @@ var basicHandlers = map[ingest.DataType]basicIngestHandler{
 	ingest.DataTypeNTAuthStore:    defaultBasicHandler(convertNTAuthStoreData),
 	ingest.DataTypeCertTemplate:   defaultBasicHandler(convertCertTemplateData),
 	ingest.DataTypeIssuancePolicy: defaultBasicHandler(convertIssuancePolicy),
+	ingest.DataTypeSite:           defaultBasicHandler(convertSiteData),
+	ingest.DataTypeSubnet:         defaultBasicHandler(convertSubnetData),
+	ingest.DataTypeDNSZone:        defaultBasicHandler(convertDNSZoneData),
+	ingest.DataTypeDNSNode:        defaultBasicHandler(convertDNSNodeData),
 }
 
 var sourceKindHandlers = map[ingest.DataType]sourceKindIngestHandler{
@@ -124,7 +124,7 @@ func WriteArchiveFileToStorage(ctx context.Context, fileService storage.FileServ
 }
 
 func ExtractIngestFiles(ctx context.Context, scratchDirectory string, fileService storage.FileService, storedFileName, providedFileName string, fileType model.FileType, prefix string) ([]IngestFileData, error) {
-	if fileType == model.FileTypeJson {
+	if fileType != model.FileTypeZip {
 		// If this isn't a zip file, just return a slice with the path in it and let stuff process as normal
 		return []IngestFileData{
 			{
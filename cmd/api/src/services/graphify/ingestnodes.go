@@ -124,11 +124,13 @@ func maybeSubmitNodeUpdate(ingestCtx *IngestContext, update graph.NodeUpdate) er
 	}
 
 	if shouldSubmit {
-		// New/modified: update via dawgs batch (will increment NodesWritten)
+		// New/modified: record in the change history and update via dawgs batch (will increment NodesWritten)
+		ingestCtx.recordChange(change.State())
 		return ingestCtx.Batch.UpdateNodeBy(update)
 	}
 
 	// Unchanged: enqueue change-- this is needed to maintain reconciliation
+	ingestCtx.trackUnchangedNode(change)
 	if ok := ingestCtx.Manager.Submit(ingestCtx.Ctx, change); !ok {
 		slog.WarnContext(ingestCtx.Ctx, "Changelog submit dropped", slog.String("objectid", objectid))
 	}
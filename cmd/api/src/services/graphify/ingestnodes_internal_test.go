@@ -24,6 +24,9 @@ import (
 	"time"
 
 	"github.com/specterops/bloodhound/cmd/api/src/daemons/changelog"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
 	"github.com/specterops/bloodhound/cmd/api/src/services/graphify/mocks"
 	"github.com/specterops/bloodhound/packages/go/ein"
 	"github.com/specterops/bloodhound/packages/go/graphschema"
@@ -148,6 +151,36 @@ func TestMaybeSubmitNodeUpdate(t *testing.T) {
 		require.Equal(t, int64(1), nodesWritten, "NodesWritten should be incremented")
 	})
 
+	t.Run("new change, record in change history", func(t *testing.T) {
+		var (
+			ctx               = context.Background()
+			ctrl              = gomock.NewController(t)
+			mockBatchUpdater  = mocks.NewMockBatchUpdater(ctrl)
+			mockChangeManager = mocks.NewMockChangeManager(ctrl)
+			history           = &recordingHistory{}
+			ingestCtx         = NewIngestContext(ctx, WithChangeManager(mockChangeManager), WithChangeSet(changelog.NewChangeSet(history, 7, 10)))
+
+			objectID   = "1234"
+			node       = graph.PrepareNode(graph.NewProperties().Set("objectid", objectID).Set("name", "USER@TESTLAB.LOCAL"), graph.StringKind("kindA"))
+			nodeUpdate = graph.NodeUpdate{Node: node}
+			change     = changelog.NewNodeChange(objectID, node.Kinds, node.Properties)
+		)
+
+		ingestCtx.BindBatchUpdater(mockBatchUpdater)
+
+		mockChangeManager.EXPECT().ResolveChange(change).Return(true, nil).Times(1)
+		mockBatchUpdater.EXPECT().UpdateNodeBy(nodeUpdate).Return(nil).Times(1)
+
+		require.NoError(t, maybeSubmitNodeUpdate(ingestCtx, nodeUpdate))
+		require.NoError(t, ingestCtx.ChangeSet.Flush(ctx))
+
+		require.Equal(t, null.Int64From(7), history.jobID)
+		require.Len(t, history.states, 1)
+		require.Equal(t, model.GraphObjectTypeNode, history.states[0].ObjectType)
+		require.Equal(t, objectID, history.states[0].ObjectKey)
+		require.Equal(t, types.JSONUntypedObject{"name": "USER@TESTLAB.LOCAL"}, history.states[0].Properties)
+	})
+
 	t.Run("unmodified, submit to changelog and track processed only", func(t *testing.T) {
 		var (
 			ctx               = context.Background()
@@ -185,6 +218,21 @@ func TestMaybeSubmitNodeUpdate(t *testing.T) {
 	})
 }
 
+type recordingHistory struct {
+	jobID  null.Int64
+	states model.GraphObjectStates
+}
+
+func (s *recordingHistory) RecordGraphChanges(_ context.Context, jobID null.Int64, states model.GraphObjectStates) (int, error) {
+	s.jobID = jobID
+	s.states = append(s.states, states...)
+	return len(states), nil
+}
+
+func (s *recordingHistory) RecordGraphEdgeRemovals(_ context.Context, _ null.Int64, _ []model.GraphEdgeFamily, _ []string) (int, error) {
+	return 0, nil
+}
+
 func TestIngestGenericData_RegisterNodeKind(t *testing.T) {
 	t.Run("new unknown kind calls registrar once", func(t *testing.T) {
 		var (
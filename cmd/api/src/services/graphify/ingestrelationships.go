@@ -90,11 +90,13 @@ func maybeSubmitRelationshipUpdate(ingestCtx *IngestContext, update graph.Relati
 	}
 
 	if shouldSubmit {
-		// New/modified: update via dawgs batch
+		// New/modified: record in the change history and update via dawgs batch
+		ingestCtx.recordChange(change.State())
 		return ingestCtx.Batch.UpdateRelationshipBy(update)
 	}
 
 	// Unchanged: enqueue change-- this is needed to maintain reconciliation
+	ingestCtx.trackUnchangedEdge(change)
 	if ok := ingestCtx.Manager.Submit(ingestCtx.Ctx, change); !ok {
 		slog.WarnContext(ingestCtx.Ctx, "Changelog submit dropped",
 			slog.String("source_object_id", sourceObjectID),
@@ -0,0 +1,319 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package graphify
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/specterops/bloodhound/cmd/api/src/services/upload"
+	"github.com/specterops/bloodhound/packages/go/ein"
+	"github.com/specterops/bloodhound/packages/go/errorlist"
+	"github.com/specterops/dawgs/graph"
+)
+
+// LineError reports a single line of an NDJSON or CSV payload that could not be ingested. Line errors are data
+// quality issues: they are surfaced to the user while the remaining lines of the file are still ingested.
+type LineError struct {
+	Line int
+	Err  error
+}
+
+func (s LineError) Error() string {
+	return fmt.Sprintf("line %d: %s", s.Line, s.Err)
+}
+
+func (s LineError) Unwrap() error {
+	return s.Err
+}
+
+// lineIngestBatch buffers the nodes and edges converted from individual lines and flushes them to the graph in
+// groups of IngestCountThreshold, mirroring DecodeGenericData.
+type lineIngestBatch struct {
+	batch      *IngestContext
+	schema     upload.IngestSchema
+	sourceKind graph.Kind
+	converted  ConvertedData
+	count      int
+	errs       *errorlist.ErrorBuilder
+}
+
+func newLineIngestBatch(batch *IngestContext, schema upload.IngestSchema) *lineIngestBatch {
+	return &lineIngestBatch{
+		batch:      batch,
+		schema:     schema,
+		sourceKind: graph.EmptyKind,
+		errs:       errorlist.NewBuilder(),
+	}
+}
+
+func (s *lineIngestBatch) addLineError(line int, err error) {
+	s.errs.Add(LineError{Line: line, Err: err})
+}
+
+// addNode validates a decoded node document and converts it for ingest
+func (s *lineIngestBatch) addNode(line int, document map[string]any, content []byte) {
+	var node ein.GenericNode
+
+	if err := s.schema.ValidateNode("node", document); err != nil {
+		s.addLineError(line, err)
+	} else if err := json.Unmarshal(content, &node); err != nil {
+		s.addLineError(line, err)
+	} else if err := ConvertGenericNode(node, &s.converted, s.batch.UseRawObjectIDs); err != nil {
+		s.addLineError(line, err)
+	} else {
+		s.increment()
+	}
+}
+
+// addEdge validates a decoded edge document and converts it for ingest
+func (s *lineIngestBatch) addEdge(line int, document map[string]any, content []byte) {
+	var edge ein.GenericEdge
+
+	if err := s.schema.ValidateEdge("edge", document); err != nil {
+		s.addLineError(line, err)
+	} else if err := json.Unmarshal(content, &edge); err != nil {
+		s.addLineError(line, err)
+	} else if err := ConvertGenericEdge(edge, &s.converted); err != nil {
+		s.addLineError(line, err)
+	} else {
+		s.increment()
+	}
+}
+
+func (s *lineIngestBatch) increment() {
+	if s.count++; s.count == IngestCountThreshold {
+		s.flush()
+	}
+}
+
+func (s *lineIngestBatch) flush() {
+	if s.count > 0 {
+		if err := IngestGenericData(s.batch, s.sourceKind, s.converted); err != nil {
+			s.errs.Add(err)
+		}
+
+		s.converted.Clear()
+		s.count = 0
+	}
+}
+
+// build flushes any remaining entities and returns the accumulated errors
+func (s *lineIngestBatch) build() error {
+	s.flush()
+	return s.errs.Build()
+}
+
+func (s *lineIngestBatch) registerSourceKind(sourceKind string, register registrationFn) error {
+	if sourceKind == "" {
+		return nil
+	}
+
+	s.sourceKind = graph.StringKind(sourceKind)
+	if err := register(s.sourceKind); err != nil {
+		return fmt.Errorf("failed to register sourceKind: %w", err)
+	}
+
+	return nil
+}
+
+// ndjsonLine is a single non-empty line of an NDJSON payload
+type ndjsonLine struct {
+	number   int
+	content  []byte
+	document map[string]any
+	err      error
+}
+
+func (s ndjsonLine) isMetadata() bool {
+	_, ok := s.document["metadata"]
+	return ok
+}
+
+func (s ndjsonLine) isEdge() bool {
+	_, ok := s.document["start"]
+	return ok
+}
+
+// scanNDJSON calls visit for every non-empty line of the payload, numbering lines from 1
+func scanNDJSON(reader io.ReadSeeker, visit func(line ndjsonLine)) error {
+	if _, err := reader.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("rewind failed: %w", err)
+	}
+
+	bufferedReader := bufio.NewReader(reader)
+
+	for number := 1; ; number++ {
+		content, err := bufferedReader.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+
+		if content = bytes.TrimSpace(content); len(content) > 0 {
+			line := ndjsonLine{
+				number:  number,
+				content: content,
+			}
+
+			if line.err = json.Unmarshal(content, &line.document); line.err == nil && line.document == nil {
+				line.err = errors.New("line is not a JSON object")
+			}
+
+			visit(line)
+		}
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+	}
+}
+
+// IngestNDJSON ingests an OpenGraph payload holding one node or edge object per line. An optional first line of the
+// form {"metadata": {"source_kind": "..."}} sets the source kind. Lines with a "start" key are edges and all other
+// lines are nodes. All nodes are ingested before any edge so that edges may reference nodes defined later in the file.
+// Lines that fail to parse, validate or convert are reported as LineError values without stopping the ingest.
+func IngestNDJSON(batch *IngestContext, reader io.ReadSeeker, options ReadOptions) error {
+	var (
+		lineBatch   = newLineIngestBatch(batch, options.IngestSchema)
+		firstLine   = true
+		metadataErr error
+	)
+
+	if options.RegisterSourceKind == nil {
+		return errors.New("missing source kind registration function for ndjson ingest")
+	}
+
+	if err := scanNDJSON(reader, func(line ndjsonLine) {
+		isFirstLine := firstLine
+		firstLine = false
+
+		switch {
+		case line.err != nil:
+			lineBatch.addLineError(line.number, line.err)
+
+		case line.isMetadata():
+			if !isFirstLine {
+				lineBatch.addLineError(line.number, errors.New("metadata must be the first line of the file"))
+				return
+			}
+
+			var metadata struct {
+				Metadata ein.GenericMetadata `json:"metadata"`
+			}
+
+			if err := json.Unmarshal(line.content, &metadata); err != nil {
+				lineBatch.addLineError(line.number, fmt.Errorf("failed to parse opengraph metadata: %w", err))
+			} else {
+				metadataErr = lineBatch.registerSourceKind(metadata.Metadata.SourceKind, options.RegisterSourceKind)
+			}
+
+		case !line.isEdge():
+			if metadataErr == nil {
+				lineBatch.addNode(line.number, line.document, line.content)
+			}
+		}
+	}); err != nil {
+		return err
+	} else if metadataErr != nil {
+		return metadataErr
+	}
+
+	// Nodes are flushed before the edges pass so that edge endpoints resolve against them
+	lineBatch.flush()
+
+	if err := scanNDJSON(reader, func(line ndjsonLine) {
+		if line.err == nil && !line.isMetadata() && line.isEdge() {
+			lineBatch.addEdge(line.number, line.document, line.content)
+		}
+	}); err != nil {
+		return err
+	}
+
+	return lineBatch.build()
+}
+
+// IngestCSV ingests an OpenGraph CSV upload as stored by upload.WriteAndValidateCSV: the header mapping on the first
+// line followed by the CSV file. Each row is mapped to a node or edge document and ingested through the same path as
+// NDJSON. Rows that fail to parse, validate or convert are reported as LineError values without stopping the ingest.
+func IngestCSV(batch *IngestContext, reader io.ReadSeeker, options ReadOptions) error {
+	if options.RegisterSourceKind == nil {
+		return errors.New("missing source kind registration function for csv ingest")
+	}
+
+	if _, err := reader.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("rewind failed: %w", err)
+	}
+
+	var (
+		bufferedReader = bufio.NewReader(reader)
+		lineBatch      = newLineIngestBatch(batch, options.IngestSchema)
+	)
+
+	mapping, err := upload.ReadCSVHeaderMapping(bufferedReader)
+	if err != nil {
+		return err
+	} else if err := lineBatch.registerSourceKind(mapping.SourceKind, options.RegisterSourceKind); err != nil {
+		return err
+	}
+
+	csvReader := csv.NewReader(bufferedReader)
+	csvReader.FieldsPerRecord = -1
+
+	header, err := csvReader.Read()
+	if err != nil {
+		return fmt.Errorf("failed to read csv header: %w", err)
+	}
+
+	rowMapper, err := mapping.NewRowMapper(header)
+	if err != nil {
+		return err
+	}
+
+	// The CSV reader starts after the stored mapping, so its positions match the line numbers of the uploaded CSV file
+	for {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			lineBatch.addLineError(parseErr.StartLine, parseErr.Err)
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		line, _ := csvReader.FieldPos(0)
+		document := rowMapper.Map(record)
+
+		if content, err := json.Marshal(document); err != nil {
+			lineBatch.addLineError(line, err)
+		} else if mapping.Type == upload.CSVEntityTypeEdges {
+			lineBatch.addEdge(line, document, content)
+		} else {
+			lineBatch.addNode(line, document, content)
+		}
+	}
+
+	return lineBatch.build()
+}
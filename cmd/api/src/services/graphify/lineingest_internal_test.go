@@ -0,0 +1,70 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package graphify
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanNDJSON(t *testing.T) {
+	var (
+		content = "{\"metadata\": {\"source_kind\": \"Example\"}}\n\n{\"id\": \"1\", \"kinds\": [\"Person\"]}\r\nnot json\n[1, 2]\nnull\n{\"kind\": \"Knows\", \"start\": {\"value\": \"1\"}, \"end\": {\"value\": \"2\"}}"
+		lines   []ndjsonLine
+	)
+
+	require.NoError(t, scanNDJSON(strings.NewReader(content), func(line ndjsonLine) {
+		lines = append(lines, line)
+	}))
+
+	require.Len(t, lines, 6)
+
+	assert.Equal(t, 1, lines[0].number)
+	assert.True(t, lines[0].isMetadata())
+
+	assert.Equal(t, 3, lines[1].number)
+	assert.NoError(t, lines[1].err)
+	assert.False(t, lines[1].isEdge())
+
+	assert.Equal(t, 4, lines[2].number)
+	assert.Error(t, lines[2].err)
+
+	assert.Equal(t, 5, lines[3].number)
+	assert.Error(t, lines[3].err)
+
+	assert.Equal(t, 6, lines[4].number)
+	assert.EqualError(t, lines[4].err, "line is not a JSON object")
+
+	// The final line is read without a trailing newline
+	assert.Equal(t, 7, lines[5].number)
+	assert.NoError(t, lines[5].err)
+	assert.True(t, lines[5].isEdge())
+}
+
+func TestLineError(t *testing.T) {
+	var (
+		cause       = errors.New("node schema validation failed")
+		err   error = LineError{Line: 12, Err: cause}
+	)
+
+	assert.EqualError(t, err, "line 12: node schema validation failed")
+	assert.ErrorIs(t, err, cause)
+}
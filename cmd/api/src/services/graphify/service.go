@@ -19,6 +19,7 @@ import (
 	"context"
 
 	"github.com/specterops/bloodhound/cmd/api/src/config"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
 	"github.com/specterops/bloodhound/cmd/api/src/model"
 	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
 	"github.com/specterops/bloodhound/cmd/api/src/services/graphify/endpoint"
@@ -38,6 +39,10 @@ type GraphifyData interface {
 
 	RegisterSourceKind(context.Context) func(sourceKind graph.Kind) error
 	EnsureStubbedCustomNodeKindForIngest(context.Context, string) error
+
+	// Graph change history
+	RecordGraphChanges(ctx context.Context, jobID null.Int64, states model.GraphObjectStates) (int, error)
+	RecordGraphEdgeRemovals(ctx context.Context, jobID null.Int64, families []model.GraphEdgeFamily, ingestedKeys []string) (int, error)
 }
 
 type GraphifyService struct {
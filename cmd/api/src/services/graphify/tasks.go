@@ -25,6 +25,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/specterops/bloodhound/cmd/api/src/daemons/changelog"
 	"github.com/specterops/bloodhound/cmd/api/src/model"
 	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
 	"github.com/specterops/bloodhound/cmd/api/src/services/graphify/endpoint"
@@ -141,6 +142,7 @@ func (s *GraphifyService) ProcessIngestFile(ic *IngestContext, fileService stora
 					var (
 						graphifyError errorlist.Error
 						resolutionErr endpoint.ResolutionError
+						lineErr       LineError
 					)
 
 					if errors.As(err, &graphifyError) {
@@ -149,6 +151,10 @@ func (s *GraphifyService) ProcessIngestFile(ic *IngestContext, fileService stora
 								// Resolution errors are data quality issues. They are surfaced to the user via
 								// UserDataErrs but must not trigger a batch rollback.
 								fileData[i].UserDataErrs = append(fileData[i].UserDataErrs, resolutionErr.Error())
+							} else if ok := errors.As(graphifyErr, &lineErr); ok {
+								// Line errors reject a single line of an NDJSON or CSV payload; the rest of the
+								// file was ingested, so they are reported the same way as resolution errors.
+								fileData[i].UserDataErrs = append(fileData[i].UserDataErrs, lineErr.Error())
 							} else {
 								fileData[i].Errors = append(fileData[i].Errors, graphifyErr.Error())
 								errs.Add(graphifyErr)
@@ -173,8 +179,9 @@ func (s *GraphifyService) NewIngestContext(ctx context.Context, ingestTime time.
 		WithUseRawObjectIDs(useRawObjectIDs),
 	}
 
+	// The graph change history is recorded from the changes resolved by the changelog and is only kept with it
 	if useChangelog {
-		opts = append(opts, WithChangeManager(s.changeManager))
+		opts = append(opts, WithChangeManager(s.changeManager), WithChangeSet(changelog.NewChangeSet(s.db, jobId, IngestCountThreshold)))
 	}
 
 	if jobId > 0 {
@@ -239,6 +246,21 @@ func (s *GraphifyService) ProcessTasks(updateJob UpdateJobFunc) {
 		ingestCtx := s.NewIngestContext(s.ctx, time.Now().UTC(), flagChangeLogEnabled, task.JobId.ValueOrZero(), flagUseRawObjectIDsEnabled)
 		fileData, err := s.ProcessIngestFile(ingestCtx, ingestFileService, task)
 
+		if ingestCtx.ChangeSet != nil {
+			var historyErr error
+
+			// Removed edges are only recorded for complete ingests; a failed ingest would report what it missed as removed
+			if err == nil {
+				historyErr = ingestCtx.ChangeSet.Complete(s.ctx)
+			} else {
+				historyErr = ingestCtx.ChangeSet.Flush(s.ctx)
+			}
+
+			if historyErr != nil {
+				slog.WarnContext(s.ctx, "Changelog history record failed", slog.Int64("task_id", task.ID), attr.Error(historyErr))
+			}
+		}
+
 		switch {
 		case errors.Is(err, fs.ErrNotExist):
 			slog.WarnContext(s.ctx,
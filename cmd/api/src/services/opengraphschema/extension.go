@@ -28,33 +28,67 @@ import (
 // UpsertOpenGraphExtension - validates the incoming graph schema, passes it to the DB layer for upserting and if successful
 // updates the in memory kinds map.
 func (s *OpenGraphSchemaService) UpsertOpenGraphExtension(ctx context.Context, openGraphExtension model.GraphExtensionInput) (bool, error) {
-	var (
-		err          error
-		schemaExists bool
-	)
-
-	if err = openGraphExtension.Validate(); err != nil {
-		return schemaExists, fmt.Errorf("%w: %w", model.ErrGraphExtensionValidation, err)
+	if err := openGraphExtension.Validate(); err != nil {
+		return false, fmt.Errorf("%w: %w", model.ErrGraphExtensionValidation, err)
 	}
 
 	// Separated due to markdown needing a stateful and long lived validation object.
 	for _, nodeKind := range openGraphExtension.NodeKindsInput {
-		if err = s.validateKindInfoMarkdown(nodeKind.Info); err != nil {
-			return schemaExists, fmt.Errorf("%w: %w", model.ErrGraphExtensionValidation, err)
+		if err := s.validateKindInfoMarkdown(nodeKind.Info); err != nil {
+			return false, fmt.Errorf("%w: %w", model.ErrGraphExtensionValidation, err)
 		}
 	}
 	for _, relationshipKind := range openGraphExtension.RelationshipKindsInput {
-		if err = s.validateKindInfoMarkdown(relationshipKind.Info); err != nil {
-			return schemaExists, fmt.Errorf("%w: %w", model.ErrGraphExtensionValidation, err)
+		if err := s.validateKindInfoMarkdown(relationshipKind.Info); err != nil {
+			return false, fmt.Errorf("%w: %w", model.ErrGraphExtensionValidation, err)
 		}
 	}
 	for _, finding := range openGraphExtension.RelationshipFindingsInput {
-		if err = s.validateRemediationMarkdown(finding.RemediationInput); err != nil {
-			return schemaExists, fmt.Errorf("%w: %w", model.ErrGraphExtensionValidation, err)
+		if err := s.validateRemediationMarkdown(finding.RemediationInput); err != nil {
+			return false, fmt.Errorf("%w: %w", model.ErrGraphExtensionValidation, err)
+		}
+	}
+
+	// Only an upgrade that migrates graph data can fail after the extension is recorded, in which case the active
+	// version is reinstalled
+	var activeVersion *model.GraphSchemaExtensionVersion
+	if !openGraphExtension.Migrations.IsEmpty() {
+		if version, err := s.activeExtensionVersion(ctx, openGraphExtension.ExtensionInput.Name); err != nil {
+			return false, err
+		} else {
+			activeVersion = version
 		}
 	}
 
-	if schemaExists, err = s.openGraphSchemaRepository.UpsertOpenGraphExtension(ctx, openGraphExtension); err != nil {
+	return s.applyOpenGraphExtension(ctx, openGraphExtension, activeVersion)
+}
+
+// activeExtensionVersion - returns the active version of the named extension, or nil if the extension is not
+// installed or has no recorded version.
+func (s *OpenGraphSchemaService) activeExtensionVersion(ctx context.Context, extensionName string) (*model.GraphSchemaExtensionVersion, error) {
+	filters := model.Filters{"name": []model.Filter{{Operator: model.Equals, Value: extensionName, SetOperator: model.FilterAnd}}}
+
+	if extensions, _, err := s.openGraphSchemaRepository.GetGraphSchemaExtensions(ctx, filters, model.Sort{}, 0, 1); err != nil {
+		return nil, fmt.Errorf("error retrieving graph extension: %w", err)
+	} else if len(extensions) == 0 {
+		return nil, nil
+	} else if versions, err := s.openGraphSchemaRepository.GetGraphSchemaExtensionVersions(ctx, extensions[0].ID); err != nil {
+		return nil, fmt.Errorf("error retrieving graph extension versions: %w", err)
+	} else if len(versions) == 0 {
+		return nil, nil
+	} else {
+		return &versions[0], nil
+	}
+}
+
+// applyOpenGraphExtension - upserts the extension through the DB layer, migrates graph data for any kinds the extension
+// renames or drops and refreshes the in memory kinds map.
+//
+// Graph data is migrated in a single transaction after the extension is committed. If the migration fails the graph is
+// left as it was and activeVersion, the version installed before this call, is reinstalled so that the schema keeps
+// describing the graph. activeVersion may be nil when there is no version to return to.
+func (s *OpenGraphSchemaService) applyOpenGraphExtension(ctx context.Context, openGraphExtension model.GraphExtensionInput, activeVersion *model.GraphSchemaExtensionVersion) (bool, error) {
+	if schemaExists, err := s.openGraphSchemaRepository.UpsertOpenGraphExtension(ctx, openGraphExtension); err != nil {
 		// Translate database-level errors to validation errors for consistent API responses
 		if model.ErrIsGraphSchemaDuplicateError(err) {
 			return schemaExists, fmt.Errorf("%w: %w", model.ErrGraphExtensionValidation, err)
@@ -65,11 +99,67 @@ func (s *OpenGraphSchemaService) UpsertOpenGraphExtension(ctx context.Context, o
 			errors.Is(err, model.ErrKindInfoDuplicateInfoKey) {
 			return schemaExists, fmt.Errorf("%w: %w", model.ErrGraphExtensionValidation, err)
 		}
+		// Translate upgrade checks against the installed version to validation errors
+		if errors.Is(err, model.ErrGraphExtensionBreakingChange) || errors.Is(err, model.ErrGraphExtensionInvalidMigration) {
+			return schemaExists, fmt.Errorf("%w: %w", model.ErrGraphExtensionValidation, err)
+		}
 		return schemaExists, fmt.Errorf("graph schema upsert error: %w", err)
+	} else if err = s.migrateGraphKinds(ctx, openGraphExtension.Migrations); err != nil {
+		migrateErr := fmt.Errorf("%w: %w", model.ErrGraphExtensionMigrateGraph, err)
+
+		if activeVersion != nil {
+			if restoreErr := s.restoreExtensionVersion(ctx, openGraphExtension, *activeVersion); restoreErr != nil {
+				return schemaExists, errors.Join(migrateErr, fmt.Errorf("error restoring graph extension version %s: %w", activeVersion.Version, restoreErr))
+			}
+		}
+
+		return schemaExists, migrateErr
 	} else if err = s.graphDBKindRepository.RefreshKinds(ctx); err != nil {
 		return schemaExists, fmt.Errorf("%w: %w", model.ErrGraphDBRefreshKinds, err)
+	} else {
+		return schemaExists, nil
+	}
+}
+
+// restoreExtensionVersion - reinstalls a recorded version of an extension over the failed definition without touching
+// graph data.
+func (s *OpenGraphSchemaService) restoreExtensionVersion(ctx context.Context, failed model.GraphExtensionInput, version model.GraphSchemaExtensionVersion) error {
+	if restored, err := version.Input(); err != nil {
+		return err
+	} else {
+		restored.Migrations = model.RollbackMigrations(failed, restored)
+		restored.RestoresSequence = version.Sequence
+
+		_, err = s.openGraphSchemaRepository.UpsertOpenGraphExtension(ctx, restored)
+		return err
+	}
+}
+
+// RollbackOpenGraphExtension - restores the version of an extension that was installed before the active one. Graph data
+// is migrated back to the kinds of the restored version and the rolled back version is soft deleted from the history.
+func (s *OpenGraphSchemaService) RollbackOpenGraphExtension(ctx context.Context, extensionID int32) (model.GraphSchemaExtension, error) {
+	if extension, err := s.openGraphSchemaRepository.GetGraphSchemaExtensionById(ctx, extensionID); err != nil {
+		return model.GraphSchemaExtension{}, fmt.Errorf("error retrieving graph extension: %w", err)
+	} else if extension.IsBuiltin {
+		return model.GraphSchemaExtension{}, model.ErrGraphExtensionBuiltIn
+	} else if versions, err := s.openGraphSchemaRepository.GetGraphSchemaExtensionVersions(ctx, extensionID); err != nil {
+		return model.GraphSchemaExtension{}, fmt.Errorf("error retrieving graph extension versions: %w", err)
+	} else if len(versions) < 2 {
+		return model.GraphSchemaExtension{}, model.ErrGraphExtensionNoPriorVersion
+	} else if current, err := versions[0].Input(); err != nil {
+		return model.GraphSchemaExtension{}, fmt.Errorf("error decoding graph extension version %s: %w", versions[0].Version, err)
+	} else if previous, err := versions[1].Input(); err != nil {
+		return model.GraphSchemaExtension{}, fmt.Errorf("error decoding graph extension version %s: %w", versions[1].Version, err)
+	} else {
+		previous.Migrations = model.RollbackMigrations(current, previous)
+		previous.RestoresSequence = versions[1].Sequence
+
+		if _, err := s.applyOpenGraphExtension(ctx, previous, &versions[0]); err != nil {
+			return model.GraphSchemaExtension{}, err
+		}
+
+		return s.openGraphSchemaRepository.GetGraphSchemaExtensionById(ctx, extensionID)
 	}
-	return schemaExists, nil
 }
 
 // validateKindInfoMarkdown runs markdown safety validation over each kind-info entry's content.
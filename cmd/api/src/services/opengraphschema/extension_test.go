@@ -46,6 +46,46 @@ func baseSimpleGraphExtensionInput() model.GraphExtensionInput {
 	}
 }
 
+// previousGraphExtensionInput is the installed definition that migratingGraphExtensionInput upgrades
+func previousGraphExtensionInput() model.GraphExtensionInput {
+	input := baseSimpleGraphExtensionInput()
+	input.NodeKindsInput[0].Name = "DEFAULT_old node kind"
+	return input
+}
+
+// restoredGraphExtensionInput reinstalls previousGraphExtensionInput, recorded under the given sequence, over
+// migratingGraphExtensionInput
+func restoredGraphExtensionInput(sequence int32) model.GraphExtensionInput {
+	input := previousGraphExtensionInput()
+	input.Migrations = model.ExtensionMigrationsInput{
+		NodeKinds: model.KindMigrationsInput{{From: "DEFAULT_node kind 1", To: "DEFAULT_old node kind"}},
+	}
+	input.RestoresSequence = sequence
+	return input
+}
+
+// expectActiveGraphExtensionVersion expects the lookup of the version installed before an upgrade with migrations
+func expectActiveGraphExtensionVersion(t *testing.T, mock *schemamocks.MockOpenGraphSchemaRepository) {
+	t.Helper()
+
+	payload, err := json.Marshal(previousGraphExtensionInput())
+	require.NoError(t, err)
+
+	mock.EXPECT().GetGraphSchemaExtensions(gomock.Any(), model.Filters{"name": []model.Filter{{Operator: model.Equals, Value: "Test extension", SetOperator: model.FilterAnd}}}, model.Sort{}, 0, 1).
+		Return(model.GraphSchemaExtensions{{Serial: model.Serial{ID: 1}, Name: "Test extension"}}, 1, nil)
+	mock.EXPECT().GetGraphSchemaExtensionVersions(gomock.Any(), int32(1)).
+		Return([]model.GraphSchemaExtensionVersion{{SchemaExtensionId: 1, Sequence: 1, Version: "v1.0.0", Payload: payload}}, nil)
+}
+
+func migratingGraphExtensionInput() model.GraphExtensionInput {
+	input := baseSimpleGraphExtensionInput()
+	input.ExtensionInput.Version = "v2.0.0"
+	input.Migrations = model.ExtensionMigrationsInput{
+		NodeKinds: model.KindMigrationsInput{{From: "DEFAULT_old node kind", To: "DEFAULT_node kind 1"}},
+	}
+	return input
+}
+
 func TestOpenGraphSchemaService_GetGraphSchemaExtensions(t *testing.T) {
 	t.Parallel()
 
@@ -294,6 +334,116 @@ func TestOpenGraphSchemaService_UpsertGraphSchemaExtension(t *testing.T) {
 			wantErr:     model.ErrGraphDBRefreshKinds,
 			wantUpdated: false,
 		},
+		{
+			name: "fail - breaking change without migrations",
+			fields: fields{
+				func(t *testing.T, mock *schemamocks.MockOpenGraphSchemaRepository) {
+					mock.EXPECT().UpsertOpenGraphExtension(gomock.Any(), baseSimpleGraphExtensionInput()).Return(true, fmt.Errorf("%w: node kind DEFAULT_old node kind is removed without a declared migration", model.ErrGraphExtensionBreakingChange))
+				},
+				func(t *testing.T, mock *schemamocks.MockGraphDBKindRepository) {},
+			},
+			args: args{
+				ctx:            context.Background(),
+				graphExtension: baseSimpleGraphExtensionInput(),
+			},
+			wantErr:     fmt.Errorf("%w: %w: node kind DEFAULT_old node kind is removed without a declared migration", model.ErrGraphExtensionValidation, model.ErrGraphExtensionBreakingChange),
+			wantUpdated: false,
+		},
+		{
+			name: "fail - invalid migration",
+			fields: fields{
+				setupOpenGraphSchemaRepositoryMock: func(t *testing.T, mock *schemamocks.MockOpenGraphSchemaRepository) {},
+				setupGraphDBKindsRepositoryMock:    func(t *testing.T, mock *schemamocks.MockGraphDBKindRepository) {},
+			},
+			args: args{
+				ctx: context.Background(),
+				graphExtension: func() model.GraphExtensionInput {
+					input := migratingGraphExtensionInput()
+					input.Migrations.NodeKinds[0].To = "DEFAULT_undeclared"
+					return input
+				}(),
+			},
+			wantErr:     model.ErrGraphExtensionInvalidMigration,
+			wantUpdated: false,
+		},
+		{
+			name: "fail - active version lookup error",
+			fields: fields{
+				func(t *testing.T, mock *schemamocks.MockOpenGraphSchemaRepository) {
+					mock.EXPECT().GetGraphSchemaExtensions(gomock.Any(), gomock.Any(), model.Sort{}, 0, 1).Return(nil, 0, fmt.Errorf("test error"))
+				},
+				func(t *testing.T, mock *schemamocks.MockGraphDBKindRepository) {},
+			},
+			args: args{
+				ctx:            context.Background(),
+				graphExtension: migratingGraphExtensionInput(),
+			},
+			wantErr:     fmt.Errorf("error retrieving graph extension: test error"),
+			wantUpdated: false,
+		},
+		{
+			name: "fail - graph migration error restores the active version",
+			fields: fields{
+				func(t *testing.T, mock *schemamocks.MockOpenGraphSchemaRepository) {
+					expectActiveGraphExtensionVersion(t, mock)
+					gomock.InOrder(
+						mock.EXPECT().UpsertOpenGraphExtension(gomock.Any(), migratingGraphExtensionInput()).Return(true, nil),
+						mock.EXPECT().UpsertOpenGraphExtension(gomock.Any(), restoredGraphExtensionInput(1)).Return(true, nil),
+					)
+				},
+				func(t *testing.T, mock *schemamocks.MockGraphDBKindRepository) {
+					mock.EXPECT().WriteTransaction(gomock.Any(), gomock.Any()).Return(fmt.Errorf("test error"))
+				},
+			},
+			args: args{
+				ctx:            context.Background(),
+				graphExtension: migratingGraphExtensionInput(),
+			},
+			wantErr:     model.ErrGraphExtensionMigrateGraph,
+			wantUpdated: false,
+		},
+		{
+			name: "fail - graph migration error and restore error",
+			fields: fields{
+				func(t *testing.T, mock *schemamocks.MockOpenGraphSchemaRepository) {
+					expectActiveGraphExtensionVersion(t, mock)
+					gomock.InOrder(
+						mock.EXPECT().UpsertOpenGraphExtension(gomock.Any(), migratingGraphExtensionInput()).Return(true, nil),
+						mock.EXPECT().UpsertOpenGraphExtension(gomock.Any(), restoredGraphExtensionInput(1)).Return(true, fmt.Errorf("restore error")),
+					)
+				},
+				func(t *testing.T, mock *schemamocks.MockGraphDBKindRepository) {
+					mock.EXPECT().WriteTransaction(gomock.Any(), gomock.Any()).Return(fmt.Errorf("test error"))
+				},
+			},
+			args: args{
+				ctx:            context.Background(),
+				graphExtension: migratingGraphExtensionInput(),
+			},
+			wantErr:     fmt.Errorf("%w: test error\nerror restoring graph extension version v1.0.0: restore error", model.ErrGraphExtensionMigrateGraph),
+			wantUpdated: false,
+		},
+		{
+			name: "success - updated with migrations",
+			fields: fields{
+				func(t *testing.T, mock *schemamocks.MockOpenGraphSchemaRepository) {
+					expectActiveGraphExtensionVersion(t, mock)
+					mock.EXPECT().UpsertOpenGraphExtension(gomock.Any(), migratingGraphExtensionInput()).Return(true, nil)
+				},
+				func(t *testing.T, mock *schemamocks.MockGraphDBKindRepository) {
+					gomock.InOrder(
+						mock.EXPECT().WriteTransaction(gomock.Any(), gomock.Any()).Return(nil),
+						mock.EXPECT().RefreshKinds(gomock.Any()).Return(nil),
+					)
+				},
+			},
+			args: args{
+				ctx:            context.Background(),
+				graphExtension: migratingGraphExtensionInput(),
+			},
+			wantErr:     nil,
+			wantUpdated: true,
+		},
 		{
 			name: "success - inserted",
 			fields: fields{
@@ -921,6 +1071,153 @@ func TestOpenGraphSchemaService_DeleteExtension(t *testing.T) {
 	}
 }
 
+func TestOpenGraphSchemaService_RollbackOpenGraphExtension(t *testing.T) {
+	t.Parallel()
+
+	type mocks struct {
+		mockOpenGraphSchema *schemamocks.MockOpenGraphSchemaRepository
+		mockGraphDB         *schemamocks.MockGraphDBKindRepository
+	}
+	type expected struct {
+		extension model.GraphSchemaExtension
+		err       error
+	}
+
+	var (
+		extension = model.GraphSchemaExtension{
+			Serial:      model.Serial{ID: 1},
+			Name:        "Test extension",
+			DisplayName: "Test extension",
+			Version:     "v2.0.0",
+			Namespace:   "DEFAULT",
+		}
+		rolledBackExtension = model.GraphSchemaExtension{
+			Serial:      model.Serial{ID: 1},
+			Name:        "Test extension",
+			DisplayName: "Test extension",
+			Version:     "v1.0.0",
+			Namespace:   "DEFAULT",
+		}
+
+		previousInput = previousGraphExtensionInput()
+		currentInput  = migratingGraphExtensionInput()
+	)
+
+	previousPayload, err := json.Marshal(previousInput)
+	require.NoError(t, err)
+	currentPayload, err := json.Marshal(currentInput)
+	require.NoError(t, err)
+
+	versions := []model.GraphSchemaExtensionVersion{
+		{SchemaExtensionId: 1, Sequence: 2, Version: "v2.0.0", Payload: currentPayload},
+		{SchemaExtensionId: 1, Sequence: 1, Version: "v1.0.0", Payload: previousPayload},
+	}
+
+	// Rolling back renames the migrated kind back to the kind declared by the previous version
+	restoredInput := restoredGraphExtensionInput(1)
+
+	// A failed rollback reinstalls the version that was active
+	reinstatedInput := currentInput
+	reinstatedInput.Migrations = model.ExtensionMigrationsInput{
+		NodeKinds: model.KindMigrationsInput{{From: "DEFAULT_old node kind", To: "DEFAULT_node kind 1"}},
+	}
+	reinstatedInput.RestoresSequence = 2
+
+	tests := []struct {
+		name       string
+		setupMocks func(t *testing.T, m *mocks)
+		expected   expected
+	}{
+		{
+			name: "Error: extension not found",
+			setupMocks: func(t *testing.T, m *mocks) {
+				t.Helper()
+				m.mockOpenGraphSchema.EXPECT().GetGraphSchemaExtensionById(gomock.Any(), int32(1)).Return(model.GraphSchemaExtension{}, errors.New("error"))
+			},
+			expected: expected{
+				err: errors.New("error retrieving graph extension: error"),
+			},
+		},
+		{
+			name: "Error: built-in extension",
+			setupMocks: func(t *testing.T, m *mocks) {
+				t.Helper()
+				m.mockOpenGraphSchema.EXPECT().GetGraphSchemaExtensionById(gomock.Any(), int32(1)).Return(model.GraphSchemaExtension{IsBuiltin: true}, nil)
+			},
+			expected: expected{
+				err: model.ErrGraphExtensionBuiltIn,
+			},
+		},
+		{
+			name: "Error: no prior version",
+			setupMocks: func(t *testing.T, m *mocks) {
+				t.Helper()
+				m.mockOpenGraphSchema.EXPECT().GetGraphSchemaExtensionById(gomock.Any(), int32(1)).Return(extension, nil)
+				m.mockOpenGraphSchema.EXPECT().GetGraphSchemaExtensionVersions(gomock.Any(), int32(1)).Return(versions[:1], nil)
+			},
+			expected: expected{
+				err: model.ErrGraphExtensionNoPriorVersion,
+			},
+		},
+		{
+			name: "Error: graph migration failed",
+			setupMocks: func(t *testing.T, m *mocks) {
+				t.Helper()
+				m.mockOpenGraphSchema.EXPECT().GetGraphSchemaExtensionById(gomock.Any(), int32(1)).Return(extension, nil)
+				m.mockOpenGraphSchema.EXPECT().GetGraphSchemaExtensionVersions(gomock.Any(), int32(1)).Return(versions, nil)
+				gomock.InOrder(
+					m.mockOpenGraphSchema.EXPECT().UpsertOpenGraphExtension(gomock.Any(), restoredInput).Return(true, nil),
+					m.mockGraphDB.EXPECT().WriteTransaction(gomock.Any(), gomock.Any()).Return(errors.New("error")),
+					m.mockOpenGraphSchema.EXPECT().UpsertOpenGraphExtension(gomock.Any(), reinstatedInput).Return(true, nil),
+				)
+			},
+			expected: expected{
+				err: fmt.Errorf("%w: error", model.ErrGraphExtensionMigrateGraph),
+			},
+		},
+		{
+			name: "Success",
+			setupMocks: func(t *testing.T, m *mocks) {
+				t.Helper()
+				gomock.InOrder(
+					m.mockOpenGraphSchema.EXPECT().GetGraphSchemaExtensionById(gomock.Any(), int32(1)).Return(extension, nil),
+					m.mockOpenGraphSchema.EXPECT().GetGraphSchemaExtensionVersions(gomock.Any(), int32(1)).Return(versions, nil),
+					m.mockOpenGraphSchema.EXPECT().UpsertOpenGraphExtension(gomock.Any(), restoredInput).Return(true, nil),
+					m.mockGraphDB.EXPECT().WriteTransaction(gomock.Any(), gomock.Any()).Return(nil),
+					m.mockGraphDB.EXPECT().RefreshKinds(gomock.Any()).Return(nil),
+					m.mockOpenGraphSchema.EXPECT().GetGraphSchemaExtensionById(gomock.Any(), int32(1)).Return(rolledBackExtension, nil),
+				)
+			},
+			expected: expected{
+				extension: rolledBackExtension,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			m := &mocks{
+				mockOpenGraphSchema: schemamocks.NewMockOpenGraphSchemaRepository(ctrl),
+				mockGraphDB:         schemamocks.NewMockGraphDBKindRepository(ctrl),
+			}
+
+			tt.setupMocks(t, m)
+
+			service := opengraphschema.NewOpenGraphSchemaService(m.mockOpenGraphSchema, m.mockGraphDB)
+
+			result, err := service.RollbackOpenGraphExtension(context.Background(), 1)
+			if tt.expected.err != nil {
+				assert.EqualError(t, err, tt.expected.err.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected.extension, result)
+			}
+		})
+	}
+}
+
 func TestOpenGraphSchemaService_GetEnvironmentKindsAndSchemaEnvironmentData(t *testing.T) {
 	t.Parallel()
 
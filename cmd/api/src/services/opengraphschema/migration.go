@@ -0,0 +1,122 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package opengraphschema
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/ops"
+	"github.com/specterops/dawgs/query"
+)
+
+// migrationBatchSize is the number of nodes or relationships loaded at a time while migrating a kind.
+const migrationBatchSize = 1000
+
+// migrateGraphKinds applies the kind migrations declared by an extension upgrade to graph data in a single write
+// transaction. Retrying a migration is safe since once applied no data of the source kinds remains to be moved.
+func (s *OpenGraphSchemaService) migrateGraphKinds(ctx context.Context, migrations model.ExtensionMigrationsInput) error {
+	if migrations.IsEmpty() {
+		return nil
+	}
+
+	return s.graphDBKindRepository.WriteTransaction(ctx, func(tx graph.Transaction) error {
+		for _, migration := range migrations.NodeKinds {
+			if err := migrateNodeKind(tx, migration); err != nil {
+				return fmt.Errorf("migrating node kind %s: %w", migration.From, err)
+			}
+		}
+
+		for _, migration := range migrations.RelationshipKinds {
+			if err := migrateRelationshipKind(tx, migration); err != nil {
+				return fmt.Errorf("migrating relationship kind %s: %w", migration.From, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// migrateNodeKind replaces the source kind label on every node carrying it, or strips it when the migration is a drop.
+// Only the IDs of the affected nodes are held at once; the nodes themselves are loaded and updated in batches.
+func migrateNodeKind(tx graph.Transaction, migration model.KindMigrationInput) error {
+	fromKind := graph.StringKind(migration.From)
+
+	nodeIDs, err := ops.FetchNodeIDs(tx.Nodes().Filter(query.KindIn(query.Node(), fromKind)))
+	if err != nil {
+		return err
+	}
+
+	for batch := range slices.Chunk(nodeIDs, migrationBatchSize) {
+		if nodes, err := ops.FetchNodes(tx.Nodes().Filter(query.InIDs(query.NodeID(), batch...))); err != nil {
+			return err
+		} else {
+			for _, node := range nodes {
+				node.DeleteKinds(fromKind)
+
+				if !migration.IsDrop() {
+					node.AddKinds(graph.StringKind(migration.To))
+				}
+
+				if err := tx.UpdateNode(node); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// migrateRelationshipKind moves every relationship of the source kind to the target kind. Relationship kinds cannot be
+// updated in place so each relationship is recreated with its properties before the original is deleted. Only the IDs
+// of the affected relationships are held at once; the relationships themselves are loaded and moved in batches.
+func migrateRelationshipKind(tx graph.Transaction, migration model.KindMigrationInput) error {
+	criteria := query.KindIn(query.Relationship(), graph.StringKind(migration.From))
+
+	if migration.IsDrop() {
+		return tx.Relationships().Filter(criteria).Delete()
+	}
+
+	relationshipIDs, err := ops.FetchRelationshipIDs(tx.Relationships().Filter(criteria))
+	if err != nil {
+		return err
+	}
+
+	for batch := range slices.Chunk(relationshipIDs, migrationBatchSize) {
+		batchCriteria := query.InIDs(query.RelationshipID(), batch...)
+
+		if relationships, err := ops.FetchRelationships(tx.Relationships().Filter(batchCriteria)); err != nil {
+			return err
+		} else {
+			for _, relationship := range relationships {
+				if _, err := tx.CreateRelationshipByIDs(relationship.StartID, relationship.EndID, graph.StringKind(migration.To), relationship.Properties); err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := tx.Relationships().Filter(batchCriteria).Delete(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
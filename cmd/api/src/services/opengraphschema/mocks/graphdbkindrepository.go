@@ -29,6 +29,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	graph "github.com/specterops/dawgs/graph"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -69,3 +70,22 @@ func (mr *MockGraphDBKindRepositoryMockRecorder) RefreshKinds(ctx any) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshKinds", reflect.TypeOf((*MockGraphDBKindRepository)(nil).RefreshKinds), ctx)
 }
+
+// WriteTransaction mocks base method.
+func (m *MockGraphDBKindRepository) WriteTransaction(ctx context.Context, txDelegate graph.TransactionDelegate, options ...graph.TransactionOption) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, txDelegate}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WriteTransaction", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteTransaction indicates an expected call of WriteTransaction.
+func (mr *MockGraphDBKindRepositoryMockRecorder) WriteTransaction(ctx, txDelegate any, options ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, txDelegate}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteTransaction", reflect.TypeOf((*MockGraphDBKindRepository)(nil).WriteTransaction), varargs...)
+}
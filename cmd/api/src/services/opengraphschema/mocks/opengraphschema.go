@@ -86,6 +86,36 @@ func (mr *MockOpenGraphSchemaRepositoryMockRecorder) GetEnvironmentsFiltered(ctx
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnvironmentsFiltered", reflect.TypeOf((*MockOpenGraphSchemaRepository)(nil).GetEnvironmentsFiltered), ctx, filters)
 }
 
+// GetGraphSchemaExtensionById mocks base method.
+func (m *MockOpenGraphSchemaRepository) GetGraphSchemaExtensionById(ctx context.Context, extensionId int32) (model.GraphSchemaExtension, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGraphSchemaExtensionById", ctx, extensionId)
+	ret0, _ := ret[0].(model.GraphSchemaExtension)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGraphSchemaExtensionById indicates an expected call of GetGraphSchemaExtensionById.
+func (mr *MockOpenGraphSchemaRepositoryMockRecorder) GetGraphSchemaExtensionById(ctx, extensionId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGraphSchemaExtensionById", reflect.TypeOf((*MockOpenGraphSchemaRepository)(nil).GetGraphSchemaExtensionById), ctx, extensionId)
+}
+
+// GetGraphSchemaExtensionVersions mocks base method.
+func (m *MockOpenGraphSchemaRepository) GetGraphSchemaExtensionVersions(ctx context.Context, extensionId int32) ([]model.GraphSchemaExtensionVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGraphSchemaExtensionVersions", ctx, extensionId)
+	ret0, _ := ret[0].([]model.GraphSchemaExtensionVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGraphSchemaExtensionVersions indicates an expected call of GetGraphSchemaExtensionVersions.
+func (mr *MockOpenGraphSchemaRepositoryMockRecorder) GetGraphSchemaExtensionVersions(ctx, extensionId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGraphSchemaExtensionVersions", reflect.TypeOf((*MockOpenGraphSchemaRepository)(nil).GetGraphSchemaExtensionVersions), ctx, extensionId)
+}
+
 // GetGraphSchemaExtensions mocks base method.
 func (m *MockOpenGraphSchemaRepository) GetGraphSchemaExtensions(ctx context.Context, extensionFilters model.Filters, sort model.Sort, skip, limit int) (model.GraphSchemaExtensions, int, error) {
 	m.ctrl.T.Helper()
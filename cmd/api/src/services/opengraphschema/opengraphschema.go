@@ -20,6 +20,7 @@ import (
 	"context"
 
 	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/dawgs/graph"
 )
 
 // OpenGraphSchemaRepository -
@@ -27,6 +28,8 @@ import (
 //go:generate go run go.uber.org/mock/mockgen -copyright_file ../../../../../LICENSE.header -destination=./mocks/opengraphschema.go -package=mocks . OpenGraphSchemaRepository
 type OpenGraphSchemaRepository interface {
 	UpsertOpenGraphExtension(ctx context.Context, graphExtensionInput model.GraphExtensionInput) (bool, error)
+	GetGraphSchemaExtensionById(ctx context.Context, extensionId int32) (model.GraphSchemaExtension, error)
+	GetGraphSchemaExtensionVersions(ctx context.Context, extensionId int32) ([]model.GraphSchemaExtensionVersion, error)
 	GetGraphSchemaExtensions(ctx context.Context, extensionFilters model.Filters, sort model.Sort, skip, limit int) (model.GraphSchemaExtensions, int, error)
 	DeleteGraphSchemaExtension(ctx context.Context, extensionID int32) error
 	GetEnvironmentsFiltered(ctx context.Context, filters model.Filters) ([]model.SchemaEnvironment, error)
@@ -39,6 +42,8 @@ type OpenGraphSchemaRepository interface {
 type GraphDBKindRepository interface {
 	// RefreshKinds refreshes the database and in memory kinds maps
 	RefreshKinds(ctx context.Context) error
+	// WriteTransaction runs the delegate in a single graph write transaction
+	WriteTransaction(ctx context.Context, txDelegate graph.TransactionDelegate, options ...graph.TransactionOption) error
 }
 
 type OpenGraphSchemaService struct {
@@ -17,6 +17,7 @@ package storage
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"strings"
@@ -94,24 +95,63 @@ func (s *fileServiceResolver) Resolve(name storage.FileServiceName) (storage.Fil
 	return fileService, nil
 }
 
-func createS3Store(bucket, prefix string, client *s3.Client) storage.FileService {
-	return storage.NewFileService(storage.NewS3Store(bucket, prefix, client))
+func createS3Store(bucket, prefix string, client *s3.Client) storage.Storage {
+	return storage.NewS3Store(bucket, prefix, client)
 }
 
-// createLocalStore takes a location to create the storage.LocalStore, and wraps that in a
-// storage.FileService. Both are returned. If there is an error in this process, nil is
-// returned for both structs, and the error is returned.
-func createLocalStore(location string) (*storage.LocalStore, storage.FileService, error) {
+// createLocalStore takes a location to create the storage.LocalStore. If there is an error in
+// this process, nil is returned along with the error.
+func createLocalStore(location string) (*storage.LocalStore, error) {
 	var (
 		localStore *storage.LocalStore
 		err        error
 	)
 
 	if localStore, err = storage.NewLocalStore(location); err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	return localStore, storage.NewFileService(localStore), nil
+	return localStore, nil
+}
+
+// defaultEncryptedFileServices are the file services that are encrypted when storage encryption is
+// enabled and the configuration does not name any.
+var defaultEncryptedFileServices = []storage.FileServiceName{
+	storage.FileServiceIngest,
+	storage.FileServiceRetained,
+	storage.FileServiceJobLogs,
+}
+
+func decodeMasterKey(keyID, encodedKey string) ([]byte, error) {
+	if keyID == "" {
+		return nil, errors.New("storage encryption master key id is required")
+	} else if key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encodedKey)); err != nil {
+		return nil, fmt.Errorf("storage encryption key %q is not valid base64: %w", keyID, err)
+	} else {
+		return key, nil
+	}
+}
+
+// createKeyRing builds the storage.KeyRing described by the encryption configuration. The configured
+// master key is the active key; retired keys are kept so existing envelopes remain readable until rotated.
+func createKeyRing(encryptionConfiguration config.StorageEncryptionConfiguration) (*storage.KeyRing, error) {
+	keys := make(map[string][]byte, len(encryptionConfiguration.RetiredKeys)+1)
+
+	for keyID, encodedKey := range encryptionConfiguration.RetiredKeys {
+		if key, err := decodeMasterKey(keyID, encodedKey); err != nil {
+			return nil, err
+		} else {
+			keys[keyID] = key
+		}
+	}
+
+	if key, err := decodeMasterKey(encryptionConfiguration.MasterKeyID, encryptionConfiguration.MasterKey); err != nil {
+		return nil, err
+	} else {
+		keys[encryptionConfiguration.MasterKeyID] = key
+	}
+
+	return storage.NewKeyRing(encryptionConfiguration.MasterKeyID, keys)
 }
 
 // closeLocalStores contains the functionality to close any storage.LocalStore that has been opened
@@ -126,6 +166,26 @@ func closeLocalStores(localStores []*storage.LocalStore) error {
 	return closeErr
 }
 
+// CloseFileServices closes the storage.LocalStore backing each of the given file services, including the local stores
+// wrapped by a storage.EncryptedStore. Errors from the close are joined together and returned.
+func CloseFileServices(fileServices FileServiceMap) error {
+	var localStores []*storage.LocalStore
+
+	for _, fileService := range fileServices {
+		if storageFileService, ok := fileService.(*storage.StorageFileService); !ok {
+			continue
+		} else if encryptedStore, ok := storageFileService.Storage.(*storage.EncryptedStore); ok {
+			if localStore, ok := encryptedStore.Storage.(*storage.LocalStore); ok {
+				localStores = append(localStores, localStore)
+			}
+		} else if localStore, ok := storageFileService.Storage.(*storage.LocalStore); ok {
+			localStores = append(localStores, localStore)
+		}
+	}
+
+	return closeLocalStores(localStores)
+}
+
 func parseFileServiceProvider(provider string) (fileServiceProvider, error) {
 	provider = strings.TrimSpace(provider)
 
@@ -164,6 +224,12 @@ type resolvedFileServiceDefinition struct {
 	definition FileServiceDefinition
 	provider   fileServiceProvider
 	prefix     string
+	encrypted  bool
+}
+
+func isEncrypted(encryptedServices map[storage.FileServiceName]struct{}, name storage.FileServiceName) bool {
+	_, found := encryptedServices[name]
+	return found
 }
 
 func resolveFileServiceDefinitions(cfg config.Configuration, definitions []FileServiceDefinition) ([]resolvedFileServiceDefinition, bool, error) {
@@ -171,9 +237,22 @@ func resolveFileServiceDefinitions(cfg config.Configuration, definitions []FileS
 		definitionsByName   = make(map[storage.FileServiceName]struct{}, len(definitions))
 		resolvedDefinitions = make([]resolvedFileServiceDefinition, 0, len(definitions))
 		s3Prefixes          = make(map[string]storage.FileServiceName)
+		encryptedServices   = make(map[storage.FileServiceName]struct{})
 		s3Required          bool
 	)
 
+	if cfg.Storage.Encryption.Enabled() {
+		if len(cfg.Storage.Encryption.FileServices) == 0 {
+			for _, serviceName := range defaultEncryptedFileServices {
+				encryptedServices[serviceName] = struct{}{}
+			}
+		} else {
+			for _, serviceName := range cfg.Storage.Encryption.FileServices {
+				encryptedServices[storage.FileServiceName(serviceName)] = struct{}{}
+			}
+		}
+	}
+
 	for _, definition := range definitions {
 		var (
 			provider             = fileServiceProviderLocal
@@ -216,6 +295,7 @@ func resolveFileServiceDefinitions(cfg config.Configuration, definitions []FileS
 			definition: definition,
 			provider:   provider,
 			prefix:     prefix,
+			encrypted:  isEncrypted(encryptedServices, definition.Name),
 		})
 	}
 
@@ -225,6 +305,12 @@ func resolveFileServiceDefinitions(cfg config.Configuration, definitions []FileS
 		}
 	}
 
+	for _, encryptedServiceName := range cfg.Storage.Encryption.FileServices {
+		if _, found := definitionsByName[storage.FileServiceName(encryptedServiceName)]; !found {
+			return nil, false, fmt.Errorf("encryption configuration references unknown file service %q", encryptedServiceName)
+		}
+	}
+
 	return resolvedDefinitions, s3Required, nil
 }
 
@@ -266,8 +352,9 @@ func NewDefaultFileServices(ctx context.Context, cfg config.Configuration, addit
 		fileServices        = make(FileServiceMap, len(definitions))
 		openedStores        []*storage.LocalStore
 		resolvedDefinitions []resolvedFileServiceDefinition
-		fileService         storage.FileService
+		store               storage.Storage
 		localStore          *storage.LocalStore
+		keyRing             *storage.KeyRing
 		s3Client            *s3.Client
 		s3Required          bool
 		err                 error
@@ -278,6 +365,12 @@ func NewDefaultFileServices(ctx context.Context, cfg config.Configuration, addit
 		return nil, err
 	}
 
+	if cfg.Storage.Encryption.Enabled() {
+		if keyRing, err = createKeyRing(cfg.Storage.Encryption); err != nil {
+			return nil, err
+		}
+	}
+
 	if s3Required {
 		if s3Client, err = createS3Client(ctx, cfg.Storage.InstanceBucket); err != nil {
 			return nil, err
@@ -287,21 +380,48 @@ func NewDefaultFileServices(ctx context.Context, cfg config.Configuration, addit
 	for _, resolvedDefinition := range resolvedDefinitions {
 		switch resolvedDefinition.provider {
 		case fileServiceProviderS3:
-			fileServices[resolvedDefinition.definition.Name] = createS3Store(
+			store = createS3Store(
 				strings.TrimSpace(cfg.Storage.InstanceBucket.Name),
 				resolvedDefinition.prefix,
 				s3Client,
 			)
 		case fileServiceProviderLocal:
-			localStore, fileService, err = createLocalStore(resolvedDefinition.definition.LocalPath)
+			localStore, err = createLocalStore(resolvedDefinition.definition.LocalPath)
 			if err != nil {
 				return nil, errors.Join(err, closeLocalStores(openedStores))
 			}
 
 			openedStores = append(openedStores, localStore)
-			fileServices[resolvedDefinition.definition.Name] = fileService
+			store = localStore
 		}
+
+		if resolvedDefinition.encrypted {
+			store = storage.NewEncryptedStore(store, keyRing)
+		}
+
+		fileServices[resolvedDefinition.definition.Name] = storage.NewFileService(store)
 	}
 
 	return fileServices, nil
 }
+
+// RotateEncryptionKeys re-wraps the data keys of every encrypted file service with the configured master key and
+// encrypts files written before encryption was enabled. Only the key records of encrypted files are rewritten. The
+// number of rewritten files is returned per service.
+func RotateEncryptionKeys(ctx context.Context, fileServices FileServiceMap) (map[storage.FileServiceName]int, error) {
+	rotated := make(map[storage.FileServiceName]int, len(fileServices))
+
+	for serviceName, fileService := range fileServices {
+		if storageFileService, ok := fileService.(*storage.StorageFileService); !ok {
+			continue
+		} else if encryptedStore, ok := storageFileService.Storage.(*storage.EncryptedStore); !ok {
+			continue
+		} else if count, err := encryptedStore.RotateKeys(ctx, ""); err != nil {
+			return rotated, fmt.Errorf("rotate keys of file service %q: %w", serviceName, err)
+		} else {
+			rotated[serviceName] = count
+		}
+	}
+
+	return rotated, nil
+}
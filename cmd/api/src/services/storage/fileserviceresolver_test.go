@@ -29,6 +29,9 @@ import (
 	"go.uber.org/mock/gomock"
 )
 
+// testMasterKey is a base64 encoded 32 byte storage encryption key.
+const testMasterKey = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+
 func newTestStorageConfiguration(workDir string, collectorsBasePath string) config.Configuration {
 	return config.Configuration{
 		WorkDir:            workDir,
@@ -282,6 +285,102 @@ func TestNewDefaultFileServices_ConfiguredS3Provider(t *testing.T) {
 	}
 }
 
+func TestNewDefaultFileServices_Encryption(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	var (
+		workDir            = t.TempDir()
+		collectorsBasePath = t.TempDir()
+		configuration      = newTestStorageConfiguration(workDir, collectorsBasePath)
+	)
+
+	require.NoError(t, os.MkdirAll(configuration.TempDirectory(), 0o750))
+	require.NoError(t, os.MkdirAll(configuration.RetainedFilesDirectory(), 0o750))
+
+	configuration.Storage.Encryption = config.StorageEncryptionConfiguration{
+		MasterKeyID: "primary",
+		MasterKey:   testMasterKey,
+	}
+
+	// Act
+	fileServices, err := api_storage.NewDefaultFileServices(context.Background(), configuration)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, fileServices, 4)
+
+	for serviceName, fileService := range fileServices {
+		storageFileService, ok := fileService.(*storage.StorageFileService)
+		require.True(t, ok)
+
+		store := storageFileService.Storage
+		if serviceName == storage.FileServiceIngest || serviceName == storage.FileServiceRetained {
+			encryptedStore, ok := store.(*storage.EncryptedStore)
+			require.True(t, ok, "file service %s is not encrypted", serviceName)
+			store = encryptedStore.Storage
+		}
+
+		localStore, ok := store.(*storage.LocalStore)
+		require.True(t, ok, "file service %s is not a local store", serviceName)
+		require.NoError(t, localStore.Close())
+	}
+}
+
+func TestRotateEncryptionKeys(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	var (
+		workDir            = t.TempDir()
+		collectorsBasePath = t.TempDir()
+		configuration      = newTestStorageConfiguration(workDir, collectorsBasePath)
+		rotatedKey         = "ZmVkY2JhOTg3NjU0MzIxMGZlZGNiYTk4NzY1NDMyMTA="
+	)
+
+	require.NoError(t, os.MkdirAll(configuration.TempDirectory(), 0o750))
+	require.NoError(t, os.MkdirAll(configuration.RetainedFilesDirectory(), 0o750))
+
+	configuration.Storage.Encryption = config.StorageEncryptionConfiguration{
+		MasterKeyID:  "primary",
+		MasterKey:    testMasterKey,
+		FileServices: []string{string(storage.FileServiceRetained)},
+	}
+
+	fileServices, err := api_storage.NewDefaultFileServices(context.Background(), configuration)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, api_storage.CloseFileServices(fileServices)) })
+	require.NoError(t, fileServices[storage.FileServiceRetained].WriteFile(context.Background(), "retained.zip", []byte("content"), storage.WriteOptions{}))
+
+	configuration.Storage.Encryption = config.StorageEncryptionConfiguration{
+		MasterKeyID:  "secondary",
+		MasterKey:    rotatedKey,
+		RetiredKeys:  map[string]string{"primary": testMasterKey},
+		FileServices: []string{string(storage.FileServiceRetained)},
+	}
+
+	rotatingFileServices, err := api_storage.NewDefaultFileServices(context.Background(), configuration)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, api_storage.CloseFileServices(rotatingFileServices)) })
+
+	// Act
+	rotated, err := api_storage.RotateEncryptionKeys(context.Background(), rotatingFileServices)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, map[storage.FileServiceName]int{storage.FileServiceRetained: 1}, rotated)
+
+	configuration.Storage.Encryption.RetiredKeys = nil
+
+	rotatedFileServices, err := api_storage.NewDefaultFileServices(context.Background(), configuration)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, api_storage.CloseFileServices(rotatedFileServices)) })
+
+	content, err := rotatedFileServices[storage.FileServiceRetained].ReadFile(context.Background(), "retained.zip")
+	require.NoError(t, err)
+	require.Equal(t, []byte("content"), content)
+}
+
 func TestNewDefaultFileServices_RejectsInvalidConfiguration(t *testing.T) {
 	t.Parallel()
 
@@ -365,6 +464,38 @@ func TestNewDefaultFileServices_RejectsInvalidConfiguration(t *testing.T) {
 			},
 			errContains: `duplicate file service definition "ingest"`,
 		},
+		{
+			name: "invalid encryption master key",
+			storageConfiguration: config.StorageConfiguration{
+				Encryption: config.StorageEncryptionConfiguration{MasterKeyID: "primary", MasterKey: "not base64!"},
+			},
+			errContains: `storage encryption key "primary" is not valid base64`,
+		},
+		{
+			name: "missing encryption master key id",
+			storageConfiguration: config.StorageConfiguration{
+				Encryption: config.StorageEncryptionConfiguration{MasterKey: testMasterKey},
+			},
+			errContains: "storage encryption master key id is required",
+		},
+		{
+			name: "short encryption master key",
+			storageConfiguration: config.StorageConfiguration{
+				Encryption: config.StorageEncryptionConfiguration{MasterKeyID: "primary", MasterKey: "c2hvcnQ="},
+			},
+			errContains: `master key "primary" must be 32 bytes`,
+		},
+		{
+			name: "unknown encrypted file service",
+			storageConfiguration: config.StorageConfiguration{
+				Encryption: config.StorageEncryptionConfiguration{
+					MasterKeyID:  "primary",
+					MasterKey:    testMasterKey,
+					FileServices: []string{"unknown"},
+				},
+			},
+			errContains: `encryption configuration references unknown file service "unknown"`,
+		},
 	}
 
 	for _, testCase := range tests {
@@ -0,0 +1,282 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package upload
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// CSV uploads are stored as a single file: the first line holds the header mapping encoded as JSON and the CSV file
+// follows unchanged. Keeping the mapping with the file lets ingest interpret the columns without another lookup.
+
+var ErrInvalidCSVHeaderMapping = errors.New("invalid csv header mapping")
+
+const (
+	CSVEntityTypeNodes = "nodes"
+	CSVEntityTypeEdges = "edges"
+
+	defaultCSVKindSeparator = "|"
+	maxCSVHeaderMappingSize = 1 << 20
+)
+
+// CSVEndpointMapping maps the column that references an edge's start or end node
+type CSVEndpointMapping struct {
+	Column string `json:"column"`
+	// MatchBy is one of id, name or property and defaults to id
+	MatchBy string `json:"match_by,omitempty"`
+	// Property is the node property compared against the column when MatchBy is property
+	Property string `json:"property,omitempty"`
+	// Kind optionally restricts the referenced node to a kind
+	Kind string `json:"kind,omitempty"`
+}
+
+// CSVHeaderMapping describes how the columns of a CSV file map to OpenGraph nodes or edges. A file holds a single
+// entity type. Properties maps property names to columns; when it is empty every column not otherwise mapped becomes a
+// property named after its header.
+type CSVHeaderMapping struct {
+	Type       string `json:"type"`
+	SourceKind string `json:"source_kind,omitempty"`
+
+	// Node columns
+	ID            string `json:"id,omitempty"`
+	Kinds         string `json:"kinds,omitempty"`
+	KindSeparator string `json:"kind_separator,omitempty"`
+
+	// Edge columns
+	Kind  string             `json:"kind,omitempty"`
+	Start CSVEndpointMapping `json:"start"`
+	End   CSVEndpointMapping `json:"end"`
+
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+// Validate checks that the mapping names every column its entity type requires
+func (s CSVHeaderMapping) Validate() error {
+	switch s.Type {
+	case CSVEntityTypeNodes:
+		if s.ID == "" {
+			return fmt.Errorf("%w: id column is required for nodes", ErrInvalidCSVHeaderMapping)
+		} else if s.Kinds == "" {
+			return fmt.Errorf("%w: kinds column is required for nodes", ErrInvalidCSVHeaderMapping)
+		}
+
+	case CSVEntityTypeEdges:
+		if s.Kind == "" {
+			return fmt.Errorf("%w: kind column is required for edges", ErrInvalidCSVHeaderMapping)
+		} else if err := s.Start.validate("start"); err != nil {
+			return err
+		} else if err := s.End.validate("end"); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("%w: type must be %s or %s", ErrInvalidCSVHeaderMapping, CSVEntityTypeNodes, CSVEntityTypeEdges)
+	}
+
+	for property, column := range s.Properties {
+		if property == "" || column == "" {
+			return fmt.Errorf("%w: property mappings require a property name and a column", ErrInvalidCSVHeaderMapping)
+		}
+	}
+
+	return nil
+}
+
+func (s CSVEndpointMapping) validate(endpoint string) error {
+	switch {
+	case s.Column == "":
+		return fmt.Errorf("%w: %s column is required for edges", ErrInvalidCSVHeaderMapping, endpoint)
+	case !slices.Contains([]string{"", "id", "name", "property"}, s.MatchBy):
+		return fmt.Errorf("%w: %s match_by must be id, name or property", ErrInvalidCSVHeaderMapping, endpoint)
+	case s.MatchBy == "property" && s.Property == "":
+		return fmt.Errorf("%w: %s property is required when matching by property", ErrInvalidCSVHeaderMapping, endpoint)
+	default:
+		return nil
+	}
+}
+
+// entityColumns returns the columns the mapping reads to build the entity itself, as opposed to its properties
+func (s CSVHeaderMapping) entityColumns() []string {
+	if s.Type == CSVEntityTypeNodes {
+		return []string{s.ID, s.Kinds}
+	}
+
+	return []string{s.Kind, s.Start.Column, s.End.Column}
+}
+
+// NewRowMapper checks the CSV header against the mapping and returns a mapper for the rows that follow it
+func (s CSVHeaderMapping) NewRowMapper(header []string) (CSVRowMapper, error) {
+	columns := make(map[string]int, len(header))
+
+	for idx, column := range header {
+		if _, duplicate := columns[column]; duplicate {
+			return CSVRowMapper{}, fmt.Errorf("%w: csv header has duplicate column %s", ErrInvalidCSVHeaderMapping, column)
+		}
+		columns[column] = idx
+	}
+
+	for _, column := range s.entityColumns() {
+		if _, ok := columns[column]; !ok {
+			return CSVRowMapper{}, fmt.Errorf("%w: csv header is missing mapped column %s", ErrInvalidCSVHeaderMapping, column)
+		}
+	}
+
+	properties := make(map[string]int)
+	if len(s.Properties) > 0 {
+		for property, column := range s.Properties {
+			if idx, ok := columns[column]; !ok {
+				return CSVRowMapper{}, fmt.Errorf("%w: csv header is missing mapped column %s", ErrInvalidCSVHeaderMapping, column)
+			} else {
+				properties[property] = idx
+			}
+		}
+	} else {
+		entityColumns := s.entityColumns()
+		for idx, column := range header {
+			if !slices.Contains(entityColumns, column) {
+				properties[column] = idx
+			}
+		}
+	}
+
+	return CSVRowMapper{
+		mapping:    s,
+		columns:    columns,
+		properties: properties,
+	}, nil
+}
+
+// CSVRowMapper converts CSV rows into OpenGraph node or edge documents shaped like the elements of a JSON payload's
+// nodes or edges array, so that they are validated and ingested the same way.
+type CSVRowMapper struct {
+	mapping    CSVHeaderMapping
+	columns    map[string]int
+	properties map[string]int
+}
+
+// Map returns the document for a single row. Cell values are kept as strings and empty cells are omitted.
+func (s CSVRowMapper) Map(record []string) map[string]any {
+	var (
+		properties = make(map[string]any, len(s.properties))
+		document   = map[string]any{"properties": properties}
+	)
+
+	for property, idx := range s.properties {
+		if value := s.cell(record, idx); value != "" {
+			properties[property] = value
+		}
+	}
+
+	if s.mapping.Type == CSVEntityTypeNodes {
+		separator := s.mapping.KindSeparator
+		if separator == "" {
+			separator = defaultCSVKindSeparator
+		}
+
+		kinds := make([]any, 0)
+		for _, kind := range strings.Split(s.cell(record, s.columns[s.mapping.Kinds]), separator) {
+			if kind = strings.TrimSpace(kind); kind != "" {
+				kinds = append(kinds, kind)
+			}
+		}
+
+		document["id"] = s.cell(record, s.columns[s.mapping.ID])
+		document["kinds"] = kinds
+	} else {
+		document["kind"] = s.cell(record, s.columns[s.mapping.Kind])
+		document["start"] = s.endpoint(record, s.mapping.Start)
+		document["end"] = s.endpoint(record, s.mapping.End)
+	}
+
+	return document
+}
+
+func (s CSVRowMapper) endpoint(record []string, mapping CSVEndpointMapping) map[string]any {
+	var (
+		value    = s.cell(record, s.columns[mapping.Column])
+		endpoint = make(map[string]any)
+	)
+
+	if mapping.MatchBy == "property" {
+		endpoint["property_matchers"] = []any{map[string]any{"key": mapping.Property, "operator": "equals", "value": value}}
+	} else {
+		endpoint["value"] = value
+	}
+
+	if mapping.MatchBy != "" {
+		endpoint["match_by"] = mapping.MatchBy
+	}
+
+	if mapping.Kind != "" {
+		endpoint["kind"] = mapping.Kind
+	}
+
+	return endpoint
+}
+
+func (s CSVRowMapper) cell(record []string, idx int) string {
+	if idx < len(record) {
+		return strings.TrimSpace(record[idx])
+	}
+
+	return ""
+}
+
+// writeCSVHeaderMapping writes the mapping as the first line of a stored CSV upload
+func writeCSVHeaderMapping(dst io.Writer, mapping CSVHeaderMapping) error {
+	if content, err := json.Marshal(mapping); err != nil {
+		return err
+	} else {
+		_, err = dst.Write(append(content, '\n'))
+		return err
+	}
+}
+
+// ReadCSVHeaderMapping reads the mapping stored on the first line of a CSV upload. The reader is left positioned at
+// the start of the CSV file.
+func ReadCSVHeaderMapping(reader *bufio.Reader) (CSVHeaderMapping, error) {
+	var mapping CSVHeaderMapping
+
+	if line, err := reader.ReadBytes('\n'); err != nil {
+		return mapping, fmt.Errorf("%w: %w", ErrInvalidCSVHeaderMapping, err)
+	} else if err := json.Unmarshal(line, &mapping); err != nil {
+		return mapping, fmt.Errorf("%w: %w", ErrInvalidCSVHeaderMapping, err)
+	}
+
+	return mapping, mapping.Validate()
+}
+
+// decodeCSVHeaderMapping decodes and validates a header mapping file as uploaded
+func decodeCSVHeaderMapping(src io.Reader) (CSVHeaderMapping, error) {
+	var (
+		mapping CSVHeaderMapping
+		decoder = json.NewDecoder(io.LimitReader(src, maxCSVHeaderMappingSize))
+	)
+
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&mapping); err != nil {
+		return mapping, fmt.Errorf("%w: %w", ErrInvalidCSVHeaderMapping, err)
+	}
+
+	return mapping, mapping.Validate()
+}
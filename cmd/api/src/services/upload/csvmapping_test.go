@@ -0,0 +1,180 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package upload
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSVHeaderMapping_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		mapping     CSVHeaderMapping
+		expectedErr string
+	}{
+		{
+			name:    "valid node mapping",
+			mapping: CSVHeaderMapping{Type: CSVEntityTypeNodes, ID: "id", Kinds: "kinds"},
+		},
+		{
+			name:    "valid edge mapping",
+			mapping: CSVHeaderMapping{Type: CSVEntityTypeEdges, Kind: "kind", Start: CSVEndpointMapping{Column: "from"}, End: CSVEndpointMapping{Column: "to", MatchBy: "name"}},
+		},
+		{
+			name:        "unknown type",
+			mapping:     CSVHeaderMapping{Type: "things"},
+			expectedErr: "type must be nodes or edges",
+		},
+		{
+			name:        "node missing id",
+			mapping:     CSVHeaderMapping{Type: CSVEntityTypeNodes, Kinds: "kinds"},
+			expectedErr: "id column is required for nodes",
+		},
+		{
+			name:        "edge missing end",
+			mapping:     CSVHeaderMapping{Type: CSVEntityTypeEdges, Kind: "kind", Start: CSVEndpointMapping{Column: "from"}},
+			expectedErr: "end column is required for edges",
+		},
+		{
+			name:        "edge with unknown match_by",
+			mapping:     CSVHeaderMapping{Type: CSVEntityTypeEdges, Kind: "kind", Start: CSVEndpointMapping{Column: "from", MatchBy: "guess"}, End: CSVEndpointMapping{Column: "to"}},
+			expectedErr: "start match_by must be id, name or property",
+		},
+		{
+			name:        "edge matching by property without a property",
+			mapping:     CSVHeaderMapping{Type: CSVEntityTypeEdges, Kind: "kind", Start: CSVEndpointMapping{Column: "from"}, End: CSVEndpointMapping{Column: "to", MatchBy: "property"}},
+			expectedErr: "end property is required when matching by property",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.mapping.Validate()
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrInvalidCSVHeaderMapping)
+				assert.ErrorContains(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestCSVHeaderMapping_NewRowMapper(t *testing.T) {
+	mapping := CSVHeaderMapping{Type: CSVEntityTypeNodes, ID: "id", Kinds: "kinds"}
+
+	t.Run("missing mapped column", func(t *testing.T) {
+		_, err := mapping.NewRowMapper([]string{"id", "name"})
+		assert.ErrorContains(t, err, "csv header is missing mapped column kinds")
+	})
+
+	t.Run("missing property column", func(t *testing.T) {
+		withProperties := mapping
+		withProperties.Properties = map[string]string{"name": "display_name"}
+
+		_, err := withProperties.NewRowMapper([]string{"id", "kinds", "name"})
+		assert.ErrorContains(t, err, "csv header is missing mapped column display_name")
+	})
+
+	t.Run("duplicate column", func(t *testing.T) {
+		_, err := mapping.NewRowMapper([]string{"id", "kinds", "id"})
+		assert.ErrorContains(t, err, "csv header has duplicate column id")
+	})
+}
+
+func TestCSVRowMapper_Map(t *testing.T) {
+	t.Run("node with unmapped columns as properties", func(t *testing.T) {
+		mapping := CSVHeaderMapping{Type: CSVEntityTypeNodes, ID: "id", Kinds: "kinds"}
+
+		rowMapper, err := mapping.NewRowMapper([]string{"id", "kinds", "name", "description"})
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]any{
+			"id":         "123",
+			"kinds":      []any{"Person", "Base"},
+			"properties": map[string]any{"name": "alice"},
+		}, rowMapper.Map([]string{"123", "Person| Base", " alice ", ""}))
+	})
+
+	t.Run("node with explicit properties", func(t *testing.T) {
+		mapping := CSVHeaderMapping{Type: CSVEntityTypeNodes, ID: "id", Kinds: "kinds", KindSeparator: ";", Properties: map[string]string{"displayname": "name"}}
+
+		rowMapper, err := mapping.NewRowMapper([]string{"id", "kinds", "name", "ignored"})
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]any{
+			"id":         "123",
+			"kinds":      []any{"Person", "Base"},
+			"properties": map[string]any{"displayname": "alice"},
+		}, rowMapper.Map([]string{"123", "Person;Base", "alice", "skipped"}))
+	})
+
+	t.Run("edge with endpoint matchers", func(t *testing.T) {
+		mapping := CSVHeaderMapping{
+			Type:  CSVEntityTypeEdges,
+			Kind:  "kind",
+			Start: CSVEndpointMapping{Column: "from", MatchBy: "name", Kind: "Person"},
+			End:   CSVEndpointMapping{Column: "to", MatchBy: "property", Property: "email"},
+		}
+
+		rowMapper, err := mapping.NewRowMapper([]string{"from", "to", "kind", "weight"})
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]any{
+			"kind": "Knows",
+			"start": map[string]any{
+				"value":    "alice",
+				"match_by": "name",
+				"kind":     "Person",
+			},
+			"end": map[string]any{
+				"property_matchers": []any{map[string]any{"key": "email", "operator": "equals", "value": "bob@example.com"}},
+				"match_by":          "property",
+			},
+			"properties": map[string]any{"weight": "3"},
+		}, rowMapper.Map([]string{"alice", "bob@example.com", "Knows", "3"}))
+	})
+}
+
+func TestReadCSVHeaderMapping(t *testing.T) {
+	var (
+		stored  bytes.Buffer
+		mapping = CSVHeaderMapping{Type: CSVEntityTypeNodes, SourceKind: "Example", ID: "id", Kinds: "kinds"}
+	)
+
+	require.NoError(t, writeCSVHeaderMapping(&stored, mapping))
+	stored.WriteString("id,kinds\n1,Person\n")
+
+	reader := bufio.NewReader(&stored)
+
+	actual, err := ReadCSVHeaderMapping(reader)
+	require.NoError(t, err)
+	assert.Equal(t, mapping, actual)
+
+	remaining, err := reader.ReadString(0)
+	assert.Equal(t, "id,kinds\n1,Person\n", remaining)
+	assert.Error(t, err)
+
+	_, err = ReadCSVHeaderMapping(bufio.NewReader(strings.NewReader("not json\n")))
+	assert.ErrorIs(t, err, ErrInvalidCSVHeaderMapping)
+}
@@ -17,12 +17,23 @@
 package upload
 
 import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
 	"io"
+	"mime/multipart"
 
 	"github.com/specterops/bloodhound/cmd/api/src/model/ingest"
 	"github.com/specterops/bloodhound/packages/go/bomenc"
 )
 
+const (
+	// CSVMappingFormField and CSVFileFormField name the form parts of a CSV upload. The mapping must come first.
+	CSVMappingFormField = "mapping"
+	CSVFileFormField    = "file"
+)
+
 // FileValidator defines the interface for ingest file validation.
 // It receives a source reader (src) and a destination writer (dst).
 // Implementations are responsible for validating the input stream,
@@ -36,6 +47,82 @@ func WriteAndValidateZip(src io.Reader, dst io.Writer) (ingest.OriginalMetadata,
 	return ingest.OriginalMetadata{}, ValidateZipFile(tr)
 }
 
+// WriteAndValidateNDJSON implements FileValidator for NDJSON ingest files. Each line is validated as it is ingested so
+// that a malformed line is reported without failing the rest of the file; at upload time only empty files are rejected.
+func WriteAndValidateNDJSON(src io.Reader, dst io.Writer) (ingest.OriginalMetadata, error) {
+	var detector contentDetector
+
+	if normalizedContent, err := bomenc.NormalizeToUTF8(src); err != nil {
+		return ingest.OriginalMetadata{}, err
+	} else if _, err := io.Copy(io.MultiWriter(dst, &detector), normalizedContent); err != nil {
+		return ingest.OriginalMetadata{}, err
+	} else if !detector.found {
+		return ingest.OriginalMetadata{}, newCriticalValidationReport("ndjson file is empty")
+	}
+
+	return ingest.OriginalMetadata{Type: ingest.DataTypeOpenGraph}, nil
+}
+
+// WriteAndValidateCSV returns a FileValidator for CSV uploads sent as multipart form data with the given boundary.
+// The header mapping is validated against the CSV header and stored ahead of the CSV file. Like NDJSON, rows are
+// validated as they are ingested.
+func WriteAndValidateCSV(boundary string) FileValidator {
+	return func(src io.Reader, dst io.Writer) (ingest.OriginalMetadata, error) {
+		form := multipart.NewReader(src, boundary)
+
+		if mappingPart, err := nextFormPart(form, CSVMappingFormField); err != nil {
+			return ingest.OriginalMetadata{}, err
+		} else if mapping, err := decodeCSVHeaderMapping(mappingPart); err != nil {
+			return ingest.OriginalMetadata{}, newCriticalValidationReport(err.Error())
+		} else if filePart, err := nextFormPart(form, CSVFileFormField); err != nil {
+			return ingest.OriginalMetadata{}, err
+		} else if normalizedContent, err := bomenc.NormalizeToUTF8(filePart); err != nil {
+			return ingest.OriginalMetadata{}, err
+		} else if err := writeCSVHeaderMapping(dst, mapping); err != nil {
+			return ingest.OriginalMetadata{}, err
+		} else {
+			// Everything the CSV reader buffers passes through the tee, so draining it afterwards copies the rest
+			tr := io.TeeReader(normalizedContent, dst)
+
+			if header, err := csv.NewReader(tr).Read(); err != nil {
+				return ingest.OriginalMetadata{}, newCriticalValidationReport(fmt.Sprintf("unable to read csv header: %v", err))
+			} else if _, err := mapping.NewRowMapper(header); err != nil {
+				return ingest.OriginalMetadata{}, newCriticalValidationReport(err.Error())
+			} else if _, err := io.Copy(io.Discard, tr); err != nil {
+				return ingest.OriginalMetadata{}, err
+			}
+
+			return ingest.OriginalMetadata{Type: ingest.DataTypeOpenGraph}, nil
+		}
+	}
+}
+
+// nextFormPart returns the next part of a CSV upload form, which must carry the given field name
+func nextFormPart(form *multipart.Reader, fieldName string) (*multipart.Part, error) {
+	if part, err := form.NextPart(); errors.Is(err, io.EOF) {
+		return nil, newCriticalValidationReport(fmt.Sprintf("csv upload is missing the %s form field", fieldName))
+	} else if err != nil {
+		return nil, newCriticalValidationReport(fmt.Sprintf("unable to read csv upload form: %v", err))
+	} else if part.FormName() != fieldName {
+		return nil, newCriticalValidationReport(fmt.Sprintf("expected csv upload form field %s but found %s", fieldName, part.FormName()))
+	} else {
+		return part, nil
+	}
+}
+
+// contentDetector records whether anything other than whitespace was written to it
+type contentDetector struct {
+	found bool
+}
+
+func (s *contentDetector) Write(p []byte) (int, error) {
+	if !s.found && len(bytes.TrimSpace(p)) > 0 {
+		s.found = true
+	}
+
+	return len(p), nil
+}
+
 // IngestValidator encapsulates precompiled JSON schemas used to validate
 // graph ingest payloads, including node and edge definitions.
 //
@@ -280,6 +280,10 @@ type ValidationReport struct {
 	ValidationErrors []validationError // nodes and edges that dont conform to the spec
 }
 
+func newCriticalValidationReport(msg string) ValidationReport {
+	return ValidationReport{CriticalErrors: []validationError{{Message: msg}}}
+}
+
 func (s ValidationReport) BuildAPIError() []string {
 	msgs := []string{"Error saving ingest file. File failed schema validation."}
 
@@ -307,11 +311,12 @@ func (s ValidationReport) Error() string {
 	return sb.String()
 }
 
-func formatSchemaValidationError(arrayName string, index int, err error) string {
+// formatSchemaValidationError describes the schema violations of the element at the given location, e.g. nodes[3]
+func formatSchemaValidationError(location string, err error) string {
 	var sb strings.Builder
 	if ve, ok := err.(*jsonschema.ValidationError); ok {
 		numberOfViolations := len(ve.Causes)
-		fmt.Fprintf(&sb, "%s[%d] schema validation failed with %d error(s): ", arrayName, index, numberOfViolations)
+		fmt.Fprintf(&sb, "%s schema validation failed with %d error(s): ", location, numberOfViolations)
 
 		sb.WriteString("[")
 
@@ -446,15 +451,8 @@ func (v *validator) validateArray(arrayName string, schema *jsonschema.Schema) {
 				v.reportCritical(index, fmt.Sprintf("%s[%d] syntax error: %s", arrayName, index, err))
 			}
 		} else {
-			if err := schema.Validate(item); err != nil {
-				v.reportValidation(index, formatSchemaValidationError(arrayName, index, err))
-			}
-			if kindErrors := validateKinds(arrayName, item); len(kindErrors) > 0 {
-				causes := make([]string, len(kindErrors))
-				for i, kindError := range kindErrors {
-					causes[i] = kindError.Error()
-				}
-				v.reportValidation(index, fmt.Sprintf("%s[%d] validation failed with %d error(s): [%s]", arrayName, index, len(causes), strings.Join(causes, ", ")))
+			for _, msg := range validateGraphElement(arrayName, fmt.Sprintf("%s[%d]", arrayName, index), schema, item) {
+				v.reportValidation(index, msg)
 			}
 		}
 
@@ -479,6 +477,46 @@ func (v *validator) report() error {
 	return nil
 }
 
+// ValidateNode applies the checks made to each element of a graph payload's nodes array to a single decoded node. The
+// location identifies the node in the returned error.
+func (s IngestSchema) ValidateNode(location string, item map[string]any) error {
+	return joinValidationMessages(validateGraphElement("nodes", location, s.NodeSchema, item))
+}
+
+// ValidateEdge applies the checks made to each element of a graph payload's edges array to a single decoded edge. The
+// location identifies the edge in the returned error.
+func (s IngestSchema) ValidateEdge(location string, item map[string]any) error {
+	return joinValidationMessages(validateGraphElement("edges", location, s.EdgeSchema, item))
+}
+
+func joinValidationMessages(msgs []string) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(msgs, "; "))
+}
+
+// validateGraphElement applies the schema and reserved kind checks to a single decoded node or edge and returns a
+// message for each check that failed. The location prefixes each message to identify the offending element.
+func validateGraphElement(arrayName, location string, schema *jsonschema.Schema, item map[string]any) []string {
+	var msgs []string
+
+	if err := schema.Validate(item); err != nil {
+		msgs = append(msgs, formatSchemaValidationError(location, err))
+	}
+
+	if kindErrors := validateKinds(arrayName, item); len(kindErrors) > 0 {
+		causes := make([]string, len(kindErrors))
+		for i, kindError := range kindErrors {
+			causes[i] = kindError.Error()
+		}
+		msgs = append(msgs, fmt.Sprintf("%s validation failed with %d error(s): [%s]", location, len(causes), strings.Join(causes, ", ")))
+	}
+
+	return msgs
+}
+
 // validateKinds enforces the reserved-kind-namespace policy on a single
 // decoded node or edge item and returns one error per offending kind. Nodes
 // are checked via the "kinds" array, edges via the "kind" field. This function
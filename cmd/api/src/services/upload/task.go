@@ -39,6 +39,10 @@ func fileFormatFromFileType(ft model.FileType) metrics.IngestFileFormat {
 		return metrics.IngestFileFormatJSON
 	case "zip":
 		return metrics.IngestFileFormatZip
+	case "ndjson":
+		return metrics.IngestFileFormatNDJSON
+	case "csv":
+		return metrics.IngestFileFormatCSV
 	default:
 		return metrics.IngestFileFormatUnknown
 	}
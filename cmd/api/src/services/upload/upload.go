@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"mime"
 	"net/http"
 	"time"
 
@@ -52,6 +53,16 @@ func SaveIngestFile(ctx context.Context, fileService storage.FileService, reques
 	case utils.HeaderMatches(request.Header, headers.ContentType.String(), ingest.AllowedZipFileUploadTypes...):
 		fileType = model.FileTypeZip
 		validationFn = WriteAndValidateZip
+	case utils.HeaderMatches(request.Header, headers.ContentType.String(), ingest.AllowedNDJSONFileUploadTypes...):
+		fileType = model.FileTypeNDJSON
+		validationFn = WriteAndValidateNDJSON
+	case utils.HeaderMatches(request.Header, headers.ContentType.String(), ingest.AllowedCSVFileUploadTypes...):
+		if _, params, err := mime.ParseMediaType(request.Header.Get(headers.ContentType.String())); err != nil || params["boundary"] == "" {
+			return IngestTaskParams{}, newCriticalValidationReport("csv upload form is missing its multipart boundary")
+		} else {
+			fileType = model.FileTypeCSV
+			validationFn = WriteAndValidateCSV(params["boundary"])
+		}
 	default:
 		return IngestTaskParams{}, fmt.Errorf("invalid content type for ingest file")
 	}
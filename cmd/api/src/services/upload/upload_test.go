@@ -17,11 +17,13 @@
 package upload
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"os"
 	"strings"
 	"testing"
@@ -271,6 +273,121 @@ func TestUpload_WriteAndValidateFile(t *testing.T) {
 	}
 }
 
+func TestWriteAndValidateNDJSON(t *testing.T) {
+	t.Run("lines are written unchanged", func(t *testing.T) {
+		var (
+			content = "{\"id\": \"1\", \"kinds\": [\"Person\"]}\nnot json\n"
+			dst     = &bytes.Buffer{}
+		)
+
+		meta, err := WriteAndValidateNDJSON(strings.NewReader(content), dst)
+		require.NoError(t, err)
+		assert.Equal(t, ingest.DataTypeOpenGraph, meta.Type)
+		assert.Equal(t, content, dst.String())
+	})
+
+	t.Run("empty file is rejected", func(t *testing.T) {
+		_, err := WriteAndValidateNDJSON(strings.NewReader("\n  \n"), &bytes.Buffer{})
+
+		var report ValidationReport
+		require.ErrorAs(t, err, &report)
+		assert.Contains(t, report.BuildAPIError(), "ndjson file is empty")
+	})
+}
+
+func buildCSVUploadForm(t *testing.T, parts ...[2]string) (*bytes.Buffer, string) {
+	t.Helper()
+
+	var (
+		body   = &bytes.Buffer{}
+		writer = multipart.NewWriter(body)
+	)
+
+	for _, part := range parts {
+		require.NoError(t, writer.WriteField(part[0], part[1]))
+	}
+	require.NoError(t, writer.Close())
+
+	return body, writer.Boundary()
+}
+
+func TestWriteAndValidateCSV(t *testing.T) {
+	const (
+		nodeMapping = `{"type": "nodes", "source_kind": "Example", "id": "id", "kinds": "kinds"}`
+		nodeCSV     = "id,kinds,name\n1,Person,alice\n2,Person,bob\n"
+	)
+
+	t.Run("mapping is stored ahead of the csv file", func(t *testing.T) {
+		var (
+			body, boundary = buildCSVUploadForm(t, [2]string{CSVMappingFormField, nodeMapping}, [2]string{CSVFileFormField, nodeCSV})
+			dst            = &bytes.Buffer{}
+		)
+
+		meta, err := WriteAndValidateCSV(boundary)(body, dst)
+		require.NoError(t, err)
+		assert.Equal(t, ingest.DataTypeOpenGraph, meta.Type)
+
+		reader := bufio.NewReader(dst)
+
+		mapping, err := ReadCSVHeaderMapping(reader)
+		require.NoError(t, err)
+		assert.Equal(t, "Example", mapping.SourceKind)
+
+		remaining, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		assert.Equal(t, nodeCSV, string(remaining))
+	})
+
+	tests := []struct {
+		name        string
+		parts       [][2]string
+		expectedErr string
+	}{
+		{
+			name:        "missing mapping",
+			parts:       [][2]string{},
+			expectedErr: "csv upload is missing the mapping form field",
+		},
+		{
+			name:        "file before mapping",
+			parts:       [][2]string{{CSVFileFormField, nodeCSV}, {CSVMappingFormField, nodeMapping}},
+			expectedErr: "expected csv upload form field mapping but found file",
+		},
+		{
+			name:        "invalid mapping",
+			parts:       [][2]string{{CSVMappingFormField, `{"type": "nodes", "id": "id"}`}, {CSVFileFormField, nodeCSV}},
+			expectedErr: "invalid csv header mapping: kinds column is required for nodes",
+		},
+		{
+			name:        "unknown mapping field",
+			parts:       [][2]string{{CSVMappingFormField, `{"type": "nodes", "id": "id", "kinds": "kinds", "label": "name"}`}, {CSVFileFormField, nodeCSV}},
+			expectedErr: `unknown field "label"`,
+		},
+		{
+			name:        "missing file",
+			parts:       [][2]string{{CSVMappingFormField, nodeMapping}},
+			expectedErr: "csv upload is missing the file form field",
+		},
+		{
+			name:        "header missing a mapped column",
+			parts:       [][2]string{{CSVMappingFormField, nodeMapping}, {CSVFileFormField, "id,name\n1,alice\n"}},
+			expectedErr: "csv header is missing mapped column kinds",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, boundary := buildCSVUploadForm(t, tt.parts...)
+
+			_, err := WriteAndValidateCSV(boundary)(body, &bytes.Buffer{})
+
+			var report ValidationReport
+			require.ErrorAs(t, err, &report)
+			assert.Contains(t, strings.Join(report.BuildAPIError(), "; "), tt.expectedErr)
+		})
+	}
+}
+
 // ErrorReader is a mock reader that always returns an error
 type ErrorReader struct {
 	err error
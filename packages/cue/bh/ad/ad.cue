@@ -793,6 +793,13 @@ MSA: types.#StringEnum & {
  	representation: "msa"
 }
 
+DMSA: types.#StringEnum & {
+	symbol:         "DMSA"
+	schema:         "ad"
+	name:           "DMSA"
+	representation: "dmsa"
+}
+
 SMBSigning: types.#StringEnum & {
 	symbol:         "SMBSigning"
 	schema:         "ad"
@@ -1037,6 +1044,111 @@ GPOStatus: types.#StringEnum & {
 	representation: "gpostatus"
 }
 
+ZoneName: types.#StringEnum & {
+	symbol:         "ZoneName"
+	schema:         "ad"
+	name:           "Zone Name"
+	representation: "zonename"
+}
+
+RecordName: types.#StringEnum & {
+	symbol:         "RecordName"
+	schema:         "ad"
+	name:           "Record Name"
+	representation: "recordname"
+}
+
+Crackability: types.#StringEnum & {
+	symbol:         "Crackability"
+	schema:         "ad"
+	name:           "Crackability"
+	representation: "crackability"
+}
+
+WMIFilter: types.#StringEnum & {
+	symbol:         "WMIFilter"
+	schema:         "ad"
+	name:           "WMI Filter"
+	representation: "wmifilter"
+}
+
+SecurityFiltering: types.#StringEnum & {
+	symbol:         "SecurityFiltering"
+	schema:         "ad"
+	name:           "Security Filtering"
+	representation: "securityfiltering"
+}
+
+GPOLocalAdmins: types.#StringEnum & {
+	symbol:         "GPOLocalAdmins"
+	schema:         "ad"
+	name:           "GPO Local Admins"
+	representation: "gpolocaladmins"
+}
+
+GPORemoteDesktopUsers: types.#StringEnum & {
+	symbol:         "GPORemoteDesktopUsers"
+	schema:         "ad"
+	name:           "GPO Remote Desktop Users"
+	representation: "gporemotedesktopusers"
+}
+
+GPODcomUsers: types.#StringEnum & {
+	symbol:         "GPODcomUsers"
+	schema:         "ad"
+	name:           "GPO DCOM Users"
+	representation: "gpodcomusers"
+}
+
+GPOPSRemoteUsers: types.#StringEnum & {
+	symbol:         "GPOPSRemoteUsers"
+	schema:         "ad"
+	name:           "GPO PS Remote Users"
+	representation: "gpopsremoteusers"
+}
+
+GPORemoteInteractiveLogonRight: types.#StringEnum & {
+	symbol:         "GPORemoteInteractiveLogonRight"
+	schema:         "ad"
+	name:           "GPO Remote Interactive Logon Right"
+	representation: "gporemoteinteractivelogonright"
+}
+
+GPOLogonUserGroups: types.#StringEnum & {
+	symbol:         "GPOLogonUserGroups"
+	schema:         "ad"
+	name:           "GPO Logon User Groups"
+	representation: "gpologonusergroups"
+}
+
+SourceGPOs: types.#StringEnum & {
+	symbol:         "SourceGPOs"
+	schema:         "ad"
+	name:           "Source GPOs"
+	representation: "sourcegpos"
+}
+
+Quarantined: types.#StringEnum & {
+	symbol:         "Quarantined"
+	schema:         "ad"
+	name:           "Quarantined"
+	representation: "quarantined"
+}
+
+SelectiveAuthentication: types.#StringEnum & {
+	symbol:         "SelectiveAuthentication"
+	schema:         "ad"
+	name:           "Selective Authentication"
+	representation: "selectiveauthentication"
+}
+
+PAMTrust: types.#StringEnum & {
+	symbol:         "PAMTrust"
+	schema:         "ad"
+	name:           "PAM Trust"
+	representation: "pamtrust"
+}
+
 Properties: [
 	AdminCount,
 	CASecurityCollected,
@@ -1149,6 +1261,7 @@ Properties: [
 	RestrictOutboundNTLM,
 	GMSA,
 	MSA,
+	DMSA,
 	DoesAnyAceGrantOwnerRights,
 	DoesAnyInheritedAceGrantOwnerRights,
 	ADCSWebEnrollmentHTTP,
@@ -1178,6 +1291,21 @@ Properties: [
 	ServicePrincipalNames,
 	GPOStatusRaw,
 	GPOStatus,
+	ZoneName,
+	RecordName,
+	Crackability,
+	WMIFilter,
+	SecurityFiltering,
+	GPOLocalAdmins,
+	GPORemoteDesktopUsers,
+	GPODcomUsers,
+	GPOPSRemoteUsers,
+	GPORemoteInteractiveLogonRight,
+	GPOLogonUserGroups,
+	SourceGPOs,
+	Quarantined,
+	SelectiveAuthentication,
+	PAMTrust,
 ]
 
 // Kinds
@@ -1264,6 +1392,26 @@ IssuancePolicy: types.#Kind & {
 	schema: "active_directory"
 }
 
+Site: types.#Kind & {
+	symbol: "Site"
+	schema: "active_directory"
+}
+
+Subnet: types.#Kind & {
+	symbol: "Subnet"
+	schema: "active_directory"
+}
+
+DNSZone: types.#Kind & {
+	symbol: "DNSZone"
+	schema: "active_directory"
+}
+
+DNSNode: types.#Kind & {
+	symbol: "DNSNode"
+	schema: "active_directory"
+}
+
 NodeKinds: [
 	Entity,
 	User,
@@ -1281,6 +1429,10 @@ NodeKinds: [
 	NTAuthStore,
 	CertTemplate,
 	IssuancePolicy,
+	Site,
+	Subnet,
+	DNSZone,
+	DNSNode,
 ]
 
 Owns: types.#Kind & {
@@ -1730,6 +1882,56 @@ WritePublicInformation: types.#Kind & {
 	schema: "active_directory"
 }
 
+CreateDMSA: types.#Kind & {
+	symbol: "CreateDMSA"
+	schema: "active_directory"
+}
+
+BadSuccessor: types.#Kind & {
+	symbol: "BadSuccessor"
+	schema: "active_directory"
+}
+
+HasSubnet: types.#Kind & {
+	symbol: "HasSubnet"
+	schema: "active_directory"
+}
+
+InSubnet: types.#Kind & {
+	symbol: "InSubnet"
+	schema: "active_directory"
+}
+
+CreateDNSRecord: types.#Kind & {
+	symbol: "CreateDNSRecord"
+	schema: "active_directory"
+}
+
+CanSpoofDNS: types.#Kind & {
+	symbol: "CanSpoofDNS"
+	schema: "active_directory"
+}
+
+Kerberoastable: types.#Kind & {
+	symbol: "Kerberoastable"
+	schema: "active_directory"
+}
+
+ASREPRoastable: types.#Kind & {
+	symbol: "ASREPRoastable"
+	schema: "active_directory"
+}
+
+AllowedToAuthenticate: types.#Kind & {
+	symbol: "AllowedToAuthenticate"
+	schema: "active_directory"
+}
+
+ForeignMemberOf: types.#Kind & {
+	symbol: "ForeignMemberOf"
+	schema: "active_directory"
+}
+
 // Relationship Kinds
 RelationshipKinds: [
 	Owns,
@@ -1820,6 +2022,16 @@ RelationshipKinds: [
 	WriteAltSecurityIdentities,
 	WritePublicInformation,
 	ProtectAdminGroups,
+	CreateDMSA,
+	BadSuccessor,
+	HasSubnet,
+	InSubnet,
+	CreateDNSRecord,
+	CanSpoofDNS,
+	Kerberoastable,
+	ASREPRoastable,
+	AllowedToAuthenticate,
+	ForeignMemberOf,
 ]
 
 // ACL Relationships
@@ -1854,6 +2066,9 @@ ACLRelationships: [
 	OwnsLimitedRights,
 	WriteAltSecurityIdentities,
 	WritePublicInformation,
+	CreateDMSA,
+	AllowedToAuthenticate,
+	CreateDNSRecord,
 ]
 
 IngestACLRelationships: [for r in ACLRelationships if !list.Contains(AllPostProcessedRelationships, r) {r}],
@@ -1919,6 +2134,10 @@ SharedRelationshipKinds: [
 	WritePublicInformation,
 	ManageCA,
 	ManageCertificates,
+	BadSuccessor,
+	Kerberoastable,
+	ASREPRoastable,
+	ForeignMemberOf,
 ]
 
 // Edges that are used during inbound traversal
@@ -1986,6 +2205,11 @@ PostProcessedRelationships: [
 	GPOAppliesTo,
 	CanApplyGPO,
 	HasTrustKeys,
+	BadSuccessor,
+	CanSpoofDNS,
+	Kerberoastable,
+	ASREPRoastable,
+	ForeignMemberOf,
 ]
 
 DCAPostProcessedRelationships: [
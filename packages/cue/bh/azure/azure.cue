@@ -339,6 +339,48 @@ FederatedIdentityCredentialAppID: types.#StringEnum & {
 	representation: "federatedidentitycredentialappid"
 }
 
+IsMemberManagementRestricted: types.#StringEnum & {
+	symbol:         "IsMemberManagementRestricted"
+	schema:         "azure"
+	name:           "Is Member Management Restricted"
+	representation: "ismembermanagementrestricted"
+}
+
+ConditionalAccessPolicyState: types.#StringEnum & {
+	symbol:         "ConditionalAccessPolicyState"
+	schema:         "azure"
+	name:           "Conditional Access Policy State"
+	representation: "capolicystate"
+}
+
+GrantControls: types.#StringEnum & {
+	symbol:         "GrantControls"
+	schema:         "azure"
+	name:           "Grant Controls"
+	representation: "grantcontrols"
+}
+
+Enforcing: types.#StringEnum & {
+	symbol:         "Enforcing"
+	schema:         "azure"
+	name:           "Enforcing"
+	representation: "enforcing"
+}
+
+IncludesAllUsers: types.#StringEnum & {
+	symbol:         "IncludesAllUsers"
+	schema:         "azure"
+	name:           "Includes All Users"
+	representation: "includesallusers"
+}
+
+IncludesAllApplications: types.#StringEnum & {
+	symbol:         "IncludesAllApplications"
+	schema:         "azure"
+	name:           "Includes All Applications"
+	representation: "includesallapplications"
+}
+
 Properties: [
 	AppOwnerOrganizationID,
 	AppDescription,
@@ -383,7 +425,13 @@ Properties: [
 	Issuer,
 	Subject,
 	Audiences,
-	FederatedIdentityCredentialAppID
+	FederatedIdentityCredentialAppID,
+	IsMemberManagementRestricted,
+	ConditionalAccessPolicyState,
+	GrantControls,
+	Enforcing,
+	IncludesAllUsers,
+	IncludesAllApplications
 ]
 
 // Kinds
@@ -513,6 +561,18 @@ FederatedIdentityCredential: types.#Kind & {
 	representation: "AZFederatedIdentityCredential"
 }
 
+AdministrativeUnit: types.#Kind & {
+	symbol:         "AdministrativeUnit"
+	schema:         "azure"
+	representation: "AZAdministrativeUnit"
+}
+
+ConditionalAccessPolicy: types.#Kind & {
+	symbol:         "ConditionalAccessPolicy"
+	schema:         "azure"
+	representation: "AZConditionalAccessPolicy"
+}
+
 NodeKinds: [
 	Entity,
 	VMScaleSet,
@@ -534,7 +594,9 @@ NodeKinds: [
 	WebApp,
 	LogicApp,
 	AutomationAccount,
-	FederatedIdentityCredential
+	FederatedIdentityCredential,
+	AdministrativeUnit,
+	ConditionalAccessPolicy
 ]
 
 AvereContributor: types.#Kind & {
@@ -836,6 +898,29 @@ AZAuthenticatesTo: types.#Kind & {
 	representation:	"AZAuthenticatesTo"
 }
 
+// Directory role assignment scoped to an administrative unit. The scope property holds the object ID of the
+// administrative unit. Scoped assignments are kept apart from AZHasRole so that traversals through the tenant-wide
+// role node do not grant control over principals outside of the administrative unit.
+AZHasScopedRole: types.#Kind & {
+	symbol:			"AZHasScopedRole"
+	schema:			"azure"
+	representation:	"AZHasScopedRole"
+}
+
+// Conditional Access policy assignments. These point from the policy to the users, groups, directory roles and
+// service principals the policy includes or excludes. They describe policy coverage only and are not traversable.
+AZConditionalAccessIncludes: types.#Kind & {
+	symbol:			"AZConditionalAccessIncludes"
+	schema:			"azure"
+	representation:	"AZConditionalAccessIncludes"
+}
+
+AZConditionalAccessExcludes: types.#Kind & {
+	symbol:			"AZConditionalAccessExcludes"
+	schema:			"azure"
+	representation:	"AZConditionalAccessExcludes"
+}
+
 RelationshipKinds: [
 	AvereContributor,
 	Contains,
@@ -886,7 +971,10 @@ RelationshipKinds: [
 	SyncedToEntraUser,
 	AZRoleEligible,
 	AZRoleApprover,
-	AZAuthenticatesTo
+	AZAuthenticatesTo,
+	AZHasScopedRole,
+	AZConditionalAccessIncludes,
+	AZConditionalAccessExcludes
 ]
 
 AppRoleTransitRelationshipKinds: [
@@ -163,6 +163,49 @@ CompositionID: types.#StringEnum & {
 	name: 			"Composition ID"
 	representation: "compositionid"
 }
+
+CompositionRule: types.#StringEnum & {
+	symbol:         "CompositionRule"
+	schema:         "common"
+	name:           "Composition Rule"
+	representation: "compositionrule"
+}
+
+CompositionNodes: types.#StringEnum & {
+	symbol:         "CompositionNodes"
+	schema:         "common"
+	name:           "Composition Nodes"
+	representation: "compositionnodes"
+}
+
+CompositionEdges: types.#StringEnum & {
+	symbol:         "CompositionEdges"
+	schema:         "common"
+	name:           "Composition Edges"
+	representation: "compositionedges"
+}
+
+TierZeroImpact: types.#StringEnum & {
+	symbol:         "TierZeroImpact"
+	schema:         "common"
+	name:           "Tier Zero Impact"
+	representation: "tierzeroimpact"
+}
+
+PrincipalExposure: types.#StringEnum & {
+	symbol:         "PrincipalExposure"
+	schema:         "common"
+	name:           "Principal Exposure"
+	representation: "principalexposure"
+}
+
+ExposureEstimated: types.#StringEnum & {
+	symbol:         "ExposureEstimated"
+	schema:         "common"
+	name:           "Exposure Estimated"
+	representation: "exposureestimated"
+}
+
 // Used to specify which icon to display for a node in the graph UI
 PrimaryKind: types.#StringEnum & {
 	symbol:         "PrimaryKind"
@@ -191,6 +234,12 @@ Properties: [
 	Email,
 	IsInherited,
 	CompositionID,
+	CompositionRule,
+	CompositionNodes,
+	CompositionEdges,
+	TierZeroImpact,
+	PrincipalExposure,
+	ExposureEstimated,
 	PrimaryKind
 ]
 
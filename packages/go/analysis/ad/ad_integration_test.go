@@ -27,6 +27,7 @@ import (
 	"github.com/specterops/bloodhound/packages/go/analysis"
 	"github.com/specterops/bloodhound/packages/go/analysis/ad/internal/nodeprops"
 	"github.com/specterops/bloodhound/packages/go/analysis/ad/wellknown"
+	"github.com/specterops/bloodhound/packages/go/analysis/post"
 	"github.com/specterops/bloodhound/packages/go/graphschema"
 	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
 	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
@@ -1788,8 +1789,40 @@ func TestDCSync(t *testing.T) {
 					require.True(t, results.Contains(harness.DCSyncHarness.User1))
 					require.True(t, results.Contains(harness.DCSyncHarness.User2))
 					require.True(t, results.Contains(harness.DCSyncHarness.Group3))
+				}
+
+				user2Edge, err := tx.Relationships().Filterf(func() graph.Criteria {
+					return query.And(
+						query.Kind(query.Relationship(), ad.DCSync),
+						query.Equals(query.StartID(), harness.DCSyncHarness.User2.ID),
+					)
+				}).First()
+				require.NoError(t, err)
 
+				composition, found := post.ParseEdgeComposition(user2Edge.Properties)
+				require.True(t, found)
+				require.Equal(t, adAnalysis.CompositionRuleDCSync, composition.Rule)
+
+				edges, err := ops.FetchRelationships(tx.Relationships().Filterf(func() graph.Criteria {
+					return query.InIDs(query.RelationshipID(), composition.EdgeIDs...)
+				}))
+				require.NoError(t, err)
+
+				var (
+					memberships = 0
+					rights      = 0
+				)
+				for _, edge := range edges {
+					switch edge.Kind {
+					case ad.MemberOf:
+						memberships++
+					case ad.GetChanges, ad.GetChangesAll:
+						rights++
+					}
 				}
+
+				require.Equal(t, 2, memberships, "the MemberOf edges leading to both rights holding groups should be recorded")
+				require.Equal(t, 2, rights, "the rights held by both groups should be recorded")
 				return nil
 			})
 		}
@@ -2304,3 +2337,70 @@ func TestProtectAdminGroups(t *testing.T) {
 		return nil
 	})
 }
+
+func TestForeignMemberOf(t *testing.T) {
+	var (
+		testCtx = integration.NewGraphTestContext(t, graphschema.DefaultGraphSchema())
+		graphDB = testCtx.Graph.Database
+	)
+
+	fixture, err := arrows.LoadGraphFromFile(integration.Harnesses, "harnesses/ForeignMemberOfHarness.json")
+	require.NoError(t, err)
+
+	// Split edges into test edges and the other edges
+	testEdges := []arrows.Edge{}
+	otherEdges := []arrows.Edge{}
+	for _, edge := range fixture.Relationships {
+		if edge.Type == ad.ForeignMemberOf.String() {
+			testEdges = append(testEdges, edge)
+		} else {
+			otherEdges = append(otherEdges, edge)
+		}
+	}
+	fixture.Relationships = otherEdges
+
+	err = arrows.WriteGraphToDatabase(graphDB, &fixture)
+	require.NoError(t, err)
+
+	err = graphDB.ReadTransaction(testCtx.Context(), func(tx graph.Transaction) error {
+		if _, err := adAnalysis.PostForeignMemberOf(testCtx.Context(), graphDB); err != nil {
+			t.Fatalf("error creating ForeignMemberOf edges in integration test; %v", err)
+		} else {
+			if err = graphDB.ReadTransaction(context.Background(), func(tx graph.Transaction) error {
+				if results, err := ops.FetchRelationshipIDs(tx.Relationships().Filterf(func() graph.Criteria {
+					return query.Kind(query.Relationship(), ad.ForeignMemberOf)
+				})); err != nil {
+					t.Fatalf("error fetching ForeignMemberOf edges in integration test; %v", err)
+				} else {
+					require.Equal(t, len(testEdges), len(results))
+				}
+
+				for _, testEdge := range testEdges {
+					if fromNode, found := findNodeByID(fixture.Nodes, testEdge.FromID); !found {
+						t.Fatalf("error finding source node with ID %s; %v", testEdge.FromID, err)
+					} else if toNode, found := findNodeByID(fixture.Nodes, testEdge.ToID); !found {
+						t.Fatalf("error finding destination node with ID %s; %v", testEdge.ToID, err)
+					} else if fromGraphNodeId, err := ops.FetchNodeIDs(tx.Nodes().Filterf(func() graph.Criteria {
+						return query.Equals(query.NodeProperty(common.Name.String()), fromNode.Caption)
+					})); err != nil || len(fromGraphNodeId) != 1 {
+						t.Fatalf("error fetching node with name %s in integration test; %v", fromNode.Caption, err)
+					} else if toGraphNodeId, err := ops.FetchNodeIDs(tx.Nodes().Filterf(func() graph.Criteria {
+						return query.Equals(query.NodeProperty(common.Name.String()), toNode.Caption)
+					})); err != nil || len(toGraphNodeId) != 1 {
+						t.Fatalf("error fetching node with name %s in integration test; %v", toNode.Caption, err)
+					} else if edge, err := analysis.FetchEdgeByStartAndEnd(testCtx.Context(), graphDB, fromGraphNodeId[0], toGraphNodeId[0], ad.ForeignMemberOf); err != nil {
+						t.Fatalf("error fetching ForeignMemberOf edge from node %s (ID: %d) to node %s (ID: %d) in integration test; %v", fromNode.Caption, fromGraphNodeId[0], toNode.Caption, toGraphNodeId[0], err)
+					} else {
+						require.NotNil(t, edge)
+					}
+				}
+
+				return nil
+			}); err != nil {
+				t.Fatalf("error in ForeignMemberOf integration test; %v", err)
+			}
+		}
+		assert.NoError(t, err)
+		return nil
+	})
+}
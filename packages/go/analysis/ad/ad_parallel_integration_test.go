@@ -127,3 +127,591 @@ func TestPostADCSESC6a_ManagedServiceAccounts(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+// TestPostBadSuccessor verifies that principals able to create a dMSA in an OU, or write to an existing dMSA, receive
+// BadSuccessor edges to the enabled accounts of their domain, but only when the domain has a Windows Server 2025 domain
+// controller.
+func TestPostBadSuccessor(t *testing.T) {
+	t.Parallel()
+
+	suite := setupIntegrationTestSuite(t)
+	defer teardownIntegrationTestSuite(t, &suite)
+
+	var (
+		domainSID     = RandomDomainSID()
+		domain        = NewActiveDirectoryDomain(t, &suite, "BadSuccessor", domainSID, false, true)
+		legacySID     = RandomDomainSID()
+		legacyDomain  = NewActiveDirectoryDomain(t, &suite, "BadSuccessorLegacy", legacySID, false, true)
+		dc            = NewActiveDirectoryComputer(t, &suite, "DC", domainSID)
+		ou            = NewNode(t, &suite, graph.AsProperties(graph.PropertyMap{common.Name: "OU", ad.DomainSID: domainSID}), ad.Entity, ad.OU)
+		legacyOU      = NewNode(t, &suite, graph.AsProperties(graph.PropertyMap{common.Name: "LegacyOU", ad.DomainSID: legacySID}), ad.Entity, ad.OU)
+		ouCreator     = NewActiveDirectoryUser(t, &suite, "OUCreator", domainSID)
+		ouDACLWriter  = NewActiveDirectoryUser(t, &suite, "OUDACLWriter", domainSID)
+		ouOwner       = NewActiveDirectoryUser(t, &suite, "OUOwner", domainSID)
+		dmsa          = NewActiveDirectoryUser(t, &suite, "DMSA", domainSID)
+		dmsaWriter    = NewActiveDirectoryUser(t, &suite, "DMSAWriter", domainSID)
+		victim        = NewActiveDirectoryUser(t, &suite, "Victim", domainSID)
+		unprivileged  = NewActiveDirectoryUser(t, &suite, "Unprivileged", domainSID)
+		legacyCreator = NewActiveDirectoryUser(t, &suite, "LegacyCreator", legacySID)
+		legacyDC      = NewActiveDirectoryComputer(t, &suite, "LegacyDC", legacySID)
+		legacyVictim  = NewActiveDirectoryUser(t, &suite, "LegacyVictim", legacySID)
+		disabled      = NewActiveDirectoryUser(t, &suite, "Disabled", domainSID)
+	)
+
+	// The domain functional level does not matter, only the operating system of the domain controllers
+	domain.Properties.Set(ad.FunctionalLevel.String(), "2016")
+	UpdateNode(t, &suite, domain)
+	legacyDomain.Properties.Set(ad.FunctionalLevel.String(), "2016")
+	UpdateNode(t, &suite, legacyDomain)
+	dc.Properties.Set(ad.IsDC.String(), true)
+	dc.Properties.Set(common.OperatingSystem.String(), "Windows Server 2025 Datacenter")
+	UpdateNode(t, &suite, dc)
+	legacyDC.Properties.Set(ad.IsDC.String(), true)
+	legacyDC.Properties.Set(common.OperatingSystem.String(), "Windows Server 2022 Datacenter")
+	UpdateNode(t, &suite, legacyDC)
+	dmsa.Properties.Set(ad.DMSA.String(), true)
+	UpdateNode(t, &suite, dmsa)
+	disabled.Properties.Set(common.Enabled.String(), false)
+	UpdateNode(t, &suite, disabled)
+
+	for _, account := range []*graph.Node{dc, legacyDC, victim, unprivileged, legacyVictim} {
+		account.Properties.Set(common.Enabled.String(), true)
+		UpdateNode(t, &suite, account)
+	}
+
+	NewRelationship(t, &suite, ouCreator, ou, ad.CreateDMSA)
+	NewRelationship(t, &suite, ouDACLWriter, ou, ad.WriteDACL)
+	NewRelationship(t, &suite, ouOwner, ou, ad.Owns)
+	NewRelationship(t, &suite, dmsaWriter, dmsa, ad.GenericWrite)
+	NewRelationship(t, &suite, legacyCreator, legacyOU, ad.CreateDMSA)
+	NewRelationship(t, &suite, domain, ou, ad.Contains)
+	NewRelationship(t, &suite, legacyDomain, legacyOU, ad.Contains)
+
+	_, err := adAnalysis.PostBadSuccessor(suite.Context, suite.GraphDB)
+	require.NoError(t, err)
+
+	err = suite.GraphDB.ReadTransaction(suite.Context, func(tx graph.Transaction) error {
+		starts, err := ops.FetchStartNodes(tx.Relationships().Filterf(func() graph.Criteria {
+			return query.Kind(query.Relationship(), ad.BadSuccessor)
+		}))
+		require.NoError(t, err)
+
+		assert.True(t, starts.Contains(ouCreator), "CreateDMSA on an OU should produce BadSuccessor edges")
+		assert.True(t, starts.Contains(ouDACLWriter), "WriteDacl on an OU should produce BadSuccessor edges")
+		assert.True(t, starts.Contains(ouOwner), "owning an OU should produce BadSuccessor edges")
+		assert.True(t, starts.Contains(dmsaWriter), "GenericWrite on a dMSA should produce BadSuccessor edges")
+		assert.False(t, starts.Contains(legacyCreator), "domains without a Windows Server 2025 domain controller should not produce BadSuccessor edges")
+
+		ends, err := ops.FetchEndNodes(tx.Relationships().Filterf(func() graph.Criteria {
+			return query.And(
+				query.Kind(query.Relationship(), ad.BadSuccessor),
+				query.Equals(query.StartID(), ouCreator.ID),
+			)
+		}))
+		require.NoError(t, err)
+
+		assert.True(t, ends.Contains(victim), "enabled users should be targeted")
+		assert.True(t, ends.Contains(unprivileged), "unprivileged accounts should be targeted")
+		assert.True(t, ends.Contains(dc), "enabled computers should be targeted")
+		assert.False(t, ends.Contains(disabled), "disabled accounts should not be targeted")
+		assert.False(t, ends.Contains(legacyVictim), "targets should be limited to the same domain")
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+// TestPostSiteGPOs verifies that GPOs linked to a site apply to the computers in the site's subnets, honoring blocked
+// inheritance for unenforced links, and that principals with WriteGPLink on the site can apply GPOs to those computers.
+func TestPostSiteGPOs(t *testing.T) {
+	t.Parallel()
+
+	suite := setupIntegrationTestSuite(t)
+	defer teardownIntegrationTestSuite(t, &suite)
+
+	var (
+		domainSID        = RandomDomainSID()
+		domain           = NewActiveDirectoryDomain(t, &suite, "SiteGPO", domainSID, false, true)
+		site             = NewNode(t, &suite, graph.AsProperties(graph.PropertyMap{common.Name: "Site"}), ad.Entity, ad.Site)
+		subnet           = NewNode(t, &suite, graph.AsProperties(graph.PropertyMap{common.Name: "10.0.0.0/24"}), ad.Entity, ad.Subnet)
+		blockingOU       = NewNode(t, &suite, graph.AsProperties(graph.PropertyMap{common.Name: "BlockingOU", ad.DomainSID: domainSID, ad.BlocksInheritance: true}), ad.Entity, ad.OU)
+		unenforcedGPO    = NewNode(t, &suite, graph.AsProperties(graph.PropertyMap{common.Name: "UnenforcedGPO", ad.DomainSID: domainSID}), ad.Entity, ad.GPO)
+		enforcedGPO      = NewNode(t, &suite, graph.AsProperties(graph.PropertyMap{common.Name: "EnforcedGPO", ad.DomainSID: domainSID}), ad.Entity, ad.GPO)
+		siteComputer     = NewActiveDirectoryComputer(t, &suite, "SiteComputer", domainSID)
+		blockedComputer  = NewActiveDirectoryComputer(t, &suite, "BlockedComputer", domainSID)
+		outsideComputer  = NewActiveDirectoryComputer(t, &suite, "OutsideComputer", domainSID)
+		siteLinkWriter   = NewActiveDirectoryUser(t, &suite, "SiteLinkWriter", domainSID)
+		unprivilegedUser = NewActiveDirectoryUser(t, &suite, "UnprivilegedUser", domainSID)
+	)
+
+	NewRelationship(t, &suite, site, subnet, ad.HasSubnet)
+	NewRelationship(t, &suite, siteComputer, subnet, ad.InSubnet)
+	NewRelationship(t, &suite, blockedComputer, subnet, ad.InSubnet)
+	NewRelationship(t, &suite, domain, blockingOU, ad.Contains)
+	NewRelationship(t, &suite, domain, siteComputer, ad.Contains)
+	NewRelationship(t, &suite, domain, outsideComputer, ad.Contains)
+	NewRelationship(t, &suite, blockingOU, blockedComputer, ad.Contains)
+	NewRelationship(t, &suite, unenforcedGPO, site, ad.GPLink, graph.AsProperties(graph.PropertyMap{ad.Enforced: false}))
+	NewRelationship(t, &suite, enforcedGPO, site, ad.GPLink, graph.AsProperties(graph.PropertyMap{ad.Enforced: true}))
+	NewRelationship(t, &suite, siteLinkWriter, site, ad.WriteGPLink)
+	NewRelationship(t, &suite, unprivilegedUser, subnet, ad.GenericAll)
+
+	_, err := adAnalysis.PostSiteGPOs(suite.Context, suite.GraphDB)
+	require.NoError(t, err)
+
+	err = suite.GraphDB.ReadTransaction(suite.Context, func(tx graph.Transaction) error {
+		unenforcedTargets, err := ops.FetchEndNodes(tx.Relationships().Filterf(func() graph.Criteria {
+			return query.And(
+				query.Kind(query.Relationship(), ad.GPOAppliesTo),
+				query.Equals(query.StartID(), unenforcedGPO.ID),
+			)
+		}))
+		require.NoError(t, err)
+
+		assert.True(t, unenforcedTargets.Contains(siteComputer))
+		assert.False(t, unenforcedTargets.Contains(blockedComputer), "blocked inheritance should stop unenforced site GPOs")
+		assert.False(t, unenforcedTargets.Contains(outsideComputer), "computers outside the site's subnets are not affected")
+
+		enforcedTargets, err := ops.FetchEndNodes(tx.Relationships().Filterf(func() graph.Criteria {
+			return query.And(
+				query.Kind(query.Relationship(), ad.GPOAppliesTo),
+				query.Equals(query.StartID(), enforcedGPO.ID),
+			)
+		}))
+		require.NoError(t, err)
+
+		assert.True(t, enforcedTargets.Contains(siteComputer))
+		assert.True(t, enforcedTargets.Contains(blockedComputer), "enforced site GPOs ignore blocked inheritance")
+
+		appliers, err := ops.FetchStartNodes(tx.Relationships().Filterf(func() graph.Criteria {
+			return query.Kind(query.Relationship(), ad.CanApplyGPO)
+		}))
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, appliers.Len())
+		assert.True(t, appliers.Contains(siteLinkWriter))
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+// TestPostADIDNS verifies that principals able to create records in a zone, or to modify its wildcard record, can spoof
+// the domain controllers and web enrollment hosts that resolve through that zone without a record of their own, and
+// that only principals able to modify a host's existing record can spoof that host.
+func TestPostADIDNS(t *testing.T) {
+	t.Parallel()
+
+	suite := setupIntegrationTestSuite(t)
+	defer teardownIntegrationTestSuite(t, &suite)
+
+	var (
+		domainSID        = RandomDomainSID()
+		zone             = NewNode(t, &suite, graph.AsProperties(graph.PropertyMap{common.Name: "adidns.local", ad.ZoneName: "adidns.local"}), ad.Entity, ad.DNSZone)
+		msdcsZone        = NewNode(t, &suite, graph.AsProperties(graph.PropertyMap{common.Name: "_msdcs.adidns.local", ad.ZoneName: "_msdcs.adidns.local"}), ad.Entity, ad.DNSZone)
+		wildcardRecord   = NewNode(t, &suite, graph.AsProperties(graph.PropertyMap{common.Name: "*", ad.RecordName: "*"}), ad.Entity, ad.DNSNode)
+		unrelatedRecord  = NewNode(t, &suite, graph.AsProperties(graph.PropertyMap{common.Name: "fileserver", ad.RecordName: "fileserver"}), ad.Entity, ad.DNSNode)
+		dcRecord         = NewNode(t, &suite, graph.AsProperties(graph.PropertyMap{common.Name: "DC", ad.RecordName: "DC"}), ad.Entity, ad.DNSNode)
+		enterpriseCA     = NewNode(t, &suite, graph.AsProperties(graph.PropertyMap{common.Name: "EnterpriseCA", ad.ADCSWebEnrollmentHTTP: true}), ad.Entity, ad.EnterpriseCA)
+		domainController = NewActiveDirectoryComputer(t, &suite, "DC", domainSID)
+		caHost           = NewActiveDirectoryComputer(t, &suite, "CAHost", domainSID)
+		workstation      = NewActiveDirectoryComputer(t, &suite, "Workstation", domainSID)
+		zoneWriter       = NewActiveDirectoryUser(t, &suite, "ZoneWriter", domainSID)
+		msdcsWriter      = NewActiveDirectoryUser(t, &suite, "MSDCSWriter", domainSID)
+		wildcardWriter   = NewActiveDirectoryUser(t, &suite, "WildcardWriter", domainSID)
+		recordWriter     = NewActiveDirectoryUser(t, &suite, "RecordWriter", domainSID)
+		dcRecordWriter   = NewActiveDirectoryUser(t, &suite, "DCRecordWriter", domainSID)
+	)
+
+	domainController.Properties.Set(ad.IsDC.String(), true)
+	domainController.Properties.Set(ad.DNSHostname.String(), "DC.ADIDNS.LOCAL")
+	UpdateNode(t, &suite, domainController)
+
+	caHost.Properties.Set(ad.DNSHostname.String(), "CAHOST.ADIDNS.LOCAL")
+	UpdateNode(t, &suite, caHost)
+
+	workstation.Properties.Set(ad.DNSHostname.String(), "WORKSTATION.ADIDNS.LOCAL")
+	UpdateNode(t, &suite, workstation)
+
+	NewRelationship(t, &suite, zone, wildcardRecord, ad.Contains)
+	NewRelationship(t, &suite, zone, unrelatedRecord, ad.Contains)
+	NewRelationship(t, &suite, zone, dcRecord, ad.Contains)
+	NewRelationship(t, &suite, caHost, enterpriseCA, ad.HostsCAService)
+	NewRelationship(t, &suite, zoneWriter, zone, ad.CreateDNSRecord)
+	NewRelationship(t, &suite, msdcsWriter, msdcsZone, ad.CreateDNSRecord)
+	NewRelationship(t, &suite, wildcardWriter, wildcardRecord, ad.GenericWrite)
+	NewRelationship(t, &suite, recordWriter, unrelatedRecord, ad.GenericAll)
+	NewRelationship(t, &suite, dcRecordWriter, dcRecord, ad.WriteDACL)
+
+	_, err := adAnalysis.PostADIDNS(suite.Context, suite.GraphDB)
+	require.NoError(t, err)
+
+	err = suite.GraphDB.ReadTransaction(suite.Context, func(tx graph.Transaction) error {
+		caHostSpoofers, err := ops.FetchStartNodes(tx.Relationships().Filterf(func() graph.Criteria {
+			return query.And(
+				query.Kind(query.Relationship(), ad.CanSpoofDNS),
+				query.Equals(query.EndID(), caHost.ID),
+			)
+		}))
+		require.NoError(t, err)
+
+		assert.True(t, caHostSpoofers.Contains(zoneWriter))
+		assert.True(t, caHostSpoofers.Contains(wildcardWriter))
+		assert.False(t, caHostSpoofers.Contains(msdcsWriter), "hosts do not resolve through zones they are not named in")
+		assert.False(t, caHostSpoofers.Contains(recordWriter), "records for other names do not affect the target")
+		assert.False(t, caHostSpoofers.Contains(dcRecordWriter), "records for other names do not affect the target")
+
+		dcSpoofers, err := ops.FetchStartNodes(tx.Relationships().Filterf(func() graph.Criteria {
+			return query.And(
+				query.Kind(query.Relationship(), ad.CanSpoofDNS),
+				query.Equals(query.EndID(), domainController.ID),
+			)
+		}))
+		require.NoError(t, err)
+
+		assert.True(t, dcSpoofers.Contains(dcRecordWriter), "writers of the host's record can redirect it")
+		assert.False(t, dcSpoofers.Contains(zoneWriter), "new records cannot shadow the host's existing record")
+		assert.False(t, dcSpoofers.Contains(wildcardWriter), "the wildcard record does not answer for registered names")
+
+		workstationSpoofers, err := ops.FetchStartNodes(tx.Relationships().Filterf(func() graph.Criteria {
+			return query.And(
+				query.Kind(query.Relationship(), ad.CanSpoofDNS),
+				query.Equals(query.EndID(), workstation.ID),
+			)
+		}))
+		require.NoError(t, err)
+
+		assert.Equal(t, 0, workstationSpoofers.Len(), "only domain controllers and web enrollment hosts are targeted")
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+// TestPostRoasting verifies that Authenticated Users receives scored Kerberoastable and ASREPRoastable edges to the
+// roastable users of its own domain, and that disabled accounts and krbtgt are skipped.
+func TestPostRoasting(t *testing.T) {
+	t.Parallel()
+
+	suite := setupIntegrationTestSuite(t)
+	defer teardownIntegrationTestSuite(t, &suite)
+
+	var (
+		domainSID          = RandomDomainSID()
+		otherDomainSID     = RandomDomainSID()
+		authenticatedUsers = NewNode(t, &suite, graph.AsProperties(graph.PropertyMap{common.Name: "AUTHENTICATED USERS@ROAST.LOCAL", common.ObjectID: "ROAST.LOCAL-S-1-5-11", ad.DomainSID: domainSID}), ad.Entity, ad.Group)
+		serviceAccount     = NewActiveDirectoryUser(t, &suite, "ServiceAccount", domainSID)
+		disabledService    = NewActiveDirectoryUser(t, &suite, "DisabledService", domainSID)
+		krbtgt             = NewNode(t, &suite, graph.AsProperties(graph.PropertyMap{common.Name: "KRBTGT@ROAST.LOCAL", common.ObjectID: domainSID + "-502", ad.DomainSID: domainSID, ad.HasSPN: true}), ad.Entity, ad.User)
+		noPreAuthUser      = NewActiveDirectoryUser(t, &suite, "NoPreAuthUser", domainSID)
+		foreignService     = NewActiveDirectoryUser(t, &suite, "ForeignService", otherDomainSID)
+	)
+
+	serviceAccount.Properties.Set(ad.HasSPN.String(), true)
+	serviceAccount.Properties.Set(ad.SupportedKerberosEncryptionTypes.String(), []string{"AES256-CTS-HMAC-SHA1-96"})
+	UpdateNode(t, &suite, serviceAccount)
+
+	disabledService.Properties.Set(ad.HasSPN.String(), true)
+	disabledService.Properties.Set(common.Enabled.String(), false)
+	UpdateNode(t, &suite, disabledService)
+
+	noPreAuthUser.Properties.Set(ad.DontRequirePreAuth.String(), true)
+	UpdateNode(t, &suite, noPreAuthUser)
+
+	foreignService.Properties.Set(ad.HasSPN.String(), true)
+	UpdateNode(t, &suite, foreignService)
+
+	_, err := adAnalysis.PostRoasting(suite.Context, suite.GraphDB)
+	require.NoError(t, err)
+
+	err = suite.GraphDB.ReadTransaction(suite.Context, func(tx graph.Transaction) error {
+		kerberoastable, err := ops.FetchRelationships(tx.Relationships().Filterf(func() graph.Criteria {
+			return query.And(
+				query.Kind(query.Relationship(), ad.Kerberoastable),
+				query.Equals(query.StartID(), authenticatedUsers.ID),
+			)
+		}))
+		require.NoError(t, err)
+		require.Len(t, kerberoastable, 1)
+		assert.Equal(t, serviceAccount.ID, kerberoastable[0].EndID)
+
+		crackability, err := kerberoastable[0].Properties.Get(ad.Crackability.String()).Float64()
+		require.NoError(t, err)
+		assert.Equal(t, 0.4, crackability, "AES-only accounts without a password age score lower")
+
+		asrepRoastable, err := ops.FetchEndNodes(tx.Relationships().Filterf(func() graph.Criteria {
+			return query.And(
+				query.Kind(query.Relationship(), ad.ASREPRoastable),
+				query.Equals(query.StartID(), authenticatedUsers.ID),
+			)
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, 1, asrepRoastable.Len())
+		assert.True(t, asrepRoastable.Contains(noPreAuthUser))
+
+		for _, excluded := range []*graph.Node{disabledService, krbtgt, foreignService} {
+			count, err := tx.Relationships().Filterf(func() graph.Criteria {
+				return query.And(
+					query.KindIn(query.Relationship(), ad.Kerberoastable, ad.ASREPRoastable),
+					query.Equals(query.EndID(), excluded.ID),
+				)
+			}).Count()
+			require.NoError(t, err)
+			assert.Zero(t, count)
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+// TestPostEffectiveGPOs verifies that only the winning GPO of each computer setting grants access to a computer, that
+// user settings grant access on the computers a user has a session on, that every granting GPO is recorded on the edges
+// created or already produced by local group collection, and that edges resting only on collector GPO changes from a
+// filtered or overridden GPO are removed while collected membership is kept.
+func TestPostEffectiveGPOs(t *testing.T) {
+	t.Parallel()
+
+	suite := setupIntegrationTestSuite(t)
+	defer teardownIntegrationTestSuite(t, &suite)
+
+	var (
+		domainSID        = RandomDomainSID()
+		domain           = NewActiveDirectoryDomain(t, &suite, "EffectiveGPO", domainSID, false, true)
+		ou               = NewNode(t, &suite, graph.AsProperties(graph.PropertyMap{common.Name: "OU", ad.DomainSID: domainSID}), ad.Entity, ad.OU)
+		blockingOU       = NewNode(t, &suite, graph.AsProperties(graph.PropertyMap{common.Name: "BlockingOU", ad.DomainSID: domainSID, ad.BlocksInheritance: true}), ad.Entity, ad.OU)
+		ouComputer       = NewActiveDirectoryComputer(t, &suite, "OUComputer", domainSID)
+		blockedComputer  = NewActiveDirectoryComputer(t, &suite, "BlockedComputer", domainSID)
+		domainComputer   = NewActiveDirectoryComputer(t, &suite, "DomainComputer", domainSID)
+		domainAdmin      = NewActiveDirectoryUser(t, &suite, "DomainAdmin", domainSID)
+		ouAdmin          = NewActiveDirectoryUser(t, &suite, "OUAdmin", domainSID)
+		enforcedRDPUser  = NewActiveDirectoryUser(t, &suite, "EnforcedRDPUser", domainSID)
+		filteredDCOMUser = NewActiveDirectoryUser(t, &suite, "FilteredDCOMUser", domainSID)
+		collectedAdmin   = NewActiveDirectoryUser(t, &suite, "CollectedAdmin", domainSID)
+		ouUser           = NewActiveDirectoryUser(t, &suite, "OUUser", domainSID)
+		objectID         = func(node *graph.Node) string {
+			value, err := node.Properties.Get(common.ObjectID.String()).String()
+			require.NoError(t, err)
+			return value
+		}
+		domainGPO = NewNode(t, &suite, graph.AsProperties(graph.PropertyMap{
+			common.Name:       "DomainGPO",
+			common.ObjectID:   "DOMAIN-GPO",
+			ad.GPOLocalAdmins: []string{objectID(domainAdmin), objectID(collectedAdmin)},
+		}), ad.Entity, ad.GPO)
+		ouGPO = NewNode(t, &suite, graph.AsProperties(graph.PropertyMap{
+			common.Name:       "OUGPO",
+			common.ObjectID:   "OU-GPO",
+			ad.GPOLocalAdmins: []string{objectID(ouAdmin)},
+		}), ad.Entity, ad.GPO)
+		enforcedGPO = NewNode(t, &suite, graph.AsProperties(graph.PropertyMap{
+			common.Name:              "EnforcedGPO",
+			common.ObjectID:          "ENFORCED-GPO",
+			ad.GPORemoteDesktopUsers: []string{objectID(enforcedRDPUser)},
+		}), ad.Entity, ad.GPO)
+		filteredGPO = NewNode(t, &suite, graph.AsProperties(graph.PropertyMap{
+			common.Name:          "FilteredGPO",
+			common.ObjectID:      "FILTERED-GPO",
+			ad.GPODcomUsers:      []string{objectID(filteredDCOMUser)},
+			ad.SecurityFiltering: []string{domainSID + "-1234"},
+		}), ad.Entity, ad.GPO)
+		rightGPO = NewNode(t, &suite, graph.AsProperties(graph.PropertyMap{
+			common.Name:                       "RightGPO",
+			common.ObjectID:                   "RIGHT-GPO",
+			ad.GPORemoteInteractiveLogonRight: []string{"EFFECTIVEGPO.LOCAL-S-1-5-32-555", objectID(enforcedRDPUser)},
+		}), ad.Entity, ad.GPO)
+		userGPO = NewNode(t, &suite, graph.AsProperties(graph.PropertyMap{
+			common.Name:           "UserGPO",
+			common.ObjectID:       "USER-GPO",
+			ad.GPOLogonUserGroups: []string{"EFFECTIVEGPO.LOCAL-S-1-5-32-544"},
+		}), ad.Entity, ad.GPO)
+		userDisabledGPO = NewNode(t, &suite, graph.AsProperties(graph.PropertyMap{
+			common.Name:           "UserDisabledGPO",
+			common.ObjectID:       "USER-DISABLED-GPO",
+			ad.GPOStatusRaw:       "1",
+			ad.GPOLogonUserGroups: []string{"S-1-5-32-580"},
+		}), ad.Entity, ad.GPO)
+	)
+
+	NewRelationship(t, &suite, domain, ou, ad.Contains)
+	NewRelationship(t, &suite, domain, blockingOU, ad.Contains)
+	NewRelationship(t, &suite, domain, domainComputer, ad.Contains)
+	NewRelationship(t, &suite, ou, ouComputer, ad.Contains)
+	NewRelationship(t, &suite, blockingOU, blockedComputer, ad.Contains)
+	NewRelationship(t, &suite, domainGPO, domain, ad.GPLink, graph.AsProperties(graph.PropertyMap{ad.Enforced: false}))
+	NewRelationship(t, &suite, ouGPO, ou, ad.GPLink, graph.AsProperties(graph.PropertyMap{ad.Enforced: false}))
+	NewRelationship(t, &suite, enforcedGPO, domain, ad.GPLink, graph.AsProperties(graph.PropertyMap{ad.Enforced: true}))
+	NewRelationship(t, &suite, filteredGPO, domain, ad.GPLink, graph.AsProperties(graph.PropertyMap{ad.Enforced: false}))
+	NewRelationship(t, &suite, rightGPO, ou, ad.GPLink, graph.AsProperties(graph.PropertyMap{ad.Enforced: false}))
+	NewRelationship(t, &suite, userGPO, ou, ad.GPLink, graph.AsProperties(graph.PropertyMap{ad.Enforced: false}))
+	NewRelationship(t, &suite, userDisabledGPO, ou, ad.GPLink, graph.AsProperties(graph.PropertyMap{ad.Enforced: false}))
+	NewRelationship(t, &suite, ou, ouUser, ad.Contains)
+	NewRelationship(t, &suite, ouComputer, ouUser, ad.HasSession)
+	NewRelationship(t, &suite, collectedAdmin, domainComputer, ad.AdminTo)
+
+	// Local group changes resolved by the collector from GPOs create unnamed local groups, while local groups collected
+	// from the computer itself are named
+	var (
+		gpoChangesDCOMGroup = NewNode(t, &suite, graph.AsProperties(graph.PropertyMap{common.ObjectID: objectID(domainComputer) + "-562"}), ad.Entity, ad.LocalGroup)
+		gpoChangesAdmins    = NewNode(t, &suite, graph.AsProperties(graph.PropertyMap{common.ObjectID: objectID(ouComputer) + "-544"}), ad.Entity, ad.LocalGroup)
+		collectedDCOMGroup  = NewNode(t, &suite, graph.AsProperties(graph.PropertyMap{common.ObjectID: objectID(ouComputer) + "-562", common.Name: "DISTRIBUTED COM USERS@OUCOMPUTER"}), ad.Entity, ad.LocalGroup)
+	)
+
+	NewRelationship(t, &suite, gpoChangesDCOMGroup, domainComputer, ad.LocalToComputer)
+	NewRelationship(t, &suite, filteredDCOMUser, gpoChangesDCOMGroup, ad.MemberOfLocalGroup)
+	NewRelationship(t, &suite, filteredDCOMUser, domainComputer, ad.ExecuteDCOM)
+	NewRelationship(t, &suite, gpoChangesAdmins, ouComputer, ad.LocalToComputer)
+	NewRelationship(t, &suite, domainAdmin, gpoChangesAdmins, ad.MemberOfLocalGroup)
+	NewRelationship(t, &suite, domainAdmin, ouComputer, ad.AdminTo)
+	NewRelationship(t, &suite, collectedDCOMGroup, ouComputer, ad.LocalToComputer)
+	NewRelationship(t, &suite, filteredDCOMUser, collectedDCOMGroup, ad.MemberOfLocalGroup)
+	NewRelationship(t, &suite, filteredDCOMUser, ouComputer, ad.ExecuteDCOM)
+
+	_, err := adAnalysis.PostEffectiveGPOs(suite.Context, suite.GraphDB)
+	require.NoError(t, err)
+
+	err = suite.GraphDB.ReadTransaction(suite.Context, func(tx graph.Transaction) error {
+		sourceGPOs := func(from, to *graph.Node, kind graph.Kind) []string {
+			relationships, err := ops.FetchRelationships(tx.Relationships().Filterf(func() graph.Criteria {
+				return query.And(
+					query.Kind(query.Relationship(), kind),
+					query.Equals(query.StartID(), from.ID),
+					query.Equals(query.EndID(), to.ID),
+				)
+			}))
+			require.NoError(t, err)
+
+			var sources []string
+			for _, relationship := range relationships {
+				if relationship.Properties.Exists(ad.SourceGPOs.String()) {
+					relationshipSources, err := relationship.Properties.Get(ad.SourceGPOs.String()).StringSlice()
+					require.NoError(t, err)
+					sources = append(sources, relationshipSources...)
+				}
+			}
+
+			return sources
+		}
+
+		edgeCount := func(from, to *graph.Node, kind graph.Kind) int64 {
+			count, err := tx.Relationships().Filterf(func() graph.Criteria {
+				return query.And(
+					query.Kind(query.Relationship(), kind),
+					query.Equals(query.StartID(), from.ID),
+					query.Equals(query.EndID(), to.ID),
+				)
+			}).Count()
+			require.NoError(t, err)
+			return count
+		}
+
+		assert.Equal(t, []string{"OU-GPO"}, sourceGPOs(ouAdmin, ouComputer, ad.AdminTo))
+		assert.Empty(t, sourceGPOs(domainAdmin, ouComputer, ad.AdminTo), "the OU GPO takes precedence over the domain GPO")
+		assert.Equal(t, []string{"DOMAIN-GPO"}, sourceGPOs(domainAdmin, domainComputer, ad.AdminTo))
+		assert.Empty(t, sourceGPOs(domainAdmin, blockedComputer, ad.AdminTo), "blocked inheritance stops unenforced domain GPOs")
+		assert.Equal(t, []string{"ENFORCED-GPO"}, sourceGPOs(enforcedRDPUser, blockedComputer, ad.CanRDP), "enforced GPOs ignore blocked inheritance")
+		assert.Equal(t, []string{"ENFORCED-GPO", "RIGHT-GPO"}, sourceGPOs(enforcedRDPUser, ouComputer, ad.CanRDP), "every GPO granting the edge is recorded")
+		assert.Equal(t, []string{"USER-GPO"}, sourceGPOs(ouUser, ouComputer, ad.AdminTo), "user settings apply where the user has a session")
+		assert.Empty(t, sourceGPOs(ouUser, ouComputer, ad.CanPSRemote), "GPOs with a disabled user configuration do not apply to users")
+
+		assert.Equal(t, int64(1), edgeCount(collectedAdmin, domainComputer, ad.AdminTo))
+		assert.Equal(t, []string{"DOMAIN-GPO"}, sourceGPOs(collectedAdmin, domainComputer, ad.AdminTo), "existing edges record the GPOs that grant them")
+
+		assert.Zero(t, edgeCount(filteredDCOMUser, domainComputer, ad.ExecuteDCOM), "collector GPO changes from a security filtered GPO are removed")
+		assert.Zero(t, edgeCount(domainAdmin, ouComputer, ad.AdminTo), "collector GPO changes from an overridden GPO are removed")
+		assert.Equal(t, int64(1), edgeCount(filteredDCOMUser, ouComputer, ad.ExecuteDCOM), "collected membership is kept")
+		assert.Empty(t, sourceGPOs(filteredDCOMUser, ouComputer, ad.ExecuteDCOM))
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+// TestPostForeignMemberOf verifies that ForeignMemberOf edges are created across same forest and cross forest trusts,
+// that selective authentication trusts require AllowedToAuthenticate, and that untrusted domains produce no edges. The
+// topology consists of a forest root with a child domain, a partner forest, a partner forest with selective
+// authentication and an untrusted forest.
+func TestPostForeignMemberOf(t *testing.T) {
+	t.Parallel()
+
+	suite := setupIntegrationTestSuite(t)
+	defer teardownIntegrationTestSuite(t, &suite)
+
+	var (
+		rootSID           = RandomDomainSID()
+		rootDomain        = NewActiveDirectoryDomain(t, &suite, "ForestRoot", rootSID, false, true)
+		childSID          = RandomDomainSID()
+		childDomain       = NewActiveDirectoryDomain(t, &suite, "ForestChild", childSID, false, true)
+		partnerSID        = RandomDomainSID()
+		partnerDomain     = NewActiveDirectoryDomain(t, &suite, "PartnerForest", partnerSID, false, true)
+		selectiveSID      = RandomDomainSID()
+		selectiveDomain   = NewActiveDirectoryDomain(t, &suite, "SelectiveForest", selectiveSID, false, true)
+		untrustedSID      = RandomDomainSID()
+		untrustedDomain   = NewActiveDirectoryDomain(t, &suite, "UntrustedForest", untrustedSID, false, true)
+		adminsGroup       = NewNode(t, &suite, graph.AsProperties(graph.PropertyMap{common.Name: "Admins", ad.DomainSID: rootSID}), ad.Entity, ad.Group)
+		rootComputer      = NewActiveDirectoryComputer(t, &suite, "RootComputer", rootSID)
+		rootUser          = NewActiveDirectoryUser(t, &suite, "RootUser", rootSID)
+		childUser         = NewActiveDirectoryUser(t, &suite, "ChildUser", childSID)
+		partnerUser       = NewActiveDirectoryUser(t, &suite, "PartnerUser", partnerSID)
+		selectiveUser     = NewActiveDirectoryUser(t, &suite, "SelectiveUser", selectiveSID)
+		selectiveAuthUser = NewActiveDirectoryUser(t, &suite, "SelectiveAuthUser", selectiveSID)
+		untrustedUser     = NewActiveDirectoryUser(t, &suite, "UntrustedUser", untrustedSID)
+	)
+
+	NewRelationship(t, &suite, rootDomain, childDomain, ad.SameForestTrust)
+	NewRelationship(t, &suite, childDomain, rootDomain, ad.SameForestTrust)
+	partnerTrust := NewRelationship(t, &suite, rootDomain, partnerDomain, ad.CrossForestTrust, graph.AsProperties(graph.PropertyMap{ad.SelectiveAuthentication: false}))
+	NewRelationship(t, &suite, rootDomain, selectiveDomain, ad.CrossForestTrust, graph.AsProperties(graph.PropertyMap{ad.SelectiveAuthentication: true}))
+
+	NewRelationship(t, &suite, rootUser, adminsGroup, ad.MemberOf)
+	NewRelationship(t, &suite, childUser, adminsGroup, ad.MemberOf)
+	partnerMembership := NewRelationship(t, &suite, partnerUser, adminsGroup, ad.MemberOf)
+	NewRelationship(t, &suite, selectiveUser, adminsGroup, ad.MemberOf)
+	NewRelationship(t, &suite, selectiveAuthUser, adminsGroup, ad.MemberOf)
+	NewRelationship(t, &suite, untrustedUser, adminsGroup, ad.MemberOf)
+	NewRelationship(t, &suite, selectiveAuthUser, rootComputer, ad.AllowedToAuthenticate)
+
+	_, err := adAnalysis.PostForeignMemberOf(suite.Context, suite.GraphDB)
+	require.NoError(t, err)
+
+	err = suite.GraphDB.ReadTransaction(suite.Context, func(tx graph.Transaction) error {
+		starts, err := ops.FetchStartNodes(tx.Relationships().Filterf(func() graph.Criteria {
+			return query.And(
+				query.Kind(query.Relationship(), ad.ForeignMemberOf),
+				query.Equals(query.EndID(), adminsGroup.ID),
+			)
+		}))
+		require.NoError(t, err)
+
+		assert.Equal(t, 3, starts.Len())
+		assert.True(t, starts.Contains(childDomain), "same forest domains are trusted transitively")
+		assert.True(t, starts.Contains(partnerDomain), "cross forest trusts without selective authentication accept foreign members")
+		assert.True(t, starts.Contains(selectiveDomain), "selective authentication accepts members allowed to authenticate")
+		assert.False(t, starts.Contains(untrustedDomain), "untrusted domains should not produce edges")
+		assert.False(t, starts.Contains(rootDomain), "members of the group's own domain are not foreign")
+
+		partnerEdge, err := tx.Relationships().Filterf(func() graph.Criteria {
+			return query.And(
+				query.Kind(query.Relationship(), ad.ForeignMemberOf),
+				query.Equals(query.StartID(), partnerDomain.ID),
+			)
+		}).First()
+		require.NoError(t, err)
+
+		composition, found := post.ParseEdgeComposition(partnerEdge.Properties)
+		require.True(t, found)
+		assert.Equal(t, adAnalysis.CompositionRuleForeignMemberOf, composition.Rule)
+		assert.ElementsMatch(t, []graph.ID{partnerUser.ID}, composition.NodeIDs)
+		assert.ElementsMatch(t, []graph.ID{partnerMembership.ID, partnerTrust.ID}, composition.EdgeIDs)
+
+		selectiveEdge, err := tx.Relationships().Filterf(func() graph.Criteria {
+			return query.And(
+				query.Kind(query.Relationship(), ad.ForeignMemberOf),
+				query.Equals(query.StartID(), selectiveDomain.ID),
+			)
+		}).First()
+		require.NoError(t, err)
+
+		composition, found = post.ParseEdgeComposition(selectiveEdge.Properties)
+		require.True(t, found)
+		assert.ElementsMatch(t, []graph.ID{selectiveAuthUser.ID}, composition.NodeIDs, "members without AllowedToAuthenticate should not contribute")
+		return nil
+	})
+	require.NoError(t, err)
+}
@@ -341,21 +341,24 @@ func TestEnrollOnBehalfOf(t *testing.T) {
 			require.Len(t, results, 3)
 
 			require.Contains(t, results, post.EnsureRelationshipJob{
-				FromID: harness.EnrollOnBehalfOfHarness1.CertTemplate11.ID,
-				ToID:   harness.EnrollOnBehalfOfHarness1.CertTemplate12.ID,
-				Kind:   ad.EnrollOnBehalfOf,
+				FromID:      harness.EnrollOnBehalfOfHarness1.CertTemplate11.ID,
+				ToID:        harness.EnrollOnBehalfOfHarness1.CertTemplate12.ID,
+				Kind:        ad.EnrollOnBehalfOf,
+				Composition: post.NewEdgeComposition(adAnalysis.CompositionRuleEnrollOnBehalfOfV1, harness.EnrollOnBehalfOfHarness1.Domain1.ID),
 			})
 
 			require.Contains(t, results, post.EnsureRelationshipJob{
-				FromID: harness.EnrollOnBehalfOfHarness1.CertTemplate13.ID,
-				ToID:   harness.EnrollOnBehalfOfHarness1.CertTemplate12.ID,
-				Kind:   ad.EnrollOnBehalfOf,
+				FromID:      harness.EnrollOnBehalfOfHarness1.CertTemplate13.ID,
+				ToID:        harness.EnrollOnBehalfOfHarness1.CertTemplate12.ID,
+				Kind:        ad.EnrollOnBehalfOf,
+				Composition: post.NewEdgeComposition(adAnalysis.CompositionRuleEnrollOnBehalfOfV1, harness.EnrollOnBehalfOfHarness1.Domain1.ID),
 			})
 
 			require.Contains(t, results, post.EnsureRelationshipJob{
-				FromID: harness.EnrollOnBehalfOfHarness1.CertTemplate12.ID,
-				ToID:   harness.EnrollOnBehalfOfHarness1.CertTemplate12.ID,
-				Kind:   ad.EnrollOnBehalfOf,
+				FromID:      harness.EnrollOnBehalfOfHarness1.CertTemplate12.ID,
+				ToID:        harness.EnrollOnBehalfOfHarness1.CertTemplate12.ID,
+				Kind:        ad.EnrollOnBehalfOf,
+				Composition: post.NewEdgeComposition(adAnalysis.CompositionRuleEnrollOnBehalfOfV1, harness.EnrollOnBehalfOfHarness1.Domain1.ID),
 			})
 
 			resultsV2 := adAnalysis.EnrollOnBehalfOfVersionTwo(cache, v2Templates, certTemplates, harness.EnrollOnBehalfOfHarness1.Domain1.ID)
@@ -400,9 +403,10 @@ func TestEnrollOnBehalfOf(t *testing.T) {
 
 			require.Len(t, resultsV2, 1)
 			require.Contains(t, resultsV2, post.EnsureRelationshipJob{
-				FromID: harness.EnrollOnBehalfOfHarness2.CertTemplate21.ID,
-				ToID:   harness.EnrollOnBehalfOfHarness2.CertTemplate23.ID,
-				Kind:   ad.EnrollOnBehalfOf,
+				FromID:      harness.EnrollOnBehalfOfHarness2.CertTemplate21.ID,
+				ToID:        harness.EnrollOnBehalfOfHarness2.CertTemplate23.ID,
+				Kind:        ad.EnrollOnBehalfOf,
+				Composition: post.NewEdgeComposition(adAnalysis.CompositionRuleEnrollOnBehalfOfAgent, harness.EnrollOnBehalfOfHarness2.Domain2.ID),
 			})
 		})
 	})
@@ -0,0 +1,277 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ad
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/specterops/bloodhound/packages/go/analysis/post"
+	"github.com/specterops/bloodhound/packages/go/bhlog/attr"
+	"github.com/specterops/bloodhound/packages/go/bhlog/measure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/dawgs/cardinality"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/ops"
+	"github.com/specterops/dawgs/query"
+	"github.com/specterops/dawgs/util/channels"
+)
+
+// wildcardDNSRecordName is the record name of an ADIDNS wildcard record, which answers for any name in the zone that
+// has no record of its own
+const wildcardDNSRecordName = "*"
+
+// PostADIDNS creates CanSpoofDNS edges from principals that can create or modify records in an AD-integrated DNS
+// zone to the domain controllers and ADCS web enrollment hosts whose names resolve through that zone. Such a principal
+// can register a record that redirects clients of the host to an attacker controlled address, which is commonly used
+// to intercept authentication for relay attacks. When the host already has a record in the zone, only principals that
+// can modify that record can redirect it; otherwise principals that can create records in the zone or modify its
+// wildcard record can.
+func PostADIDNS(ctx context.Context, db graph.Database) (*post.AtomicPostProcessingStats, error) {
+	defer measure.ContextLogAndMeasure(
+		ctx,
+		slog.LevelInfo,
+		"Post-processing ADIDNS",
+		attr.Namespace("analysis"),
+		attr.Function("PostADIDNS"),
+		attr.Scope("process"),
+	)()
+
+	var (
+		zoneNodes []*graph.Node
+		hostNodes graph.NodeSet
+	)
+
+	if err := db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		var err error
+		if zoneNodes, err = ops.FetchNodes(tx.Nodes().Filterf(func() graph.Criteria {
+			return query.Kind(query.Node(), ad.DNSZone)
+		})); err != nil {
+			return err
+		} else if len(zoneNodes) == 0 {
+			return nil
+		}
+
+		hostNodes, err = FetchDNSSpoofTargets(tx)
+		return err
+	}); err != nil {
+		return &post.AtomicPostProcessingStats{}, err
+	}
+
+	operation := post.NewPostRelationshipOperation(ctx, db, "ADIDNS Post Processing")
+
+	for zoneID, hosts := range mapHostsToDNSZones(zoneNodes, hostNodes) {
+		var (
+			innerZoneID = zoneID
+			innerHosts  = hosts
+		)
+
+		operation.Operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, outC chan<- post.EnsureRelationshipJob) error {
+			if recordsByName, err := fetchDNSZoneRecords(tx, innerZoneID); err != nil {
+				return err
+			} else if zoneWriters, err := fetchDNSZoneWriters(tx, innerZoneID); err != nil {
+				return err
+			} else if wildcardWriters, err := fetchDNSRecordWriters(tx, recordsByName[wildcardDNSRecordName]); err != nil {
+				return err
+			} else {
+				// Principals that can create a record for an unregistered name, or answer for it through the wildcard
+				unregisteredSpoofers := cardinality.NewBitmap64()
+				unregisteredSpoofers.Or(zoneWriters)
+				unregisteredSpoofers.Or(wildcardWriters)
+
+				for _, host := range innerHosts {
+					var spoofers cardinality.Duplex[uint64]
+
+					if hostRecords, registered := recordsByName[host.label]; !registered {
+						spoofers = unregisteredSpoofers
+					} else if recordWriters, err := fetchDNSRecordWriters(tx, hostRecords); err != nil {
+						return err
+					} else {
+						spoofers = recordWriters
+					}
+
+					composition := post.NewEdgeComposition(CompositionRuleCanSpoofDNS, innerZoneID)
+
+					spoofers.Each(func(spoofer uint64) bool {
+						if spoofer == host.node.ID.Uint64() {
+							return true
+						}
+
+						return channels.Submit(ctx, outC, post.EnsureRelationshipJob{
+							FromID:      graph.ID(spoofer),
+							ToID:        host.node.ID,
+							Kind:        ad.CanSpoofDNS,
+							Composition: composition,
+						})
+					})
+				}
+
+				return nil
+			}
+		})
+	}
+
+	return &operation.Stats, operation.Done()
+}
+
+// dnsZoneHost is a spoofable host along with its name relative to the DNS zone it resolves through
+type dnsZoneHost struct {
+	node  *graph.Node
+	label string
+}
+
+// mapHostsToDNSZones groups hosts by the most specific DNS zone that their dnshostname falls within. Hosts that do not
+// resolve through any of the given zones are omitted.
+func mapHostsToDNSZones(zoneNodes []*graph.Node, hostNodes graph.NodeSet) map[graph.ID][]dnsZoneHost {
+	hostsByZone := make(map[graph.ID][]dnsZoneHost)
+
+	for _, host := range hostNodes {
+		rawHostname, err := host.Properties.Get(ad.DNSHostname.String()).String()
+		if err != nil || rawHostname == "" {
+			continue
+		}
+
+		var (
+			hostname    = strings.ToLower(strings.TrimSuffix(rawHostname, "."))
+			bestZone    graph.ID
+			bestZoneLen = -1
+			bestLabel   string
+		)
+
+		for _, zone := range zoneNodes {
+			zoneName, err := zone.Properties.Get(ad.ZoneName.String()).String()
+			if err != nil || zoneName == "" {
+				continue
+			}
+
+			zoneName = strings.ToLower(strings.TrimSuffix(zoneName, "."))
+			if label, found := strings.CutSuffix(hostname, "."+zoneName); found && label != "" && len(zoneName) > bestZoneLen {
+				bestZone = zone.ID
+				bestZoneLen = len(zoneName)
+				bestLabel = label
+			}
+		}
+
+		if bestZoneLen >= 0 {
+			hostsByZone[bestZone] = append(hostsByZone[bestZone], dnsZoneHost{
+				node:  host,
+				label: bestLabel,
+			})
+		}
+	}
+
+	return hostsByZone
+}
+
+// FetchDNSSpoofTargets returns domain controllers and computers hosting an enterprise CA with HTTP or HTTPS web
+// enrollment enabled. These are the hosts whose name resolution is most valuable to an attacker positioned to relay
+// authentication.
+func FetchDNSSpoofTargets(tx graph.Transaction) (graph.NodeSet, error) {
+	if domainControllers, err := ops.FetchNodeSet(tx.Nodes().Filterf(func() graph.Criteria {
+		return query.And(
+			query.Kind(query.Node(), ad.Computer),
+			query.Equals(query.NodeProperty(ad.IsDC.String()), true),
+		)
+	})); err != nil {
+		return nil, err
+	} else if webEnrollmentHosts, err := ops.FetchStartNodes(tx.Relationships().Filterf(func() graph.Criteria {
+		return query.And(
+			query.Kind(query.Start(), ad.Computer),
+			query.Kind(query.Relationship(), ad.HostsCAService),
+			query.Kind(query.End(), ad.EnterpriseCA),
+			query.Or(
+				query.Equals(query.EndProperty(ad.ADCSWebEnrollmentHTTP.String()), true),
+				query.Equals(query.EndProperty(ad.ADCSWebEnrollmentHTTPS.String()), true),
+			),
+		)
+	})); err != nil {
+		return nil, err
+	} else {
+		domainControllers.AddSet(webEnrollmentHosts)
+		return domainControllers, nil
+	}
+}
+
+// fetchDNSZoneWriters returns the IDs of principals that can create new records in the zone, either directly or by
+// taking control of the zone object
+func fetchDNSZoneWriters(tx graph.Transaction, zoneID graph.ID) (cardinality.Duplex[uint64], error) {
+	writers := cardinality.NewBitmap64()
+
+	if ids, err := ops.FetchStartNodeIDs(tx.Relationships().Filterf(func() graph.Criteria {
+		return query.And(
+			query.KindIn(query.Start(), ad.Group, ad.User, ad.Computer),
+			query.KindIn(query.Relationship(), ad.CreateDNSRecord, ad.GenericAll, ad.GenericWrite, ad.WriteDACL, ad.Owns, ad.WriteOwner),
+			query.Equals(query.EndID(), zoneID),
+		)
+	})); err != nil {
+		return nil, err
+	} else {
+		for _, id := range ids {
+			writers.Add(id.Uint64())
+		}
+
+		return writers, nil
+	}
+}
+
+// fetchDNSZoneRecords returns the IDs of the records contained by the zone, keyed by their lowercase record name
+func fetchDNSZoneRecords(tx graph.Transaction, zoneID graph.ID) (map[string][]graph.ID, error) {
+	if records, err := ops.FetchEndNodes(tx.Relationships().Filterf(func() graph.Criteria {
+		return query.And(
+			query.Equals(query.StartID(), zoneID),
+			query.Kind(query.Relationship(), ad.Contains),
+			query.Kind(query.End(), ad.DNSNode),
+		)
+	})); err != nil {
+		return nil, err
+	} else {
+		recordsByName := make(map[string][]graph.ID, records.Len())
+
+		for _, record := range records {
+			if recordName, err := record.Properties.Get(ad.RecordName.String()).String(); err == nil && recordName != "" {
+				recordName = strings.ToLower(recordName)
+				recordsByName[recordName] = append(recordsByName[recordName], record.ID)
+			}
+		}
+
+		return recordsByName, nil
+	}
+}
+
+// fetchDNSRecordWriters returns the IDs of principals that can modify any of the given records
+func fetchDNSRecordWriters(tx graph.Transaction, recordIDs []graph.ID) (cardinality.Duplex[uint64], error) {
+	writers := cardinality.NewBitmap64()
+
+	if len(recordIDs) == 0 {
+		return writers, nil
+	} else if ids, err := ops.FetchStartNodeIDs(tx.Relationships().Filterf(func() graph.Criteria {
+		return query.And(
+			query.KindIn(query.Start(), ad.Group, ad.User, ad.Computer),
+			query.KindIn(query.Relationship(), ad.GenericAll, ad.GenericWrite, ad.WriteDACL, ad.Owns, ad.WriteOwner),
+			query.InIDs(query.EndID(), recordIDs...),
+		)
+	})); err != nil {
+		return nil, err
+	} else {
+		for _, id := range ids {
+			writers.Add(id.Uint64())
+		}
+
+		return writers, nil
+	}
+}
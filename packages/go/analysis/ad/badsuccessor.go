@@ -0,0 +1,179 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ad
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/specterops/bloodhound/packages/go/analysis/post"
+	"github.com/specterops/bloodhound/packages/go/bhlog/attr"
+	"github.com/specterops/bloodhound/packages/go/bhlog/measure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/cardinality"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/ops"
+	"github.com/specterops/dawgs/query"
+	"github.com/specterops/dawgs/util/channels"
+)
+
+// dmsaOperatingSystem identifies the operating system of domain controllers that support dMSAs
+const dmsaOperatingSystem = "2025"
+
+// PostBadSuccessor creates BadSuccessor edges from principals that can create a dMSA in a container of the domain, or
+// write to an existing dMSA, to every enabled user and computer account of that domain. Such a principal can point
+// the msDS-ManagedAccountPrecededByLink attribute of the dMSA at any account and inherit its privileges. dMSAs only
+// require a single Windows Server 2025 domain controller rather than a raised functional level, so the edge is created
+// for every domain with at least one such domain controller.
+func PostBadSuccessor(ctx context.Context, db graph.Database) (*post.AtomicPostProcessingStats, error) {
+	defer measure.ContextLogAndMeasure(
+		ctx,
+		slog.LevelInfo,
+		"Post-processing BadSuccessor",
+		attr.Namespace("analysis"),
+		attr.Function("PostBadSuccessor"),
+		attr.Scope("process"),
+	)()
+
+	domainNodes, err := fetchCollectedDomainNodes(ctx, db)
+	if err != nil {
+		return &post.AtomicPostProcessingStats{}, err
+	}
+
+	operation := post.NewPostRelationshipOperation(ctx, db, "BadSuccessor Post Processing")
+
+	for _, domain := range domainNodes {
+		innerDomain := domain
+
+		operation.Operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, outC chan<- post.EnsureRelationshipJob) error {
+			if domainSID, err := innerDomain.Properties.Get(ad.DomainSID.String()).String(); err != nil {
+				slog.DebugContext(
+					ctx,
+					"Skipping domain. Missing DomainSID property",
+					slog.Uint64("domain_id", uint64(innerDomain.ID)),
+				)
+				return nil
+			} else if supported, err := supportsDMSA(tx, domainSID); err != nil {
+				return err
+			} else if !supported {
+				return nil
+			} else if attackers, err := FetchBadSuccessorAttackers(tx, innerDomain, domainSID); err != nil {
+				return err
+			} else if attackers.Cardinality() == 0 {
+				return nil
+			} else if targets, err := fetchBadSuccessorTargets(tx, domainSID); err != nil {
+				return err
+			} else {
+				attackers.Each(func(attacker uint64) bool {
+					for _, target := range targets {
+						if target.Uint64() == attacker {
+							continue
+						}
+
+						if !channels.Submit(ctx, outC, post.EnsureRelationshipJob{
+							FromID:      graph.ID(attacker),
+							ToID:        target,
+							Kind:        ad.BadSuccessor,
+							Composition: post.NewEdgeComposition(CompositionRuleBadSuccessor, innerDomain.ID),
+						}) {
+							return false
+						}
+					}
+
+					return true
+				})
+
+				return nil
+			}
+		})
+	}
+
+	return &operation.Stats, operation.Done()
+}
+
+// supportsDMSA returns true if the domain has at least one Windows Server 2025 domain controller. Collectors report
+// the operating system of a computer as its product name, such as "Windows Server 2025 Datacenter".
+func supportsDMSA(tx graph.Transaction, domainSID string) (bool, error) {
+	if count, err := tx.Nodes().Filterf(func() graph.Criteria {
+		return query.And(
+			query.Kind(query.Node(), ad.Computer),
+			query.Equals(query.NodeProperty(ad.IsDC.String()), true),
+			query.Equals(query.NodeProperty(ad.DomainSID.String()), domainSID),
+			query.StringContains(query.NodeProperty(common.OperatingSystem.String()), dmsaOperatingSystem),
+		)
+	}).Count(); err != nil {
+		return false, err
+	} else {
+		return count > 0, nil
+	}
+}
+
+// FetchBadSuccessorAttackers returns the IDs of principals that can create a dMSA in the domain or in an OU or container
+// within it, as well as principals that hold GenericAll or GenericWrite on an existing dMSA. A dMSA can be created with
+// CreateDMSA, which generic CreateChild grants are ingested as, with GenericAll, or by a principal that can grant
+// itself either through WriteDacl, WriteOwner or ownership of the container.
+func FetchBadSuccessorAttackers(tx graph.Transaction, domain *graph.Node, domainSID string) (cardinality.Duplex[uint64], error) {
+	attackers := cardinality.NewBitmap64()
+
+	if containerControllers, err := ops.FetchStartNodeIDs(tx.Relationships().Filterf(func() graph.Criteria {
+		return query.And(
+			query.KindIn(query.Start(), ad.Group, ad.User, ad.Computer),
+			query.KindIn(query.Relationship(), ad.CreateDMSA, ad.GenericAll, ad.WriteDACL, ad.WriteOwner, ad.WriteOwnerRaw, ad.Owns, ad.OwnsRaw),
+			query.Or(
+				query.Equals(query.EndID(), domain.ID),
+				query.And(
+					query.KindIn(query.End(), ad.OU, ad.Container),
+					query.Equals(query.EndProperty(ad.DomainSID.String()), domainSID),
+				),
+			),
+		)
+	})); err != nil {
+		return nil, err
+	} else if dmsaWriters, err := ops.FetchStartNodeIDs(tx.Relationships().Filterf(func() graph.Criteria {
+		return query.And(
+			query.KindIn(query.Start(), ad.Group, ad.User, ad.Computer),
+			query.KindIn(query.Relationship(), ad.GenericAll, ad.GenericWrite),
+			query.Equals(query.EndProperty(ad.DMSA.String()), true),
+			query.Equals(query.EndProperty(ad.DomainSID.String()), domainSID),
+		)
+	})); err != nil {
+		return nil, err
+	} else {
+		for _, id := range containerControllers {
+			attackers.Add(id.Uint64())
+		}
+
+		for _, id := range dmsaWriters {
+			attackers.Add(id.Uint64())
+		}
+
+		return attackers, nil
+	}
+}
+
+// fetchBadSuccessorTargets returns the IDs of the enabled user and computer accounts in the domain. A dMSA may
+// supersede any of them, privileged or not.
+func fetchBadSuccessorTargets(tx graph.Transaction, domainSID string) ([]graph.ID, error) {
+	return ops.FetchNodeIDs(tx.Nodes().Filterf(func() graph.Criteria {
+		return query.And(
+			query.KindIn(query.Node(), ad.User, ad.Computer),
+			query.Equals(query.NodeProperty(ad.DomainSID.String()), domainSID),
+			query.Equals(query.NodeProperty(common.Enabled.String()), true),
+		)
+	}))
+}
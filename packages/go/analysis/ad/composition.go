@@ -0,0 +1,183 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ad
+
+import (
+	"sync"
+
+	"github.com/specterops/bloodhound/packages/go/analysis/post"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/dawgs/cardinality"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/ops"
+	"github.com/specterops/dawgs/query"
+)
+
+// Composition rules recorded on AD post-processed edges. Each rule names the condition that caused an edge to be
+// created; the contributing nodes and edges recorded alongside a rule are listed next to it.
+const (
+	// Nodes: the domain the replication rights are held on; edges: the GetChanges and GetChangesAll edges held by the
+	// principal or its groups and the MemberOf edges leading to those groups
+	CompositionRuleDCSync = "DCSyncReplicationRights"
+	// Nodes: the domain the LAPS synchronization rights are held on; edges: the GetChanges and GetChangesInFilteredSet
+	// edges held by the principal or its groups and the MemberOf edges leading to those groups
+	CompositionRuleSyncLAPSPassword = "SyncLAPSPasswordRights"
+	// Nodes: the domain whose AdminSDHolder protects the target; edges: the MemberOf edges leading from the target to
+	// the protected groups it is a member of
+	CompositionRuleProtectAdminGroups = "AdminSDHolderProtection"
+	// Nodes: the trusting domain the trust account belongs to
+	CompositionRuleHasTrustKeys = "TrustAccountKeys"
+	// Nodes: the local group the principal is a member of
+	CompositionRuleLocalGroupMembership = "LocalGroupMembership"
+	// Nodes: the Remote Desktop Users local group and, when Citrix is enabled, the Direct Access Users group
+	CompositionRuleRemoteDesktopUsers = "RemoteDesktopUsersMembership"
+	// Edges: the raw ownership or write owner ACE
+	CompositionRuleOwnerRights = "OwnerRights"
+	// Nodes: the domain the delegated managed service account can be created in
+	CompositionRuleBadSuccessor = "DMSASuccessorCreation"
+	// Nodes: the site; edges: the site's GPLink
+	CompositionRuleSiteGPOLink = "SiteGPOLink"
+	// Nodes: the site whose gPLink attribute can be written
+	CompositionRuleSiteGPLinkWrite = "SiteGPLinkWrite"
+	// Nodes: the DNS zone that resolves the target
+	CompositionRuleCanSpoofDNS = "DNSRecordCreation"
+	// No contributing nodes: the target holds a service principal name or does not require preauthentication
+	CompositionRuleKerberoastable = "ServicePrincipalName"
+	CompositionRuleASREPRoastable = "PreauthenticationNotRequired"
+	// Nodes: the foreign members of the group; edges: their MemberOf edges and the direct trust, if any
+	CompositionRuleForeignMemberOf = "ForeignGroupMembership"
+	// Nodes: the GPOs whose restricted group, user rights or logon user group settings grant the edge
+	CompositionRuleEffectiveGPO = "EffectiveGPOSetting"
+
+	// Nodes: the enterprise CA and the certificate templates that grant the escalation
+	CompositionRuleADCSESC1  = "ADCSESC1EnrolleeSuppliesSubject"
+	CompositionRuleADCSESC3  = "ADCSESC3EnrollmentAgent"
+	CompositionRuleADCSESC4  = "ADCSESC4TemplateControl"
+	CompositionRuleADCSESC6a = "ADCSESC6aUserSpecifiedSAN"
+	CompositionRuleADCSESC6b = "ADCSESC6bUserSpecifiedSAN"
+	CompositionRuleADCSESC9a = "ADCSESC9aNoSecurityExtension"
+	CompositionRuleADCSESC9b = "ADCSESC9bNoSecurityExtension"
+	CompositionRuleADCSESC10 = "ADCSESC10WeakCertificateMapping"
+	CompositionRuleADCSESC13 = "ADCSESC13IssuancePolicyGroupLink"
+	// Nodes: the enterprise CA hosted by the computer
+	CompositionRuleGoldenCert = "HostsCAService"
+	// No contributing nodes: the edge follows from certificate thumbprints and OIDs
+	CompositionRuleTrustedForNTAuth      = "NTAuthStoreThumbprint"
+	CompositionRuleEnterpriseCAFor       = "CACertificateThumbprint"
+	CompositionRuleIssuedSignedBy        = "CertificateChainParent"
+	CompositionRuleExtendedByPolicy      = "IssuancePolicyOID"
+	CompositionRuleEnrollOnBehalfOfV1    = "EnrollmentAgentSchemaVersion1"
+	CompositionRuleEnrollOnBehalfOfAgent = "EnrollmentAgentApplicationPolicy"
+
+	// Nodes: the enterprise CA and certificate template the relayed authentication enrolls in
+	CompositionRuleCoerceAndRelayNTLMToADCS = "NTLMRelayToWebEnrollment"
+	// Nodes: the target's domain. The coercible computers and the domain controllers that accept relayed LDAP or LDAPS
+	// authentication are resolved through the relay targets endpoint rather than copied onto every edge
+	CompositionRuleCoerceAndRelayNTLMToSMB   = "NTLMRelayToSMBWithoutSigning"
+	CompositionRuleCoerceAndRelayNTLMToLDAP  = "NTLMRelayToLDAPWithoutSigning"
+	CompositionRuleCoerceAndRelayNTLMToLDAPS = "NTLMRelayToLDAPSWithoutChannelBinding"
+)
+
+// sourceCompositions accumulates the nodes that justify each start node of a post-processed edge kind when the start
+// nodes are calculated as bitmaps across several contributing nodes, such as the certificate templates published to an
+// enterprise CA.
+type sourceCompositions struct {
+	rule     string
+	nodeIDs  []graph.ID
+	bySource map[uint64][]graph.ID
+	lock     sync.Mutex
+}
+
+func newSourceCompositions(rule string, nodeIDs ...graph.ID) *sourceCompositions {
+	return &sourceCompositions{
+		rule:     rule,
+		nodeIDs:  nodeIDs,
+		bySource: map[uint64][]graph.ID{},
+	}
+}
+
+// Track records the given nodes as contributing to every source in the bitmap and returns the bitmap.
+func (s *sourceCompositions) Track(sources cardinality.Duplex[uint64], nodeIDs ...graph.ID) cardinality.Duplex[uint64] {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	sources.Each(func(source uint64) bool {
+		s.bySource[source] = append(s.bySource[source], nodeIDs...)
+		return true
+	})
+
+	return sources
+}
+
+// Get returns the composition of the given source.
+func (s *sourceCompositions) Get(source uint64) *post.EdgeComposition {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return post.NewEdgeComposition(s.rule, s.nodeIDs...).AddNodes(s.bySource[source]...)
+}
+
+// addMembershipComposition records the rights edges that grant a post-processed edge to the given principal. Rights
+// held by the principal itself are recorded as is. Rights held by a group are recorded along with the MemberOf edges
+// leading from the principal to that group. Implicit memberships, such as Authenticated Users, have no MemberOf edges
+// and are not chained.
+func addMembershipComposition(tx graph.Transaction, composition *post.EdgeComposition, principalID graph.ID, rights []*graph.Relationship) error {
+	holderRights := map[graph.ID][]graph.ID{}
+
+	for _, right := range rights {
+		holderRights[right.StartID] = append(holderRights[right.StartID], right.ID)
+	}
+
+	composition.AddEdges(holderRights[principalID]...)
+	delete(holderRights, principalID)
+
+	if len(holderRights) == 0 {
+		return nil
+	}
+
+	return addMemberOfComposition(tx, composition, principalID, holderRights)
+}
+
+// addMemberOfComposition records the MemberOf edges leading from the given principal to each of the given groups along
+// with the edges recorded for each group that is reached.
+func addMemberOfComposition(tx graph.Transaction, composition *post.EdgeComposition, principalID graph.ID, groupEdges map[graph.ID][]graph.ID) error {
+	if principal, err := ops.FetchNode(tx, principalID); err != nil {
+		return err
+	} else if memberships, err := ops.TraversePaths(tx, ops.TraversalPlan{
+		Root:      principal,
+		Direction: graph.DirectionOutbound,
+		BranchQuery: func() graph.Criteria {
+			return query.Kind(query.Relationship(), ad.MemberOf)
+		},
+		PathFilter: func(ctx *ops.TraversalContext, segment *graph.PathSegment) bool {
+			_, isGroup := groupEdges[segment.Node.ID]
+			return isGroup
+		},
+	}); err != nil {
+		return err
+	} else {
+		for _, membership := range memberships.Paths() {
+			for _, edge := range membership.Edges {
+				composition.AddEdges(edge.ID)
+			}
+
+			composition.AddEdges(groupEdges[membership.Terminal().ID]...)
+		}
+
+		return nil
+	}
+}
@@ -33,7 +33,11 @@ import (
 )
 
 func PostADCSESC1(ctx context.Context, tx graph.Transaction, outC chan<- post.EnsureRelationshipJob, localGroupData *LocalGroupData, certChains *EnterpriseCAChainedDomains, cache *ADCSCache) error {
-	results := cardinality.NewBitmap64()
+	var (
+		results      = cardinality.NewBitmap64()
+		compositions = newSourceCompositions(CompositionRuleADCSESC1, certChains.EnterpriseCA.ID)
+	)
+
 	if publishedCertTemplates := cache.GetPublishedTemplateCache(certChains.EnterpriseCA.ID); len(publishedCertTemplates) == 0 {
 		return nil
 	} else {
@@ -42,17 +46,20 @@ func PostADCSESC1(ctx context.Context, tx graph.Transaction, outC chan<- post.En
 			if !isCertTemplateValidForEsc1(ctx, certTemplate) {
 				continue
 			} else {
-				results.Or(CalculateCrossProductNodeSets(localGroupData, cache.GetCertTemplateEnrollers(certTemplate.ID), ecaEnrollers))
+				results.Or(compositions.Track(CalculateCrossProductNodeSets(localGroupData, cache.GetCertTemplateEnrollers(certTemplate.ID), ecaEnrollers), certTemplate.ID))
 			}
 		}
 	}
 
 	results.Each(func(source uint64) bool {
+		composition := compositions.Get(source)
+
 		for _, domain := range certChains.Domains.Slice() {
 			channels.Submit(ctx, outC, post.EnsureRelationshipJob{
-				FromID: graph.ID(source),
-				ToID:   graph.ID(domain),
-				Kind:   ad.ADCSESC1,
+				FromID:      graph.ID(source),
+				ToID:        graph.ID(domain),
+				Kind:        ad.ADCSESC1,
+				Composition: composition,
 			})
 		}
 		return true
@@ -39,7 +39,10 @@ func PostADCSESC10a(ctx context.Context, tx graph.Transaction, outC chan<- post.
 	} else if ecaEnrollers := cache.GetEnterpriseCAEnrollers(certChains.EnterpriseCA.ID); ecaEnrollers.IsEmpty() {
 		return nil
 	} else {
-		results := cardinality.NewBitmap64()
+		var (
+			results      = cardinality.NewBitmap64()
+			compositions = newSourceCompositions(CompositionRuleADCSESC10, certChains.EnterpriseCA.ID)
+		)
 
 		for _, template := range publishedCertTemplates {
 			if !isCertTemplateValidForESC10(ctx, template, false) {
@@ -69,7 +72,7 @@ func PostADCSESC10a(ctx context.Context, tx graph.Transaction, outC chan<- post.
 					)
 					continue
 				} else {
-					results.Or(graph.NodeIDsToDuplex(attackers))
+					results.Or(compositions.Track(graph.NodeIDsToDuplex(attackers), template.ID))
 				}
 			}
 		}
@@ -78,9 +81,10 @@ func PostADCSESC10a(ctx context.Context, tx graph.Transaction, outC chan<- post.
 			for _, domain := range certChains.Domains.Slice() {
 				if cache.HasUPNCertMappingInForest(domain) {
 					channels.Submit(ctx, outC, post.EnsureRelationshipJob{
-						FromID: graph.ID(source),
-						ToID:   graph.ID(domain),
-						Kind:   ad.ADCSESC10a,
+						FromID:      graph.ID(source),
+						ToID:        graph.ID(domain),
+						Kind:        ad.ADCSESC10a,
+						Composition: compositions.Get(source),
 					})
 				}
 			}
@@ -96,7 +100,10 @@ func PostADCSESC10b(ctx context.Context, tx graph.Transaction, outC chan<- post.
 	} else if ecaEnrollers := cache.GetEnterpriseCAEnrollers(chains.EnterpriseCA.ID); ecaEnrollers.IsEmpty() {
 		return nil
 	} else {
-		results := cardinality.NewBitmap64()
+		var (
+			results      = cardinality.NewBitmap64()
+			compositions = newSourceCompositions(CompositionRuleADCSESC10, chains.EnterpriseCA.ID)
+		)
 
 		for _, template := range publishedCertTemplates {
 			if !isCertTemplateValidForESC10(ctx, template, true) {
@@ -119,7 +126,7 @@ func PostADCSESC10b(ctx context.Context, tx graph.Transaction, outC chan<- post.
 					)
 					continue
 				} else {
-					results.Or(graph.NodeIDsToDuplex(attackers))
+					results.Or(compositions.Track(graph.NodeIDsToDuplex(attackers), template.ID))
 				}
 			}
 		}
@@ -128,9 +135,10 @@ func PostADCSESC10b(ctx context.Context, tx graph.Transaction, outC chan<- post.
 			for _, domain := range chains.Domains.Slice() {
 				if cache.HasUPNCertMappingInForest(domain) {
 					channels.Submit(ctx, outC, post.EnsureRelationshipJob{
-						FromID: graph.ID(source),
-						ToID:   graph.ID(domain),
-						Kind:   ad.ADCSESC10b,
+						FromID:      graph.ID(source),
+						ToID:        graph.ID(domain),
+						Kind:        ad.ADCSESC10b,
+						Composition: compositions.Get(source),
 					})
 				}
 			}
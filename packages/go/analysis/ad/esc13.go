@@ -57,11 +57,14 @@ func PostADCSESC13(ctx context.Context, tx graph.Transaction, outC chan<- post.E
 						for _, domain := range certChains.Domains.Slice() {
 							domainID := graph.ID(domain)
 							if groupIsContainedOrTrusted(tx, group, domainID) {
+								composition := post.NewEdgeComposition(CompositionRuleADCSESC13, certChains.EnterpriseCA.ID, template.ID, domainID)
+
 								filtered.Each(func(source uint64) bool {
 									channels.Submit(ctx, outC, post.EnsureRelationshipJob{
-										FromID: graph.ID(source),
-										ToID:   group.ID,
-										Kind:   ad.ADCSESC13,
+										FromID:      graph.ID(source),
+										ToID:        group.ID,
+										Kind:        ad.ADCSESC13,
+										Composition: composition,
 									})
 									return true
 								})
@@ -37,8 +37,9 @@ import (
 
 func PostADCSESC3(ctx context.Context, tx graph.Transaction, outC chan<- post.EnsureRelationshipJob, localGroupData *LocalGroupData, certChains *EnterpriseCAChainedDomains, cache *ADCSCache) error {
 	var (
-		results = cardinality.NewBitmap64()
-		eca2ID  = certChains.EnterpriseCA.ID
+		results      = cardinality.NewBitmap64()
+		eca2ID       = certChains.EnterpriseCA.ID
+		compositions = newSourceCompositions(CompositionRuleADCSESC3, eca2ID)
 	)
 
 	if publishedCertTemplates := cache.GetPublishedTemplateCache(eca2ID); len(publishedCertTemplates) == 0 {
@@ -123,7 +124,7 @@ func PostADCSESC3(ctx context.Context, tx graph.Transaction, outC chan<- post.En
 										attr.Error(err),
 									)
 								} else {
-									results.Or(filteredResults)
+									results.Or(compositions.Track(filteredResults, certTemplateOne.ID, certTemplateTwo.ID, eca1.ID))
 								}
 							}
 						}
@@ -143,7 +144,7 @@ func PostADCSESC3(ctx context.Context, tx graph.Transaction, outC chan<- post.En
 									attr.Error(err),
 								)
 							} else {
-								results.Or(filteredResults)
+								results.Or(compositions.Track(filteredResults, certTemplateOne.ID, certTemplateTwo.ID, eca1.ID))
 							}
 						}
 					}
@@ -153,11 +154,14 @@ func PostADCSESC3(ctx context.Context, tx graph.Transaction, outC chan<- post.En
 	}
 
 	results.Each(func(source uint64) bool {
+		composition := compositions.Get(source)
+
 		for _, domain := range certChains.Domains.Slice() {
 			channels.Submit(ctx, outC, post.EnsureRelationshipJob{
-				FromID: graph.ID(source),
-				ToID:   graph.ID(domain),
-				Kind:   ad.ADCSESC3,
+				FromID:      graph.ID(source),
+				ToID:        graph.ID(domain),
+				Kind:        ad.ADCSESC3,
+				Composition: composition,
 			})
 		}
 		return true
@@ -256,9 +260,10 @@ func EnrollOnBehalfOfVersionOne(cache *ADCSCache, versionOneCertTemplates []*gra
 				}
 
 				results = append(results, post.EnsureRelationshipJob{
-					FromID: certTemplateOne.ID,
-					ToID:   certTemplateTwo.ID,
-					Kind:   ad.EnrollOnBehalfOf,
+					FromID:      certTemplateOne.ID,
+					ToID:        certTemplateTwo.ID,
+					Kind:        ad.EnrollOnBehalfOf,
+					Composition: post.NewEdgeComposition(CompositionRuleEnrollOnBehalfOfV1, domainID),
 				})
 			}
 		}
@@ -322,9 +327,10 @@ func EnrollOnBehalfOfVersionTwo(cache *ADCSCache, versionTwoCertTemplates, publi
 					continue
 				} else {
 					results = append(results, post.EnsureRelationshipJob{
-						FromID: certTemplateOne.ID,
-						ToID:   certTemplateTwo.ID,
-						Kind:   ad.EnrollOnBehalfOf,
+						FromID:      certTemplateOne.ID,
+						ToID:        certTemplateTwo.ID,
+						Kind:        ad.EnrollOnBehalfOf,
+						Composition: post.NewEdgeComposition(CompositionRuleEnrollOnBehalfOfAgent, domainID),
 					})
 				}
 			}
@@ -37,6 +37,7 @@ func PostADCSESC4(ctx context.Context, tx graph.Transaction, outC chan<- post.En
 	var (
 		principals         = cardinality.NewBitmap64()
 		publishedTemplates = cache.GetPublishedTemplateCache(certChains.EnterpriseCA.ID)
+		compositions       = newSourceCompositions(CompositionRuleADCSESC4, certChains.EnterpriseCA.ID)
 	)
 
 	// 2. iterate certtemplates that have an outbound `PublishedTo` edge to eca
@@ -70,22 +71,25 @@ func PostADCSESC4(ctx context.Context, tx graph.Transaction, outC chan<- post.En
 			)
 
 			// 2a. principals that control the cert template
-			principals.Or(
+			principals.Or(compositions.Track(
 				CalculateCrossProductNodeSets(
 					localGroupData,
 					enterpriseCAEnrollers,
 					certTemplateControllers,
-				))
+				),
+				certTemplate.ID,
+			))
 
 			// 2b. principals with `Enroll/AllExtendedRights` + `Generic Write` combination on the cert template
-			principals.Or(
+			principals.Or(compositions.Track(
 				CalculateCrossProductNodeSets(
 					localGroupData,
 					enterpriseCAEnrollers,
 					principalsWithGenericWrite,
 					principalsWithEnrollOrAllExtendedRights,
 				),
-			)
+				certTemplate.ID,
+			))
 
 			// 2c. kick out early if cert template does meet conditions for ESC4
 			if !isCertTemplateValidForESC4(ctx, certTemplate) {
@@ -93,46 +97,51 @@ func PostADCSESC4(ctx context.Context, tx graph.Transaction, outC chan<- post.En
 			}
 
 			// 2d. principals with `Enroll/AllExtendedRights` + `WritePKINameFlag` + `WritePKIEnrollmentFlag` on the cert template
-			principals.Or(CalculateCrossProductNodeSets(
+			principals.Or(compositions.Track(CalculateCrossProductNodeSets(
 				localGroupData,
 				enterpriseCAEnrollers,
 				principalsWithEnrollOrAllExtendedRights,
 				principalsWithPKINameFlag,
 				principalsWithPKIEnrollmentFlag,
-			))
+			), certTemplate.ID))
 
 			// 2e.
 			if enrolleeSuppliesSubject {
-				principals.Or(
+				principals.Or(compositions.Track(
 					CalculateCrossProductNodeSets(
 						localGroupData,
 						enterpriseCAEnrollers,
 						principalsWithEnrollOrAllExtendedRights,
 						principalsWithPKIEnrollmentFlag,
 					),
-				)
+					certTemplate.ID,
+				))
 			}
 
 			// 2f.
 			if !requiresManagerApproval {
-				principals.Or(
+				principals.Or(compositions.Track(
 					CalculateCrossProductNodeSets(
 						localGroupData,
 						enterpriseCAEnrollers,
 						principalsWithEnrollOrAllExtendedRights,
 						principalsWithPKINameFlag,
 					),
-				)
+					certTemplate.ID,
+				))
 			}
 		}
 	}
 
 	principals.Each(func(value uint64) bool {
+		composition := compositions.Get(value)
+
 		for _, domain := range certChains.Domains.Slice() {
 			channels.Submit(ctx, outC, post.EnsureRelationshipJob{
-				FromID: graph.ID(value),
-				ToID:   graph.ID(domain),
-				Kind:   ad.ADCSESC4,
+				FromID:      graph.ID(value),
+				ToID:        graph.ID(domain),
+				Kind:        ad.ADCSESC4,
+				Composition: composition,
 			})
 		}
 		return true
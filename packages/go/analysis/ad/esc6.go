@@ -63,12 +63,15 @@ func PostADCSESC6a(ctx context.Context, tx graph.Transaction, outC chan<- post.E
 					)
 					continue
 				} else {
+					composition := post.NewEdgeComposition(CompositionRuleADCSESC6a, certChains.EnterpriseCA.ID, publishedCertTemplate.ID)
+
 					filteredEnrollers.Each(func(source uint64) bool {
 						for _, domain := range certChains.Domains.Slice() {
 							channels.Submit(ctx, outC, post.EnsureRelationshipJob{
-								FromID: graph.ID(source),
-								ToID:   graph.ID(domain),
-								Kind:   ad.ADCSESC6a,
+								FromID:      graph.ID(source),
+								ToID:        graph.ID(domain),
+								Kind:        ad.ADCSESC6a,
+								Composition: composition,
 							})
 						}
 						return true
@@ -110,13 +113,16 @@ func PostADCSESC6b(ctx context.Context, tx graph.Transaction, outC chan<- post.E
 					)
 					continue
 				} else {
+					composition := post.NewEdgeComposition(CompositionRuleADCSESC6b, chains.EnterpriseCA.ID, publishedCertTemplate.ID)
+
 					filteredEnrollers.Each(func(source uint64) bool {
 						for _, domain := range chains.Domains.Slice() {
 							if cache.HasUPNCertMappingInForest(domain) {
 								channels.Submit(ctx, outC, post.EnsureRelationshipJob{
-									FromID: graph.ID(source),
-									ToID:   graph.ID(domain),
-									Kind:   ad.ADCSESC6b,
+									FromID:      graph.ID(source),
+									ToID:        graph.ID(domain),
+									Kind:        ad.ADCSESC6b,
+									Composition: composition,
 								})
 							}
 						}
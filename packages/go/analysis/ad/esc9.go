@@ -33,7 +33,10 @@ import (
 )
 
 func PostADCSESC9a(ctx context.Context, tx graph.Transaction, outC chan<- post.EnsureRelationshipJob, localGroupData *LocalGroupData, chains *EnterpriseCAChainedDomains, cache *ADCSCache) error {
-	results := cardinality.NewBitmap64()
+	var (
+		results      = cardinality.NewBitmap64()
+		compositions = newSourceCompositions(CompositionRuleADCSESC9a, chains.EnterpriseCA.ID)
+	)
 
 	if publishedCertTemplates := cache.GetPublishedTemplateCache(chains.EnterpriseCA.ID); len(publishedCertTemplates) == 0 {
 		return nil
@@ -63,7 +66,7 @@ func PostADCSESC9a(ctx context.Context, tx graph.Transaction, outC chan<- post.E
 					)
 					continue
 				} else {
-					results.Or(graph.NodeIDsToDuplex(attackers))
+					results.Or(compositions.Track(graph.NodeIDsToDuplex(attackers), template.ID))
 				}
 			}
 		}
@@ -72,9 +75,10 @@ func PostADCSESC9a(ctx context.Context, tx graph.Transaction, outC chan<- post.E
 			for _, domain := range chains.Domains.Slice() {
 				if cache.HasWeakCertBindingInForest(domain) {
 					channels.Submit(ctx, outC, post.EnsureRelationshipJob{
-						FromID: graph.ID(source),
-						ToID:   graph.ID(domain),
-						Kind:   ad.ADCSESC9a,
+						FromID:      graph.ID(source),
+						ToID:        graph.ID(domain),
+						Kind:        ad.ADCSESC9a,
+						Composition: compositions.Get(source),
 					})
 				}
 			}
@@ -86,7 +90,10 @@ func PostADCSESC9a(ctx context.Context, tx graph.Transaction, outC chan<- post.E
 }
 
 func PostADCSESC9b(ctx context.Context, tx graph.Transaction, outC chan<- post.EnsureRelationshipJob, localGroupData *LocalGroupData, chains *EnterpriseCAChainedDomains, cache *ADCSCache) error {
-	results := cardinality.NewBitmap64()
+	var (
+		results      = cardinality.NewBitmap64()
+		compositions = newSourceCompositions(CompositionRuleADCSESC9b, chains.EnterpriseCA.ID)
+	)
 
 	if publishedCertTemplates := cache.GetPublishedTemplateCache(chains.EnterpriseCA.ID); len(publishedCertTemplates) == 0 {
 		return nil
@@ -114,7 +121,7 @@ func PostADCSESC9b(ctx context.Context, tx graph.Transaction, outC chan<- post.E
 					)
 					continue
 				} else {
-					results.Or(graph.NodeIDsToDuplex(attackers))
+					results.Or(compositions.Track(graph.NodeIDsToDuplex(attackers), template.ID))
 				}
 			}
 		}
@@ -123,9 +130,10 @@ func PostADCSESC9b(ctx context.Context, tx graph.Transaction, outC chan<- post.E
 			for _, domain := range chains.Domains.Slice() {
 				if cache.HasWeakCertBindingInForest(domain) {
 					channels.Submit(ctx, outC, post.EnsureRelationshipJob{
-						FromID: graph.ID(source),
-						ToID:   graph.ID(domain),
-						Kind:   ad.ADCSESC9b,
+						FromID:      graph.ID(source),
+						ToID:        graph.ID(domain),
+						Kind:        ad.ADCSESC9b,
+						Composition: compositions.Get(source),
 					})
 				}
 			}
@@ -62,9 +62,10 @@ func PostTrustedForNTAuth(ctx context.Context, db graph.Database, operation post
 							} else {
 								for _, sourceNodeID := range sourceNodeIDs {
 									if !channels.Submit(ctx, outC, post.EnsureRelationshipJob{
-										FromID: sourceNodeID,
-										ToID:   innerNode.ID,
-										Kind:   ad.TrustedForNTAuth,
+										FromID:      sourceNodeID,
+										ToID:        innerNode.ID,
+										Kind:        ad.TrustedForNTAuth,
+										Composition: post.NewEdgeComposition(CompositionRuleTrustedForNTAuth),
 									}) {
 										return nil
 									}
@@ -153,9 +154,10 @@ func PostEnterpriseCAFor(operation post.StatTrackedOperation[post.EnsureRelation
 				} else {
 					for _, rootCANodeID := range rootCAIDs {
 						if !channels.Submit(ctx, outC, post.EnsureRelationshipJob{
-							FromID: ecaNode.ID,
-							ToID:   rootCANodeID,
-							Kind:   ad.EnterpriseCAFor,
+							FromID:      ecaNode.ID,
+							ToID:        rootCANodeID,
+							Kind:        ad.EnterpriseCAFor,
+							Composition: post.NewEdgeComposition(CompositionRuleEnterpriseCAFor),
 						}) {
 							return fmt.Errorf("context timed out while creating EnterpriseCAFor edge")
 						}
@@ -166,9 +168,10 @@ func PostEnterpriseCAFor(operation post.StatTrackedOperation[post.EnsureRelation
 				} else {
 					for _, aiaCANodeID := range aiaCAIDs {
 						if !channels.Submit(ctx, outC, post.EnsureRelationshipJob{
-							FromID: ecaNode.ID,
-							ToID:   aiaCANodeID,
-							Kind:   ad.EnterpriseCAFor,
+							FromID:      ecaNode.ID,
+							ToID:        aiaCANodeID,
+							Kind:        ad.EnterpriseCAFor,
+							Composition: post.NewEdgeComposition(CompositionRuleEnterpriseCAFor),
 						}) {
 							return fmt.Errorf("context timed out while creating EnterpriseCAFor edge")
 						}
@@ -193,9 +196,10 @@ func PostGoldenCert(ctx context.Context, tx graph.Transaction, outC chan<- post.
 		for _, computer := range hostCAServiceComputers {
 			for _, domain := range certChains.Domains.Slice() {
 				channels.Submit(ctx, outC, post.EnsureRelationshipJob{
-					FromID: computer.ID,
-					ToID:   graph.ID(domain),
-					Kind:   ad.GoldenCert,
+					FromID:      computer.ID,
+					ToID:        graph.ID(domain),
+					Kind:        ad.GoldenCert,
+					Composition: post.NewEdgeComposition(CompositionRuleGoldenCert, certChains.EnterpriseCA.ID),
 				})
 			}
 		}
@@ -227,9 +231,10 @@ func PostExtendedByPolicyBinding(operation post.StatTrackedOperation[post.Ensure
 							} else if certTemplateDomain != "" && certTemplateDomain == issuancePolicyDomain {
 								// Create ExtendedByPolicy edge
 								if !channels.Submit(ctx, outC, post.EnsureRelationshipJob{
-									FromID: certTemplate.ID,
-									ToID:   issuancePolicy.ID,
-									Kind:   ad.ExtendedByPolicy,
+									FromID:      certTemplate.ID,
+									ToID:        issuancePolicy.ID,
+									Kind:        ad.ExtendedByPolicy,
+									Composition: post.NewEdgeComposition(CompositionRuleExtendedByPolicy),
 								}) {
 									return fmt.Errorf("context timed out while creating ExtendedByPolicy edge")
 								}
@@ -287,9 +292,10 @@ func processCertChainParent(node *graph.Node, tx graph.Transaction) ([]post.Ensu
 		} else {
 			return slicesext.Map(targetNodes, func(nodeId graph.ID) post.EnsureRelationshipJob {
 				return post.EnsureRelationshipJob{
-					FromID: node.ID,
-					ToID:   nodeId,
-					Kind:   ad.IssuedSignedBy,
+					FromID:      node.ID,
+					ToID:        nodeId,
+					Kind:        ad.IssuedSignedBy,
+					Composition: post.NewEdgeComposition(CompositionRuleIssuedSignedBy),
 				}
 			}), nil
 		}
@@ -0,0 +1,1002 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ad
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/specterops/bloodhound/packages/go/analysis/post"
+	"github.com/specterops/bloodhound/packages/go/bhlog/attr"
+	"github.com/specterops/bloodhound/packages/go/bhlog/measure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/ops"
+	"github.com/specterops/dawgs/query"
+	"github.com/specterops/dawgs/util/channels"
+)
+
+const (
+	// gpoStatusUserConfigurationDisabled, gpoStatusComputerConfigurationDisabled and gpoStatusDisabled are the raw GPO
+	// status values that stop a GPO's user configuration, computer configuration or both from applying
+	gpoStatusUserConfigurationDisabled     = "1"
+	gpoStatusComputerConfigurationDisabled = "2"
+	gpoStatusDisabled                      = "3"
+
+	builtinAdministratorsSID        = "S-1-5-32-544"
+	builtinRemoteDesktopUsersSID    = "S-1-5-32-555"
+	builtinDistributedCOMUsersSID   = "S-1-5-32-562"
+	builtinRemoteManagementUsersSID = "S-1-5-32-580"
+	authenticatedUsersSID           = "S-1-5-11"
+)
+
+// gpoSettingEdge maps a GPO setting property to the relationship kind that it grants on the computers it applies to
+type gpoSettingEdge struct {
+	Setting ad.Property
+	Kind    graph.Kind
+}
+
+var gpoSettingEdges = []gpoSettingEdge{
+	{Setting: ad.GPOLocalAdmins, Kind: ad.AdminTo},
+	{Setting: ad.GPORemoteDesktopUsers, Kind: ad.CanRDP},
+	{Setting: ad.GPODcomUsers, Kind: ad.ExecuteDCOM},
+	{Setting: ad.GPOPSRemoteUsers, Kind: ad.CanPSRemote},
+	{Setting: ad.GPORemoteInteractiveLogonRight, Kind: ad.CanRDP},
+}
+
+// gpoLogonUserGroupEdges maps the builtin local groups that a GPO's user configuration can add the logged on user to, to
+// the relationship kind that membership grants on the computer the user logs on to
+var gpoLogonUserGroupEdges = map[string]graph.Kind{
+	builtinAdministratorsSID:        ad.AdminTo,
+	builtinRemoteDesktopUsersSID:    ad.CanRDP,
+	builtinDistributedCOMUsersSID:   ad.ExecuteDCOM,
+	builtinRemoteManagementUsersSID: ad.CanPSRemote,
+}
+
+// gpoSettingGrant is an edge granted by GPO settings
+type gpoSettingGrant struct {
+	FromID graph.ID
+	ToID   graph.ID
+	Kind   graph.Kind
+}
+
+// gpoSettingGrants collects the GPOs that grant each edge, so that an edge granted by several settings or several GPOs
+// records all of them rather than only the last one written
+type gpoSettingGrants map[gpoSettingGrant][]*graph.Node
+
+func (s gpoSettingGrants) add(grant gpoSettingGrant, gpo *graph.Node) {
+	if !slices.ContainsFunc(s[grant], func(granting *graph.Node) bool {
+		return granting.ID == gpo.ID
+	}) {
+		s[grant] = append(s[grant], gpo)
+	}
+}
+
+// GPOLink is a GPO linked to a site, domain or OU along with whether the link is enforced
+type GPOLink struct {
+	GPO      *graph.Node
+	Enforced bool
+}
+
+// gpoContainerLinks caches the links that a computer inherits from its domain, OU and container ancestry. Sites are
+// not part of the ancestry and are resolved for each computer.
+type gpoContainerLinks struct {
+	Unenforced        []GPOLink
+	Enforced          []GPOLink
+	BlocksInheritance bool
+}
+
+// PostEffectiveGPOs resolves the GPOs that grant local group membership or user rights on every computer that a GPO
+// with such settings is linked above, and creates the AdminTo, CanRDP, ExecuteDCOM and CanPSRemote edges those settings
+// grant. Each edge records the object IDs of the GPOs that grant it in its sourcegpos property.
+//
+// Computer settings are resolved per computer: for each restricted group and user rights setting only the winning GPO
+// applies. GPOs are ordered by the usual site, domain, OU precedence: unenforced links closer to the computer win,
+// blocked inheritance drops unenforced links from above the blocking OU or domain, and enforced links win over
+// unenforced links with enforced links closer to the root winning.
+//
+// User settings are resolved per user: every GPO whose user configuration adds the logged on user to a builtin local
+// group grants that group's access to the computers the user has a session on. Such settings are additive, so each
+// applicable GPO contributes. Users are not placed in a site, so only domain and OU links apply to them.
+//
+// A GPO only applies if the matching configuration is enabled, the computer or user passes its security filtering and
+// the computer may match its WMI filter; see EvaluateWMIFilter. Edges that already exist, such as those post-processed
+// from collected local group membership, have the granting GPOs added to their sourcegpos property. Existing edges
+// that only a filtered or overridden GPO grants are removed when they rest solely on the local group changes that the
+// collector resolved from GPOs; see isGPOChangesOnlyGrant.
+func PostEffectiveGPOs(ctx context.Context, db graph.Database) (*post.AtomicPostProcessingStats, error) {
+	defer measure.ContextLogAndMeasure(
+		ctx,
+		slog.LevelInfo,
+		"Post-processing effective GPO settings",
+		attr.Namespace("analysis"),
+		attr.Function("PostEffectiveGPOs"),
+		attr.Scope("process"),
+	)()
+
+	var (
+		grants     = gpoSettingGrants{}
+		candidates = map[gpoSettingGrant]struct{}{}
+		existing   map[gpoSettingGrant][]*graph.Relationship
+		removals   []*graph.Relationship
+	)
+
+	if err := db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		var (
+			rdpRestricted = map[graph.ID]bool{}
+
+			settingGPOs       map[graph.ID]*graph.Node
+			settingPrincipals map[graph.ID]map[ad.Property][]graph.ID
+			computers         graph.NodeSet
+			logonUserGPOs     map[graph.ID]*graph.Node
+			users             graph.NodeSet
+			err               error
+		)
+
+		if settingGPOs, err = fetchGPOsWithProperties(tx, gpoSettingProperties()...); err != nil {
+			return err
+		} else if settingPrincipals, err = resolveGPOSettingPrincipals(tx, settingGPOs); err != nil {
+			return err
+		} else if computers, err = fetchGPOLinkedNodes(tx, settingGPOs, ad.Computer); err != nil {
+			return err
+		} else if logonUserGPOs, err = fetchGPOsWithProperties(tx, ad.GPOLogonUserGroups); err != nil {
+			return err
+		} else if users, err = fetchGPOLinkedNodes(tx, logonUserGPOs, ad.User); err != nil {
+			return err
+		} else if err = collectComputerGPOSettingGrants(tx, computers, settingGPOs, settingPrincipals, grants, candidates, rdpRestricted); err != nil {
+			return err
+		} else if err = collectUserGPOSettingGrants(tx, users, logonUserGPOs, grants, candidates, rdpRestricted); err != nil {
+			return err
+		} else if existing, err = fetchExistingGPOSettingGrants(tx, candidates); err != nil {
+			return err
+		}
+
+		for candidate := range candidates {
+			if _, granted := grants[candidate]; granted || len(existing[candidate]) == 0 {
+				continue
+			} else if gpoChangesOnly, err := isGPOChangesOnlyGrant(tx, candidate); err != nil {
+				return err
+			} else if gpoChangesOnly {
+				removals = append(removals, existing[candidate]...)
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return &post.AtomicPostProcessingStats{}, err
+	}
+
+	operation := post.NewPostRelationshipOperation(ctx, db, "Effective GPO Post Processing")
+
+	if err := db.WriteTransaction(ctx, func(tx graph.Transaction) error {
+		for grant, gpos := range grants {
+			for _, relationship := range existing[grant] {
+				relationship.Properties.Set(ad.SourceGPOs.String(), mergeSourceGPOs(relationship, gpos))
+
+				if err := tx.UpdateRelationship(relationship); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}); err != nil {
+		operation.Done()
+		return &operation.Stats, err
+	} else if err := db.BatchOperation(ctx, func(batch graph.Batch) error {
+		for _, relationship := range removals {
+			if err := batch.DeleteRelationship(relationship.ID); err != nil {
+				return err
+			}
+
+			operation.Stats.AddRelationshipsDeleted(relationship.Kind, 1)
+		}
+
+		return nil
+	}); err != nil {
+		operation.Done()
+		return &operation.Stats, err
+	}
+
+	operation.Operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, outC chan<- post.EnsureRelationshipJob) error {
+		for grant, gpos := range grants {
+			if len(existing[grant]) > 0 {
+				continue
+			}
+
+			if !channels.Submit(ctx, outC, gpoSettingGrantJob(grant, gpos)) {
+				return nil
+			}
+		}
+
+		return nil
+	})
+
+	return &operation.Stats, operation.Done()
+}
+
+// collectComputerGPOSettingGrants resolves the computer settings that apply to each computer and adds the edges they
+// grant. Every edge that any linked GPO would grant, whether or not the GPO applies or wins, is added to candidates.
+// Computers whose winning user rights assignment takes the remote interactive logon right away from Remote Desktop
+// Users are recorded in rdpRestricted.
+func collectComputerGPOSettingGrants(tx graph.Transaction, computers graph.NodeSet, gpos map[graph.ID]*graph.Node, settingPrincipals map[graph.ID]map[ad.Property][]graph.ID, grants gpoSettingGrants, candidates map[gpoSettingGrant]struct{}, rdpRestricted map[graph.ID]bool) error {
+	containerLinks := map[graph.ID]gpoContainerLinks{}
+
+	for _, computer := range computers {
+		if links, err := FetchEffectiveGPOLinks(tx, computer, gpos, containerLinks); err != nil {
+			return err
+		} else if len(links) == 0 {
+			continue
+		} else if applicable, err := filterApplicableGPOLinks(tx, computer, links); err != nil {
+			return err
+		} else {
+			for _, link := range links {
+				for _, settingEdge := range gpoSettingEdges {
+					for _, principal := range settingPrincipals[link.GPO.ID][settingEdge.Setting] {
+						if principal != computer.ID {
+							candidates[gpoSettingGrant{
+								FromID: principal,
+								ToID:   computer.ID,
+								Kind:   settingEdge.Kind,
+							}] = struct{}{}
+						}
+					}
+				}
+			}
+
+			rdpRestricted[computer.ID] = addEffectiveGPOSettingGrants(grants, computer, applicable, settingPrincipals)
+		}
+	}
+
+	return nil
+}
+
+// addEffectiveGPOSettingGrants adds the edges granted by the winning GPO of each setting. The links must be ordered
+// from lowest to highest precedence and already be filtered down to the GPOs that apply to the computer. It returns
+// true if the winning user rights assignment takes the remote interactive logon right away from Remote Desktop Users.
+func addEffectiveGPOSettingGrants(grants gpoSettingGrants, computer *graph.Node, links []GPOLink, settingPrincipals map[graph.ID]map[ad.Property][]graph.ID) bool {
+	var (
+		winners       = make(map[ad.Property]*graph.Node, len(gpoSettingEdges))
+		rdpRestricted bool
+	)
+
+	for _, settingEdge := range gpoSettingEdges {
+		for idx := len(links) - 1; idx >= 0; idx-- {
+			if links[idx].GPO.Properties.Exists(settingEdge.Setting.String()) {
+				winners[settingEdge.Setting] = links[idx].GPO
+				break
+			}
+		}
+	}
+
+	// Members of Remote Desktop Users can only log on over RDP while the group holds the remote interactive logon
+	// right, which a winning user rights assignment may take away
+	if rightWinner, found := winners[ad.GPORemoteInteractiveLogonRight]; found {
+		if holders, _ := rightWinner.Properties.Get(ad.GPORemoteInteractiveLogonRight.String()).StringSlice(); !slices.ContainsFunc(holders, isRemoteDesktopUsersSID) {
+			delete(winners, ad.GPORemoteDesktopUsers)
+			rdpRestricted = true
+		}
+	}
+
+	for _, settingEdge := range gpoSettingEdges {
+		winner, found := winners[settingEdge.Setting]
+		if !found {
+			continue
+		}
+
+		for _, principal := range settingPrincipals[winner.ID][settingEdge.Setting] {
+			if principal == computer.ID {
+				continue
+			}
+
+			grants.add(gpoSettingGrant{
+				FromID: principal,
+				ToID:   computer.ID,
+				Kind:   settingEdge.Kind,
+			}, winner)
+		}
+	}
+
+	return rdpRestricted
+}
+
+// collectUserGPOSettingGrants adds the edges granted to each user by the GPOs whose user configuration adds the logged
+// on user to a builtin local group. The edges point at the computers the user has a session on, as that is where the
+// setting is applied. Every edge that any linked GPO would grant, whether or not the GPO applies, is added to
+// candidates.
+func collectUserGPOSettingGrants(tx graph.Transaction, users graph.NodeSet, gpos map[graph.ID]*graph.Node, grants gpoSettingGrants, candidates map[gpoSettingGrant]struct{}, rdpRestricted map[graph.ID]bool) error {
+	containerLinks := map[graph.ID]gpoContainerLinks{}
+
+	for _, user := range users {
+		if links, err := FetchEffectiveGPOLinks(tx, user, gpos, containerLinks); err != nil {
+			return err
+		} else if len(links) == 0 {
+			continue
+		} else if sessionComputers, err := ops.FetchStartNodes(tx.Relationships().Filterf(func() graph.Criteria {
+			return query.And(
+				query.Kind(query.Start(), ad.Computer),
+				query.Kind(query.Relationship(), ad.HasSession),
+				query.Equals(query.EndID(), user.ID),
+			)
+		})); err != nil {
+			return err
+		} else if applicable, err := filterApplicableGPOLinks(tx, user, links); err != nil {
+			return err
+		} else {
+			for _, link := range links {
+				groups, _ := link.GPO.Properties.Get(ad.GPOLogonUserGroups.String()).StringSlice()
+
+				for _, computer := range sessionComputers {
+					for _, group := range groups {
+						if kind, found := logonUserGroupKind(group); found {
+							candidates[gpoSettingGrant{
+								FromID: user.ID,
+								ToID:   computer.ID,
+								Kind:   kind,
+							}] = struct{}{}
+						}
+					}
+				}
+			}
+
+			for _, link := range applicable {
+				var (
+					groups, _    = link.GPO.Properties.Get(ad.GPOLogonUserGroups.String()).StringSlice()
+					wmiFilter, _ = link.GPO.Properties.GetOrDefault(ad.WMIFilter.String(), "").String()
+				)
+
+				for _, computer := range sessionComputers {
+					// The WMI filter is evaluated on the computer the user logs on to
+					if wmiFilter != "" && !EvaluateWMIFilter(wmiFilter, computer) {
+						continue
+					}
+
+					for _, group := range groups {
+						if kind, found := logonUserGroupKind(group); !found {
+							continue
+						} else if kind == ad.CanRDP && rdpRestricted[computer.ID] {
+							continue
+						} else {
+							grants.add(gpoSettingGrant{
+								FromID: user.ID,
+								ToID:   computer.ID,
+								Kind:   kind,
+							}, link.GPO)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// logonUserGroupKind returns the relationship kind granted by membership of the given builtin local group. Collectors
+// may prefix builtin SIDs with the domain name, so the SID is matched by its suffix.
+func logonUserGroupKind(groupSID string) (graph.Kind, bool) {
+	groupSID = strings.ToUpper(groupSID)
+
+	for builtinSID, kind := range gpoLogonUserGroupEdges {
+		if strings.HasSuffix(groupSID, builtinSID) {
+			return kind, true
+		}
+	}
+
+	return nil, false
+}
+
+// fetchExistingGPOSettingGrants returns the edges that already exist in the graph for the given grants. GPO settings
+// are resolved after local group collection has been post-processed, so any such edge was derived from collected
+// membership, including the local group changes that the collector resolved from GPOs.
+func fetchExistingGPOSettingGrants(tx graph.Transaction, grants map[gpoSettingGrant]struct{}) (map[gpoSettingGrant][]*graph.Relationship, error) {
+	var (
+		existing = map[gpoSettingGrant][]*graph.Relationship{}
+		targets  = map[graph.ID]struct{}{}
+		kinds    = make(graph.Kinds, 0, len(gpoLogonUserGroupEdges))
+	)
+
+	for _, kind := range gpoLogonUserGroupEdges {
+		kinds = append(kinds, kind)
+	}
+
+	for grant := range grants {
+		targets[grant.ToID] = struct{}{}
+	}
+
+	for target := range targets {
+		if relationships, err := ops.FetchRelationships(tx.Relationships().Filterf(func() graph.Criteria {
+			return query.And(
+				query.KindIn(query.Relationship(), kinds...),
+				query.Equals(query.EndID(), target),
+			)
+		})); err != nil {
+			return nil, err
+		} else {
+			for _, relationship := range relationships {
+				grant := gpoSettingGrant{
+					FromID: relationship.StartID,
+					ToID:   relationship.EndID,
+					Kind:   relationship.Kind,
+				}
+
+				if _, found := grants[grant]; found {
+					existing[grant] = append(existing[grant], relationship)
+				}
+			}
+		}
+	}
+
+	return existing, nil
+}
+
+// isGPOChangesOnlyGrant returns true if the edge for a grant rests solely on the local group changes that the collector
+// resolved from the GPOs linked above the computer. The collector does not evaluate security or WMI filtering, so
+// such membership may come from a GPO that does not apply. Local groups collected from the computer itself carry a
+// name while those created from GPO changes do not, so membership of a named group is treated as collected.
+func isGPOChangesOnlyGrant(tx graph.Transaction, grant gpoSettingGrant) (bool, error) {
+	for builtinSID, kind := range gpoLogonUserGroupEdges {
+		if kind != grant.Kind {
+			continue
+		}
+
+		if localGroup, err := FetchComputerLocalGroupBySIDSuffix(tx, grant.ToID, builtinSID[strings.LastIndex(builtinSID, "-"):]); err != nil {
+			if graph.IsErrNotFound(err) {
+				return false, nil
+			}
+
+			return false, err
+		} else if localGroup.Properties.Exists(common.Name.String()) {
+			return false, nil
+		} else if count, err := tx.Relationships().Filterf(func() graph.Criteria {
+			return query.And(
+				query.Kind(query.Relationship(), ad.MemberOfLocalGroup),
+				query.Equals(query.StartID(), grant.FromID),
+				query.Equals(query.EndID(), localGroup.ID),
+			)
+		}).Count(); err != nil {
+			return false, err
+		} else {
+			return count > 0, nil
+		}
+	}
+
+	return false, nil
+}
+
+// gpoSettingGrantJob creates the edge for a grant, recording the object IDs of the granting GPOs in sourcegpos
+func gpoSettingGrantJob(grant gpoSettingGrant, gpos []*graph.Node) post.EnsureRelationshipJob {
+	gpoIDs := make([]graph.ID, 0, len(gpos))
+	for _, gpo := range gpos {
+		gpoIDs = append(gpoIDs, gpo.ID)
+	}
+
+	return post.EnsureRelationshipJob{
+		FromID:      grant.FromID,
+		ToID:        grant.ToID,
+		Kind:        grant.Kind,
+		Composition: post.NewEdgeComposition(CompositionRuleEffectiveGPO, gpoIDs...),
+		RelProperties: map[string]any{
+			ad.SourceGPOs.String(): gpoObjectIDs(nil, gpos),
+		},
+	}
+}
+
+// mergeSourceGPOs returns the sourcegpos of an existing edge with the object IDs of the given granting GPOs added
+func mergeSourceGPOs(relationship *graph.Relationship, gpos []*graph.Node) []string {
+	sourceGPOs, _ := relationship.Properties.GetOrDefault(ad.SourceGPOs.String(), []string{}).StringSlice()
+	return gpoObjectIDs(sourceGPOs, gpos)
+}
+
+// gpoObjectIDs appends the object IDs of the given GPOs to sourceGPOs and returns them sorted and deduplicated
+func gpoObjectIDs(sourceGPOs []string, gpos []*graph.Node) []string {
+	for _, gpo := range gpos {
+		if objectID, err := gpo.Properties.Get(common.ObjectID.String()).String(); err == nil {
+			sourceGPOs = append(sourceGPOs, objectID)
+		}
+	}
+
+	slices.Sort(sourceGPOs)
+	return slices.Compact(sourceGPOs)
+}
+
+func isRemoteDesktopUsersSID(sid string) bool {
+	return strings.HasSuffix(strings.ToUpper(sid), builtinRemoteDesktopUsersSID)
+}
+
+// gpoSettingProperties returns the GPO properties holding restricted group and user rights settings
+func gpoSettingProperties() []ad.Property {
+	properties := make([]ad.Property, 0, len(gpoSettingEdges))
+	for _, settingEdge := range gpoSettingEdges {
+		properties = append(properties, settingEdge.Setting)
+	}
+
+	return properties
+}
+
+// fetchGPOsWithProperties returns every GPO that has at least one of the given setting properties
+func fetchGPOsWithProperties(tx graph.Transaction, properties ...ad.Property) (map[graph.ID]*graph.Node, error) {
+	criteria := make([]graph.Criteria, 0, len(properties))
+	for _, property := range properties {
+		criteria = append(criteria, query.Exists(query.NodeProperty(property.String())))
+	}
+
+	if gpos, err := ops.FetchNodes(tx.Nodes().Filterf(func() graph.Criteria {
+		return query.And(
+			query.Kind(query.Node(), ad.GPO),
+			query.Or(criteria...),
+		)
+	})); err != nil {
+		return nil, err
+	} else {
+		gposByID := make(map[graph.ID]*graph.Node, len(gpos))
+		for _, gpo := range gpos {
+			gposByID[gpo.ID] = gpo
+		}
+
+		return gposByID, nil
+	}
+}
+
+// resolveGPOSettingPrincipals maps the principal object IDs of each GPO setting to graph IDs. Principals that were not
+// collected, such as local or builtin groups, are dropped.
+func resolveGPOSettingPrincipals(tx graph.Transaction, gpos map[graph.ID]*graph.Node) (map[graph.ID]map[ad.Property][]graph.ID, error) {
+	var (
+		objectIDs = map[string]struct{}{}
+		resolved  = make(map[graph.ID]map[ad.Property][]graph.ID, len(gpos))
+	)
+
+	for _, gpo := range gpos {
+		for _, settingEdge := range gpoSettingEdges {
+			principals, _ := gpo.Properties.Get(settingEdge.Setting.String()).StringSlice()
+			for _, principal := range principals {
+				objectIDs[strings.ToUpper(principal)] = struct{}{}
+			}
+		}
+	}
+
+	nodeIDs := make(map[string]graph.ID, len(objectIDs))
+
+	if len(objectIDs) > 0 {
+		if err := tx.Nodes().Filterf(func() graph.Criteria {
+			return query.In(query.NodeProperty(common.ObjectID.String()), slices.Collect(maps.Keys(objectIDs)))
+		}).Fetch(func(cursor graph.Cursor[*graph.Node]) error {
+			for node := range cursor.Chan() {
+				if objectID, err := node.Properties.Get(common.ObjectID.String()).String(); err == nil {
+					nodeIDs[strings.ToUpper(objectID)] = node.ID
+				}
+			}
+
+			return cursor.Error()
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	for gpoID, gpo := range gpos {
+		settings := make(map[ad.Property][]graph.ID, len(gpoSettingEdges))
+
+		for _, settingEdge := range gpoSettingEdges {
+			principals, _ := gpo.Properties.Get(settingEdge.Setting.String()).StringSlice()
+			for _, principal := range principals {
+				if nodeID, found := nodeIDs[strings.ToUpper(principal)]; found {
+					settings[settingEdge.Setting] = append(settings[settingEdge.Setting], nodeID)
+				}
+			}
+		}
+
+		resolved[gpoID] = settings
+	}
+
+	return resolved, nil
+}
+
+// fetchGPOLinkedNodes returns every node of the given kind contained by a domain or OU that one of the given GPOs is
+// linked to. Computers mapped to a site that one of the GPOs is linked to are included as well.
+func fetchGPOLinkedNodes(tx graph.Transaction, gpos map[graph.ID]*graph.Node, kind graph.Kind) (graph.NodeSet, error) {
+	nodes := graph.NewNodeSet()
+
+	if len(gpos) == 0 {
+		return nodes, nil
+	}
+
+	gpoIDs := make([]graph.ID, 0, len(gpos))
+	for gpoID := range gpos {
+		gpoIDs = append(gpoIDs, gpoID)
+	}
+
+	if linkedContainers, err := ops.FetchEndNodes(tx.Relationships().Filterf(func() graph.Criteria {
+		return query.And(
+			query.InIDs(query.StartID(), gpoIDs...),
+			query.Kind(query.Relationship(), ad.GPLink),
+			query.KindIn(query.End(), ad.Domain, ad.OU, ad.Site),
+		)
+	})); err != nil {
+		return nil, err
+	} else {
+		for _, container := range linkedContainers {
+			if container.Kinds.ContainsOneOf(ad.Site) {
+				if kind != ad.Computer {
+					continue
+				} else if siteComputers, err := FetchSiteComputers(tx, container); err != nil {
+					return nil, err
+				} else {
+					nodes.AddSet(siteComputers)
+				}
+			} else if containedNodes, err := ops.AcyclicTraverseTerminals(tx, ops.TraversalPlan{
+				Root:      container,
+				Direction: graph.DirectionOutbound,
+				BranchQuery: func() graph.Criteria {
+					return query.Kind(query.Relationship(), ad.Contains)
+				},
+				PathFilter: func(ctx *ops.TraversalContext, segment *graph.PathSegment) bool {
+					return segment.Node.Kinds.ContainsOneOf(kind)
+				},
+			}); err != nil {
+				return nil, err
+			} else {
+				nodes.AddSet(containedNodes)
+			}
+		}
+
+		return nodes, nil
+	}
+}
+
+// FetchEffectiveGPOLinks returns the links of the given GPOs that reach the computer or user, ordered from lowest to
+// highest precedence. Links from the target's containers are cached by container in containerLinks, which must only be
+// shared between calls for the same GPOs. Users have no subnet, so site links never reach them.
+func FetchEffectiveGPOLinks(tx graph.Transaction, target *graph.Node, gpos map[graph.ID]*graph.Node, containerLinks map[graph.ID]gpoContainerLinks) ([]GPOLink, error) {
+	if parents, err := fetchGPOContainerParents(tx, target); err != nil {
+		return nil, err
+	} else if siteLinks, err := fetchComputerSiteGPOLinks(tx, target, gpos); err != nil {
+		return nil, err
+	} else {
+		var inherited gpoContainerLinks
+
+		if len(parents) > 0 {
+			parent := parents[0]
+
+			if cached, found := containerLinks[parent.ID]; found {
+				inherited = cached
+			} else if inherited, err = fetchGPOContainerLinks(tx, parent, gpos); err != nil {
+				return nil, err
+			} else {
+				containerLinks[parent.ID] = inherited
+			}
+		}
+
+		var (
+			siteUnenforced []GPOLink
+			siteEnforced   []GPOLink
+		)
+
+		for _, link := range siteLinks {
+			if link.Enforced {
+				siteEnforced = append(siteEnforced, link)
+			} else if !inherited.BlocksInheritance {
+				siteUnenforced = append(siteUnenforced, link)
+			}
+		}
+
+		links := make([]GPOLink, 0, len(siteLinks)+len(inherited.Unenforced)+len(inherited.Enforced))
+		links = append(links, siteUnenforced...)
+		links = append(links, inherited.Unenforced...)
+		links = append(links, inherited.Enforced...)
+		links = append(links, siteEnforced...)
+
+		return links, nil
+	}
+}
+
+// fetchGPOContainerLinks resolves the links inherited by objects directly beneath the given container by walking up
+// its Contains ancestry to the domain
+func fetchGPOContainerLinks(tx graph.Transaction, container *graph.Node, gpos map[graph.ID]*graph.Node) (gpoContainerLinks, error) {
+	var (
+		result    gpoContainerLinks
+		ancestry  = []*graph.Node{container}
+		processed = map[graph.ID]struct{}{container.ID: {}}
+	)
+
+	// Walk from the container up to the root of its Contains ancestry
+	for next := container; ; {
+		if parents, err := fetchGPOContainerParents(tx, next); err != nil {
+			return result, err
+		} else if len(parents) == 0 {
+			break
+		} else if _, seen := processed[parents[0].ID]; seen {
+			break
+		} else {
+			next = parents[0]
+			processed[next.ID] = struct{}{}
+			ancestry = append(ancestry, next)
+		}
+	}
+
+	// Walk back down from the root so that links closer to the computer are appended last
+	for idx := len(ancestry) - 1; idx >= 0; idx-- {
+		node := ancestry[idx]
+
+		if !node.Kinds.ContainsOneOf(ad.Domain, ad.OU) {
+			continue
+		}
+
+		if blocksInheritance, _ := node.Properties.GetOrDefault(ad.BlocksInheritance.String(), false).Bool(); blocksInheritance {
+			result.Unenforced = result.Unenforced[:0]
+			result.BlocksInheritance = true
+		}
+
+		if links, err := fetchGPOLinksTo(tx, node, gpos); err != nil {
+			return result, err
+		} else {
+			for _, link := range links {
+				if link.Enforced {
+					// Enforced links closer to the root take precedence, so they are prepended
+					result.Enforced = append([]GPOLink{link}, result.Enforced...)
+				} else {
+					result.Unenforced = append(result.Unenforced, link)
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func fetchGPOContainerParents(tx graph.Transaction, node *graph.Node) ([]*graph.Node, error) {
+	if parents, err := ops.FetchStartNodes(tx.Relationships().Filterf(func() graph.Criteria {
+		return query.And(
+			query.KindIn(query.Start(), ad.Domain, ad.OU, ad.Container),
+			query.Kind(query.Relationship(), ad.Contains),
+			query.Equals(query.EndID(), node.ID),
+		)
+	})); err != nil {
+		return nil, err
+	} else {
+		return parents.Slice(), nil
+	}
+}
+
+func fetchGPOLinksTo(tx graph.Transaction, target *graph.Node, gpos map[graph.ID]*graph.Node) ([]GPOLink, error) {
+	if gpLinks, err := ops.FetchRelationships(tx.Relationships().Filterf(func() graph.Criteria {
+		return query.And(
+			query.Kind(query.Start(), ad.GPO),
+			query.Kind(query.Relationship(), ad.GPLink),
+			query.Equals(query.EndID(), target.ID),
+		)
+	})); err != nil {
+		return nil, err
+	} else {
+		links := make([]GPOLink, 0, len(gpLinks))
+
+		for _, gpLink := range gpLinks {
+			if gpo, found := gpos[gpLink.StartID]; found {
+				enforced, _ := gpLink.Properties.GetOrDefault(ad.Enforced.String(), false).Bool()
+				links = append(links, GPOLink{
+					GPO:      gpo,
+					Enforced: enforced,
+				})
+			}
+		}
+
+		return links, nil
+	}
+}
+
+func fetchComputerSiteGPOLinks(tx graph.Transaction, computer *graph.Node, gpos map[graph.ID]*graph.Node) ([]GPOLink, error) {
+	if subnets, err := ops.FetchEndNodes(tx.Relationships().Filterf(func() graph.Criteria {
+		return query.And(
+			query.Equals(query.StartID(), computer.ID),
+			query.Kind(query.Relationship(), ad.InSubnet),
+		)
+	})); err != nil {
+		return nil, err
+	} else if len(subnets) == 0 {
+		return nil, nil
+	} else if sites, err := ops.FetchStartNodes(tx.Relationships().Filterf(func() graph.Criteria {
+		return query.And(
+			query.Kind(query.Start(), ad.Site),
+			query.Kind(query.Relationship(), ad.HasSubnet),
+			query.InIDs(query.EndID(), subnets.IDs()...),
+		)
+	})); err != nil {
+		return nil, err
+	} else {
+		var links []GPOLink
+
+		for _, site := range sites {
+			if siteLinks, err := fetchGPOLinksTo(tx, site, gpos); err != nil {
+				return nil, err
+			} else {
+				links = append(links, siteLinks...)
+			}
+		}
+
+		return links, nil
+	}
+}
+
+// filterApplicableGPOLinks drops links to GPOs whose configuration for the target is disabled or whose security
+// filtering excludes the target. For computers, links to GPOs whose WMI filter the computer does not match are dropped
+// as well; for users, the WMI filter depends on the computer they log on to and is left to the caller.
+func filterApplicableGPOLinks(tx graph.Transaction, target *graph.Node, links []GPOLink) ([]GPOLink, error) {
+	var (
+		applicable     []GPOLink
+		memberOf       map[string]struct{}
+		isComputer     = target.Kinds.ContainsOneOf(ad.Computer)
+		disabledStatus = gpoStatusUserConfigurationDisabled
+	)
+
+	if isComputer {
+		disabledStatus = gpoStatusComputerConfigurationDisabled
+	}
+
+	for _, link := range links {
+		if status, _ := link.GPO.Properties.GetOrDefault(ad.GPOStatusRaw.String(), "").String(); status == disabledStatus || status == gpoStatusDisabled {
+			continue
+		}
+
+		if wmiFilter, _ := link.GPO.Properties.GetOrDefault(ad.WMIFilter.String(), "").String(); isComputer && wmiFilter != "" && !EvaluateWMIFilter(wmiFilter, target) {
+			continue
+		}
+
+		if securityFiltering, _ := link.GPO.Properties.Get(ad.SecurityFiltering.String()).StringSlice(); len(securityFiltering) > 0 {
+			if memberOf == nil {
+				var err error
+				if memberOf, err = fetchSecurityFilteringIdentities(tx, target); err != nil {
+					return nil, err
+				}
+			}
+
+			if !slices.ContainsFunc(securityFiltering, func(sid string) bool {
+				_, found := memberOf[strings.ToUpper(sid)]
+				return found || strings.HasSuffix(sid, authenticatedUsersSID)
+			}) {
+				continue
+			}
+		}
+
+		applicable = append(applicable, link)
+	}
+
+	return applicable, nil
+}
+
+// fetchSecurityFilteringIdentities returns the upper-cased object IDs of the principal and of every group it is a
+// transitive member of
+func fetchSecurityFilteringIdentities(tx graph.Transaction, principal *graph.Node) (map[string]struct{}, error) {
+	identities := map[string]struct{}{}
+
+	if objectID, err := principal.Properties.Get(common.ObjectID.String()).String(); err == nil {
+		identities[strings.ToUpper(objectID)] = struct{}{}
+	}
+
+	if groups, err := ops.AcyclicTraverseNodes(tx, ops.TraversalPlan{
+		Root:      principal,
+		Direction: graph.DirectionOutbound,
+		BranchQuery: func() graph.Criteria {
+			return query.Kind(query.Relationship(), ad.MemberOf)
+		},
+	}, func(node *graph.Node) bool {
+		return node.ID != principal.ID
+	}); err != nil {
+		return nil, err
+	} else {
+		for _, group := range groups {
+			if objectID, err := group.Properties.Get(common.ObjectID.String()).String(); err == nil {
+				identities[strings.ToUpper(objectID)] = struct{}{}
+			}
+		}
+	}
+
+	return identities, nil
+}
+
+var (
+	wmiQuerySplitPattern  = regexp.MustCompile(`(?i)\bselect\b`)
+	wmiWherePattern       = regexp.MustCompile(`(?is)\bfrom\s+(\w+)\s+where\s+(.+)`)
+	wmiOrPattern          = regexp.MustCompile(`(?i)\s+or\s+`)
+	wmiAndPattern         = regexp.MustCompile(`(?i)\s+and\s+`)
+	wmiProductTypePattern = regexp.MustCompile(`(?i)^producttype\s*(=|<>|!=)\s*['"]?(\d)['"]?$`)
+	wmiCaptionPattern     = regexp.MustCompile(`(?i)^caption\s+(not\s+)?like\s+['"]([^'"]*)['"]$`)
+)
+
+// EvaluateWMIFilter reports whether the computer may match the given WMI filter. A filter may hold several WQL
+// queries, all of which must match. Only ProductType and Caption conditions on Win32_OperatingSystem can be checked
+// against collected data; any other query or condition is assumed to match so that GPO-derived access is not hidden.
+//
+// The evaluation is best-effort: WQL is not parsed but split on SELECT, OR and AND with regular expressions, and
+// parentheses are dropped, so a condition nested under NOT or grouped to change precedence may be misjudged. Such
+// filters should be reviewed by hand before relying on the edges they suppress.
+func EvaluateWMIFilter(filter string, computer *graph.Node) bool {
+	for _, wql := range wmiQuerySplitPattern.Split(filter, -1) {
+		matches := wmiWherePattern.FindStringSubmatch(wql)
+		if matches == nil || !strings.EqualFold(matches[1], "Win32_OperatingSystem") {
+			continue
+		}
+
+		where := strings.NewReplacer("(", " ", ")", " ").Replace(strings.TrimRight(strings.TrimSpace(matches[2]), ";"))
+
+		if !slices.ContainsFunc(wmiOrPattern.Split(where, -1), func(branch string) bool {
+			for _, condition := range wmiAndPattern.Split(branch, -1) {
+				if !evaluateWMICondition(strings.TrimSpace(condition), computer) {
+					return false
+				}
+			}
+
+			return true
+		}) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func evaluateWMICondition(condition string, computer *graph.Node) bool {
+	operatingSystem, _ := computer.Properties.GetOrDefault(common.OperatingSystem.String(), "").String()
+
+	if matches := wmiProductTypePattern.FindStringSubmatch(condition); matches != nil {
+		productType := computerProductType(computer, operatingSystem)
+		if productType == "" {
+			return true
+		}
+
+		return (matches[1] == "=") == (productType == matches[2])
+	} else if matches := wmiCaptionPattern.FindStringSubmatch(condition); matches != nil {
+		if operatingSystem == "" {
+			return true
+		}
+
+		var (
+			pattern = "(?i)^" + strings.ReplaceAll(regexp.QuoteMeta(matches[2]), "%", ".*") + "$"
+			caption = operatingSystem
+		)
+
+		if !strings.HasPrefix(strings.ToLower(caption), "microsoft") {
+			caption = "Microsoft " + caption
+		}
+
+		matched, err := regexp.MatchString(pattern, caption)
+		if err != nil {
+			return true
+		}
+
+		return matched != (matches[1] != "")
+	}
+
+	return true
+}
+
+// computerProductType returns the Win32_OperatingSystem ProductType of the computer: "1" for workstations, "2" for
+// domain controllers and "3" for other servers. An empty string is returned when it cannot be determined.
+func computerProductType(computer *graph.Node, operatingSystem string) string {
+	if isDC, _ := computer.Properties.GetOrDefault(ad.IsDC.String(), false).Bool(); isDC {
+		return "2"
+	} else if operatingSystem == "" {
+		return ""
+	} else if strings.Contains(strings.ToLower(operatingSystem), "server") {
+		return "3"
+	} else {
+		return "1"
+	}
+}
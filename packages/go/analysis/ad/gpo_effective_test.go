@@ -0,0 +1,104 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ad_test
+
+import (
+	"testing"
+
+	ad2 "github.com/specterops/bloodhound/packages/go/analysis/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvaluateWMIFilter(t *testing.T) {
+	var (
+		workstation      = graph.NewProperties().Set(common.OperatingSystem.String(), "Windows 11 Enterprise")
+		server           = graph.NewProperties().Set(common.OperatingSystem.String(), "Windows Server 2022 Standard")
+		domainController = graph.NewProperties().Set(common.OperatingSystem.String(), "Windows Server 2022 Standard").Set(ad.IsDC.String(), true)
+	)
+
+	testCases := []struct {
+		name       string
+		filter     string
+		properties *graph.Properties
+		expected   bool
+	}{
+		{
+			name:       "Workstation product type on a workstation",
+			filter:     `SELECT * FROM Win32_OperatingSystem WHERE ProductType = "1"`,
+			properties: workstation,
+			expected:   true,
+		},
+		{
+			name:       "Workstation product type on a server",
+			filter:     `SELECT * FROM Win32_OperatingSystem WHERE ProductType = "1"`,
+			properties: server,
+			expected:   false,
+		},
+		{
+			name:       "Excluded domain controllers",
+			filter:     `SELECT * FROM Win32_OperatingSystem WHERE ProductType <> 2`,
+			properties: domainController,
+			expected:   false,
+		},
+		{
+			name:       "Either branch matches",
+			filter:     `SELECT * FROM Win32_OperatingSystem WHERE ProductType = "2" OR ProductType = "3"`,
+			properties: server,
+			expected:   true,
+		},
+		{
+			name:       "Caption and product type must both match",
+			filter:     `SELECT * FROM Win32_OperatingSystem WHERE Caption LIKE "%Windows 10%" AND ProductType = "1"`,
+			properties: workstation,
+			expected:   false,
+		},
+		{
+			name:       "Caption matches",
+			filter:     `SELECT * FROM Win32_OperatingSystem WHERE Caption LIKE "Microsoft Windows 11%"`,
+			properties: workstation,
+			expected:   true,
+		},
+		{
+			name:       "Every query must match",
+			filter:     `SELECT * FROM Win32_OperatingSystem WHERE ProductType = "3"; SELECT * FROM Win32_OperatingSystem WHERE Caption LIKE "%2019%"`,
+			properties: server,
+			expected:   false,
+		},
+		{
+			name:       "Unsupported classes are assumed to match",
+			filter:     `SELECT * FROM Win32_ComputerSystem WHERE Manufacturer = "VMware, Inc."`,
+			properties: workstation,
+			expected:   true,
+		},
+		{
+			name:       "Unknown operating system is assumed to match",
+			filter:     `SELECT * FROM Win32_OperatingSystem WHERE ProductType = "3"`,
+			properties: graph.NewProperties(),
+			expected:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			computer := graph.NewNode(1, testCase.properties, ad.Entity, ad.Computer)
+			assert.Equal(t, testCase.expected, ad2.EvaluateWMIFilter(testCase.filter, computer))
+		})
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ad
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/specterops/bloodhound/packages/go/analysis/post"
+	"github.com/specterops/bloodhound/packages/go/bhlog/attr"
+	"github.com/specterops/bloodhound/packages/go/bhlog/measure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/ops"
+	"github.com/specterops/dawgs/query"
+	"github.com/specterops/dawgs/util/channels"
+)
+
+// PostSiteGPOs creates GPOAppliesTo edges from GPOs linked to a site to every computer in the site's subnets, and
+// CanApplyGPO edges from principals that can modify the site's GPO links to those same computers. Unenforced links
+// are not applied to computers beneath an OU or domain that blocks GPO inheritance.
+func PostSiteGPOs(ctx context.Context, db graph.Database) (*post.AtomicPostProcessingStats, error) {
+	defer measure.ContextLogAndMeasure(
+		ctx,
+		slog.LevelInfo,
+		"Post-processing site GPOs",
+		attr.Namespace("analysis"),
+		attr.Function("PostSiteGPOs"),
+		attr.Scope("process"),
+	)()
+
+	var siteNodes []*graph.Node
+	if err := db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		var err error
+		siteNodes, err = ops.FetchNodes(tx.Nodes().Filterf(func() graph.Criteria {
+			return query.Kind(query.Node(), ad.Site)
+		}))
+		return err
+	}); err != nil {
+		return &post.AtomicPostProcessingStats{}, err
+	}
+
+	operation := post.NewPostRelationshipOperation(ctx, db, "Site GPO Post Processing")
+
+	for _, site := range siteNodes {
+		innerSite := site
+
+		operation.Operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, outC chan<- post.EnsureRelationshipJob) error {
+			if computers, err := FetchSiteComputers(tx, innerSite); err != nil {
+				return err
+			} else if len(computers) == 0 {
+				return nil
+			} else if gpLinks, err := fetchSiteGPLinks(tx, innerSite); err != nil {
+				return err
+			} else if linkers, err := fetchSiteGPLinkWriters(tx, innerSite); err != nil {
+				return err
+			} else {
+				blocked := make(map[graph.ID]bool, len(computers))
+
+				for _, gpLink := range gpLinks {
+					enforced, _ := gpLink.Properties.GetOrDefault(ad.Enforced.String(), false).Bool()
+
+					for _, computer := range computers {
+						if !enforced {
+							isBlocked, seen := blocked[computer.ID]
+							if !seen {
+								if isBlocked, err = isGPOInheritanceBlocked(tx, computer); err != nil {
+									return err
+								}
+
+								blocked[computer.ID] = isBlocked
+							}
+
+							if isBlocked {
+								continue
+							}
+						}
+
+						if !channels.Submit(ctx, outC, post.EnsureRelationshipJob{
+							FromID:      gpLink.StartID,
+							ToID:        computer.ID,
+							Kind:        ad.GPOAppliesTo,
+							Composition: post.NewEdgeComposition(CompositionRuleSiteGPOLink, innerSite.ID).AddEdges(gpLink.ID),
+						}) {
+							return nil
+						}
+					}
+				}
+
+				// A principal that can write the site's gPLink attribute may link any GPO as enforced, so inheritance
+				// blocking does not protect any computer in the site
+				for _, linker := range linkers {
+					for _, computer := range computers {
+						if !channels.Submit(ctx, outC, post.EnsureRelationshipJob{
+							FromID:      linker,
+							ToID:        computer.ID,
+							Kind:        ad.CanApplyGPO,
+							Composition: post.NewEdgeComposition(CompositionRuleSiteGPLinkWrite, innerSite.ID),
+						}) {
+							return nil
+						}
+					}
+				}
+
+				return nil
+			}
+		})
+	}
+
+	return &operation.Stats, operation.Done()
+}
+
+// FetchSiteComputers returns the computers that have been mapped to any of the given site's subnets
+func FetchSiteComputers(tx graph.Transaction, site *graph.Node) (graph.NodeSet, error) {
+	if subnets, err := ops.FetchEndNodes(tx.Relationships().Filterf(func() graph.Criteria {
+		return query.And(
+			query.Equals(query.StartID(), site.ID),
+			query.Kind(query.Relationship(), ad.HasSubnet),
+			query.Kind(query.End(), ad.Subnet),
+		)
+	})); err != nil {
+		return nil, err
+	} else if len(subnets) == 0 {
+		return graph.NewNodeSet(), nil
+	} else {
+		return ops.FetchStartNodes(tx.Relationships().Filterf(func() graph.Criteria {
+			return query.And(
+				query.Kind(query.Start(), ad.Computer),
+				query.Kind(query.Relationship(), ad.InSubnet),
+				query.InIDs(query.EndID(), subnets.IDs()...),
+			)
+		}))
+	}
+}
+
+func fetchSiteGPLinks(tx graph.Transaction, site *graph.Node) ([]*graph.Relationship, error) {
+	return ops.FetchRelationships(tx.Relationships().Filterf(func() graph.Criteria {
+		return query.And(
+			query.Kind(query.Start(), ad.GPO),
+			query.Kind(query.Relationship(), ad.GPLink),
+			query.Equals(query.EndID(), site.ID),
+		)
+	}))
+}
+
+func fetchSiteGPLinkWriters(tx graph.Transaction, site *graph.Node) ([]graph.ID, error) {
+	return ops.FetchStartNodeIDs(tx.Relationships().Filterf(func() graph.Criteria {
+		return query.And(
+			query.KindIn(query.Start(), ad.Group, ad.User, ad.Computer),
+			query.KindIn(query.Relationship(), ad.WriteGPLink, ad.GenericAll, ad.GenericWrite),
+			query.Equals(query.EndID(), site.ID),
+		)
+	}))
+}
+
+// isGPOInheritanceBlocked returns true if any OU or domain containing the given node blocks GPO inheritance
+func isGPOInheritanceBlocked(tx graph.Transaction, node *graph.Node) (bool, error) {
+	blocked := false
+
+	err := ops.Traversal(tx, ops.TraversalPlan{
+		Root:      node,
+		Direction: graph.DirectionInbound,
+		BranchQuery: func() graph.Criteria {
+			return query.And(
+				query.KindIn(query.Start(), ad.Domain, ad.OU, ad.Container),
+				query.Kind(query.Relationship(), ad.Contains),
+			)
+		},
+	}, func(ctx *ops.TraversalContext, segment *graph.PathSegment) error {
+		if segment.Node.Kinds.ContainsOneOf(ad.OU, ad.Domain) {
+			if blocksInheritance, _ := segment.Node.Properties.GetOrDefault(ad.BlocksInheritance.String(), false).Bool(); blocksInheritance {
+				blocked = true
+			}
+		}
+
+		return nil
+	})
+
+	return blocked, err
+}
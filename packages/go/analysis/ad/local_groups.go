@@ -83,7 +83,7 @@ func PostCanRDP(parentCtx context.Context, graphDB graph.Database, localGroupDat
 				// Because reach is calculated using a compontent graph the code must exclude
 				// any self references
 				if nextPost.FromID != nextPost.ToID {
-					if err := batch.CreateRelationshipByIDs(nextPost.FromID, nextPost.ToID, nextPost.Kind, relProperties); err != nil {
+					if err := batch.CreateRelationshipByIDs(nextPost.FromID, nextPost.ToID, nextPost.Kind, nextPost.MergeProperties(relProperties)); err != nil {
 						return err
 					}
 				}
@@ -117,11 +117,14 @@ func PostCanRDP(parentCtx context.Context, graphDB graph.Database, localGroupDat
 					slog.Error("FetchCanRDPEntityBitmapForComputer Error", attr.Error(err))
 					done()
 				} else {
+					composition := canRDPComposition(nextComputerRDPJob, citrixEnabled)
+
 					rdpEntities.Each(func(fromID uint64) bool {
 						return channels.Submit(ctx, postC, post.EnsureRelationshipJob{
-							FromID: graph.ID(fromID),
-							ToID:   nextComputerRDPJob.Computer,
-							Kind:   ad.CanRDP,
+							FromID:      graph.ID(fromID),
+							ToID:        nextComputerRDPJob.Computer,
+							Kind:        ad.CanRDP,
+							Composition: composition,
 						})
 					})
 				}
@@ -245,7 +248,7 @@ func PostLocalGroups(parentCtx context.Context, graphDB graph.Database, localGro
 					break
 				}
 
-				if err := batch.CreateRelationshipByIDs(nextPost.FromID, nextPost.ToID, nextPost.Kind, relProperties); err != nil {
+				if err := batch.CreateRelationshipByIDs(nextPost.FromID, nextPost.ToID, nextPost.Kind, nextPost.MergeProperties(relProperties)); err != nil {
 					return err
 				}
 
@@ -287,11 +290,14 @@ func PostLocalGroups(parentCtx context.Context, graphDB graph.Database, localGro
 					continue
 				}
 
+				composition := post.NewEdgeComposition(CompositionRuleLocalGroupMembership, graph.ID(nextJob.targetGroup))
+
 				localGroupData.LocalGroupMembershipDigraph.EachAdjacentNode(nextJob.targetGroup, graph.DirectionInbound, func(fromID uint64) bool {
 					return channels.Submit(ctx, postC, post.EnsureRelationshipJob{
-						FromID: graph.ID(fromID),
-						ToID:   graph.ID(nextJob.targetComputer),
-						Kind:   edgeKind,
+						FromID:      graph.ID(fromID),
+						ToID:        graph.ID(nextJob.targetComputer),
+						Kind:        edgeKind,
+						Composition: composition,
 					})
 				})
 			}
@@ -382,3 +388,18 @@ func PostLocalGroups(parentCtx context.Context, graphDB graph.Database, localGro
 
 	return &stats, nil
 }
+
+// canRDPComposition records the local groups that grant CanRDP on the computer being analyzed.
+func canRDPComposition(computerData *CanRDPComputerData, citrixEnabled bool) *post.EdgeComposition {
+	composition := post.NewEdgeComposition(CompositionRuleRemoteDesktopUsers)
+
+	if computerData.RemoteDesktopUsersLocalGroup != nil {
+		composition.AddNodes(computerData.RemoteDesktopUsersLocalGroup.ID)
+	}
+
+	if citrixEnabled && computerData.DAUGroup != nil {
+		composition.AddNodes(computerData.DAUGroup.ID)
+	}
+
+	return composition
+}
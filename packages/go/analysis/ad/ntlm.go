@@ -59,6 +59,12 @@ func (s NTLMCache) GetLdapCacheForDomain(domainSid string) (LDAPSigningCache, bo
 	return cache, ok
 }
 
+// GetDomainIDForDomain returns the ID of the domain node with the given SID
+func (s NTLMCache) GetDomainIDForDomain(domainSid string) (graph.ID, bool) {
+	cache, ok := s.LdapCache[domainSid]
+	return cache.DomainID, ok
+}
+
 func NewNTLMCache(ctx context.Context, db graph.Database, localGroupData *LocalGroupData) (NTLMCache, error) {
 	var (
 		ntlmCache                   = NTLMCache{}
@@ -409,9 +415,10 @@ func PostCoerceAndRelayNTLMToADCS(ctx context.Context, operation post.StatTracke
 							if err := operation.Operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, outC chan<- post.EnsureRelationshipJob) error {
 								victims.Each(func(target uint64) bool {
 									outC <- post.EnsureRelationshipJob{
-										FromID: authUsersGroup,
-										ToID:   graph.ID(target),
-										Kind:   ad.CoerceAndRelayNTLMToADCS,
+										FromID:      authUsersGroup,
+										ToID:        graph.ID(target),
+										Kind:        ad.CoerceAndRelayNTLMToADCS,
+										Composition: post.NewEdgeComposition(CompositionRuleCoerceAndRelayNTLMToADCS, ecaID, certTemplate.ID),
 									}
 									return true
 								})
@@ -535,6 +542,9 @@ func PostCoerceAndRelayNTLMToSMB(tx graph.Transaction, outC chan<- post.EnsureRe
 	} else if err != nil {
 		return err
 	} else if !smbSigningEnabled {
+		domainSid, _ := computer.Properties.Get(ad.DomainSID.String()).String()
+		domainID, hasDomain := ntlmCache.GetDomainIDForDomain(domainSid)
+
 		// Fetch the admins with edges to the provided computer
 		if firstDegreeAdmins, err := fetchFirstDegreeNodes(tx, computer, ad.AdminTo); err != nil {
 			return err
@@ -556,10 +566,16 @@ func PostCoerceAndRelayNTLMToSMB(tx graph.Transaction, outC chan<- post.EnsureRe
 			allAdminPrincipals.Remove(computer.ID.Uint64())
 
 			if allAdminPrincipals.Cardinality() > 0 {
+				composition := post.NewEdgeComposition(CompositionRuleCoerceAndRelayNTLMToSMB)
+				if hasDomain {
+					composition.AddNodes(domainID)
+				}
+
 				outC <- post.EnsureRelationshipJob{
-					FromID: authenticatedUserID,
-					ToID:   computer.ID,
-					Kind:   ad.CoerceAndRelayNTLMToSMB,
+					FromID:      authenticatedUserID,
+					ToID:        computer.ID,
+					Kind:        ad.CoerceAndRelayNTLMToSMB,
+					Composition: composition,
 				}
 			}
 		}
@@ -772,29 +788,33 @@ func PostCoerceAndRelayNTLMToLDAP(outC chan<- post.EnsureRelationshipJob, comput
 				// We also ignore instances where the computer is relaying to itself
 				if len(signingCache.relayableToDCLDAP) == 1 && signingCache.relayableToDCLDAP[0] != computer.ID {
 					outC <- post.EnsureRelationshipJob{
-						FromID: authenticatedUserGroupID,
-						ToID:   computer.ID,
-						Kind:   ad.CoerceAndRelayNTLMToLDAP,
+						FromID:      authenticatedUserGroupID,
+						ToID:        computer.ID,
+						Kind:        ad.CoerceAndRelayNTLMToLDAP,
+						Composition: post.NewEdgeComposition(CompositionRuleCoerceAndRelayNTLMToLDAP, signingCache.DomainID),
 					}
 				} else if len(signingCache.relayableToDCLDAP) > 1 {
 					outC <- post.EnsureRelationshipJob{
-						FromID: authenticatedUserGroupID,
-						ToID:   computer.ID,
-						Kind:   ad.CoerceAndRelayNTLMToLDAP,
+						FromID:      authenticatedUserGroupID,
+						ToID:        computer.ID,
+						Kind:        ad.CoerceAndRelayNTLMToLDAP,
+						Composition: post.NewEdgeComposition(CompositionRuleCoerceAndRelayNTLMToLDAP, signingCache.DomainID),
 					}
 				}
 
 				if len(signingCache.relayableToDCLDAPS) == 1 && signingCache.relayableToDCLDAPS[0] != computer.ID {
 					outC <- post.EnsureRelationshipJob{
-						FromID: authenticatedUserGroupID,
-						ToID:   computer.ID,
-						Kind:   ad.CoerceAndRelayNTLMToLDAPS,
+						FromID:      authenticatedUserGroupID,
+						ToID:        computer.ID,
+						Kind:        ad.CoerceAndRelayNTLMToLDAPS,
+						Composition: post.NewEdgeComposition(CompositionRuleCoerceAndRelayNTLMToLDAPS, signingCache.DomainID),
 					}
 				} else if len(signingCache.relayableToDCLDAPS) > 1 {
 					outC <- post.EnsureRelationshipJob{
-						FromID: authenticatedUserGroupID,
-						ToID:   computer.ID,
-						Kind:   ad.CoerceAndRelayNTLMToLDAPS,
+						FromID:      authenticatedUserGroupID,
+						ToID:        computer.ID,
+						Kind:        ad.CoerceAndRelayNTLMToLDAPS,
+						Composition: post.NewEdgeComposition(CompositionRuleCoerceAndRelayNTLMToLDAPS, signingCache.DomainID),
 					}
 				}
 			}
@@ -859,6 +879,7 @@ func FetchProtectedUsersMappedToDomains(ctx context.Context, db graph.Database,
 // LDAPSigningCache encapsulates whether a domain had a valid functionallevel property and slices of node ids that meet the criteria
 // for a CoerceAndRelayNTLMToLDAP or CoerceAndRelayNTLMToLDAPS edge
 type LDAPSigningCache struct {
+	DomainID                    graph.ID
 	IsVulnerableFunctionalLevel bool
 	relayableToDCLDAP           []graph.ID
 	relayableToDCLDAPS          []graph.ID
@@ -928,6 +949,7 @@ func FetchLDAPSigningCache(ctx context.Context, db graph.Database) (map[string]L
 					}
 
 					cache[domainSid] = LDAPSigningCache{
+						DomainID:                    domain.ID,
 						IsVulnerableFunctionalLevel: isFunctionalLevelVulnerable,
 						relayableToDCLDAP:           relayableToDcLdap,
 						relayableToDCLDAPS:          relayableToDcLdaps,
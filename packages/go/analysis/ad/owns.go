@@ -280,9 +280,10 @@ func createPostRelFromRaw(rel *graph.Relationship, kind graph.Kind) post.EnsureR
 	inheritanceHash, _ := rel.Properties.GetOrDefault(ad.InheritanceHash.String(), "").String()
 
 	return post.EnsureRelationshipJob{
-		FromID: rel.StartID,
-		ToID:   rel.EndID,
-		Kind:   kind,
+		FromID:      rel.StartID,
+		ToID:        rel.EndID,
+		Kind:        kind,
+		Composition: post.NewEdgeComposition(CompositionRuleOwnerRights).AddEdges(rel.ID),
 		RelProperties: map[string]any{
 			ad.IsACL.String():           true,
 			common.IsInherited.String(): isInherited,
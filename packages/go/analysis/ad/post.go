@@ -54,19 +54,32 @@ func PostSyncLAPSPassword(ctx context.Context, db graph.Database, localGroupData
 		for _, domain := range domainNodes {
 			innerDomain := domain
 			operation.Operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, outC chan<- post.EnsureRelationshipJob) error {
-				if lapsSyncers, err := getLAPSSyncers(tx, innerDomain, localGroupData); err != nil {
+				if lapsSyncers, rights, err := getLAPSSyncers(tx, innerDomain, localGroupData); err != nil {
 					return err
 				} else if lapsSyncers.Cardinality() == 0 {
 					return nil
 				} else if computers, err := getLAPSComputersForDomain(tx, innerDomain); err != nil {
 					return err
 				} else {
+					compositions := map[uint64]*post.EdgeComposition{}
+
+					for _, lapsSyncer := range lapsSyncers.Slice() {
+						composition := post.NewEdgeComposition(CompositionRuleSyncLAPSPassword, innerDomain.ID)
+
+						if err := addMembershipComposition(tx, composition, graph.ID(lapsSyncer), rights); err != nil {
+							return err
+						}
+
+						compositions[lapsSyncer] = composition
+					}
+
 					for _, computer := range computers {
 						lapsSyncers.Each(func(value uint64) bool {
 							channels.Submit(ctx, outC, post.EnsureRelationshipJob{
-								FromID: graph.ID(value),
-								ToID:   computer,
-								Kind:   ad.SyncLAPSPassword,
+								FromID:      graph.ID(value),
+								ToID:        computer,
+								Kind:        ad.SyncLAPSPassword,
+								Composition: compositions[value],
 							})
 							return true
 						})
@@ -99,19 +112,25 @@ func PostDCSync(ctx context.Context, db graph.Database, localGroupData *LocalGro
 		for _, domain := range domainNodes {
 			innerDomain := domain
 			operation.Operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, outC chan<- post.EnsureRelationshipJob) error {
-				if dcSyncers, err := getDCSyncers(tx, innerDomain, localGroupData); err != nil {
+				if dcSyncers, rights, err := getDCSyncers(tx, innerDomain, localGroupData); err != nil {
 					return err
 				} else if dcSyncers.Cardinality() == 0 {
 					return nil
 				} else {
-					dcSyncers.Each(func(value uint64) bool {
+					for _, dcSyncer := range dcSyncers.Slice() {
+						composition := post.NewEdgeComposition(CompositionRuleDCSync, innerDomain.ID)
+
+						if err := addMembershipComposition(tx, composition, graph.ID(dcSyncer), rights); err != nil {
+							return err
+						}
+
 						channels.Submit(ctx, outC, post.EnsureRelationshipJob{
-							FromID: graph.ID(value),
-							ToID:   innerDomain.ID,
-							Kind:   ad.DCSync,
+							FromID:      graph.ID(dcSyncer),
+							ToID:        innerDomain.ID,
+							Kind:        ad.DCSync,
+							Composition: composition,
 						})
-						return true
-					})
+					}
 
 					return nil
 				}
@@ -150,15 +169,32 @@ func PostProtectAdminGroups(ctx context.Context, db graph.Database) (*post.Atomi
 			} else if len(adminSDHolderIDs) == 0 {
 				// No AdminSDHolder IDs found for this domain
 				return nil
-			} else if protectedObjectIDs, err := getAdminSDHolderProtected(tx, domain); err != nil {
+			} else if protectedObjects, err := getAdminSDHolderProtected(tx, domain); err != nil {
 				return err
 			} else {
-				fromID := adminSDHolderIDs[0] // AdminSDHolder should be unique per domain
-				for _, toID := range protectedObjectIDs {
+				var (
+					fromID          = adminSDHolderIDs[0] // AdminSDHolder should be unique per domain
+					protectedGroups = map[graph.ID][]graph.ID{}
+				)
+
+				for _, protectedGroup := range protectedObjects.ContainingNodeKinds(ad.Group) {
+					protectedGroups[protectedGroup.ID] = nil
+				}
+
+				for _, protectedObject := range protectedObjects {
+					composition := post.NewEdgeComposition(CompositionRuleProtectAdminGroups, domain.ID)
+
+					if len(protectedGroups) > 0 {
+						if err := addMemberOfComposition(tx, composition, protectedObject.ID, protectedGroups); err != nil {
+							return err
+						}
+					}
+
 					channels.Submit(ctx, outC, post.EnsureRelationshipJob{
-						FromID: fromID,
-						ToID:   toID,
-						Kind:   ad.ProtectAdminGroups,
+						FromID:      fromID,
+						ToID:        protectedObject.ID,
+						Kind:        ad.ProtectAdminGroups,
+						Composition: composition,
 					})
 				}
 				return nil
@@ -222,9 +258,10 @@ func PostHasTrustKeys(ctx context.Context, db graph.Database) (*post.AtomicPostP
 							continue
 						} else {
 							channels.Submit(ctx, outC, post.EnsureRelationshipJob{
-								FromID: domain.ID,
-								ToID:   trustAccount.ID,
-								Kind:   ad.HasTrustKeys,
+								FromID:      domain.ID,
+								ToID:        trustAccount.ID,
+								Kind:        ad.HasTrustKeys,
+								Composition: post.NewEdgeComposition(CompositionRuleHasTrustKeys, trustingDomain.ID),
 							})
 						}
 					}
@@ -373,38 +410,47 @@ func fromEntityToEntityWithRelationshipKind(tx graph.Transaction, target *graph.
 	})
 }
 
-func getLAPSSyncers(tx graph.Transaction, domain *graph.Node, localGroupData *LocalGroupData) (cardinality.Duplex[uint64], error) {
-	var (
-		getChangesQuery         = fromEntityToEntityWithRelationshipKind(tx, domain, ad.GetChanges)
-		getChangesFilteredQuery = fromEntityToEntityWithRelationshipKind(tx, domain, ad.GetChangesInFilteredSet)
-	)
+// getLAPSSyncers returns the principals holding both GetChanges and GetChangesInFilteredSet on the domain along with
+// the rights edges that were considered.
+func getLAPSSyncers(tx graph.Transaction, domain *graph.Node, localGroupData *LocalGroupData) (cardinality.Duplex[uint64], []*graph.Relationship, error) {
+	return getReplicationRightsHolders(tx, domain, localGroupData, ad.GetChanges, ad.GetChangesInFilteredSet)
+}
 
-	if getChangesNodes, err := ops.FetchStartNodes(getChangesQuery); err != nil {
-		return nil, err
-	} else if getChangesFilteredNodes, err := ops.FetchStartNodes(getChangesFilteredQuery); err != nil {
-		return nil, err
+// getDCSyncers returns the principals holding both GetChanges and GetChangesAll on the domain along with the rights
+// edges that were considered.
+func getDCSyncers(tx graph.Transaction, domain *graph.Node, localGroupData *LocalGroupData) (cardinality.Duplex[uint64], []*graph.Relationship, error) {
+	return getReplicationRightsHolders(tx, domain, localGroupData, ad.GetChanges, ad.GetChangesAll)
+}
+
+func getReplicationRightsHolders(tx graph.Transaction, domain *graph.Node, localGroupData *LocalGroupData, firstRight, secondRight graph.Kind) (cardinality.Duplex[uint64], []*graph.Relationship, error) {
+	if firstRights, err := ops.FetchRelationships(fromEntityToEntityWithRelationshipKind(tx, domain, firstRight)); err != nil {
+		return nil, nil, err
+	} else if secondRights, err := ops.FetchRelationships(fromEntityToEntityWithRelationshipKind(tx, domain, secondRight)); err != nil {
+		return nil, nil, err
+	} else if firstHolders, err := fetchRelationshipStartNodes(tx, firstRights); err != nil {
+		return nil, nil, err
+	} else if secondHolders, err := fetchRelationshipStartNodes(tx, secondRights); err != nil {
+		return nil, nil, err
 	} else {
-		results := CalculateCrossProductNodeSets(localGroupData, NewCachedPrincipalSet(getChangesNodes.Slice()), NewCachedPrincipalSet(getChangesFilteredNodes.Slice()))
+		results := CalculateCrossProductNodeSets(localGroupData, NewCachedPrincipalSet(firstHolders), NewCachedPrincipalSet(secondHolders))
 
-		return results, nil
+		return results, append(firstRights, secondRights...), nil
 	}
 }
 
-func getDCSyncers(tx graph.Transaction, domain *graph.Node, localGroupData *LocalGroupData) (cardinality.Duplex[uint64], error) {
-	var (
-		getChangesQuery    = fromEntityToEntityWithRelationshipKind(tx, domain, ad.GetChanges)
-		getChangesAllQuery = fromEntityToEntityWithRelationshipKind(tx, domain, ad.GetChangesAll)
-	)
-
-	if getChangesNodes, err := ops.FetchStartNodes(getChangesQuery); err != nil {
-		return nil, err
-	} else if getChangesAllNodes, err := ops.FetchStartNodes(getChangesAllQuery); err != nil {
-		return nil, err
-	} else {
-		results := CalculateCrossProductNodeSets(localGroupData, NewCachedPrincipalSet(getChangesNodes.Slice()), NewCachedPrincipalSet(getChangesAllNodes.Slice()))
+func fetchRelationshipStartNodes(tx graph.Transaction, relationships []*graph.Relationship) ([]*graph.Node, error) {
+	if len(relationships) == 0 {
+		return nil, nil
+	}
 
-		return results, nil
+	startIDs := make([]graph.ID, 0, len(relationships))
+	for _, relationship := range relationships {
+		startIDs = append(startIDs, relationship.StartID)
 	}
+
+	return ops.FetchNodes(tx.Nodes().Filterf(func() graph.Criteria {
+		return query.InIDs(query.NodeID(), startIDs...)
+	}))
 }
 
 func getLAPSComputersForDomain(tx graph.Transaction, domain *graph.Node) ([]graph.ID, error) {
@@ -436,11 +482,11 @@ func getAdminSDHolder(tx graph.Transaction, domain *graph.Node) ([]graph.ID, err
 	}
 }
 
-func getAdminSDHolderProtected(tx graph.Transaction, domain *graph.Node) ([]graph.ID, error) {
+func getAdminSDHolderProtected(tx graph.Transaction, domain *graph.Node) (graph.NodeSet, error) {
 	if domainSid, err := domain.Properties.Get(ad.DomainSID.String()).String(); err != nil {
 		return nil, err
 	} else {
-		return ops.FetchNodeIDs(tx.Nodes().Filterf(func() graph.Criteria {
+		return ops.FetchNodeSet(tx.Nodes().Filterf(func() graph.Criteria {
 			return query.And(
 				query.KindIn(query.Node(), ad.Computer, ad.User, ad.Group),
 				query.Equals(
@@ -911,10 +957,22 @@ func Post(ctx context.Context, db graph.Database, citrixEnabled, ntlmEnabled boo
 		return &aggregateStats, err
 	} else if hasTrustKeyStats, err := PostHasTrustKeys(ctx, db); err != nil {
 		return &aggregateStats, err
+	} else if foreignMemberOfStats, err := PostForeignMemberOf(ctx, db); err != nil {
+		return &aggregateStats, err
+	} else if badSuccessorStats, err := PostBadSuccessor(ctx, db); err != nil {
+		return &aggregateStats, err
+	} else if siteGPOStats, err := PostSiteGPOs(ctx, db); err != nil {
+		return &aggregateStats, err
+	} else if adidnsStats, err := PostADIDNS(ctx, db); err != nil {
+		return &aggregateStats, err
+	} else if roastingStats, err := PostRoasting(ctx, db); err != nil {
+		return &aggregateStats, err
 	} else if localGroupStats, err := PostLocalGroups(ctx, db, localGroupData); err != nil {
 		return &aggregateStats, err
 	} else if canRDPStats, err := PostCanRDP(ctx, db, localGroupData, true, citrixEnabled); err != nil {
 		return &aggregateStats, err
+	} else if effectiveGPOStats, err := PostEffectiveGPOs(ctx, db); err != nil {
+		return &aggregateStats, err
 	} else if adcsStats, adcsCache, err := PostADCS(ctx, db, localGroupData); err != nil {
 		return &aggregateStats, err
 	} else if ownsStats, err := PostOwnsAndWriteOwner(ctx, db, localGroupData); err != nil {
@@ -925,10 +983,16 @@ func Post(ctx context.Context, db graph.Database, citrixEnabled, ntlmEnabled boo
 		aggregateStats.Merge(deleteTransitEdgesStats)
 		aggregateStats.Merge(syncLAPSStats)
 		aggregateStats.Merge(hasTrustKeyStats)
+		aggregateStats.Merge(foreignMemberOfStats)
+		aggregateStats.Merge(badSuccessorStats)
+		aggregateStats.Merge(siteGPOStats)
+		aggregateStats.Merge(adidnsStats)
+		aggregateStats.Merge(roastingStats)
 		aggregateStats.Merge(dcSyncStats)
 		aggregateStats.Merge(protectAdminGroupsStats)
 		aggregateStats.Merge(localGroupStats)
 		aggregateStats.Merge(canRDPStats)
+		aggregateStats.Merge(effectiveGPOStats)
 		aggregateStats.Merge(adcsStats)
 		aggregateStats.Merge(ownsStats)
 		aggregateStats.Merge(ntlmStats)
@@ -0,0 +1,254 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ad
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/specterops/bloodhound/packages/go/analysis/ad/wellknown"
+	"github.com/specterops/bloodhound/packages/go/analysis/post"
+	"github.com/specterops/bloodhound/packages/go/bhlog/attr"
+	"github.com/specterops/bloodhound/packages/go/bhlog/measure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/ops"
+	"github.com/specterops/dawgs/query"
+	"github.com/specterops/dawgs/util/channels"
+)
+
+const (
+	// MinimumCrackability is the lowest crackability score assigned to a roastable account. Even AES-only accounts with
+	// recently rotated passwords remain crackable when the password is weak, so the score never reaches zero.
+	MinimumCrackability = 0.1
+
+	// aesOnlyCrackabilityFactor scales the score of accounts that only issue AES encrypted tickets, which are
+	// considerably slower to crack than RC4 or DES
+	aesOnlyCrackabilityFactor = 0.4
+
+	// neverExpiresCrackabilityFactor is the lowest password age factor given to accounts whose password never expires
+	neverExpiresCrackabilityFactor = 0.75
+
+	passwordAgeYear = 365 * 24 * time.Hour
+)
+
+// PostRoasting creates Kerberoastable edges from the Authenticated Users group of each domain to the enabled user
+// accounts in that domain with a service principal name, and ASREPRoastable edges to the user accounts that do not
+// require Kerberos pre-authentication. Any authenticated principal can request crackable key material for these
+// accounts. Each edge carries a crackability score in the range (0, 1] that weighted pathfinding uses to prefer
+// realistic roasts; see RoastCrackability.
+func PostRoasting(ctx context.Context, db graph.Database) (*post.AtomicPostProcessingStats, error) {
+	defer measure.ContextLogAndMeasure(
+		ctx,
+		slog.LevelInfo,
+		"Post-processing Kerberoasting and AS-REP roasting",
+		attr.Namespace("analysis"),
+		attr.Function("PostRoasting"),
+		attr.Scope("process"),
+	)()
+
+	var authenticatedUsers map[string]graph.ID
+	if err := db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		var err error
+		authenticatedUsers, err = FetchAuthUsersMappedToDomains(tx)
+		return err
+	}); err != nil {
+		return &post.AtomicPostProcessingStats{}, err
+	}
+
+	var (
+		now       = time.Now().UTC()
+		operation = post.NewPostRelationshipOperation(ctx, db, "Roasting Post Processing")
+	)
+
+	for domainSID, authenticatedUsersID := range authenticatedUsers {
+		var (
+			innerDomainSID          = domainSID
+			innerAuthenticatedUsers = authenticatedUsersID
+		)
+
+		operation.Operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, outC chan<- post.EnsureRelationshipJob) error {
+			if kerberoastable, err := FetchKerberoastableUsers(tx, innerDomainSID); err != nil {
+				return err
+			} else if asrepRoastable, err := FetchASREPRoastableUsers(tx, innerDomainSID); err != nil {
+				return err
+			} else {
+				if !submitRoastingJobs(ctx, outC, innerAuthenticatedUsers, kerberoastable, ad.Kerberoastable, CompositionRuleKerberoastable, now) {
+					return nil
+				}
+
+				submitRoastingJobs(ctx, outC, innerAuthenticatedUsers, asrepRoastable, ad.ASREPRoastable, CompositionRuleASREPRoastable, now)
+				return nil
+			}
+		})
+	}
+
+	return &operation.Stats, operation.Done()
+}
+
+func submitRoastingJobs(ctx context.Context, outC chan<- post.EnsureRelationshipJob, fromID graph.ID, targets []*graph.Node, kind graph.Kind, rule string, now time.Time) bool {
+	for _, target := range targets {
+		if !channels.Submit(ctx, outC, post.EnsureRelationshipJob{
+			FromID:      fromID,
+			ToID:        target.ID,
+			Kind:        kind,
+			Composition: post.NewEdgeComposition(rule),
+			RelProperties: map[string]any{
+				ad.Crackability.String(): RoastCrackability(target, now),
+			},
+		}) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FetchKerberoastableUsers returns the enabled user accounts of the domain that have a service principal name. The
+// krbtgt account and managed service accounts are excluded since their passwords are random and not practical to crack.
+func FetchKerberoastableUsers(tx graph.Transaction, domainSID string) ([]*graph.Node, error) {
+	if users, err := ops.FetchNodes(tx.Nodes().Filterf(func() graph.Criteria {
+		return query.And(
+			query.Kind(query.Node(), ad.User),
+			query.Equals(query.NodeProperty(ad.DomainSID.String()), domainSID),
+			query.Equals(query.NodeProperty(ad.HasSPN.String()), true),
+		)
+	})); err != nil {
+		return nil, err
+	} else {
+		kerberoastable := make([]*graph.Node, 0, len(users))
+
+		for _, user := range users {
+			if !isRoastableUser(user) {
+				continue
+			} else if objectID, err := user.Properties.Get(common.ObjectID.String()).String(); err == nil && strings.HasSuffix(objectID, wellknown.KRBTGTAccountSIDSuffix.String()) {
+				continue
+			} else if isGMSA, _ := user.Properties.GetOrDefault(ad.GMSA.String(), false).Bool(); isGMSA {
+				continue
+			} else if isMSA, _ := user.Properties.GetOrDefault(ad.MSA.String(), false).Bool(); isMSA {
+				continue
+			}
+
+			kerberoastable = append(kerberoastable, user)
+		}
+
+		return kerberoastable, nil
+	}
+}
+
+// FetchASREPRoastableUsers returns the enabled user accounts of the domain that do not require Kerberos
+// pre-authentication
+func FetchASREPRoastableUsers(tx graph.Transaction, domainSID string) ([]*graph.Node, error) {
+	if users, err := ops.FetchNodes(tx.Nodes().Filterf(func() graph.Criteria {
+		return query.And(
+			query.Kind(query.Node(), ad.User),
+			query.Equals(query.NodeProperty(ad.DomainSID.String()), domainSID),
+			query.Equals(query.NodeProperty(ad.DontRequirePreAuth.String()), true),
+		)
+	})); err != nil {
+		return nil, err
+	} else {
+		asrepRoastable := make([]*graph.Node, 0, len(users))
+
+		for _, user := range users {
+			if isRoastableUser(user) {
+				asrepRoastable = append(asrepRoastable, user)
+			}
+		}
+
+		return asrepRoastable, nil
+	}
+}
+
+// isRoastableUser returns false for accounts that are known to be disabled. Accounts without an enabled property are
+// assumed to be enabled.
+func isRoastableUser(user *graph.Node) bool {
+	enabled, _ := user.Properties.GetOrDefault(common.Enabled.String(), true).Bool()
+	return enabled
+}
+
+// CrackabilityCost divides the cost of traversing a relationship that carries a crackability score, such as
+// Kerberoastable, by that score so that weighted path searches prefer the roasts most likely to succeed. It satisfies
+// pathfinding.CostModifier.
+func CrackabilityCost(relationship *graph.Relationship, cost float64) float64 {
+	if relationship.Properties == nil {
+		return cost
+	}
+
+	// Scores are stored in the range (0, 1], so dividing by one never makes a relationship cheaper
+	if crackability, err := relationship.Properties.Get(ad.Crackability.String()).Float64(); err == nil && crackability > 0 && crackability <= 1 {
+		return cost / crackability
+	}
+
+	return cost
+}
+
+// RoastCrackability scores how practical it is to crack the key material recovered by roasting the given account, from
+// MinimumCrackability up to 1. The score is the product of two factors:
+//
+//   - Encryption: accounts that only support AES encrypted tickets score lower than those that allow RC4 or DES. An
+//     account without supported encryption types is assumed to allow RC4.
+//   - Password age: passwords set less than a year ago score lowest and passwords older than three years, or never set,
+//     score highest. Passwords that never expire are treated as at least moderately aged.
+func RoastCrackability(user *graph.Node, now time.Time) float64 {
+	var (
+		encryptionFactor  = roastEncryptionFactor(user)
+		passwordAgeFactor = roastPasswordAgeFactor(user, now)
+		score             = encryptionFactor * passwordAgeFactor
+	)
+
+	return math.Round(max(score, MinimumCrackability)*100) / 100
+}
+
+func roastEncryptionFactor(user *graph.Node) float64 {
+	encryptionTypes, err := user.Properties.Get(ad.SupportedKerberosEncryptionTypes.String()).StringSlice()
+	if err != nil || len(encryptionTypes) == 0 {
+		return 1
+	}
+
+	for _, encryptionType := range encryptionTypes {
+		if strings.Contains(encryptionType, "RC4") || strings.Contains(encryptionType, "DES") {
+			return 1
+		}
+	}
+
+	return aesOnlyCrackabilityFactor
+}
+
+func roastPasswordAgeFactor(user *graph.Node, now time.Time) float64 {
+	var ageFactor float64
+
+	if passwordLastSet, err := user.Properties.Get(common.PasswordLastSet.String()).Float64(); err != nil || passwordLastSet <= 0 {
+		ageFactor = 1
+	} else if passwordAge := now.Sub(time.Unix(int64(passwordLastSet), 0)); passwordAge < passwordAgeYear {
+		ageFactor = 0.5
+	} else if passwordAge < 3*passwordAgeYear {
+		ageFactor = 0.75
+	} else {
+		ageFactor = 1
+	}
+
+	if neverExpires, _ := user.Properties.GetOrDefault(ad.PasswordNeverExpires.String(), false).Bool(); neverExpires {
+		ageFactor = max(ageFactor, neverExpiresCrackabilityFactor)
+	}
+
+	return ageFactor
+}
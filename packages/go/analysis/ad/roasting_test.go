@@ -0,0 +1,100 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ad_test
+
+import (
+	"testing"
+	"time"
+
+	ad2 "github.com/specterops/bloodhound/packages/go/analysis/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoastCrackability(t *testing.T) {
+	var (
+		now        = time.Date(2026, time.June, 1, 0, 0, 0, 0, time.UTC)
+		recentSet  = now.Add(-30 * 24 * time.Hour).Unix()
+		twoYearSet = now.Add(-2 * 365 * 24 * time.Hour).Unix()
+		oldSet     = now.Add(-5 * 365 * 24 * time.Hour).Unix()
+		aesOnly    = []string{"AES128-CTS-HMAC-SHA1-96", "AES256-CTS-HMAC-SHA1-96"}
+		withRC4    = []string{"RC4-HMAC-MD5", "AES256-CTS-HMAC-SHA1-96"}
+	)
+
+	testCases := []struct {
+		name       string
+		properties *graph.Properties
+		expected   float64
+	}{
+		{
+			name:       "No properties",
+			properties: graph.NewProperties(),
+			expected:   1,
+		},
+		{
+			name:       "RC4 with an old password",
+			properties: graph.NewProperties().Set(ad.SupportedKerberosEncryptionTypes.String(), withRC4).Set(common.PasswordLastSet.String(), oldSet),
+			expected:   1,
+		},
+		{
+			name:       "RC4 with a recent password",
+			properties: graph.NewProperties().Set(ad.SupportedKerberosEncryptionTypes.String(), withRC4).Set(common.PasswordLastSet.String(), recentSet),
+			expected:   0.5,
+		},
+		{
+			name:       "RC4 with a two year old password",
+			properties: graph.NewProperties().Set(common.PasswordLastSet.String(), twoYearSet),
+			expected:   0.75,
+		},
+		{
+			name:       "AES only with a recent password",
+			properties: graph.NewProperties().Set(ad.SupportedKerberosEncryptionTypes.String(), aesOnly).Set(common.PasswordLastSet.String(), recentSet),
+			expected:   0.2,
+		},
+		{
+			name:       "AES only with a recent password that never expires",
+			properties: graph.NewProperties().Set(ad.SupportedKerberosEncryptionTypes.String(), aesOnly).Set(common.PasswordLastSet.String(), recentSet).Set(ad.PasswordNeverExpires.String(), true),
+			expected:   0.3,
+		},
+		{
+			name:       "Password never set",
+			properties: graph.NewProperties().Set(ad.SupportedKerberosEncryptionTypes.String(), aesOnly).Set(common.PasswordLastSet.String(), 0),
+			expected:   0.4,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			user := graph.NewNode(1, testCase.properties, ad.Entity, ad.User)
+			assert.Equal(t, testCase.expected, ad2.RoastCrackability(user, now))
+		})
+	}
+}
+
+func TestCrackabilityCost(t *testing.T) {
+	var (
+		easyRoast = &graph.Relationship{Kind: ad.Kerberoastable, Properties: graph.NewProperties().Set(ad.Crackability.String(), 1.0)}
+		hardRoast = &graph.Relationship{Kind: ad.Kerberoastable, Properties: graph.NewProperties().Set(ad.Crackability.String(), 0.25)}
+		unscored  = &graph.Relationship{Kind: ad.Kerberoastable}
+	)
+
+	assert.Equal(t, float64(2), ad2.CrackabilityCost(easyRoast, 2))
+	assert.Equal(t, float64(8), ad2.CrackabilityCost(hardRoast, 2))
+	assert.Equal(t, float64(2), ad2.CrackabilityCost(unscored, 2))
+}
@@ -0,0 +1,267 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ad
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/specterops/bloodhound/packages/go/analysis/post"
+	"github.com/specterops/bloodhound/packages/go/bhlog/attr"
+	"github.com/specterops/bloodhound/packages/go/bhlog/measure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/dawgs/cardinality"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/ops"
+	"github.com/specterops/dawgs/query"
+	"github.com/specterops/dawgs/util/channels"
+)
+
+// TrustedDomain is a domain whose principals are accepted by a trusting domain, along with the trust edge that grants
+// this. The trust edge is nil when the domains only trust each other transitively through their forest.
+type TrustedDomain struct {
+	Domain                  *graph.Node
+	Trust                   *graph.Relationship
+	SelectiveAuthentication bool
+}
+
+// PostForeignMemberOf creates ForeignMemberOf edges from a trusted domain to each group of a trusting domain that has
+// members from the trusted domain. Compromising the trusted domain grants control of those members and therefore the
+// privileges of the group. When the trust uses selective authentication the edge is only created if a foreign member,
+// or the group itself, is granted AllowedToAuthenticate on a computer of the trusting domain.
+func PostForeignMemberOf(ctx context.Context, db graph.Database) (*post.AtomicPostProcessingStats, error) {
+	defer measure.ContextLogAndMeasure(
+		ctx,
+		slog.LevelInfo,
+		"Post-processing ForeignMemberOf",
+		attr.Namespace("analysis"),
+		attr.Function("PostForeignMemberOf"),
+		attr.Scope("process"),
+	)()
+
+	domainNodes, err := fetchCollectedDomainNodes(ctx, db)
+	if err != nil {
+		return &post.AtomicPostProcessingStats{}, err
+	}
+
+	operation := post.NewPostRelationshipOperation(ctx, db, "ForeignMemberOf Post Processing")
+
+	for _, domain := range domainNodes {
+		innerDomain := domain
+
+		operation.Operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, outC chan<- post.EnsureRelationshipJob) error {
+			if domainSID, err := innerDomain.Properties.Get(ad.DomainSID.String()).String(); err != nil {
+				slog.DebugContext(
+					ctx,
+					"Skipping domain. Missing DomainSID property",
+					slog.Uint64("domain_id", uint64(innerDomain.ID)),
+				)
+				return nil
+			} else if trustedDomains, err := FetchTrustedDomains(tx, innerDomain); err != nil {
+				return err
+			} else if len(trustedDomains) == 0 {
+				return nil
+			} else if foreignMemberships, err := fetchForeignMemberships(tx, domainSID); err != nil {
+				return err
+			} else if len(foreignMemberships) == 0 {
+				return nil
+			} else if authenticators, err := fetchAllowedToAuthenticatePrincipals(tx, domainSID); err != nil {
+				return err
+			} else {
+				for _, job := range foreignMemberOfJobs(trustedDomains, foreignMemberships, authenticators) {
+					if !channels.Submit(ctx, outC, job) {
+						return nil
+					}
+				}
+
+				return nil
+			}
+		})
+	}
+
+	return &operation.Stats, operation.Done()
+}
+
+// foreignMemberOfJobs groups the foreign memberships of a trusting domain by trusted domain and group. Each
+// membership that is usable across its trust contributes the member and its MemberOf edge to the group's composition.
+func foreignMemberOfJobs(trustedDomains map[string]TrustedDomain, foreignMemberships graph.PathSet, authenticators cardinality.Duplex[uint64]) []post.EnsureRelationshipJob {
+	var (
+		jobs    []post.EnsureRelationshipJob
+		indexed = map[[2]graph.ID]int{}
+	)
+
+	for _, membership := range foreignMemberships {
+		var (
+			member = membership.Root()
+			group  = membership.Terminal()
+		)
+
+		if memberDomainSID, err := member.Properties.Get(ad.DomainSID.String()).String(); err != nil {
+			continue
+		} else if trustedDomain, trusted := trustedDomains[memberDomainSID]; !trusted {
+			continue
+		} else if trustedDomain.SelectiveAuthentication && !authenticators.Contains(member.ID.Uint64()) && !authenticators.Contains(group.ID.Uint64()) {
+			continue
+		} else {
+			key := [2]graph.ID{trustedDomain.Domain.ID, group.ID}
+
+			if idx, seen := indexed[key]; seen {
+				jobs[idx].Composition.AddNodes(member.ID).AddEdges(membership.Edges[0].ID)
+			} else {
+				composition := post.NewEdgeComposition(CompositionRuleForeignMemberOf, member.ID).AddEdges(membership.Edges[0].ID)
+
+				if trustedDomain.Trust != nil {
+					composition.AddEdges(trustedDomain.Trust.ID)
+				}
+
+				indexed[key] = len(jobs)
+				jobs = append(jobs, post.EnsureRelationshipJob{
+					FromID:      trustedDomain.Domain.ID,
+					ToID:        group.ID,
+					Kind:        ad.ForeignMemberOf,
+					Composition: composition,
+				})
+			}
+		}
+	}
+
+	return jobs
+}
+
+// FetchTrustedDomains returns the domains trusted by the given domain keyed by their domain SID. Direct trusts are read
+// from the domain's outbound trust edges, a transitive cross forest trust extends to every domain of the trusted forest
+// and every other domain of the same forest is trusted transitively.
+func FetchTrustedDomains(tx graph.Transaction, domain *graph.Node) (map[string]TrustedDomain, error) {
+	trustedDomains := map[string]TrustedDomain{}
+
+	if directTrusts, err := ops.FetchPathSet(tx.Relationships().Filterf(func() graph.Criteria {
+		return query.And(
+			query.Equals(query.StartID(), domain.ID),
+			query.KindIn(query.Relationship(), ad.SameForestTrust, ad.CrossForestTrust),
+			query.Kind(query.End(), ad.Domain),
+		)
+	})); err != nil {
+		return nil, err
+	} else if forestDomains, err := FetchNodesWithSameForestTrustRelationship(tx, domain); err != nil {
+		return nil, err
+	} else {
+		var transitiveForestTrusts []TrustedDomain
+
+		for _, directTrust := range directTrusts {
+			var (
+				trust         = directTrust.Edges[0]
+				trustedDomain = directTrust.Terminal()
+			)
+
+			if trustedDomainSID, err := trustedDomain.Properties.Get(ad.DomainSID.String()).String(); err != nil {
+				continue
+			} else {
+				var (
+					selectiveAuthentication, _ = trust.Properties.GetOrDefault(ad.SelectiveAuthentication.String(), false).Bool()
+					transitive, _              = trust.Properties.GetOrDefault(ad.Transitive.String(), false).Bool()
+					trusted                    = TrustedDomain{
+						Domain:                  trustedDomain,
+						Trust:                   trust,
+						SelectiveAuthentication: selectiveAuthentication,
+					}
+				)
+
+				trustedDomains[trustedDomainSID] = trusted
+
+				if trust.Kind.Is(ad.CrossForestTrust) && transitive {
+					transitiveForestTrusts = append(transitiveForestTrusts, trusted)
+				}
+			}
+		}
+
+		// A transitive forest trust extends to every domain of the trusted forest
+		for _, forestTrust := range transitiveForestTrusts {
+			if trustedForestDomains, err := FetchNodesWithSameForestTrustRelationship(tx, forestTrust.Domain); err != nil {
+				return nil, err
+			} else {
+				for _, trustedForestDomain := range trustedForestDomains {
+					if trustedForestDomainSID, err := trustedForestDomain.Properties.Get(ad.DomainSID.String()).String(); err != nil {
+						continue
+					} else if _, alreadyTrusted := trustedDomains[trustedForestDomainSID]; !alreadyTrusted {
+						trustedDomains[trustedForestDomainSID] = TrustedDomain{
+							Domain:                  trustedForestDomain,
+							Trust:                   forestTrust.Trust,
+							SelectiveAuthentication: forestTrust.SelectiveAuthentication,
+						}
+					}
+				}
+			}
+		}
+
+		for _, forestDomain := range forestDomains {
+			if forestDomain.ID == domain.ID {
+				continue
+			} else if forestDomainSID, err := forestDomain.Properties.Get(ad.DomainSID.String()).String(); err != nil {
+				continue
+			} else if _, hasDirectTrust := trustedDomains[forestDomainSID]; !hasDirectTrust {
+				trustedDomains[forestDomainSID] = TrustedDomain{
+					Domain: forestDomain,
+				}
+			}
+		}
+
+		return trustedDomains, nil
+	}
+}
+
+// fetchForeignMemberships returns the MemberOf edges from principals of other domains to groups of the given domain
+func fetchForeignMemberships(tx graph.Transaction, domainSID string) (graph.PathSet, error) {
+	return ops.FetchPathSet(tx.Relationships().Filterf(func() graph.Criteria {
+		return query.And(
+			query.KindIn(query.Start(), ad.User, ad.Computer, ad.Group),
+			query.Kind(query.Relationship(), ad.MemberOf),
+			query.Kind(query.End(), ad.Group),
+			query.Equals(query.EndProperty(ad.DomainSID.String()), domainSID),
+			query.Not(query.Equals(query.StartProperty(ad.DomainSID.String()), domainSID)),
+		)
+	}))
+}
+
+// fetchAllowedToAuthenticatePrincipals returns the IDs of principals granted AllowedToAuthenticate on at least one
+// computer of the given domain, either directly or through membership of a granted group
+func fetchAllowedToAuthenticatePrincipals(tx graph.Transaction, domainSID string) (cardinality.Duplex[uint64], error) {
+	principals := cardinality.NewBitmap64()
+
+	if grantees, err := ops.FetchStartNodes(tx.Relationships().Filterf(func() graph.Criteria {
+		return query.And(
+			query.Kind(query.Relationship(), ad.AllowedToAuthenticate),
+			query.Kind(query.End(), ad.Computer),
+			query.Equals(query.EndProperty(ad.DomainSID.String()), domainSID),
+		)
+	})); err != nil {
+		return nil, err
+	} else {
+		for _, grantee := range grantees {
+			principals.Add(grantee.ID.Uint64())
+
+			if grantee.Kinds.ContainsOneOf(ad.Group) {
+				if members, err := ExpandGroupMembershipIDBitmap(tx, grantee); err != nil {
+					return nil, err
+				} else {
+					principals.Or(members)
+				}
+			}
+		}
+
+		return principals, nil
+	}
+}
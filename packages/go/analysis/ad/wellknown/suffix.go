@@ -71,6 +71,7 @@ var (
 	ClaimsValidSIDSuffix                             = NewSIDSuffix("-497")
 	AdministratorAccountSIDSuffix                    = NewSIDSuffix("-500")
 	GuestSIDSuffix                                   = NewSIDSuffix("-501")
+	KRBTGTAccountSIDSuffix                           = NewSIDSuffix("-502")
 	DomainAdminsGroupSIDSuffix                       = NewSIDSuffix("-512")
 	DomainUsersSIDSuffix                             = NewSIDSuffix("-513")
 	DomainComputersSIDSuffix                         = NewSIDSuffix("-515")
@@ -184,6 +184,34 @@ func FetchNodesFromSeeds(ctx context.Context, agtParameters appcfg.AGTParameters
 						seedNodes.AddIfNotExists(nodeWithSrc)
 					}
 				}
+			case model.SelectorTypeProperty:
+				if selector, err := model.ParsePropertySelector(seed.Value); err != nil {
+					slog.WarnContext(
+						ctx,
+						"AGT: Invalid property selector",
+						slog.String("property_selector", seed.Value),
+						attr.Error(err),
+					)
+					errs = append(errs, err)
+				} else if nodes, err := ops.FetchNodes(limitNodeQuery(tx.Nodes().Filter(selector.Criteria()), limit)); err != nil {
+					slog.WarnContext(
+						ctx,
+						"AGT: Fetch Property Selector Err",
+						slog.String("property_selector", seed.Value),
+						attr.Error(err),
+					)
+					errs = append(errs, err)
+				} else {
+					for _, node := range nodes {
+						nodeWithSrc := &nodeWithSource{Source: model.AssetGroupSelectorNodeSourceSeed, Node: node}
+						if result.AddIfNotExists(nodeWithSrc) {
+							if result.LimitReached(limit) {
+								return nil
+							}
+						}
+						seedNodes.AddIfNotExists(nodeWithSrc)
+					}
+				}
 			default:
 				slog.WarnContext(
 					ctx,
@@ -223,6 +251,15 @@ func FetchNodesFromSeeds(ctx context.Context, agtParameters appcfg.AGTParameters
 	return result, errs
 }
 
+// limitNodeQuery applies the selection limit to a node query. A limit of zero or less is unlimited.
+func limitNodeQuery(nodeQuery graph.NodeQuery, limit int) graph.NodeQuery {
+	if limit > 0 {
+		return nodeQuery.Limit(limit)
+	}
+
+	return nodeQuery
+}
+
 // fetchChildNodes - fetches all children for a single node and submits any found to supplied collector ch
 func fetchChildNodes(ctx context.Context, tx traversal.Traversal, node *graph.Node, ch chan<- *nodeWithSource) error {
 	var pattern traversal.PatternContinuation
@@ -1027,32 +1064,6 @@ func ClearAssetGroupTagNodeSet(ctx context.Context, graphDb graph.Database, asse
 	return nil
 }
 
-// ClearAssetGroupHistoryRecords Truncate the asset group history table to the rolling window
-func ClearAssetGroupHistoryRecords(ctx context.Context, db database.Database) {
-	defer measure.ContextMeasure(
-		ctx,
-		slog.LevelInfo,
-		"Clear Asset Group History",
-		attr.Namespace("analysis"),
-		attr.Function("ClearAssetGroupHistoryRecords"),
-		attr.Scope("step"),
-	)()
-	if recordsDeletedCount, err := db.DeleteAssetGroupHistoryRecordsByCreatedDate(ctx, time.Now().UTC().AddDate(0, 0, -1*model.AssetGroupHistoryRecordRollingWindow)); err != nil {
-		slog.WarnContext(
-			ctx,
-			"AGT: ClearAssetGroupHistoryRecords error",
-			slog.String("count_deleted", strconv.FormatInt(recordsDeletedCount, 10)),
-			attr.Error(err),
-		)
-	} else {
-		slog.InfoContext(
-			ctx,
-			"AGT: ClearAssetGroupHistoryRecords",
-			slog.String("count_deleted", strconv.FormatInt(recordsDeletedCount, 10)),
-		)
-	}
-}
-
 func migrateCustomObjectIdSelectorNames(ctx context.Context, db database.Database, graphDb graph.Database) error {
 	if selectorsToMigrate, err := db.GetCustomAssetGroupTagSelectorsToMigrate(ctx); err != nil {
 		return err
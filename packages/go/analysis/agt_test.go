@@ -91,6 +91,27 @@ func TestAGT_FetchNodesFromSeeds_Expansions(t *testing.T) {
 		require.Equal(t, result[testContext.Harness.GPOEnforcement.OrganizationalUnitA.ID].Source, model.AssetGroupSelectorNodeSourceParent)
 	})
 
+	t.Run("FetchNodesFromSeeds with a property selector and no expansion", func(t *testing.T) {
+		propertySeeds := []model.SelectorSeed{{
+			Type:  model.SelectorTypeProperty,
+			Value: fmt.Sprintf(`{"kind":"%s","predicates":[{"property":"%s","operator":"eq","value":"%s"}]}`, ad.OU, common.ObjectID, seedObjectId),
+		}}
+
+		result, errs := FetchNodesFromSeeds(testCtx, agtParameters, testContext.Graph.Database, propertySeeds, model.AssetGroupExpansionMethodNone, -1)
+		require.Empty(t, errs)
+		require.Len(t, result, 1)
+		require.Equal(t, result[testContext.Harness.GPOEnforcement.OrganizationalUnitC.ID].Source, model.AssetGroupSelectorNodeSourceSeed)
+	})
+
+	t.Run("FetchNodesFromSeeds with an invalid property selector", func(t *testing.T) {
+		propertySeeds := []model.SelectorSeed{{Type: model.SelectorTypeProperty, Value: `{"kind":"OU"}`}}
+
+		result, errs := FetchNodesFromSeeds(testCtx, agtParameters, testContext.Graph.Database, propertySeeds, model.AssetGroupExpansionMethodNone, -1)
+		require.Len(t, errs, 1)
+		require.ErrorIs(t, errs[0], model.ErrInvalidPropertySelector)
+		require.Empty(t, result)
+	})
+
 	t.Run("FetchNodesFromSeeds with all expansions with limit for seeds only", func(t *testing.T) {
 		result, errs := FetchNodesFromSeeds(testCtx, agtParameters, testContext.Graph.Database, seeds, model.AssetGroupExpansionMethodAll, 1)
 		require.Empty(t, errs)
@@ -28,7 +28,9 @@ import (
 	"github.com/specterops/bloodhound/cmd/api/src/model"
 	"github.com/specterops/bloodhound/cmd/api/src/model/appcfg"
 	"github.com/specterops/bloodhound/cmd/api/src/services/agi"
+	"github.com/specterops/bloodhound/cmd/api/src/services/chokepoint"
 	"github.com/specterops/bloodhound/cmd/api/src/services/dataquality"
+	"github.com/specterops/bloodhound/cmd/api/src/services/exposure"
 	adAnalysis "github.com/specterops/bloodhound/packages/go/analysis/ad"
 	azureAnalysis "github.com/specterops/bloodhound/packages/go/analysis/azure"
 	"github.com/specterops/bloodhound/packages/go/bhlog/attr"
@@ -126,6 +128,7 @@ func ExpandGroupMembershipPaths(tx graph.Transaction, candidates graph.NodeSet)
 var (
 	ErrAnalysisFailed             = errors.New("analysis failed")
 	ErrAnalysisPartiallyCompleted = errors.New("analysis partially completed")
+	ErrTaggingPausedByDataQuality = errors.New("tagging paused by data quality threshold violation")
 )
 
 // pipelineStepStatus is used for per-step run logging.
@@ -153,12 +156,17 @@ type analysisErrors struct {
 	agt         bool
 	agtPartial  bool
 	dataQuality bool
+	// taggingPaused is set when a data quality threshold violation requires tagging, and the steps scored against
+	// the tagged Tier Zero members, to be skipped for this run.
+	taggingPaused      bool
+	chokePoints        bool
+	principalExposures bool
 }
 
 func (s *analysisErrors) evaluateErrors() error {
 	if s.adPost && s.azurePost && s.agt && s.dataQuality {
 		return ErrAnalysisFailed
-	} else if s.adPost || s.azurePost || s.agt || s.agtPartial || s.dataQuality {
+	} else if s.adPost || s.azurePost || s.agt || s.agtPartial || s.dataQuality || s.taggingPaused || s.chokePoints || s.principalExposures {
 		return ErrAnalysisPartiallyCompleted
 	}
 
@@ -324,6 +332,10 @@ func azurePostProcessingOperation(run analysisPipelineRun) (pipelineStepStatus,
 
 // TODO Cleanup tieringEnabled after Tiering GA
 func taggingOperation(run analysisPipelineRun) (pipelineStepStatus, []error) {
+	if run.analysisErrs.taggingPaused {
+		return pipelineStepStatusSkipped, []error{ErrTaggingPausedByDataQuality}
+	}
+
 	var (
 		collectedErrors []error
 		status          pipelineStepStatus = pipelineStepStatusSuccess
@@ -364,12 +376,67 @@ func dataQualityOperation(run analysisPipelineRun) (pipelineStepStatus, []error)
 		return pipelineStepStatusFailed, collectedErrors
 	}
 
+	if violations, err := dataquality.EvaluateThresholds(run.ctx, run.db); err != nil {
+		collectedErrors = append(collectedErrors, fmt.Errorf("error evaluating data quality thresholds: %v", err))
+		run.analysisErrs.dataQuality = true
+		return pipelineStepStatusFailed, collectedErrors
+	} else if violations.PausesTagging() {
+		run.analysisErrs.taggingPaused = true
+	}
+
+	return pipelineStepStatusSuccess, collectedErrors
+}
+
+// tierZeroUnavailable reports whether the Tier Zero members tagged by this run are unavailable to the steps scored
+// against them, either because tagging did not run, was paused or failed. The pause and the failure are already
+// reported by the tagging step, so dependent steps skip without an error.
+func tierZeroUnavailable(run analysisPipelineRun) bool {
+	return !run.analysisSteps.Has(model.AnalysisStepTagging()) || run.analysisErrs.taggingPaused || run.analysisErrs.agt
+}
+
+// chokePointOperation only runs alongside tagging, since choke points are scored against the tagged Tier Zero members
+func chokePointOperation(run analysisPipelineRun) (pipelineStepStatus, []error) {
+	var collectedErrors []error
+
+	if tierZeroUnavailable(run) {
+		return pipelineStepStatusSkipped, collectedErrors
+	}
+
+	if err := chokepoint.SaveChokePoints(run.ctx, run.db, run.graphDB); err != nil {
+		collectedErrors = append(collectedErrors, fmt.Errorf("error saving attack path choke points: %w", err))
+		run.analysisErrs.chokePoints = true
+		return pipelineStepStatusFailed, collectedErrors
+	}
+
+	return pipelineStepStatusSuccess, collectedErrors
+}
+
+// principalExposureOperation only runs alongside tagging, since impact is scored against the tagged Tier Zero members
+func principalExposureOperation(run analysisPipelineRun) (pipelineStepStatus, []error) {
+	var collectedErrors []error
+
+	if tierZeroUnavailable(run) {
+		return pipelineStepStatusSkipped, collectedErrors
+	}
+
+	if err := exposure.SavePrincipalExposures(run.ctx, run.db, run.graphDB); err != nil {
+		collectedErrors = append(collectedErrors, fmt.Errorf("error saving principal exposures: %w", err))
+		run.analysisErrs.principalExposures = true
+		return pipelineStepStatusFailed, collectedErrors
+	}
+
 	return pipelineStepStatusSuccess, collectedErrors
 }
 
-const DataQuality = "data_quality"
+const (
+	DataQuality        = "data_quality"
+	ChokePoints        = "choke_points"
+	PrincipalExposures = "principal_exposures"
+)
 
-// The definition of our analysis pipeline
+// The definition of our analysis pipeline. Data quality runs ahead of tagging so that a regressed collection can
+// pause tagging before it is applied to an incomplete graph. Choke points and principal exposures run last since they
+// are scored against the Tier Zero members tagged by the tagging step.
 func newPipeline() analysisPipeline {
 	return analysisPipeline{
 		{
@@ -380,13 +447,21 @@ func newPipeline() analysisPipeline {
 			analysisStep: model.AnalysisStepAzurePostProcessing(),
 			operation:    azurePostProcessingOperation,
 		},
+		{
+			name:      DataQuality,
+			operation: dataQualityOperation,
+		},
 		{
 			analysisStep: model.AnalysisStepTagging(),
 			operation:    taggingOperation,
 		},
 		{
-			name:      DataQuality,
-			operation: dataQualityOperation,
+			name:      ChokePoints,
+			operation: chokePointOperation,
+		},
+		{
+			name:      PrincipalExposures,
+			operation: principalExposureOperation,
 		},
 	}
 }
@@ -297,6 +297,27 @@ func TestAnalysisErrorsEvaluateErrors(t *testing.T) {
 			},
 			expectedErr: ErrAnalysisPartiallyCompleted,
 		},
+		{
+			name: "choke point failure partially completes",
+			errs: analysisErrors{
+				chokePoints: true,
+			},
+			expectedErr: ErrAnalysisPartiallyCompleted,
+		},
+		{
+			name: "principal exposure failure partially completes",
+			errs: analysisErrors{
+				principalExposures: true,
+			},
+			expectedErr: ErrAnalysisPartiallyCompleted,
+		},
+		{
+			name: "tagging paused by data quality partially completes",
+			errs: analysisErrors{
+				taggingPaused: true,
+			},
+			expectedErr: ErrAnalysisPartiallyCompleted,
+		},
 	} {
 		t.Run(testCase.name, func(t *testing.T) {
 			t.Parallel()
@@ -312,6 +333,97 @@ func TestAnalysisErrorsEvaluateErrors(t *testing.T) {
 	}
 }
 
+func TestTaggingOperationPausedByDataQuality(t *testing.T) {
+	t.Parallel()
+
+	status, errs := taggingOperation(analysisPipelineRun{
+		ctx:          context.Background(),
+		analysisErrs: &analysisErrors{taggingPaused: true},
+	})
+
+	assert.Equal(t, pipelineStepStatusSkipped, status)
+	require.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[0], ErrTaggingPausedByDataQuality)
+}
+
+func TestChokePointOperationSkippedWithoutTagging(t *testing.T) {
+	t.Parallel()
+
+	status, errs := chokePointOperation(analysisPipelineRun{
+		ctx:           context.Background(),
+		analysisSteps: model.AnalysisSteps{},
+		analysisErrs:  &analysisErrors{},
+	})
+
+	assert.Equal(t, pipelineStepStatusSkipped, status)
+	assert.Empty(t, errs)
+}
+
+func TestChokePointOperationPausedByDataQuality(t *testing.T) {
+	t.Parallel()
+
+	status, errs := chokePointOperation(analysisPipelineRun{
+		ctx:           context.Background(),
+		analysisSteps: model.AnalysisStepsFull(),
+		analysisErrs:  &analysisErrors{taggingPaused: true},
+	})
+
+	assert.Equal(t, pipelineStepStatusSkipped, status)
+	assert.Empty(t, errs)
+}
+
+func TestChokePointOperationSkippedAfterTaggingFailure(t *testing.T) {
+	t.Parallel()
+
+	status, errs := chokePointOperation(analysisPipelineRun{
+		ctx:           context.Background(),
+		analysisSteps: model.AnalysisStepsFull(),
+		analysisErrs:  &analysisErrors{agt: true},
+	})
+
+	assert.Equal(t, pipelineStepStatusSkipped, status)
+	assert.Empty(t, errs)
+}
+
+func TestPrincipalExposureOperationPausedByDataQuality(t *testing.T) {
+	t.Parallel()
+
+	status, errs := principalExposureOperation(analysisPipelineRun{
+		ctx:           context.Background(),
+		analysisSteps: model.AnalysisStepsFull(),
+		analysisErrs:  &analysisErrors{taggingPaused: true},
+	})
+
+	assert.Equal(t, pipelineStepStatusSkipped, status)
+	assert.Empty(t, errs)
+}
+
+func TestPrincipalExposureOperationSkippedAfterTaggingFailure(t *testing.T) {
+	t.Parallel()
+
+	status, errs := principalExposureOperation(analysisPipelineRun{
+		ctx:           context.Background(),
+		analysisSteps: model.AnalysisStepsFull(),
+		analysisErrs:  &analysisErrors{agt: true},
+	})
+
+	assert.Equal(t, pipelineStepStatusSkipped, status)
+	assert.Empty(t, errs)
+}
+
+func TestPrincipalExposureOperationSkippedWithoutTagging(t *testing.T) {
+	t.Parallel()
+
+	status, errs := principalExposureOperation(analysisPipelineRun{
+		ctx:           context.Background(),
+		analysisSteps: model.AnalysisSteps{},
+		analysisErrs:  &analysisErrors{},
+	})
+
+	assert.Equal(t, pipelineStepStatusSkipped, status)
+	assert.Empty(t, errs)
+}
+
 func TestAnalysisErrorsCoversPipelineSteps(t *testing.T) {
 	t.Parallel()
 
@@ -321,6 +433,8 @@ func TestAnalysisErrorsCoversPipelineSteps(t *testing.T) {
 			"azure_post_processing": analysisErrorCoverageClassified,
 			"tagging":               analysisErrorCoverageClassified,
 			DataQuality:             analysisErrorCoverageClassified,
+			ChokePoints:             analysisErrorCoverageClassified,
+			PrincipalExposures:      analysisErrorCoverageClassified,
 		}
 		allowedCoverageValues = map[analysisErrorCoverage]struct{}{
 			analysisErrorCoverageClassified: {},
@@ -0,0 +1,270 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/cardinality"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/ops"
+	"github.com/specterops/dawgs/query"
+)
+
+// AdministrativeUnitRoleAssignments holds the directory role assignments scoped to a single administrative unit
+type AdministrativeUnitRoleAssignments struct {
+	AdministrativeUnit *graph.Node
+	Members            graph.NodeKindSet
+
+	// MemberManagementRestricted is set for restricted management administrative units, whose members may only be
+	// managed by principals holding a role scoped to the administrative unit
+	MemberManagementRestricted bool
+
+	// RoleMap maps role template IDs to the users and service principals that hold the role within the
+	// administrative unit
+	RoleMap map[string]cardinality.Duplex[uint64]
+
+	// RoleGrants records the AZHasScopedRole and AZMemberOf edges through which each principal in RoleMap holds its role
+	RoleGrants RoleGrants
+}
+
+// PrincipalsWithRole returns a roaring bitmap of principals that have been assigned one or more of the matching roles
+// within the administrative unit
+func (s AdministrativeUnitRoleAssignments) PrincipalsWithRole(roleTemplateIDs ...string) cardinality.Duplex[uint64] {
+	result := cardinality.NewBitmap64()
+	for _, roleTemplateID := range roleTemplateIDs {
+		if bitmap, ok := s.RoleMap[roleTemplateID]; ok {
+			result.Or(bitmap)
+		}
+	}
+	return result
+}
+
+func (s AdministrativeUnitRoleAssignments) Users() cardinality.Duplex[uint64] {
+	return s.Members.Get(azure.User).IDBitmap()
+}
+
+// TenantAdministrativeUnits returns the NodeSet of administrative units contained by the given tenant
+func TenantAdministrativeUnits(tx graph.Transaction, tenant *graph.Node) (graph.NodeSet, error) {
+	if !IsTenantNode(tenant) {
+		return nil, fmt.Errorf("node %d must contain kind %s", tenant.ID, azure.Tenant)
+	} else {
+		return ops.FetchEndNodes(tx.Relationships().Filterf(func() graph.Criteria {
+			return query.And(
+				query.Equals(query.StartID(), tenant.ID),
+				query.Kind(query.Relationship(), azure.Contains),
+				query.Kind(query.End(), azure.AdministrativeUnit),
+			)
+		}))
+	}
+}
+
+// AdministrativeUnitMembers returns the NodeSet of users, groups and devices contained by the given administrative unit
+func AdministrativeUnitMembers(tx graph.Transaction, administrativeUnit *graph.Node) (graph.NodeSet, error) {
+	return ops.FetchEndNodes(tx.Relationships().Filterf(func() graph.Criteria {
+		return query.And(
+			query.Equals(query.StartID(), administrativeUnit.ID),
+			query.Kind(query.Relationship(), azure.Contains),
+			query.KindIn(query.End(), azure.User, azure.Group, azure.Device),
+		)
+	}))
+}
+
+// isMemberManagementRestricted reports whether the administrative unit is a restricted management administrative unit
+func isMemberManagementRestricted(administrativeUnit *graph.Node) bool {
+	restricted, err := administrativeUnit.Properties.Get(azure.IsMemberManagementRestricted.String()).Bool()
+	return err == nil && restricted
+}
+
+// restrictedManagementMembers returns the members of the restricted management administrative units of the given
+// tenant. Tenant-wide roles and roles scoped to other administrative units do not grant control over these members.
+func restrictedManagementMembers(tx graph.Transaction, tenant *graph.Node) (cardinality.Duplex[uint64], error) {
+	result := cardinality.NewBitmap64()
+
+	if administrativeUnits, err := TenantAdministrativeUnits(tx, tenant); err != nil {
+		return nil, err
+	} else {
+		for _, administrativeUnit := range administrativeUnits {
+			if !isMemberManagementRestricted(administrativeUnit) {
+				continue
+			} else if members, err := AdministrativeUnitMembers(tx, administrativeUnit); err != nil {
+				return nil, err
+			} else {
+				result.Or(members.IDBitmap())
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// fetchScopedRoleAssignments returns the AZHasScopedRole edges that assign the given role within an administrative unit
+func fetchScopedRoleAssignments(tx graph.Transaction, role *graph.Node) ([]*graph.Relationship, error) {
+	return ops.FetchRelationships(tx.Relationships().Filterf(func() graph.Criteria {
+		return query.And(
+			query.Equals(query.EndID(), role.ID),
+			query.Kind(query.Relationship(), azure.AZHasScopedRole),
+		)
+	}))
+}
+
+// scopedRoleHolders returns the users and service principals that hold the given role within any administrative unit
+func scopedRoleHolders(tx graph.Transaction, role *graph.Node) (cardinality.Duplex[uint64], error) {
+	result := cardinality.NewBitmap64()
+
+	if scopedAssignments, err := fetchScopedRoleAssignments(tx, role); err != nil {
+		return nil, err
+	} else {
+		for _, scopedAssignment := range scopedAssignments {
+			if principals, _, err := scopedRolePrincipals(tx, scopedAssignment); err != nil {
+				return nil, err
+			} else {
+				result.Or(principals)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// scopedRolePrincipals returns the users and service principals that hold a role through the given scoped
+// assignment along with the edges through which each of them holds it. Role assignable groups pass the assignment on
+// to their direct members.
+func scopedRolePrincipals(tx graph.Transaction, assignment *graph.Relationship) (cardinality.Duplex[uint64], map[graph.ID][]graph.ID, error) {
+	var (
+		result = cardinality.NewBitmap64()
+		grants = map[graph.ID][]graph.ID{}
+	)
+
+	if principal, err := ops.FetchNode(tx, assignment.StartID); err != nil {
+		return nil, nil, err
+	} else if principal.Kinds.ContainsOneOf(azure.User, azure.ServicePrincipal) {
+		result.Add(principal.ID.Uint64())
+		grants[principal.ID] = []graph.ID{assignment.ID}
+	} else if principal.Kinds.ContainsOneOf(azure.Group) {
+		if memberships, err := ops.FetchRelationships(tx.Relationships().Filterf(func() graph.Criteria {
+			return query.And(
+				query.Equals(query.EndID(), principal.ID),
+				query.Kind(query.Relationship(), azure.MemberOf),
+				query.KindIn(query.Start(), azure.User, azure.ServicePrincipal),
+			)
+		})); err != nil {
+			return nil, nil, err
+		} else {
+			for _, membership := range memberships {
+				result.Add(membership.StartID.Uint64())
+				grants[membership.StartID] = append(grants[membership.StartID], membership.ID, assignment.ID)
+			}
+		}
+	}
+
+	return result, grants, nil
+}
+
+// FetchTenantAdministrativeUnitRoleAssignments returns the role assignments scoped to each administrative unit of the
+// given tenant. Administrative units without any scoped assignments are omitted.
+func FetchTenantAdministrativeUnitRoleAssignments(ctx context.Context, db graph.Database, tenant *graph.Node) ([]AdministrativeUnitRoleAssignments, error) {
+	var results []AdministrativeUnitRoleAssignments
+
+	if !IsTenantNode(tenant) {
+		return nil, fmt.Errorf("cannot fetch administrative unit role assignments - node %d must be of kind %s", tenant.ID, azure.Tenant)
+	}
+
+	if err := db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		administrativeUnits, err := TenantAdministrativeUnits(tx, tenant)
+		if err != nil {
+			return err
+		} else if administrativeUnits.Len() == 0 {
+			return nil
+		}
+
+		roles, err := TenantRoles(tx, tenant)
+		if err != nil {
+			return err
+		}
+
+		administrativeUnitsByObjectID := make(map[string]*AdministrativeUnitRoleAssignments, administrativeUnits.Len())
+		for _, administrativeUnit := range administrativeUnits {
+			if objectID, err := administrativeUnit.Properties.Get(common.ObjectID.String()).String(); err != nil {
+				slog.WarnContext(ctx, "Administrative unit is missing property", slog.Uint64("node_id", administrativeUnit.ID.Uint64()), slog.String("property", common.ObjectID.String()))
+			} else {
+				administrativeUnitsByObjectID[strings.ToUpper(objectID)] = &AdministrativeUnitRoleAssignments{
+					AdministrativeUnit:         administrativeUnit,
+					MemberManagementRestricted: isMemberManagementRestricted(administrativeUnit),
+					RoleMap:                    map[string]cardinality.Duplex[uint64]{},
+					RoleGrants:                 RoleGrants{},
+				}
+			}
+		}
+
+		for _, role := range roles {
+			roleTemplateID, err := role.Properties.Get(azure.RoleTemplateID.String()).String()
+			if err != nil {
+				if graph.IsErrPropertyNotFound(err) {
+					continue
+				}
+
+				return err
+			}
+
+			scopedAssignments, err := fetchScopedRoleAssignments(tx, role)
+			if err != nil {
+				return err
+			}
+
+			for _, scopedAssignment := range scopedAssignments {
+				if scope, err := scopedAssignment.Properties.Get(azure.Scope.String()).String(); err != nil {
+					slog.WarnContext(ctx, "Scoped role assignment is missing property", slog.Uint64("relationship_id", scopedAssignment.ID.Uint64()), slog.String("property", azure.Scope.String()))
+				} else if administrativeUnit, ok := administrativeUnitsByObjectID[strings.ToUpper(scope)]; !ok {
+					continue
+				} else if principals, grants, err := scopedRolePrincipals(tx, scopedAssignment); err != nil {
+					return err
+				} else {
+					if existing, ok := administrativeUnit.RoleMap[roleTemplateID]; ok {
+						existing.Or(principals)
+					} else {
+						administrativeUnit.RoleMap[roleTemplateID] = principals
+					}
+
+					administrativeUnit.RoleGrants.add(roleTemplateID, grants)
+				}
+			}
+		}
+
+		for _, administrativeUnit := range administrativeUnitsByObjectID {
+			if len(administrativeUnit.RoleMap) == 0 {
+				continue
+			} else if members, err := AdministrativeUnitMembers(tx, administrativeUnit.AdministrativeUnit); err != nil {
+				return err
+			} else {
+				administrativeUnit.Members = members.KindSet()
+				results = append(results, *administrativeUnit)
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
@@ -203,3 +203,29 @@ func NewAzureRole(t *testing.T, suite *IntegrationTestSuite, name, objectID, rol
 		azure.TenantID:       tenantID,
 	}), azure.Entity, azure.Role)
 }
+
+func NewAzureUser(t *testing.T, suite *IntegrationTestSuite, name, objectID, tenantID string) *graph.Node {
+	return NewNode(t, suite, graph.AsProperties(graph.PropertyMap{
+		common.Name:     name,
+		common.ObjectID: objectID,
+		azure.TenantID:  tenantID,
+	}), azure.Entity, azure.User)
+}
+
+func NewAzureGroup(t *testing.T, suite *IntegrationTestSuite, name, objectID, tenantID string, isAssignableToRole bool) *graph.Node {
+	return NewNode(t, suite, graph.AsProperties(graph.PropertyMap{
+		common.Name:              name,
+		common.ObjectID:          objectID,
+		azure.TenantID:           tenantID,
+		azure.IsAssignableToRole: isAssignableToRole,
+	}), azure.Entity, azure.Group)
+}
+
+func NewAzureAdministrativeUnit(t *testing.T, suite *IntegrationTestSuite, name, objectID, tenantID string, isMemberManagementRestricted bool) *graph.Node {
+	return NewNode(t, suite, graph.AsProperties(graph.PropertyMap{
+		common.Name:                        name,
+		common.ObjectID:                    objectID,
+		azure.TenantID:                     tenantID,
+		azure.IsMemberManagementRestricted: isMemberManagementRestricted,
+	}), azure.Entity, azure.AdministrativeUnit)
+}
@@ -1168,3 +1168,150 @@ func TestListEntityDescendents_NestedManagementGroupToSubscription(t *testing.T)
 	require.Equal(t, 1, len(nodes), "expected nested subscription to be returned as a descendent of mgRoot")
 	require.Contains(t, nodes.IDs(), nestedSubNode.ID)
 }
+
+// fetchEdgePairs returns the start and end node IDs of every relationship of the given kind
+func fetchEdgePairs(t *testing.T, suite *IntegrationTestSuite, kind graph.Kind) map[[2]graph.ID]struct{} {
+	pairs := map[[2]graph.ID]struct{}{}
+
+	require.NoError(t, suite.GraphDB.ReadTransaction(suite.Context, func(tx graph.Transaction) error {
+		return tx.Relationships().Filterf(func() graph.Criteria {
+			return query.Kind(query.Relationship(), kind)
+		}).Fetch(func(cursor graph.Cursor[*graph.Relationship]) error {
+			for relationship := range cursor.Chan() {
+				pairs[[2]graph.ID{relationship.StartID, relationship.EndID}] = struct{}{}
+			}
+
+			return cursor.Error()
+		})
+	}))
+
+	return pairs
+}
+
+func TestUserRoleAssignments_ScopedRoles(t *testing.T) {
+	t.Parallel()
+
+	suite := setupIntegrationTestSuite(t)
+	defer teardownIntegrationTestSuite(t, &suite)
+
+	var (
+		tenantID             = integration.RandomObjectID(t)
+		administrativeUnitID = integration.RandomObjectID(t)
+		scope                = graph.AsProperties(graph.PropertyMap{graphAzure.Scope: administrativeUnitID})
+
+		tenant             = NewAzureTenant(t, &suite, tenantID)
+		administrativeUnit = NewAzureAdministrativeUnit(t, &suite, "AU", administrativeUnitID, tenantID, false)
+		helpdeskRole       = NewAzureRole(t, &suite, "HelpdeskAdministrator", integration.RandomObjectID(t), graphAzure.HelpdeskAdministratorRole, tenantID)
+		privAuthAdminRole  = NewAzureRole(t, &suite, "PrivilegedAuthenticationAdministrator", integration.RandomObjectID(t), graphAzure.PrivilegedAuthenticationAdministratorRole, tenantID)
+		groupsAdminRole    = NewAzureRole(t, &suite, "GroupsAdministrator", integration.RandomObjectID(t), graphAzure.GroupsAdministratorRole, tenantID)
+
+		helpdeskAdmin     = NewAzureUser(t, &suite, "HelpdeskAdmin", integration.RandomObjectID(t), tenantID)
+		scopedPrivAuth    = NewAzureUser(t, &suite, "ScopedPrivAuthAdmin", integration.RandomObjectID(t), tenantID)
+		scopedGroupsAdmin = NewAzureUser(t, &suite, "ScopedGroupsAdmin", integration.RandomObjectID(t), tenantID)
+		administeredUser  = NewAzureUser(t, &suite, "AdministeredUser", integration.RandomObjectID(t), tenantID)
+		outsideUser       = NewAzureUser(t, &suite, "OutsideUser", integration.RandomObjectID(t), tenantID)
+		administeredGroup = NewAzureGroup(t, &suite, "AdministeredGroup", integration.RandomObjectID(t), tenantID, false)
+		outsideGroup      = NewAzureGroup(t, &suite, "OutsideGroup", integration.RandomObjectID(t), tenantID, false)
+	)
+
+	for _, node := range []*graph.Node{administrativeUnit, helpdeskRole, privAuthAdminRole, groupsAdminRole, helpdeskAdmin, scopedPrivAuth, scopedGroupsAdmin, administeredUser, outsideUser, administeredGroup, outsideGroup} {
+		NewRelationship(t, &suite, tenant, node, graphAzure.Contains)
+	}
+
+	NewRelationship(t, &suite, administrativeUnit, administeredUser, graphAzure.Contains)
+	NewRelationship(t, &suite, administrativeUnit, administeredGroup, graphAzure.Contains)
+	NewRelationship(t, &suite, helpdeskAdmin, helpdeskRole, graphAzure.HasRole)
+	NewRelationship(t, &suite, scopedPrivAuth, privAuthAdminRole, graphAzure.AZHasScopedRole, scope)
+	NewRelationship(t, &suite, scopedGroupsAdmin, groupsAdminRole, graphAzure.AZHasScopedRole, scope)
+
+	_, err := azure.UserRoleAssignments(context.Background(), suite.GraphDB)
+	require.NoError(t, err)
+
+	var (
+		resetPassword = fetchEdgePairs(t, &suite, graphAzure.ResetPassword)
+		addMembers    = fetchEdgePairs(t, &suite, graphAzure.AddMembers)
+	)
+
+	// A tenant-wide Helpdesk Administrator may only reset users without privileged roles, including scoped ones
+	assert.Contains(t, resetPassword, [2]graph.ID{helpdeskRole.ID, administeredUser.ID})
+	assert.Contains(t, resetPassword, [2]graph.ID{helpdeskRole.ID, outsideUser.ID})
+	assert.NotContains(t, resetPassword, [2]graph.ID{helpdeskRole.ID, scopedPrivAuth.ID})
+
+	// Scoped roles only grant control over members of the administrative unit
+	assert.Contains(t, resetPassword, [2]graph.ID{scopedPrivAuth.ID, administeredUser.ID})
+	assert.NotContains(t, resetPassword, [2]graph.ID{scopedPrivAuth.ID, outsideUser.ID})
+	assert.NotContains(t, resetPassword, [2]graph.ID{privAuthAdminRole.ID, administeredUser.ID})
+	assert.Contains(t, addMembers, [2]graph.ID{scopedGroupsAdmin.ID, administeredGroup.ID})
+	assert.NotContains(t, addMembers, [2]graph.ID{scopedGroupsAdmin.ID, outsideGroup.ID})
+}
+
+func TestUserRoleAssignments_RestrictedManagementAdministrativeUnits(t *testing.T) {
+	t.Parallel()
+
+	suite := setupIntegrationTestSuite(t)
+	defer teardownIntegrationTestSuite(t, &suite)
+
+	var (
+		tenantID        = integration.RandomObjectID(t)
+		openID          = integration.RandomObjectID(t)
+		restrictedID    = integration.RandomObjectID(t)
+		openScope       = graph.AsProperties(graph.PropertyMap{graphAzure.Scope: openID})
+		restrictedScope = graph.AsProperties(graph.PropertyMap{graphAzure.Scope: restrictedID})
+
+		tenant          = NewAzureTenant(t, &suite, tenantID)
+		openUnit        = NewAzureAdministrativeUnit(t, &suite, "OpenAU", openID, tenantID, false)
+		restrictedUnit  = NewAzureAdministrativeUnit(t, &suite, "RestrictedAU", restrictedID, tenantID, true)
+		helpdeskRole    = NewAzureRole(t, &suite, "HelpdeskAdministrator", integration.RandomObjectID(t), graphAzure.HelpdeskAdministratorRole, tenantID)
+		groupsAdminRole = NewAzureRole(t, &suite, "GroupsAdministrator", integration.RandomObjectID(t), graphAzure.GroupsAdministratorRole, tenantID)
+
+		tenantHelpdesk     = NewAzureUser(t, &suite, "TenantHelpdesk", integration.RandomObjectID(t), tenantID)
+		tenantGroupsAdmin  = NewAzureUser(t, &suite, "TenantGroupsAdmin", integration.RandomObjectID(t), tenantID)
+		openHelpdesk       = NewAzureUser(t, &suite, "OpenHelpdesk", integration.RandomObjectID(t), tenantID)
+		openGroupsAdmin    = NewAzureUser(t, &suite, "OpenGroupsAdmin", integration.RandomObjectID(t), tenantID)
+		restrictedHelpdesk = NewAzureUser(t, &suite, "RestrictedHelpdesk", integration.RandomObjectID(t), tenantID)
+		openUser           = NewAzureUser(t, &suite, "OpenUser", integration.RandomObjectID(t), tenantID)
+		restrictedUser     = NewAzureUser(t, &suite, "RestrictedUser", integration.RandomObjectID(t), tenantID)
+		openGroup          = NewAzureGroup(t, &suite, "OpenGroup", integration.RandomObjectID(t), tenantID, false)
+		restrictedGroup    = NewAzureGroup(t, &suite, "RestrictedGroup", integration.RandomObjectID(t), tenantID, false)
+	)
+
+	for _, node := range []*graph.Node{openUnit, restrictedUnit, helpdeskRole, groupsAdminRole, tenantHelpdesk, tenantGroupsAdmin, openHelpdesk, openGroupsAdmin, restrictedHelpdesk, openUser, restrictedUser, openGroup, restrictedGroup} {
+		NewRelationship(t, &suite, tenant, node, graphAzure.Contains)
+	}
+
+	// The restricted user and group are members of both administrative units
+	for _, member := range []*graph.Node{openUser, restrictedUser, openGroup, restrictedGroup} {
+		NewRelationship(t, &suite, openUnit, member, graphAzure.Contains)
+	}
+
+	NewRelationship(t, &suite, restrictedUnit, restrictedUser, graphAzure.Contains)
+	NewRelationship(t, &suite, restrictedUnit, restrictedGroup, graphAzure.Contains)
+	NewRelationship(t, &suite, tenantHelpdesk, helpdeskRole, graphAzure.HasRole)
+	NewRelationship(t, &suite, tenantGroupsAdmin, groupsAdminRole, graphAzure.HasRole)
+	NewRelationship(t, &suite, openHelpdesk, helpdeskRole, graphAzure.AZHasScopedRole, openScope)
+	NewRelationship(t, &suite, openGroupsAdmin, groupsAdminRole, graphAzure.AZHasScopedRole, openScope)
+	NewRelationship(t, &suite, restrictedHelpdesk, helpdeskRole, graphAzure.AZHasScopedRole, restrictedScope)
+
+	_, err := azure.UserRoleAssignments(context.Background(), suite.GraphDB)
+	require.NoError(t, err)
+
+	var (
+		resetPassword = fetchEdgePairs(t, &suite, graphAzure.ResetPassword)
+		addMembers    = fetchEdgePairs(t, &suite, graphAzure.AddMembers)
+	)
+
+	// Tenant-wide roles do not reach members of a restricted management administrative unit
+	assert.Contains(t, resetPassword, [2]graph.ID{helpdeskRole.ID, openUser.ID})
+	assert.NotContains(t, resetPassword, [2]graph.ID{helpdeskRole.ID, restrictedUser.ID})
+	assert.Contains(t, addMembers, [2]graph.ID{tenantGroupsAdmin.ID, openGroup.ID})
+	assert.NotContains(t, addMembers, [2]graph.ID{tenantGroupsAdmin.ID, restrictedGroup.ID})
+
+	// Neither do roles scoped to another administrative unit
+	assert.Contains(t, resetPassword, [2]graph.ID{openHelpdesk.ID, openUser.ID})
+	assert.NotContains(t, resetPassword, [2]graph.ID{openHelpdesk.ID, restrictedUser.ID})
+	assert.Contains(t, addMembers, [2]graph.ID{openGroupsAdmin.ID, openGroup.ID})
+	assert.NotContains(t, addMembers, [2]graph.ID{openGroupsAdmin.ID, restrictedGroup.ID})
+
+	// Roles scoped to the restricted management administrative unit do
+	assert.Contains(t, resetPassword, [2]graph.ID{restrictedHelpdesk.ID, restrictedUser.ID})
+}
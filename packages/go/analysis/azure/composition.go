@@ -0,0 +1,89 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package azure
+
+import (
+	"github.com/specterops/bloodhound/packages/go/analysis/post"
+	"github.com/specterops/dawgs/graph"
+)
+
+// Composition rules recorded on Azure post-processed edges. Each rule names the condition that caused an edge to be
+// created; the contributing nodes and edges recorded alongside a rule are listed next to it.
+const (
+	// Nodes: the tenant the role is assigned in; edges: the AZHasRole and AZMemberOf edges that grant the principal the
+	// role
+	CompositionRuleGlobalAdmin         = "GlobalAdministratorRole"
+	CompositionRulePrivilegedRoleAdmin = "PrivilegedRoleAdministratorRole"
+	CompositionRulePrivilegedAuthAdmin = "PrivilegedAuthenticationAdministratorRole"
+	CompositionRuleExecuteCommand      = "IntuneServiceAdministratorRole"
+	// Nodes: the tenant the role is assigned in; edges: the tenant's AZContains edge to the target
+	CompositionRuleAddSecret = "TenantRoleAddSecret"
+	CompositionRuleAddOwner  = "TenantRoleAddOwner"
+	// Nodes: the tenant the role is assigned in; edges: the AZHasRole and AZMemberOf edges that grant the target its
+	// own roles, if any
+	CompositionRuleResetPassword = "TenantRoleResetPassword"
+	// Edges: the AZHasRole and AZMemberOf edges that grant the principal a tenant-wide group membership administration
+	// role
+	CompositionRuleAddMembers = "TenantRoleAddMembers"
+	// Nodes: the administrative unit the role assignment is scoped to; edges: the AZHasScopedRole and AZMemberOf edges
+	// that grant the principal the role and, for password resets, the edges that grant the target its own roles
+	CompositionRuleScopedResetPassword = "AdministrativeUnitRoleResetPassword"
+	CompositionRuleScopedAddMembers    = "AdministrativeUnitRoleAddMembers"
+	// Nodes: the tenant whose default administrator roles approve activation of the target role
+	CompositionRuleDefaultRoleApprover = "RoleManagementPolicyDefaultApprovers"
+	// No contributing nodes: the principal is listed as an approver in the target role's management policy
+	CompositionRulePrincipalRoleApprover = "RoleManagementPolicyApprovers"
+)
+
+// RoleGrants maps role template IDs to the principals holding the role and the role assignment and AZMemberOf edges
+// through which each principal holds it.
+type RoleGrants map[string]map[graph.ID][]graph.ID
+
+func (s RoleGrants) add(roleTemplateID string, grants map[graph.ID][]graph.ID) {
+	if existing, ok := s[roleTemplateID]; !ok {
+		s[roleTemplateID] = grants
+	} else {
+		for principalID, edgeIDs := range grants {
+			existing[principalID] = append(existing[principalID], edgeIDs...)
+		}
+	}
+}
+
+// Edges returns the edges through which the given principal holds any of the given roles. All roles are considered
+// when no role template IDs are given.
+func (s RoleGrants) Edges(principalID graph.ID, roleTemplateIDs ...string) []graph.ID {
+	var edgeIDs []graph.ID
+
+	if len(roleTemplateIDs) == 0 {
+		for _, grants := range s {
+			edgeIDs = append(edgeIDs, grants[principalID]...)
+		}
+	} else {
+		for _, roleTemplateID := range roleTemplateIDs {
+			edgeIDs = append(edgeIDs, s[roleTemplateID][principalID]...)
+		}
+	}
+
+	return edgeIDs
+}
+
+// appRoleComposition returns the composition of an MS Graph app role abuse edge. The rule is the app role permission
+// held by the source service principal; the tenant and its contains relationship to the target are recorded as the
+// contributing node and edge.
+func appRoleComposition(permission graph.Kind, tenantContainsTargetRelationship *graph.Relationship) *post.EdgeComposition {
+	return post.NewEdgeComposition(permission.String(), tenantContainsTargetRelationship.StartID).AddEdges(tenantContainsTargetRelationship.ID)
+}
@@ -283,15 +283,17 @@ func postAZMGApplicationReadWriteAllEdges(ctx context.Context, db graph.Database
 			for _, targetRelationship := range append(tenantContainsServicePrincipalRelationships, tenantContainsAppRelationships...) {
 				for _, sourceNode := range sourceNodes {
 					sink.Submit(ctx, post.EnsureRelationshipJob{
-						FromID: sourceNode.ID,
-						ToID:   targetRelationship.EndID,
-						Kind:   azure.AZMGAddSecret,
+						FromID:      sourceNode.ID,
+						ToID:        targetRelationship.EndID,
+						Kind:        azure.AZMGAddSecret,
+						Composition: appRoleComposition(azure.ApplicationReadWriteAll, targetRelationship),
 					})
 
 					sink.Submit(ctx, post.EnsureRelationshipJob{
-						FromID: sourceNode.ID,
-						ToID:   targetRelationship.EndID,
-						Kind:   azure.AZMGAddOwner,
+						FromID:      sourceNode.ID,
+						ToID:        targetRelationship.EndID,
+						Kind:        azure.AZMGAddOwner,
+						Composition: appRoleComposition(azure.ApplicationReadWriteAll, targetRelationship),
 					})
 				}
 			}
@@ -309,9 +311,10 @@ func postAZMGAppRoleAssignmentReadWriteAllEdges(ctx context.Context, db graph.Da
 			for _, tenantContainsServicePrincipalRelationship := range tenantContainsServicePrincipalRelationships {
 				for _, sourceNode := range sourceNodes {
 					sink.Submit(ctx, post.EnsureRelationshipJob{
-						FromID: sourceNode.ID,
-						ToID:   tenantContainsServicePrincipalRelationship.StartID, // the tenant
-						Kind:   azure.AZMGGrantAppRoles,
+						FromID:      sourceNode.ID,
+						ToID:        tenantContainsServicePrincipalRelationship.StartID, // the tenant
+						Kind:        azure.AZMGGrantAppRoles,
+						Composition: appRoleComposition(azure.AppRoleAssignmentReadWriteAll, tenantContainsServicePrincipalRelationship),
 					})
 				}
 			}
@@ -331,15 +334,17 @@ func postAZMGDirectoryReadWriteAllEdges(ctx context.Context, db graph.Database,
 			for _, tenantContainsGroupRelationship := range tenantContainsGroupRelationships {
 				for _, sourceNode := range sourceNodes {
 					sink.Submit(ctx, post.EnsureRelationshipJob{
-						FromID: sourceNode.ID,
-						ToID:   tenantContainsGroupRelationship.EndID,
-						Kind:   azure.AZMGAddMember,
+						FromID:      sourceNode.ID,
+						ToID:        tenantContainsGroupRelationship.EndID,
+						Kind:        azure.AZMGAddMember,
+						Composition: appRoleComposition(azure.DirectoryReadWriteAll, tenantContainsGroupRelationship),
 					})
 
 					sink.Submit(ctx, post.EnsureRelationshipJob{
-						FromID: sourceNode.ID,
-						ToID:   tenantContainsGroupRelationship.EndID,
-						Kind:   azure.AZMGAddOwner,
+						FromID:      sourceNode.ID,
+						ToID:        tenantContainsGroupRelationship.EndID,
+						Kind:        azure.AZMGAddOwner,
+						Composition: appRoleComposition(azure.DirectoryReadWriteAll, tenantContainsGroupRelationship),
 					})
 				}
 			}
@@ -359,15 +364,17 @@ func postAZMGGroupReadWriteAllEdges(ctx context.Context, db graph.Database, sink
 			for _, tenantContainsGroupRelationship := range tenantContainsGroupRelationships {
 				for _, sourceNode := range sourceNodes {
 					sink.Submit(ctx, post.EnsureRelationshipJob{
-						FromID: sourceNode.ID,
-						ToID:   tenantContainsGroupRelationship.EndID,
-						Kind:   azure.AZMGAddMember,
+						FromID:      sourceNode.ID,
+						ToID:        tenantContainsGroupRelationship.EndID,
+						Kind:        azure.AZMGAddMember,
+						Composition: appRoleComposition(azure.GroupReadWriteAll, tenantContainsGroupRelationship),
 					})
 
 					sink.Submit(ctx, post.EnsureRelationshipJob{
-						FromID: sourceNode.ID,
-						ToID:   tenantContainsGroupRelationship.EndID,
-						Kind:   azure.AZMGAddOwner,
+						FromID:      sourceNode.ID,
+						ToID:        tenantContainsGroupRelationship.EndID,
+						Kind:        azure.AZMGAddOwner,
+						Composition: appRoleComposition(azure.GroupReadWriteAll, tenantContainsGroupRelationship),
 					})
 				}
 			}
@@ -387,9 +394,10 @@ func postAZMGGroupMemberReadWriteAllEdges(ctx context.Context, db graph.Database
 			for _, tenantContainsGroupRelationship := range tenantContainsGroupRelationships {
 				for _, sourceNode := range sourceNodes {
 					sink.Submit(ctx, post.EnsureRelationshipJob{
-						FromID: sourceNode.ID,
-						ToID:   tenantContainsGroupRelationship.EndID,
-						Kind:   azure.AZMGAddMember,
+						FromID:      sourceNode.ID,
+						ToID:        tenantContainsGroupRelationship.EndID,
+						Kind:        azure.AZMGAddMember,
+						Composition: appRoleComposition(azure.GroupMemberReadWriteAll, tenantContainsGroupRelationship),
 					})
 				}
 			}
@@ -409,9 +417,10 @@ func postAZMGRoleManagementReadWriteDirectoryEdgesPart1(ctx context.Context, db
 			for _, tenantContainsRoleRelationship := range tenantContainsRoleRelationships {
 				for _, sourceNode := range sourceNodes {
 					sink.Submit(ctx, post.EnsureRelationshipJob{
-						FromID: sourceNode.ID,
-						ToID:   tenantContainsRoleRelationship.StartID,
-						Kind:   azure.AZMGGrantAppRoles,
+						FromID:      sourceNode.ID,
+						ToID:        tenantContainsRoleRelationship.StartID,
+						Kind:        azure.AZMGGrantAppRoles,
+						Composition: appRoleComposition(azure.RoleManagementReadWriteDirectory, tenantContainsRoleRelationship),
 					})
 				}
 			}
@@ -431,9 +440,10 @@ func postAZMGRoleManagementReadWriteDirectoryEdgesPart2(ctx context.Context, db
 			for _, tenantContainsRoleRelationship := range tenantContainsRoleRelationships {
 				for _, sourceNode := range sourceNodes {
 					sink.Submit(ctx, post.EnsureRelationshipJob{
-						FromID: sourceNode.ID,
-						ToID:   tenantContainsRoleRelationship.EndID,
-						Kind:   azure.AZMGGrantRole,
+						FromID:      sourceNode.ID,
+						ToID:        tenantContainsRoleRelationship.EndID,
+						Kind:        azure.AZMGGrantRole,
+						Composition: appRoleComposition(azure.RoleManagementReadWriteDirectory, tenantContainsRoleRelationship),
 					})
 				}
 			}
@@ -451,15 +461,17 @@ func postAZMGRoleManagementReadWriteDirectoryEdgesPart3(ctx context.Context, db
 			for _, tenantContainsServicePrincipalRelationship := range tenantContainsServicePrincipalRelationships {
 				for _, sourceNode := range sourceNodes {
 					sink.Submit(ctx, post.EnsureRelationshipJob{
-						FromID: sourceNode.ID,
-						ToID:   tenantContainsServicePrincipalRelationship.EndID,
-						Kind:   azure.AZMGAddSecret,
+						FromID:      sourceNode.ID,
+						ToID:        tenantContainsServicePrincipalRelationship.EndID,
+						Kind:        azure.AZMGAddSecret,
+						Composition: appRoleComposition(azure.RoleManagementReadWriteDirectory, tenantContainsServicePrincipalRelationship),
 					})
 
 					sink.Submit(ctx, post.EnsureRelationshipJob{
-						FromID: sourceNode.ID,
-						ToID:   tenantContainsServicePrincipalRelationship.EndID,
-						Kind:   azure.AZMGAddOwner,
+						FromID:      sourceNode.ID,
+						ToID:        tenantContainsServicePrincipalRelationship.EndID,
+						Kind:        azure.AZMGAddOwner,
+						Composition: appRoleComposition(azure.RoleManagementReadWriteDirectory, tenantContainsServicePrincipalRelationship),
 					})
 				}
 			}
@@ -479,15 +491,17 @@ func postAZMGRoleManagementReadWriteDirectoryEdgesPart4(ctx context.Context, db
 			for _, tenantContainsAppRelationship := range tenantContainsAppRelationships {
 				for _, sourceNode := range sourceNodes {
 					sink.Submit(ctx, post.EnsureRelationshipJob{
-						FromID: sourceNode.ID,
-						ToID:   tenantContainsAppRelationship.EndID,
-						Kind:   azure.AZMGAddSecret,
+						FromID:      sourceNode.ID,
+						ToID:        tenantContainsAppRelationship.EndID,
+						Kind:        azure.AZMGAddSecret,
+						Composition: appRoleComposition(azure.RoleManagementReadWriteDirectory, tenantContainsAppRelationship),
 					})
 
 					sink.Submit(ctx, post.EnsureRelationshipJob{
-						FromID: sourceNode.ID,
-						ToID:   tenantContainsAppRelationship.EndID,
-						Kind:   azure.AZMGAddOwner,
+						FromID:      sourceNode.ID,
+						ToID:        tenantContainsAppRelationship.EndID,
+						Kind:        azure.AZMGAddOwner,
+						Composition: appRoleComposition(azure.RoleManagementReadWriteDirectory, tenantContainsAppRelationship),
 					})
 				}
 			}
@@ -507,15 +521,17 @@ func postAZMGRoleManagementReadWriteDirectoryEdgesPart5(ctx context.Context, db
 			for _, tenantContainsGroupRelationship := range tenantContainsGroupRelationships {
 				for _, sourceNode := range sourceNodes {
 					sink.Submit(ctx, post.EnsureRelationshipJob{
-						FromID: sourceNode.ID,
-						ToID:   tenantContainsGroupRelationship.EndID,
-						Kind:   azure.AZMGAddMember,
+						FromID:      sourceNode.ID,
+						ToID:        tenantContainsGroupRelationship.EndID,
+						Kind:        azure.AZMGAddMember,
+						Composition: appRoleComposition(azure.RoleManagementReadWriteDirectory, tenantContainsGroupRelationship),
 					})
 
 					sink.Submit(ctx, post.EnsureRelationshipJob{
-						FromID: sourceNode.ID,
-						ToID:   tenantContainsGroupRelationship.EndID,
-						Kind:   azure.AZMGAddOwner,
+						FromID:      sourceNode.ID,
+						ToID:        tenantContainsGroupRelationship.EndID,
+						Kind:        azure.AZMGAddOwner,
+						Composition: appRoleComposition(azure.RoleManagementReadWriteDirectory, tenantContainsGroupRelationship),
 					})
 				}
 			}
@@ -533,9 +549,10 @@ func postAZMGServicePrincipalEndpointReadWriteAllEdges(ctx context.Context, db g
 			for _, tenantContainsServicePrincipalRelationship := range tenantContainsServicePrincipalRelationships {
 				for _, sourceNode := range sourceNodes {
 					sink.Submit(ctx, post.EnsureRelationshipJob{
-						FromID: sourceNode.ID,
-						ToID:   tenantContainsServicePrincipalRelationship.EndID,
-						Kind:   azure.AZMGAddOwner,
+						FromID:      sourceNode.ID,
+						ToID:        tenantContainsServicePrincipalRelationship.EndID,
+						Kind:        azure.AZMGAddOwner,
+						Composition: appRoleComposition(azure.ServicePrincipalEndpointReadWriteAll, tenantContainsServicePrincipalRelationship),
 					})
 				}
 			}
@@ -549,23 +566,23 @@ func postAddSecret(ctx context.Context, db graph.Database, sink *post.FilteredRe
 	return db.ReadTransaction(ctx, func(tx graph.Transaction) error {
 		if addSecretRoles, err := TenantRoles(tx, tenant, AddSecretRoleIDs()...); err != nil {
 			return err
-		} else if tenantAppsAndSPs, err := TenantApplicationsAndServicePrincipals(tx, tenant); err != nil {
+		} else if tenantContainsAppsAndSPs, err := fetchTenantContainsRelationships(tx, tenant, azure.App, azure.ServicePrincipal); err != nil {
 			return err
 		} else {
 			for _, role := range addSecretRoles {
-				for _, target := range tenantAppsAndSPs {
+				for _, tenantContainsTarget := range tenantContainsAppsAndSPs {
 					slog.DebugContext(
 						ctx,
 						"Adding AZAddSecret edge from role to target",
 						slog.String("role_id", role.ID.String()),
-						slog.String("target_kinds", strings.Join(target.Kinds.Strings(), ",")),
-						slog.Uint64("target_id", target.ID.Uint64()),
+						slog.Uint64("target_id", tenantContainsTarget.EndID.Uint64()),
 					)
 
 					sink.Submit(ctx, post.EnsureRelationshipJob{
-						FromID: role.ID,
-						ToID:   target.ID,
-						Kind:   azure.AddSecret,
+						FromID:      role.ID,
+						ToID:        tenantContainsTarget.EndID,
+						Kind:        azure.AddSecret,
+						Composition: post.NewEdgeComposition(CompositionRuleAddSecret, tenant.ID).AddEdges(tenantContainsTarget.ID),
 					})
 				}
 			}
@@ -617,22 +634,22 @@ func postAzureAddOwner(ctx context.Context, db graph.Database, sink *post.Filter
 	return db.ReadTransaction(ctx, func(tx graph.Transaction) error {
 		if addOwnerRoles, err := TenantRoles(tx, tenant, AddOwnerRoleIDs()...); err != nil {
 			return err
-		} else if tenantAppsAndSPs, err := TenantApplicationsAndServicePrincipals(tx, tenant); err != nil {
+		} else if tenantContainsAppsAndSPs, err := fetchTenantContainsRelationships(tx, tenant, azure.App, azure.ServicePrincipal); err != nil {
 			return err
 		} else {
 			for _, role := range addOwnerRoles {
-				for _, target := range tenantAppsAndSPs {
+				for _, tenantContainsTarget := range tenantContainsAppsAndSPs {
 					slog.DebugContext(
 						ctx,
 						"Adding AZAddOwner edge from role to target",
 						slog.String("role_id", role.ID.String()),
-						slog.String("target_kinds", strings.Join(target.Kinds.Strings(), ",")),
-						slog.Uint64("target_id", target.ID.Uint64()),
+						slog.Uint64("target_id", tenantContainsTarget.EndID.Uint64()),
 					)
 					nextJob := post.EnsureRelationshipJob{
-						FromID: role.ID,
-						ToID:   target.ID,
-						Kind:   azure.AddOwner,
+						FromID:      role.ID,
+						ToID:        tenantContainsTarget.EndID,
+						Kind:        azure.AddOwner,
+						Composition: post.NewEdgeComposition(CompositionRuleAddOwner, tenant.ID).AddEdges(tenantContainsTarget.ID),
 					}
 
 					if !sink.Submit(ctx, nextJob) {
@@ -666,7 +683,9 @@ func ExecuteCommand(ctx context.Context, db graph.Database) (*post.AtomicPostPro
 					return err
 				} else if tenantDevices.Len() == 0 {
 					return nil
-				} else if intuneAdmins, err := RoleMembers(tx, tenant, azure.IntuneServiceAdministratorRole); err != nil {
+				} else if intuneAdminRoles, err := TenantRoles(tx, tenant, azure.IntuneServiceAdministratorRole); err != nil {
+					return err
+				} else if intuneAdmins, intuneAdminGrants, err := roleMemberGrants(tx, intuneAdminRoles); err != nil {
 					return err
 				} else {
 					for _, tenantDevice := range tenantDevices {
@@ -678,9 +697,10 @@ func ExecuteCommand(ctx context.Context, db graph.Database) (*post.AtomicPostPro
 							} else if isWindowsDevice {
 								for _, intuneAdmin := range intuneAdmins {
 									nextJob := post.EnsureRelationshipJob{
-										FromID: intuneAdmin.ID,
-										ToID:   innerTenantDevice.ID,
-										Kind:   azure.ExecuteCommand,
+										FromID:      intuneAdmin.ID,
+										ToID:        innerTenantDevice.ID,
+										Kind:        azure.ExecuteCommand,
+										Composition: post.NewEdgeComposition(CompositionRuleExecuteCommand, tenant.ID).AddEdges(intuneAdminGrants[intuneAdmin.ID]...),
 									}
 
 									if !channels.Submit(ctx, outC, nextJob) {
@@ -719,11 +739,13 @@ func postAzureResetPassword(ctx context.Context, db graph.Database, sink *post.F
 				if targets, err := resetPasswordEndNodeBitmapForRole(role, roleAssignments); err != nil {
 					return fmt.Errorf("unable to continue processing azresetpassword for tenant node %d: %w", tenant.ID, err)
 				} else {
+					targets.AndNot(roleAssignments.RestrictedManagementMembers())
 					targets.Each(func(nextID uint64) bool {
 						nextJob := post.EnsureRelationshipJob{
-							FromID: role.ID,
-							ToID:   graph.ID(nextID),
-							Kind:   azure.ResetPassword,
+							FromID:      role.ID,
+							ToID:        graph.ID(nextID),
+							Kind:        azure.ResetPassword,
+							Composition: post.NewEdgeComposition(CompositionRuleResetPassword, tenant.ID).AddEdges(roleAssignments.RoleGrants.Edges(graph.ID(nextID))...),
 						}
 
 						return sink.Submit(ctx, nextJob)
@@ -741,44 +763,99 @@ func resetPasswordEndNodeBitmapForRole(role *graph.Node, roleAssignments RoleAss
 		return nil, fmt.Errorf("role node %d is missing property %s", role.ID, azure.RoleTemplateID)
 	} else if roleTemplateID, err := roleTemplateIDProp.String(); err != nil {
 		return nil, fmt.Errorf("role node %d property %s is not a string", role.ID, azure.RoleTemplateID)
+	} else if result, supported := resetPasswordEndNodeBitmapForRoleTemplate(roleTemplateID, roleAssignments); !supported {
+		return nil, fmt.Errorf("role node %d has unsupported role template id '%s'", role.ID, roleTemplateID)
 	} else {
-		result := cardinality.NewBitmap64()
-		switch roleTemplateID {
-		case azure.CompanyAdministratorRole, azure.PrivilegedAuthenticationAdministratorRole, azure.PartnerTier2SupportRole:
-			result.Or(roleAssignments.Users())
-		case azure.UserAccountAdministratorRole:
-			result.Or(roleAssignments.UsersWithoutRoles())
-			result.Or(roleAssignments.UsersWithRolesExclusive(UserAdministratorPasswordResetTargetRoles()...))
-			result.AndNot(roleAssignments.UsersWithRoleAssignableGroupMembership())
-		case azure.HelpdeskAdministratorRole:
-			result.Or(roleAssignments.UsersWithoutRoles())
-			result.Or(roleAssignments.UsersWithRolesExclusive(HelpdeskAdministratorPasswordResetTargetRoles()...))
-			result.AndNot(roleAssignments.UsersWithRoleAssignableGroupMembership())
-		case azure.AuthenticationAdministratorRole:
-			result.Or(roleAssignments.UsersWithoutRoles())
-			result.Or(roleAssignments.UsersWithRolesExclusive(AuthenticationAdministratorPasswordResetTargetRoles()...))
-			result.AndNot(roleAssignments.UsersWithRoleAssignableGroupMembership())
-		case azure.PasswordAdministratorRole:
-			result.Or(roleAssignments.UsersWithoutRoles())
-			result.Or(roleAssignments.UsersWithRolesExclusive(PasswordAdministratorPasswordResetTargetRoles()...))
-			result.AndNot(roleAssignments.UsersWithRoleAssignableGroupMembership())
-		case azure.PartnerTier1SupportRole:
-			result.Or(roleAssignments.UsersWithoutRoles())
-			result.AndNot(roleAssignments.UsersWithRoleAssignableGroupMembership())
-		default:
-			return nil, fmt.Errorf("role node %d has unsupported role template id '%s'", role.ID, roleTemplateID)
+		return result, nil
+	}
+}
+
+// resetPasswordEndNodeBitmapForRoleTemplate returns the users whose password may be reset by a holder of the given role.
+// The returned boolean is false if the role does not grant password resets.
+func resetPasswordEndNodeBitmapForRoleTemplate(roleTemplateID string, roleAssignments RoleAssignments) (cardinality.Duplex[uint64], bool) {
+	result := cardinality.NewBitmap64()
+	switch roleTemplateID {
+	case azure.CompanyAdministratorRole, azure.PrivilegedAuthenticationAdministratorRole, azure.PartnerTier2SupportRole:
+		result.Or(roleAssignments.Users())
+	case azure.UserAccountAdministratorRole:
+		result.Or(roleAssignments.UsersWithoutRoles())
+		result.Or(roleAssignments.UsersWithRolesExclusive(UserAdministratorPasswordResetTargetRoles()...))
+		result.AndNot(roleAssignments.UsersWithRoleAssignableGroupMembership())
+	case azure.HelpdeskAdministratorRole:
+		result.Or(roleAssignments.UsersWithoutRoles())
+		result.Or(roleAssignments.UsersWithRolesExclusive(HelpdeskAdministratorPasswordResetTargetRoles()...))
+		result.AndNot(roleAssignments.UsersWithRoleAssignableGroupMembership())
+	case azure.AuthenticationAdministratorRole:
+		result.Or(roleAssignments.UsersWithoutRoles())
+		result.Or(roleAssignments.UsersWithRolesExclusive(AuthenticationAdministratorPasswordResetTargetRoles()...))
+		result.AndNot(roleAssignments.UsersWithRoleAssignableGroupMembership())
+	case azure.PasswordAdministratorRole:
+		result.Or(roleAssignments.UsersWithoutRoles())
+		result.Or(roleAssignments.UsersWithRolesExclusive(PasswordAdministratorPasswordResetTargetRoles()...))
+		result.AndNot(roleAssignments.UsersWithRoleAssignableGroupMembership())
+	case azure.PartnerTier1SupportRole:
+		result.Or(roleAssignments.UsersWithoutRoles())
+		result.AndNot(roleAssignments.UsersWithRoleAssignableGroupMembership())
+	default:
+		return nil, false
+	}
+
+	return result, true
+}
+
+// postAzureScopedResetPassword creates ResetPassword edges for role assignments scoped to an administrative unit. The
+// edges start at the assigned principal rather than the role node since the tenant-wide role node would otherwise
+// grant the reset over every user in the tenant. Targets are limited to users contained by the administrative unit,
+// excluding members of restricted management administrative units unless the assignment is scoped to one of them.
+func postAzureScopedResetPassword(ctx context.Context, sink *post.FilteredRelationshipSink, roleAssignments RoleAssignments, administrativeUnitRoleAssignments []AdministrativeUnitRoleAssignments) {
+	for _, administrativeUnit := range administrativeUnitRoleAssignments {
+		administrativeUnitUsers := administrativeUnit.Users()
+		if !administrativeUnit.MemberManagementRestricted {
+			administrativeUnitUsers.AndNot(roleAssignments.RestrictedManagementMembers())
 		}
 
-		return result, nil
+		for _, roleTemplateID := range ResetPasswordRoleIDs() {
+			principals := administrativeUnit.PrincipalsWithRole(roleTemplateID)
+			if principals.Cardinality() == 0 {
+				continue
+			}
+
+			targets, _ := resetPasswordEndNodeBitmapForRoleTemplate(roleTemplateID, roleAssignments)
+			targets.And(administrativeUnitUsers)
+
+			principals.Each(func(principalID uint64) bool {
+				submitted := true
+
+				principalGrants := administrativeUnit.RoleGrants.Edges(graph.ID(principalID), roleTemplateID)
+
+				targets.Each(func(targetID uint64) bool {
+					composition := post.NewEdgeComposition(CompositionRuleScopedResetPassword, administrativeUnit.AdministrativeUnit.ID).
+						AddEdges(principalGrants...).
+						AddEdges(roleAssignments.RoleGrants.Edges(graph.ID(targetID))...)
+
+					submitted = sink.Submit(ctx, post.EnsureRelationshipJob{
+						FromID:      graph.ID(principalID),
+						ToID:        graph.ID(targetID),
+						Kind:        azure.ResetPassword,
+						Composition: composition,
+					})
+
+					return submitted
+				})
+
+				return submitted
+			})
+		}
 	}
 }
 
 func postAzureGlobalAdmins(ctx context.Context, sink *post.FilteredRelationshipSink, roleAssignments RoleAssignments, tenant *graph.Node) error {
 	roleAssignments.PrincipalsWithRole(azure.CompanyAdministratorRole).Each(func(nextID uint64) bool {
 		nextJob := post.EnsureRelationshipJob{
-			FromID: graph.ID(nextID),
-			ToID:   tenant.ID,
-			Kind:   azure.GlobalAdmin,
+			FromID:      graph.ID(nextID),
+			ToID:        tenant.ID,
+			Kind:        azure.GlobalAdmin,
+			Composition: post.NewEdgeComposition(CompositionRuleGlobalAdmin, tenant.ID).AddEdges(roleAssignments.RoleGrants.Edges(graph.ID(nextID), azure.CompanyAdministratorRole)...),
 		}
 
 		return sink.Submit(ctx, nextJob)
@@ -790,9 +867,10 @@ func postAzureGlobalAdmins(ctx context.Context, sink *post.FilteredRelationshipS
 func postAzurePrivilegedRoleAdmins(ctx context.Context, sink *post.FilteredRelationshipSink, roleAssignments RoleAssignments, tenant *graph.Node) {
 	roleAssignments.PrincipalsWithRole(azure.PrivilegedRoleAdministratorRole).Each(func(nextID uint64) bool {
 		nextJob := post.EnsureRelationshipJob{
-			FromID: graph.ID(nextID),
-			ToID:   tenant.ID,
-			Kind:   azure.PrivilegedRoleAdmin,
+			FromID:      graph.ID(nextID),
+			ToID:        tenant.ID,
+			Kind:        azure.PrivilegedRoleAdmin,
+			Composition: post.NewEdgeComposition(CompositionRulePrivilegedRoleAdmin, tenant.ID).AddEdges(roleAssignments.RoleGrants.Edges(graph.ID(nextID), azure.PrivilegedRoleAdministratorRole)...),
 		}
 
 		return sink.Submit(ctx, nextJob)
@@ -802,9 +880,10 @@ func postAzurePrivilegedRoleAdmins(ctx context.Context, sink *post.FilteredRelat
 func postAzurePrivilegedAuthAdmins(ctx context.Context, sink *post.FilteredRelationshipSink, roleAssignments RoleAssignments, tenant *graph.Node) {
 	roleAssignments.PrincipalsWithRole(azure.PrivilegedAuthenticationAdministratorRole).Each(func(nextID uint64) bool {
 		nextJob := post.EnsureRelationshipJob{
-			FromID: graph.ID(nextID),
-			ToID:   tenant.ID,
-			Kind:   azure.PrivilegedAuthAdmin,
+			FromID:      graph.ID(nextID),
+			ToID:        tenant.ID,
+			Kind:        azure.PrivilegedAuthAdmin,
+			Composition: post.NewEdgeComposition(CompositionRulePrivilegedAuthAdmin, tenant.ID).AddEdges(roleAssignments.RoleGrants.Edges(graph.ID(nextID), azure.PrivilegedAuthenticationAdministratorRole)...),
 		}
 
 		return sink.Submit(ctx, nextJob)
@@ -812,12 +891,19 @@ func postAzurePrivilegedAuthAdmins(ctx context.Context, sink *post.FilteredRelat
 }
 
 func postAzureAddMembers(ctx context.Context, sink *post.FilteredRelationshipSink, roleAssignments RoleAssignments) error {
+	restrictedMembers := roleAssignments.RestrictedManagementMembers()
+
 	for tenantGroupID, tenantGroup := range roleAssignments.TenantPrincipals.Get(azure.Group) {
+		if restrictedMembers.Contains(tenantGroupID.Uint64()) {
+			continue
+		}
+
 		roleAssignments.UsersWithRole(AddMemberAllGroupsTargetRoles()...).Each(func(nextID uint64) bool {
 			nextJob := post.EnsureRelationshipJob{
-				FromID: graph.ID(nextID),
-				ToID:   tenantGroupID,
-				Kind:   azure.AddMembers,
+				FromID:      graph.ID(nextID),
+				ToID:        tenantGroupID,
+				Kind:        azure.AddMembers,
+				Composition: post.NewEdgeComposition(CompositionRuleAddMembers).AddEdges(roleAssignments.RoleGrants.Edges(graph.ID(nextID), AddMemberAllGroupsTargetRoles()...)...),
 			}
 
 			return sink.Submit(ctx, nextJob)
@@ -825,9 +911,10 @@ func postAzureAddMembers(ctx context.Context, sink *post.FilteredRelationshipSin
 
 		roleAssignments.ServicePrincipalsWithRole(AddMemberAllGroupsTargetRoles()...).Each(func(nextID uint64) bool {
 			nextJob := post.EnsureRelationshipJob{
-				FromID: graph.ID(nextID),
-				ToID:   tenantGroupID,
-				Kind:   azure.AddMembers,
+				FromID:      graph.ID(nextID),
+				ToID:        tenantGroupID,
+				Kind:        azure.AddMembers,
+				Composition: post.NewEdgeComposition(CompositionRuleAddMembers).AddEdges(roleAssignments.RoleGrants.Edges(graph.ID(nextID), AddMemberAllGroupsTargetRoles()...)...),
 			}
 
 			return sink.Submit(ctx, nextJob)
@@ -847,9 +934,10 @@ func postAzureAddMembers(ctx context.Context, sink *post.FilteredRelationshipSin
 		} else if !isRoleAssignable {
 			roleAssignments.UsersWithRole(AddMemberGroupNotRoleAssignableTargetRoles()...).Each(func(nextID uint64) bool {
 				nextJob := post.EnsureRelationshipJob{
-					FromID: graph.ID(nextID),
-					ToID:   tenantGroupID,
-					Kind:   azure.AddMembers,
+					FromID:      graph.ID(nextID),
+					ToID:        tenantGroupID,
+					Kind:        azure.AddMembers,
+					Composition: post.NewEdgeComposition(CompositionRuleAddMembers).AddEdges(roleAssignments.RoleGrants.Edges(graph.ID(nextID), AddMemberGroupNotRoleAssignableTargetRoles()...)...),
 				}
 
 				return sink.Submit(ctx, nextJob)
@@ -870,9 +958,10 @@ func postAzureAddMembers(ctx context.Context, sink *post.FilteredRelationshipSin
 		} else if !isRoleAssignable {
 			roleAssignments.ServicePrincipalsWithRole(AddMemberGroupNotRoleAssignableTargetRoles()...).Each(func(nextID uint64) bool {
 				nextJob := post.EnsureRelationshipJob{
-					FromID: graph.ID(nextID),
-					ToID:   tenantGroupID,
-					Kind:   azure.AddMembers,
+					FromID:      graph.ID(nextID),
+					ToID:        tenantGroupID,
+					Kind:        azure.AddMembers,
+					Composition: post.NewEdgeComposition(CompositionRuleAddMembers).AddEdges(roleAssignments.RoleGrants.Edges(graph.ID(nextID), AddMemberGroupNotRoleAssignableTargetRoles()...)...),
 				}
 
 				return sink.Submit(ctx, nextJob)
@@ -883,6 +972,60 @@ func postAzureAddMembers(ctx context.Context, sink *post.FilteredRelationshipSin
 	return nil
 }
 
+// postAzureScopedAddMembers creates AddMembers edges for role assignments scoped to an administrative unit. Targets
+// are limited to groups contained by the administrative unit, excluding members of restricted management
+// administrative units unless the assignment is scoped to one of them.
+func postAzureScopedAddMembers(ctx context.Context, sink *post.FilteredRelationshipSink, roleAssignments RoleAssignments, administrativeUnitRoleAssignments []AdministrativeUnitRoleAssignments) error {
+	restrictedMembers := roleAssignments.RestrictedManagementMembers()
+
+	for _, administrativeUnit := range administrativeUnitRoleAssignments {
+		var (
+			allGroupsPrincipals               = administrativeUnit.PrincipalsWithRole(AddMemberAllGroupsTargetRoles()...)
+			notRoleAssignableGroupsPrincipals = administrativeUnit.PrincipalsWithRole(AddMemberGroupNotRoleAssignableTargetRoles()...)
+		)
+
+		for groupID, group := range administrativeUnit.Members.Get(azure.Group) {
+			if !administrativeUnit.MemberManagementRestricted && restrictedMembers.Contains(groupID.Uint64()) {
+				continue
+			}
+
+			var (
+				principals      = cardinality.NewBitmap64()
+				roleTemplateIDs = AddMemberAllGroupsTargetRoles()
+			)
+
+			principals.Or(allGroupsPrincipals)
+
+			if isRoleAssignable, err := group.Properties.Get(azure.IsAssignableToRole.String()).Bool(); err != nil {
+				if graph.IsErrPropertyNotFound(err) {
+					slog.WarnContext(
+						ctx,
+						"Node is missing property",
+						slog.Uint64("node_id", group.ID.Uint64()),
+						slog.String("property", azure.IsAssignableToRole.String()),
+					)
+				} else {
+					return err
+				}
+			} else if !isRoleAssignable {
+				principals.Or(notRoleAssignableGroupsPrincipals)
+				roleTemplateIDs = append(roleTemplateIDs, AddMemberGroupNotRoleAssignableTargetRoles()...)
+			}
+
+			principals.Each(func(nextID uint64) bool {
+				return sink.Submit(ctx, post.EnsureRelationshipJob{
+					FromID:      graph.ID(nextID),
+					ToID:        groupID,
+					Kind:        azure.AddMembers,
+					Composition: post.NewEdgeComposition(CompositionRuleScopedAddMembers, administrativeUnit.AdministrativeUnit.ID).AddEdges(administrativeUnit.RoleGrants.Edges(graph.ID(nextID), roleTemplateIDs...)...),
+				})
+			})
+		}
+	}
+
+	return nil
+}
+
 var userRoleAssignmentPostProcessedEdges = graph.Kinds{
 	azure.ResetPassword,
 	azure.GlobalAdmin,
@@ -918,6 +1061,8 @@ func UserRoleAssignments(ctx context.Context, db graph.Database) (*post.AtomicPo
 		for _, tenant := range tenantNodes {
 			if roleAssignments, err := FetchTenantRoleAssignments(ctx, db, tenant); err != nil {
 				return &post.AtomicPostProcessingStats{}, err
+			} else if administrativeUnitRoleAssignments, err := FetchTenantAdministrativeUnitRoleAssignments(ctx, db, tenant); err != nil {
+				return &post.AtomicPostProcessingStats{}, err
 			} else {
 				if err := postAzureResetPassword(ctx, db, sink, tenant, roleAssignments); err != nil {
 					return &post.AtomicPostProcessingStats{}, err
@@ -929,6 +1074,12 @@ func UserRoleAssignments(ctx context.Context, db graph.Database) (*post.AtomicPo
 					if err := postAzureAddMembers(ctx, sink, roleAssignments); err != nil {
 						slog.Error("Azure AddMember Post-Processing Failure", attr.Error(err))
 					}
+
+					postAzureScopedResetPassword(ctx, sink, roleAssignments, administrativeUnitRoleAssignments)
+
+					if err := postAzureScopedAddMembers(ctx, sink, roleAssignments, administrativeUnitRoleAssignments); err != nil {
+						slog.Error("Azure Scoped AddMember Post-Processing Failure", attr.Error(err))
+					}
 				}
 			}
 		}
@@ -85,6 +85,58 @@ func TestRoleAssignments_NodesWithRolesExclusive(t *testing.T) {
 	assert.Equal(t, graph.EmptyNodeSet().Get(0), assignments.NodesWithRolesExclusive(azschema.ReportsReaderRole).Get(azschema.User).Get(user.ID))
 }
 
+func TestRoleAssignments_ScopedRoles(t *testing.T) {
+	var (
+		tenant        = graph.NewNode(4, graph.NewProperties(), azschema.Tenant)
+		roleMap       = map[string]cardinality.Duplex[uint64]{constants.HelpdeskAdministratorRoleID: cardinality.NewBitmap64()}
+		scopedRoleMap = map[string]cardinality.Duplex[uint64]{azschema.PrivilegedAuthenticationAdministratorRole: cardinality.NewBitmap64()}
+	)
+
+	// user2 only holds a role scoped to an administrative unit
+	roleMap[constants.HelpdeskAdministratorRoleID].Add(uint64(user.ID))
+	scopedRoleMap[azschema.PrivilegedAuthenticationAdministratorRole].Add(uint64(user2.ID))
+
+	assignments := azure.NewTenantRoleAssignments(tenant, graph.NewNodeSet(user, user2).KindSet(), cardinality.NewBitmap64(), roleMap, scopedRoleMap)
+
+	assert.Equal(t, uint64(0), assignments.UsersWithoutRoles().Cardinality())
+	assert.False(t, assignments.PrincipalsWithRolesExclusive(constants.HelpdeskAdministratorRoleID).Contains(uint64(user2.ID)))
+	assert.True(t, assignments.PrincipalsWithRolesExclusive(constants.HelpdeskAdministratorRoleID).Contains(uint64(user.ID)))
+	assert.False(t, assignments.NodeHasRole(user2.ID, azschema.PrivilegedAuthenticationAdministratorRole))
+}
+
+func TestAdministrativeUnitRoleAssignments(t *testing.T) {
+	roleMap := map[string]cardinality.Duplex[uint64]{
+		constants.HelpdeskAdministratorRoleID: cardinality.NewBitmap64(),
+	}
+	roleMap[constants.HelpdeskAdministratorRoleID].Add(uint64(app.ID))
+
+	assignments := azure.AdministrativeUnitRoleAssignments{
+		Members: graph.NewNodeSet(user2, group).KindSet(),
+		RoleMap: roleMap,
+	}
+
+	assert.True(t, assignments.PrincipalsWithRole(constants.HelpdeskAdministratorRoleID, constants.GlobalAdministratorRoleID).Contains(uint64(app.ID)))
+	assert.Equal(t, uint64(0), assignments.PrincipalsWithRole(constants.GlobalAdministratorRoleID).Cardinality())
+	assert.Equal(t, []uint64{uint64(user2.ID)}, assignments.Users().Slice())
+}
+
+func TestRoleGrants_Edges(t *testing.T) {
+	grants := azure.RoleGrants{
+		constants.GlobalAdministratorRoleID: {
+			user.ID: {10},
+		},
+		constants.HelpdeskAdministratorRoleID: {
+			user.ID:  {11, 12},
+			group.ID: {13},
+		},
+	}
+
+	assert.Equal(t, []graph.ID{10}, grants.Edges(user.ID, constants.GlobalAdministratorRoleID))
+	assert.ElementsMatch(t, []graph.ID{10, 11, 12}, grants.Edges(user.ID))
+	assert.Equal(t, []graph.ID{13}, grants.Edges(group.ID, constants.GlobalAdministratorRoleID, constants.HelpdeskAdministratorRoleID))
+	assert.Empty(t, grants.Edges(user2.ID))
+}
+
 func TestTenantRoles(t *testing.T) {
 	var (
 		ctrl       = gomock.NewController(t)
@@ -99,12 +99,19 @@ type RoleAssignments struct {
 	// so that the members don't need to be exported.
 	TenantPrincipals graph.NodeKindSet
 	RoleMap          map[string]cardinality.Duplex[uint64]
+	RoleGrants       RoleGrants
+
+	// ScopedRoleMap maps role template IDs to the principals that hold the role within an administrative unit. Scoped
+	// roles grant no tenant-wide control but still protect their holders from administrators that may only manage
+	// users without roles.
+	ScopedRoleMap map[string]cardinality.Duplex[uint64]
 
 	users                         cardinality.Duplex[uint64]
 	usersWithAnyRole              cardinality.Duplex[uint64]
 	usersWithoutRoles             cardinality.Duplex[uint64]
 	servicePrincipals             cardinality.Duplex[uint64]
 	roleAssignableGroupMembership cardinality.Duplex[uint64]
+	restrictedManagementMembers   cardinality.Duplex[uint64]
 }
 
 func (s RoleAssignments) GetNodeKindSet(bm cardinality.Duplex[uint64]) graph.NodeKindSet {
@@ -163,6 +170,16 @@ func (s RoleAssignments) UsersWithRoleAssignableGroupMembership() cardinality.Du
 	return s.roleAssignableGroupMembership
 }
 
+// RestrictedManagementMembers returns a roaring bitmap of the members of restricted management administrative units,
+// which tenant-wide roles do not grant control over
+func (s RoleAssignments) RestrictedManagementMembers() cardinality.Duplex[uint64] {
+	if s.restrictedManagementMembers == nil {
+		return cardinality.NewBitmap64()
+	}
+
+	return s.restrictedManagementMembers
+}
+
 // PrincipalsWithRole returns a roaring bitmap of principals that have been assigned one or more of the matching roles from list of role template IDs
 func (s RoleAssignments) PrincipalsWithRole(roleTemplateIDs ...string) cardinality.Duplex[uint64] {
 	result := cardinality.NewBitmap64()
@@ -180,11 +197,13 @@ func (s RoleAssignments) PrincipalsWithRolesExclusive(roleTemplateIDs ...string)
 		result             = cardinality.NewBitmap64()
 		excludedPrincipals = cardinality.NewBitmap64()
 	)
-	for roleID, bitmap := range s.RoleMap {
-		if slices.Contains(roleTemplateIDs, roleID) {
-			result.Or(bitmap)
-		} else {
-			excludedPrincipals.Or(bitmap)
+	for _, roleMap := range []map[string]cardinality.Duplex[uint64]{s.RoleMap, s.ScopedRoleMap} {
+		for roleID, bitmap := range roleMap {
+			if slices.Contains(roleTemplateIDs, roleID) {
+				result.Or(bitmap)
+			} else {
+				excludedPrincipals.Or(bitmap)
+			}
 		}
 	}
 	result.AndNot(excludedPrincipals)
@@ -208,7 +227,7 @@ func (s RoleAssignments) NodeHasRole(id graph.ID, roleTemplateIDs ...string) boo
 	return false
 }
 
-func NewTenantRoleAssignments(tenant *graph.Node, tenantPrincipals graph.NodeKindSet, roleAssignableGroupMembership cardinality.Duplex[uint64], roleMap map[string]cardinality.Duplex[uint64]) RoleAssignments {
+func NewTenantRoleAssignments(tenant *graph.Node, tenantPrincipals graph.NodeKindSet, roleAssignableGroupMembership cardinality.Duplex[uint64], roleMap, scopedRoleMap map[string]cardinality.Duplex[uint64]) RoleAssignments {
 	var (
 		users             = tenantPrincipals.Get(azure.User).IDBitmap()
 		usersWithAnyRole  = cardinality.NewBitmap64()
@@ -216,11 +235,15 @@ func NewTenantRoleAssignments(tenant *graph.Node, tenantPrincipals graph.NodeKin
 		servicePrincipals = tenantPrincipals.Get(azure.ServicePrincipal).IDBitmap()
 	)
 
-	// Calculate users with any role first
+	// Calculate users with any role first, including roles scoped to an administrative unit
 	for _, bitmap := range roleMap {
 		usersWithAnyRole.Or(bitmap)
 	}
 
+	for _, bitmap := range scopedRoleMap {
+		usersWithAnyRole.Or(bitmap)
+	}
+
 	usersWithAnyRole.And(users)
 
 	// Calculate users without roles next
@@ -239,6 +262,7 @@ func NewTenantRoleAssignments(tenant *graph.Node, tenantPrincipals graph.NodeKin
 		usersWithoutRoles:             usersWithoutRoles,
 		servicePrincipals:             servicePrincipals,
 		RoleMap:                       roleMap,
+		ScopedRoleMap:                 scopedRoleMap,
 		roleAssignableGroupMembership: roleAssignableGroupMembership,
 	}
 }
@@ -260,6 +284,8 @@ func FetchTenantRoleAssignments(ctx context.Context, db graph.Database, tenant *
 				tenantPrincipalsNodeKindSet   = tenantPrincipalsNodeSet.KindSet()
 				roleAssignableGroupMembership = cardinality.NewBitmap64()
 				roleMap                       = map[string]cardinality.Duplex[uint64]{}
+				scopedRoleMap                 = map[string]cardinality.Duplex[uint64]{}
+				roleGrants                    = RoleGrants{}
 			)
 
 			// for each of the role assignable groups returned, fetch the users who are members
@@ -273,20 +299,39 @@ func FetchTenantRoleAssignments(ctx context.Context, db graph.Database, tenant *
 			}
 
 			for _, node := range roles {
-				if roleTemplateID, err := node.Properties.Get(azure.RoleTemplateID.String()).String(); err != nil {
+				roleTemplateID, err := node.Properties.Get(azure.RoleTemplateID.String()).String()
+				if err != nil {
 					if !graph.IsErrPropertyNotFound(err) {
 						return err
 					}
-				} else if members, err := RoleMembers(tx, tenant, roleTemplateID); err != nil {
+
+					continue
+				}
+
+				if members, grants, err := roleMemberGrants(tx, graph.NewNodeSet(node)); err != nil {
 					if !graph.IsErrNotFound(err) {
 						return err
 					}
 				} else {
 					roleMap[roleTemplateID] = members.IDBitmap()
+					roleGrants.add(roleTemplateID, grants)
+				}
+
+				if scopedHolders, err := scopedRoleHolders(tx, node); err != nil {
+					return err
+				} else if scopedHolders.Cardinality() > 0 {
+					scopedRoleMap[roleTemplateID] = scopedHolders
 				}
 			}
 
-			roleAssignments = NewTenantRoleAssignments(tenant, tenantPrincipalsNodeKindSet, roleAssignableGroupMembership, roleMap)
+			restrictedMembers, err := restrictedManagementMembers(tx, tenant)
+			if err != nil {
+				return err
+			}
+
+			roleAssignments = NewTenantRoleAssignments(tenant, tenantPrincipalsNodeKindSet, roleAssignableGroupMembership, roleMap, scopedRoleMap)
+			roleAssignments.RoleGrants = roleGrants
+			roleAssignments.restrictedManagementMembers = restrictedMembers
 			return nil
 		}
 	}); err != nil {
@@ -337,6 +382,42 @@ func roleMembers(tx graph.Transaction, tenantRoles graph.NodeSet, additionalRela
 	return members, nil
 }
 
+// roleMemberGrants returns the members of the given roles along with the AZHasRole and AZMemberOf edges through which
+// each member holds its role
+func roleMemberGrants(tx graph.Transaction, tenantRoles graph.NodeSet) (graph.NodeSet, map[graph.ID][]graph.ID, error) {
+	var (
+		members = graph.NewNodeSet()
+		grants  = map[graph.ID][]graph.ID{}
+	)
+
+	for _, tenantRole := range tenantRoles {
+		if paths, err := ops.TraversePaths(tx, ops.TraversalPlan{
+			Root:      tenantRole,
+			Direction: graph.DirectionInbound,
+			BranchQuery: func() graph.Criteria {
+				return query.KindIn(query.Relationship(), azure.MemberOf, azure.HasRole)
+			},
+			DescentFilter: roleDescentFilter,
+			PathFilter: func(ctx *ops.TraversalContext, segment *graph.PathSegment) bool {
+				return segment.Node.Kinds.ContainsOneOf(azure.User, azure.Group, azure.ServicePrincipal)
+			},
+		}); err != nil {
+			return nil, nil, err
+		} else {
+			for _, path := range paths.Paths() {
+				member := path.Terminal()
+				members.Add(member)
+
+				for _, edge := range path.Edges {
+					grants[member.ID] = append(grants[member.ID], edge.ID)
+				}
+			}
+		}
+	}
+
+	return members, grants, nil
+}
+
 // RoleMembersWithGrants returns the NodeSet of members for a given set of roles, including those members who may be able to grant themselves one of the given roles
 // NOTE: The current implementation also includes the role nodes in the returned set. It may be worth considering removing those nodes from the set if doing so doesn't break tier zero/high value assignment
 func RoleMembersWithGrants(tx graph.Transaction, tenant *graph.Node, roleTemplateIDs ...string) (graph.NodeSet, error) {
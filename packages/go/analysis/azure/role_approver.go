@@ -173,9 +173,10 @@ func handleDefaultAdminRoles(
 	for _, fetchedNode := range fetchedNodes {
 		// Enqueue creation of AZRoleApprover edge: from admin role → target AZRole
 		channels.Submit(ctx, outC, post.EnsureRelationshipJob{
-			FromID: fetchedNode.ID,
-			ToID:   fetchedAZRole.ID,
-			Kind:   azure.AZRoleApprover,
+			FromID:      fetchedNode.ID,
+			ToID:        fetchedAZRole.ID,
+			Kind:        azure.AZRoleApprover,
+			Composition: post.NewEdgeComposition(CompositionRuleDefaultRoleApprover, tenantNode.ID),
 		})
 	}
 	return nil
@@ -241,9 +242,10 @@ func handlePrincipalApprovers(
 
 		// Step 3c.ii.2: Create AZRoleApprover edge from approver node to target AZRole
 		if !channels.Submit(ctx, outC, post.EnsureRelationshipJob{
-			FromID: fetchedNode.ID,
-			ToID:   fetchedAZRole.ID,
-			Kind:   azure.AZRoleApprover,
+			FromID:      fetchedNode.ID,
+			ToID:        fetchedAZRole.ID,
+			Kind:        azure.AZRoleApprover,
+			Composition: post.NewEdgeComposition(CompositionRulePrincipalRoleApprover),
 		}) {
 			return nil
 		}
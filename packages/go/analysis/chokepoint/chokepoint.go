@@ -0,0 +1,485 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package chokepoint ranks the nodes and relationships that attack paths into Tier Zero depend on. A choke point is
+// scored by the number of principals that would lose every path into Tier Zero if it were removed, and by its
+// betweenness over the shortest paths from each principal into Tier Zero.
+package chokepoint
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/dawgs/graph"
+)
+
+// PrincipalKinds are the kinds of nodes counted as principals when scoring choke points
+var PrincipalKinds = graph.Kinds{ad.User, ad.Computer, ad.Group, azure.User, azure.Group, azure.ServicePrincipal}
+
+// NodeScore scores the removal of a node
+type NodeScore struct {
+	Node              *graph.Node
+	PrincipalsRemoved int
+	Betweenness       float64
+}
+
+// RelationshipScore scores the removal of a relationship
+type RelationshipScore struct {
+	Relationship      *graph.Relationship
+	PrincipalsRemoved int
+	Betweenness       float64
+}
+
+// Result holds the scored choke points of a Graph. Nodes and Relationships only contain choke points with a non-zero
+// score and are ordered by the number of principals removed, then by betweenness.
+type Result struct {
+	Principals    int
+	Nodes         []NodeScore
+	Relationships []RelationshipScore
+}
+
+// Graph is an in-memory view of every relationship that leads, directly or transitively, into a set of roots
+type Graph struct {
+	roots         map[graph.ID]struct{}
+	nodes         map[graph.ID]*graph.Node
+	relationships map[graph.ID]*graph.Relationship
+}
+
+// NewGraph returns a Graph whose paths end in the given roots
+func NewGraph(roots graph.NodeSet) *Graph {
+	newGraph := &Graph{
+		roots:         map[graph.ID]struct{}{},
+		nodes:         map[graph.ID]*graph.Node{},
+		relationships: map[graph.ID]*graph.Relationship{},
+	}
+
+	for _, root := range roots {
+		newGraph.roots[root.ID] = struct{}{}
+		newGraph.nodes[root.ID] = root
+	}
+
+	return newGraph
+}
+
+// IsRoot reports whether the node with the given ID is one of the roots of the Graph
+func (s *Graph) IsRoot(id graph.ID) bool {
+	_, isRoot := s.roots[id]
+	return isRoot
+}
+
+// Relate adds start and the relationship leaving it. The end of the relationship must already be part of the Graph.
+// Relationships leaving a root are ignored, since a path ends at the first root it reaches. The returned bool is true
+// when start was not yet part of the Graph.
+func (s *Graph) Relate(start *graph.Node, relationship *graph.Relationship) bool {
+	if s.IsRoot(start.ID) || relationship.StartID == relationship.EndID {
+		return false
+	} else if _, known := s.nodes[relationship.EndID]; !known {
+		return false
+	}
+
+	s.relationships[relationship.ID] = relationship
+
+	if _, known := s.nodes[start.ID]; known {
+		return false
+	}
+
+	s.nodes[start.ID] = start
+	return true
+}
+
+// Len returns the number of nodes in the Graph
+func (s *Graph) Len() int {
+	return len(s.nodes)
+}
+
+// Node returns the node of the Graph with the given ID
+func (s *Graph) Node(id graph.ID) (*graph.Node, bool) {
+	node, found := s.nodes[id]
+	return node, found
+}
+
+func (s *Graph) isPrincipal(node *graph.Node) bool {
+	return !s.IsRoot(node.ID) && node.Kinds.ContainsOneOf(PrincipalKinds...)
+}
+
+// vertices is the dense form of a Graph used by Analyze. Vertex zero is a virtual sink that every root leads into.
+// Vertices 1 through len(nodes) are nodes, and each relationship is split by a vertex of its own so that dominance is
+// computed for relationships as well as for nodes.
+type vertices struct {
+	nodes         []*graph.Node
+	relationships []*graph.Relationship
+	index         map[graph.ID]int
+	principal     []bool
+	roots         []int
+	// inbound and outbound list the relationship indexes ending and starting at each node index
+	inbound  [][]int
+	outbound [][]int
+}
+
+func (s *Graph) vertices() vertices {
+	dense := vertices{
+		index: make(map[graph.ID]int, len(s.nodes)),
+	}
+
+	for _, node := range s.nodes {
+		dense.nodes = append(dense.nodes, node)
+	}
+
+	// Order by ID so that results, including ties, are deterministic
+	slices.SortFunc(dense.nodes, func(a, b *graph.Node) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	dense.principal = make([]bool, len(dense.nodes))
+	dense.inbound = make([][]int, len(dense.nodes))
+	dense.outbound = make([][]int, len(dense.nodes))
+
+	for idx, node := range dense.nodes {
+		dense.index[node.ID] = idx
+		dense.principal[idx] = s.isPrincipal(node)
+
+		if s.IsRoot(node.ID) {
+			dense.roots = append(dense.roots, idx)
+		}
+	}
+
+	for _, relationship := range s.relationships {
+		dense.relationships = append(dense.relationships, relationship)
+	}
+
+	slices.SortFunc(dense.relationships, func(a, b *graph.Relationship) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	for idx, relationship := range dense.relationships {
+		start, end := dense.index[relationship.StartID], dense.index[relationship.EndID]
+
+		dense.outbound[start] = append(dense.outbound[start], idx)
+		dense.inbound[end] = append(dense.inbound[end], idx)
+	}
+
+	return dense
+}
+
+func (s vertices) nodeVertex(nodeIdx int) int {
+	return nodeIdx + 1
+}
+
+func (s vertices) relationshipVertex(relationshipIdx int) int {
+	return len(s.nodes) + relationshipIdx + 1
+}
+
+// successors returns the vertices that follow each vertex when walking backwards from the sink, against the
+// direction of the relationships
+func (s vertices) successors() [][]int {
+	successors := make([][]int, len(s.nodes)+len(s.relationships)+1)
+
+	for _, root := range s.roots {
+		successors[0] = append(successors[0], s.nodeVertex(root))
+	}
+
+	for idx, relationship := range s.relationships {
+		var (
+			start = s.nodeVertex(s.index[relationship.StartID])
+			end   = s.nodeVertex(s.index[relationship.EndID])
+			split = s.relationshipVertex(idx)
+		)
+
+		successors[end] = append(successors[end], split)
+		successors[split] = append(successors[split], start)
+	}
+
+	return successors
+}
+
+// dominators computes the immediate dominator of every vertex reachable from the sink using the iterative algorithm
+// of Cooper, Harvey and Kennedy. A vertex dominates another when every path from the other vertex into the roots
+// passes through it. The vertices are also returned in postorder so that callers may walk the dominator tree from
+// the leaves up.
+func dominators(successors [][]int) ([]int, []int) {
+	var (
+		numVertices  = len(successors)
+		postorder    = make([]int, 0, numVertices)
+		postorderIdx = make([]int, numVertices)
+		visited      = make([]bool, numVertices)
+		predecessors = make([][]int, numVertices)
+		idom         = make([]int, numVertices)
+	)
+
+	for vertex, next := range successors {
+		for _, successor := range next {
+			predecessors[successor] = append(predecessors[successor], vertex)
+		}
+	}
+
+	// Iterative depth first search to number the vertices in postorder
+	type frame struct {
+		vertex int
+		next   int
+	}
+
+	stack := []frame{{vertex: 0}}
+	visited[0] = true
+
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+
+		if top.next < len(successors[top.vertex]) {
+			successor := successors[top.vertex][top.next]
+			top.next++
+
+			if !visited[successor] {
+				visited[successor] = true
+				stack = append(stack, frame{vertex: successor})
+			}
+		} else {
+			postorderIdx[top.vertex] = len(postorder)
+			postorder = append(postorder, top.vertex)
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	for vertex := range idom {
+		idom[vertex] = -1
+	}
+
+	idom[0] = 0
+
+	intersect := func(a, b int) int {
+		for a != b {
+			for postorderIdx[a] < postorderIdx[b] {
+				a = idom[a]
+			}
+
+			for postorderIdx[b] < postorderIdx[a] {
+				b = idom[b]
+			}
+		}
+
+		return a
+	}
+
+	for changed := true; changed; {
+		changed = false
+
+		// Walk in reverse postorder, skipping the sink which is the last vertex in postorder
+		for idx := len(postorder) - 2; idx >= 0; idx-- {
+			var (
+				vertex  = postorder[idx]
+				newIdom = -1
+			)
+
+			for _, predecessor := range predecessors[vertex] {
+				if idom[predecessor] == -1 {
+					continue
+				} else if newIdom == -1 {
+					newIdom = predecessor
+				} else {
+					newIdom = intersect(predecessor, newIdom)
+				}
+			}
+
+			if idom[vertex] != newIdom {
+				idom[vertex] = newIdom
+				changed = true
+			}
+		}
+	}
+
+	return idom, postorder
+}
+
+// principalsRemoved counts, for every vertex, the principals whose every path into the roots passes through it. A
+// principal is not counted against itself.
+func (s vertices) principalsRemoved() []int {
+	var (
+		idom, postorder = dominators(s.successors())
+		dominated       = make([]int, len(idom))
+	)
+
+	for nodeIdx, principal := range s.principal {
+		if principal {
+			dominated[s.nodeVertex(nodeIdx)] = 1
+		}
+	}
+
+	// Postorder visits every vertex before its immediate dominator
+	for _, vertex := range postorder {
+		if vertex != 0 {
+			dominated[idom[vertex]] += dominated[vertex]
+		}
+	}
+
+	for nodeIdx, principal := range s.principal {
+		if principal {
+			dominated[s.nodeVertex(nodeIdx)] -= 1
+		}
+	}
+
+	return dominated
+}
+
+// betweenness computes, for every node and relationship, the sum over principals of the fraction of the principal's
+// shortest paths into the roots that pass through it. Every shortest path into the roots only follows relationships
+// that lead one hop closer to them, so the fractions for all principals are accumulated in two linear passes over
+// that subgraph rather than with one search per principal.
+func (s vertices) betweenness() ([]float64, []float64) {
+	var (
+		distance = make([]int, len(s.nodes))
+		order    = make([]int, 0, len(s.nodes))
+		paths    = make([]float64, len(s.nodes))
+		flow     = make([]float64, len(s.nodes))
+
+		nodeBetweenness         = make([]float64, len(s.nodes))
+		relationshipBetweenness = make([]float64, len(s.relationships))
+	)
+
+	for idx := range distance {
+		distance[idx] = -1
+	}
+
+	for _, root := range s.roots {
+		distance[root] = 0
+		paths[root] = 1
+		order = append(order, root)
+	}
+
+	// Breadth first search against the direction of the relationships yields the nodes in order of their distance
+	for next := 0; next < len(order); next++ {
+		end := order[next]
+
+		for _, relationshipIdx := range s.inbound[end] {
+			start := s.index[s.relationships[relationshipIdx].StartID]
+
+			if distance[start] == -1 {
+				distance[start] = distance[end] + 1
+				order = append(order, start)
+			}
+		}
+	}
+
+	shortest := func(relationshipIdx int) (int, int, bool) {
+		var (
+			relationship = s.relationships[relationshipIdx]
+			start        = s.index[relationship.StartID]
+			end          = s.index[relationship.EndID]
+		)
+
+		return start, end, distance[start] != -1 && distance[start] == distance[end]+1
+	}
+
+	// Count the shortest paths from each node into the roots
+	for _, nodeIdx := range order {
+		for _, relationshipIdx := range s.outbound[nodeIdx] {
+			if _, end, isShortest := shortest(relationshipIdx); isShortest {
+				paths[nodeIdx] += paths[end]
+			}
+		}
+	}
+
+	// Push each principal's unit of flow toward the roots, split over its shortest paths
+	for idx := len(order) - 1; idx >= 0; idx-- {
+		nodeIdx := order[idx]
+
+		if s.principal[nodeIdx] {
+			flow[nodeIdx] += 1 / paths[nodeIdx]
+		}
+
+		for _, relationshipIdx := range s.inbound[nodeIdx] {
+			if start, _, isShortest := shortest(relationshipIdx); isShortest {
+				flow[nodeIdx] += flow[start]
+			}
+		}
+	}
+
+	for _, nodeIdx := range order {
+		if distance[nodeIdx] > 0 {
+			nodeBetweenness[nodeIdx] = flow[nodeIdx] * paths[nodeIdx]
+
+			if s.principal[nodeIdx] {
+				nodeBetweenness[nodeIdx] -= 1
+			}
+		}
+	}
+
+	for relationshipIdx := range s.relationships {
+		if start, end, isShortest := shortest(relationshipIdx); isShortest {
+			relationshipBetweenness[relationshipIdx] = flow[start] * paths[end]
+		}
+	}
+
+	return nodeBetweenness, relationshipBetweenness
+}
+
+func compareScores(aRemoved, bRemoved int, aBetweenness, bBetweenness float64, aID, bID graph.ID) int {
+	if byRemoved := cmp.Compare(bRemoved, aRemoved); byRemoved != 0 {
+		return byRemoved
+	} else if byBetweenness := cmp.Compare(bBetweenness, aBetweenness); byBetweenness != 0 {
+		return byBetweenness
+	}
+
+	return cmp.Compare(aID, bID)
+}
+
+// Analyze scores every node and relationship of the Graph other than the roots
+func (s *Graph) Analyze() Result {
+	var (
+		dense                                    = s.vertices()
+		removed                                  = dense.principalsRemoved()
+		nodeBetweenness, relationshipBetweenness = dense.betweenness()
+		result                                   Result
+	)
+
+	for nodeIdx, node := range dense.nodes {
+		if dense.principal[nodeIdx] {
+			result.Principals++
+		}
+
+		if s.IsRoot(node.ID) {
+			continue
+		}
+
+		if score := (NodeScore{
+			Node:              node,
+			PrincipalsRemoved: removed[dense.nodeVertex(nodeIdx)],
+			Betweenness:       nodeBetweenness[nodeIdx],
+		}); score.PrincipalsRemoved > 0 || score.Betweenness > 0 {
+			result.Nodes = append(result.Nodes, score)
+		}
+	}
+
+	for relationshipIdx, relationship := range dense.relationships {
+		if score := (RelationshipScore{
+			Relationship:      relationship,
+			PrincipalsRemoved: removed[dense.relationshipVertex(relationshipIdx)],
+			Betweenness:       relationshipBetweenness[relationshipIdx],
+		}); score.PrincipalsRemoved > 0 || score.Betweenness > 0 {
+			result.Relationships = append(result.Relationships, score)
+		}
+	}
+
+	slices.SortFunc(result.Nodes, func(a, b NodeScore) int {
+		return compareScores(a.PrincipalsRemoved, b.PrincipalsRemoved, a.Betweenness, b.Betweenness, a.Node.ID, b.Node.ID)
+	})
+
+	slices.SortFunc(result.Relationships, func(a, b RelationshipScore) int {
+		return compareScores(a.PrincipalsRemoved, b.PrincipalsRemoved, a.Betweenness, b.Betweenness, a.Relationship.ID, b.Relationship.ID)
+	})
+
+	return result
+}
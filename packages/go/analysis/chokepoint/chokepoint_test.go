@@ -0,0 +1,154 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package chokepoint_test
+
+import (
+	"testing"
+
+	"github.com/specterops/bloodhound/packages/go/analysis/chokepoint"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/dawgs/graph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testGraph struct {
+	graph *chokepoint.Graph
+	next  graph.ID
+}
+
+func newTestGraph(roots ...*graph.Node) *testGraph {
+	return &testGraph{
+		graph: chokepoint.NewGraph(graph.NewNodeSet(roots...)),
+		next:  100,
+	}
+}
+
+func (s *testGraph) relate(start *graph.Node, kind graph.Kind, end *graph.Node) *graph.Relationship {
+	relationship := &graph.Relationship{ID: s.next, StartID: start.ID, EndID: end.ID, Kind: kind}
+	s.next++
+
+	s.graph.Relate(start, relationship)
+	return relationship
+}
+
+func TestGraph_Relate(t *testing.T) {
+	var (
+		root     = graph.NewNode(1, graph.NewProperties(), ad.Entity, ad.Group)
+		user     = graph.NewNode(2, graph.NewProperties(), ad.Entity, ad.User)
+		computer = graph.NewNode(3, graph.NewProperties(), ad.Entity, ad.Computer)
+		loaded   = chokepoint.NewGraph(graph.NewNodeSet(root))
+	)
+
+	assert.True(t, loaded.Relate(user, &graph.Relationship{ID: 10, StartID: user.ID, EndID: root.ID, Kind: ad.GenericAll}))
+	assert.False(t, loaded.Relate(user, &graph.Relationship{ID: 11, StartID: user.ID, EndID: root.ID, Kind: ad.WriteDACL}), "known start nodes are not reported again")
+	assert.False(t, loaded.Relate(root, &graph.Relationship{ID: 12, StartID: root.ID, EndID: user.ID, Kind: ad.GenericAll}), "paths end at the first root")
+	assert.False(t, loaded.Relate(computer, &graph.Relationship{ID: 13, StartID: computer.ID, EndID: 99, Kind: ad.AdminTo}), "the end must already be part of the graph")
+
+	result := loaded.Analyze()
+	assert.Equal(t, 1, result.Principals)
+	assert.Empty(t, result.Nodes)
+	require.Len(t, result.Relationships, 2)
+	assert.Equal(t, 0.5, result.Relationships[0].Betweenness)
+	assert.Equal(t, 0, result.Relationships[0].PrincipalsRemoved)
+}
+
+func TestGraph_Analyze(t *testing.T) {
+	// userA and userB only reach Tier Zero through group, while userC also has a direct path
+	var (
+		root   = graph.NewNode(1, graph.NewProperties(), ad.Entity, ad.Group)
+		group  = graph.NewNode(2, graph.NewProperties(), ad.Entity, ad.Group)
+		userA  = graph.NewNode(3, graph.NewProperties(), ad.Entity, ad.User)
+		userB  = graph.NewNode(4, graph.NewProperties(), ad.Entity, ad.User)
+		userC  = graph.NewNode(5, graph.NewProperties(), ad.Entity, ad.User)
+		loaded = newTestGraph(root)
+
+		groupToRoot  = loaded.relate(group, ad.GenericAll, root)
+		userAToGroup = loaded.relate(userA, ad.MemberOf, group)
+		userBToGroup = loaded.relate(userB, ad.MemberOf, group)
+		_            = loaded.relate(userC, ad.MemberOf, group)
+		userCToRoot  = loaded.relate(userC, ad.WriteDACL, root)
+	)
+
+	result := loaded.graph.Analyze()
+	assert.Equal(t, 4, result.Principals)
+
+	require.Len(t, result.Nodes, 1)
+	assert.Equal(t, group.ID, result.Nodes[0].Node.ID)
+	assert.Equal(t, 2, result.Nodes[0].PrincipalsRemoved)
+	assert.Equal(t, float64(2), result.Nodes[0].Betweenness)
+
+	require.Len(t, result.Relationships, 4)
+	assert.Equal(t, groupToRoot.ID, result.Relationships[0].Relationship.ID)
+	assert.Equal(t, 3, result.Relationships[0].PrincipalsRemoved)
+	assert.Equal(t, float64(3), result.Relationships[0].Betweenness)
+
+	assert.Equal(t, userAToGroup.ID, result.Relationships[1].Relationship.ID)
+	assert.Equal(t, 1, result.Relationships[1].PrincipalsRemoved)
+	assert.Equal(t, userBToGroup.ID, result.Relationships[2].Relationship.ID)
+	assert.Equal(t, 1, result.Relationships[2].PrincipalsRemoved)
+
+	// userC's membership is not on a shortest path, so only its direct relationship is scored
+	assert.Equal(t, userCToRoot.ID, result.Relationships[3].Relationship.ID)
+	assert.Equal(t, 0, result.Relationships[3].PrincipalsRemoved)
+	assert.Equal(t, float64(1), result.Relationships[3].Betweenness)
+}
+
+func TestGraph_Analyze_SplitPaths(t *testing.T) {
+	// user reaches Tier Zero through either of two GPOs, so neither is a choke point on its own
+	var (
+		root   = graph.NewNode(1, graph.NewProperties(), ad.Entity, ad.Domain)
+		gpoA   = graph.NewNode(2, graph.NewProperties(), ad.Entity, ad.GPO)
+		gpoB   = graph.NewNode(3, graph.NewProperties(), ad.Entity, ad.GPO)
+		user   = graph.NewNode(4, graph.NewProperties(), ad.Entity, ad.User)
+		loaded = newTestGraph(root)
+	)
+
+	loaded.relate(gpoA, ad.GPLink, root)
+	loaded.relate(gpoB, ad.GPLink, root)
+	loaded.relate(user, ad.GenericWrite, gpoA)
+	loaded.relate(user, ad.GenericWrite, gpoB)
+
+	result := loaded.graph.Analyze()
+	assert.Equal(t, 1, result.Principals)
+
+	require.Len(t, result.Nodes, 2)
+	for _, score := range result.Nodes {
+		assert.Equal(t, 0, score.PrincipalsRemoved)
+		assert.Equal(t, 0.5, score.Betweenness)
+	}
+
+	require.Len(t, result.Relationships, 4)
+	for _, score := range result.Relationships {
+		assert.Equal(t, 0, score.PrincipalsRemoved)
+		assert.Equal(t, 0.5, score.Betweenness)
+	}
+
+	// Adding a single relationship into user makes it, and the new relationship, choke points for the new principal
+	computer := graph.NewNode(5, graph.NewProperties(), ad.Entity, ad.Computer)
+	session := loaded.relate(computer, ad.HasSession, user)
+
+	result = loaded.graph.Analyze()
+	assert.Equal(t, 2, result.Principals)
+	require.NotEmpty(t, result.Nodes)
+	assert.Equal(t, user.ID, result.Nodes[0].Node.ID)
+	assert.Equal(t, 1, result.Nodes[0].PrincipalsRemoved)
+	assert.Equal(t, float64(1), result.Nodes[0].Betweenness)
+	require.NotEmpty(t, result.Relationships)
+	assert.Equal(t, session.ID, result.Relationships[0].Relationship.ID)
+	assert.Equal(t, 1, result.Relationships[0].PrincipalsRemoved)
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package chokepoint
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/query"
+)
+
+const (
+	// loadBatchSize bounds the number of node IDs matched by a single query while loading a Graph
+	loadBatchSize = 1000
+
+	// MaximumNodes bounds the number of nodes loaded into a Graph
+	MaximumNodes = 2_000_000
+)
+
+// ErrGraphTooLarge is returned by Load when the paths into the roots hold more than MaximumNodes nodes
+var ErrGraphTooLarge = errors.New("attack paths exceed the maximum number of nodes")
+
+// Load walks the relationships of the given kinds backwards from the roots and returns every path leading into them
+// as a Graph. Only the IDs and kinds of the nodes and relationships are loaded; callers fetch the properties of the
+// few nodes they report on.
+func Load(ctx context.Context, db graph.Database, roots graph.NodeSet, traversalKinds graph.Kinds) (*Graph, error) {
+	loaded := NewGraph(roots)
+
+	return loaded, db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		frontier := roots.IDs()
+
+		for len(frontier) > 0 {
+			var next []graph.ID
+
+			for batch := range slices.Chunk(frontier, loadBatchSize) {
+				if err := tx.Relationships().Filter(query.And(
+					query.InIDs(query.EndID(), batch...),
+					query.KindIn(query.Relationship(), traversalKinds...),
+				)).FetchKinds(func(cursor graph.Cursor[graph.RelationshipKindsResult]) error {
+					for result := range cursor.Chan() {
+						relationship := &graph.Relationship{
+							ID:      result.ID,
+							StartID: result.StartID,
+							EndID:   result.EndID,
+							Kind:    result.Kind,
+						}
+
+						if loaded.Relate(graph.NewNode(result.StartID, graph.NewProperties()), relationship) {
+							next = append(next, result.StartID)
+						}
+					}
+
+					return cursor.Error()
+				}); err != nil {
+					return err
+				}
+			}
+
+			if loaded.Len() > MaximumNodes {
+				return ErrGraphTooLarge
+			}
+
+			// Principals are told apart by kind, so the kinds of the nodes found at this depth are fetched as well
+			for batch := range slices.Chunk(next, loadBatchSize) {
+				if err := tx.Nodes().Filter(
+					query.InIDs(query.NodeID(), batch...),
+				).FetchKinds(func(cursor graph.Cursor[graph.KindsResult]) error {
+					for result := range cursor.Chan() {
+						if node, found := loaded.Node(result.ID); found {
+							node.Kinds = result.Kinds
+						}
+					}
+
+					return cursor.Error()
+				}); err != nil {
+					return err
+				}
+			}
+
+			frontier = next
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package environment enumerates the collected domains and tenants that attack path analysis scores independently
+package environment
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	adAnalysis "github.com/specterops/bloodhound/packages/go/analysis/ad"
+	azureAnalysis "github.com/specterops/bloodhound/packages/go/analysis/azure"
+	"github.com/specterops/bloodhound/packages/go/analysis/tiering"
+	"github.com/specterops/bloodhound/packages/go/bhlog/attr"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/ops"
+	"github.com/specterops/dawgs/query"
+)
+
+// Environment is a collected domain or tenant along with its Tier Zero members
+type Environment struct {
+	ObjectID string
+	Node     *graph.Node
+	TierZero graph.NodeSet
+}
+
+// Members returns criteria matching the nodes that belong to the environment
+func (s Environment) Members() graph.Criteria {
+	if s.Node.Kinds.ContainsOneOf(azure.Tenant) {
+		return query.And(
+			query.Kind(query.Node(), azure.Entity),
+			query.Equals(query.NodeProperty(azure.TenantID.String()), s.ObjectID),
+		)
+	}
+
+	return query.And(
+		query.Kind(query.Node(), ad.Entity),
+		query.Equals(query.NodeProperty(ad.DomainSID.String()), s.ObjectID),
+	)
+}
+
+func fetchTierZero(tx graph.Transaction, nextEnvironment Environment, tieringEnabled bool) (graph.NodeSet, error) {
+	tierZero, err := ops.FetchNodeSet(tx.Nodes().Filterf(func() graph.Criteria {
+		return query.And(
+			nextEnvironment.Members(),
+			tiering.SearchTierNodes(tieringEnabled),
+		)
+	}))
+
+	if err != nil {
+		return nil, err
+	} else if tiering.IsTierZero(nextEnvironment.Node) {
+		tierZero.Add(nextEnvironment.Node)
+	}
+
+	return tierZero, nil
+}
+
+func appendEnvironments(ctx context.Context, tx graph.Transaction, environments []Environment, nodes graph.NodeSet, tieringEnabled bool) ([]Environment, error) {
+	for _, node := range nodes {
+		if objectID, err := node.Properties.Get(common.ObjectID.String()).String(); err != nil {
+			slog.WarnContext(ctx, "Environment node does not have a valid objectid property", slog.Uint64("node_id", node.ID.Uint64()), attr.Error(err))
+		} else {
+			nextEnvironment := Environment{
+				ObjectID: objectID,
+				Node:     node,
+			}
+
+			if nextEnvironment.TierZero, err = fetchTierZero(tx, nextEnvironment, tieringEnabled); err != nil {
+				return nil, fmt.Errorf("fetching tier zero members of environment %s: %w", objectID, err)
+			}
+
+			environments = append(environments, nextEnvironment)
+		}
+	}
+
+	return environments, nil
+}
+
+// Fetch returns every collected domain and tenant along with its Tier Zero members
+func Fetch(ctx context.Context, graphDB graph.Database, tieringEnabled bool) ([]Environment, error) {
+	var environments []Environment
+
+	return environments, graphDB.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		if domains, err := adAnalysis.FetchCollectedDomains(tx); err != nil {
+			return fmt.Errorf("fetching collected domains: %w", err)
+		} else if environments, err = appendEnvironments(ctx, tx, environments, domains, tieringEnabled); err != nil {
+			return err
+		}
+
+		if tenants, err := azureAnalysis.FetchCollectedTenants(tx); err != nil {
+			return fmt.Errorf("fetching collected tenants: %w", err)
+		} else if environments, err = appendEnvironments(ctx, tx, environments, tenants, tieringEnabled); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,265 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package exposure scores how far the attack paths of each principal reach. A principal's impact is the number of
+// Tier Zero objects it has a path to and its exposure is the number of other principals with a path to it.
+//
+// Counting the nodes reachable from every node exactly requires the transitive closure of the graph, so both counts
+// are taken with bottom-k reachability sketches (Cohen, 1997). Each source is assigned a pseudo-random rank and sources
+// are walked in rank order, with a node recording the first SketchSize sources that reach it. Counts below SketchSize
+// are exact; larger counts are estimated from the rank of the last source recorded. Every node is expanded at most
+// SketchSize times, so scoring a graph takes O(SketchSize * E) time and O(V) memory.
+package exposure
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/dawgs/graph"
+)
+
+// SketchSize is the largest count that is computed exactly. The relative standard error of larger, estimated counts
+// is roughly 1/sqrt(SketchSize - 2).
+const SketchSize = 64
+
+// PrincipalKinds are the kinds of nodes scored as principals
+var PrincipalKinds = graph.Kinds{ad.User, ad.Computer, azure.User, azure.Device}
+
+// Score holds the impact and exposure of a principal. Estimated is set when either count exceeded SketchSize.
+type Score struct {
+	Node      *graph.Node
+	Impact    int
+	Exposure  int
+	Estimated bool
+}
+
+// Graph is a dense, in-memory copy of the attack paths leaving a set of principals
+type Graph struct {
+	ids           map[graph.ID]int32
+	nodes         []*graph.Node
+	outbound      [][]int32
+	inbound       [][]int32
+	principals    []int32
+	tierZero      []int32
+	tierZeroNodes graph.NodeSet
+}
+
+// NewGraph returns a Graph holding the given principals, which are the nodes scored and counted as principals. Nodes
+// later added to the Graph are counted as Tier Zero when they are members of tierZero.
+func NewGraph(principals graph.NodeSet, tierZero graph.NodeSet) *Graph {
+	var (
+		newGraph = &Graph{
+			ids:           make(map[graph.ID]int32, principals.Len()),
+			tierZeroNodes: tierZero,
+		}
+		ordered = principals.Slice()
+	)
+
+	// Principals are added in ID order so that the Graph does not depend on map iteration order
+	slices.SortFunc(ordered, func(a, b *graph.Node) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	for _, principal := range ordered {
+		newGraph.principals = append(newGraph.principals, newGraph.add(principal))
+	}
+
+	return newGraph
+}
+
+func (s *Graph) add(node *graph.Node) int32 {
+	vertex := int32(len(s.nodes))
+
+	s.ids[node.ID] = vertex
+	s.nodes = append(s.nodes, node)
+	s.outbound = append(s.outbound, nil)
+	s.inbound = append(s.inbound, nil)
+
+	if s.tierZeroNodes.ContainsID(node.ID) {
+		s.tierZero = append(s.tierZero, vertex)
+	}
+
+	return vertex
+}
+
+// Relate adds a relationship leaving a node already in the Graph along with the node it leads to. The returned bool is
+// true when end was not yet part of the Graph and its own relationships must be added. Self-referencing relationships
+// and relationships leaving unknown nodes are ignored.
+func (s *Graph) Relate(relationship *graph.Relationship, end *graph.Node) bool {
+	start, found := s.ids[relationship.StartID]
+	if !found || relationship.StartID == relationship.EndID {
+		return false
+	}
+
+	vertex, known := s.ids[end.ID]
+	if !known {
+		vertex = s.add(end)
+	}
+
+	s.outbound[start] = append(s.outbound[start], vertex)
+	s.inbound[vertex] = append(s.inbound[vertex], start)
+
+	return !known
+}
+
+// Len returns the number of nodes in the Graph
+func (s *Graph) Len() int {
+	return len(s.nodes)
+}
+
+// Node returns the node with the given ID if it is part of the Graph
+func (s *Graph) Node(id graph.ID) (*graph.Node, bool) {
+	if vertex, found := s.ids[id]; found {
+		return s.nodes[vertex], true
+	}
+
+	return nil, false
+}
+
+// rank maps a node ID to a pseudo-random value in (0, 1] using the SplitMix64 finalizer, so that sketches are
+// reproducible between runs over the same graph
+func rank(id graph.ID) float64 {
+	value := uint64(id) + 0x9e3779b97f4a7c15
+	value = (value ^ (value >> 30)) * 0xbf58476d1ce4e5b9
+	value = (value ^ (value >> 27)) * 0x94d049bb133111eb
+	value ^= value >> 31
+
+	return float64(value>>11+1) / (1 << 53)
+}
+
+// sketch records, for every vertex, how many sources reach it up to SketchSize and the rank of the last one recorded
+type sketch struct {
+	counts   []int32
+	lastRank []float64
+	sources  int
+}
+
+// newSketch walks adjacency from every source in rank order. A source is counted as reaching itself.
+func newSketch(adjacency [][]int32, sources []int32, ids func(int32) graph.ID) sketch {
+	var (
+		result = sketch{
+			counts:   make([]int32, len(adjacency)),
+			lastRank: make([]float64, len(adjacency)),
+			sources:  len(sources),
+		}
+		visited = make([]int32, len(adjacency))
+		ranked  = slices.Clone(sources)
+		queue   []int32
+	)
+
+	slices.SortFunc(ranked, func(a, b int32) int {
+		if byRank := cmp.Compare(rank(ids(a)), rank(ids(b))); byRank != 0 {
+			return byRank
+		}
+
+		return cmp.Compare(ids(a), ids(b))
+	})
+
+	for idx, source := range ranked {
+		var (
+			sourceRank = rank(ids(source))
+			mark       = int32(idx + 1)
+		)
+
+		visited[source] = mark
+		queue = append(queue[:0], source)
+
+		for head := 0; head < len(queue); head++ {
+			vertex := queue[head]
+
+			// A full sketch already holds lower ranked sources that reach every vertex past this one
+			if result.counts[vertex] >= SketchSize {
+				continue
+			}
+
+			result.counts[vertex]++
+			result.lastRank[vertex] = sourceRank
+
+			for _, next := range adjacency[vertex] {
+				if visited[next] != mark && result.counts[next] < SketchSize {
+					visited[next] = mark
+					queue = append(queue, next)
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+// count returns the number of sources reaching the given vertex, excluding the vertex itself when it is a source
+func (s sketch) count(vertex int32, isSource bool) (int, bool) {
+	var (
+		count     = int(s.counts[vertex])
+		estimated = false
+	)
+
+	if count >= SketchSize {
+		estimated = true
+		count = int(math.Round((SketchSize - 1) / s.lastRank[vertex]))
+		count = min(max(count, SketchSize), s.sources)
+	}
+
+	if isSource {
+		count = max(count-1, 0)
+	}
+
+	return count, estimated
+}
+
+// Score returns the impact and exposure of every principal in the Graph, ordered by impact, then exposure, descending
+func (s *Graph) Score() []Score {
+	var (
+		ids        = func(vertex int32) graph.ID { return s.nodes[vertex].ID }
+		impacts    = newSketch(s.inbound, s.tierZero, ids)
+		exposures  = newSketch(s.outbound, s.principals, ids)
+		isTierZero = make([]bool, len(s.nodes))
+		scores     = make([]Score, 0, len(s.principals))
+	)
+
+	for _, vertex := range s.tierZero {
+		isTierZero[vertex] = true
+	}
+
+	for _, vertex := range s.principals {
+		var (
+			impact, impactEstimated     = impacts.count(vertex, isTierZero[vertex])
+			exposure, exposureEstimated = exposures.count(vertex, true)
+		)
+
+		scores = append(scores, Score{
+			Node:      s.nodes[vertex],
+			Impact:    impact,
+			Exposure:  exposure,
+			Estimated: impactEstimated || exposureEstimated,
+		})
+	}
+
+	slices.SortFunc(scores, func(a, b Score) int {
+		if byImpact := cmp.Compare(b.Impact, a.Impact); byImpact != 0 {
+			return byImpact
+		} else if byExposure := cmp.Compare(b.Exposure, a.Exposure); byExposure != 0 {
+			return byExposure
+		}
+
+		return cmp.Compare(a.Node.ID, b.Node.ID)
+	})
+
+	return scores
+}
@@ -0,0 +1,148 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package exposure_test
+
+import (
+	"testing"
+
+	"github.com/specterops/bloodhound/packages/go/analysis/exposure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/dawgs/graph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testGraph struct {
+	graph *exposure.Graph
+	next  graph.ID
+}
+
+func newTestGraph(principals []*graph.Node, tierZero ...*graph.Node) *testGraph {
+	return &testGraph{
+		graph: exposure.NewGraph(graph.NewNodeSet(principals...), graph.NewNodeSet(tierZero...)),
+		next:  100000,
+	}
+}
+
+func (s *testGraph) relate(start *graph.Node, kind graph.Kind, end *graph.Node) {
+	s.graph.Relate(&graph.Relationship{ID: s.next, StartID: start.ID, EndID: end.ID, Kind: kind}, end)
+	s.next++
+}
+
+func scoresByID(scores []exposure.Score) map[graph.ID]exposure.Score {
+	byID := make(map[graph.ID]exposure.Score, len(scores))
+	for _, score := range scores {
+		byID[score.Node.ID] = score
+	}
+
+	return byID
+}
+
+func TestGraph_Relate(t *testing.T) {
+	var (
+		user     = graph.NewNode(1, graph.NewProperties(), ad.Entity, ad.User)
+		group    = graph.NewNode(2, graph.NewProperties(), ad.Entity, ad.Group)
+		computer = graph.NewNode(3, graph.NewProperties(), ad.Entity, ad.Computer)
+		loaded   = exposure.NewGraph(graph.NewNodeSet(user), graph.NewNodeSet())
+	)
+
+	assert.True(t, loaded.Relate(&graph.Relationship{ID: 10, StartID: user.ID, EndID: group.ID, Kind: ad.MemberOf}, group))
+	assert.False(t, loaded.Relate(&graph.Relationship{ID: 11, StartID: user.ID, EndID: group.ID, Kind: ad.GenericAll}, group), "known end nodes are not reported again")
+	assert.False(t, loaded.Relate(&graph.Relationship{ID: 12, StartID: computer.ID, EndID: user.ID, Kind: ad.HasSession}, user), "the start must already be part of the graph")
+	assert.False(t, loaded.Relate(&graph.Relationship{ID: 13, StartID: user.ID, EndID: user.ID, Kind: ad.GenericAll}, user), "self-referencing relationships are ignored")
+
+	_, found := loaded.Node(group.ID)
+	assert.True(t, found)
+
+	_, found = loaded.Node(computer.ID)
+	assert.False(t, found)
+}
+
+func TestGraph_Score(t *testing.T) {
+	// alice and bob reach each other and Domain Admins through a group; carol is Tier Zero and reaches the domain, and
+	// dave is reached by bob but reaches nothing
+	var (
+		alice        = graph.NewNode(1, graph.NewProperties(), ad.Entity, ad.User)
+		bob          = graph.NewNode(2, graph.NewProperties(), ad.Entity, ad.User)
+		carol        = graph.NewNode(3, graph.NewProperties(), ad.Entity, ad.User)
+		dave         = graph.NewNode(4, graph.NewProperties(), ad.Entity, ad.Computer)
+		helpdesk     = graph.NewNode(5, graph.NewProperties(), ad.Entity, ad.Group)
+		domainAdmins = graph.NewNode(6, graph.NewProperties(), ad.Entity, ad.Group)
+		domain       = graph.NewNode(7, graph.NewProperties(), ad.Entity, ad.Domain)
+		loaded       = newTestGraph([]*graph.Node{alice, bob, carol, dave}, domainAdmins, domain, carol)
+	)
+
+	loaded.relate(alice, ad.MemberOf, helpdesk)
+	loaded.relate(helpdesk, ad.ForceChangePassword, bob)
+	loaded.relate(bob, ad.GenericAll, alice)
+	loaded.relate(bob, ad.AdminTo, dave)
+	loaded.relate(helpdesk, ad.AddMember, domainAdmins)
+	loaded.relate(domainAdmins, ad.GenericAll, domain)
+	loaded.relate(carol, ad.DCSync, domain)
+
+	scores := loaded.graph.Score()
+	require.Len(t, scores, 4)
+
+	// Ranked by impact, then exposure, then ID
+	assert.Equal(t, []graph.ID{1, 2, 3, 4}, []graph.ID{scores[0].Node.ID, scores[1].Node.ID, scores[2].Node.ID, scores[3].Node.ID})
+
+	byID := scoresByID(scores)
+
+	assert.Equal(t, exposure.Score{Node: alice, Impact: 2, Exposure: 1}, byID[alice.ID])
+	assert.Equal(t, exposure.Score{Node: bob, Impact: 2, Exposure: 1}, byID[bob.ID])
+	assert.Equal(t, exposure.Score{Node: carol, Impact: 1, Exposure: 0}, byID[carol.ID], "a Tier Zero principal does not count itself")
+	assert.Equal(t, exposure.Score{Node: dave, Impact: 0, Exposure: 2}, byID[dave.ID])
+}
+
+func TestGraph_ScoreEstimated(t *testing.T) {
+	const principalCount = 20 * exposure.SketchSize
+
+	var (
+		target     = graph.NewNode(1, graph.NewProperties(), ad.Entity, ad.Computer)
+		tierZero   = graph.NewNode(2, graph.NewProperties(), ad.Entity, ad.Group)
+		hub        = graph.NewNode(3, graph.NewProperties(), ad.Entity, ad.Group)
+		principals = []*graph.Node{target}
+	)
+
+	for id := graph.ID(10); len(principals) <= principalCount; id++ {
+		principals = append(principals, graph.NewNode(id, graph.NewProperties(), ad.Entity, ad.User))
+	}
+
+	loaded := newTestGraph(principals, tierZero)
+
+	for _, principal := range principals[1:] {
+		loaded.relate(principal, ad.MemberOf, hub)
+	}
+
+	loaded.relate(hub, ad.AdminTo, target)
+	loaded.relate(target, ad.GenericAll, tierZero)
+
+	byID := scoresByID(loaded.graph.Score())
+
+	// Every user reaches the target, which is too many to count exactly
+	targetScore := byID[target.ID]
+	assert.True(t, targetScore.Estimated)
+	assert.Equal(t, 1, targetScore.Impact)
+	assert.InDelta(t, principalCount, targetScore.Exposure, 0.5*principalCount)
+	assert.LessOrEqual(t, targetScore.Exposure, principalCount)
+
+	// Each user is only reached by itself
+	userScore := byID[principals[1].ID]
+	assert.False(t, userScore.Estimated)
+	assert.Equal(t, 1, userScore.Impact)
+	assert.Equal(t, 0, userScore.Exposure)
+}
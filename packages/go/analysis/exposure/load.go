@@ -0,0 +1,83 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package exposure
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/query"
+)
+
+const (
+	// loadBatchSize bounds the number of node IDs matched by a single relationship query while loading a Graph
+	loadBatchSize = 1000
+
+	// MaximumNodes bounds the number of nodes loaded into a Graph
+	MaximumNodes = 2_000_000
+)
+
+// ErrGraphTooLarge is returned by Load when the paths leaving the principals hold more than MaximumNodes nodes
+var ErrGraphTooLarge = errors.New("attack paths exceed the maximum number of nodes")
+
+// Load walks the relationships of the given kinds forwards from the principals and returns every path leaving them as
+// a Graph. Principals and Tier Zero members are told apart by ID, so only the IDs of the other nodes are loaded.
+func Load(ctx context.Context, db graph.Database, principals graph.NodeSet, tierZero graph.NodeSet, traversalKinds graph.Kinds) (*Graph, error) {
+	loaded := NewGraph(principals, tierZero)
+
+	return loaded, db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		frontier := principals.IDs()
+
+		for len(frontier) > 0 {
+			var next []graph.ID
+
+			for batch := range slices.Chunk(frontier, loadBatchSize) {
+				if err := tx.Relationships().Filter(query.And(
+					query.InIDs(query.StartID(), batch...),
+					query.KindIn(query.Relationship(), traversalKinds...),
+				)).FetchKinds(func(cursor graph.Cursor[graph.RelationshipKindsResult]) error {
+					for result := range cursor.Chan() {
+						relationship := &graph.Relationship{
+							ID:      result.ID,
+							StartID: result.StartID,
+							EndID:   result.EndID,
+							Kind:    result.Kind,
+						}
+
+						if loaded.Relate(relationship, graph.NewNode(result.EndID, graph.NewProperties())) {
+							next = append(next, result.EndID)
+						}
+					}
+
+					return cursor.Error()
+				}); err != nil {
+					return err
+				}
+			}
+
+			if loaded.Len() > MaximumNodes {
+				return ErrGraphTooLarge
+			}
+
+			frontier = next
+		}
+
+		return nil
+	})
+}
@@ -34,6 +34,10 @@ import (
 	"github.com/specterops/dawgs/util/channels"
 )
 
+// CompositionRuleOnPremisesSync is recorded on the SyncedToEntraUser and SyncedToADUser edges. No contributing nodes are
+// recorded as the edges follow from the Entra user's on-premises security identifier matching the AD user's object ID.
+const CompositionRuleOnPremisesSync = "OnPremisesSecurityIdentifierMatch"
+
 func fetchTenants(ctx context.Context, db graph.Database) (graph.NodeSet, error) {
 	var nodeSet graph.NodeSet
 	if err := db.ReadTransaction(ctx, func(tx graph.Transaction) error {
@@ -129,9 +133,10 @@ func PostHybrid(ctx context.Context, db graph.Database) (*post.AtomicPostProcess
 		if err := operation.Operation.SubmitReader(func(ctx context.Context, tx graph.Transaction, outC chan<- post.EnsureRelationshipJob) error {
 			for azUser, adUser := range entraToADMap {
 				SyncedToEntraUserRelationship := post.EnsureRelationshipJob{
-					FromID: adUser,
-					ToID:   azUser,
-					Kind:   azure.SyncedToEntraUser,
+					FromID:      adUser,
+					ToID:        azUser,
+					Kind:        azure.SyncedToEntraUser,
+					Composition: post.NewEdgeComposition(CompositionRuleOnPremisesSync),
 				}
 
 				if !channels.Submit(ctx, outC, SyncedToEntraUserRelationship) {
@@ -139,9 +144,10 @@ func PostHybrid(ctx context.Context, db graph.Database) (*post.AtomicPostProcess
 				}
 
 				SyncedToADUserRelationship := post.EnsureRelationshipJob{
-					FromID: azUser,
-					ToID:   adUser,
-					Kind:   adSchema.SyncedToADUser,
+					FromID:      azUser,
+					ToID:        adUser,
+					Kind:        adSchema.SyncedToADUser,
+					Composition: post.NewEdgeComposition(CompositionRuleOnPremisesSync),
 				}
 
 				if !channels.Submit(ctx, outC, SyncedToADUserRelationship) {
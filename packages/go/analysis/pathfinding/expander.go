@@ -0,0 +1,106 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pathfinding
+
+import (
+	"context"
+
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/query"
+)
+
+// TransactionExpander expands nodes by fetching their outbound relationships within a dawgs transaction. Expansions
+// are cached so that the repeated searches made by KShortestPaths query each node at most once.
+type TransactionExpander struct {
+	tx        graph.Transaction
+	criteria  graph.Criteria
+	direction graph.Direction
+	cache     map[graph.ID][]Edge
+}
+
+// NewTransactionExpander returns a TransactionExpander that only traverses relationships matching the given
+// criteria. A nil criteria traverses every relationship.
+func NewTransactionExpander(tx graph.Transaction, criteria graph.Criteria) *TransactionExpander {
+	return &TransactionExpander{
+		tx:        tx,
+		criteria:  criteria,
+		direction: graph.DirectionOutbound,
+		cache:     map[graph.ID][]Edge{},
+	}
+}
+
+// NewInboundTransactionExpander returns a TransactionExpander that walks the relationships matching the given criteria
+// against their direction. Each Edge leads to the node at the start of an inbound relationship.
+func NewInboundTransactionExpander(tx graph.Transaction, criteria graph.Criteria) *TransactionExpander {
+	return &TransactionExpander{
+		tx:        tx,
+		criteria:  criteria,
+		direction: graph.DirectionInbound,
+		cache:     map[graph.ID][]Edge{},
+	}
+}
+
+func (s *TransactionExpander) Expand(_ context.Context, node *graph.Node) ([]Edge, error) {
+	if edges, cached := s.cache[node.ID]; cached {
+		return edges, nil
+	}
+
+	var (
+		edges             []Edge
+		traversalCriteria = []graph.Criteria{
+			query.Equals(query.StartID(), query.Parameter(node.ID)),
+			query.Not(
+				query.Equals(query.EndID(), query.Parameter(node.ID)),
+			),
+		}
+		// Fetching in the inbound direction yields the node at the end of each outbound relationship
+		fetchDirection = graph.DirectionInbound
+	)
+
+	if s.direction == graph.DirectionInbound {
+		traversalCriteria = []graph.Criteria{
+			query.Equals(query.EndID(), query.Parameter(node.ID)),
+			query.Not(
+				query.Equals(query.StartID(), query.Parameter(node.ID)),
+			),
+		}
+		fetchDirection = graph.DirectionOutbound
+	}
+
+	if s.criteria != nil {
+		traversalCriteria = append(traversalCriteria, s.criteria)
+	}
+
+	if err := s.tx.Relationships().Filter(query.And(traversalCriteria...)).FetchDirection(
+		fetchDirection,
+		func(cursor graph.Cursor[graph.DirectionalResult]) error {
+			for next := range cursor.Chan() {
+				edges = append(edges, Edge{
+					Relationship: next.Relationship,
+					Node:         next.Node,
+				})
+			}
+
+			return cursor.Error()
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	s.cache[node.ID] = edges
+	return edges, nil
+}
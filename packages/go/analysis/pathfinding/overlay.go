@@ -0,0 +1,172 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pathfinding
+
+import (
+	"context"
+	"math"
+
+	"github.com/specterops/dawgs/graph"
+)
+
+// RelationshipRef identifies a relationship by the nodes it joins and its kind
+type RelationshipRef struct {
+	StartID graph.ID
+	EndID   graph.ID
+	Kind    graph.Kind
+}
+
+func (s RelationshipRef) matches(relationship *graph.Relationship) bool {
+	return relationship.StartID == s.StartID && relationship.EndID == s.EndID && relationship.Kind != nil && relationship.Kind.Is(s.Kind)
+}
+
+// Overlay is an Expander that layers hypothetical changes over another Expander. Excluded relationships are hidden
+// from searches and synthetic relationships are traversed as though they existed. The underlying graph is never
+// written to, so the same base Expander may be searched with and without the overlay.
+type Overlay struct {
+	base      Expander
+	inbound   bool
+	excluded  map[graph.ID][]RelationshipRef
+	synthetic map[graph.ID][]Edge
+	changes   []overlayChange
+	nextID    graph.ID
+}
+
+// overlayChange records a change in the order it was made so that it may be replayed by Reverse
+type overlayChange struct {
+	exclusion    *RelationshipRef
+	relationship *graph.Relationship
+	start        *graph.Node
+	end          *graph.Node
+}
+
+// NewOverlay returns an Overlay with no changes applied to base
+func NewOverlay(base Expander) *Overlay {
+	return &Overlay{
+		base:      base,
+		excluded:  map[graph.ID][]RelationshipRef{},
+		synthetic: map[graph.ID][]Edge{},
+		nextID:    math.MaxUint64,
+	}
+}
+
+// Reverse returns an Overlay applying the same changes to base, an Expander that walks relationships against their
+// direction such as one returned by NewInboundTransactionExpander. Synthetic relationships keep their IDs.
+func (s *Overlay) Reverse(base Expander) *Overlay {
+	reversed := NewOverlay(base)
+	reversed.inbound = !s.inbound
+
+	for _, change := range s.changes {
+		if change.exclusion != nil {
+			reversed.Exclude(*change.exclusion)
+		} else {
+			reversed.include(change.relationship, change.start, change.end)
+		}
+	}
+
+	reversed.nextID = s.nextID
+	return reversed
+}
+
+// Exclude hides every relationship matching ref from searches
+func (s *Overlay) Exclude(ref RelationshipRef) {
+	expanded := ref.StartID
+	if s.inbound {
+		expanded = ref.EndID
+	}
+
+	s.excluded[expanded] = append(s.excluded[expanded], ref)
+	s.changes = append(s.changes, overlayChange{exclusion: &ref})
+}
+
+// Include adds a synthetic relationship of the given kind from start to end and returns it. Synthetic relationships
+// are assigned IDs counting down from the largest graph.ID so that they do not collide with stored relationships.
+func (s *Overlay) Include(start, end *graph.Node, kind graph.Kind) *graph.Relationship {
+	relationship := &graph.Relationship{
+		ID:         s.nextID,
+		StartID:    start.ID,
+		EndID:      end.ID,
+		Kind:       kind,
+		Properties: graph.NewProperties(),
+	}
+
+	s.nextID--
+	s.include(relationship, start, end)
+
+	return relationship
+}
+
+func (s *Overlay) include(relationship *graph.Relationship, start, end *graph.Node) {
+	if s.inbound {
+		s.synthetic[end.ID] = append(s.synthetic[end.ID], Edge{
+			Relationship: relationship,
+			Node:         start,
+		})
+	} else {
+		s.synthetic[start.ID] = append(s.synthetic[start.ID], Edge{
+			Relationship: relationship,
+			Node:         end,
+		})
+	}
+
+	s.changes = append(s.changes, overlayChange{
+		relationship: relationship,
+		start:        start,
+		end:          end,
+	})
+}
+
+// IsSynthetic reports whether the given relationship ID was assigned by Include
+func (s *Overlay) IsSynthetic(id graph.ID) bool {
+	return id > s.nextID
+}
+
+func (s *Overlay) Expand(ctx context.Context, node *graph.Node) ([]Edge, error) {
+	edges, err := s.base.Expand(ctx, node)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		exclusions = s.excluded[node.ID]
+		additions  = s.synthetic[node.ID]
+	)
+
+	if len(exclusions) == 0 && len(additions) == 0 {
+		return edges, nil
+	}
+
+	// The base Expander may cache its expansions so the result is copied rather than filtered in place
+	overlaid := make([]Edge, 0, len(edges)+len(additions))
+
+	for _, edge := range edges {
+		excluded := false
+
+		for _, exclusion := range exclusions {
+			if exclusion.matches(edge.Relationship) {
+				excluded = true
+				break
+			}
+		}
+
+		if !excluded {
+			overlaid = append(overlaid, edge)
+		}
+	}
+
+	return append(overlaid, additions...), nil
+}
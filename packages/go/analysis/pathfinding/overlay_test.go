@@ -0,0 +1,107 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pathfinding_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/specterops/bloodhound/packages/go/analysis/pathfinding"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/dawgs/graph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOverlay_Exclude(t *testing.T) {
+	// 0 -GenericAll-> 1 -Contains-> 2 is the only path; removing GenericAll must leave 2 unreachable
+	expander := newMemoryExpander(3)
+	expander.relate(0, 1, ad.GenericAll)
+	expander.relate(0, 1, ad.Owns)
+	expander.relate(1, 2, ad.Contains)
+
+	overlay := pathfinding.NewOverlay(expander)
+	overlay.Exclude(pathfinding.RelationshipRef{StartID: 0, EndID: 1, Kind: ad.GenericAll})
+
+	path, found, err := pathfinding.ShortestPath(context.Background(), overlay, pathfinding.HopCostTable(), expander.nodes[0], expander.nodes[2], pathfinding.SearchLimits{})
+	require.NoError(t, err)
+	require.True(t, found, "the parallel Owns relationship still reaches the target")
+	assert.Equal(t, ad.Owns, path.Path.Edges[0].Kind)
+
+	overlay.Exclude(pathfinding.RelationshipRef{StartID: 0, EndID: 1, Kind: ad.Owns})
+
+	_, found, err = pathfinding.ShortestPath(context.Background(), overlay, pathfinding.HopCostTable(), expander.nodes[0], expander.nodes[2], pathfinding.SearchLimits{})
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	// The base expander is left untouched
+	_, found, err = pathfinding.ShortestPath(context.Background(), expander, pathfinding.HopCostTable(), expander.nodes[0], expander.nodes[2], pathfinding.SearchLimits{})
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Len(t, expander.edges[0], 2)
+}
+
+func TestOverlay_Include(t *testing.T) {
+	expander := newMemoryExpander(3)
+	stored := expander.relate(0, 1, ad.MemberOf)
+
+	overlay := pathfinding.NewOverlay(expander)
+	first := overlay.Include(expander.nodes[1], expander.nodes[2], ad.AdminTo)
+	second := overlay.Include(expander.nodes[0], expander.nodes[2], ad.GenericAll)
+
+	assert.NotEqual(t, first.ID, second.ID)
+	assert.True(t, overlay.IsSynthetic(first.ID))
+	assert.True(t, overlay.IsSynthetic(second.ID))
+	assert.False(t, overlay.IsSynthetic(stored))
+
+	paths, err := pathfinding.KShortestPaths(context.Background(), overlay, pathfinding.HopCostTable(), expander.nodes[0], expander.nodes[2], 5, pathfinding.SearchLimits{})
+	require.NoError(t, err)
+	require.Len(t, paths, 2)
+	assert.Equal(t, []graph.ID{0, 2}, pathNodeIDs(paths[0]))
+	assert.Equal(t, second.ID, paths[0].Path.Edges[0].ID)
+	assert.Equal(t, []graph.ID{0, 1, 2}, pathNodeIDs(paths[1]))
+
+	_, found, err := pathfinding.ShortestPath(context.Background(), expander, pathfinding.HopCostTable(), expander.nodes[0], expander.nodes[2], pathfinding.SearchLimits{})
+	require.NoError(t, err)
+	assert.False(t, found, "synthetic relationships are not added to the base expander")
+}
+
+func TestOverlay_Reverse(t *testing.T) {
+	// The inbound expander walks 0 -MemberOf-> 1 -GenericAll-> 2 from 2 back to 0
+	var (
+		outbound = newMemoryExpander(4)
+		inbound  = newMemoryExpander(4)
+	)
+
+	outbound.relate(0, 1, ad.MemberOf)
+	outbound.relate(1, 2, ad.GenericAll)
+	inbound.relateInbound(0, 1, ad.MemberOf)
+	inbound.relateInbound(1, 2, ad.GenericAll)
+
+	overlay := pathfinding.NewOverlay(outbound)
+	overlay.Exclude(pathfinding.RelationshipRef{StartID: 1, EndID: 2, Kind: ad.GenericAll})
+	synthetic := overlay.Include(outbound.nodes[3], outbound.nodes[2], ad.AdminTo)
+
+	reached, err := pathfinding.NewLimitedSearch(pathfinding.SearchLimits{}).Reach(context.Background(), overlay.Reverse(inbound), inbound.nodes[2])
+	require.NoError(t, err)
+	assert.Equal(t, []graph.ID{3}, reached.IDs(), "only the synthetic relationship still leads into the target")
+
+	edges, err := overlay.Reverse(inbound).Expand(context.Background(), inbound.nodes[2])
+	require.NoError(t, err)
+	require.Len(t, edges, 1)
+	assert.Equal(t, synthetic.ID, edges[0].Relationship.ID)
+}
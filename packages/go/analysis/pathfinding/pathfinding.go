@@ -0,0 +1,592 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package pathfinding implements weighted and k-shortest loopless pathfinding on top of dawgs. Searches expand nodes
+// through an Expander so that they run against any dawgs driver, or against an in-memory view of the graph.
+package pathfinding
+
+import (
+	"cmp"
+	"container/heap"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/specterops/dawgs/graph"
+)
+
+// MaximumK bounds the number of paths a single k-shortest search may return
+const MaximumK = 25
+
+var (
+	ErrInvalidCost         = errors.New("invalid edge cost")
+	ErrSearchLimitExceeded = errors.New("path search limit exceeded")
+)
+
+const (
+	// DefaultMaxDepth is the default number of relationships a returned path may traverse
+	DefaultMaxDepth = 15
+	// DefaultMaxExpandedNodes is the default number of node expansions a single search call may make
+	DefaultMaxExpandedNodes = 250_000
+	// DefaultSearchTimeout is the default wall clock time a single search call may take
+	DefaultSearchTimeout = 30 * time.Second
+)
+
+// SearchLimits bounds the work done by a single ShortestPath or KShortestPaths call, or by every search run through a
+// LimitedSearch. A zero field leaves that dimension unbounded. Exceeding MaxExpandedNodes or Timeout fails the call with ErrSearchLimitExceeded.
+type SearchLimits struct {
+	// MaxDepth is the greatest number of relationships a returned path may traverse. Each node is settled once at its
+	// cheapest cost, so a path within MaxDepth is not found if it reaches a node at a higher cost than a longer path.
+	MaxDepth int
+	// MaxExpandedNodes is the number of node expansions allowed, shared by every search KShortestPaths runs
+	MaxExpandedNodes int
+	// Timeout bounds the wall clock time of the call
+	Timeout time.Duration
+}
+
+// DefaultSearchLimits returns the limits applied to searches made on behalf of API requests
+func DefaultSearchLimits() SearchLimits {
+	return SearchLimits{
+		MaxDepth:         DefaultMaxDepth,
+		MaxExpandedNodes: DefaultMaxExpandedNodes,
+		Timeout:          DefaultSearchTimeout,
+	}
+}
+
+// searchBudget tracks the node expansions left to a search call
+type searchBudget struct {
+	limit     int
+	remaining int
+}
+
+func newSearchBudget(limits SearchLimits) *searchBudget {
+	return &searchBudget{
+		limit:     limits.MaxExpandedNodes,
+		remaining: limits.MaxExpandedNodes,
+	}
+}
+
+// spend accounts for a single node expansion
+func (s *searchBudget) spend() error {
+	if s.limit <= 0 {
+		return nil
+	} else if s.remaining == 0 {
+		return fmt.Errorf("%w: more than %d nodes expanded", ErrSearchLimitExceeded, s.limit)
+	}
+
+	s.remaining--
+	return nil
+}
+
+// LimitedSearch applies a single set of SearchLimits to every search run through it, so that a request running more
+// than one search is bounded as a whole. The timeout starts when the LimitedSearch is created.
+type LimitedSearch struct {
+	limits   SearchLimits
+	budget   *searchBudget
+	deadline time.Time
+}
+
+// NewLimitedSearch returns a LimitedSearch with the whole of limits left to spend
+func NewLimitedSearch(limits SearchLimits) *LimitedSearch {
+	search := &LimitedSearch{
+		limits: limits,
+		budget: newSearchBudget(limits),
+	}
+
+	if limits.Timeout > 0 {
+		search.deadline = time.Now().Add(limits.Timeout)
+	}
+
+	return search
+}
+
+// run runs search until the deadline of the LimitedSearch, reporting a timeout of the search itself as
+// ErrSearchLimitExceeded. Cancellation of the parent context is returned as is.
+func (s *LimitedSearch) run(ctx context.Context, search func(ctx context.Context) error) error {
+	if s.deadline.IsZero() {
+		return search(ctx)
+	}
+
+	searchCtx, cancel := context.WithDeadline(ctx, s.deadline)
+	defer cancel()
+
+	if err := search(searchCtx); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+			return fmt.Errorf("%w: timed out after %s", ErrSearchLimitExceeded, s.limits.Timeout)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// Edge is a relationship leaving a node paired with the node it leads to
+type Edge struct {
+	Relationship *graph.Relationship
+	Node         *graph.Node
+}
+
+// Expander returns the edges a path may traverse when leaving the given node
+type Expander interface {
+	Expand(ctx context.Context, node *graph.Node) ([]Edge, error)
+}
+
+// CostModifier adjusts the cost of traversing a relationship once its kind's cost has been looked up. A modifier must
+// not return a negative cost.
+type CostModifier func(relationship *graph.Relationship, cost float64) float64
+
+// CostTable assigns a traversal cost to each relationship kind. Relationships whose kind is not present in Costs are
+// assigned DefaultCost. Modifiers are then applied in order, letting callers weigh relationships by their properties.
+type CostTable struct {
+	Costs       map[string]float64
+	DefaultCost float64
+	Modifiers   []CostModifier
+}
+
+// NewCostTable returns a CostTable after checking that every cost is a finite, non-negative number
+func NewCostTable(defaultCost float64, costs map[string]float64, modifiers ...CostModifier) (CostTable, error) {
+	if err := validateCost(defaultCost); err != nil {
+		return CostTable{}, fmt.Errorf("default cost: %w", err)
+	}
+
+	for kind, cost := range costs {
+		if err := validateCost(cost); err != nil {
+			return CostTable{}, fmt.Errorf("cost for %s: %w", kind, err)
+		}
+	}
+
+	return CostTable{
+		Costs:       costs,
+		DefaultCost: defaultCost,
+		Modifiers:   modifiers,
+	}, nil
+}
+
+// HopCostTable returns a CostTable that assigns every relationship a cost of one, ranking paths by their length
+func HopCostTable() CostTable {
+	return CostTable{DefaultCost: 1}
+}
+
+func validateCost(cost float64) error {
+	if math.IsNaN(cost) || math.IsInf(cost, 0) || cost < 0 {
+		return fmt.Errorf("%w: %v must be a finite, non-negative number", ErrInvalidCost, cost)
+	}
+
+	return nil
+}
+
+// Cost returns the cost of traversing the given relationship
+func (s CostTable) Cost(relationship *graph.Relationship) float64 {
+	cost := s.DefaultCost
+
+	if relationship.Kind != nil {
+		if kindCost, ok := s.Costs[relationship.Kind.String()]; ok {
+			cost = kindCost
+		}
+	}
+
+	for _, modifier := range s.Modifiers {
+		cost = modifier(relationship, cost)
+	}
+
+	return cost
+}
+
+// WeightedPath is a path along with the sum of the costs of its relationships
+type WeightedPath struct {
+	Path graph.Path
+	Cost float64
+}
+
+// key identifies a path by the sequence of relationships it traverses
+func (s WeightedPath) key() string {
+	var builder strings.Builder
+
+	for idx, edge := range s.Path.Edges {
+		if idx > 0 {
+			builder.WriteString(",")
+		}
+
+		builder.WriteString(edge.ID.String())
+	}
+
+	return builder.String()
+}
+
+func compareWeightedPaths(a, b WeightedPath) int {
+	if byCost := cmp.Compare(a.Cost, b.Cost); byCost != 0 {
+		return byCost
+	} else if byLength := cmp.Compare(len(a.Path.Edges), len(b.Path.Edges)); byLength != 0 {
+		return byLength
+	}
+
+	return strings.Compare(a.key(), b.key())
+}
+
+// searchConstraints removes nodes and relationships from the graph for the duration of a single search
+type searchConstraints struct {
+	excludedNodes map[graph.ID]struct{}
+	excludedEdges map[graph.ID]struct{}
+}
+
+func newSearchConstraints() searchConstraints {
+	return searchConstraints{
+		excludedNodes: map[graph.ID]struct{}{},
+		excludedEdges: map[graph.ID]struct{}{},
+	}
+}
+
+func (s searchConstraints) allows(edge Edge) bool {
+	if _, excluded := s.excludedEdges[edge.Relationship.ID]; excluded {
+		return false
+	}
+
+	_, excluded := s.excludedNodes[edge.Node.ID]
+	return !excluded
+}
+
+// frontierEntry is a node waiting to be expanded by shortestPath
+type frontierEntry struct {
+	node *graph.Node
+	cost float64
+	hops int
+	seq  int
+}
+
+type frontier []frontierEntry
+
+func (s frontier) Len() int {
+	return len(s)
+}
+
+func (s frontier) Less(i, j int) bool {
+	if s[i].cost != s[j].cost {
+		return s[i].cost < s[j].cost
+	} else if s[i].hops != s[j].hops {
+		return s[i].hops < s[j].hops
+	}
+
+	return s[i].seq < s[j].seq
+}
+
+func (s frontier) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s *frontier) Push(value any) {
+	*s = append(*s, value.(frontierEntry))
+}
+
+func (s *frontier) Pop() any {
+	var (
+		old   = *s
+		last  = len(old) - 1
+		entry = old[last]
+	)
+
+	*s = old[:last]
+	return entry
+}
+
+// visit records how a node was reached during a search
+type visit struct {
+	cost     float64
+	hops     int
+	previous *graph.Node
+	edge     *graph.Relationship
+}
+
+// shortestPath runs Dijkstra's algorithm from start to end. Ties in cost are broken by the number of hops. Nodes
+// reached after maxDepth hops are not expanded, unless maxDepth is zero, and every expansion is paid for from budget.
+// The returned bool is false when end is unreachable.
+func shortestPath(ctx context.Context, expander Expander, costs CostTable, start, end *graph.Node, constraints searchConstraints, maxDepth int, budget *searchBudget) (WeightedPath, bool, error) {
+	var (
+		visits   = map[graph.ID]visit{start.ID: {}}
+		settled  = map[graph.ID]struct{}{}
+		queue    = &frontier{{node: start}}
+		sequence = 1
+	)
+
+	for queue.Len() > 0 {
+		if err := ctx.Err(); err != nil {
+			return WeightedPath{}, false, err
+		}
+
+		next := heap.Pop(queue).(frontierEntry)
+
+		if _, ok := settled[next.node.ID]; ok {
+			continue
+		}
+
+		settled[next.node.ID] = struct{}{}
+
+		if next.node.ID == end.ID {
+			return buildWeightedPath(visits, start, next.node), true, nil
+		} else if maxDepth > 0 && next.hops >= maxDepth {
+			continue
+		} else if err := budget.spend(); err != nil {
+			return WeightedPath{}, false, err
+		}
+
+		edges, err := expander.Expand(ctx, next.node)
+		if err != nil {
+			return WeightedPath{}, false, err
+		}
+
+		for _, edge := range edges {
+			if _, ok := settled[edge.Node.ID]; ok || !constraints.allows(edge) {
+				continue
+			}
+
+			var (
+				cost = next.cost + costs.Cost(edge.Relationship)
+				hops = next.hops + 1
+			)
+
+			if known, seen := visits[edge.Node.ID]; !seen || cost < known.cost || (cost == known.cost && hops < known.hops) {
+				visits[edge.Node.ID] = visit{
+					cost:     cost,
+					hops:     hops,
+					previous: next.node,
+					edge:     edge.Relationship,
+				}
+
+				heap.Push(queue, frontierEntry{node: edge.Node, cost: cost, hops: hops, seq: sequence})
+				sequence++
+			}
+		}
+	}
+
+	return WeightedPath{}, false, nil
+}
+
+func buildWeightedPath(visits map[graph.ID]visit, start, end *graph.Node) WeightedPath {
+	var (
+		nodes  = []*graph.Node{end}
+		edges  []*graph.Relationship
+		cursor = end
+	)
+
+	for cursor.ID != start.ID {
+		step := visits[cursor.ID]
+
+		nodes = append(nodes, step.previous)
+		edges = append(edges, step.edge)
+		cursor = step.previous
+	}
+
+	slices.Reverse(nodes)
+	slices.Reverse(edges)
+
+	return WeightedPath{
+		Path: graph.Path{
+			Nodes: nodes,
+			Edges: edges,
+		},
+		Cost: visits[end.ID].cost,
+	}
+}
+
+// ShortestPath returns the cheapest path from start to end under the given cost table and search limits. The returned
+// bool is false when end is unreachable from start.
+func ShortestPath(ctx context.Context, expander Expander, costs CostTable, start, end *graph.Node, limits SearchLimits) (WeightedPath, bool, error) {
+	return NewLimitedSearch(limits).ShortestPath(ctx, expander, costs, start, end)
+}
+
+// ShortestPath returns the cheapest path from start to end, paid for from the limits left to the LimitedSearch
+func (s *LimitedSearch) ShortestPath(ctx context.Context, expander Expander, costs CostTable, start, end *graph.Node) (WeightedPath, bool, error) {
+	if start.ID == end.ID {
+		return WeightedPath{}, false, nil
+	}
+
+	var (
+		path  WeightedPath
+		found bool
+	)
+
+	return path, found, s.run(ctx, func(ctx context.Context) error {
+		var err error
+		path, found, err = shortestPath(ctx, expander, costs, start, end, newSearchConstraints(), s.limits.MaxDepth, s.budget)
+		return err
+	})
+}
+
+// sharesRoot reports whether path begins with the first depth relationships of root
+func sharesRoot(path, root graph.Path, depth int) bool {
+	if len(path.Edges) <= depth {
+		return false
+	}
+
+	for idx := 0; idx < depth; idx++ {
+		if path.Edges[idx].ID != root.Edges[idx].ID {
+			return false
+		}
+	}
+
+	return true
+}
+
+// joinPaths appends spur to the first depth relationships of root
+func joinPaths(root WeightedPath, depth int, spur WeightedPath, costs CostTable) WeightedPath {
+	joined := WeightedPath{
+		Path: graph.Path{
+			Nodes: append(slices.Clone(root.Path.Nodes[:depth]), spur.Path.Nodes...),
+			Edges: append(slices.Clone(root.Path.Edges[:depth]), spur.Path.Edges...),
+		},
+		Cost: spur.Cost,
+	}
+
+	for _, edge := range root.Path.Edges[:depth] {
+		joined.Cost += costs.Cost(edge)
+	}
+
+	return joined
+}
+
+// KShortestPaths returns up to k loopless paths from start to end ordered by cost, then by length, using Yen's
+// algorithm. Paths that traverse different relationships between the same nodes are considered distinct. The search
+// limits apply to the call as a whole rather than to each of the searches Yen's algorithm runs.
+func KShortestPaths(ctx context.Context, expander Expander, costs CostTable, start, end *graph.Node, k int, limits SearchLimits) ([]WeightedPath, error) {
+	return NewLimitedSearch(limits).KShortestPaths(ctx, expander, costs, start, end, k)
+}
+
+// KShortestPaths returns up to k loopless paths from start to end, paid for from the limits left to the LimitedSearch
+func (s *LimitedSearch) KShortestPaths(ctx context.Context, expander Expander, costs CostTable, start, end *graph.Node, k int) ([]WeightedPath, error) {
+	if k < 1 || k > MaximumK {
+		return nil, fmt.Errorf("k must be between 1 and %d", MaximumK)
+	} else if start.ID == end.ID {
+		return nil, nil
+	}
+
+	var paths []WeightedPath
+
+	return paths, s.run(ctx, func(ctx context.Context) error {
+		var err error
+		paths, err = kShortestPaths(ctx, expander, costs, start, end, k, s.limits.MaxDepth, s.budget)
+		return err
+	})
+}
+
+// Reach returns every node, other than start, reachable from start within the maximum depth of the LimitedSearch.
+// Each node reached is expanded once and paid for from the limits left to the LimitedSearch.
+func (s *LimitedSearch) Reach(ctx context.Context, expander Expander, start *graph.Node) (graph.NodeSet, error) {
+	reached := graph.NewNodeSet()
+
+	return reached, s.run(ctx, func(ctx context.Context) error {
+		var (
+			depth    = 0
+			visited  = map[graph.ID]struct{}{start.ID: {}}
+			frontier = []*graph.Node{start}
+		)
+
+		for len(frontier) > 0 && (s.limits.MaxDepth <= 0 || depth < s.limits.MaxDepth) {
+			var next []*graph.Node
+
+			for _, node := range frontier {
+				if err := ctx.Err(); err != nil {
+					return err
+				} else if err := s.budget.spend(); err != nil {
+					return err
+				}
+
+				edges, err := expander.Expand(ctx, node)
+				if err != nil {
+					return err
+				}
+
+				for _, edge := range edges {
+					if _, seen := visited[edge.Node.ID]; !seen {
+						visited[edge.Node.ID] = struct{}{}
+						reached.Add(edge.Node)
+						next = append(next, edge.Node)
+					}
+				}
+			}
+
+			frontier = next
+			depth++
+		}
+
+		return nil
+	})
+}
+
+func kShortestPaths(ctx context.Context, expander Expander, costs CostTable, start, end *graph.Node, k, maxDepth int, budget *searchBudget) ([]WeightedPath, error) {
+	first, found, err := shortestPath(ctx, expander, costs, start, end, newSearchConstraints(), maxDepth, budget)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	var (
+		accepted   = []WeightedPath{first}
+		candidates []WeightedPath
+		seen       = map[string]struct{}{first.key(): {}}
+	)
+
+	for len(accepted) < k {
+		previous := accepted[len(accepted)-1]
+
+		for depth := 0; depth < len(previous.Path.Edges); depth++ {
+			constraints := newSearchConstraints()
+
+			// Remove the next relationship of every accepted path sharing this root so the spur must diverge
+			for _, path := range accepted {
+				if sharesRoot(path.Path, previous.Path, depth) {
+					constraints.excludedEdges[path.Path.Edges[depth].ID] = struct{}{}
+				}
+			}
+
+			// Remove the root's nodes so that the spur cannot loop back through them
+			for _, node := range previous.Path.Nodes[:depth] {
+				constraints.excludedNodes[node.ID] = struct{}{}
+			}
+
+			// The spur may only use the depth left after the root
+			spurDepth := 0
+			if maxDepth > 0 {
+				spurDepth = maxDepth - depth
+			}
+
+			spur, found, err := shortestPath(ctx, expander, costs, previous.Path.Nodes[depth], end, constraints, spurDepth, budget)
+			if err != nil {
+				return nil, err
+			} else if !found {
+				continue
+			}
+
+			candidate := joinPaths(previous, depth, spur, costs)
+			if _, duplicate := seen[candidate.key()]; !duplicate {
+				seen[candidate.key()] = struct{}{}
+				candidates = append(candidates, candidate)
+			}
+		}
+
+		if len(candidates) == 0 {
+			break
+		}
+
+		slices.SortFunc(candidates, compareWeightedPaths)
+		accepted = append(accepted, candidates[0])
+		candidates = candidates[1:]
+	}
+
+	return accepted, nil
+}
@@ -0,0 +1,306 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pathfinding_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/packages/go/analysis/pathfinding"
+	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
+	"github.com/specterops/dawgs/graph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryExpander is an in-memory adjacency list used to exercise the search algorithms without a database
+type memoryExpander struct {
+	nodes map[graph.ID]*graph.Node
+	edges map[graph.ID][]pathfinding.Edge
+	next  graph.ID
+}
+
+func newMemoryExpander(nodeCount int) *memoryExpander {
+	expander := &memoryExpander{
+		nodes: map[graph.ID]*graph.Node{},
+		edges: map[graph.ID][]pathfinding.Edge{},
+		next:  100,
+	}
+
+	for id := 0; id < nodeCount; id++ {
+		expander.nodes[graph.ID(id)] = graph.NewNode(graph.ID(id), graph.NewProperties())
+	}
+
+	return expander
+}
+
+func (s *memoryExpander) relate(start, end graph.ID, kind graph.Kind) graph.ID {
+	relationshipID := s.next
+	s.next++
+
+	s.edges[start] = append(s.edges[start], pathfinding.Edge{
+		Relationship: &graph.Relationship{
+			ID:      relationshipID,
+			StartID: start,
+			EndID:   end,
+			Kind:    kind,
+		},
+		Node: s.nodes[end],
+	})
+
+	return relationshipID
+}
+
+// relateInbound records a relationship from start to end as an edge leaving end, as walked by an inbound expander
+func (s *memoryExpander) relateInbound(start, end graph.ID, kind graph.Kind) graph.ID {
+	relationshipID := s.next
+	s.next++
+
+	s.edges[end] = append(s.edges[end], pathfinding.Edge{
+		Relationship: &graph.Relationship{
+			ID:      relationshipID,
+			StartID: start,
+			EndID:   end,
+			Kind:    kind,
+		},
+		Node: s.nodes[start],
+	})
+
+	return relationshipID
+}
+
+func (s *memoryExpander) Expand(_ context.Context, node *graph.Node) ([]pathfinding.Edge, error) {
+	return s.edges[node.ID], nil
+}
+
+// blockingExpander never yields any edges, blocking each expansion until the search is canceled
+type blockingExpander struct{}
+
+func (blockingExpander) Expand(ctx context.Context, _ *graph.Node) ([]pathfinding.Edge, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func pathNodeIDs(path pathfinding.WeightedPath) []graph.ID {
+	ids := make([]graph.ID, len(path.Path.Nodes))
+	for idx, node := range path.Path.Nodes {
+		ids[idx] = node.ID
+	}
+
+	return ids
+}
+
+func TestNewCostTable(t *testing.T) {
+	_, err := pathfinding.NewCostTable(1, map[string]float64{ad.AdminTo.String(): 0.5})
+	require.NoError(t, err)
+
+	_, err = pathfinding.NewCostTable(-1, nil)
+	assert.ErrorIs(t, err, pathfinding.ErrInvalidCost)
+
+	_, err = pathfinding.NewCostTable(1, map[string]float64{ad.ADCSESC3.String(): -2})
+	assert.ErrorIs(t, err, pathfinding.ErrInvalidCost)
+}
+
+func TestCostTable_Modifiers(t *testing.T) {
+	var (
+		double = func(_ *graph.Relationship, cost float64) float64 {
+			return cost * 2
+		}
+		plusOne = func(_ *graph.Relationship, cost float64) float64 {
+			return cost + 1
+		}
+		relationship = &graph.Relationship{Kind: ad.Kerberoastable}
+	)
+
+	costs, err := pathfinding.NewCostTable(1, map[string]float64{ad.Kerberoastable.String(): 2}, double, plusOne)
+	require.NoError(t, err)
+
+	assert.Equal(t, float64(5), costs.Cost(relationship), "modifiers apply in order after the kind's cost")
+
+	costs, err = pathfinding.NewCostTable(1, map[string]float64{ad.Kerberoastable.String(): 2})
+	require.NoError(t, err)
+
+	assert.Equal(t, float64(2), costs.Cost(relationship), "no modifiers are applied unless given")
+}
+
+func TestShortestPath_Weighted(t *testing.T) {
+	// 0 -ADCSESC3-> 3 is a single hop but expensive; 0 -AdminTo-> 1 -AdminTo-> 2 -AdminTo-> 3 is cheaper
+	expander := newMemoryExpander(4)
+	expander.relate(0, 3, ad.ADCSESC3)
+	expander.relate(0, 1, ad.AdminTo)
+	expander.relate(1, 2, ad.AdminTo)
+	expander.relate(2, 3, ad.AdminTo)
+
+	costs, err := pathfinding.NewCostTable(1, map[string]float64{ad.AdminTo.String(): 1, ad.ADCSESC3.String(): 10})
+	require.NoError(t, err)
+
+	path, found, err := pathfinding.ShortestPath(context.Background(), expander, costs, expander.nodes[0], expander.nodes[3], pathfinding.SearchLimits{})
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, []graph.ID{0, 1, 2, 3}, pathNodeIDs(path))
+	assert.Equal(t, float64(3), path.Cost)
+
+	path, found, err = pathfinding.ShortestPath(context.Background(), expander, pathfinding.HopCostTable(), expander.nodes[0], expander.nodes[3], pathfinding.SearchLimits{})
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, []graph.ID{0, 3}, pathNodeIDs(path))
+	assert.Equal(t, float64(1), path.Cost)
+
+	_, found, err = pathfinding.ShortestPath(context.Background(), expander, costs, expander.nodes[3], expander.nodes[0], pathfinding.SearchLimits{})
+	require.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestKShortestPaths(t *testing.T) {
+	// Costs are assigned per relationship kind; the third and fourth cheapest paths tie on cost and length
+	var (
+		expander = newMemoryExpander(6)
+		costs    = pathfinding.CostTable{
+			Costs: map[string]float64{
+				ad.AdminTo.String():      1,
+				ad.GenericAll.String():   2,
+				ad.GenericWrite.String(): 3,
+				ad.Owns.String():         4,
+			},
+		}
+	)
+
+	expander.relate(0, 1, ad.Owns)
+	expander.relate(0, 2, ad.GenericAll)
+	expander.relate(1, 3, ad.Owns)
+	expander.relate(2, 1, ad.AdminTo)
+	expander.relate(2, 3, ad.GenericAll)
+	expander.relate(2, 4, ad.GenericWrite)
+	expander.relate(3, 4, ad.GenericAll)
+	expander.relate(3, 5, ad.AdminTo)
+	expander.relate(4, 5, ad.GenericAll)
+
+	paths, err := pathfinding.KShortestPaths(context.Background(), expander, costs, expander.nodes[0], expander.nodes[5], 3, pathfinding.SearchLimits{})
+	require.NoError(t, err)
+	require.Len(t, paths, 3)
+
+	assert.Equal(t, []graph.ID{0, 2, 3, 5}, pathNodeIDs(paths[0]))
+	assert.Equal(t, float64(5), paths[0].Cost)
+
+	assert.Equal(t, []graph.ID{0, 2, 4, 5}, pathNodeIDs(paths[1]))
+	assert.Equal(t, float64(7), paths[1].Cost)
+
+	assert.Equal(t, []graph.ID{0, 2, 1, 3, 5}, pathNodeIDs(paths[2]))
+	assert.Equal(t, float64(8), paths[2].Cost)
+
+	for _, path := range paths {
+		seen := map[graph.ID]struct{}{}
+		for _, node := range path.Path.Nodes {
+			_, loops := seen[node.ID]
+			assert.False(t, loops, "path revisits node %d", node.ID)
+			seen[node.ID] = struct{}{}
+		}
+	}
+}
+
+func TestKShortestPaths_ParallelRelationships(t *testing.T) {
+	expander := newMemoryExpander(2)
+	cheap := expander.relate(0, 1, ad.AdminTo)
+	expensive := expander.relate(0, 1, ad.GenericAll)
+
+	costs := pathfinding.CostTable{
+		Costs:       map[string]float64{ad.GenericAll.String(): 5},
+		DefaultCost: 1,
+	}
+
+	paths, err := pathfinding.KShortestPaths(context.Background(), expander, costs, expander.nodes[0], expander.nodes[1], 5, pathfinding.SearchLimits{})
+	require.NoError(t, err)
+	require.Len(t, paths, 2)
+	assert.Equal(t, cheap, paths[0].Path.Edges[0].ID)
+	assert.Equal(t, expensive, paths[1].Path.Edges[0].ID)
+}
+
+func TestKShortestPaths_Limits(t *testing.T) {
+	// A chain 0 -> 1 -> 2 -> 3 -> 4 alongside a direct, expensive relationship 0 -> 4
+	expander := newMemoryExpander(5)
+	expander.relate(0, 1, ad.AdminTo)
+	expander.relate(1, 2, ad.AdminTo)
+	expander.relate(2, 3, ad.AdminTo)
+	expander.relate(3, 4, ad.AdminTo)
+	expander.relate(0, 4, ad.GenericAll)
+
+	costs := pathfinding.CostTable{
+		Costs:       map[string]float64{ad.GenericAll.String(): 10},
+		DefaultCost: 1,
+	}
+
+	paths, err := pathfinding.KShortestPaths(context.Background(), expander, costs, expander.nodes[0], expander.nodes[4], 2, pathfinding.SearchLimits{MaxDepth: 3})
+	require.NoError(t, err)
+	require.Len(t, paths, 1, "the chain is longer than the maximum depth")
+	assert.Equal(t, []graph.ID{0, 4}, pathNodeIDs(paths[0]))
+
+	_, err = pathfinding.KShortestPaths(context.Background(), expander, costs, expander.nodes[0], expander.nodes[4], 2, pathfinding.SearchLimits{MaxExpandedNodes: 2})
+	assert.ErrorIs(t, err, pathfinding.ErrSearchLimitExceeded)
+
+	_, err = pathfinding.KShortestPaths(context.Background(), blockingExpander{}, costs, expander.nodes[0], expander.nodes[4], 2, pathfinding.SearchLimits{Timeout: time.Millisecond})
+	assert.ErrorIs(t, err, pathfinding.ErrSearchLimitExceeded)
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = pathfinding.KShortestPaths(canceled, blockingExpander{}, costs, expander.nodes[0], expander.nodes[4], 2, pathfinding.SearchLimits{Timeout: time.Minute})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.NotErrorIs(t, err, pathfinding.ErrSearchLimitExceeded)
+}
+
+func TestLimitedSearch_SharedBudget(t *testing.T) {
+	expander := newMemoryExpander(3)
+	expander.relate(0, 1, ad.AdminTo)
+	expander.relate(1, 2, ad.AdminTo)
+
+	search := pathfinding.NewLimitedSearch(pathfinding.SearchLimits{MaxExpandedNodes: 3})
+
+	paths, err := search.KShortestPaths(context.Background(), expander, pathfinding.HopCostTable(), expander.nodes[0], expander.nodes[2], 1)
+	require.NoError(t, err)
+	require.Len(t, paths, 1)
+
+	// The first search expanded two nodes, leaving too few for a second search of the same graph
+	_, err = search.KShortestPaths(context.Background(), expander, pathfinding.HopCostTable(), expander.nodes[0], expander.nodes[2], 1)
+	assert.ErrorIs(t, err, pathfinding.ErrSearchLimitExceeded)
+}
+
+func TestLimitedSearch_Reach(t *testing.T) {
+	expander := newMemoryExpander(4)
+	expander.relate(0, 1, ad.AdminTo)
+	expander.relate(1, 2, ad.AdminTo)
+	expander.relate(2, 0, ad.AdminTo)
+	expander.relate(2, 3, ad.AdminTo)
+
+	reached, err := pathfinding.NewLimitedSearch(pathfinding.SearchLimits{}).Reach(context.Background(), expander, expander.nodes[0])
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []graph.ID{1, 2, 3}, reached.IDs())
+
+	reached, err = pathfinding.NewLimitedSearch(pathfinding.SearchLimits{MaxDepth: 2}).Reach(context.Background(), expander, expander.nodes[0])
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []graph.ID{1, 2}, reached.IDs())
+}
+
+func TestKShortestPaths_InvalidK(t *testing.T) {
+	expander := newMemoryExpander(2)
+
+	_, err := pathfinding.KShortestPaths(context.Background(), expander, pathfinding.HopCostTable(), expander.nodes[0], expander.nodes[1], 0, pathfinding.SearchLimits{})
+	assert.Error(t, err)
+
+	_, err = pathfinding.KShortestPaths(context.Background(), expander, pathfinding.HopCostTable(), expander.nodes[0], expander.nodes[1], pathfinding.MaximumK+1, pathfinding.SearchLimits{})
+	assert.Error(t, err)
+}
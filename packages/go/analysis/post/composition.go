@@ -0,0 +1,184 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package post
+
+import (
+	"context"
+	"slices"
+
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+	"github.com/specterops/dawgs/ops"
+	"github.com/specterops/dawgs/query"
+)
+
+// EdgeComposition records why a post-processed edge exists: the rule that produced the edge along with the nodes and
+// edges that satisfied it. Compositions are stored as properties of the post-processed edge so that they are replaced
+// along with the edge each time analysis runs.
+type EdgeComposition struct {
+	Rule    string
+	NodeIDs []graph.ID
+	EdgeIDs []graph.ID
+}
+
+// NewEdgeComposition creates a composition for the given rule with any contributing node IDs.
+func NewEdgeComposition(rule string, nodeIDs ...graph.ID) *EdgeComposition {
+	return &EdgeComposition{
+		Rule:    rule,
+		NodeIDs: slices.Clone(nodeIDs),
+	}
+}
+
+// AddNodes appends contributing node IDs to the composition.
+func (s *EdgeComposition) AddNodes(nodeIDs ...graph.ID) *EdgeComposition {
+	s.NodeIDs = append(s.NodeIDs, nodeIDs...)
+	return s
+}
+
+// AddEdges appends contributing edge IDs to the composition.
+func (s *EdgeComposition) AddEdges(edgeIDs ...graph.ID) *EdgeComposition {
+	s.EdgeIDs = append(s.EdgeIDs, edgeIDs...)
+	return s
+}
+
+// Properties returns the composition as edge properties. IDs are sorted and deduplicated so that the same composition
+// always produces the same property fingerprint.
+func (s *EdgeComposition) Properties() map[string]any {
+	return map[string]any{
+		common.CompositionRule.String():  s.Rule,
+		common.CompositionNodes.String(): sortedUniqueIDs(s.NodeIDs),
+		common.CompositionEdges.String(): sortedUniqueIDs(s.EdgeIDs),
+	}
+}
+
+func sortedUniqueIDs(ids []graph.ID) []uint64 {
+	values := make([]uint64, 0, len(ids))
+
+	for _, id := range ids {
+		values = append(values, id.Uint64())
+	}
+
+	slices.Sort(values)
+	return slices.Compact(values)
+}
+
+// ParseEdgeComposition reads the composition stored on a post-processed edge. The second return value is false when
+// the edge does not carry a composition.
+func ParseEdgeComposition(properties *graph.Properties) (EdgeComposition, bool) {
+	if properties == nil {
+		return EdgeComposition{}, false
+	}
+
+	rule, err := properties.Get(common.CompositionRule.String()).String()
+	if err != nil || rule == "" {
+		return EdgeComposition{}, false
+	}
+
+	return EdgeComposition{
+		Rule:    rule,
+		NodeIDs: parseCompositionIDs(properties.Get(common.CompositionNodes.String()).Any()),
+		EdgeIDs: parseCompositionIDs(properties.Get(common.CompositionEdges.String()).Any()),
+	}, true
+}
+
+// parseCompositionIDs converts a stored ID array back to graph IDs. Depending on the driver and whether the edge was
+// read back from storage the array may be typed or decoded from JSON.
+func parseCompositionIDs(raw any) []graph.ID {
+	var ids []graph.ID
+
+	switch typed := raw.(type) {
+	case []graph.ID:
+		ids = append(ids, typed...)
+	case []uint64:
+		for _, value := range typed {
+			ids = append(ids, graph.ID(value))
+		}
+	case []int64:
+		for _, value := range typed {
+			ids = append(ids, graph.ID(value))
+		}
+	case []any:
+		for _, value := range typed {
+			switch number := value.(type) {
+			case float64:
+				ids = append(ids, graph.ID(number))
+			case int64:
+				ids = append(ids, graph.ID(number))
+			case uint64:
+				ids = append(ids, graph.ID(number))
+			case int:
+				ids = append(ids, graph.ID(number))
+			}
+		}
+	}
+
+	return ids
+}
+
+// FetchEdgeCompositionGraph fetches the contributing nodes and edges recorded in the given composition. Contributing
+// objects that have since been removed from the graph are omitted from the results.
+func FetchEdgeCompositionGraph(ctx context.Context, db graph.Database, composition EdgeComposition) (graph.NodeSet, []*graph.Relationship, error) {
+	var (
+		nodes         = graph.NewNodeSet()
+		relationships []*graph.Relationship
+	)
+
+	err := db.ReadTransaction(ctx, func(tx graph.Transaction) error {
+		if len(composition.NodeIDs) > 0 {
+			if fetchedNodes, err := ops.FetchNodeSet(tx.Nodes().Filterf(func() graph.Criteria {
+				return query.InIDs(query.NodeID(), composition.NodeIDs...)
+			})); err != nil {
+				return err
+			} else {
+				nodes.AddSet(fetchedNodes)
+			}
+		}
+
+		if len(composition.EdgeIDs) > 0 {
+			if fetchedRelationships, err := ops.FetchRelationships(tx.Relationships().Filterf(func() graph.Criteria {
+				return query.InIDs(query.RelationshipID(), composition.EdgeIDs...)
+			})); err != nil {
+				return err
+			} else {
+				relationships = fetchedRelationships
+
+				// Include the endpoints of contributing edges so that the composition renders as a connected graph
+				if endpoints, err := ops.FetchNodeSet(tx.Nodes().Filterf(func() graph.Criteria {
+					return query.InIDs(query.NodeID(), relationshipEndpointIDs(fetchedRelationships)...)
+				})); err != nil {
+					return err
+				} else {
+					nodes.AddSet(endpoints)
+				}
+			}
+		}
+
+		return nil
+	})
+
+	return nodes, relationships, err
+}
+
+func relationshipEndpointIDs(relationships []*graph.Relationship) []graph.ID {
+	ids := make([]graph.ID, 0, len(relationships)*2)
+
+	for _, relationship := range relationships {
+		ids = append(ids, relationship.StartID, relationship.EndID)
+	}
+
+	return ids
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package post
+
+import (
+	"testing"
+
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEdgeComposition_Properties(t *testing.T) {
+	composition := NewEdgeComposition("rule", 3, 1, 3).AddEdges(9, 7)
+
+	require.Equal(t, map[string]any{
+		common.CompositionRule.String():  "rule",
+		common.CompositionNodes.String(): []uint64{1, 3},
+		common.CompositionEdges.String(): []uint64{7, 9},
+	}, composition.Properties())
+}
+
+func TestParseEdgeComposition(t *testing.T) {
+	t.Run("Missing composition", func(t *testing.T) {
+		_, found := ParseEdgeComposition(graph.NewProperties().Set(common.LastSeen.String(), "2026-01-01"))
+		require.False(t, found)
+	})
+
+	t.Run("Decoded composition", func(t *testing.T) {
+		properties := graph.NewProperties().
+			Set(common.CompositionRule.String(), "rule").
+			Set(common.CompositionNodes.String(), []any{float64(1), float64(3)}).
+			Set(common.CompositionEdges.String(), []any{float64(7)})
+
+		composition, found := ParseEdgeComposition(properties)
+		require.True(t, found)
+		require.Equal(t, EdgeComposition{
+			Rule:    "rule",
+			NodeIDs: []graph.ID{1, 3},
+			EdgeIDs: []graph.ID{7},
+		}, composition)
+	})
+
+	t.Run("Job properties", func(t *testing.T) {
+		job := EnsureRelationshipJob{
+			RelProperties: map[string]any{"isacl": true},
+			Composition:   NewEdgeComposition("rule", 2),
+		}
+
+		properties := graph.NewProperties()
+		for key, value := range job.Properties() {
+			properties.Set(key, value)
+		}
+
+		composition, found := ParseEdgeComposition(properties)
+		require.True(t, found)
+		require.Equal(t, "rule", composition.Rule)
+		require.Equal(t, []graph.ID{2}, composition.NodeIDs)
+		require.Empty(t, composition.EdgeIDs)
+	})
+}
@@ -25,4 +25,39 @@ type EnsureRelationshipJob struct {
 	ToID          graph.ID
 	Kind          graph.Kind
 	RelProperties map[string]any
+	Composition   *EdgeComposition
+}
+
+// Properties returns the job's relationship properties merged with its composition, if any. The returned map must not
+// be mutated as it may be the job's RelProperties.
+func (s EnsureRelationshipJob) Properties() map[string]any {
+	if s.Composition == nil {
+		return s.RelProperties
+	}
+
+	properties := s.Composition.Properties()
+
+	for key, value := range s.RelProperties {
+		properties[key] = value
+	}
+
+	return properties
+}
+
+// MergeProperties returns the given base properties with the job's properties set on top. The base properties are
+// cloned before being modified and are returned as-is when the job carries no properties of its own.
+func (s EnsureRelationshipJob) MergeProperties(base *graph.Properties) *graph.Properties {
+	jobProperties := s.Properties()
+
+	if len(jobProperties) == 0 {
+		return base
+	}
+
+	merged := base.Clone()
+
+	for key, value := range jobProperties {
+		merged.Set(key, value)
+	}
+
+	return merged
 }
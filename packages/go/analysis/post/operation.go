@@ -57,8 +57,8 @@ type StatTrackedOperation[T any] struct {
 // NewPostRelationshipOperation creates and starts a StatTrackedOperation that processes
 // EnsureRelationshipJob values. A single writer goroutine is registered that batches
 // relationship creation against the provided database. For each job:
-//   - If the job carries additional RelProperties they are merged (via clone) on top
-//     of the shared LastSeen properties so that base properties are not mutated.
+//   - If the job carries additional RelProperties or a Composition they are merged (via
+//     clone) on top of the shared LastSeen properties so that base properties are not mutated.
 //   - The relationship is inserted by node ID using the job's Kind.
 //   - The Stats counter for that Kind is incremented atomically.
 //
@@ -71,17 +71,7 @@ func NewPostRelationshipOperation(ctx context.Context, db graph.Database, operat
 		relProp := NewPropertiesWithLastSeen()
 
 		for nextJob := range inC {
-			relProps := relProp
-
-			if len(nextJob.RelProperties) > 0 {
-				relProps = relProp.Clone()
-
-				for key, val := range nextJob.RelProperties {
-					relProps.Set(key, val)
-				}
-			}
-
-			if err := batch.CreateRelationshipByIDs(nextJob.FromID, nextJob.ToID, nextJob.Kind, relProps); err != nil {
+			if err := batch.CreateRelationshipByIDs(nextJob.FromID, nextJob.ToID, nextJob.Kind, nextJob.MergeProperties(relProp)); err != nil {
 				return err
 			}
 
@@ -64,24 +64,14 @@ func NewFilteredRelationshipSink(ctx context.Context, operationName string, db g
 }
 
 // insertWorker processes incoming jobs by inserting them into the database using batch operations. It uses common properties
-// (with first seen timestamp) and applies custom relationship properties if provided.
+// (with first seen timestamp) and applies custom relationship properties and compositions if provided.
 func (s *FilteredRelationshipSink) insertWorker(ctx context.Context, commonProps *graph.Properties, insertC chan EnsureRelationshipJob) {
 	if err := s.db.BatchOperation(ctx, func(batch graph.Batch) error {
 		for {
 			if nextJob, shouldContinue := channels.Receive(ctx, insertC); !shouldContinue {
 				break
 			} else {
-				relProps := commonProps
-
-				if len(nextJob.RelProperties) > 0 {
-					relProps = commonProps.Clone()
-
-					for key, val := range nextJob.RelProperties {
-						relProps.Set(key, val)
-					}
-				}
-
-				if err := batch.CreateRelationshipByIDs(nextJob.FromID, nextJob.ToID, nextJob.Kind, relProps); err != nil {
+				if err := batch.CreateRelationshipByIDs(nextJob.FromID, nextJob.ToID, nextJob.Kind, nextJob.MergeProperties(commonProps)); err != nil {
 					slog.Error("Create Relationship Error", slog.String("err", err.Error()))
 				} else {
 					s.stats.AddRelationshipsCreated(nextJob.Kind, 1)
@@ -110,7 +100,7 @@ func (s *FilteredRelationshipSink) deltaFilterWorker(ctx context.Context, filter
 			break
 		}
 
-		if !s.edgeTracker.HasEdge(nextJob.FromID.Uint64(), nextJob.ToID.Uint64(), nextJob.Kind, nextJob.RelProperties) {
+		if !s.edgeTracker.HasEdge(nextJob.FromID.Uint64(), nextJob.ToID.Uint64(), nextJob.Kind, nextJob.Properties()) {
 			if !channels.Submit(ctx, insertC, nextJob) {
 				break
 			}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/cespare/xxhash/v2"
 	"github.com/specterops/bloodhound/packages/go/graphschema"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
 	"github.com/specterops/dawgs/graph"
 	"github.com/specterops/dawgs/query"
 )
@@ -88,6 +89,13 @@ var ignoredFingerprintKeys = map[string]struct{}{
 	"lastseen":  {},
 }
 
+// compositionIDFingerprintKeys contains property keys holding edge composition ID arrays. These are normalized before
+// being hashed so that a stored composition matches the same composition when it is submitted again.
+var compositionIDFingerprintKeys = map[string]struct{}{
+	common.CompositionNodes.String(): {},
+	common.CompositionEdges.String(): {},
+}
+
 // PropertiesFingerprint computes a deterministic hash of a property map. Keys are sorted
 // lexicographically before hashing so that insertion order does not affect the result.
 // Properties listed in ignoredFingerprintKeys are excluded from the hash.
@@ -113,7 +121,13 @@ func (s *KeyEncoder) PropertiesFingerprint(properties map[string]any) uint64 {
 
 	for _, key := range sortedKeys {
 		s.digester.WriteString(key)
-		fmt.Fprint(s.digester, properties[key])
+
+		if _, isCompositionIDs := compositionIDFingerprintKeys[key]; isCompositionIDs {
+			// Stored ID arrays may be decoded as a different numeric type than the one submitted
+			fmt.Fprint(s.digester, sortedUniqueIDs(parseCompositionIDs(properties[key])))
+		} else {
+			fmt.Fprint(s.digester, properties[key])
+		}
 	}
 
 	return s.digester.Sum64()
@@ -19,6 +19,7 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
 	"github.com/specterops/dawgs/graph"
 	"github.com/stretchr/testify/require"
 )
@@ -272,3 +273,31 @@ func TestTracker_HasEdge_NilVsEmptyProperties(t *testing.T) {
 		t.Fatalf("expected nil properties to match nil-tracked edge")
 	}
 }
+
+func TestTracker_HasEdge_StoredCompositionMatches(t *testing.T) {
+	var (
+		builder   = NewTrackerBuilder()
+		submitted = EnsureRelationshipJob{
+			FromID:      1,
+			ToID:        2,
+			Kind:        kindA,
+			Composition: NewEdgeComposition("rule", 30, 20, 20).AddEdges(40),
+		}
+		stored = map[string]any{
+			common.CompositionRule.String():  "rule",
+			common.CompositionNodes.String(): []any{float64(20), float64(30)},
+			common.CompositionEdges.String(): []any{float64(40)},
+			"lastseen":                       "2026-01-01",
+		}
+	)
+
+	// Track the edge as it would be read back from the database
+	builder.TrackEdge(10, 1, 2, kindA, stored)
+	sub := builder.Build()
+
+	require.True(t, sub.HasEdge(1, 2, kindA, submitted.Properties()))
+	require.Empty(t, sub.Deleted())
+
+	submitted.Composition.AddNodes(50)
+	require.False(t, sub.HasEdge(1, 2, kindA, submitted.Properties()), "expected a changed composition to not match")
+}
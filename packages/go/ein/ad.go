@@ -19,6 +19,7 @@ package ein
 import (
 	"fmt"
 	"log/slog"
+	"maps"
 	"strconv"
 	"strings"
 	"time"
@@ -43,6 +44,18 @@ func ConvertSessionObject(session Session) IngestibleSession {
 	}
 }
 
+const (
+	// createChildRightName is the right collectors report for a generic CreateChild grant, which allows creating
+	// objects of any class beneath the target
+	createChildRightName = "CreateChild"
+
+	// objectClassProperty holds the object classes of an object when a collector reports them
+	objectClassProperty = "objectclass"
+
+	// dmsaObjectClass is the object class of delegated Managed Service Accounts
+	dmsaObjectClass = "msds-delegatedmanagedserviceaccount"
+)
+
 func ConvertObjectToNode(item IngestBase, itemType graph.Kind, ingestTime time.Time) IngestibleNode {
 	return IngestibleNode{
 		ObjectID:    item.ObjectIdentifier,
@@ -226,10 +239,43 @@ func getBaseProperties(item IngestBase, ingestTime time.Time) map[string]any {
 	itemProps[common.LastCollected.String()] = ingestTime
 
 	convertOwnsEdgeToProperty(item, itemProps)
+	convertDMSAProperty(itemProps)
 
 	return itemProps
 }
 
+// convertDMSAProperty sets the dmsa property of an account whose reported object classes include
+// msDS-DelegatedManagedServiceAccount. A dmsa value sent by the collector is kept as is.
+func convertDMSAProperty(itemProps map[string]any) {
+	if _, ok := itemProps[ad.DMSA.String()]; ok {
+		return
+	}
+
+	var objectClasses []string
+
+	switch typedValue := itemProps[objectClassProperty].(type) {
+	case string:
+		objectClasses = []string{typedValue}
+	case []string:
+		objectClasses = typedValue
+	case []any:
+		for _, value := range typedValue {
+			if objectClass, ok := value.(string); ok {
+				objectClasses = append(objectClasses, objectClass)
+			}
+		}
+	default:
+		return
+	}
+
+	for _, objectClass := range objectClasses {
+		if strings.EqualFold(objectClass, dmsaObjectClass) {
+			itemProps[ad.DMSA.String()] = true
+			return
+		}
+	}
+}
+
 // This function is to support our new method of doing Owns edges and makes older data sets backwards compatible
 func convertOwnsEdgeToProperty(item IngestBase, itemProps map[string]any) {
 	for _, ace := range item.Aces {
@@ -452,7 +498,13 @@ func ParseACEData(targetNode IngestibleNode, aces []ACE, targetID string, target
 			continue
 		}
 
-		if rightKind, err := ParseKind(ace.RightName); err != nil {
+		rightName := ace.RightName
+		if rightName == createChildRightName {
+			// A generic CreateChild grant includes creating a dMSA, the only child object BloodHound models creating
+			rightName = ad.CreateDMSA.String()
+		}
+
+		if rightKind, err := ParseKind(rightName); err != nil {
 			slog.Error(
 				"Error during ParseACEData",
 				attr.Error(err),
@@ -464,6 +516,15 @@ func ParseACEData(targetNode IngestibleNode, aces []ACE, targetID string, target
 				slog.String("right_name", ace.RightName),
 			)
 			continue
+		} else if rightKind.Is(ad.CreateDMSA) && !targetType.Is(ad.OU, ad.Container, ad.Domain) {
+			// dMSAs can only be created beneath container-like objects, so the right is meaningless elsewhere
+			continue
+		} else if rightKind.Is(ad.CreateDNSRecord) && !targetType.Is(ad.DNSZone) {
+			// Creating dnsNode children only lets a principal register records when granted on a zone
+			continue
+		} else if rightKind.Is(ad.AllowedToAuthenticate) && !targetType.Is(ad.Computer) {
+			// Selective authentication is only evaluated against the computer being authenticated to
+			continue
 		} else if rightKind.Is(ad.Owns) || rightKind.Is(ad.OwnsRaw) {
 			// Get Owner SID from ACE granting Owns permission
 			ownerPrincipalInfo = ace.GetCachedValue().SourceData
@@ -862,8 +923,58 @@ func ParseGpLinks(links []GPLink, itemIdentifier string, itemType graph.Kind) []
 	return relationships
 }
 
+// ParseSubnetData creates the HasSubnet relationship from the subnet's site to the subnet and an InSubnet relationship
+// from every computer that resolved to an address within the subnet.
+func ParseSubnetData(subnet Subnet) []IngestibleRelationship {
+	relationships := make([]IngestibleRelationship, 0, len(subnet.Computers)+1)
+
+	if subnet.Site != "" {
+		relationships = append(relationships, NewIngestibleRelationship(
+			IngestibleEndpoint{
+				Value: subnet.Site,
+				Kind:  ad.Site,
+			},
+			IngestibleEndpoint{
+				Value: subnet.ObjectIdentifier,
+				Kind:  ad.Subnet,
+			},
+			IngestibleRel{
+				RelProps: map[string]any{ad.IsACL.String(): false},
+				RelType:  ad.HasSubnet,
+			},
+		))
+	}
+
+	for _, computer := range subnet.Computers {
+		relationships = append(relationships, NewIngestibleRelationship(
+			IngestibleEndpoint{
+				Value: computer.ObjectIdentifier,
+				Kind:  computer.Kind(),
+			},
+			IngestibleEndpoint{
+				Value: subnet.ObjectIdentifier,
+				Kind:  ad.Subnet,
+			},
+			IngestibleRel{
+				RelProps: map[string]any{ad.IsACL.String(): false},
+				RelType:  ad.InSubnet,
+			},
+		))
+	}
+
+	return relationships
+}
+
+// Flags of the trustAttributes attribute that change how authentication is evaluated across a trust
+const (
+	TrustAttributeQuarantinedDomain = 0x00000004
+	TrustAttributeCrossOrganization = 0x00000010
+	TrustAttributePIMTrust          = 0x00000400
+)
+
 // ParseDomainTrusts converts the marshalled value of the domain's trust attributes to a valid int or nil
-// and sets the trust relationships for the domain
+// and sets the trust relationships for the domain. Quarantined trusts never produce SpoofSIDHistory edges while
+// PAM trusts produce them regardless of SID filtering, as the trusting forest accepts the SIDs of shadow principals.
 func ParseDomainTrusts(domain Domain) ParsedDomainTrustData {
 	parsedData := ParsedDomainTrustData{}
 
@@ -872,6 +983,9 @@ func ParseDomainTrusts(domain Domain) ParsedDomainTrustData {
 			convertedTrustAttributes int
 			invalidTrustAttribute    bool
 			finalTrustAttributes     any
+			quarantined              bool
+			pamTrust                 bool
+			trustAttributeProps      = map[string]any{}
 		)
 
 		// The type of the TrustAttributes is decided during decoding due to the `any` type usage
@@ -905,6 +1019,13 @@ func ParseDomainTrusts(domain Domain) ParsedDomainTrustData {
 			finalTrustAttributes = nil
 		} else {
 			finalTrustAttributes = convertedTrustAttributes
+			quarantined = convertedTrustAttributes&TrustAttributeQuarantinedDomain != 0
+			pamTrust = convertedTrustAttributes&TrustAttributePIMTrust != 0
+
+			// Only model trust attribute flags when the attributes were collected
+			trustAttributeProps[ad.Quarantined.String()] = quarantined
+			trustAttributeProps[ad.SelectiveAuthentication.String()] = convertedTrustAttributes&TrustAttributeCrossOrganization != 0
+			trustAttributeProps[ad.PAMTrust.String()] = pamTrust
 		}
 
 		parsedData.ExtraNodeProps = append(parsedData.ExtraNodeProps, IngestibleNode{
@@ -931,6 +1052,7 @@ func ParseDomainTrusts(domain Domain) ParsedDomainTrustData {
 			if finalTrustAttributes != nil {
 				realProps[ad.TGTDelegation.String()] = trust.TGTDelegationEnabled // collection of tgtdelegation was added with trustattributes
 			}
+			maps.Copy(realProps, trustAttributeProps)
 
 			parsedData.TrustRelationships = append(parsedData.TrustRelationships, NewIngestibleRelationship(
 				IngestibleEndpoint{
@@ -958,10 +1080,10 @@ func ParseDomainTrusts(domain Domain) ParsedDomainTrustData {
 						Kind:  ad.Domain,
 					},
 					IngestibleRel{
-						RelProps: map[string]any{
+						RelProps: withTrustAttributeProps(map[string]any{
 							ad.IsACL.String():     false,
 							ad.TrustType.String(): trust.TrustType,
-						},
+						}, trustAttributeProps),
 						RelType: ad.AbuseTGTDelegation,
 					},
 				))
@@ -976,6 +1098,7 @@ func ParseDomainTrusts(domain Domain) ParsedDomainTrustData {
 				ad.Transitive.String():              trust.IsTransitive,
 				ad.TrustAttributesOutbound.String(): finalTrustAttributes,
 			}
+			maps.Copy(realProps, trustAttributeProps)
 
 			parsedData.TrustRelationships = append(parsedData.TrustRelationships, NewIngestibleRelationship(
 				IngestibleEndpoint{
@@ -992,7 +1115,7 @@ func ParseDomainTrusts(domain Domain) ParsedDomainTrustData {
 				},
 			))
 
-			if edgeType == ad.CrossForestTrust && !trust.SidFilteringEnabled {
+			if edgeType == ad.CrossForestTrust && (!trust.SidFilteringEnabled || pamTrust) && !quarantined {
 				parsedData.TrustRelationships = append(parsedData.TrustRelationships, NewIngestibleRelationship(
 					IngestibleEndpoint{
 						Value: trust.TargetDomainSid,
@@ -1003,10 +1126,10 @@ func ParseDomainTrusts(domain Domain) ParsedDomainTrustData {
 						Kind:  ad.Domain,
 					},
 					IngestibleRel{
-						RelProps: map[string]any{
+						RelProps: withTrustAttributeProps(map[string]any{
 							ad.IsACL.String():     false,
 							ad.TrustType.String(): trust.TrustType,
-						},
+						}, trustAttributeProps),
 						RelType: ad.SpoofSIDHistory,
 					},
 				))
@@ -1017,6 +1140,13 @@ func ParseDomainTrusts(domain Domain) ParsedDomainTrustData {
 	return parsedData
 }
 
+// withTrustAttributeProps returns the given trust abuse edge properties with the trust attribute flags copied onto
+// them so that selective authentication and PAM trusts are visible on the abuse edge itself
+func withTrustAttributeProps(props map[string]any, trustAttributeProps map[string]any) map[string]any {
+	maps.Copy(props, trustAttributeProps)
+	return props
+}
+
 // ParseComputerMiscData parses AllowedToDelegate, AllowedToAct, HasSIDHistory, DumpSMSAPassword, DCFor, Sessions, and CoerceToTGT
 func ParseComputerMiscData(computer Computer) []IngestibleRelationship {
 	relationships := make([]IngestibleRelationship, 0)
@@ -1566,6 +1696,16 @@ const (
 	PrettyGPOStatusDisabled                      = "Disabled"
 )
 
+// typedPrincipalIDs returns the object identifiers of the given principals
+func typedPrincipalIDs(principals []TypedPrincipal) []string {
+	ids := make([]string, 0, len(principals))
+	for _, principal := range principals {
+		ids = append(ids, principal.ObjectIdentifier)
+	}
+
+	return ids
+}
+
 func ParseGPOData(gpo GPO) IngestibleNode {
 	propMap := make(map[string]any)
 
@@ -1586,6 +1726,37 @@ func ParseGPOData(gpo GPO) IngestibleNode {
 		}
 	}
 
+	if gpo.WMIFilter != "" {
+		propMap[ad.WMIFilter.String()] = gpo.WMIFilter
+	}
+
+	if len(gpo.SecurityFiltering) > 0 {
+		propMap[ad.SecurityFiltering.String()] = typedPrincipalIDs(gpo.SecurityFiltering)
+	}
+
+	settings := map[ad.Property][]TypedPrincipal{
+		ad.GPOLocalAdmins:        gpo.Settings.LocalAdmins,
+		ad.GPORemoteDesktopUsers: gpo.Settings.RemoteDesktopUsers,
+		ad.GPODcomUsers:          gpo.Settings.DcomUsers,
+		ad.GPOPSRemoteUsers:      gpo.Settings.PSRemoteUsers,
+	}
+
+	for _, userRight := range gpo.Settings.UserRights {
+		if userRight.Privilege == UserRightRemoteInteractiveLogon {
+			settings[ad.GPORemoteInteractiveLogonRight] = append(settings[ad.GPORemoteInteractiveLogonRight], userRight.Principals...)
+		}
+	}
+
+	for property, principals := range settings {
+		if len(principals) > 0 {
+			propMap[property.String()] = typedPrincipalIDs(principals)
+		}
+	}
+
+	if len(gpo.UserSettings.LogonUserGroups) > 0 {
+		propMap[ad.GPOLogonUserGroups.String()] = gpo.UserSettings.LogonUserGroups
+	}
+
 	return IngestibleNode{
 		ObjectID:    gpo.ObjectIdentifier,
 		PropertyMap: propMap,
@@ -248,6 +248,100 @@ func TestParseDomainTrusts_TrustAttributes(t *testing.T) {
 	})
 }
 
+func TestParseDomainTrusts_TrustAttributeFlags(t *testing.T) {
+	t.Parallel()
+
+	const (
+		domainSID  = "S-1-5-21-100"
+		trustedSID = "S-1-5-21-200"
+	)
+
+	parseOutboundForestTrust := func(sidFilteringEnabled bool, trustAttributes any) ein.ParsedDomainTrustData {
+		return ein.ParseDomainTrusts(ein.Domain{
+			IngestBase: ein.IngestBase{ObjectIdentifier: domainSID},
+			Trusts: []ein.Trust{
+				{
+					TargetDomainSid:     trustedSID,
+					IsTransitive:        true,
+					TrustDirection:      ein.TrustDirectionOutbound,
+					TrustType:           "Forest",
+					SidFilteringEnabled: sidFilteringEnabled,
+					TargetDomainName:    "TRUSTED.LOCAL",
+					TrustAttributes:     trustAttributes,
+				},
+			},
+		})
+	}
+
+	relationshipsOfKind := func(result ein.ParsedDomainTrustData, kind graph.Kind) []ein.IngestibleRelationship {
+		var matched []ein.IngestibleRelationship
+		for _, rel := range result.TrustRelationships {
+			if rel.RelType.Is(kind) {
+				matched = append(matched, rel)
+			}
+		}
+		return matched
+	}
+
+	t.Run("Selective authentication", func(t *testing.T) {
+		t.Parallel()
+
+		result := parseOutboundForestTrust(false, ein.TrustAttributeCrossOrganization)
+
+		trusts := relationshipsOfKind(result, ad.CrossForestTrust)
+		require.Len(t, trusts, 1)
+		assert.Equal(t, true, trusts[0].RelProps[ad.SelectiveAuthentication.String()])
+		assert.Equal(t, false, trusts[0].RelProps[ad.Quarantined.String()])
+		assert.Equal(t, false, trusts[0].RelProps[ad.PAMTrust.String()])
+
+		spoofs := relationshipsOfKind(result, ad.SpoofSIDHistory)
+		require.Len(t, spoofs, 1)
+		assert.Equal(t, trustedSID, spoofs[0].Source.Value)
+		assert.Equal(t, domainSID, spoofs[0].Target.Value)
+		assert.Equal(t, true, spoofs[0].RelProps[ad.SelectiveAuthentication.String()])
+	})
+
+	t.Run("Quarantined trust blocks SID history", func(t *testing.T) {
+		t.Parallel()
+
+		result := parseOutboundForestTrust(false, ein.TrustAttributeQuarantinedDomain)
+
+		trusts := relationshipsOfKind(result, ad.CrossForestTrust)
+		require.Len(t, trusts, 1)
+		assert.Equal(t, true, trusts[0].RelProps[ad.Quarantined.String()])
+		assert.Empty(t, relationshipsOfKind(result, ad.SpoofSIDHistory))
+	})
+
+	t.Run("PAM trust allows SID history despite SID filtering", func(t *testing.T) {
+		t.Parallel()
+
+		result := parseOutboundForestTrust(true, ein.TrustAttributePIMTrust)
+
+		spoofs := relationshipsOfKind(result, ad.SpoofSIDHistory)
+		require.Len(t, spoofs, 1)
+		assert.Equal(t, true, spoofs[0].RelProps[ad.PAMTrust.String()])
+	})
+
+	t.Run("SID filtering without PAM blocks SID history", func(t *testing.T) {
+		t.Parallel()
+
+		result := parseOutboundForestTrust(true, 0)
+		assert.Empty(t, relationshipsOfKind(result, ad.SpoofSIDHistory))
+	})
+
+	t.Run("Uncollected trust attributes", func(t *testing.T) {
+		t.Parallel()
+
+		result := parseOutboundForestTrust(false, nil)
+
+		trusts := relationshipsOfKind(result, ad.CrossForestTrust)
+		require.Len(t, trusts, 1)
+		assert.NotContains(t, trusts[0].RelProps, ad.SelectiveAuthentication.String())
+		assert.NotContains(t, trusts[0].RelProps, ad.Quarantined.String())
+		assert.Len(t, relationshipsOfKind(result, ad.SpoofSIDHistory), 1)
+	})
+}
+
 func TestConvertComputerToNode(t *testing.T) {
 	var restrictSendingNtlmTraffic uint = 2
 
@@ -371,7 +465,9 @@ func TestParseGPOData(t *testing.T) {
 			name: "ParseGPOData without Properties",
 			args: args{
 				gpo: ein.GPO{
-					ObjectIdentifier: "gpoBase",
+					IngestBase: ein.IngestBase{
+						ObjectIdentifier: "gpoBase",
+					},
 				},
 			},
 			expected: ein.IngestibleNode{
@@ -384,8 +480,10 @@ func TestParseGPOData(t *testing.T) {
 			name: "ParseGPOData with GPO Enabled",
 			args: args{
 				gpo: ein.GPO{
-					ObjectIdentifier: "gpoBase",
-					Properties:       map[string]any{ad.GPOStatus.String(): "0"},
+					IngestBase: ein.IngestBase{
+						ObjectIdentifier: "gpoBase",
+						Properties:       map[string]any{ad.GPOStatus.String(): "0"},
+					},
 				},
 			},
 			expected: ein.IngestibleNode{
@@ -398,8 +496,10 @@ func TestParseGPOData(t *testing.T) {
 			name: "ParseGPOData with GPO UserConfigurationDisabled",
 			args: args{
 				gpo: ein.GPO{
-					ObjectIdentifier: "gpoBase",
-					Properties:       map[string]any{ad.GPOStatus.String(): "1"},
+					IngestBase: ein.IngestBase{
+						ObjectIdentifier: "gpoBase",
+						Properties:       map[string]any{ad.GPOStatus.String(): "1"},
+					},
 				},
 			},
 			expected: ein.IngestibleNode{
@@ -412,8 +512,10 @@ func TestParseGPOData(t *testing.T) {
 			name: "ParseGPOData with GPO ComputerConfigurationDisabled",
 			args: args{
 				gpo: ein.GPO{
-					ObjectIdentifier: "gpoBase",
-					Properties:       map[string]any{ad.GPOStatus.String(): "2"},
+					IngestBase: ein.IngestBase{
+						ObjectIdentifier: "gpoBase",
+						Properties:       map[string]any{ad.GPOStatus.String(): "2"},
+					},
 				},
 			},
 			expected: ein.IngestibleNode{
@@ -426,8 +528,10 @@ func TestParseGPOData(t *testing.T) {
 			name: "ParseGPOData with GPO Disabled",
 			args: args{
 				gpo: ein.GPO{
-					ObjectIdentifier: "gpoBase",
-					Properties:       map[string]any{ad.GPOStatus.String(): "3"},
+					IngestBase: ein.IngestBase{
+						ObjectIdentifier: "gpoBase",
+						Properties:       map[string]any{ad.GPOStatus.String(): "3"},
+					},
 				},
 			},
 			expected: ein.IngestibleNode{
@@ -440,8 +544,10 @@ func TestParseGPOData(t *testing.T) {
 			name: "ParseGPOData with Invalid GPO Status",
 			args: args{
 				gpo: ein.GPO{
-					ObjectIdentifier: "gpoBase",
-					Properties:       map[string]any{ad.GPOStatus.String(): "4"},
+					IngestBase: ein.IngestBase{
+						ObjectIdentifier: "gpoBase",
+						Properties:       map[string]any{ad.GPOStatus.String(): "4"},
+					},
 				},
 			},
 			expected: ein.IngestibleNode{
@@ -454,8 +560,10 @@ func TestParseGPOData(t *testing.T) {
 			name: "ParseGPOData with numeric status (int)",
 			args: args{
 				gpo: ein.GPO{
-					ObjectIdentifier: "gpoBase",
-					Properties:       map[string]any{ad.GPOStatus.String(): 2},
+					IngestBase: ein.IngestBase{
+						ObjectIdentifier: "gpoBase",
+						Properties:       map[string]any{ad.GPOStatus.String(): 2},
+					},
 				},
 			},
 			expected: ein.IngestibleNode{
@@ -468,8 +576,10 @@ func TestParseGPOData(t *testing.T) {
 			name: "ParseGPOData with numeric status (float64)",
 			args: args{
 				gpo: ein.GPO{
-					ObjectIdentifier: "gpoBase",
-					Properties:       map[string]any{ad.GPOStatus.String(): float64(3)},
+					IngestBase: ein.IngestBase{
+						ObjectIdentifier: "gpoBase",
+						Properties:       map[string]any{ad.GPOStatus.String(): float64(3)},
+					},
 				},
 			},
 			expected: ein.IngestibleNode{
@@ -482,8 +592,10 @@ func TestParseGPOData(t *testing.T) {
 			name: "ParseGPOData with whitespace-padded string",
 			args: args{
 				gpo: ein.GPO{
-					ObjectIdentifier: "gpoBase",
-					Properties:       map[string]any{ad.GPOStatus.String(): " 1 "},
+					IngestBase: ein.IngestBase{
+						ObjectIdentifier: "gpoBase",
+						Properties:       map[string]any{ad.GPOStatus.String(): " 1 "},
+					},
 				},
 			},
 			expected: ein.IngestibleNode{
@@ -492,6 +604,45 @@ func TestParseGPOData(t *testing.T) {
 				Labels:      []graph.Kind{ad.GPO},
 			},
 		},
+		{
+			name: "ParseGPOData with settings and filtering",
+			args: args{
+				gpo: ein.GPO{
+					IngestBase: ein.IngestBase{
+						ObjectIdentifier: "gpoBase",
+					},
+					Settings: ein.GPOSettings{
+						LocalAdmins: []ein.TypedPrincipal{{ObjectIdentifier: "S-1-5-21-1-1105", ObjectType: ad.Group.String()}},
+						UserRights: []ein.GPOUserRight{
+							{
+								Privilege:  ein.UserRightRemoteInteractiveLogon,
+								Principals: []ein.TypedPrincipal{{ObjectIdentifier: "S-1-5-21-1-1106", ObjectType: ad.User.String()}},
+							},
+							{
+								Privilege:  "SeBackupPrivilege",
+								Principals: []ein.TypedPrincipal{{ObjectIdentifier: "S-1-5-21-1-1107", ObjectType: ad.User.String()}},
+							},
+						},
+					},
+					UserSettings: ein.GPOUserSettings{
+						LogonUserGroups: []string{"S-1-5-32-555"},
+					},
+					WMIFilter:         `SELECT * FROM Win32_OperatingSystem WHERE ProductType = "1"`,
+					SecurityFiltering: []ein.TypedPrincipal{{ObjectIdentifier: "S-1-5-21-1-515", ObjectType: ad.Group.String()}},
+				},
+			},
+			expected: ein.IngestibleNode{
+				ObjectID: "gpoBase",
+				PropertyMap: map[string]any{
+					ad.GPOLocalAdmins.String():                 []string{"S-1-5-21-1-1105"},
+					ad.GPORemoteInteractiveLogonRight.String(): []string{"S-1-5-21-1-1106"},
+					ad.GPOLogonUserGroups.String():             []string{"S-1-5-32-555"},
+					ad.WMIFilter.String():                      `SELECT * FROM Win32_OperatingSystem WHERE ProductType = "1"`,
+					ad.SecurityFiltering.String():              []string{"S-1-5-21-1-515"},
+				},
+				Labels: []graph.Kind{ad.GPO},
+			},
+		},
 	}
 
 	for _, testCase := range tt {
@@ -503,3 +654,185 @@ func TestParseGPOData(t *testing.T) {
 		})
 	}
 }
+
+func TestParseACEData_CreateDMSA(t *testing.T) {
+	t.Parallel()
+
+	aces := []ein.ACE{
+		{
+			PrincipalSID:  "S-1-5-21-1-1105",
+			PrincipalType: ad.User.String(),
+			RightName:     ad.CreateDMSA.String(),
+			IsInherited:   false,
+		},
+	}
+
+	t.Run("OU target", func(t *testing.T) {
+		t.Parallel()
+
+		result := ein.ParseACEData(ein.IngestibleNode{}, aces, "ou-guid", ad.OU)
+		require.Len(t, result, 1)
+		assert.Equal(t, ad.CreateDMSA, result[0].RelType)
+		assert.Equal(t, "S-1-5-21-1-1105", result[0].Source.Value)
+		assert.Equal(t, "ou-guid", result[0].Target.Value)
+		assert.Equal(t, true, result[0].RelProps[ad.IsACL.String()])
+	})
+
+	t.Run("Non-container target", func(t *testing.T) {
+		t.Parallel()
+
+		result := ein.ParseACEData(ein.IngestibleNode{}, aces, "S-1-5-21-1-1106", ad.User)
+		assert.Empty(t, result)
+	})
+
+	t.Run("Generic CreateChild", func(t *testing.T) {
+		t.Parallel()
+
+		result := ein.ParseACEData(ein.IngestibleNode{}, []ein.ACE{{
+			PrincipalSID:  "S-1-5-21-1-1105",
+			PrincipalType: ad.User.String(),
+			RightName:     "CreateChild",
+		}}, "ou-guid", ad.OU)
+		require.Len(t, result, 1)
+		assert.Equal(t, ad.CreateDMSA, result[0].RelType)
+	})
+}
+
+func TestConvertObjectToNode_DMSA(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name       string
+		properties map[string]any
+		expected   any
+	}{
+		{
+			name:       "object class list",
+			properties: map[string]any{"objectclass": []any{"top", "person", "msDS-DelegatedManagedServiceAccount"}},
+			expected:   true,
+		},
+		{
+			name:       "object class string",
+			properties: map[string]any{"objectclass": "msDS-DelegatedManagedServiceAccount"},
+			expected:   true,
+		},
+		{
+			name:       "collector value is kept",
+			properties: map[string]any{"objectclass": "msDS-DelegatedManagedServiceAccount", "dmsa": false},
+			expected:   false,
+		},
+		{
+			name:       "other object class",
+			properties: map[string]any{"objectclass": []string{"top", "user"}},
+		},
+	}
+
+	for _, testCase := range tt {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			result := ein.ConvertObjectToNode(ein.IngestBase{Properties: testCase.properties}, ad.User, time.Now())
+			assert.Equal(t, testCase.expected, result.PropertyMap[ad.DMSA.String()])
+		})
+	}
+}
+
+func TestParseACEData_CreateDNSRecord(t *testing.T) {
+	t.Parallel()
+
+	aces := []ein.ACE{
+		{
+			PrincipalSID:  "S-1-5-21-1-1105",
+			PrincipalType: ad.User.String(),
+			RightName:     ad.CreateDNSRecord.String(),
+			IsInherited:   false,
+		},
+	}
+
+	t.Run("DNSZone target", func(t *testing.T) {
+		t.Parallel()
+
+		result := ein.ParseACEData(ein.IngestibleNode{}, aces, "zone-guid", ad.DNSZone)
+		require.Len(t, result, 1)
+		assert.Equal(t, ad.CreateDNSRecord, result[0].RelType)
+		assert.Equal(t, "S-1-5-21-1-1105", result[0].Source.Value)
+		assert.Equal(t, "zone-guid", result[0].Target.Value)
+		assert.Equal(t, true, result[0].RelProps[ad.IsACL.String()])
+	})
+
+	t.Run("Non-zone target", func(t *testing.T) {
+		t.Parallel()
+
+		result := ein.ParseACEData(ein.IngestibleNode{}, aces, "ou-guid", ad.OU)
+		assert.Empty(t, result)
+	})
+}
+
+func TestParseSubnetData(t *testing.T) {
+	t.Parallel()
+
+	subnet := ein.Subnet{
+		IngestBase: ein.IngestBase{
+			ObjectIdentifier: "subnet-guid",
+		},
+		Site: "site-guid",
+		Computers: []ein.TypedPrincipal{
+			{
+				ObjectIdentifier: "S-1-5-21-1-1001",
+				ObjectType:       ad.Computer.String(),
+			},
+		},
+	}
+
+	expected := []ein.IngestibleRelationship{
+		{
+			Source:   ein.IngestibleEndpoint{Value: "site-guid", Kind: ad.Site},
+			Target:   ein.IngestibleEndpoint{Value: "subnet-guid", Kind: ad.Subnet},
+			RelType:  ad.HasSubnet,
+			RelProps: map[string]any{ad.IsACL.String(): false},
+		},
+		{
+			Source:   ein.IngestibleEndpoint{Value: "S-1-5-21-1-1001", Kind: ad.Computer},
+			Target:   ein.IngestibleEndpoint{Value: "subnet-guid", Kind: ad.Subnet},
+			RelType:  ad.InSubnet,
+			RelProps: map[string]any{ad.IsACL.String(): false},
+		},
+	}
+
+	assert.Equal(t, expected, ein.ParseSubnetData(subnet))
+
+	subnet.Site = ""
+	result := ein.ParseSubnetData(subnet)
+	require.Len(t, result, 1)
+	assert.Equal(t, ad.InSubnet, result[0].RelType)
+}
+
+func TestParseACEData_AllowedToAuthenticate(t *testing.T) {
+	t.Parallel()
+
+	aces := []ein.ACE{
+		{
+			PrincipalSID:  "S-1-5-21-200-1105",
+			PrincipalType: ad.User.String(),
+			RightName:     ad.AllowedToAuthenticate.String(),
+			IsInherited:   false,
+		},
+	}
+
+	t.Run("Computer target", func(t *testing.T) {
+		t.Parallel()
+
+		result := ein.ParseACEData(ein.IngestibleNode{}, aces, "S-1-5-21-100-1001", ad.Computer)
+		require.Len(t, result, 1)
+		assert.Equal(t, ad.AllowedToAuthenticate, result[0].RelType)
+		assert.Equal(t, "S-1-5-21-200-1105", result[0].Source.Value)
+		assert.Equal(t, "S-1-5-21-100-1001", result[0].Target.Value)
+	})
+
+	t.Run("Non-computer target", func(t *testing.T) {
+		t.Parallel()
+
+		result := ein.ParseACEData(ein.IngestibleNode{}, aces, "S-1-5-21-100-1106", ad.User)
+		assert.Empty(t, result)
+	})
+}
@@ -41,6 +41,8 @@ const (
 	KeyVaultPermissionGet string = "Get"
 	AzureSerialError      string = "Error deserializing Azure data"
 	AzureExtractError     string = "Failed to extract id/type from Azure directory object"
+
+	AdministrativeUnitScopePrefix string = "/administrativeUnits/"
 )
 
 var (
@@ -818,6 +820,78 @@ func ConvertAzureManagementGroup(data models.ManagementGroup, ingestTime time.Ti
 		)
 }
 
+func ConvertAzureAdministrativeUnit(data AzureAdministrativeUnit, ingestTime time.Time) (IngestibleNode, IngestibleRelationship) {
+	return IngestibleNode{
+			ObjectID: data.Id,
+			PropertyMap: map[string]any{
+				common.Name.String():                        fmt.Sprintf("%s@%s", data.DisplayName, data.TenantName),
+				common.Description.String():                 data.Description,
+				common.DisplayName.String():                 data.DisplayName,
+				azure.IsMemberManagementRestricted.String(): data.IsMemberManagementRestricted,
+				azure.TenantID.String():                     strings.ToUpper(data.TenantId),
+				common.LastCollected.String():               ingestTime,
+			},
+			Labels: []graph.Kind{azure.AdministrativeUnit},
+		}, NewIngestibleRelationship(
+			IngestibleEndpoint{
+				Value: data.TenantId,
+				Kind:  azure.Tenant,
+			},
+			IngestibleEndpoint{
+				Kind:  azure.AdministrativeUnit,
+				Value: data.Id,
+			},
+			IngestibleRel{
+				RelProps: map[string]any{},
+				RelType:  azure.Contains,
+			},
+		)
+}
+
+func ConvertAzureAdministrativeUnitMembersToRels(data AzureAdministrativeUnitMembers) []IngestibleRelationship {
+	relationships := make([]IngestibleRelationship, 0)
+
+	for _, raw := range data.Members {
+		var (
+			member azure2.DirectoryObject
+		)
+		if err := json.Unmarshal(raw.Member, &member); err != nil {
+			slog.Error(
+				AzureSerialError,
+				slog.String("type", "administrative unit member"),
+				attr.Error(err),
+			)
+		} else if memberType, err := ExtractTypeFromDirectoryObject(member); errors.Is(err, ErrInvalidType) {
+			slog.Warn(
+				AzureExtractError,
+				attr.Error(err),
+			)
+		} else if err != nil {
+			slog.Error(
+				AzureExtractError,
+				attr.Error(err),
+			)
+		} else {
+			relationships = append(relationships, NewIngestibleRelationship(
+				IngestibleEndpoint{
+					Kind:  azure.AdministrativeUnit,
+					Value: data.AdministrativeUnitId,
+				},
+				IngestibleEndpoint{
+					Value: member.Id,
+					Kind:  memberType,
+				},
+				IngestibleRel{
+					RelProps: map[string]any{},
+					RelType:  azure.Contains,
+				},
+			))
+		}
+	}
+
+	return relationships
+}
+
 func ConvertAzureResourceGroup(data models.ResourceGroup, ingestTime time.Time) (IngestibleNode, IngestibleRelationship) {
 	return IngestibleNode{
 			ObjectID: data.Id,
@@ -958,7 +1032,26 @@ func ConvertAzureRoleAssignmentToRels(roleAssignment azure2.UnifiedRoleAssignmen
 		scope = strings.ToUpper(roleAssignment.DirectoryScopeId[1:])
 	}
 
-	if CanAddSecret(roleAssignment.RoleDefinitionId) && roleAssignment.DirectoryScopeId != "/" {
+	if administrativeUnitId, isScoped := strings.CutPrefix(roleAssignment.DirectoryScopeId, AdministrativeUnitScopePrefix); isScoped {
+		// Assignments scoped to an administrative unit only grant control over its members. They are written as
+		// AZHasScopedRole so that post-processing of the tenant-wide AZHasRole edges does not pick them up.
+		relationships = append(relationships, NewIngestibleRelationship(
+			IngestibleEndpoint{
+				Value: roleAssignment.PrincipalId,
+				Kind:  azure.Entity,
+			},
+			IngestibleEndpoint{
+				Kind:  azure.Role,
+				Value: roleObjectId,
+			},
+			IngestibleRel{
+				RelProps: map[string]any{
+					azure.Scope.String(): strings.ToUpper(administrativeUnitId),
+				},
+				RelType: azure.AZHasScopedRole,
+			},
+		))
+	} else if CanAddSecret(roleAssignment.RoleDefinitionId) && roleAssignment.DirectoryScopeId != "/" {
 		if relType, err := GetAddSecretRoleKind(roleAssignment.RoleDefinitionId); err != nil {
 			slog.Error(
 				"Error processing role assignment for role",
@@ -0,0 +1,154 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ein
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
+)
+
+const (
+	ConditionalAccessStateEnabled = "enabled"
+
+	ConditionalAccessAll = "All"
+
+	ConditionalAccessControlMFA             = "mfa"
+	ConditionalAccessControlCompliantDevice = "compliantDevice"
+)
+
+// conditionalAccessKeywords are values Microsoft Graph places in the include and exclude lists of a Conditional Access
+// policy that do not refer to a directory object
+var conditionalAccessKeywords = []string{
+	ConditionalAccessAll,
+	"None",
+	"GuestsOrExternalUsers",
+	"Office365",
+	"MicrosoftAdminPortals",
+}
+
+// IsEnforcing reports whether the policy is switched on and requires either MFA or a compliant device. Policies in
+// report-only mode are not enforcing.
+func (s AzureConditionalAccessPolicy) IsEnforcing() bool {
+	if s.State != ConditionalAccessStateEnabled {
+		return false
+	}
+
+	for _, control := range s.GrantControls.BuiltInControls {
+		if strings.EqualFold(control, ConditionalAccessControlMFA) || strings.EqualFold(control, ConditionalAccessControlCompliantDevice) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func ConvertAzureConditionalAccessPolicy(data AzureConditionalAccessPolicy, ingestTime time.Time) (IngestibleNode, []IngestibleRelationship) {
+	var (
+		users         = data.Conditions.Users
+		applications  = data.Conditions.Applications
+		relationships = []IngestibleRelationship{
+			NewIngestibleRelationship(
+				IngestibleEndpoint{
+					Value: data.TenantId,
+					Kind:  azure.Tenant,
+				},
+				IngestibleEndpoint{
+					Kind:  azure.ConditionalAccessPolicy,
+					Value: data.Id,
+				},
+				IngestibleRel{
+					RelProps: map[string]any{},
+					RelType:  azure.Contains,
+				},
+			),
+		}
+	)
+
+	relationships = append(relationships, conditionalAccessRels(data.Id, users.IncludeUsers, azure.User, azure.AZConditionalAccessIncludes)...)
+	relationships = append(relationships, conditionalAccessRels(data.Id, users.ExcludeUsers, azure.User, azure.AZConditionalAccessExcludes)...)
+	relationships = append(relationships, conditionalAccessRels(data.Id, users.IncludeGroups, azure.Group, azure.AZConditionalAccessIncludes)...)
+	relationships = append(relationships, conditionalAccessRels(data.Id, users.ExcludeGroups, azure.Group, azure.AZConditionalAccessExcludes)...)
+	relationships = append(relationships, conditionalAccessRels(data.Id, conditionalAccessRoleObjectIDs(users.IncludeRoles, data.TenantId), azure.Role, azure.AZConditionalAccessIncludes)...)
+	relationships = append(relationships, conditionalAccessRels(data.Id, conditionalAccessRoleObjectIDs(users.ExcludeRoles, data.TenantId), azure.Role, azure.AZConditionalAccessExcludes)...)
+	relationships = append(relationships, conditionalAccessRels(data.Id, applications.IncludeApplications, azure.App, azure.AZConditionalAccessIncludes)...)
+	relationships = append(relationships, conditionalAccessRels(data.Id, applications.ExcludeApplications, azure.App, azure.AZConditionalAccessExcludes)...)
+
+	return IngestibleNode{
+		ObjectID: data.Id,
+		PropertyMap: map[string]any{
+			common.Name.String():                        fmt.Sprintf("%s@%s", data.DisplayName, data.TenantName),
+			common.DisplayName.String():                 data.DisplayName,
+			azure.ConditionalAccessPolicyState.String(): data.State,
+			azure.GrantControls.String():                data.GrantControls.BuiltInControls,
+			azure.Enforcing.String():                    data.IsEnforcing(),
+			azure.IncludesAllUsers.String():             slices.Contains(users.IncludeUsers, ConditionalAccessAll),
+			azure.IncludesAllApplications.String():      slices.Contains(applications.IncludeApplications, ConditionalAccessAll),
+			azure.TenantID.String():                     strings.ToUpper(data.TenantId),
+			common.LastCollected.String():               ingestTime,
+		},
+		Labels: []graph.Kind{azure.ConditionalAccessPolicy},
+	}, relationships
+}
+
+// conditionalAccessRoleObjectIDs maps the role template IDs used by Conditional Access to the object IDs of the
+// tenant's role nodes
+func conditionalAccessRoleObjectIDs(roleTemplateIDs []string, tenantID string) []string {
+	objectIDs := make([]string, 0, len(roleTemplateIDs))
+
+	for _, roleTemplateID := range roleTemplateIDs {
+		if slices.Contains(conditionalAccessKeywords, roleTemplateID) {
+			continue
+		}
+
+		objectIDs = append(objectIDs, strings.ToUpper(fmt.Sprintf("%s@%s", roleTemplateID, tenantID)))
+	}
+
+	return objectIDs
+}
+
+func conditionalAccessRels(policyID string, targetIDs []string, targetKind graph.Kind, relType graph.Kind) []IngestibleRelationship {
+	relationships := make([]IngestibleRelationship, 0, len(targetIDs))
+
+	for _, targetID := range targetIDs {
+		if slices.Contains(conditionalAccessKeywords, targetID) {
+			continue
+		}
+
+		relationships = append(relationships, NewIngestibleRelationship(
+			IngestibleEndpoint{
+				Kind:  azure.ConditionalAccessPolicy,
+				Value: policyID,
+			},
+			IngestibleEndpoint{
+				Kind:  targetKind,
+				Value: targetID,
+			},
+			IngestibleRel{
+				RelProps: map[string]any{},
+				RelType:  relType,
+			},
+		))
+	}
+
+	return relationships
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ein_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/specterops/bloodhound/packages/go/ein"
+	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/dawgs/graph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAzureConditionalAccessPolicy_IsEnforcing(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		state    string
+		controls []string
+		expected bool
+	}{
+		{name: "enabled with mfa", state: "enabled", controls: []string{"mfa"}, expected: true},
+		{name: "enabled with compliant device", state: "enabled", controls: []string{"domainJoinedDevice", "compliantDevice"}, expected: true},
+		{name: "report only", state: "enabledForReportingButNotEnforced", controls: []string{"mfa"}, expected: false},
+		{name: "disabled", state: "disabled", controls: []string{"mfa"}, expected: false},
+		{name: "block only", state: "enabled", controls: []string{"block"}, expected: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			policy := ein.AzureConditionalAccessPolicy{
+				State:         tc.state,
+				GrantControls: ein.AzureConditionalAccessGrantControls{BuiltInControls: tc.controls},
+			}
+
+			assert.Equal(t, tc.expected, policy.IsEnforcing())
+		})
+	}
+}
+
+func TestConvertAzureConditionalAccessPolicy(t *testing.T) {
+	t.Parallel()
+
+	var (
+		tenantID   = "6c12b0b0-b2cc-4a73-8252-0b94bfca2145"
+		policyID   = "a8b2c3d4-0000-4f4f-9a9a-0123456789ab"
+		userID     = "e1a4ad1e-3b5f-4a8e-9a21-1f4f3b1a7c11"
+		groupID    = "1b6a8d7c-2e3f-4a5b-8c9d-0e1f2a3b4c5d"
+		globalRole = "62e90394-69f5-4237-9190-012177145e10"
+		appID      = "797f4846-ba00-4fd7-ba43-dac1f8f63013"
+		ingestTime = time.Now()
+		policy     = ein.AzureConditionalAccessPolicy{
+			Id:          policyID,
+			DisplayName: "Require MFA for all users",
+			State:       "enabled",
+			Conditions: ein.AzureConditionalAccessConditions{
+				Users: ein.AzureConditionalAccessUsers{
+					IncludeUsers:  []string{"All"},
+					ExcludeUsers:  []string{userID, "GuestsOrExternalUsers"},
+					ExcludeGroups: []string{groupID},
+					IncludeRoles:  []string{globalRole},
+				},
+				Applications: ein.AzureConditionalAccessApplications{
+					IncludeApplications: []string{"All"},
+					ExcludeApplications: []string{appID},
+				},
+			},
+			GrantControls: ein.AzureConditionalAccessGrantControls{Operator: "OR", BuiltInControls: []string{"mfa"}},
+			TenantId:      tenantID,
+			TenantName:    "contoso.onmicrosoft.com",
+		}
+	)
+
+	node, rels := ein.ConvertAzureConditionalAccessPolicy(policy, ingestTime)
+
+	assert.Equal(t, policyID, node.ObjectID)
+	assert.Equal(t, []graph.Kind{azure.ConditionalAccessPolicy}, node.Labels)
+	assert.Equal(t, "Require MFA for all users@contoso.onmicrosoft.com", node.PropertyMap["name"])
+	assert.Equal(t, true, node.PropertyMap[azure.Enforcing.String()])
+	assert.Equal(t, true, node.PropertyMap[azure.IncludesAllUsers.String()])
+	assert.Equal(t, true, node.PropertyMap[azure.IncludesAllApplications.String()])
+	assert.Equal(t, "6C12B0B0-B2CC-4A73-8252-0B94BFCA2145", node.PropertyMap[azure.TenantID.String()])
+
+	// Tenant containment plus one relationship per directory object; keywords such as All are not edges
+	require.Len(t, rels, 5)
+
+	assert.Equal(t, tenantID, rels[0].Source.Value)
+	assert.Equal(t, azure.Contains, rels[0].RelType)
+
+	assert.Equal(t, userID, rels[1].Target.Value)
+	assert.Equal(t, azure.User, rels[1].Target.Kind)
+	assert.Equal(t, azure.AZConditionalAccessExcludes, rels[1].RelType)
+
+	assert.Equal(t, groupID, rels[2].Target.Value)
+	assert.Equal(t, azure.Group, rels[2].Target.Kind)
+	assert.Equal(t, azure.AZConditionalAccessExcludes, rels[2].RelType)
+
+	assert.Equal(t, "62E90394-69F5-4237-9190-012177145E10@6C12B0B0-B2CC-4A73-8252-0B94BFCA2145", rels[3].Target.Value)
+	assert.Equal(t, azure.Role, rels[3].Target.Kind)
+	assert.Equal(t, azure.AZConditionalAccessIncludes, rels[3].RelType)
+
+	assert.Equal(t, policyID, rels[4].Source.Value)
+	assert.Equal(t, appID, rels[4].Target.Value)
+	assert.Equal(t, azure.App, rels[4].Target.Kind)
+	assert.Equal(t, azure.AZConditionalAccessExcludes, rels[4].RelType)
+}
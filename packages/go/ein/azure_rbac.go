@@ -0,0 +1,313 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ein
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/dawgs/graph"
+)
+
+const (
+	RBACActionAll                   = "*"
+	RBACActionRoleAssignmentsWrite  = "Microsoft.Authorization/roleAssignments/write"
+	RBACActionVMRunCommand          = "Microsoft.Compute/virtualMachines/runCommand/action"
+	RBACActionVMLoginAsAdmin        = "Microsoft.Compute/virtualMachines/loginAsAdmin/action"
+	RBACActionVMScaleSetRunCommand  = "Microsoft.Compute/virtualMachineScaleSets/virtualMachines/runCommand/action"
+	RBACActionKeyVaultPolicyWrite   = "Microsoft.KeyVault/vaults/accessPolicies/write"
+	RBACActionKeyVaultGetSecret     = "Microsoft.KeyVault/vaults/secrets/getSecret/action"
+	RBACActionKeyVaultReadKeys      = "Microsoft.KeyVault/vaults/keys/read"
+	RBACActionKeyVaultReadCerts     = "Microsoft.KeyVault/vaults/certificates/read"
+	RBACActionRunbookWrite          = "Microsoft.Automation/automationAccounts/runbooks/write"
+	RBACActionAKSRunCommand         = "Microsoft.ContainerService/managedClusters/runCommand/action"
+	RBACActionWebSiteWrite          = "Microsoft.Web/sites/write"
+	RBACActionLogicAppWorkflowWrite = "Microsoft.Logic/workflows/write"
+)
+
+const (
+	rbacResourceTypeVM                = "microsoft.compute/virtualmachines"
+	rbacResourceTypeVMScaleSet        = "microsoft.compute/virtualmachinescalesets"
+	rbacResourceTypeKeyVault          = "microsoft.keyvault/vaults"
+	rbacResourceTypeAutomationAccount = "microsoft.automation/automationaccounts"
+	rbacResourceTypeManagedCluster    = "microsoft.containerservice/managedclusters"
+	rbacResourceTypeLogicApp          = "microsoft.logic/workflows"
+	rbacResourceTypeContainerRegistry = "microsoft.containerregistry/registries"
+	rbacResourceTypeWebSite           = "microsoft.web/sites"
+)
+
+// rbacResourceTypeKinds maps Azure resource provider types to the node kind that represents them. Microsoft.Web/sites
+// is shared by web apps and function apps so it is left out and matched as an entity.
+var rbacResourceTypeKinds = map[string]graph.Kind{
+	rbacResourceTypeVM:                azure.VM,
+	rbacResourceTypeVMScaleSet:        azure.VMScaleSet,
+	rbacResourceTypeKeyVault:          azure.KeyVault,
+	rbacResourceTypeAutomationAccount: azure.AutomationAccount,
+	rbacResourceTypeManagedCluster:    azure.ManagedCluster,
+	rbacResourceTypeLogicApp:          azure.LogicApp,
+	rbacResourceTypeContainerRegistry: azure.ContainerRegistry,
+}
+
+// rbacAbuseAction pairs an action with the abuse edge created when a role grants it over a resource of the given type
+type rbacAbuseAction struct {
+	resourceType string
+	action       string
+	dataAction   bool
+	relType      graph.Kind
+}
+
+// rbacAbuseActions lists the resource specific abuse edges. They are created for assignments on the resource itself
+// and for assignments inherited from the resource group or subscription containing it.
+var rbacAbuseActions = []rbacAbuseAction{
+	{resourceType: rbacResourceTypeVM, action: RBACActionVMRunCommand, relType: azure.VMContributor},
+	{resourceType: rbacResourceTypeVM, action: RBACActionVMLoginAsAdmin, dataAction: true, relType: azure.VMAdminLogin},
+	{resourceType: rbacResourceTypeVMScaleSet, action: RBACActionVMScaleSetRunCommand, relType: azure.VMContributor},
+	{resourceType: rbacResourceTypeKeyVault, action: RBACActionKeyVaultPolicyWrite, relType: azure.KeyVaultContributor},
+	{resourceType: rbacResourceTypeKeyVault, action: RBACActionKeyVaultGetSecret, dataAction: true, relType: azure.GetSecrets},
+	{resourceType: rbacResourceTypeKeyVault, action: RBACActionKeyVaultReadKeys, dataAction: true, relType: azure.GetKeys},
+	{resourceType: rbacResourceTypeKeyVault, action: RBACActionKeyVaultReadCerts, dataAction: true, relType: azure.GetCertificates},
+	{resourceType: rbacResourceTypeAutomationAccount, action: RBACActionRunbookWrite, relType: azure.AutomationContributor},
+	{resourceType: rbacResourceTypeManagedCluster, action: RBACActionAKSRunCommand, relType: azure.AKSContributor},
+	{resourceType: rbacResourceTypeWebSite, action: RBACActionWebSiteWrite, relType: azure.WebsiteContributor},
+	{resourceType: rbacResourceTypeLogicApp, action: RBACActionLogicAppWorkflowWrite, relType: azure.LogicAppContributor},
+}
+
+// rbacResourceType returns the lower case provider resource type of a top level Azure resource ID, or an empty string
+// if the ID does not identify a resource within a resource group
+func rbacResourceType(resourceID string) string {
+	segments := strings.Split(strings.Trim(strings.ToLower(resourceID), "/"), "/")
+
+	if len(segments) == 8 && segments[0] == "subscriptions" && segments[2] == "resourcegroups" && segments[4] == "providers" {
+		return segments[5] + "/" + segments[6]
+	}
+
+	return ""
+}
+
+// RBACTargetKind returns the node kind of the Azure resource identified by the given resource ID. Resources that
+// cannot be told apart by their resource ID are returned as azure.Entity.
+func RBACTargetKind(resourceID string) graph.Kind {
+	segments := strings.Split(strings.Trim(strings.ToLower(resourceID), "/"), "/")
+
+	switch {
+	case len(segments) == 4 && segments[0] == "providers" && segments[1] == "microsoft.management" && segments[2] == "managementgroups":
+		return azure.ManagementGroup
+	case len(segments) == 2 && segments[0] == "subscriptions":
+		return azure.Subscription
+	case len(segments) == 4 && segments[0] == "subscriptions" && segments[2] == "resourcegroups":
+		return azure.ResourceGroup
+	}
+
+	if kind, ok := rbacResourceTypeKinds[rbacResourceType(resourceID)]; ok {
+		return kind
+	}
+
+	return azure.Entity
+}
+
+// RBACActionMatches reports whether the given action matches an action pattern of a role definition. Patterns are
+// compared case-insensitively and a wildcard matches any sequence of characters, including separators.
+func RBACActionMatches(pattern, action string) bool {
+	var (
+		parts     = strings.Split(strings.ToLower(pattern), "*")
+		remaining = strings.ToLower(action)
+	)
+
+	if !strings.HasPrefix(remaining, parts[0]) {
+		return false
+	}
+
+	remaining = remaining[len(parts[0]):]
+
+	if len(parts) == 1 {
+		return remaining == ""
+	}
+
+	for _, part := range parts[1 : len(parts)-1] {
+		if idx := strings.Index(remaining, part); idx < 0 {
+			return false
+		} else {
+			remaining = remaining[idx+len(part):]
+		}
+	}
+
+	return strings.HasSuffix(remaining, parts[len(parts)-1])
+}
+
+func rbacActionAllowed(allowed, denied []string, action string) bool {
+	for _, pattern := range denied {
+		if RBACActionMatches(pattern, action) {
+			return false
+		}
+	}
+
+	for _, pattern := range allowed {
+		if RBACActionMatches(pattern, action) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GrantsAction reports whether any permission block of the role definition allows the given control plane action
+func (s AzureRBACRoleDefinition) GrantsAction(action string) bool {
+	for _, permission := range s.Permissions {
+		if rbacActionAllowed(permission.Actions, permission.NotActions, action) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GrantsDataAction reports whether any permission block of the role definition allows the given data plane action
+func (s AzureRBACRoleDefinition) GrantsDataAction(action string) bool {
+	for _, permission := range s.Permissions {
+		if rbacActionAllowed(permission.DataActions, permission.NotDataActions, action) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GrantsAllActions reports whether a permission block of the role definition allows every control plane action
+// through an unrestricted wildcard. A wildcard whose NotActions exclude any of the resource specific abuse actions
+// does not grant full control; its remaining actions are evaluated one by one through GrantsAction instead.
+func (s AzureRBACRoleDefinition) GrantsAllActions() bool {
+	for _, permission := range s.Permissions {
+		if slices.Contains(permission.Actions, RBACActionAll) && !rbacDeniesAbuseAction(permission.NotActions) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func rbacDeniesAbuseAction(denied []string) bool {
+	for _, abuseAction := range rbacAbuseActions {
+		if abuseAction.dataAction {
+			continue
+		}
+
+		for _, pattern := range denied {
+			if RBACActionMatches(pattern, abuseAction.action) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// rbacScopeIncludes reports whether a role assignment made at the given scope applies to the Azure resource with the
+// given ID, either because it was made on the resource itself or on one of its ancestors
+func rbacScopeIncludes(scope, resourceID string) bool {
+	var (
+		normalizedScope    = strings.TrimSuffix(strings.ToLower(scope), "/")
+		normalizedResource = strings.TrimSuffix(strings.ToLower(resourceID), "/")
+	)
+
+	return normalizedScope != "" && (normalizedResource == normalizedScope || strings.HasPrefix(normalizedResource, normalizedScope+"/"))
+}
+
+// RBACAbuseEdgeKinds returns the abuse edges the role definition grants over the Azure resource with the given ID.
+// Roles that allow every action are reported as AZOwner or AZContributor depending on whether they may also assign
+// roles, the same way the built in Owner and Contributor roles are modeled.
+func RBACAbuseEdgeKinds(roleDefinition AzureRBACRoleDefinition, resourceID string) []graph.Kind {
+	return rbacAbuseEdgeKinds(roleDefinition, resourceID, false)
+}
+
+// rbacAbuseEdgeKinds returns the abuse edges of the role definition over the resource. Assignments inherited from an
+// ancestor scope only produce the resource specific edges: the AZOwner, AZContributor and AZUserAccessAdministrator
+// edges of the ancestor already reach the resource through containment.
+func rbacAbuseEdgeKinds(roleDefinition AzureRBACRoleDefinition, resourceID string, inherited bool) []graph.Kind {
+	var (
+		relTypes               = make([]graph.Kind, 0)
+		resourceType           = rbacResourceType(resourceID)
+		grantsAllActions       = roleDefinition.GrantsAllActions()
+		canWriteRoleAssignment = roleDefinition.GrantsAction(RBACActionRoleAssignmentsWrite)
+	)
+
+	switch {
+	case inherited:
+	case grantsAllActions && canWriteRoleAssignment:
+		relTypes = append(relTypes, azure.Owner)
+	case grantsAllActions:
+		relTypes = append(relTypes, azure.Contributor)
+	case canWriteRoleAssignment:
+		relTypes = append(relTypes, azure.UserAccessAdministrator)
+	}
+
+	for _, abuseAction := range rbacAbuseActions {
+		if abuseAction.resourceType != resourceType {
+			continue
+		}
+
+		// Owners and contributors already control the resource so only data plane access adds to their edge
+		if grantsAllActions && !abuseAction.dataAction {
+			continue
+		}
+
+		if abuseAction.dataAction && roleDefinition.GrantsDataAction(abuseAction.action) {
+			relTypes = append(relTypes, abuseAction.relType)
+		} else if !abuseAction.dataAction && roleDefinition.GrantsAction(abuseAction.action) {
+			relTypes = append(relTypes, abuseAction.relType)
+		}
+	}
+
+	return relTypes
+}
+
+// ConvertAzureRBACRoleAssignmentsToRels derives abuse edges from the permissions of the assigned role definitions
+// rather than from well known role names, so that custom roles are taken into account. Assignments made on an
+// ancestor of the resource, such as its resource group or subscription, are included.
+func ConvertAzureRBACRoleAssignmentsToRels(data AzureRBACRoleAssignments) []IngestibleRelationship {
+	var (
+		relationships = make([]IngestibleRelationship, 0)
+		targetKind    = RBACTargetKind(data.ObjectId)
+	)
+
+	for _, roleAssignment := range data.RoleAssignments {
+		if !rbacScopeIncludes(roleAssignment.Scope, data.ObjectId) {
+			continue
+		}
+
+		inherited := !strings.EqualFold(strings.TrimSuffix(roleAssignment.Scope, "/"), strings.TrimSuffix(data.ObjectId, "/"))
+
+		for _, relType := range rbacAbuseEdgeKinds(roleAssignment.RoleDefinition, data.ObjectId, inherited) {
+			relationships = append(relationships, NewIngestibleRelationship(
+				IngestibleEndpoint{
+					Value: roleAssignment.PrincipalId,
+					Kind:  azure.Entity,
+				},
+				IngestibleEndpoint{
+					Kind:  targetKind,
+					Value: data.ObjectId,
+				},
+				IngestibleRel{
+					RelProps: map[string]any{},
+					RelType:  relType,
+				},
+			))
+		}
+	}
+
+	return relationships
+}
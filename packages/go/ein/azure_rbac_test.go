@@ -0,0 +1,186 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ein_test
+
+import (
+	"testing"
+
+	"github.com/specterops/bloodhound/packages/go/ein"
+	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
+	"github.com/specterops/dawgs/graph"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSubscriptionID = "/subscriptions/0f5a1e7c-6a8b-4c1d-9e2f-3a4b5c6d7e8f"
+	testResourceGroup  = testSubscriptionID + "/resourceGroups/rg-prod"
+	testVirtualMachine = testResourceGroup + "/providers/Microsoft.Compute/virtualMachines/vm-01"
+	testKeyVault       = testResourceGroup + "/providers/Microsoft.KeyVault/vaults/kv-01"
+)
+
+func TestRBACActionMatches(t *testing.T) {
+	t.Parallel()
+
+	type testData struct {
+		name     string
+		pattern  string
+		action   string
+		expected bool
+	}
+
+	tt := []testData{
+		{name: "Success: Exact match", pattern: ein.RBACActionVMRunCommand, action: ein.RBACActionVMRunCommand, expected: true},
+		{name: "Success: Case insensitive", pattern: "microsoft.compute/virtualmachines/runcommand/action", action: ein.RBACActionVMRunCommand, expected: true},
+		{name: "Success: Global wildcard", pattern: "*", action: ein.RBACActionRoleAssignmentsWrite, expected: true},
+		{name: "Success: Provider wildcard", pattern: "Microsoft.Compute/*", action: ein.RBACActionVMRunCommand, expected: true},
+		{name: "Success: Inner wildcard", pattern: "Microsoft.Authorization/*/Write", action: ein.RBACActionRoleAssignmentsWrite, expected: true},
+		{name: "Error: Different suffix", pattern: "Microsoft.Compute/*/read", action: ein.RBACActionVMRunCommand, expected: false},
+		{name: "Error: Prefix only", pattern: "Microsoft.Compute/virtualMachines/runCommand", action: ein.RBACActionVMRunCommand, expected: false},
+	}
+
+	for _, testCase := range tt {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, testCase.expected, ein.RBACActionMatches(testCase.pattern, testCase.action))
+		})
+	}
+}
+
+func TestRBACTargetKind(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, azure.ManagementGroup, ein.RBACTargetKind("/providers/Microsoft.Management/managementGroups/mg-root"))
+	assert.Equal(t, azure.Subscription, ein.RBACTargetKind(testSubscriptionID))
+	assert.Equal(t, azure.ResourceGroup, ein.RBACTargetKind(testResourceGroup))
+	assert.Equal(t, azure.VM, ein.RBACTargetKind(testVirtualMachine))
+	assert.Equal(t, azure.KeyVault, ein.RBACTargetKind(testKeyVault))
+	assert.Equal(t, azure.Entity, ein.RBACTargetKind(testResourceGroup+"/providers/Microsoft.Web/sites/app-01"))
+}
+
+func TestRBACAbuseEdgeKinds(t *testing.T) {
+	t.Parallel()
+
+	type testData struct {
+		name       string
+		resourceID string
+		permission ein.AzureRBACPermission
+		expected   []graph.Kind
+	}
+
+	tt := []testData{
+		{
+			name:       "Success: Wildcard with role assignment write is owner",
+			resourceID: testVirtualMachine,
+			permission: ein.AzureRBACPermission{Actions: []string{"*"}},
+			expected:   []graph.Kind{azure.Owner},
+		},
+		{
+			name:       "Success: Wildcard excluding authorization writes is contributor",
+			resourceID: testKeyVault,
+			permission: ein.AzureRBACPermission{
+				Actions:     []string{"*"},
+				NotActions:  []string{"Microsoft.Authorization/*/Write"},
+				DataActions: []string{"Microsoft.KeyVault/vaults/secrets/*"},
+			},
+			expected: []graph.Kind{azure.Contributor, azure.GetSecrets},
+		},
+		{
+			name:       "Success: Wildcard excluding an abuse action is not contributor",
+			resourceID: testVirtualMachine,
+			permission: ein.AzureRBACPermission{
+				Actions:    []string{"*"},
+				NotActions: []string{"Microsoft.Authorization/*/Write", "Microsoft.Compute/virtualMachines/runCommand/*"},
+			},
+			expected: []graph.Kind{},
+		},
+		{
+			name:       "Success: Custom role with run command",
+			resourceID: testVirtualMachine,
+			permission: ein.AzureRBACPermission{Actions: []string{"Microsoft.Compute/virtualMachines/*/action"}},
+			expected:   []graph.Kind{azure.VMContributor},
+		},
+		{
+			name:       "Success: Custom role with role assignment write",
+			resourceID: testResourceGroup,
+			permission: ein.AzureRBACPermission{Actions: []string{ein.RBACActionRoleAssignmentsWrite}},
+			expected:   []graph.Kind{azure.UserAccessAdministrator},
+		},
+		{
+			name:       "Success: Excluded action is not granted",
+			resourceID: testVirtualMachine,
+			permission: ein.AzureRBACPermission{
+				Actions:    []string{"Microsoft.Compute/*"},
+				NotActions: []string{ein.RBACActionVMRunCommand},
+			},
+			expected: []graph.Kind{},
+		},
+		{
+			name:       "Success: Resource specific actions do not apply to other resource types",
+			resourceID: testKeyVault,
+			permission: ein.AzureRBACPermission{Actions: []string{ein.RBACActionVMRunCommand}},
+			expected:   []graph.Kind{},
+		},
+	}
+
+	for _, testCase := range tt {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			roleDefinition := ein.AzureRBACRoleDefinition{RoleName: "Custom", Permissions: []ein.AzureRBACPermission{testCase.permission}}
+			assert.Equal(t, testCase.expected, ein.RBACAbuseEdgeKinds(roleDefinition, testCase.resourceID))
+		})
+	}
+}
+
+func TestConvertAzureRBACRoleAssignmentsToRels(t *testing.T) {
+	t.Parallel()
+
+	var (
+		principalID          = "03e9a7b2-9508-4e24-8248-16672f5f1377"
+		inheritedPrincipalID = "5b1c7f2e-3d4a-4b8c-9e6f-0a1b2c3d4e5f"
+		ownerDefinition      = ein.AzureRBACRoleDefinition{RoleName: "Owner", Permissions: []ein.AzureRBACPermission{{Actions: []string{"*"}}}}
+		roleDefinition       = ein.AzureRBACRoleDefinition{
+			RoleName:    "VM Operator",
+			RoleType:    "CustomRole",
+			Permissions: []ein.AzureRBACPermission{{Actions: []string{ein.RBACActionVMRunCommand}}},
+		}
+	)
+
+	rels := ein.ConvertAzureRBACRoleAssignmentsToRels(ein.AzureRBACRoleAssignments{
+		ObjectId: testVirtualMachine,
+		RoleAssignments: []ein.AzureRBACRoleAssignment{
+			{PrincipalId: principalID, Scope: testVirtualMachine, RoleDefinition: roleDefinition},
+			// Assignments inherited from the resource group grant the same resource specific edge
+			{PrincipalId: inheritedPrincipalID, Scope: testResourceGroup, RoleDefinition: roleDefinition},
+			// Owners of the subscription already reach the resource through containment
+			{PrincipalId: inheritedPrincipalID, Scope: testSubscriptionID, RoleDefinition: ownerDefinition},
+			// Assignments on unrelated scopes do not apply to the resource
+			{PrincipalId: principalID, Scope: testKeyVault, RoleDefinition: roleDefinition},
+		},
+	})
+
+	require.Len(t, rels, 2)
+	assert.Equal(t, principalID, rels[0].Source.Value)
+	assert.Equal(t, azure.Entity, rels[0].Source.Kind)
+	assert.Equal(t, testVirtualMachine, rels[0].Target.Value)
+	assert.Equal(t, azure.VM, rels[0].Target.Kind)
+	assert.Equal(t, azure.VMContributor, rels[0].RelType)
+	assert.Equal(t, inheritedPrincipalID, rels[1].Source.Value)
+	assert.Equal(t, testVirtualMachine, rels[1].Target.Value)
+	assert.Equal(t, azure.VMContributor, rels[1].RelType)
+}
@@ -17,15 +17,19 @@
 package ein_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/bloodhoundad/azurehound/v2/enums"
 	"github.com/bloodhoundad/azurehound/v2/models"
 	azure2 "github.com/bloodhoundad/azurehound/v2/models/azure"
 	"github.com/specterops/bloodhound/packages/go/ein"
 	"github.com/specterops/bloodhound/packages/go/graphschema/azure"
 	"github.com/specterops/bloodhound/packages/go/graphschema/common"
+	"github.com/specterops/dawgs/graph"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -524,3 +528,102 @@ func TestConvertAzureVirtualMachineAvereContributorToRels(t *testing.T) {
 		require.Len(t, rels, 0)
 	})
 }
+
+func TestConvertAzureRoleAssignmentToRels_AdministrativeUnitScope(t *testing.T) {
+	var (
+		tenantID     = "6c12b0b0-b2cc-4a73-8252-0b94bfca2145"
+		principalID  = "03e9a7b2-9508-4e24-8248-16672f5f1377"
+		roleObjectID = strings.ToUpper(fmt.Sprintf("%s@%s", azure.HelpdeskAdministratorRole, tenantID))
+		data         = models.RoleAssignments{TenantId: tenantID}
+	)
+
+	t.Run("tenant scoped assignment creates AZHasRole", func(t *testing.T) {
+		rels := ein.ConvertAzureRoleAssignmentToRels(azure2.UnifiedRoleAssignment{
+			PrincipalId:      principalID,
+			RoleDefinitionId: azure.HelpdeskAdministratorRole,
+			DirectoryScopeId: "/",
+		}, data, roleObjectID)
+
+		require.Len(t, rels, 1)
+		assert.Equal(t, azure.HasRole, rels[0].RelType)
+		assert.Equal(t, strings.ToUpper(tenantID), rels[0].RelProps[azure.Scope.String()])
+	})
+
+	t.Run("administrative unit scoped assignment creates AZHasScopedRole", func(t *testing.T) {
+		rels := ein.ConvertAzureRoleAssignmentToRels(azure2.UnifiedRoleAssignment{
+			PrincipalId:      principalID,
+			RoleDefinitionId: azure.HelpdeskAdministratorRole,
+			DirectoryScopeId: "/administrativeUnits/a0c3e4b1-5d6f-4e8a-9b7c-1d2e3f4a5b6c",
+		}, data, roleObjectID)
+
+		require.Len(t, rels, 1)
+		assert.Equal(t, azure.AZHasScopedRole, rels[0].RelType)
+		assert.Equal(t, principalID, rels[0].Source.Value)
+		assert.Equal(t, roleObjectID, rels[0].Target.Value)
+		assert.Equal(t, azure.Role, rels[0].Target.Kind)
+		assert.Equal(t, "A0C3E4B1-5D6F-4E8A-9B7C-1D2E3F4A5B6C", rels[0].RelProps[azure.Scope.String()])
+	})
+}
+
+func TestConvertAzureAdministrativeUnit(t *testing.T) {
+	var (
+		ingestTime = time.Now()
+		data       = ein.AzureAdministrativeUnit{
+			Id:                           "a0c3e4b1-5d6f-4e8a-9b7c-1d2e3f4a5b6c",
+			DisplayName:                  "Helpdesk Europe",
+			Description:                  "Users managed by the European helpdesk",
+			IsMemberManagementRestricted: true,
+			TenantId:                     "6c12b0b0-b2cc-4a73-8252-0b94bfca2145",
+			TenantName:                   "SPECTERDEV",
+		}
+	)
+
+	node, rel := ein.ConvertAzureAdministrativeUnit(data, ingestTime)
+
+	require.True(t, node.IsValid())
+	require.True(t, rel.IsValid())
+
+	assert.Equal(t, data.Id, node.ObjectID)
+	assert.Equal(t, []graph.Kind{azure.AdministrativeUnit}, node.Labels)
+	assert.Equal(t, "Helpdesk Europe@SPECTERDEV", node.PropertyMap[common.Name.String()])
+	assert.Equal(t, true, node.PropertyMap[azure.IsMemberManagementRestricted.String()])
+	assert.Equal(t, strings.ToUpper(data.TenantId), node.PropertyMap[azure.TenantID.String()])
+
+	assert.Equal(t, data.TenantId, rel.Source.Value)
+	assert.Equal(t, azure.Tenant, rel.Source.Kind)
+	assert.Equal(t, data.Id, rel.Target.Value)
+	assert.Equal(t, azure.AdministrativeUnit, rel.Target.Kind)
+	assert.Equal(t, azure.Contains, rel.RelType)
+}
+
+func TestConvertAzureAdministrativeUnitMembersToRels(t *testing.T) {
+	var (
+		administrativeUnitID = "a0c3e4b1-5d6f-4e8a-9b7c-1d2e3f4a5b6c"
+		members              []ein.AzureAdministrativeUnitMember
+	)
+
+	for _, member := range []azure2.DirectoryObject{
+		{Id: "03e9a7b2-9508-4e24-8248-16672f5f1377", Type: enums.EntityUser},
+		{Id: "b7a4c1d2-3e4f-5a6b-7c8d-9e0f1a2b3c4d", Type: enums.EntityGroup},
+		{Id: "c1d2e3f4-a5b6-c7d8-e9f0-a1b2c3d4e5f6", Type: "#microsoft.graph.unknown"},
+	} {
+		raw, err := json.Marshal(member)
+		require.NoError(t, err)
+
+		members = append(members, ein.AzureAdministrativeUnitMember{Member: raw, AdministrativeUnitId: administrativeUnitID})
+	}
+
+	rels := ein.ConvertAzureAdministrativeUnitMembersToRels(ein.AzureAdministrativeUnitMembers{
+		Members:              members,
+		AdministrativeUnitId: administrativeUnitID,
+	})
+
+	require.Len(t, rels, 2)
+	for _, rel := range rels {
+		assert.Equal(t, administrativeUnitID, rel.Source.Value)
+		assert.Equal(t, azure.AdministrativeUnit, rel.Source.Kind)
+		assert.Equal(t, azure.Contains, rel.RelType)
+	}
+	assert.Equal(t, azure.User, rels[0].Target.Kind)
+	assert.Equal(t, azure.Group, rels[1].Target.Kind)
+}
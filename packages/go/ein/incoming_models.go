@@ -17,6 +17,8 @@
 package ein
 
 import (
+	"encoding/json"
+
 	"github.com/specterops/bloodhound/packages/go/graphschema/ad"
 	"github.com/specterops/dawgs/graph"
 )
@@ -164,7 +166,38 @@ type VulnerableNetlogonSecurityDescriptor struct {
 	Value string
 }
 
-type GPO IngestBase
+// GPOUserRight is a user rights assignment configured by a GPO
+type GPOUserRight struct {
+	Privilege  string
+	Principals []TypedPrincipal
+}
+
+// GPOSettings are the restricted group and user rights settings configured by a single GPO. Unlike GPOChanges, which
+// the collector resolves for each OU and domain, these are not merged with the settings of any other GPO.
+type GPOSettings struct {
+	LocalAdmins        []TypedPrincipal
+	RemoteDesktopUsers []TypedPrincipal
+	DcomUsers          []TypedPrincipal
+	PSRemoteUsers      []TypedPrincipal
+	UserRights         []GPOUserRight
+}
+
+// GPOUserSettings are the settings of a GPO's user configuration. LogonUserGroups lists the SIDs of the builtin local
+// groups that Local Users and Groups preferences add the logged on user to on the computers they log on to.
+type GPOUserSettings struct {
+	LogonUserGroups []string
+}
+
+// GPO is a group policy object. WMIFilter is the WQL query of the WMI filter linked to the GPO, if any, and
+// SecurityFiltering lists the principals granted the Apply Group Policy right. An empty SecurityFiltering means the
+// collector did not report the GPO's security filtering.
+type GPO struct {
+	IngestBase
+	Settings          GPOSettings
+	UserSettings      GPOUserSettings
+	WMIFilter         string
+	SecurityFiltering []TypedPrincipal
+}
 
 type AIACA IngestBase
 
@@ -173,6 +206,27 @@ type IssuancePolicy struct {
 	GroupLink TypedPrincipal
 }
 
+type Site struct {
+	IngestBase
+	Links []GPLink
+}
+
+// Subnet is an AD subnet object. Site is the object identifier of the site the subnet is mapped to and Computers
+// lists the computers whose addresses the collector resolved to fall within the subnet.
+type Subnet struct {
+	IngestBase
+	Site      string
+	Computers []TypedPrincipal
+}
+
+// DNSZone is an AD-integrated DNS zone. ChildObjects lists the DNS records (dnsNode objects) stored in the zone.
+type DNSZone struct {
+	IngestBase
+	ChildObjects []TypedPrincipal
+}
+
+type DNSNode IngestBase
+
 type RootCA struct {
 	IngestBase
 	DomainSID string
@@ -392,3 +446,89 @@ type EdgeEndpoint struct {
 	MatchBy          string            `json:"match_by"`
 	PropertyMatchers []PropertyMatcher `json:"property_matchers"`
 }
+
+// AzureAdministrativeUnit is an Entra ID administrative unit. AzureHound does not model administrative units so the
+// payload shape is owned by BloodHound.
+type AzureAdministrativeUnit struct {
+	Id                           string `json:"id"`
+	DisplayName                  string `json:"displayName"`
+	Description                  string `json:"description"`
+	IsMemberManagementRestricted bool   `json:"isMemberManagementRestricted"`
+	TenantId                     string `json:"tenantId"`
+	TenantName                   string `json:"tenantName"`
+}
+
+type AzureAdministrativeUnitMember struct {
+	Member               json.RawMessage `json:"member"`
+	AdministrativeUnitId string          `json:"administrativeUnitId"`
+}
+
+type AzureAdministrativeUnitMembers struct {
+	Members              []AzureAdministrativeUnitMember `json:"members"`
+	AdministrativeUnitId string                          `json:"administrativeUnitId"`
+}
+
+// AzureConditionalAccessPolicy is an Entra ID Conditional Access policy. Only the assignment conditions and grant
+// controls that BloodHound reasons about are modelled; the field names follow the Microsoft Graph resource.
+type AzureConditionalAccessPolicy struct {
+	Id            string                              `json:"id"`
+	DisplayName   string                              `json:"displayName"`
+	State         string                              `json:"state"`
+	Conditions    AzureConditionalAccessConditions    `json:"conditions"`
+	GrantControls AzureConditionalAccessGrantControls `json:"grantControls"`
+	TenantId      string                              `json:"tenantId"`
+	TenantName    string                              `json:"tenantName"`
+}
+
+type AzureConditionalAccessConditions struct {
+	Users        AzureConditionalAccessUsers        `json:"users"`
+	Applications AzureConditionalAccessApplications `json:"applications"`
+}
+
+type AzureConditionalAccessUsers struct {
+	IncludeUsers  []string `json:"includeUsers"`
+	ExcludeUsers  []string `json:"excludeUsers"`
+	IncludeGroups []string `json:"includeGroups"`
+	ExcludeGroups []string `json:"excludeGroups"`
+	IncludeRoles  []string `json:"includeRoles"`
+	ExcludeRoles  []string `json:"excludeRoles"`
+}
+
+type AzureConditionalAccessApplications struct {
+	IncludeApplications []string `json:"includeApplications"`
+	ExcludeApplications []string `json:"excludeApplications"`
+}
+
+type AzureConditionalAccessGrantControls struct {
+	Operator        string   `json:"operator"`
+	BuiltInControls []string `json:"builtInControls"`
+}
+
+// AzureRBACPermission is a single permission block of an Azure RBAC role definition
+type AzureRBACPermission struct {
+	Actions        []string `json:"actions"`
+	NotActions     []string `json:"notActions"`
+	DataActions    []string `json:"dataActions"`
+	NotDataActions []string `json:"notDataActions"`
+}
+
+// AzureRBACRoleDefinition is an Azure RBAC role definition, either built in or custom
+type AzureRBACRoleDefinition struct {
+	Id          string                `json:"id"`
+	RoleName    string                `json:"roleName"`
+	RoleType    string                `json:"roleType"`
+	Permissions []AzureRBACPermission `json:"permissions"`
+}
+
+type AzureRBACRoleAssignment struct {
+	PrincipalId    string                  `json:"principalId"`
+	Scope          string                  `json:"scope"`
+	RoleDefinition AzureRBACRoleDefinition `json:"roleDefinition"`
+}
+
+// AzureRBACRoleAssignments holds the RBAC role assignments of a single Azure resource along with the definitions of
+// the assigned roles
+type AzureRBACRoleAssignments struct {
+	ObjectId        string                    `json:"objectId"`
+	RoleAssignments []AzureRBACRoleAssignment `json:"roleAssignments"`
+}
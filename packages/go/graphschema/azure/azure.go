@@ -46,6 +46,8 @@ var (
 	LogicApp                             = graph.StringKind("AZLogicApp")
 	AutomationAccount                    = graph.StringKind("AZAutomationAccount")
 	FederatedIdentityCredential          = graph.StringKind("AZFederatedIdentityCredential")
+	AdministrativeUnit                   = graph.StringKind("AZAdministrativeUnit")
+	ConditionalAccessPolicy              = graph.StringKind("AZConditionalAccessPolicy")
 	AvereContributor                     = graph.StringKind("AZAvereContributor")
 	Contains                             = graph.StringKind("AZContains")
 	Contributor                          = graph.StringKind("AZContributor")
@@ -96,6 +98,9 @@ var (
 	AZRoleEligible                       = graph.StringKind("AZRoleEligible")
 	AZRoleApprover                       = graph.StringKind("AZRoleApprover")
 	AZAuthenticatesTo                    = graph.StringKind("AZAuthenticatesTo")
+	AZHasScopedRole                      = graph.StringKind("AZHasScopedRole")
+	AZConditionalAccessIncludes          = graph.StringKind("AZConditionalAccessIncludes")
+	AZConditionalAccessExcludes          = graph.StringKind("AZConditionalAccessExcludes")
 )
 
 type Property string
@@ -145,10 +150,16 @@ const (
 	Subject                                           Property = "subject"
 	Audiences                                         Property = "audiences"
 	FederatedIdentityCredentialAppID                  Property = "federatedidentitycredentialappid"
+	IsMemberManagementRestricted                      Property = "ismembermanagementrestricted"
+	ConditionalAccessPolicyState                      Property = "capolicystate"
+	GrantControls                                     Property = "grantcontrols"
+	Enforcing                                         Property = "enforcing"
+	IncludesAllUsers                                  Property = "includesallusers"
+	IncludesAllApplications                           Property = "includesallapplications"
 )
 
 func AllProperties() []Property {
-	return []Property{AppOwnerOrganizationID, AppDescription, AppDisplayName, ServicePrincipalType, UserType, TenantID, ServicePrincipalID, OperatingSystemVersion, TrustType, IsBuiltIn, AppID, AppRoleID, DeviceID, NodeResourceGroupID, OnPremID, OnPremSyncEnabled, SecurityEnabled, SecurityIdentifier, EnableRBACAuthorization, Scope, Offer, MFAEnabled, License, Licenses, LoginURL, MFAEnforced, UserPrincipalName, IsAssignableToRole, PublisherDomain, SignInAudience, RoleTemplateID, RoleDefinitionId, EndUserAssignmentRequiresApproval, EndUserAssignmentRequiresCAPAuthenticationContext, EndUserAssignmentUserApprovers, EndUserAssignmentGroupApprovers, EndUserAssignmentRequiresMFA, EndUserAssignmentRequiresJustification, EndUserAssignmentRequiresTicketInformation, LastSuccessfulSignInDateTime, Issuer, Subject, Audiences, FederatedIdentityCredentialAppID}
+	return []Property{AppOwnerOrganizationID, AppDescription, AppDisplayName, ServicePrincipalType, UserType, TenantID, ServicePrincipalID, OperatingSystemVersion, TrustType, IsBuiltIn, AppID, AppRoleID, DeviceID, NodeResourceGroupID, OnPremID, OnPremSyncEnabled, SecurityEnabled, SecurityIdentifier, EnableRBACAuthorization, Scope, Offer, MFAEnabled, License, Licenses, LoginURL, MFAEnforced, UserPrincipalName, IsAssignableToRole, PublisherDomain, SignInAudience, RoleTemplateID, RoleDefinitionId, EndUserAssignmentRequiresApproval, EndUserAssignmentRequiresCAPAuthenticationContext, EndUserAssignmentUserApprovers, EndUserAssignmentGroupApprovers, EndUserAssignmentRequiresMFA, EndUserAssignmentRequiresJustification, EndUserAssignmentRequiresTicketInformation, LastSuccessfulSignInDateTime, Issuer, Subject, Audiences, FederatedIdentityCredentialAppID, IsMemberManagementRestricted, ConditionalAccessPolicyState, GrantControls, Enforcing, IncludesAllUsers, IncludesAllApplications}
 }
 func ParseProperty(source string) (Property, error) {
 	switch source {
@@ -240,6 +251,18 @@ func ParseProperty(source string) (Property, error) {
 		return Audiences, nil
 	case "federatedidentitycredentialappid":
 		return FederatedIdentityCredentialAppID, nil
+	case "ismembermanagementrestricted":
+		return IsMemberManagementRestricted, nil
+	case "capolicystate":
+		return ConditionalAccessPolicyState, nil
+	case "grantcontrols":
+		return GrantControls, nil
+	case "enforcing":
+		return Enforcing, nil
+	case "includesallusers":
+		return IncludesAllUsers, nil
+	case "includesallapplications":
+		return IncludesAllApplications, nil
 	default:
 		return "", errors.New("Invalid enumeration value: " + source)
 	}
@@ -334,6 +357,18 @@ func (s Property) String() string {
 		return string(Audiences)
 	case FederatedIdentityCredentialAppID:
 		return string(FederatedIdentityCredentialAppID)
+	case IsMemberManagementRestricted:
+		return string(IsMemberManagementRestricted)
+	case ConditionalAccessPolicyState:
+		return string(ConditionalAccessPolicyState)
+	case GrantControls:
+		return string(GrantControls)
+	case Enforcing:
+		return string(Enforcing)
+	case IncludesAllUsers:
+		return string(IncludesAllUsers)
+	case IncludesAllApplications:
+		return string(IncludesAllApplications)
 	default:
 		return "Invalid enumeration case: " + string(s)
 	}
@@ -428,6 +463,18 @@ func (s Property) Name() string {
 		return "Audiences"
 	case FederatedIdentityCredentialAppID:
 		return "Federated Identity Credential Application ID"
+	case IsMemberManagementRestricted:
+		return "Is Member Management Restricted"
+	case ConditionalAccessPolicyState:
+		return "Conditional Access Policy State"
+	case GrantControls:
+		return "Grant Controls"
+	case Enforcing:
+		return "Enforcing"
+	case IncludesAllUsers:
+		return "Includes All Users"
+	case IncludesAllApplications:
+		return "Includes All Applications"
 	default:
 		return "Invalid enumeration case: " + string(s)
 	}
@@ -441,7 +488,7 @@ func (s Property) Is(others ...graph.Kind) bool {
 	return false
 }
 func Relationships() []graph.Kind {
-	return []graph.Kind{AvereContributor, Contains, Contributor, GetCertificates, GetKeys, GetSecrets, HasRole, MemberOf, Owner, RunsAs, VMContributor, AutomationContributor, KeyVaultContributor, VMAdminLogin, AddMembers, AddSecret, ExecuteCommand, GlobalAdmin, PrivilegedAuthAdmin, Grant, GrantSelf, PrivilegedRoleAdmin, ResetPassword, UserAccessAdministrator, Owns, ScopedTo, CloudAppAdmin, AppAdmin, AddOwner, ManagedIdentity, ApplicationReadWriteAll, AppRoleAssignmentReadWriteAll, DirectoryReadWriteAll, GroupReadWriteAll, GroupMemberReadWriteAll, RoleManagementReadWriteDirectory, ServicePrincipalEndpointReadWriteAll, AKSContributor, NodeResourceGroup, WebsiteContributor, LogicAppContributor, AZMGAddMember, AZMGAddOwner, AZMGAddSecret, AZMGGrantAppRoles, AZMGGrantRole, SyncedToEntraUser, AZRoleEligible, AZRoleApprover, AZAuthenticatesTo}
+	return []graph.Kind{AvereContributor, Contains, Contributor, GetCertificates, GetKeys, GetSecrets, HasRole, MemberOf, Owner, RunsAs, VMContributor, AutomationContributor, KeyVaultContributor, VMAdminLogin, AddMembers, AddSecret, ExecuteCommand, GlobalAdmin, PrivilegedAuthAdmin, Grant, GrantSelf, PrivilegedRoleAdmin, ResetPassword, UserAccessAdministrator, Owns, ScopedTo, CloudAppAdmin, AppAdmin, AddOwner, ManagedIdentity, ApplicationReadWriteAll, AppRoleAssignmentReadWriteAll, DirectoryReadWriteAll, GroupReadWriteAll, GroupMemberReadWriteAll, RoleManagementReadWriteDirectory, ServicePrincipalEndpointReadWriteAll, AKSContributor, NodeResourceGroup, WebsiteContributor, LogicAppContributor, AZMGAddMember, AZMGAddOwner, AZMGAddSecret, AZMGGrantAppRoles, AZMGGrantRole, SyncedToEntraUser, AZRoleEligible, AZRoleApprover, AZAuthenticatesTo, AZHasScopedRole, AZConditionalAccessIncludes, AZConditionalAccessExcludes}
 }
 func AppRoleTransitRelationshipKinds() []graph.Kind {
 	return []graph.Kind{AZMGAddMember, AZMGAddOwner, AZMGAddSecret, AZMGGrantAppRoles, AZMGGrantRole}
@@ -462,5 +509,5 @@ func PostProcessedRelationships() []graph.Kind {
 	return []graph.Kind{ExecuteCommand, SyncedToEntraUser, AZRoleApprover}
 }
 func NodeKinds() []graph.Kind {
-	return []graph.Kind{Entity, VMScaleSet, App, Role, Device, FunctionApp, Group, KeyVault, ManagementGroup, ResourceGroup, ServicePrincipal, Subscription, Tenant, User, VM, ManagedCluster, ContainerRegistry, WebApp, LogicApp, AutomationAccount, FederatedIdentityCredential}
+	return []graph.Kind{Entity, VMScaleSet, App, Role, Device, FunctionApp, Group, KeyVault, ManagementGroup, ResourceGroup, ServicePrincipal, Subscription, Tenant, User, VM, ManagedCluster, ContainerRegistry, WebApp, LogicApp, AutomationAccount, FederatedIdentityCredential, AdministrativeUnit, ConditionalAccessPolicy}
 }
@@ -49,30 +49,36 @@ func OutboundRelationshipKinds() []graph.Kind {
 type Property string
 
 const (
-	ObjectID        Property = "objectid"
-	Name            Property = "name"
-	DisplayName     Property = "displayname"
-	Description     Property = "description"
-	OwnerObjectID   Property = "owner_objectid"
-	Collected       Property = "collected"
-	OperatingSystem Property = "operatingsystem"
-	SystemTags      Property = "system_tags"
-	UserTags        Property = "user_tags"
-	LastSeen        Property = "lastseen"
-	FirstSeen       Property = "firstseen"
-	LastCollected   Property = "lastcollected"
-	WhenCreated     Property = "whencreated"
-	Enabled         Property = "enabled"
-	PasswordLastSet Property = "pwdlastset"
-	Title           Property = "title"
-	Email           Property = "email"
-	IsInherited     Property = "isinherited"
-	CompositionID   Property = "compositionid"
-	PrimaryKind     Property = "primarykind"
+	ObjectID          Property = "objectid"
+	Name              Property = "name"
+	DisplayName       Property = "displayname"
+	Description       Property = "description"
+	OwnerObjectID     Property = "owner_objectid"
+	Collected         Property = "collected"
+	OperatingSystem   Property = "operatingsystem"
+	SystemTags        Property = "system_tags"
+	UserTags          Property = "user_tags"
+	LastSeen          Property = "lastseen"
+	FirstSeen         Property = "firstseen"
+	LastCollected     Property = "lastcollected"
+	WhenCreated       Property = "whencreated"
+	Enabled           Property = "enabled"
+	PasswordLastSet   Property = "pwdlastset"
+	Title             Property = "title"
+	Email             Property = "email"
+	IsInherited       Property = "isinherited"
+	CompositionID     Property = "compositionid"
+	CompositionRule   Property = "compositionrule"
+	CompositionNodes  Property = "compositionnodes"
+	CompositionEdges  Property = "compositionedges"
+	TierZeroImpact    Property = "tierzeroimpact"
+	PrincipalExposure Property = "principalexposure"
+	ExposureEstimated Property = "exposureestimated"
+	PrimaryKind       Property = "primarykind"
 )
 
 func AllProperties() []Property {
-	return []Property{ObjectID, Name, DisplayName, Description, OwnerObjectID, Collected, OperatingSystem, SystemTags, UserTags, LastSeen, FirstSeen, LastCollected, WhenCreated, Enabled, PasswordLastSet, Title, Email, IsInherited, CompositionID, PrimaryKind}
+	return []Property{ObjectID, Name, DisplayName, Description, OwnerObjectID, Collected, OperatingSystem, SystemTags, UserTags, LastSeen, FirstSeen, LastCollected, WhenCreated, Enabled, PasswordLastSet, Title, Email, IsInherited, CompositionID, CompositionRule, CompositionNodes, CompositionEdges, TierZeroImpact, PrincipalExposure, ExposureEstimated, PrimaryKind}
 }
 func ParseProperty(source string) (Property, error) {
 	switch source {
@@ -114,6 +120,18 @@ func ParseProperty(source string) (Property, error) {
 		return IsInherited, nil
 	case "compositionid":
 		return CompositionID, nil
+	case "compositionrule":
+		return CompositionRule, nil
+	case "compositionnodes":
+		return CompositionNodes, nil
+	case "compositionedges":
+		return CompositionEdges, nil
+	case "tierzeroimpact":
+		return TierZeroImpact, nil
+	case "principalexposure":
+		return PrincipalExposure, nil
+	case "exposureestimated":
+		return ExposureEstimated, nil
 	case "primarykind":
 		return PrimaryKind, nil
 	default:
@@ -160,6 +178,18 @@ func (s Property) String() string {
 		return string(IsInherited)
 	case CompositionID:
 		return string(CompositionID)
+	case CompositionRule:
+		return string(CompositionRule)
+	case CompositionNodes:
+		return string(CompositionNodes)
+	case CompositionEdges:
+		return string(CompositionEdges)
+	case TierZeroImpact:
+		return string(TierZeroImpact)
+	case PrincipalExposure:
+		return string(PrincipalExposure)
+	case ExposureEstimated:
+		return string(ExposureEstimated)
 	case PrimaryKind:
 		return string(PrimaryKind)
 	default:
@@ -206,6 +236,18 @@ func (s Property) Name() string {
 		return "Is Inherited"
 	case CompositionID:
 		return "Composition ID"
+	case CompositionRule:
+		return "Composition Rule"
+	case CompositionNodes:
+		return "Composition Nodes"
+	case CompositionEdges:
+		return "Composition Edges"
+	case TierZeroImpact:
+		return "Tier Zero Impact"
+	case PrincipalExposure:
+		return "Principal Exposure"
+	case ExposureEstimated:
+		return "Exposure Estimated"
 	case PrimaryKind:
 		return "Primary Kind"
 	default:
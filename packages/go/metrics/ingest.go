@@ -70,6 +70,12 @@ const (
 	// IngestFileFormatZip indicates a ZIP archive.
 	IngestFileFormatZip IngestFileFormat = "zip"
 
+	// IngestFileFormatNDJSON indicates a newline delimited JSON file.
+	IngestFileFormatNDJSON IngestFileFormat = "ndjson"
+
+	// IngestFileFormatCSV indicates a CSV file with a header mapping.
+	IngestFileFormatCSV IngestFileFormat = "csv"
+
 	// IngestFileFormatUnknown indicates an unknown or unsupported file format.
 	IngestFileFormatUnknown IngestFileFormat = "unknown"
 )
@@ -76,6 +76,22 @@ var nodeIcons = map[string]nodeIcon{
 		Icon:  "clipboard-check",
 		Color: "#99B2DD",
 	},
+	"Site": {
+		Icon:  "map-location-dot",
+		Color: "#7FB77E",
+	},
+	"Subnet": {
+		Icon:  "network-wired",
+		Color: "#A8C5E8",
+	},
+	"DNSZone": {
+		Icon:  "folder-tree",
+		Color: "#8FB3D9",
+	},
+	"DNSNode": {
+		Icon:  "file-lines",
+		Color: "#B7C9DE",
+	},
 	"OU": {
 		Icon:  "sitemap",
 		Color: "#FFAA00",
@@ -165,6 +181,14 @@ var nodeIcons = map[string]nodeIcon{
 		Icon:  "key",
 		Color: "#FFEE8C",
 	},
+	"AZAdministrativeUnit": {
+		Icon:  "users-rectangle",
+		Color: "#C8D8F0",
+	},
+	"AZConditionalAccessPolicy": {
+		Icon:  "shield-halved",
+		Color: "#9EC9C1",
+	},
 }
 
 func GenerateExtensionSQLActiveDirectory(dir string, adSchema model.ActiveDirectory) error {
@@ -0,0 +1,660 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"strings"
+)
+
+const (
+	// KeyRecordSuffix is appended to the name of an encrypted file to form the name of the key record holding its
+	// wrapped data key. Key records are hidden from listings and may not be written directly.
+	KeyRecordSuffix = ".envelope"
+
+	// MasterKeySize is the required size of a master key in bytes. Master keys are AES-256 keys.
+	MasterKeySize = 32
+
+	// MaxKeyIDLength is the maximum length of a master key id in bytes. Key ids are stored in the key record of every
+	// encrypted file.
+	MaxKeyIDLength = 128
+
+	envelopeVersion     = 1
+	envelopeMaxSize     = 4096
+	encryptedMagic      = "BHENC\x00\x00\x01"
+	fileIDSize          = 16
+	encryptedHeaderSize = len(encryptedMagic) + fileIDSize
+	encryptedChunkSize  = 64 * 1024
+	encryptedTagSize    = 16
+	encryptedSealedSize = encryptedChunkSize + encryptedTagSize
+)
+
+var (
+	ErrReservedName      = errors.New("name is reserved for encryption key records")
+	ErrUnknownMasterKey  = errors.New("unknown master key")
+	ErrDecryptionFailed  = errors.New("encrypted file failed authentication")
+	ErrUnsupportedFormat = errors.New("unsupported encryption envelope")
+)
+
+// KeyRing holds the master keys used to wrap the per-file data keys of an EncryptedStore. New data keys are always
+// wrapped with the active key. Retired keys remain in the key ring until every envelope has been rotated away from
+// them.
+type KeyRing struct {
+	activeKeyID string
+	keys        map[string]cipher.AEAD
+}
+
+// NewKeyRing creates a KeyRing from a set of named master keys. The active key must be one of the given keys.
+func NewKeyRing(activeKeyID string, keys map[string][]byte) (*KeyRing, error) {
+	keyRing := &KeyRing{
+		activeKeyID: activeKeyID,
+		keys:        make(map[string]cipher.AEAD, len(keys)),
+	}
+
+	for keyID, key := range keys {
+		if keyID == "" {
+			return nil, errors.New("master key id is required")
+		} else if len(keyID) > MaxKeyIDLength {
+			return nil, fmt.Errorf("master key id %q must be at most %d bytes", keyID, MaxKeyIDLength)
+		} else if len(key) != MasterKeySize {
+			return nil, fmt.Errorf("master key %q must be %d bytes", keyID, MasterKeySize)
+		} else if aead, err := newAEAD(key); err != nil {
+			return nil, fmt.Errorf("master key %q: %w", keyID, err)
+		} else {
+			keyRing.keys[keyID] = aead
+		}
+	}
+
+	if _, found := keyRing.keys[activeKeyID]; !found {
+		return nil, fmt.Errorf("%w: active key %q", ErrUnknownMasterKey, activeKeyID)
+	}
+
+	return keyRing, nil
+}
+
+// ActiveKeyID returns the id of the master key that new data keys are wrapped with.
+func (s *KeyRing) ActiveKeyID() string {
+	return s.activeKeyID
+}
+
+// wrap seals the data key of the file with the given id. The file id is authenticated along with the key id so a key
+// record cannot be paired with the payload of another file.
+func (s *KeyRing) wrap(fileID, dataKey []byte) (envelope, error) {
+	nonce := make([]byte, s.keys[s.activeKeyID].NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return envelope{}, err
+	}
+
+	return envelope{
+		Version:    envelopeVersion,
+		KeyID:      s.activeKeyID,
+		FileID:     fileID,
+		WrappedKey: s.keys[s.activeKeyID].Seal(nonce, nonce, dataKey, envelopeAdditionalData(s.activeKeyID, fileID)),
+	}, nil
+}
+
+func (s *KeyRing) unwrap(fileEnvelope envelope) ([]byte, error) {
+	if fileEnvelope.Version != envelopeVersion {
+		return nil, fmt.Errorf("%w: version %d", ErrUnsupportedFormat, fileEnvelope.Version)
+	}
+
+	masterKey, found := s.keys[fileEnvelope.KeyID]
+	if !found {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownMasterKey, fileEnvelope.KeyID)
+	}
+
+	nonceSize := masterKey.NonceSize()
+	if len(fileEnvelope.WrappedKey) < nonceSize {
+		return nil, ErrDecryptionFailed
+	}
+
+	if dataKey, err := masterKey.Open(nil, fileEnvelope.WrappedKey[:nonceSize], fileEnvelope.WrappedKey[nonceSize:], envelopeAdditionalData(fileEnvelope.KeyID, fileEnvelope.FileID)); err != nil {
+		return nil, ErrDecryptionFailed
+	} else {
+		return dataKey, nil
+	}
+}
+
+func envelopeAdditionalData(keyID string, fileID []byte) []byte {
+	return append([]byte(keyID), fileID...)
+}
+
+// envelope is the stored form of a wrapped data key. It is written to the key record of the file it belongs to so
+// that rotating master keys only rewrites key records. The file id matches the header of the payload the data key
+// encrypts.
+type envelope struct {
+	Version    int    `json:"version"`
+	KeyID      string `json:"key_id"`
+	FileID     []byte `json:"file_id"`
+	WrappedKey []byte `json:"wrapped_key"`
+}
+
+// readHeader consumes the header of an encrypted file from reader and returns its file id. The boolean result is
+// false when the file does not start with an encryption header, in which case the consumed bytes are returned so the
+// caller can still use them.
+func readHeader(reader io.Reader) ([]byte, bool, []byte, error) {
+	header := make([]byte, encryptedHeaderSize)
+
+	read, err := io.ReadFull(reader, header)
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, false, header[:read], nil
+	} else if err != nil {
+		return nil, false, nil, err
+	} else if !bytes.HasPrefix(header, []byte(encryptedMagic)) {
+		return nil, false, header, nil
+	}
+
+	return header[len(encryptedMagic):], true, header, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if block, err := aes.NewCipher(key); err != nil {
+		return nil, err
+	} else {
+		return cipher.NewGCM(block)
+	}
+}
+
+// chunkNonce derives the nonce of a payload chunk from its position. Data keys are never reused across files so a
+// counter is sufficient. The final chunk is flagged so that truncation at a chunk boundary is detected.
+func chunkNonce(counter uint64, final bool) []byte {
+	nonce := make([]byte, 12)
+	binary.BigEndian.PutUint64(nonce, counter)
+
+	if final {
+		nonce[len(nonce)-1] = 1
+	}
+
+	return nonce
+}
+
+// plaintextSize returns the size of the plaintext of an encrypted file of the given stored size.
+func plaintextSize(storedSize int64) int64 {
+	encryptedSize := storedSize - int64(encryptedHeaderSize)
+	if encryptedSize <= 0 {
+		return 0
+	}
+
+	chunks := (encryptedSize + encryptedSealedSize - 1) / encryptedSealedSize
+	return encryptedSize - chunks*encryptedTagSize
+}
+
+// encryptingReader seals the plaintext of its source in fixed size chunks. One byte of read-ahead is kept so the
+// final chunk is known when it is sealed.
+type encryptingReader struct {
+	source   io.Reader
+	aead     cipher.AEAD
+	buffer   []byte
+	buffered int
+	sealed   []byte
+	pending  []byte
+	counter  uint64
+	final    bool
+}
+
+func newEncryptingReader(source io.Reader, aead cipher.AEAD) *encryptingReader {
+	return &encryptingReader{
+		source: source,
+		aead:   aead,
+		buffer: make([]byte, encryptedChunkSize+1),
+		sealed: make([]byte, 0, encryptedSealedSize),
+	}
+}
+
+func (s *encryptingReader) Read(buffer []byte) (int, error) {
+	for len(s.pending) == 0 {
+		if s.final {
+			return 0, io.EOF
+		} else if err := s.sealNext(); err != nil {
+			return 0, err
+		}
+	}
+
+	read := copy(buffer, s.pending)
+	s.pending = s.pending[read:]
+
+	return read, nil
+}
+
+func (s *encryptingReader) sealNext() error {
+	read, err := io.ReadFull(s.source, s.buffer[s.buffered:])
+	s.buffered += read
+
+	switch {
+	case err == nil:
+		s.pending = s.aead.Seal(s.sealed[:0], chunkNonce(s.counter, false), s.buffer[:encryptedChunkSize], nil)
+		s.buffer[0] = s.buffer[encryptedChunkSize]
+		s.buffered = 1
+
+	case errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF):
+		s.pending = s.aead.Seal(s.sealed[:0], chunkNonce(s.counter, true), s.buffer[:s.buffered], nil)
+		s.final = true
+
+	default:
+		return err
+	}
+
+	s.counter++
+	return nil
+}
+
+// decryptingReader opens the chunks sealed by an encryptingReader. Any authentication failure, including a missing
+// final chunk, is reported as ErrDecryptionFailed.
+type decryptingReader struct {
+	source    io.ReadCloser
+	aead      cipher.AEAD
+	buffer    []byte
+	buffered  int
+	plaintext []byte
+	pending   []byte
+	counter   uint64
+	final     bool
+}
+
+func newDecryptingReader(source io.ReadCloser, aead cipher.AEAD) *decryptingReader {
+	return &decryptingReader{
+		source:    source,
+		aead:      aead,
+		buffer:    make([]byte, encryptedSealedSize+1),
+		plaintext: make([]byte, 0, encryptedChunkSize),
+	}
+}
+
+func (s *decryptingReader) Read(buffer []byte) (int, error) {
+	for len(s.pending) == 0 {
+		if s.final {
+			return 0, io.EOF
+		} else if err := s.openNext(); err != nil {
+			return 0, err
+		}
+	}
+
+	read := copy(buffer, s.pending)
+	s.pending = s.pending[read:]
+
+	return read, nil
+}
+
+func (s *decryptingReader) openNext() error {
+	read, err := io.ReadFull(s.source, s.buffer[s.buffered:])
+	s.buffered += read
+
+	switch {
+	case err == nil:
+		if s.pending, err = s.aead.Open(s.plaintext[:0], chunkNonce(s.counter, false), s.buffer[:encryptedSealedSize], nil); err != nil {
+			return ErrDecryptionFailed
+		}
+
+		s.buffer[0] = s.buffer[encryptedSealedSize]
+		s.buffered = 1
+
+	case errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF):
+		if s.pending, err = s.aead.Open(s.plaintext[:0], chunkNonce(s.counter, true), s.buffer[:s.buffered], nil); err != nil {
+			return ErrDecryptionFailed
+		}
+
+		s.final = true
+
+	default:
+		return err
+	}
+
+	s.counter++
+	return nil
+}
+
+func (s *decryptingReader) Close() error {
+	return s.source.Close()
+}
+
+// EncryptedStore is a Storage implementation that encrypts files at rest before handing them to another Storage.
+// Every file is encrypted with its own AES-256-GCM data key. The data key is wrapped with the active master key of
+// the KeyRing and stored in a key record named after the file with KeyRecordSuffix appended, so rotating master keys
+// rewrites key records and never payloads. The payload header carries a random file id that the key record must
+// match; a payload paired with the key record of another write is refused with ErrDecryptionFailed.
+//
+// Files without an encryption header were written before encryption was enabled and are read back as they are.
+// RotateKeys encrypts them in place.
+type EncryptedStore struct {
+	Storage Storage
+	keyRing *KeyRing
+}
+
+func NewEncryptedStore(storage Storage, keyRing *KeyRing) *EncryptedStore {
+	return &EncryptedStore{
+		Storage: storage,
+		keyRing: keyRing,
+	}
+}
+
+func keyRecordName(name string) string {
+	return name + KeyRecordSuffix
+}
+
+func isKeyRecordName(name string) bool {
+	return strings.HasSuffix(name, KeyRecordSuffix)
+}
+
+// readKeyRecord returns the envelope of the named file. The boolean result is false when the file has no key record.
+func (s *EncryptedStore) readKeyRecord(ctx context.Context, name string) (envelope, bool, error) {
+	var fileEnvelope envelope
+
+	reader, _, err := s.Storage.Get(ctx, keyRecordName(name))
+	if errors.Is(err, fs.ErrNotExist) {
+		return envelope{}, false, nil
+	} else if err != nil {
+		return envelope{}, false, err
+	}
+
+	content, readErr := io.ReadAll(io.LimitReader(reader, envelopeMaxSize))
+	if closeErr := reader.Close(); readErr != nil || closeErr != nil {
+		return envelope{}, false, errors.Join(readErr, closeErr)
+	}
+
+	if err := json.Unmarshal(content, &fileEnvelope); err != nil {
+		return envelope{}, false, fmt.Errorf("%w: %w", ErrUnsupportedFormat, err)
+	}
+
+	return fileEnvelope, true, nil
+}
+
+func (s *EncryptedStore) writeKeyRecord(ctx context.Context, name string, fileEnvelope envelope) error {
+	if content, err := json.Marshal(fileEnvelope); err != nil {
+		return err
+	} else {
+		return s.Storage.Put(ctx, keyRecordName(name), bytes.NewReader(content), WriteOptions{ContentType: "application/json"})
+	}
+}
+
+// Put encrypts the contents of reader with a new data key. The payload is written before its key record so a failed
+// payload write leaves the previous file and key record untouched.
+func (s *EncryptedStore) Put(ctx context.Context, name string, reader io.Reader, options WriteOptions) error {
+	if isKeyRecordName(name) {
+		return fmt.Errorf("put %q: %w", name, ErrReservedName)
+	}
+
+	var (
+		fileID  = make([]byte, fileIDSize)
+		dataKey = make([]byte, MasterKeySize)
+	)
+
+	if _, err := rand.Read(fileID); err != nil {
+		return err
+	} else if _, err := rand.Read(dataKey); err != nil {
+		return err
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return err
+	}
+
+	fileEnvelope, err := s.keyRing.wrap(fileID, dataKey)
+	if err != nil {
+		return err
+	}
+
+	header := append([]byte(encryptedMagic), fileID...)
+	if err := s.Storage.Put(ctx, name, io.MultiReader(bytes.NewReader(header), newEncryptingReader(reader, aead)), options); err != nil {
+		return err
+	}
+
+	return s.writeKeyRecord(ctx, name, fileEnvelope)
+}
+
+// plaintextReader returns the bytes consumed while looking for an encryption header followed by the rest of the file.
+type plaintextReader struct {
+	io.Reader
+	io.Closer
+}
+
+func (s *EncryptedStore) Get(ctx context.Context, name string) (io.ReadCloser, FileInfo, error) {
+	reader, info, err := s.Storage.Get(ctx, name)
+	if err != nil {
+		return nil, FileInfo{}, err
+	}
+
+	fileID, encrypted, consumed, err := readHeader(reader)
+	if err != nil {
+		return nil, FileInfo{}, errors.Join(fmt.Errorf("get %q: %w", name, err), reader.Close())
+	} else if !encrypted {
+		return plaintextReader{Reader: io.MultiReader(bytes.NewReader(consumed), reader), Closer: reader}, info, nil
+	}
+
+	aead, err := s.openKeyRecord(ctx, name, fileID)
+	if err != nil {
+		return nil, FileInfo{}, errors.Join(fmt.Errorf("get %q: %w", name, err), reader.Close())
+	}
+
+	info.Size = plaintextSize(info.Size)
+	return newDecryptingReader(reader, aead), info, nil
+}
+
+// openKeyRecord unwraps the data key of the named file and returns its cipher. The key record must belong to the
+// payload with the given file id.
+func (s *EncryptedStore) openKeyRecord(ctx context.Context, name string, fileID []byte) (cipher.AEAD, error) {
+	if fileEnvelope, found, err := s.readKeyRecord(ctx, name); err != nil {
+		return nil, err
+	} else if !found {
+		return nil, fmt.Errorf("%w: key record is missing", ErrDecryptionFailed)
+	} else if !bytes.Equal(fileEnvelope.FileID, fileID) {
+		return nil, fmt.Errorf("%w: key record belongs to another payload", ErrDecryptionFailed)
+	} else if dataKey, err := s.keyRing.unwrap(fileEnvelope); err != nil {
+		return nil, err
+	} else {
+		return newAEAD(dataKey)
+	}
+}
+
+func (s *EncryptedStore) Stat(ctx context.Context, name string) (FileInfo, error) {
+	info, err := s.Storage.Stat(ctx, name)
+	if err != nil {
+		return FileInfo{}, err
+	}
+
+	if encrypted, err := s.Storage.Exists(ctx, keyRecordName(name)); err != nil {
+		return FileInfo{}, err
+	} else if encrypted {
+		info.Size = plaintextSize(info.Size)
+	}
+
+	return info, nil
+}
+
+// Delete removes the payload before its key record. A key record left behind by an interrupted delete is harmless.
+func (s *EncryptedStore) Delete(ctx context.Context, name string) error {
+	if err := s.Storage.Delete(ctx, name); err != nil {
+		return err
+	}
+
+	return s.Storage.Delete(ctx, keyRecordName(name))
+}
+
+func (s *EncryptedStore) PruneEmptyParents(ctx context.Context, name string) error {
+	return s.Storage.PruneEmptyParents(ctx, name)
+}
+
+func (s *EncryptedStore) Exists(ctx context.Context, name string) (bool, error) {
+	return s.Storage.Exists(ctx, name)
+}
+
+// List hides key records and reports the plaintext size of encrypted files. Listings are ordered by name and every
+// key record sorts after its payload, so twice the requested limit always contains enough payloads.
+func (s *EncryptedStore) List(ctx context.Context, name string, options ListOptions) ([]FileInfo, error) {
+	var (
+		limit       = options.Limit
+		encrypted   = map[string]struct{}{}
+		listOptions = options
+	)
+
+	if limit > 0 {
+		listOptions.Limit = limit * 2
+	}
+
+	listedInfos, err := s.Storage.List(ctx, name, listOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, info := range listedInfos {
+		if isKeyRecordName(info.Path) {
+			encrypted[strings.TrimSuffix(info.Path, KeyRecordSuffix)] = struct{}{}
+		}
+	}
+
+	fileInfos := make([]FileInfo, 0, len(listedInfos)-len(encrypted))
+	for _, info := range listedInfos {
+		if isKeyRecordName(info.Path) {
+			continue
+		}
+
+		if _, found := encrypted[info.Path]; found && !info.IsDir {
+			info.Size = plaintextSize(info.Size)
+		}
+
+		fileInfos = append(fileInfos, info)
+
+		if limit > 0 && len(fileInfos) >= limit {
+			break
+		}
+	}
+
+	return fileInfos, nil
+}
+
+// Copy duplicates a file and its key record as they are. The copy shares the data key of the original.
+func (s *EncryptedStore) Copy(ctx context.Context, srcName, dstName string, options WriteOptions) error {
+	if isKeyRecordName(dstName) {
+		return fmt.Errorf("copy %q: %w", dstName, ErrReservedName)
+	}
+
+	if err := s.Storage.Copy(ctx, srcName, dstName, options); err != nil {
+		return err
+	}
+
+	return s.relocateKeyRecord(ctx, srcName, dstName, s.Storage.Copy)
+}
+
+// Move relocates a file and then its key record.
+func (s *EncryptedStore) Move(ctx context.Context, srcName, dstName string, options WriteOptions) error {
+	if isKeyRecordName(dstName) {
+		return fmt.Errorf("move %q: %w", dstName, ErrReservedName)
+	}
+
+	if err := s.Storage.Move(ctx, srcName, dstName, options); err != nil {
+		return err
+	}
+
+	return s.relocateKeyRecord(ctx, srcName, dstName, s.Storage.Move)
+}
+
+// relocateKeyRecord copies or moves the key record of srcName to dstName. When srcName has no key record any key
+// record left at dstName by a previous file is removed.
+func (s *EncryptedStore) relocateKeyRecord(ctx context.Context, srcName, dstName string, relocate func(ctx context.Context, srcName, dstName string, options WriteOptions) error) error {
+	if encrypted, err := s.Storage.Exists(ctx, keyRecordName(srcName)); err != nil {
+		return err
+	} else if !encrypted {
+		return s.Storage.Delete(ctx, keyRecordName(dstName))
+	} else {
+		return relocate(ctx, keyRecordName(srcName), keyRecordName(dstName), WriteOptions{ContentType: "application/json"})
+	}
+}
+
+// RotateKeys re-wraps the data key of every encrypted file under name with the active master key and encrypts files
+// that were written before encryption was enabled. Only the key records of encrypted files are rewritten; their
+// payloads are neither read nor written. The number of rewritten key records and encrypted files is returned.
+func (s *EncryptedStore) RotateKeys(ctx context.Context, name string) (int, error) {
+	var (
+		rotated   int
+		encrypted = map[string]struct{}{}
+	)
+
+	listedInfos, err := s.Storage.List(ctx, name, ListOptions{Recursive: true})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, info := range listedInfos {
+		if isKeyRecordName(info.Path) {
+			encrypted[strings.TrimSuffix(info.Path, KeyRecordSuffix)] = struct{}{}
+		}
+	}
+
+	for _, info := range listedInfos {
+		if info.IsDir || isKeyRecordName(info.Path) {
+			continue
+		}
+
+		rewrite := s.rotateKeyRecord
+		if _, found := encrypted[info.Path]; !found {
+			rewrite = s.encryptInPlace
+		}
+
+		if rewritten, err := rewrite(ctx, info.Path); err != nil {
+			return rotated, fmt.Errorf("rotate key of %q: %w", info.Path, err)
+		} else if rewritten {
+			rotated++
+		}
+	}
+
+	return rotated, nil
+}
+
+// rotateKeyRecord re-wraps the data key of the named file unless it is already wrapped with the active master key and
+// reports whether the key record was rewritten.
+func (s *EncryptedStore) rotateKeyRecord(ctx context.Context, name string) (bool, error) {
+	if fileEnvelope, found, err := s.readKeyRecord(ctx, name); err != nil || !found {
+		return false, err
+	} else if fileEnvelope.KeyID == s.keyRing.ActiveKeyID() {
+		return false, nil
+	} else if dataKey, err := s.keyRing.unwrap(fileEnvelope); err != nil {
+		return false, err
+	} else if rotatedEnvelope, err := s.keyRing.wrap(fileEnvelope.FileID, dataKey); err != nil {
+		return false, err
+	} else {
+		return true, s.writeKeyRecord(ctx, name, rotatedEnvelope)
+	}
+}
+
+// encryptInPlace encrypts the named file if it was written before encryption was enabled and reports whether it was
+// rewritten.
+func (s *EncryptedStore) encryptInPlace(ctx context.Context, name string) (bool, error) {
+	reader, info, err := s.Storage.Get(ctx, name)
+	if err != nil {
+		return false, err
+	}
+
+	_, encrypted, consumed, err := readHeader(reader)
+	if err != nil || encrypted {
+		// An encrypted payload without a key record cannot be rotated
+		return false, errors.Join(err, reader.Close())
+	}
+
+	// The bytes read while looking for a header are part of the plaintext
+	err = s.Put(ctx, name, io.MultiReader(bytes.NewReader(consumed), reader), WriteOptions{ContentType: info.ContentType})
+	return err == nil, errors.Join(err, reader.Close())
+}
@@ -0,0 +1,428 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/specterops/bloodhound/packages/go/storage"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testChunkSize  = 64 * 1024
+	testHeaderSize = 24
+)
+
+func newTestMasterKey(t *testing.T) []byte {
+	t.Helper()
+
+	key := make([]byte, storage.MasterKeySize)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return key
+}
+
+func newTestKeyRing(t *testing.T, activeKeyID string, keys map[string][]byte) *storage.KeyRing {
+	t.Helper()
+
+	keyRing, err := storage.NewKeyRing(activeKeyID, keys)
+	require.NoError(t, err)
+	return keyRing
+}
+
+func newTestEncryptedStore(t *testing.T) (string, *storage.LocalStore, *storage.EncryptedStore) {
+	t.Helper()
+
+	rootPath, localStore := newTestLocalStore(t)
+	keyRing := newTestKeyRing(t, "primary", map[string][]byte{"primary": newTestMasterKey(t)})
+
+	return rootPath, localStore, storage.NewEncryptedStore(localStore, keyRing)
+}
+
+func requireEncryptedContent(t *testing.T, encryptedStore *storage.EncryptedStore, name string, expected []byte) {
+	t.Helper()
+
+	reader, info, err := encryptedStore.Get(context.Background(), name)
+	require.NoError(t, err)
+
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	require.Equal(t, expected, content)
+	require.Equal(t, int64(len(expected)), info.Size)
+}
+
+func TestNewKeyRing(t *testing.T) {
+	t.Parallel()
+
+	type testData struct {
+		name        string
+		activeKeyID string
+		keys        map[string][]byte
+		errContains string
+	}
+
+	tests := []testData{
+		{
+			name:        "valid key ring",
+			activeKeyID: "primary",
+			keys:        map[string][]byte{"primary": make([]byte, storage.MasterKeySize)},
+		},
+		{
+			name:        "active key missing",
+			activeKeyID: "secondary",
+			keys:        map[string][]byte{"primary": make([]byte, storage.MasterKeySize)},
+			errContains: "unknown master key",
+		},
+		{
+			name:        "short key",
+			activeKeyID: "primary",
+			keys:        map[string][]byte{"primary": make([]byte, 16)},
+			errContains: "must be 32 bytes",
+		},
+		{
+			name:        "empty key id",
+			activeKeyID: "",
+			keys:        map[string][]byte{"": make([]byte, storage.MasterKeySize)},
+			errContains: "master key id is required",
+		},
+		{
+			name:        "long key id",
+			activeKeyID: strings.Repeat("k", storage.MaxKeyIDLength+1),
+			keys:        map[string][]byte{strings.Repeat("k", storage.MaxKeyIDLength+1): make([]byte, storage.MasterKeySize)},
+			errContains: "must be at most 128 bytes",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			keyRing, err := storage.NewKeyRing(testCase.activeKeyID, testCase.keys)
+
+			if testCase.errContains != "" {
+				require.ErrorContains(t, err, testCase.errContains)
+				require.Nil(t, keyRing)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testCase.activeKeyID, keyRing.ActiveKeyID())
+			}
+		})
+	}
+}
+
+func TestEncryptedStore_PutGet(t *testing.T) {
+	t.Parallel()
+
+	sizes := map[string]int{
+		"empty":                   0,
+		"single byte":             1,
+		"exactly one chunk":       testChunkSize,
+		"one byte past one chunk": testChunkSize + 1,
+		"several chunks":          3*testChunkSize + 17,
+	}
+
+	for name, size := range sizes {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			rootPath, _, encryptedStore := newTestEncryptedStore(t)
+			plaintext := make([]byte, size)
+			_, err := rand.Read(plaintext)
+			require.NoError(t, err)
+
+			// Act
+			err = encryptedStore.Put(context.Background(), "dir/file.json", bytes.NewReader(plaintext), storage.WriteOptions{})
+
+			// Assert
+			require.NoError(t, err)
+			requireEncryptedContent(t, encryptedStore, "dir/file.json", plaintext)
+
+			stored := requireReadFile(t, filepath.Join(rootPath, "dir", "file.json"))
+			require.NotEqual(t, plaintext, stored)
+
+			fileInfos, err := encryptedStore.List(context.Background(), "dir", storage.ListOptions{})
+			require.NoError(t, err)
+			require.Equal(t, []string{"dir/file.json"}, fileInfoPaths(fileInfos))
+			require.Equal(t, int64(size), fileInfos[0].Size)
+
+			info, err := encryptedStore.Stat(context.Background(), "dir/file.json")
+			require.NoError(t, err)
+			require.Equal(t, int64(size), info.Size)
+		})
+	}
+}
+
+func TestEncryptedStore_Put_FailIfExists(t *testing.T) {
+	t.Parallel()
+
+	_, _, encryptedStore := newTestEncryptedStore(t)
+	require.NoError(t, encryptedStore.Put(context.Background(), "file", bytes.NewReader([]byte("original")), storage.WriteOptions{}))
+
+	err := encryptedStore.Put(context.Background(), "file", bytes.NewReader([]byte("replacement")), storage.WriteOptions{FailIfExists: true})
+
+	require.ErrorIs(t, err, fs.ErrExist)
+	requireEncryptedContent(t, encryptedStore, "file", []byte("original"))
+}
+
+func TestEncryptedStore_Put_ReaderErrorKeepsPreviousFile(t *testing.T) {
+	t.Parallel()
+
+	rootPath, _, encryptedStore := newTestEncryptedStore(t)
+	readErr := errors.New("read failed")
+
+	err := encryptedStore.Put(context.Background(), "new", errorReader{err: readErr}, storage.WriteOptions{})
+	require.ErrorIs(t, err, readErr)
+	require.NoFileExists(t, filepath.Join(rootPath, "new"))
+
+	require.NoError(t, encryptedStore.Put(context.Background(), "existing", bytes.NewReader([]byte("original")), storage.WriteOptions{}))
+
+	err = encryptedStore.Put(context.Background(), "existing", errorReader{err: readErr}, storage.WriteOptions{})
+	require.ErrorIs(t, err, readErr)
+	requireEncryptedContent(t, encryptedStore, "existing", []byte("original"))
+}
+
+func TestEncryptedStore_Get_DetectsTampering(t *testing.T) {
+	t.Parallel()
+
+	type testData struct {
+		name   string
+		tamper func(content []byte) []byte
+	}
+
+	tests := []testData{
+		{
+			name: "modified byte",
+			tamper: func(content []byte) []byte {
+				content[len(content)-10] ^= 0xff
+				return content
+			},
+		},
+		{
+			name: "truncated at chunk boundary",
+			tamper: func(content []byte) []byte {
+				return content[:testHeaderSize+testChunkSize+16]
+			},
+		},
+		{
+			name: "truncated within chunk",
+			tamper: func(content []byte) []byte {
+				return content[:len(content)-1]
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			rootPath, _, encryptedStore := newTestEncryptedStore(t)
+			plaintext := bytes.Repeat([]byte("a"), 2*testChunkSize+5)
+			require.NoError(t, encryptedStore.Put(context.Background(), "file", bytes.NewReader(plaintext), storage.WriteOptions{}))
+
+			payloadPath := filepath.Join(rootPath, "file")
+			require.NoError(t, os.WriteFile(payloadPath, testCase.tamper(requireReadFile(t, payloadPath)), 0o640))
+
+			// Act
+			reader, _, err := encryptedStore.Get(context.Background(), "file")
+			require.NoError(t, err)
+			_, err = io.ReadAll(reader)
+
+			// Assert
+			require.ErrorIs(t, err, storage.ErrDecryptionFailed)
+			require.NoError(t, reader.Close())
+		})
+	}
+}
+
+func TestEncryptedStore_Get_PlaintextFile(t *testing.T) {
+	t.Parallel()
+
+	contents := map[string]string{
+		"shorter than a header": "plaintext",
+		"longer than a header":  strings.Repeat("written before encryption was enabled ", 10),
+	}
+
+	for name, content := range contents {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rootPath, _, encryptedStore := newTestEncryptedStore(t)
+			writeTestFile(t, rootPath, "legacy.json", content)
+
+			requireEncryptedContent(t, encryptedStore, "legacy.json", []byte(content))
+
+			info, err := encryptedStore.Stat(context.Background(), "legacy.json")
+			require.NoError(t, err)
+			require.Equal(t, int64(len(content)), info.Size)
+		})
+	}
+}
+
+func TestEncryptedStore_Get_MismatchedKeyRecord(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	rootPath, _, encryptedStore := newTestEncryptedStore(t)
+	require.NoError(t, encryptedStore.Put(context.Background(), "a", bytes.NewReader([]byte("first")), storage.WriteOptions{}))
+	require.NoError(t, encryptedStore.Put(context.Background(), "b", bytes.NewReader([]byte("second")), storage.WriteOptions{}))
+
+	// Act
+	require.NoError(t, os.WriteFile(filepath.Join(rootPath, "a"+storage.KeyRecordSuffix), requireReadFile(t, filepath.Join(rootPath, "b"+storage.KeyRecordSuffix)), 0o640))
+	_, _, mismatchedErr := encryptedStore.Get(context.Background(), "a")
+
+	require.NoError(t, os.Remove(filepath.Join(rootPath, "b"+storage.KeyRecordSuffix)))
+	_, _, missingErr := encryptedStore.Get(context.Background(), "b")
+
+	// Assert
+	require.ErrorIs(t, mismatchedErr, storage.ErrDecryptionFailed)
+	require.ErrorIs(t, missingErr, storage.ErrDecryptionFailed)
+}
+
+func TestEncryptedStore_Put_ReservedName(t *testing.T) {
+	t.Parallel()
+
+	_, _, encryptedStore := newTestEncryptedStore(t)
+
+	err := encryptedStore.Put(context.Background(), "file"+storage.KeyRecordSuffix, bytes.NewReader([]byte("content")), storage.WriteOptions{})
+	require.ErrorIs(t, err, storage.ErrReservedName)
+}
+
+func TestEncryptedStore_List(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	_, _, encryptedStore := newTestEncryptedStore(t)
+	require.NoError(t, encryptedStore.Put(context.Background(), "dir/a", bytes.NewReader([]byte("first")), storage.WriteOptions{}))
+	require.NoError(t, encryptedStore.Put(context.Background(), "dir/b", bytes.NewReader([]byte("second")), storage.WriteOptions{}))
+	require.NoError(t, encryptedStore.Put(context.Background(), "dir/c", bytes.NewReader([]byte("third")), storage.WriteOptions{}))
+
+	// Act
+	fileInfos, err := encryptedStore.List(context.Background(), "dir", storage.ListOptions{})
+	require.NoError(t, err)
+	limitedFileInfos, limitedErr := encryptedStore.List(context.Background(), "dir", storage.ListOptions{Limit: 2})
+	require.NoError(t, limitedErr)
+
+	// Assert
+	require.Equal(t, []string{"dir/a", "dir/b", "dir/c"}, fileInfoPaths(fileInfos))
+	require.Equal(t, int64(len("first")), fileInfos[0].Size)
+	require.Equal(t, int64(len("second")), fileInfos[1].Size)
+	require.Equal(t, int64(len("third")), fileInfos[2].Size)
+	require.Equal(t, []string{"dir/a", "dir/b"}, fileInfoPaths(limitedFileInfos))
+}
+
+func TestEncryptedStore_CopyMove(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	rootPath, _, encryptedStore := newTestEncryptedStore(t)
+	require.NoError(t, encryptedStore.Put(context.Background(), "src", bytes.NewReader([]byte("content")), storage.WriteOptions{}))
+
+	// Act
+	require.NoError(t, encryptedStore.Copy(context.Background(), "src", "copied", storage.WriteOptions{}))
+	require.NoError(t, encryptedStore.Move(context.Background(), "src", "moved", storage.WriteOptions{}))
+
+	// Assert
+	requireEncryptedContent(t, encryptedStore, "copied", []byte("content"))
+	requireEncryptedContent(t, encryptedStore, "moved", []byte("content"))
+	require.NoFileExists(t, filepath.Join(rootPath, "src"))
+	require.NoFileExists(t, filepath.Join(rootPath, "src"+storage.KeyRecordSuffix))
+}
+
+func TestEncryptedStore_Delete(t *testing.T) {
+	t.Parallel()
+
+	rootPath, _, encryptedStore := newTestEncryptedStore(t)
+	require.NoError(t, encryptedStore.Put(context.Background(), "file", bytes.NewReader([]byte("content")), storage.WriteOptions{}))
+
+	require.NoError(t, encryptedStore.Delete(context.Background(), "file"))
+
+	require.NoFileExists(t, filepath.Join(rootPath, "file"))
+	require.NoFileExists(t, filepath.Join(rootPath, "file"+storage.KeyRecordSuffix))
+}
+
+func TestEncryptedStore_RotateKeys(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	var (
+		rootPath, localStore = newTestLocalStore(t)
+		oldKey               = newTestMasterKey(t)
+		newKey               = newTestMasterKey(t)
+		oldStore             = storage.NewEncryptedStore(localStore, newTestKeyRing(t, "old", map[string][]byte{"old": oldKey}))
+		rotatingStore        = storage.NewEncryptedStore(localStore, newTestKeyRing(t, "new", map[string][]byte{"old": oldKey, "new": newKey}))
+		newStore             = storage.NewEncryptedStore(localStore, newTestKeyRing(t, "new", map[string][]byte{"new": newKey}))
+	)
+
+	require.NoError(t, oldStore.Put(context.Background(), "a", bytes.NewReader([]byte("first")), storage.WriteOptions{}))
+	require.NoError(t, oldStore.Put(context.Background(), "nested/b", bytes.NewReader([]byte("second")), storage.WriteOptions{}))
+	require.NoError(t, rotatingStore.Put(context.Background(), "c", bytes.NewReader([]byte("third")), storage.WriteOptions{}))
+	writeTestFile(t, rootPath, "legacy", "written before encryption")
+
+	payloadBefore := readTestFile(t, rootPath, "nested/b")
+
+	_, _, err := newStore.Get(context.Background(), "a")
+	require.ErrorIs(t, err, storage.ErrUnknownMasterKey)
+
+	// Act
+	rotated, err := rotatingStore.RotateKeys(context.Background(), "")
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, 3, rotated)
+	require.Equal(t, payloadBefore, readTestFile(t, rootPath, "nested/b"))
+
+	requireEncryptedContent(t, newStore, "a", []byte("first"))
+	requireEncryptedContent(t, newStore, "nested/b", []byte("second"))
+	requireEncryptedContent(t, newStore, "c", []byte("third"))
+	requireEncryptedContent(t, newStore, "legacy", []byte("written before encryption"))
+
+	rotated, err = rotatingStore.RotateKeys(context.Background(), "")
+	require.NoError(t, err)
+	require.Zero(t, rotated)
+}
+
+func TestEncryptedStore_FileService(t *testing.T) {
+	t.Parallel()
+
+	_, _, encryptedStore := newTestEncryptedStore(t)
+	fileService := storage.NewFileService(encryptedStore)
+
+	tempName, err := fileService.WriteTempFile(context.Background(), "upload-", bytes.NewReader([]byte("upload")), storage.WriteOptions{})
+	require.NoError(t, err)
+	require.NoError(t, fileService.MoveFile(context.Background(), tempName, "retained/upload", storage.WriteOptions{}))
+
+	content, err := fileService.ReadFile(context.Background(), "retained/upload")
+	require.NoError(t, err)
+	require.Equal(t, []byte("upload"), content)
+
+	fileInfos, err := fileService.ListFiles(context.Background(), ".", storage.ListOptions{Recursive: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{"retained/upload"}, fileInfoPaths(fileInfos))
+}
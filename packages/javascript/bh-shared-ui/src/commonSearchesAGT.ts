@@ -455,6 +455,11 @@ RETURN p\nLIMIT 1000`,
                 description: '',
                 query: `MATCH (n:AZBase)\nWHERE (n:${TAG_TIER_ZERO_AGT})\nAND n.enabled = false\nRETURN n\nLIMIT 100`,
             },
+            {
+                name: 'Tier Zero / High Value users not covered by any enforcing Conditional Access policy',
+                description: '',
+                query: `MATCH (n:AZUser)\nWHERE (n:${TAG_TIER_ZERO_AGT})\nOPTIONAL MATCH (n)-[:AZMemberOf|AZHasRole*0..3]->(:AZBase)<-[:AZConditionalAccessExcludes]-(x:AZConditionalAccessPolicy)\nWHERE x.enforcing = true\nWITH n, COLLECT(DISTINCT x) AS exclusions\nOPTIONAL MATCH (n)-[:AZMemberOf|AZHasRole*0..3]->(:AZBase)<-[:AZConditionalAccessIncludes]-(i:AZConditionalAccessPolicy)\nWHERE i.enforcing = true\nAND NOT i IN exclusions\nWITH n, exclusions, COUNT(DISTINCT i) AS included\nOPTIONAL MATCH (a:AZConditionalAccessPolicy)\nWHERE a.enforcing = true\nAND a.includesallusers = true\nAND a.tenantid = n.tenantid\nAND NOT a IN exclusions\nWITH n, included, COUNT(DISTINCT a) AS includedAll\nWHERE included = 0\nAND includedAll = 0\nRETURN n\nLIMIT 100`,
+            },
             {
                 name: 'Devices with unsupported operating systems',
                 description: '',
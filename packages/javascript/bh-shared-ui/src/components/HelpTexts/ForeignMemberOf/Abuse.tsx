@@ -0,0 +1,42 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+import { Typography } from 'doodle-ui';
+import { FC } from 'react';
+import CodeController from '../CodeController/CodeController';
+
+const Abuse: FC = () => {
+    return (
+        <>
+            <Typography variant='body2'>
+                With control of the trusted domain, reset the password of a foreign member of the group or use the
+                domain's krbtgt key to forge a ticket for it. The edge composition lists the members that qualify.
+            </Typography>
+            <Typography variant='body2'>
+                Request a referral ticket for the trusting domain as the foreign member with Rubeus:
+            </Typography>
+            <CodeController>
+                {`Rubeus.exe asktgs /service:krbtgt/<trusting domain> /dc:<trusted domain DC> /ticket:<TGT of foreign member> /nowrap`}
+            </CodeController>
+            <Typography variant='body2'>
+                Use the referral ticket to request service tickets in the trusting domain. The membership of the group
+                is added to the PAC by the trusting domain's domain controller.
+            </Typography>
+        </>
+    );
+};
+
+export default Abuse;
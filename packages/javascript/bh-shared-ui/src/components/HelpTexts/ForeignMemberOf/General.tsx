@@ -0,0 +1,37 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+import { Typography } from 'doodle-ui';
+import { FC } from 'react';
+import { EdgeInfoProps } from '../index';
+
+const General: FC<EdgeInfoProps> = ({ sourceName, targetName }) => {
+    return (
+        <>
+            <Typography variant='body2'>
+                One or more principals of the domain {sourceName} are members of the group {targetName}, and the domain
+                of {targetName} trusts {sourceName}. An attacker in control of {sourceName} controls those principals
+                and therefore has the privileges of {targetName}.
+            </Typography>
+            <Typography variant='body2'>
+                When the trust uses selective authentication, the edge is only created if a foreign member or the group
+                is allowed to authenticate to a computer in the domain of {targetName}.
+            </Typography>
+        </>
+    );
+};
+
+export default General;
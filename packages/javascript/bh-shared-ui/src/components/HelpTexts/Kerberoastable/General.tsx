@@ -0,0 +1,37 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+import { Typography } from 'doodle-ui';
+import { FC } from 'react';
+import { EdgeInfoProps } from '../index';
+
+const General: FC<EdgeInfoProps> = ({ sourceName, targetName }) => {
+    return (
+        <>
+            <Typography variant='body2'>
+                {targetName} has a service principal name, so any member of {sourceName} can request a Kerberos service
+                ticket for it. Part of the ticket is encrypted with a key derived from the password of {targetName} and
+                can be cracked offline.
+            </Typography>
+            <Typography variant='body2'>
+                The crackability property of this edge estimates how practical cracking is, from 0.1 to 1. Accounts
+                that allow RC4 encryption and have old or non-expiring passwords score highest.
+            </Typography>
+        </>
+    );
+};
+
+export default General;
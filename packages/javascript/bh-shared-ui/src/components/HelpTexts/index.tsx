@@ -25,6 +25,7 @@ import ADCSESC6a from './ADCSESC6a/ADCSESC6a';
 import ADCSESC6b from './ADCSESC6b/ADCSESC6b';
 import ADCSESC9a from './ADCSESC9a/ADCSESC9a';
 import ADCSESC9b from './ADCSESC9b/ADCSESC9b';
+import ASREPRoastable from './ASREPRoastable/ASREPRoastable';
 import AZAKSContributor from './AZAKSContributor/AZAKSContributor';
 import AZAddMembers from './AZAddMembers/AZAddMembers';
 import AZAddOwner from './AZAddOwner/AZAddOwner';
@@ -79,9 +80,12 @@ import AddSelf from './AddSelf/AddSelf';
 import AdminTo from './AdminTo/AdminTo';
 import AllExtendedRights from './AllExtendedRights/AllExtendedRights';
 import AllowedToAct from './AllowedToAct/AllowedToAct';
+import AllowedToAuthenticate from './AllowedToAuthenticate/AllowedToAuthenticate';
 import AllowedToDelegate from './AllowedToDelegate/AllowedToDelegate';
+import BadSuccessor from './BadSuccessor/BadSuccessor';
 import CanPSRemote from './CanPSRemote/CanPSRemote';
 import CanRDP from './CanRDP/CanRDP';
+import CanSpoofDNS from './CanSpoofDNS/CanSpoofDNS';
 import ClaimSpecialIdentity from './ClaimSpecialIdentity/ClaimSpecialIdentity';
 import CoerceAndRelayNTLMToADCS from './CoerceAndRelayNTLMToADCS/CoerceAndRelayNTLMToADCS';
 import CoerceAndRelayNTLMToLDAP from './CoerceAndRelayNTLMToLDAP/CoerceAndRelayNTLMToLDAP';
@@ -89,6 +93,7 @@ import CoerceAndRelayNTLMToLDAPS from './CoerceAndRelayNTLMToLDAPS/CoerceAndRela
 import CoerceAndRelayNTLMToSMB from './CoerceAndRelayNTLMToSMB/CoerceAndRelayNTLMToSMB';
 import CoerceToTGT from './CoerceToTGT/CoerceToTGT';
 import Contains from './Contains/Contains';
+import CreateDNSRecord from './CreateDNSRecord/CreateDNSRecord';
 import CrossForestTrust from './CrossForestTrust/CrossForestTrust';
 import DCFor from './DCFor/DCFor';
 import DCSync from './DCSync/DCSync';
@@ -100,6 +105,7 @@ import EnterpriseCAFor from './EnterpriseCAFor/EnterpriseCAFor';
 import ExecuteDCOM from './ExecuteDCOM/ExecuteDCOM';
 import ExtendedByPolicy from './ExtendedByPolicy/ExtendedByPolicy';
 import ForceChangePassword from './ForceChangePassword/ForceChangePassword';
+import ForeignMemberOf from './ForeignMemberOf/ForeignMemberOf';
 import GPLink from './GPLink/GPLink';
 import GenericAll from './GenericAll/GenericAll';
 import GenericWrite from './GenericWrite/GenericWrite';
@@ -108,9 +114,12 @@ import GetChangesAll from './GetChangesAll/GetChangesAll';
 import GoldenCert from './GoldenCert/GoldenCert';
 import HasSIDHistory from './HasSIDHistory/HasSIDHistory';
 import HasSession from './HasSession/HasSession';
+import HasSubnet from './HasSubnet/HasSubnet';
 import HasTrustKeys from './HasTrustKeys/HasTrustKeys';
 import HostsCAService from './HostsCAService/HostsCAService';
+import InSubnet from './InSubnet/InSubnet';
 import IssuedSignedBy from './IssuedSignedBy/IssuedSignedBy';
+import Kerberoastable from './Kerberoastable/Kerberoastable';
 import ManageCA from './ManageCA/ManageCA';
 import ManageCertificates from './ManageCertificates/ManageCertificates';
 import MemberOf from './MemberOf/MemberOf';
@@ -281,6 +290,15 @@ const EdgeInfoComponents = {
     HasTrustKeys: HasTrustKeys,
     WriteAltSecurityIdentities: WriteAltSecurityIdentities,
     WritePublicInformation: WritePublicInformation,
+    BadSuccessor: BadSuccessor,
+    HasSubnet: HasSubnet,
+    InSubnet: InSubnet,
+    CreateDNSRecord: CreateDNSRecord,
+    CanSpoofDNS: CanSpoofDNS,
+    Kerberoastable: Kerberoastable,
+    ASREPRoastable: ASREPRoastable,
+    AllowedToAuthenticate: AllowedToAuthenticate,
+    ForeignMemberOf: ForeignMemberOf,
     AZAuthenticatesTo: AZAuthenticatesTo,
 };
 
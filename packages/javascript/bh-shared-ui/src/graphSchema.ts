@@ -30,6 +30,10 @@ export enum ActiveDirectoryNodeKind {
     NTAuthStore = 'NTAuthStore',
     CertTemplate = 'CertTemplate',
     IssuancePolicy = 'IssuancePolicy',
+    Site = 'Site',
+    Subnet = 'Subnet',
+    DNSZone = 'DNSZone',
+    DNSNode = 'DNSNode',
 }
 export function ActiveDirectoryNodeKindToDisplay(value: ActiveDirectoryNodeKind): string | undefined {
     switch (value) {
@@ -65,6 +69,14 @@ export function ActiveDirectoryNodeKindToDisplay(value: ActiveDirectoryNodeKind)
             return 'CertTemplate';
         case ActiveDirectoryNodeKind.IssuancePolicy:
             return 'IssuancePolicy';
+        case ActiveDirectoryNodeKind.Site:
+            return 'Site';
+        case ActiveDirectoryNodeKind.Subnet:
+            return 'Subnet';
+        case ActiveDirectoryNodeKind.DNSZone:
+            return 'DNSZone';
+        case ActiveDirectoryNodeKind.DNSNode:
+            return 'DNSNode';
         default:
             return undefined;
     }
@@ -158,6 +170,16 @@ export enum ActiveDirectoryRelationshipKind {
     WriteAltSecurityIdentities = 'WriteAltSecurityIdentities',
     WritePublicInformation = 'WritePublicInformation',
     ProtectAdminGroups = 'ProtectAdminGroups',
+    CreateDMSA = 'CreateDMSA',
+    BadSuccessor = 'BadSuccessor',
+    HasSubnet = 'HasSubnet',
+    InSubnet = 'InSubnet',
+    CreateDNSRecord = 'CreateDNSRecord',
+    CanSpoofDNS = 'CanSpoofDNS',
+    Kerberoastable = 'Kerberoastable',
+    ASREPRoastable = 'ASREPRoastable',
+    AllowedToAuthenticate = 'AllowedToAuthenticate',
+    ForeignMemberOf = 'ForeignMemberOf',
 }
 export function ActiveDirectoryRelationshipKindToDisplay(value: ActiveDirectoryRelationshipKind): string | undefined {
     switch (value) {
@@ -337,6 +359,26 @@ export function ActiveDirectoryRelationshipKindToDisplay(value: ActiveDirectoryR
             return 'WritePublicInformation';
         case ActiveDirectoryRelationshipKind.ProtectAdminGroups:
             return 'ProtectAdminGroups';
+        case ActiveDirectoryRelationshipKind.CreateDMSA:
+            return 'CreateDMSA';
+        case ActiveDirectoryRelationshipKind.BadSuccessor:
+            return 'BadSuccessor';
+        case ActiveDirectoryRelationshipKind.HasSubnet:
+            return 'HasSubnet';
+        case ActiveDirectoryRelationshipKind.InSubnet:
+            return 'InSubnet';
+        case ActiveDirectoryRelationshipKind.CreateDNSRecord:
+            return 'CreateDNSRecord';
+        case ActiveDirectoryRelationshipKind.CanSpoofDNS:
+            return 'CanSpoofDNS';
+        case ActiveDirectoryRelationshipKind.Kerberoastable:
+            return 'Kerberoastable';
+        case ActiveDirectoryRelationshipKind.ASREPRoastable:
+            return 'ASREPRoastable';
+        case ActiveDirectoryRelationshipKind.AllowedToAuthenticate:
+            return 'AllowedToAuthenticate';
+        case ActiveDirectoryRelationshipKind.ForeignMemberOf:
+            return 'ForeignMemberOf';
         default:
             return undefined;
     }
@@ -473,6 +515,7 @@ export enum ActiveDirectoryKindProperties {
     RestrictOutboundNTLM = 'restrictoutboundntlm',
     GMSA = 'gmsa',
     MSA = 'msa',
+    DMSA = 'dmsa',
     DoesAnyAceGrantOwnerRights = 'doesanyacegrantownerrights',
     DoesAnyInheritedAceGrantOwnerRights = 'doesanyinheritedacegrantownerrights',
     ADCSWebEnrollmentHTTP = 'adcswebenrollmenthttp',
@@ -502,6 +545,21 @@ export enum ActiveDirectoryKindProperties {
     ServicePrincipalNames = 'serviceprincipalnames',
     GPOStatusRaw = 'gpostatusraw',
     GPOStatus = 'gpostatus',
+    ZoneName = 'zonename',
+    RecordName = 'recordname',
+    Crackability = 'crackability',
+    WMIFilter = 'wmifilter',
+    SecurityFiltering = 'securityfiltering',
+    GPOLocalAdmins = 'gpolocaladmins',
+    GPORemoteDesktopUsers = 'gporemotedesktopusers',
+    GPODcomUsers = 'gpodcomusers',
+    GPOPSRemoteUsers = 'gpopsremoteusers',
+    GPORemoteInteractiveLogonRight = 'gporemoteinteractivelogonright',
+    GPOLogonUserGroups = 'gpologonusergroups',
+    SourceGPOs = 'sourcegpos',
+    Quarantined = 'quarantined',
+    SelectiveAuthentication = 'selectiveauthentication',
+    PAMTrust = 'pamtrust',
 }
 export function ActiveDirectoryKindPropertiesToDisplay(value: ActiveDirectoryKindProperties): string | undefined {
     switch (value) {
@@ -727,6 +785,8 @@ export function ActiveDirectoryKindPropertiesToDisplay(value: ActiveDirectoryKin
             return 'GMSA';
         case ActiveDirectoryKindProperties.MSA:
             return 'MSA';
+        case ActiveDirectoryKindProperties.DMSA:
+            return 'DMSA';
         case ActiveDirectoryKindProperties.DoesAnyAceGrantOwnerRights:
             return 'Does Any ACE Grant Owner Rights';
         case ActiveDirectoryKindProperties.DoesAnyInheritedAceGrantOwnerRights:
@@ -785,6 +845,36 @@ export function ActiveDirectoryKindPropertiesToDisplay(value: ActiveDirectoryKin
             return 'GPO Status (Raw)';
         case ActiveDirectoryKindProperties.GPOStatus:
             return 'GPO Status';
+        case ActiveDirectoryKindProperties.ZoneName:
+            return 'Zone Name';
+        case ActiveDirectoryKindProperties.RecordName:
+            return 'Record Name';
+        case ActiveDirectoryKindProperties.Crackability:
+            return 'Crackability';
+        case ActiveDirectoryKindProperties.WMIFilter:
+            return 'WMI Filter';
+        case ActiveDirectoryKindProperties.SecurityFiltering:
+            return 'Security Filtering';
+        case ActiveDirectoryKindProperties.GPOLocalAdmins:
+            return 'GPO Local Admins';
+        case ActiveDirectoryKindProperties.GPORemoteDesktopUsers:
+            return 'GPO Remote Desktop Users';
+        case ActiveDirectoryKindProperties.GPODcomUsers:
+            return 'GPO DCOM Users';
+        case ActiveDirectoryKindProperties.GPOPSRemoteUsers:
+            return 'GPO PS Remote Users';
+        case ActiveDirectoryKindProperties.GPORemoteInteractiveLogonRight:
+            return 'GPO Remote Interactive Logon Right';
+        case ActiveDirectoryKindProperties.GPOLogonUserGroups:
+            return 'GPO Logon User Groups';
+        case ActiveDirectoryKindProperties.SourceGPOs:
+            return 'Source GPOs';
+        case ActiveDirectoryKindProperties.Quarantined:
+            return 'Quarantined';
+        case ActiveDirectoryKindProperties.SelectiveAuthentication:
+            return 'Selective Authentication';
+        case ActiveDirectoryKindProperties.PAMTrust:
+            return 'PAM Trust';
         default:
             return undefined;
     }
@@ -850,6 +940,10 @@ export function ActiveDirectoryPathfindingEdges(): ActiveDirectoryRelationshipKi
         ActiveDirectoryRelationshipKind.WritePublicInformation,
         ActiveDirectoryRelationshipKind.ManageCA,
         ActiveDirectoryRelationshipKind.ManageCertificates,
+        ActiveDirectoryRelationshipKind.BadSuccessor,
+        ActiveDirectoryRelationshipKind.Kerberoastable,
+        ActiveDirectoryRelationshipKind.ASREPRoastable,
+        ActiveDirectoryRelationshipKind.ForeignMemberOf,
         ActiveDirectoryRelationshipKind.Contains,
         ActiveDirectoryRelationshipKind.DCFor,
         ActiveDirectoryRelationshipKind.SameForestTrust,
@@ -914,6 +1008,10 @@ export function ActiveDirectoryPathfindingEdgesMatchFrontend(): ActiveDirectoryR
         ActiveDirectoryRelationshipKind.WritePublicInformation,
         ActiveDirectoryRelationshipKind.ManageCA,
         ActiveDirectoryRelationshipKind.ManageCertificates,
+        ActiveDirectoryRelationshipKind.BadSuccessor,
+        ActiveDirectoryRelationshipKind.Kerberoastable,
+        ActiveDirectoryRelationshipKind.ASREPRoastable,
+        ActiveDirectoryRelationshipKind.ForeignMemberOf,
         ActiveDirectoryRelationshipKind.Contains,
         ActiveDirectoryRelationshipKind.DCFor,
         ActiveDirectoryRelationshipKind.SameForestTrust,
@@ -944,6 +1042,8 @@ export enum AzureNodeKind {
     LogicApp = 'AZLogicApp',
     AutomationAccount = 'AZAutomationAccount',
     FederatedIdentityCredential = 'AZFederatedIdentityCredential',
+    AdministrativeUnit = 'AZAdministrativeUnit',
+    ConditionalAccessPolicy = 'AZConditionalAccessPolicy',
 }
 export function AzureNodeKindToDisplay(value: AzureNodeKind): string | undefined {
     switch (value) {
@@ -989,6 +1089,10 @@ export function AzureNodeKindToDisplay(value: AzureNodeKind): string | undefined
             return 'AutomationAccount';
         case AzureNodeKind.FederatedIdentityCredential:
             return 'FederatedIdentityCredential';
+        case AzureNodeKind.AdministrativeUnit:
+            return 'AdministrativeUnit';
+        case AzureNodeKind.ConditionalAccessPolicy:
+            return 'ConditionalAccessPolicy';
         default:
             return undefined;
     }
@@ -1044,6 +1148,9 @@ export enum AzureRelationshipKind {
     AZRoleEligible = 'AZRoleEligible',
     AZRoleApprover = 'AZRoleApprover',
     AZAuthenticatesTo = 'AZAuthenticatesTo',
+    AZHasScopedRole = 'AZHasScopedRole',
+    AZConditionalAccessIncludes = 'AZConditionalAccessIncludes',
+    AZConditionalAccessExcludes = 'AZConditionalAccessExcludes',
 }
 export function AzureRelationshipKindToDisplay(value: AzureRelationshipKind): string | undefined {
     switch (value) {
@@ -1147,6 +1254,12 @@ export function AzureRelationshipKindToDisplay(value: AzureRelationshipKind): st
             return 'AZRoleApprover';
         case AzureRelationshipKind.AZAuthenticatesTo:
             return 'AZAuthenticatesTo';
+        case AzureRelationshipKind.AZHasScopedRole:
+            return 'AZHasScopedRole';
+        case AzureRelationshipKind.AZConditionalAccessIncludes:
+            return 'AZConditionalAccessIncludes';
+        case AzureRelationshipKind.AZConditionalAccessExcludes:
+            return 'AZConditionalAccessExcludes';
         default:
             return undefined;
     }
@@ -1197,6 +1310,12 @@ export enum AzureKindProperties {
     Subject = 'subject',
     Audiences = 'audiences',
     FederatedIdentityCredentialAppID = 'federatedidentitycredentialappid',
+    IsMemberManagementRestricted = 'ismembermanagementrestricted',
+    ConditionalAccessPolicyState = 'capolicystate',
+    GrantControls = 'grantcontrols',
+    Enforcing = 'enforcing',
+    IncludesAllUsers = 'includesallusers',
+    IncludesAllApplications = 'includesallapplications',
 }
 export function AzureKindPropertiesToDisplay(value: AzureKindProperties): string | undefined {
     switch (value) {
@@ -1288,6 +1407,18 @@ export function AzureKindPropertiesToDisplay(value: AzureKindProperties): string
             return 'Audiences';
         case AzureKindProperties.FederatedIdentityCredentialAppID:
             return 'Federated Identity Credential Application ID';
+        case AzureKindProperties.IsMemberManagementRestricted:
+            return 'Is Member Management Restricted';
+        case AzureKindProperties.ConditionalAccessPolicyState:
+            return 'Conditional Access Policy State';
+        case AzureKindProperties.GrantControls:
+            return 'Grant Controls';
+        case AzureKindProperties.Enforcing:
+            return 'Enforcing';
+        case AzureKindProperties.IncludesAllUsers:
+            return 'Includes All Users';
+        case AzureKindProperties.IncludesAllApplications:
+            return 'Includes All Applications';
         default:
             return undefined;
     }
@@ -1369,6 +1500,12 @@ export enum CommonKindProperties {
     Email = 'email',
     IsInherited = 'isinherited',
     CompositionID = 'compositionid',
+    CompositionRule = 'compositionrule',
+    CompositionNodes = 'compositionnodes',
+    CompositionEdges = 'compositionedges',
+    TierZeroImpact = 'tierzeroimpact',
+    PrincipalExposure = 'principalexposure',
+    ExposureEstimated = 'exposureestimated',
     PrimaryKind = 'primarykind',
 }
 export function CommonKindPropertiesToDisplay(value: CommonKindProperties): string | undefined {
@@ -1411,6 +1548,18 @@ export function CommonKindPropertiesToDisplay(value: CommonKindProperties): stri
             return 'Is Inherited';
         case CommonKindProperties.CompositionID:
             return 'Composition ID';
+        case CommonKindProperties.CompositionRule:
+            return 'Composition Rule';
+        case CommonKindProperties.CompositionNodes:
+            return 'Composition Nodes';
+        case CommonKindProperties.CompositionEdges:
+            return 'Composition Edges';
+        case CommonKindProperties.TierZeroImpact:
+            return 'Tier Zero Impact';
+        case CommonKindProperties.PrincipalExposure:
+            return 'Principal Exposure';
+        case CommonKindProperties.ExposureEstimated:
+            return 'Exposure Estimated';
         case CommonKindProperties.PrimaryKind:
             return 'Primary Kind';
         default:
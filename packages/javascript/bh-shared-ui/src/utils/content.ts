@@ -144,6 +144,10 @@ export const entityInformationEndpoints: Record<EntityKinds, (id: string, option
             undefined,
             options
         ),
+    [AzureNodeKind.AdministrativeUnit]: (id: string, options?: RequestOptions) =>
+        apiClient.getAZEntityInfoV2('az-base', id, undefined, false, undefined, undefined, undefined, options),
+    [AzureNodeKind.ConditionalAccessPolicy]: (id: string, options?: RequestOptions) =>
+        apiClient.getAZEntityInfoV2('az-base', id, undefined, false, undefined, undefined, undefined, options),
     [ActiveDirectoryNodeKind.Entity]: (id: string, options?: RequestOptions) => apiClient.getBaseV2(id, false, options),
     // LocalGroups and LocalUsers are entities that we handle directly and add the `Base` kind to so using getBaseV2 is an assumption but should work
     [ActiveDirectoryNodeKind.LocalGroup]: (id: string, options?: RequestOptions) =>
@@ -171,6 +175,12 @@ export const entityInformationEndpoints: Record<EntityKinds, (id: string, option
     [ActiveDirectoryNodeKind.User]: (id: string, options?: RequestOptions) => apiClient.getUserV2(id, false, options),
     [ActiveDirectoryNodeKind.IssuancePolicy]: (id: string, options?: RequestOptions) =>
         apiClient.getIssuancePolicyV2(id, false, options),
+    [ActiveDirectoryNodeKind.Site]: (id: string, options?: RequestOptions) => apiClient.getBaseV2(id, false, options),
+    [ActiveDirectoryNodeKind.Subnet]: (id: string, options?: RequestOptions) => apiClient.getBaseV2(id, false, options),
+    [ActiveDirectoryNodeKind.DNSZone]: (id: string, options?: RequestOptions) =>
+        apiClient.getBaseV2(id, false, options),
+    [ActiveDirectoryNodeKind.DNSNode]: (id: string, options?: RequestOptions) =>
+        apiClient.getBaseV2(id, false, options),
 };
 
 export const allSections: Partial<Record<EntityKinds, (id: string) => EntityInfoDataTableProps[]>> = {
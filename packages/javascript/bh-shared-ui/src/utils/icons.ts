@@ -28,21 +28,27 @@ import {
     faCube,
     faCubes,
     faDesktop,
+    faFileLines,
+    faFolderTree,
     faGlobe,
     faIdCard,
     faKey,
     faLandmark,
     faList,
     faLock,
+    faMapLocationDot,
+    faNetworkWired,
     faObjectGroup,
     faQuestion,
     faRobot,
     fas,
     faServer,
+    faShieldHalved,
     faSitemap,
     faStore,
     faUser,
     faUsers,
+    faUsersRectangle,
     faWindowRestore,
     IconDefinition,
 } from '@fortawesome/free-solid-svg-icons';
@@ -125,6 +131,26 @@ export const NODE_ICONS: IconDictionary = {
         color: '#99B2DD',
     },
 
+    [ActiveDirectoryNodeKind.Site]: {
+        icon: faMapLocationDot,
+        color: '#7FB77E',
+    },
+
+    [ActiveDirectoryNodeKind.Subnet]: {
+        icon: faNetworkWired,
+        color: '#A8C5E8',
+    },
+
+    [ActiveDirectoryNodeKind.DNSZone]: {
+        icon: faFolderTree,
+        color: '#8FB3D9',
+    },
+
+    [ActiveDirectoryNodeKind.DNSNode]: {
+        icon: faFileLines,
+        color: '#B7C9DE',
+    },
+
     [ActiveDirectoryNodeKind.OU]: {
         icon: faSitemap,
         color: '#FFAA00',
@@ -232,6 +258,16 @@ export const NODE_ICONS: IconDictionary = {
         icon: faKey,
         color: '#FFEE8C',
     },
+
+    [AzureNodeKind.AdministrativeUnit]: {
+        icon: faUsersRectangle,
+        color: '#C8D8F0',
+    },
+
+    [AzureNodeKind.ConditionalAccessPolicy]: {
+        icon: faShieldHalved,
+        color: '#9EC9C1',
+    },
 };
 
 export const UNKNOWN_ICON: IconInfo = {
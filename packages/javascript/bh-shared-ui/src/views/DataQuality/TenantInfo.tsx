@@ -66,6 +66,14 @@ export const TenantMap = {
     managed_clusters: { displayText: 'Managed Clusters', kind: AzureNodeKind.ManagedCluster },
     vm_scale_sets: { displayText: 'VM Scale Sets', kind: AzureNodeKind.VMScaleSet },
     web_apps: { displayText: 'Web Apps', kind: AzureNodeKind.WebApp },
+    conditional_access_policies: {
+        displayText: 'Conditional Access Policies',
+        kind: AzureNodeKind.ConditionalAccessPolicy,
+    },
+    enforcing_conditional_access_policies: {
+        displayText: 'Enforcing Conditional Access Policies',
+        kind: AzureNodeKind.ConditionalAccessPolicy,
+    },
     tenants: { displayText: 'Tenants', kind: AzureNodeKind.Tenant },
 };
 
@@ -76,6 +76,8 @@ export const BUILTIN_EDGE_CATEGORIES: Category[] = [
                     ActiveDirectoryRelationshipKind.ReadLAPSPassword,
                     ActiveDirectoryRelationshipKind.SyncLAPSPassword,
                     ActiveDirectoryRelationshipKind.HasTrustKeys,
+                    ActiveDirectoryRelationshipKind.Kerberoastable,
+                    ActiveDirectoryRelationshipKind.ASREPRoastable,
                 ],
             },
             {
@@ -100,6 +102,7 @@ export const BUILTIN_EDGE_CATEGORIES: Category[] = [
                 edgeTypes: [
                     ActiveDirectoryRelationshipKind.AddAllowedToAct,
                     ActiveDirectoryRelationshipKind.AddKeyCredentialLink,
+                    ActiveDirectoryRelationshipKind.BadSuccessor,
                     ActiveDirectoryRelationshipKind.WriteAccountRestrictions,
                     ActiveDirectoryRelationshipKind.WriteAltSecurityIdentities,
                     ActiveDirectoryRelationshipKind.WriteGPLink,
@@ -130,6 +133,7 @@ export const BUILTIN_EDGE_CATEGORIES: Category[] = [
                 edgeTypes: [
                     ActiveDirectoryRelationshipKind.SpoofSIDHistory,
                     ActiveDirectoryRelationshipKind.AbuseTGTDelegation,
+                    ActiveDirectoryRelationshipKind.ForeignMemberOf,
                 ],
             },
             {
@@ -31,6 +31,7 @@ import {
     CreateUserRequest,
     CreateWebhookRequest,
     DeleteUserQueryPermissionsRequest,
+    GetPrincipalExposuresRequest,
     LoginRequest,
     PostureRequest,
     PreviewSelectorsRequest,
@@ -68,6 +69,7 @@ import {
     AssetGroupTagSelectorsResponse,
     AssetGroupTagsHistory,
     AssetGroupTagsResponse,
+    AttackPathChokePointsResponse,
     AzureDataQualityResponse,
     BasicResponse,
     CreateAuthTokenResponse,
@@ -107,6 +109,7 @@ import {
     PostureHistoryResponse,
     PostureResponse,
     PreviewSelectorsResponse,
+    PrincipalExposuresResponse,
     RotateWebhookSecretResponse,
     SavedQuery,
     SavedQueryPermissionsResponse,
@@ -731,6 +734,34 @@ class BHEAPIClient {
         );
     };
 
+    getAttackPathChokePoints = (
+        environmentId: string,
+        params?: { type?: 'node' | 'relationship'; sort_by?: string; skip?: number; limit?: number },
+        options?: RequestOptions
+    ) =>
+        this.baseClient.get<AttackPathChokePointsResponse>('/api/v2/attack-paths/choke-points', {
+            ...options,
+            params: { ...params, environment_id: environmentId },
+        });
+
+    getPrincipalExposures = (environmentId: string, params?: GetPrincipalExposuresRequest, options?: RequestOptions) =>
+        this.baseClient.get<PrincipalExposuresResponse>('/api/v2/attack-paths/principal-exposures', {
+            ...options,
+            params: { ...params, environment_id: environmentId },
+            paramsSerializer: { indexes: null },
+        });
+
+    getPrincipalExposureHistory = (
+        environmentId: string,
+        params?: GetPrincipalExposuresRequest,
+        options?: RequestOptions
+    ) =>
+        this.baseClient.get<PrincipalExposuresResponse>('/api/v2/attack-paths/principal-exposures/history', {
+            ...options,
+            params: { ...params, environment_id: environmentId },
+            paramsSerializer: { indexes: null },
+        });
+
     getPostureFindingTrends = (options?: RequestOptions) =>
         this.baseClient.get<PostureFindingTrendsResponse>(`/api/v2/attack-paths/finding-trends`, {
             ...options,
@@ -367,3 +367,17 @@ export interface AlertRetryRequest {
     channel_id: string;
     event_id: string;
 }
+
+// Filter values take an optional predicate prefix, for example `gte:10`; repeat a filter by passing an array
+export interface GetPrincipalExposuresRequest {
+    sort_by?: string | string[];
+    snapshot_date?: string | string[];
+    object_id?: string | string[];
+    name?: string | string[];
+    kind?: string | string[];
+    impact?: string | string[];
+    exposure?: string | string[];
+    estimated?: string;
+    skip?: number;
+    limit?: number;
+}
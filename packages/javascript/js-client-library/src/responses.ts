@@ -93,6 +93,40 @@ export type Environment = {
 
 export type GraphResponse = BasicResponse<GraphData>;
 
+export type AttackPathChokePoint = TimestampFields & {
+    id: number;
+    run_id: string;
+    environment_id: string;
+    rank: number;
+    type: 'node' | 'relationship';
+    kind: string;
+    object_id?: string;
+    name?: string;
+    start_object_id?: string;
+    start_name?: string;
+    end_object_id?: string;
+    end_name?: string;
+    principals_removed: number;
+    principals: number;
+    betweenness: number;
+};
+
+export type AttackPathChokePointsResponse = PaginatedResponse<AttackPathChokePoint[]>;
+
+export type PrincipalExposure = TimestampFields & {
+    id: number;
+    environment_id: string;
+    snapshot_date: string;
+    object_id: string;
+    name: string;
+    kind: string;
+    impact: number;
+    exposure: number;
+    estimated: boolean;
+};
+
+export type PrincipalExposuresResponse = PaginatedResponse<PrincipalExposure[]>;
+
 export type ActiveDirectoryQualityStat = TimestampFields & {
     users: number;
     computers: number;
@@ -136,6 +170,8 @@ export type AzureDataQualityStat = TimestampFields & {
     managed_clusters: number;
     vm_scale_sets: number;
     web_apps: number;
+    conditional_access_policies: number;
+    enforcing_conditional_access_policies: number;
     tenants?: number;
     tenantid?: string;
 };
@@ -92,7 +92,7 @@ func TestRegister_RateLimitingReturns429(t *testing.T) {
 
 	// Strict factory: 1 request per second per IP.
 	strictRateLimitFactory := func() mux.MiddlewareFunc {
-		return middleware.RateLimitMiddleware(mockDB, 1)
+		return middleware.RateLimitMiddleware(cfg, mockDB, 1)
 	}
 
 	routes.Register(&routerInst, handlerSet, strictRateLimitFactory)
@@ -24,6 +24,7 @@ import (
 
 	"github.com/huandu/go-sqlbuilder"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/specterops/bloodhound/packages/go/params"
 	"github.com/specterops/bloodhound/server/identity/internal/services"
 )
@@ -34,14 +35,16 @@ const (
 	tableRolesPermissions = "roles_permissions"
 )
 
-var validRoleColumns = []string{"id", "name", "description", "created_at", "updated_at"}
+var validRoleColumns = []string{"id", "name", "description", "built_in", "created_at", "updated_at"}
 
 type queryExecer interface {
 	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
 }
 
 type pgxQuerier interface {
 	queryExecer
+	BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error)
 }
 
 type permission struct {
@@ -56,6 +59,7 @@ type role struct {
 	ID          int32     `db:"id"`
 	Name        string    `db:"name"`
 	Description string    `db:"description"`
+	BuiltIn     bool      `db:"built_in"`
 	CreatedAt   time.Time `db:"created_at"`
 	UpdatedAt   time.Time `db:"updated_at"`
 }
@@ -87,6 +91,7 @@ func toRole(row role, permissionRows []permission) services.Role {
 		ID:          row.ID,
 		Name:        row.Name,
 		Description: row.Description,
+		BuiltIn:     row.BuiltIn,
 		Permissions: permissions,
 		CreatedAt:   row.CreatedAt,
 		UpdatedAt:   row.UpdatedAt,
@@ -94,6 +99,13 @@ func toRole(row role, permissionRows []permission) services.Role {
 }
 
 func (s *Store) GetRole(ctx context.Context, id int32) (services.Role, error) {
+	return selectRole(ctx, s.db, id)
+}
+
+// selectRole loads a role and its permissions through the given querier so
+// that it can be read either directly from the pool or from within a
+// transaction that has just written the role.
+func selectRole(ctx context.Context, querier queryExecer, id int32) (services.Role, error) {
 	var (
 		roleSB   = sqlbuilder.PostgreSQL.NewSelectBuilder()
 		roleRows pgx.Rows
@@ -104,7 +116,7 @@ func (s *Store) GetRole(ctx context.Context, id int32) (services.Role, error) {
 	roleSB.Select(validRoleColumns...).From(tableRoles).Where(roleSB.Equal("id", id)).Limit(1)
 	roleQuery, roleArgs := roleSB.Build()
 
-	roleRows, err = s.db.Query(ctx, roleQuery, roleArgs...)
+	roleRows, err = querier.Query(ctx, roleQuery, roleArgs...)
 	if err != nil {
 		return services.Role{}, err
 	}
@@ -116,7 +128,7 @@ func (s *Store) GetRole(ctx context.Context, id int32) (services.Role, error) {
 		return services.Role{}, fmt.Errorf("finding role: %s", err)
 	}
 
-	permissionRows, err := s.getRolePermissions(ctx, roleRow.ID)
+	permissionRows, err := getRolePermissions(ctx, querier, roleRow.ID)
 	if err != nil {
 		return services.Role{}, err
 	}
@@ -127,7 +139,7 @@ func (s *Store) GetRole(ctx context.Context, id int32) (services.Role, error) {
 // getRolePermissions retrieves the permissions associated with a role via the
 // join table. It is shared by GetRole and ListRoles so both issue the same
 // per-role permissions query.
-func (s *Store) getRolePermissions(ctx context.Context, roleID int32) ([]permission, error) {
+func getRolePermissions(ctx context.Context, querier queryExecer, roleID int32) ([]permission, error) {
 	var (
 		sb   = sqlbuilder.PostgreSQL.NewSelectBuilder()
 		rows pgx.Rows
@@ -141,7 +153,7 @@ func (s *Store) getRolePermissions(ctx context.Context, roleID int32) ([]permiss
 
 	query, args := sb.Build()
 
-	rows, err = s.db.Query(ctx, query, args...)
+	rows, err = querier.Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("querying permissions for role: %w", err)
 	}
@@ -203,6 +215,7 @@ var roleColumns = map[string]string{
 	"id":          "id",
 	"name":        "name",
 	"description": "description",
+	"built_in":    "built_in",
 	"created_at":  "created_at",
 	"updated_at":  "updated_at",
 	"deleted_at":  "deleted_at",
@@ -362,3 +375,30 @@ func (s *Store) GetPermission(ctx context.Context, id int) (services.Permission,
 
 	return toPermission(row), nil
 }
+
+// ListPermissions returns every permission known to the application. It is
+// used to validate the permission set of custom roles.
+func (s *Store) ListPermissions(ctx context.Context) ([]services.Permission, error) {
+	sb := sqlbuilder.PostgreSQL.NewSelectBuilder()
+	sb.Select("id", "authority", "name", "created_at", "updated_at")
+	sb.From(tablePermissions)
+	sb.OrderBy("id")
+
+	query, args := sb.Build()
+
+	rows, err := s.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	permissionRows, err := pgx.CollectRows(rows, pgx.RowToStructByName[permission])
+	if err != nil {
+		return nil, fmt.Errorf("collecting permissions: %s", err)
+	}
+
+	result := make([]services.Permission, 0, len(permissionRows))
+	for _, permissionRow := range permissionRows {
+		result = append(result, toPermission(permissionRow))
+	}
+
+	return result, nil
+}
@@ -34,7 +34,7 @@ import (
 const expectedGetPermissionSQL = `SELECT id, authority, name, created_at, updated_at FROM permissions WHERE id = $1 LIMIT $2`
 
 // expectedGetRoleSQL is the literal SQL the Store issues for the roles query in GetRole.
-const expectedGetRoleSQL = `SELECT id, name, description, created_at, updated_at FROM roles WHERE id = $1 LIMIT $2`
+const expectedGetRoleSQL = `SELECT id, name, description, built_in, created_at, updated_at FROM roles WHERE id = $1 LIMIT $2`
 
 // expectedGetRolePermissionsSQL is the literal SQL the Store issues for the
 // per-role permissions query in GetRole.
@@ -49,19 +49,19 @@ const expectedListRolePermissionsTwoSQL = `SELECT rp.role_id, p.id, p.authority,
 
 // expectedListRolesSQL is the literal SQL the Store issues for the roles query in
 // ListRoles when no filters or sorts are supplied.
-const expectedListRolesSQL = `SELECT id, name, description, created_at, updated_at FROM roles`
+const expectedListRolesSQL = `SELECT id, name, description, built_in, created_at, updated_at FROM roles`
 
 // expectedListRolesSortedSQL is the literal SQL the Store issues when a single
 // ascending sort on name is supplied.
-const expectedListRolesSortedSQL = `SELECT id, name, description, created_at, updated_at FROM roles ORDER BY name ASC`
+const expectedListRolesSortedSQL = `SELECT id, name, description, built_in, created_at, updated_at FROM roles ORDER BY name ASC`
 
 // expectedListRolesFilteredSQL is the literal SQL the Store issues when a single
 // equality filter on name is supplied.
-const expectedListRolesFilteredSQL = `SELECT id, name, description, created_at, updated_at FROM roles WHERE (name = $1)`
+const expectedListRolesFilteredSQL = `SELECT id, name, description, built_in, created_at, updated_at FROM roles WHERE (name = $1)`
 
 // expectedListRolesFilteredByIDSQL is the literal SQL the Store issues when a
 // single greater-than filter on the numeric id column is supplied.
-const expectedListRolesFilteredByIDSQL = `SELECT id, name, description, created_at, updated_at FROM roles WHERE (id > $1)`
+const expectedListRolesFilteredByIDSQL = `SELECT id, name, description, built_in, created_at, updated_at FROM roles WHERE (id > $1)`
 
 func newTestStore(t *testing.T) (*appdb.Store, pgxmock.PgxPoolIface) {
 	t.Helper()
@@ -162,7 +162,7 @@ func TestStore_GetPermission(t *testing.T) {
 }
 
 func roleRowColumns() []string {
-	return []string{"id", "name", "description", "created_at", "updated_at"}
+	return []string{"id", "name", "description", "built_in", "created_at", "updated_at"}
 }
 
 func rolePermissionRowColumns() []string {
@@ -184,6 +184,7 @@ func TestStore_GetRole(t *testing.T) {
 			ID:          3,
 			Name:        "Administrator",
 			Description: "Can manage the application",
+			BuiltIn:     true,
 			Permissions: []services.Permission{
 				{ID: 1, Authority: "auth", Name: "ManageProviders", CreatedAt: createdAt, UpdatedAt: updatedAt},
 			},
@@ -207,6 +208,7 @@ func TestStore_GetRole(t *testing.T) {
 						expected.ID,
 						expected.Name,
 						expected.Description,
+						expected.BuiltIn,
 						expected.CreatedAt,
 						expected.UpdatedAt,
 					),
@@ -244,7 +246,7 @@ func TestStore_GetRole(t *testing.T) {
 			expectations: func(pool pgxmock.PgxPoolIface) {
 				pool.ExpectQuery(expectedGetRoleSQL).WithArgs(roleID, 1).WillReturnRows(
 					pool.NewRows(roleRowColumns()).AddRow(
-						expected.ID, expected.Name, expected.Description, expected.CreatedAt, expected.UpdatedAt,
+						expected.ID, expected.Name, expected.Description, expected.BuiltIn, expected.CreatedAt, expected.UpdatedAt,
 					),
 				)
 				pool.ExpectQuery(expectedGetRolePermissionsSQL).WithArgs(roleID).WillReturnError(dbErr)
@@ -302,7 +304,7 @@ func TestStore_ListRoles(t *testing.T) {
 	expectRoleRows := func(pool pgxmock.PgxPoolIface, roles ...services.Role) *pgxmock.Rows {
 		rows := pool.NewRows(roleRowColumns())
 		for _, r := range roles {
-			rows.AddRow(r.ID, r.Name, r.Description, r.CreatedAt, r.UpdatedAt)
+			rows.AddRow(r.ID, r.Name, r.Description, r.BuiltIn, r.CreatedAt, r.UpdatedAt)
 		}
 		return rows
 	}
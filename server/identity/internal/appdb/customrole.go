@@ -0,0 +1,342 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package appdb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/huandu/go-sqlbuilder"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/bhctx"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/server/identity/internal/services"
+	"github.com/specterops/bloodhound/server/internal/administrators"
+)
+
+const (
+	tableAuditLogs    = "audit_logs"
+	tableUsersRoles   = "users_roles"
+	tableSSOProviders = "sso_providers"
+
+	// rolesNameUniqueConstraint is the unique constraint on roles.name created by the initial schema.
+	rolesNameUniqueConstraint = "roles_name_key"
+	pgUniqueViolation         = "23505"
+)
+
+// CreateRole inserts a custom role together with its permission grants and an
+// audit log entry in a single transaction.
+func (s *Store) CreateRole(ctx context.Context, template services.RoleTemplate) (services.Role, error) {
+	var (
+		insertBuilder = sqlbuilder.PostgreSQL.NewInsertBuilder()
+		now           = time.Now().UTC()
+		roleID        int32
+		created       services.Role
+		tx            pgx.Tx
+		rows          pgx.Rows
+		err           error
+	)
+
+	tx, err = s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return services.Role{}, fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer func() {
+		// Rollback is a no-op once the transaction has been committed.
+		_ = tx.Rollback(ctx)
+	}()
+
+	insertBuilder.InsertInto(tableRoles)
+	insertBuilder.Cols("name", "description", "built_in", "created_at", "updated_at")
+	insertBuilder.Values(template.Name, template.Description, false, now, now)
+	insertBuilder.Returning("id")
+
+	sqlQuery, args := insertBuilder.Build()
+	rows, err = tx.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return services.Role{}, mapRoleWriteError(err)
+	}
+	roleID, err = pgx.CollectExactlyOneRow(rows, pgx.RowTo[int32])
+	if err != nil {
+		return services.Role{}, mapRoleWriteError(err)
+	}
+
+	if err = insertRolePermissions(ctx, tx, roleID, template.PermissionIDs); err != nil {
+		return services.Role{}, err
+	}
+
+	if created, err = selectRole(ctx, tx, roleID); err != nil {
+		return services.Role{}, err
+	}
+
+	if err = insertAuditLog(ctx, tx, model.AuditLogActionCreateRole, created); err != nil {
+		return services.Role{}, err
+	}
+
+	return created, tx.Commit(ctx)
+}
+
+// UpdateRole replaces the attributes and permission grants of a custom role.
+// The change is rolled back with services.ErrLastAdministratorRole when it
+// would leave no enabled user holding a role that grants auth:ManageUsers.
+func (s *Store) UpdateRole(ctx context.Context, id int32, template services.RoleTemplate) (services.Role, error) {
+	var (
+		updateBuilder = sqlbuilder.PostgreSQL.NewUpdateBuilder()
+		deleteBuilder = sqlbuilder.PostgreSQL.NewDeleteBuilder()
+		updated       services.Role
+		commandTag    pgconn.CommandTag
+		tx            pgx.Tx
+		err           error
+	)
+
+	tx, err = s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return services.Role{}, fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer func() {
+		// Rollback is a no-op once the transaction has been committed.
+		_ = tx.Rollback(ctx)
+	}()
+
+	updateBuilder.Update(tableRoles)
+	updateBuilder.Set(
+		updateBuilder.Assign("name", template.Name),
+		updateBuilder.Assign("description", template.Description),
+		updateBuilder.Assign("updated_at", time.Now().UTC()),
+	)
+	updateBuilder.Where(updateBuilder.Equal("id", id), updateBuilder.Equal("built_in", false))
+
+	sqlQuery, args := updateBuilder.Build()
+	commandTag, err = tx.Exec(ctx, sqlQuery, args...)
+	if err != nil {
+		return services.Role{}, mapRoleWriteError(err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return services.Role{}, services.ErrNoRoleFound
+	}
+
+	deleteBuilder.DeleteFrom(tableRolesPermissions)
+	deleteBuilder.Where(deleteBuilder.Equal("role_id", id))
+
+	sqlQuery, args = deleteBuilder.Build()
+	if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+		return services.Role{}, fmt.Errorf("clearing role permissions: %w", err)
+	}
+
+	if err = insertRolePermissions(ctx, tx, id, template.PermissionIDs); err != nil {
+		return services.Role{}, err
+	}
+
+	if err = ensureAdministratorRemains(ctx, tx); err != nil {
+		return services.Role{}, err
+	}
+
+	if updated, err = selectRole(ctx, tx, id); err != nil {
+		return services.Role{}, err
+	}
+
+	if err = insertAuditLog(ctx, tx, model.AuditLogActionUpdateRole, updated); err != nil {
+		return services.Role{}, err
+	}
+
+	return updated, tx.Commit(ctx)
+}
+
+// DeleteRole removes a custom role that is neither assigned to a user nor used
+// as the default role of an SSO provider. Permission grants are removed by the
+// cascading foreign key on roles_permissions.
+func (s *Store) DeleteRole(ctx context.Context, id int32) error {
+	var (
+		deleteBuilder = sqlbuilder.PostgreSQL.NewDeleteBuilder()
+		deleted       services.Role
+		inUse         bool
+		commandTag    pgconn.CommandTag
+		tx            pgx.Tx
+		err           error
+	)
+
+	tx, err = s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer func() {
+		// Rollback is a no-op once the transaction has been committed.
+		_ = tx.Rollback(ctx)
+	}()
+
+	if deleted, err = selectRole(ctx, tx, id); err != nil {
+		return err
+	}
+
+	if inUse, err = isRoleInUse(ctx, tx, id); err != nil {
+		return err
+	} else if inUse {
+		return services.ErrRoleInUse
+	}
+
+	deleteBuilder.DeleteFrom(tableRoles)
+	deleteBuilder.Where(deleteBuilder.Equal("id", id), deleteBuilder.Equal("built_in", false))
+
+	sqlQuery, args := deleteBuilder.Build()
+	commandTag, err = tx.Exec(ctx, sqlQuery, args...)
+	if err != nil {
+		return fmt.Errorf("deleting role: %w", err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return services.ErrNoRoleFound
+	}
+
+	if err = insertAuditLog(ctx, tx, model.AuditLogActionDeleteRole, deleted); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func insertRolePermissions(ctx context.Context, querier queryExecer, roleID int32, permissionIDs []int32) error {
+	if len(permissionIDs) == 0 {
+		return nil
+	}
+
+	insertBuilder := sqlbuilder.PostgreSQL.NewInsertBuilder()
+	insertBuilder.InsertInto(tableRolesPermissions)
+	insertBuilder.Cols("role_id", "permission_id")
+	for _, permissionID := range permissionIDs {
+		insertBuilder.Values(roleID, permissionID)
+	}
+
+	sqlQuery, args := insertBuilder.Build()
+	if _, err := querier.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("granting role permissions: %w", err)
+	}
+
+	return nil
+}
+
+// isRoleInUse reports whether any user is assigned the role or any SSO
+// provider auto-provisions new users with it.
+func isRoleInUse(ctx context.Context, querier queryExecer, roleID int32) (bool, error) {
+	var (
+		usersBuilder = sqlbuilder.PostgreSQL.NewSelectBuilder()
+		ssoBuilder   = sqlbuilder.PostgreSQL.NewSelectBuilder()
+		inUse        bool
+		rows         pgx.Rows
+		err          error
+	)
+
+	usersBuilder.Select("1").From(tableUsersRoles).Where(usersBuilder.Equal("role_id", roleID))
+	ssoBuilder.Select("1").From(tableSSOProviders).Where(ssoBuilder.Equal("(config->'auto_provision'->>'default_role_id')::integer", roleID))
+
+	existsBuilder := sqlbuilder.PostgreSQL.NewSelectBuilder()
+	existsBuilder.Select(existsBuilder.Or(existsBuilder.Exists(usersBuilder), existsBuilder.Exists(ssoBuilder)))
+
+	sqlQuery, args := existsBuilder.Build()
+	rows, err = querier.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return false, fmt.Errorf("checking role assignments: %w", err)
+	}
+	inUse, err = pgx.CollectExactlyOneRow(rows, pgx.RowTo[bool])
+	if err != nil {
+		return false, fmt.Errorf("checking role assignments: %w", err)
+	}
+
+	return inUse, nil
+}
+
+// ensureAdministratorRemains returns services.ErrLastAdministratorRole when no
+// enabled user holds a role granting auth:ManageUsers.
+func ensureAdministratorRemains(ctx context.Context, querier queryExecer) error {
+	if count, err := administrators.CountEnabled(ctx, querier); err != nil {
+		return err
+	} else if count == 0 {
+		return services.ErrLastAdministratorRole
+	}
+
+	return nil
+}
+
+func mapRoleWriteError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation && pgErr.ConstraintName == rolesNameUniqueConstraint {
+		return services.ErrDuplicateRoleName
+	}
+	return fmt.Errorf("writing role: %w", err)
+}
+
+// roleAuditData returns the fields recorded in the audit log for a role change.
+func roleAuditData(role services.Role) model.AuditData {
+	permissionNames := make([]string, 0, len(role.Permissions))
+	for _, permission := range role.Permissions {
+		permissionNames = append(permissionNames, permission.Authority+":"+permission.Name)
+	}
+
+	return model.AuditData{
+		"id":          role.ID,
+		"name":        role.Name,
+		"description": role.Description,
+		"permissions": permissionNames,
+	}
+}
+
+func insertAuditLog(ctx context.Context, querier queryExecer, action model.AuditLogAction, role services.Role) error {
+	var (
+		commitID, err = uuid.NewV4()
+		bheCtx        = bhctx.Get(ctx)
+		user, isUser  = auth.GetUserFromAuthCtx(bheCtx.AuthCtx)
+	)
+	if err != nil {
+		return fmt.Errorf("generating commit id: %w", err)
+	}
+	if !isUser {
+		return fmt.Errorf("no authenticated user on context")
+	}
+
+	fields, err := json.Marshal(roleAuditData(role))
+	if err != nil {
+		return fmt.Errorf("marshalling audit fields: %w", err)
+	}
+
+	insertBuilder := sqlbuilder.PostgreSQL.NewInsertBuilder()
+	insertBuilder.InsertInto(tableAuditLogs)
+	insertBuilder.Cols(
+		"created_at", "actor_id", "actor_name", "actor_email",
+		"action", "fields", "request_id", "source_ip_address",
+		"status", "commit_id",
+	)
+	insertBuilder.Values(
+		time.Now().UTC(),
+		user.ID.String(),
+		user.PrincipalName,
+		user.EmailAddress.ValueOrZero(),
+		string(action),
+		string(fields),
+		bheCtx.RequestID,
+		bheCtx.RequestIP,
+		string(model.AuditLogStatusSuccess),
+		commitID.String(),
+	)
+
+	sqlQuery, args := insertBuilder.Build()
+	_, err = querier.Exec(ctx, sqlQuery, args...)
+	return err
+}
@@ -0,0 +1,305 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package appdb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/bhctx"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/server/identity/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	expectedListPermissionsSQL = `SELECT id, authority, name, created_at, updated_at FROM permissions ORDER BY id`
+
+	expectedInsertRoleSQL = `INSERT INTO roles (name, description, built_in, created_at, updated_at) VALUES ($1, $2, $3, $4, $5) RETURNING id`
+
+	expectedInsertRolePermissionsSQL = `INSERT INTO roles_permissions (role_id, permission_id) VALUES ($1, $2), ($3, $4)`
+
+	expectedUpdateRoleSQL = `UPDATE roles SET name = $1, description = $2, updated_at = $3 WHERE id = $4 AND built_in = $5`
+
+	expectedClearRolePermissionsSQL = `DELETE FROM roles_permissions WHERE role_id = $1`
+
+	expectedCountAdministratorsSQL = `SELECT count(DISTINCT ur.user_id) FROM users_roles ur JOIN users u ON u.id = ur.user_id JOIN roles_permissions rp ON rp.role_id = ur.role_id JOIN permissions p ON p.id = rp.permission_id WHERE p.authority = $1 AND p.name = $2 AND u.is_disabled IS NOT TRUE`
+
+	expectedRoleInUseSQL = `SELECT (EXISTS (SELECT 1 FROM users_roles WHERE role_id = $1) OR EXISTS (SELECT 1 FROM sso_providers WHERE (config->'auto_provision'->>'default_role_id')::integer = $2))`
+
+	expectedDeleteRoleSQL = `DELETE FROM roles WHERE id = $1 AND built_in = $2`
+
+	expectedAuditInsertSQL = `INSERT INTO audit_logs (created_at, actor_id, actor_name, actor_email, action, fields, request_id, source_ip_address, status, commit_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+)
+
+// authenticatedContext attaches a bhctx.Context carrying the supplied user as
+// the auth owner, mirroring what the auth middleware does on real requests.
+// The custom role write paths read the audit actor from this context.
+func authenticatedContext(userID uuid.UUID) context.Context {
+	return bhctx.Set(context.Background(), &bhctx.Context{
+		RequestID: "test-request",
+		RequestIP: "127.0.0.1",
+		AuthCtx: auth.Context{
+			Owner: model.User{
+				Unique:        model.Unique{ID: userID},
+				PrincipalName: "test-user",
+			},
+		},
+	})
+}
+
+func expectAuditInsert(pool pgxmock.PgxPoolIface, userID uuid.UUID, action model.AuditLogAction) {
+	pool.ExpectExec(expectedAuditInsertSQL).
+		WithArgs(
+			pgxmock.AnyArg(),                    // created_at
+			userID.String(),                     // actor_id
+			"test-user",                         // actor_name
+			"",                                  // actor_email
+			string(action),                      // action
+			pgxmock.AnyArg(),                    // fields (json)
+			"test-request",                      // request_id
+			"127.0.0.1",                         // source_ip_address
+			string(model.AuditLogStatusSuccess), // status
+			pgxmock.AnyArg(),                    // commit_id
+		).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+}
+
+func TestStore_ListPermissions(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		dbErr     = errors.New("connection refused")
+		createdAt = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		updatedAt = time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+		expected  = []services.Permission{
+			{ID: 1, Authority: "auth", Name: "ManageUsers", CreatedAt: createdAt, UpdatedAt: updatedAt},
+			{ID: 2, Authority: "graphdb", Name: "Read", CreatedAt: createdAt, UpdatedAt: updatedAt},
+		}
+	)
+
+	t.Run("returns every permission on success", func(t *testing.T) {
+		store, pool := newTestStore(t)
+
+		rows := pool.NewRows(permissionRowColumns())
+		for _, permission := range expected {
+			rows.AddRow(permission.Authority, permission.Name, permission.ID, permission.CreatedAt, permission.UpdatedAt)
+		}
+		pool.ExpectQuery(expectedListPermissionsSQL).WillReturnRows(rows)
+
+		result, err := store.ListPermissions(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+		require.NoError(t, pool.ExpectationsWereMet())
+	})
+
+	t.Run("propagates database errors", func(t *testing.T) {
+		store, pool := newTestStore(t)
+
+		pool.ExpectQuery(expectedListPermissionsSQL).WillReturnError(dbErr)
+
+		_, err := store.ListPermissions(ctx)
+		assert.ErrorIs(t, err, dbErr)
+		require.NoError(t, pool.ExpectationsWereMet())
+	})
+}
+
+func TestStore_CreateRole(t *testing.T) {
+	var (
+		userID   = uuid.Must(uuid.NewV4())
+		ctx      = authenticatedContext(userID)
+		template = services.RoleTemplate{
+			Name:          "Query Curator",
+			Description:   "Reads the graph and manages saved queries",
+			PermissionIDs: []int32{4, 9},
+		}
+	)
+
+	t.Run("inserts the role, its grants and an audit entry", func(t *testing.T) {
+		store, pool := newTestStore(t)
+
+		pool.ExpectBegin()
+		pool.ExpectQuery(expectedInsertRoleSQL).
+			WithArgs(template.Name, template.Description, false, pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnRows(pool.NewRows([]string{"id"}).AddRow(int32(12)))
+		pool.ExpectExec(expectedInsertRolePermissionsSQL).
+			WithArgs(int32(12), int32(4), int32(12), int32(9)).
+			WillReturnResult(pgxmock.NewResult("INSERT", 2))
+		pool.ExpectQuery(expectedGetRoleSQL).WithArgs(int32(12), 1).WillReturnRows(
+			pool.NewRows(roleRowColumns()).AddRow(int32(12), template.Name, template.Description, false, time.Time{}, time.Time{}),
+		)
+		pool.ExpectQuery(expectedGetRolePermissionsSQL).WithArgs(int32(12)).WillReturnRows(
+			pool.NewRows(rolePermissionRowColumns()).
+				AddRow(int32(4), "graphdb", "Read", time.Time{}, time.Time{}).
+				AddRow(int32(9), "saved_queries", "Write", time.Time{}, time.Time{}),
+		)
+		expectAuditInsert(pool, userID, model.AuditLogActionCreateRole)
+		pool.ExpectCommit()
+
+		created, err := store.CreateRole(ctx, template)
+		require.NoError(t, err)
+		assert.Equal(t, int32(12), created.ID)
+		assert.False(t, created.BuiltIn)
+		assert.Len(t, created.Permissions, 2)
+		require.NoError(t, pool.ExpectationsWereMet())
+	})
+
+	t.Run("maps a role name unique violation to ErrDuplicateRoleName", func(t *testing.T) {
+		store, pool := newTestStore(t)
+
+		pool.ExpectBegin()
+		pool.ExpectQuery(expectedInsertRoleSQL).
+			WithArgs(template.Name, template.Description, false, pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "roles_name_key"})
+		pool.ExpectRollback()
+
+		_, err := store.CreateRole(ctx, template)
+		assert.ErrorIs(t, err, services.ErrDuplicateRoleName)
+		require.NoError(t, pool.ExpectationsWereMet())
+	})
+}
+
+func TestStore_UpdateRole(t *testing.T) {
+	var (
+		userID   = uuid.Must(uuid.NewV4())
+		ctx      = authenticatedContext(userID)
+		roleID   = int32(12)
+		template = services.RoleTemplate{
+			Name:          "Query Curator",
+			Description:   "Reads the graph and manages saved queries",
+			PermissionIDs: []int32{4, 9},
+		}
+	)
+
+	expectRoleRewrite := func(pool pgxmock.PgxPoolIface) {
+		pool.ExpectBegin()
+		pool.ExpectExec(expectedUpdateRoleSQL).
+			WithArgs(template.Name, template.Description, pgxmock.AnyArg(), roleID, false).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		pool.ExpectExec(expectedClearRolePermissionsSQL).
+			WithArgs(roleID).
+			WillReturnResult(pgxmock.NewResult("DELETE", 1))
+		pool.ExpectExec(expectedInsertRolePermissionsSQL).
+			WithArgs(roleID, int32(4), roleID, int32(9)).
+			WillReturnResult(pgxmock.NewResult("INSERT", 2))
+	}
+
+	t.Run("replaces the role grants and writes an audit entry", func(t *testing.T) {
+		store, pool := newTestStore(t)
+
+		expectRoleRewrite(pool)
+		pool.ExpectQuery(expectedCountAdministratorsSQL).
+			WithArgs("auth", "ManageUsers").
+			WillReturnRows(pool.NewRows([]string{"count"}).AddRow(int64(1)))
+		pool.ExpectQuery(expectedGetRoleSQL).WithArgs(roleID, 1).WillReturnRows(
+			pool.NewRows(roleRowColumns()).AddRow(roleID, template.Name, template.Description, false, time.Time{}, time.Time{}),
+		)
+		pool.ExpectQuery(expectedGetRolePermissionsSQL).WithArgs(roleID).WillReturnRows(
+			pool.NewRows(rolePermissionRowColumns()),
+		)
+		expectAuditInsert(pool, userID, model.AuditLogActionUpdateRole)
+		pool.ExpectCommit()
+
+		updated, err := store.UpdateRole(ctx, roleID, template)
+		require.NoError(t, err)
+		assert.Equal(t, template.Name, updated.Name)
+		require.NoError(t, pool.ExpectationsWereMet())
+	})
+
+	t.Run("rolls back when no enabled administrator would remain", func(t *testing.T) {
+		store, pool := newTestStore(t)
+
+		expectRoleRewrite(pool)
+		pool.ExpectQuery(expectedCountAdministratorsSQL).
+			WithArgs("auth", "ManageUsers").
+			WillReturnRows(pool.NewRows([]string{"count"}).AddRow(int64(0)))
+		pool.ExpectRollback()
+
+		_, err := store.UpdateRole(ctx, roleID, template)
+		assert.ErrorIs(t, err, services.ErrLastAdministratorRole)
+		require.NoError(t, pool.ExpectationsWereMet())
+	})
+
+	t.Run("returns ErrNoRoleFound when no custom role matches", func(t *testing.T) {
+		store, pool := newTestStore(t)
+
+		pool.ExpectBegin()
+		pool.ExpectExec(expectedUpdateRoleSQL).
+			WithArgs(template.Name, template.Description, pgxmock.AnyArg(), roleID, false).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+		pool.ExpectRollback()
+
+		_, err := store.UpdateRole(ctx, roleID, template)
+		assert.ErrorIs(t, err, services.ErrNoRoleFound)
+		require.NoError(t, pool.ExpectationsWereMet())
+	})
+}
+
+func TestStore_DeleteRole(t *testing.T) {
+	var (
+		userID = uuid.Must(uuid.NewV4())
+		ctx    = authenticatedContext(userID)
+		roleID = int32(12)
+	)
+
+	expectRoleLookup := func(pool pgxmock.PgxPoolIface) {
+		pool.ExpectQuery(expectedGetRoleSQL).WithArgs(roleID, 1).WillReturnRows(
+			pool.NewRows(roleRowColumns()).AddRow(roleID, "Query Curator", "", false, time.Time{}, time.Time{}),
+		)
+		pool.ExpectQuery(expectedGetRolePermissionsSQL).WithArgs(roleID).WillReturnRows(
+			pool.NewRows(rolePermissionRowColumns()),
+		)
+	}
+
+	t.Run("deletes an unassigned role and writes an audit entry", func(t *testing.T) {
+		store, pool := newTestStore(t)
+
+		pool.ExpectBegin()
+		expectRoleLookup(pool)
+		pool.ExpectQuery(expectedRoleInUseSQL).
+			WithArgs(roleID, roleID).
+			WillReturnRows(pool.NewRows([]string{"exists"}).AddRow(false))
+		pool.ExpectExec(expectedDeleteRoleSQL).
+			WithArgs(roleID, false).
+			WillReturnResult(pgxmock.NewResult("DELETE", 1))
+		expectAuditInsert(pool, userID, model.AuditLogActionDeleteRole)
+		pool.ExpectCommit()
+
+		require.NoError(t, store.DeleteRole(ctx, roleID))
+		require.NoError(t, pool.ExpectationsWereMet())
+	})
+
+	t.Run("rejects a role that is still assigned", func(t *testing.T) {
+		store, pool := newTestStore(t)
+
+		pool.ExpectBegin()
+		expectRoleLookup(pool)
+		pool.ExpectQuery(expectedRoleInUseSQL).
+			WithArgs(roleID, roleID).
+			WillReturnRows(pool.NewRows([]string{"exists"}).AddRow(true))
+		pool.ExpectRollback()
+
+		assert.ErrorIs(t, store.DeleteRole(ctx, roleID), services.ErrRoleInUse)
+		require.NoError(t, pool.ExpectationsWereMet())
+	})
+}
@@ -37,6 +37,9 @@ type Identity interface {
 	GetRole(ctx context.Context, id int32) (services.Role, error)
 	GetPermission(ctx context.Context, id int) (services.Permission, error)
 	ListRoles(ctx context.Context, queryFilters params.Filters, sortItems params.SortItems) ([]services.Role, error)
+	CreateRole(ctx context.Context, template services.RoleTemplate) (services.Role, error)
+	UpdateRole(ctx context.Context, id int32, template services.RoleTemplate) (services.Role, error)
+	DeleteRole(ctx context.Context, id int32) error
 }
 
 // Handlers is a dependency injection container for identity handlers.
@@ -114,11 +117,84 @@ func (s *Handlers) ListRoles(response http.ResponseWriter, request *http.Request
 	responses.WriteBasic(ctx, BuildRoleListView(roles), http.StatusOK, response)
 }
 
+func (s *Handlers) CreateRole(response http.ResponseWriter, request *http.Request) {
+	var (
+		ctx         = request.Context()
+		roleRequest RoleRequest
+	)
+
+	if err := api.ReadJSONRequestPayloadLimited(&roleRequest, request); err != nil {
+		responses.WriteError(ctx, http.StatusBadRequest, api.ErrorResponsePayloadUnmarshalError, response)
+		return
+	}
+
+	role, err := s.identity.CreateRole(ctx, roleRequest.RoleTemplate())
+	if err != nil {
+		handleIdentityError(request, response, err)
+		return
+	}
+
+	responses.WriteBasic(ctx, BuildRoleView(role), http.StatusCreated, response)
+}
+
+func (s *Handlers) UpdateRole(response http.ResponseWriter, request *http.Request) {
+	var (
+		ctx         = request.Context()
+		rawRoleID   = mux.Vars(request)[api.URIPathVariableRoleID]
+		roleRequest RoleRequest
+	)
+
+	roleID, err := strconv.ParseInt(rawRoleID, 10, 32)
+	if err != nil {
+		responses.WriteError(ctx, http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, response)
+		return
+	}
+
+	if err := api.ReadJSONRequestPayloadLimited(&roleRequest, request); err != nil {
+		responses.WriteError(ctx, http.StatusBadRequest, api.ErrorResponsePayloadUnmarshalError, response)
+		return
+	}
+
+	role, err := s.identity.UpdateRole(ctx, int32(roleID), roleRequest.RoleTemplate())
+	if err != nil {
+		handleIdentityError(request, response, err)
+		return
+	}
+
+	responses.WriteBasic(ctx, BuildRoleView(role), http.StatusOK, response)
+}
+
+func (s *Handlers) DeleteRole(response http.ResponseWriter, request *http.Request) {
+	var (
+		ctx       = request.Context()
+		rawRoleID = mux.Vars(request)[api.URIPathVariableRoleID]
+	)
+
+	roleID, err := strconv.ParseInt(rawRoleID, 10, 32)
+	if err != nil {
+		responses.WriteError(ctx, http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, response)
+		return
+	}
+
+	if err := s.identity.DeleteRole(ctx, int32(roleID)); err != nil {
+		handleIdentityError(request, response, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusNoContent)
+}
+
 func handleIdentityError(request *http.Request, response http.ResponseWriter, err error) {
 	var ctx = request.Context()
 
 	if errors.Is(err, services.ErrNoRoleFound) || errors.Is(err, services.ErrNoPermissionFound) {
 		responses.WriteError(ctx, http.StatusNotFound, api.ErrorResponseDetailsResourceNotFound, response)
+	} else if errors.Is(err, services.ErrInvalidRoleName) || errors.Is(err, services.ErrInvalidPermission) {
+		responses.WriteError(ctx, http.StatusBadRequest, err.Error(), response)
+	} else if errors.Is(err, services.ErrBuiltInRole) {
+		responses.WriteError(ctx, http.StatusForbidden, err.Error(), response)
+	} else if errors.Is(err, services.ErrDuplicateRoleName) || errors.Is(err, services.ErrRoleInUse) || errors.Is(err, services.ErrLastAdministratorRole) {
+		responses.WriteError(ctx, http.StatusConflict, err.Error(), response)
 	} else if errors.Is(err, context.DeadlineExceeded) {
 		responses.WriteError(ctx, http.StatusInternalServerError, api.ErrorResponseRequestTimeout, response)
 	} else {
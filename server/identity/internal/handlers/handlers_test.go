@@ -22,6 +22,7 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/gorilla/mux"
@@ -286,3 +287,215 @@ func TestHandlers_ListRoles(t *testing.T) {
 		})
 	}
 }
+
+func newJSONRequestWithVars(t *testing.T, method, target, body string, vars map[string]string) *http.Request {
+	t.Helper()
+	req, err := http.NewRequest(method, target, strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	return mux.SetURLVars(req, vars)
+}
+
+func TestHandlers_CreateRole(t *testing.T) {
+	var (
+		template = services.RoleTemplate{Name: "Query Curator", Description: "Manages saved queries", PermissionIDs: []int32{4, 9}}
+		created  = services.Role{
+			ID:          12,
+			Name:        "Query Curator",
+			Description: "Manages saved queries",
+			Permissions: []services.Permission{{ID: 4, Authority: "graphdb", Name: "Read"}, {ID: 9, Authority: "saved_queries", Name: "Write"}},
+		}
+		validBody = `{"name":"Query Curator","description":"Manages saved queries","permissions":[4,9]}`
+	)
+
+	tests := []struct {
+		name       string
+		body       string
+		expect     func(m *mocks.MockIdentity, ctx context.Context)
+		wantStatus int
+		assertBody func(t *testing.T, body []byte)
+	}{
+		{
+			name: "returns 201 with the created role",
+			body: validBody,
+			expect: func(m *mocks.MockIdentity, ctx context.Context) {
+				m.EXPECT().CreateRole(ctx, template).Return(created, nil)
+			},
+			wantStatus: http.StatusCreated,
+			assertBody: func(t *testing.T, body []byte) {
+				var envelope struct {
+					Data handlers.RoleView `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(body, &envelope))
+				assert.Equal(t, created.ID, envelope.Data.ID)
+				assert.False(t, envelope.Data.BuiltIn)
+				assert.Len(t, envelope.Data.Permissions, 2)
+			},
+		},
+		{
+			name:       "returns 400 for a malformed payload",
+			body:       `{"name":`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "returns 400 for unknown permissions",
+			body: validBody,
+			expect: func(m *mocks.MockIdentity, ctx context.Context) {
+				m.EXPECT().CreateRole(ctx, template).Return(services.Role{}, services.ErrInvalidPermission)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "returns 409 for a duplicate role name",
+			body: validBody,
+			expect: func(m *mocks.MockIdentity, ctx context.Context) {
+				m.EXPECT().CreateRole(ctx, template).Return(services.Role{}, services.ErrDuplicateRoleName)
+			},
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				identityMock = mocks.NewMockIdentity(t)
+				handlerSet   = handlers.NewHandlersContainer(identityMock)
+				recorder     = httptest.NewRecorder()
+				request      = newJSONRequestWithVars(t, http.MethodPost, "/api/v2/roles", tt.body, nil)
+			)
+
+			if tt.expect != nil {
+				tt.expect(identityMock, request.Context())
+			}
+
+			handlerSet.CreateRole(recorder, request)
+
+			assert.Equal(t, tt.wantStatus, recorder.Code)
+			if tt.assertBody != nil {
+				tt.assertBody(t, recorder.Body.Bytes())
+			}
+		})
+	}
+}
+
+func TestHandlers_UpdateRole(t *testing.T) {
+	var (
+		template = services.RoleTemplate{Name: "Graph Reader", PermissionIDs: []int32{4}}
+		updated  = services.Role{ID: 12, Name: "Graph Reader"}
+		body     = `{"name":"Graph Reader","permissions":[4]}`
+	)
+
+	tests := []struct {
+		name       string
+		rawID      string
+		expect     func(m *mocks.MockIdentity, ctx context.Context)
+		wantStatus int
+	}{
+		{
+			name:  "returns 200 with the updated role",
+			rawID: "12",
+			expect: func(m *mocks.MockIdentity, ctx context.Context) {
+				m.EXPECT().UpdateRole(ctx, int32(12), template).Return(updated, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "returns 400 for a malformed role ID",
+			rawID:      "not-an-int",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "returns 403 for built-in roles",
+			rawID: "1",
+			expect: func(m *mocks.MockIdentity, ctx context.Context) {
+				m.EXPECT().UpdateRole(ctx, int32(1), template).Return(services.Role{}, services.ErrBuiltInRole)
+			},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:  "returns 409 when the last administrator would be removed",
+			rawID: "12",
+			expect: func(m *mocks.MockIdentity, ctx context.Context) {
+				m.EXPECT().UpdateRole(ctx, int32(12), template).Return(services.Role{}, services.ErrLastAdministratorRole)
+			},
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				identityMock = mocks.NewMockIdentity(t)
+				handlerSet   = handlers.NewHandlersContainer(identityMock)
+				recorder     = httptest.NewRecorder()
+				request      = newJSONRequestWithVars(t, http.MethodPut, "/api/v2/roles/"+tt.rawID, body, map[string]string{"role_id": tt.rawID})
+			)
+
+			if tt.expect != nil {
+				tt.expect(identityMock, request.Context())
+			}
+
+			handlerSet.UpdateRole(recorder, request)
+
+			assert.Equal(t, tt.wantStatus, recorder.Code)
+		})
+	}
+}
+
+func TestHandlers_DeleteRole(t *testing.T) {
+	tests := []struct {
+		name       string
+		rawID      string
+		expect     func(m *mocks.MockIdentity, ctx context.Context)
+		wantStatus int
+	}{
+		{
+			name:  "returns 204 when the role is deleted",
+			rawID: "12",
+			expect: func(m *mocks.MockIdentity, ctx context.Context) {
+				m.EXPECT().DeleteRole(ctx, int32(12)).Return(nil)
+			},
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "returns 400 for a malformed role ID",
+			rawID:      "not-an-int",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "returns 404 when the role does not exist",
+			rawID: "12",
+			expect: func(m *mocks.MockIdentity, ctx context.Context) {
+				m.EXPECT().DeleteRole(ctx, int32(12)).Return(services.ErrNoRoleFound)
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:  "returns 409 when the role is still assigned",
+			rawID: "12",
+			expect: func(m *mocks.MockIdentity, ctx context.Context) {
+				m.EXPECT().DeleteRole(ctx, int32(12)).Return(services.ErrRoleInUse)
+			},
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				identityMock = mocks.NewMockIdentity(t)
+				handlerSet   = handlers.NewHandlersContainer(identityMock)
+				recorder     = httptest.NewRecorder()
+				request      = newRequestWithVars(t, "/api/v2/roles/"+tt.rawID, map[string]string{"role_id": tt.rawID})
+			)
+
+			if tt.expect != nil {
+				tt.expect(identityMock, request.Context())
+			}
+
+			handlerSet.DeleteRole(recorder, request)
+
+			assert.Equal(t, tt.wantStatus, recorder.Code)
+		})
+	}
+}
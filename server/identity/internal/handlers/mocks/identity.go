@@ -54,6 +54,129 @@ func (_m *MockIdentity) EXPECT() *MockIdentity_Expecter {
 	return &MockIdentity_Expecter{mock: &_m.Mock}
 }
 
+// CreateRole provides a mock function for the type MockIdentity
+func (_mock *MockIdentity) CreateRole(ctx context.Context, template services.RoleTemplate) (services.Role, error) {
+	ret := _mock.Called(ctx, template)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRole")
+	}
+
+	var r0 services.Role
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.RoleTemplate) (services.Role, error)); ok {
+		return returnFunc(ctx, template)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.RoleTemplate) services.Role); ok {
+		r0 = returnFunc(ctx, template)
+	} else {
+		r0 = ret.Get(0).(services.Role)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.RoleTemplate) error); ok {
+		r1 = returnFunc(ctx, template)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIdentity_CreateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRole'
+type MockIdentity_CreateRole_Call struct {
+	*mock.Call
+}
+
+// CreateRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - template services.RoleTemplate
+func (_e *MockIdentity_Expecter) CreateRole(ctx interface{}, template interface{}) *MockIdentity_CreateRole_Call {
+	return &MockIdentity_CreateRole_Call{Call: _e.mock.On("CreateRole", ctx, template)}
+}
+
+func (_c *MockIdentity_CreateRole_Call) Run(run func(ctx context.Context, template services.RoleTemplate)) *MockIdentity_CreateRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.RoleTemplate
+		if args[1] != nil {
+			arg1 = args[1].(services.RoleTemplate)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIdentity_CreateRole_Call) Return(role services.Role, err error) *MockIdentity_CreateRole_Call {
+	_c.Call.Return(role, err)
+	return _c
+}
+
+func (_c *MockIdentity_CreateRole_Call) RunAndReturn(run func(ctx context.Context, template services.RoleTemplate) (services.Role, error)) *MockIdentity_CreateRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRole provides a mock function for the type MockIdentity
+func (_mock *MockIdentity) DeleteRole(ctx context.Context, id int32) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRole")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIdentity_DeleteRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRole'
+type MockIdentity_DeleteRole_Call struct {
+	*mock.Call
+}
+
+// DeleteRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockIdentity_Expecter) DeleteRole(ctx interface{}, id interface{}) *MockIdentity_DeleteRole_Call {
+	return &MockIdentity_DeleteRole_Call{Call: _e.mock.On("DeleteRole", ctx, id)}
+}
+
+func (_c *MockIdentity_DeleteRole_Call) Run(run func(ctx context.Context, id int32)) *MockIdentity_DeleteRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int32
+		if args[1] != nil {
+			arg1 = args[1].(int32)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIdentity_DeleteRole_Call) Return(err error) *MockIdentity_DeleteRole_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIdentity_DeleteRole_Call) RunAndReturn(run func(ctx context.Context, id int32) error) *MockIdentity_DeleteRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPermission provides a mock function for the type MockIdentity
 func (_mock *MockIdentity) GetPermission(ctx context.Context, id int) (services.Permission, error) {
 	ret := _mock.Called(ctx, id)
@@ -259,3 +382,75 @@ func (_c *MockIdentity_ListRoles_Call) RunAndReturn(run func(ctx context.Context
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpdateRole provides a mock function for the type MockIdentity
+func (_mock *MockIdentity) UpdateRole(ctx context.Context, id int32, template services.RoleTemplate) (services.Role, error) {
+	ret := _mock.Called(ctx, id, template)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRole")
+	}
+
+	var r0 services.Role
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int32, services.RoleTemplate) (services.Role, error)); ok {
+		return returnFunc(ctx, id, template)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int32, services.RoleTemplate) services.Role); ok {
+		r0 = returnFunc(ctx, id, template)
+	} else {
+		r0 = ret.Get(0).(services.Role)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int32, services.RoleTemplate) error); ok {
+		r1 = returnFunc(ctx, id, template)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIdentity_UpdateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRole'
+type MockIdentity_UpdateRole_Call struct {
+	*mock.Call
+}
+
+// UpdateRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+//   - template services.RoleTemplate
+func (_e *MockIdentity_Expecter) UpdateRole(ctx interface{}, id interface{}, template interface{}) *MockIdentity_UpdateRole_Call {
+	return &MockIdentity_UpdateRole_Call{Call: _e.mock.On("UpdateRole", ctx, id, template)}
+}
+
+func (_c *MockIdentity_UpdateRole_Call) Run(run func(ctx context.Context, id int32, template services.RoleTemplate)) *MockIdentity_UpdateRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int32
+		if args[1] != nil {
+			arg1 = args[1].(int32)
+		}
+		var arg2 services.RoleTemplate
+		if args[2] != nil {
+			arg2 = args[2].(services.RoleTemplate)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIdentity_UpdateRole_Call) Return(role services.Role, err error) *MockIdentity_UpdateRole_Call {
+	_c.Call.Return(role, err)
+	return _c
+}
+
+func (_c *MockIdentity_UpdateRole_Call) RunAndReturn(run func(ctx context.Context, id int32, template services.RoleTemplate) (services.Role, error)) *MockIdentity_UpdateRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -63,6 +63,7 @@ type RoleView struct {
 	ID          int32            `json:"id"`
 	Name        string           `json:"name"`
 	Description string           `json:"description"`
+	BuiltIn     bool             `json:"built_in"`
 	Permissions []PermissionView `json:"permissions"`
 	CreatedAt   time.Time        `json:"created_at"`
 	UpdatedAt   time.Time        `json:"updated_at"`
@@ -81,6 +82,7 @@ func BuildRoleView(role services.Role) RoleView {
 		ID:          role.ID,
 		Name:        role.Name,
 		Description: role.Description,
+		BuiltIn:     role.BuiltIn,
 		Permissions: permissions,
 		CreatedAt:   role.CreatedAt,
 		UpdatedAt:   role.UpdatedAt,
@@ -144,9 +146,25 @@ func (s RoleListView) ValidFilters() map[string]params.FilterableField {
 // middleware may order on. It reproduces the legacy GET /api/v2/roles contract.
 func (s RoleListView) IsSortable(field string) bool {
 	switch field {
-	case "name", "description", "id", "created_at", "updated_at", "deleted_at":
+	case "name", "description", "built_in", "id", "created_at", "updated_at", "deleted_at":
 		return true
 	default:
 		return false
 	}
 }
+
+// RoleRequest is the JSON body accepted when creating or replacing a custom role.
+type RoleRequest struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Permissions []int32 `json:"permissions"`
+}
+
+// RoleTemplate converts the request body into the services-layer template.
+func (s RoleRequest) RoleTemplate() services.RoleTemplate {
+	return services.RoleTemplate{
+		Name:          s.Name,
+		Description:   s.Description,
+		PermissionIDs: s.Permissions,
+	}
+}
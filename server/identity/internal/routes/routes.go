@@ -33,6 +33,9 @@ func Register(routerInst *router.Router, handler *handlers.Handlers) {
 	)
 
 	routerInst.GET("/api/v2/roles", handler.ListRoles).RequirePermissions(permissions.AuthManageSelf).WithFilters(roleList).WithSort(roleList)
+	routerInst.POST("/api/v2/roles", handler.CreateRole).RequirePermissions(permissions.AuthManageUsers)
 	routerInst.GET(fmt.Sprintf("/api/v2/roles/{%s}", api.URIPathVariableRoleID), handler.GetRole).RequirePermissions(permissions.AuthManageSelf)
+	routerInst.PUT(fmt.Sprintf("/api/v2/roles/{%s}", api.URIPathVariableRoleID), handler.UpdateRole).RequirePermissions(permissions.AuthManageUsers)
+	routerInst.DELETE(fmt.Sprintf("/api/v2/roles/{%s}", api.URIPathVariableRoleID), handler.DeleteRole).RequirePermissions(permissions.AuthManageUsers)
 	routerInst.GET(fmt.Sprintf("/api/v2/permissions/{%s}", api.URIPathVariablePermissionID), handler.GetPermission).RequirePermissions(permissions.AuthManageSelf)
 }
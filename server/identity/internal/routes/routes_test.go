@@ -49,7 +49,10 @@ func TestRegister(t *testing.T) {
 		path   string
 	}{
 		{http.MethodGet, "/api/v2/roles"},
+		{http.MethodPost, "/api/v2/roles"},
 		{http.MethodGet, "/api/v2/roles/1"},
+		{http.MethodPut, "/api/v2/roles/1"},
+		{http.MethodDelete, "/api/v2/roles/1"},
 		{http.MethodGet, "/api/v2/permissions/1"},
 	} {
 		req := httptest.NewRequest(tc.method, tc.path, nil)
@@ -79,7 +82,10 @@ func TestRegister_RoutesRequireAuthentication(t *testing.T) {
 		path   string
 	}{
 		{http.MethodGet, "/api/v2/roles"},
+		{http.MethodPost, "/api/v2/roles"},
 		{http.MethodGet, "/api/v2/roles/1"},
+		{http.MethodPut, "/api/v2/roles/1"},
+		{http.MethodDelete, "/api/v2/roles/1"},
 		{http.MethodGet, "/api/v2/permissions/1"},
 	} {
 		t.Run(tc.method+" "+tc.path, func(t *testing.T) {
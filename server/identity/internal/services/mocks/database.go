@@ -54,6 +54,129 @@ func (_m *MockDatabase) EXPECT() *MockDatabase_Expecter {
 	return &MockDatabase_Expecter{mock: &_m.Mock}
 }
 
+// CreateRole provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) CreateRole(ctx context.Context, template services.RoleTemplate) (services.Role, error) {
+	ret := _mock.Called(ctx, template)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRole")
+	}
+
+	var r0 services.Role
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.RoleTemplate) (services.Role, error)); ok {
+		return returnFunc(ctx, template)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, services.RoleTemplate) services.Role); ok {
+		r0 = returnFunc(ctx, template)
+	} else {
+		r0 = ret.Get(0).(services.Role)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, services.RoleTemplate) error); ok {
+		r1 = returnFunc(ctx, template)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDatabase_CreateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRole'
+type MockDatabase_CreateRole_Call struct {
+	*mock.Call
+}
+
+// CreateRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - template services.RoleTemplate
+func (_e *MockDatabase_Expecter) CreateRole(ctx interface{}, template interface{}) *MockDatabase_CreateRole_Call {
+	return &MockDatabase_CreateRole_Call{Call: _e.mock.On("CreateRole", ctx, template)}
+}
+
+func (_c *MockDatabase_CreateRole_Call) Run(run func(ctx context.Context, template services.RoleTemplate)) *MockDatabase_CreateRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 services.RoleTemplate
+		if args[1] != nil {
+			arg1 = args[1].(services.RoleTemplate)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDatabase_CreateRole_Call) Return(role services.Role, err error) *MockDatabase_CreateRole_Call {
+	_c.Call.Return(role, err)
+	return _c
+}
+
+func (_c *MockDatabase_CreateRole_Call) RunAndReturn(run func(ctx context.Context, template services.RoleTemplate) (services.Role, error)) *MockDatabase_CreateRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRole provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) DeleteRole(ctx context.Context, id int32) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRole")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDatabase_DeleteRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRole'
+type MockDatabase_DeleteRole_Call struct {
+	*mock.Call
+}
+
+// DeleteRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockDatabase_Expecter) DeleteRole(ctx interface{}, id interface{}) *MockDatabase_DeleteRole_Call {
+	return &MockDatabase_DeleteRole_Call{Call: _e.mock.On("DeleteRole", ctx, id)}
+}
+
+func (_c *MockDatabase_DeleteRole_Call) Run(run func(ctx context.Context, id int32)) *MockDatabase_DeleteRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int32
+		if args[1] != nil {
+			arg1 = args[1].(int32)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDatabase_DeleteRole_Call) Return(err error) *MockDatabase_DeleteRole_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDatabase_DeleteRole_Call) RunAndReturn(run func(ctx context.Context, id int32) error) *MockDatabase_DeleteRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPermission provides a mock function for the type MockDatabase
 func (_mock *MockDatabase) GetPermission(ctx context.Context, id int) (services.Permission, error) {
 	ret := _mock.Called(ctx, id)
@@ -186,6 +309,68 @@ func (_c *MockDatabase_GetRole_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
+// ListPermissions provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) ListPermissions(ctx context.Context) ([]services.Permission, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPermissions")
+	}
+
+	var r0 []services.Permission
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]services.Permission, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []services.Permission); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]services.Permission)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDatabase_ListPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPermissions'
+type MockDatabase_ListPermissions_Call struct {
+	*mock.Call
+}
+
+// ListPermissions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDatabase_Expecter) ListPermissions(ctx interface{}) *MockDatabase_ListPermissions_Call {
+	return &MockDatabase_ListPermissions_Call{Call: _e.mock.On("ListPermissions", ctx)}
+}
+
+func (_c *MockDatabase_ListPermissions_Call) Run(run func(ctx context.Context)) *MockDatabase_ListPermissions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDatabase_ListPermissions_Call) Return(permissions []services.Permission, err error) *MockDatabase_ListPermissions_Call {
+	_c.Call.Return(permissions, err)
+	return _c
+}
+
+func (_c *MockDatabase_ListPermissions_Call) RunAndReturn(run func(ctx context.Context) ([]services.Permission, error)) *MockDatabase_ListPermissions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListRoles provides a mock function for the type MockDatabase
 func (_mock *MockDatabase) ListRoles(ctx context.Context, queryFilters params.Filters, sortItems params.SortItems) ([]services.Role, error) {
 	ret := _mock.Called(ctx, queryFilters, sortItems)
@@ -259,3 +444,75 @@ func (_c *MockDatabase_ListRoles_Call) RunAndReturn(run func(ctx context.Context
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpdateRole provides a mock function for the type MockDatabase
+func (_mock *MockDatabase) UpdateRole(ctx context.Context, id int32, template services.RoleTemplate) (services.Role, error) {
+	ret := _mock.Called(ctx, id, template)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRole")
+	}
+
+	var r0 services.Role
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int32, services.RoleTemplate) (services.Role, error)); ok {
+		return returnFunc(ctx, id, template)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int32, services.RoleTemplate) services.Role); ok {
+		r0 = returnFunc(ctx, id, template)
+	} else {
+		r0 = ret.Get(0).(services.Role)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int32, services.RoleTemplate) error); ok {
+		r1 = returnFunc(ctx, id, template)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDatabase_UpdateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRole'
+type MockDatabase_UpdateRole_Call struct {
+	*mock.Call
+}
+
+// UpdateRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+//   - template services.RoleTemplate
+func (_e *MockDatabase_Expecter) UpdateRole(ctx interface{}, id interface{}, template interface{}) *MockDatabase_UpdateRole_Call {
+	return &MockDatabase_UpdateRole_Call{Call: _e.mock.On("UpdateRole", ctx, id, template)}
+}
+
+func (_c *MockDatabase_UpdateRole_Call) Run(run func(ctx context.Context, id int32, template services.RoleTemplate)) *MockDatabase_UpdateRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int32
+		if args[1] != nil {
+			arg1 = args[1].(int32)
+		}
+		var arg2 services.RoleTemplate
+		if args[2] != nil {
+			arg2 = args[2].(services.RoleTemplate)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDatabase_UpdateRole_Call) Return(role services.Role, err error) *MockDatabase_UpdateRole_Call {
+	_c.Call.Return(role, err)
+	return _c
+}
+
+func (_c *MockDatabase_UpdateRole_Call) RunAndReturn(run func(ctx context.Context, id int32, template services.RoleTemplate) (services.Role, error)) *MockDatabase_UpdateRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -22,6 +22,8 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/specterops/bloodhound/packages/go/params"
@@ -43,19 +45,46 @@ type Role struct {
 	ID          int32
 	Name        string
 	Description string
+	BuiltIn     bool
 	Permissions []Permission
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 	DeletedAt   sql.NullTime
 }
 
-// ErrNoRoleFound indicates that no role with the given ID was found.
-var ErrNoRoleFound = errors.New("no role was found")
+// RoleTemplate carries the caller-supplied attributes of a custom role. It is
+// used for both creation and full replacement of an existing custom role.
+type RoleTemplate struct {
+	Name          string
+	Description   string
+	PermissionIDs []int32
+}
+
+var (
+	// ErrNoRoleFound indicates that no role with the given ID was found.
+	ErrNoRoleFound = errors.New("no role was found")
+	// ErrBuiltInRole indicates an attempt to modify or delete one of the roles shipped with BloodHound.
+	ErrBuiltInRole = errors.New("built-in roles cannot be modified")
+	// ErrInvalidRoleName indicates that the supplied role name is empty.
+	ErrInvalidRoleName = errors.New("role name must not be empty")
+	// ErrDuplicateRoleName indicates that another role already uses the supplied name.
+	ErrDuplicateRoleName = errors.New("a role with this name already exists")
+	// ErrInvalidPermission indicates that the role template references a permission that does not exist.
+	ErrInvalidPermission = errors.New("role references an unknown permission")
+	// ErrRoleInUse indicates that the role is still assigned to users or used as an SSO provider default role.
+	ErrRoleInUse = errors.New("role is assigned to users or SSO providers")
+	// ErrLastAdministratorRole indicates that the change would leave no enabled user able to manage users and roles.
+	ErrLastAdministratorRole = errors.New("change would remove the last administrator-capable role assignment")
+)
 
 type Database interface {
 	GetRole(ctx context.Context, id int32) (Role, error)
 	GetPermission(ctx context.Context, id int) (Permission, error)
+	ListPermissions(ctx context.Context) ([]Permission, error)
 	ListRoles(ctx context.Context, queryFilters params.Filters, sortItems params.SortItems) ([]Role, error)
+	CreateRole(ctx context.Context, template RoleTemplate) (Role, error)
+	UpdateRole(ctx context.Context, id int32, template RoleTemplate) (Role, error)
+	DeleteRole(ctx context.Context, id int32) error
 }
 
 type Service struct {
@@ -77,3 +106,85 @@ func (s *Service) GetPermission(ctx context.Context, id int) (Permission, error)
 func (s *Service) ListRoles(ctx context.Context, queryFilters params.Filters, sortItems params.SortItems) ([]Role, error) {
 	return s.db.ListRoles(ctx, queryFilters, sortItems)
 }
+
+// CreateRole validates the template against the known permissions and persists
+// a new custom role. Custom roles are immediately assignable to users and SSO
+// provider role mappings.
+func (s *Service) CreateRole(ctx context.Context, template RoleTemplate) (Role, error) {
+	normalized, err := s.validateRoleTemplate(ctx, template)
+	if err != nil {
+		return Role{}, err
+	}
+
+	return s.db.CreateRole(ctx, normalized)
+}
+
+// UpdateRole replaces the name, description and permission set of an existing
+// custom role. Built-in roles are rejected with ErrBuiltInRole.
+func (s *Service) UpdateRole(ctx context.Context, id int32, template RoleTemplate) (Role, error) {
+	existing, err := s.db.GetRole(ctx, id)
+	if err != nil {
+		return Role{}, err
+	}
+	if existing.BuiltIn {
+		return Role{}, ErrBuiltInRole
+	}
+
+	normalized, err := s.validateRoleTemplate(ctx, template)
+	if err != nil {
+		return Role{}, err
+	}
+
+	return s.db.UpdateRole(ctx, id, normalized)
+}
+
+// DeleteRole removes an unassigned custom role. Built-in roles are rejected
+// with ErrBuiltInRole.
+func (s *Service) DeleteRole(ctx context.Context, id int32) error {
+	existing, err := s.db.GetRole(ctx, id)
+	if err != nil {
+		return err
+	}
+	if existing.BuiltIn {
+		return ErrBuiltInRole
+	}
+
+	return s.db.DeleteRole(ctx, id)
+}
+
+// validateRoleTemplate trims the role name and ensures every referenced
+// permission exists. Duplicate permission IDs are collapsed and the result is
+// sorted so that persisted grants are deterministic.
+func (s *Service) validateRoleTemplate(ctx context.Context, template RoleTemplate) (RoleTemplate, error) {
+	var (
+		normalized = RoleTemplate{
+			Name:        strings.TrimSpace(template.Name),
+			Description: strings.TrimSpace(template.Description),
+		}
+		knownPermissions = make(map[int32]struct{})
+	)
+
+	if normalized.Name == "" {
+		return RoleTemplate{}, ErrInvalidRoleName
+	}
+
+	permissions, err := s.db.ListPermissions(ctx)
+	if err != nil {
+		return RoleTemplate{}, err
+	}
+	for _, permission := range permissions {
+		knownPermissions[permission.ID] = struct{}{}
+	}
+
+	for _, permissionID := range template.PermissionIDs {
+		if _, isKnown := knownPermissions[permissionID]; !isKnown {
+			return RoleTemplate{}, ErrInvalidPermission
+		}
+	}
+
+	normalized.PermissionIDs = slices.Clone(template.PermissionIDs)
+	slices.Sort(normalized.PermissionIDs)
+	normalized.PermissionIDs = slices.Compact(normalized.PermissionIDs)
+
+	return normalized, nil
+}
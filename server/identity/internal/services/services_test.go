@@ -211,3 +211,203 @@ func TestService_ListRoles(t *testing.T) {
 		})
 	}
 }
+
+func TestService_CreateRole(t *testing.T) {
+	var (
+		ctx           = context.Background()
+		unexpectedErr = errors.New("connection refused")
+		permissions   = []services.Permission{
+			{ID: 4, Authority: "graphdb", Name: "Read"},
+			{ID: 9, Authority: "saved_queries", Name: "Write"},
+		}
+		created = services.Role{ID: 12, Name: "Query Curator", Permissions: permissions}
+	)
+
+	tests := []struct {
+		name     string
+		template services.RoleTemplate
+		expect   func(databaseMock *mocks.MockDatabase)
+		wantErr  error
+	}{
+		{
+			name:     "trims, deduplicates and persists a valid template",
+			template: services.RoleTemplate{Name: "  Query Curator ", PermissionIDs: []int32{9, 4, 9}},
+			expect: func(databaseMock *mocks.MockDatabase) {
+				databaseMock.EXPECT().ListPermissions(ctx).Return(permissions, nil)
+				databaseMock.EXPECT().CreateRole(ctx, services.RoleTemplate{Name: "Query Curator", PermissionIDs: []int32{4, 9}}).Return(created, nil)
+			},
+		},
+		{
+			name:     "rejects an empty role name",
+			template: services.RoleTemplate{Name: "   ", PermissionIDs: []int32{4}},
+			wantErr:  services.ErrInvalidRoleName,
+		},
+		{
+			name:     "rejects unknown permission IDs",
+			template: services.RoleTemplate{Name: "Query Curator", PermissionIDs: []int32{4, 99}},
+			expect: func(databaseMock *mocks.MockDatabase) {
+				databaseMock.EXPECT().ListPermissions(ctx).Return(permissions, nil)
+			},
+			wantErr: services.ErrInvalidPermission,
+		},
+		{
+			name:     "propagates duplicate role names from the database",
+			template: services.RoleTemplate{Name: "Query Curator", PermissionIDs: []int32{4}},
+			expect: func(databaseMock *mocks.MockDatabase) {
+				databaseMock.EXPECT().ListPermissions(ctx).Return(permissions, nil)
+				databaseMock.EXPECT().CreateRole(ctx, services.RoleTemplate{Name: "Query Curator", PermissionIDs: []int32{4}}).Return(services.Role{}, services.ErrDuplicateRoleName)
+			},
+			wantErr: services.ErrDuplicateRoleName,
+		},
+		{
+			name:     "propagates permission lookup errors",
+			template: services.RoleTemplate{Name: "Query Curator"},
+			expect: func(databaseMock *mocks.MockDatabase) {
+				databaseMock.EXPECT().ListPermissions(ctx).Return(nil, unexpectedErr)
+			},
+			wantErr: unexpectedErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				databaseMock = mocks.NewMockDatabase(t)
+				svc          = services.NewService(databaseMock)
+			)
+
+			if tt.expect != nil {
+				tt.expect(databaseMock)
+			}
+
+			result, err := svc.CreateRole(ctx, tt.template)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, created, result)
+			}
+		})
+	}
+}
+
+func TestService_UpdateRole(t *testing.T) {
+	var (
+		ctx         = context.Background()
+		roleID      = int32(12)
+		permissions = []services.Permission{{ID: 4, Authority: "graphdb", Name: "Read"}}
+		template    = services.RoleTemplate{Name: "Graph Reader", PermissionIDs: []int32{4}}
+		updated     = services.Role{ID: roleID, Name: "Graph Reader", Permissions: permissions}
+	)
+
+	tests := []struct {
+		name    string
+		expect  func(databaseMock *mocks.MockDatabase)
+		wantErr error
+	}{
+		{
+			name: "replaces a custom role",
+			expect: func(databaseMock *mocks.MockDatabase) {
+				databaseMock.EXPECT().GetRole(ctx, roleID).Return(services.Role{ID: roleID, Name: "Query Curator"}, nil)
+				databaseMock.EXPECT().ListPermissions(ctx).Return(permissions, nil)
+				databaseMock.EXPECT().UpdateRole(ctx, roleID, template).Return(updated, nil)
+			},
+		},
+		{
+			name: "rejects built-in roles",
+			expect: func(databaseMock *mocks.MockDatabase) {
+				databaseMock.EXPECT().GetRole(ctx, roleID).Return(services.Role{ID: roleID, Name: "Administrator", BuiltIn: true}, nil)
+			},
+			wantErr: services.ErrBuiltInRole,
+		},
+		{
+			name: "propagates ErrNoRoleFound",
+			expect: func(databaseMock *mocks.MockDatabase) {
+				databaseMock.EXPECT().GetRole(ctx, roleID).Return(services.Role{}, services.ErrNoRoleFound)
+			},
+			wantErr: services.ErrNoRoleFound,
+		},
+		{
+			name: "propagates ErrLastAdministratorRole",
+			expect: func(databaseMock *mocks.MockDatabase) {
+				databaseMock.EXPECT().GetRole(ctx, roleID).Return(services.Role{ID: roleID, Name: "Query Curator"}, nil)
+				databaseMock.EXPECT().ListPermissions(ctx).Return(permissions, nil)
+				databaseMock.EXPECT().UpdateRole(ctx, roleID, template).Return(services.Role{}, services.ErrLastAdministratorRole)
+			},
+			wantErr: services.ErrLastAdministratorRole,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				databaseMock = mocks.NewMockDatabase(t)
+				svc          = services.NewService(databaseMock)
+			)
+
+			tt.expect(databaseMock)
+
+			result, err := svc.UpdateRole(ctx, roleID, template)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, updated, result)
+			}
+		})
+	}
+}
+
+func TestService_DeleteRole(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		roleID = int32(12)
+	)
+
+	tests := []struct {
+		name    string
+		expect  func(databaseMock *mocks.MockDatabase)
+		wantErr error
+	}{
+		{
+			name: "deletes a custom role",
+			expect: func(databaseMock *mocks.MockDatabase) {
+				databaseMock.EXPECT().GetRole(ctx, roleID).Return(services.Role{ID: roleID, Name: "Query Curator"}, nil)
+				databaseMock.EXPECT().DeleteRole(ctx, roleID).Return(nil)
+			},
+		},
+		{
+			name: "rejects built-in roles",
+			expect: func(databaseMock *mocks.MockDatabase) {
+				databaseMock.EXPECT().GetRole(ctx, roleID).Return(services.Role{ID: roleID, Name: "Auditor", BuiltIn: true}, nil)
+			},
+			wantErr: services.ErrBuiltInRole,
+		},
+		{
+			name: "propagates ErrRoleInUse",
+			expect: func(databaseMock *mocks.MockDatabase) {
+				databaseMock.EXPECT().GetRole(ctx, roleID).Return(services.Role{ID: roleID, Name: "Query Curator"}, nil)
+				databaseMock.EXPECT().DeleteRole(ctx, roleID).Return(services.ErrRoleInUse)
+			},
+			wantErr: services.ErrRoleInUse,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				databaseMock = mocks.NewMockDatabase(t)
+				svc          = services.NewService(databaseMock)
+			)
+
+			tt.expect(databaseMock)
+
+			err := svc.DeleteRole(ctx, roleID)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package administrators holds the user management checks shared by the server
+// modules that write users or roles directly.
+package administrators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/huandu/go-sqlbuilder"
+	"github.com/jackc/pgx/v5"
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
+)
+
+// Querier runs a query against the database or an open transaction.
+type Querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+// CountEnabled returns the number of enabled users holding a role that grants
+// auth:ManageUsers. Callers evaluate it inside the transaction after applying a
+// change so that the count observes the post-change state.
+func CountEnabled(ctx context.Context, querier Querier) (int64, error) {
+	var (
+		manageUsers = auth.Permissions().AuthManageUsers
+		sb          = sqlbuilder.PostgreSQL.NewSelectBuilder()
+	)
+
+	sb.Select("count(DISTINCT ur.user_id)")
+	sb.From("users_roles ur")
+	sb.Join("users u", "u.id = ur.user_id")
+	sb.Join("roles_permissions rp", "rp.role_id = ur.role_id")
+	sb.Join("permissions p", "p.id = rp.permission_id")
+	sb.Where(
+		sb.Equal("p.authority", manageUsers.Authority),
+		sb.Equal("p.name", manageUsers.Name),
+		"u.is_disabled IS NOT TRUE",
+	)
+
+	sqlQuery, args := sb.Build()
+
+	rows, err := querier.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return 0, fmt.Errorf("counting administrators: %w", err)
+	}
+	count, err := pgx.CollectExactlyOneRow(rows, pgx.RowTo[int64])
+	if err != nil {
+		return 0, fmt.Errorf("counting administrators: %w", err)
+	}
+
+	return count, nil
+}
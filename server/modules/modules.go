@@ -31,6 +31,7 @@ import (
 	"github.com/specterops/bloodhound/server/featureflags"
 	"github.com/specterops/bloodhound/server/graphdb"
 	"github.com/specterops/bloodhound/server/identity"
+	"github.com/specterops/bloodhound/server/scim"
 	"github.com/specterops/dawgs/graph"
 )
 
@@ -77,4 +78,5 @@ func Register(deps Deps) {
 	featureflags.Register(deps.Router, deps.Pool)
 	graphdb.Register(deps.Router, deps.Pool, deps.Graph, deps.RateLimitMiddleware, deps.DogTags)
 	extensions.Register(deps.Router, deps.Pool, deps.RateLimitMiddleware)
+	scim.Register(deps.Router, deps.Pool, deps.RateLimitMiddleware)
 }
@@ -0,0 +1,463 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/huandu/go-sqlbuilder"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/bhctx"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/server/internal/administrators"
+	"github.com/specterops/bloodhound/server/scim/internal/services"
+)
+
+const (
+	tableAuditLogs        = "audit_logs"
+	tableAuthTokens       = "auth_tokens"
+	tableSCIMGroupMembers = "scim_group_members"
+	tableSCIMGroupRoles   = "scim_group_roles"
+	tableSCIMGroups       = "scim_groups"
+	tableSCIMTokens       = "scim_tokens"
+	tableSCIMUserRoles    = "scim_user_roles"
+	tableSCIMUsers        = "scim_users"
+	tableUserSessions     = "user_sessions"
+	tableUsers            = "users"
+	tableUsersRoles       = "users_roles"
+
+	// scimActorNamePrefix distinguishes SCIM tokens from users in the actor_name column of audit log entries.
+	scimActorNamePrefix = "scim:"
+
+	pgUniqueViolation     = "23505"
+	pgForeignKeyViolation = "23503"
+
+	usersPrincipalNameUniqueConstraint     = "users_principal_name_key"
+	scimGroupsDisplayNameUniqueConstraint  = "scim_groups_display_name_key"
+	scimGroupMembersUserForeignKey         = "scim_group_members_user_id_fkey"
+	scimTokensSSOProviderForeignConstraint = "scim_tokens_sso_provider_id_fkey"
+	scimGroupRolesRoleForeignKey           = "scim_group_roles_role_id_fkey"
+)
+
+var validTokenColumns = []string{"id", "name", "sso_provider_id", "last_used_at", "created_at", "updated_at"}
+
+type queryExecer interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+}
+
+type pgxQuerier interface {
+	queryExecer
+	BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error)
+}
+
+type token struct {
+	ID            string        `db:"id"`
+	Name          string        `db:"name"`
+	SSOProviderID sql.NullInt32 `db:"sso_provider_id"`
+	LastUsedAt    sql.NullTime  `db:"last_used_at"`
+	CreatedAt     time.Time     `db:"created_at"`
+	UpdatedAt     time.Time     `db:"updated_at"`
+}
+
+type userRole struct {
+	UserID string `db:"user_id"`
+	RoleID int32  `db:"role_id"`
+}
+
+type Store struct {
+	db pgxQuerier
+}
+
+func NewStore(db pgxQuerier) *Store {
+	return &Store{db: db}
+}
+
+func toToken(row token) services.Token {
+	return services.Token{
+		ID:            uuid.FromStringOrNil(row.ID),
+		Name:          row.Name,
+		SSOProviderID: row.SSOProviderID,
+		LastUsedAt:    row.LastUsedAt,
+		CreatedAt:     row.CreatedAt,
+		UpdatedAt:     row.UpdatedAt,
+	}
+}
+
+// UseToken resolves the token with the given digest and records the time it was last used.
+func (s *Store) UseToken(ctx context.Context, digest string) (services.Token, error) {
+	var updateBuilder = sqlbuilder.PostgreSQL.NewUpdateBuilder()
+
+	updateBuilder.Update(tableSCIMTokens)
+	updateBuilder.Set(updateBuilder.Assign("last_used_at", time.Now().UTC()))
+	updateBuilder.Where(updateBuilder.Equal("digest", digest))
+	updateBuilder.Returning(validTokenColumns...)
+
+	sqlQuery, args := updateBuilder.Build()
+
+	rows, err := s.db.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return services.Token{}, err
+	}
+	row, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[token])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return services.Token{}, services.ErrTokenNotFound
+	}
+	if err != nil {
+		return services.Token{}, fmt.Errorf("finding SCIM token: %w", err)
+	}
+
+	return toToken(row), nil
+}
+
+func (s *Store) ListTokens(ctx context.Context) ([]services.Token, error) {
+	var sb = sqlbuilder.PostgreSQL.NewSelectBuilder()
+
+	sb.Select(validTokenColumns...).From(tableSCIMTokens).OrderBy("created_at", "id")
+
+	sqlQuery, args := sb.Build()
+
+	rows, err := s.db.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	tokenRows, err := pgx.CollectRows(rows, pgx.RowToStructByName[token])
+	if err != nil {
+		return nil, fmt.Errorf("collecting SCIM tokens: %w", err)
+	}
+
+	result := make([]services.Token, 0, len(tokenRows))
+	for _, tokenRow := range tokenRows {
+		result = append(result, toToken(tokenRow))
+	}
+
+	return result, nil
+}
+
+// CreateToken inserts a token digest together with an audit log entry in a single transaction.
+func (s *Store) CreateToken(ctx context.Context, template services.TokenTemplate, digest string) (services.Token, error) {
+	var (
+		insertBuilder = sqlbuilder.PostgreSQL.NewInsertBuilder()
+		now           = time.Now().UTC()
+		tokenID, err  = uuid.NewV4()
+		created       token
+		tx            pgx.Tx
+		rows          pgx.Rows
+	)
+	if err != nil {
+		return services.Token{}, fmt.Errorf("generating token id: %w", err)
+	}
+
+	tx, err = s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return services.Token{}, fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer func() {
+		// Rollback is a no-op once the transaction has been committed.
+		_ = tx.Rollback(ctx)
+	}()
+
+	insertBuilder.InsertInto(tableSCIMTokens)
+	insertBuilder.Cols("id", "name", "digest", "sso_provider_id", "created_at", "updated_at")
+	insertBuilder.Values(tokenID.String(), template.Name, digest, template.SSOProviderID, now, now)
+	insertBuilder.Returning(validTokenColumns...)
+
+	sqlQuery, args := insertBuilder.Build()
+	rows, err = tx.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return services.Token{}, mapWriteError(err)
+	}
+	created, err = pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[token])
+	if err != nil {
+		return services.Token{}, mapWriteError(err)
+	}
+
+	auditData := model.AuditData{"id": created.ID, "name": created.Name}
+	if created.SSOProviderID.Valid {
+		auditData["sso_provider_id"] = created.SSOProviderID.Int32
+	}
+	if err = insertAuditLog(ctx, tx, model.AuditLogActionCreateSCIMToken, auditData); err != nil {
+		return services.Token{}, err
+	}
+
+	return toToken(created), tx.Commit(ctx)
+}
+
+func (s *Store) DeleteToken(ctx context.Context, id uuid.UUID) error {
+	var (
+		deleteBuilder = sqlbuilder.PostgreSQL.NewDeleteBuilder()
+		commandTag    pgconn.CommandTag
+		tx            pgx.Tx
+		err           error
+	)
+
+	tx, err = s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer func() {
+		// Rollback is a no-op once the transaction has been committed.
+		_ = tx.Rollback(ctx)
+	}()
+
+	deleteBuilder.DeleteFrom(tableSCIMTokens)
+	deleteBuilder.Where(deleteBuilder.Equal("id", id.String()))
+
+	sqlQuery, args := deleteBuilder.Build()
+	if commandTag, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("deleting SCIM token: %w", err)
+	} else if commandTag.RowsAffected() == 0 {
+		return services.ErrTokenNotFound
+	}
+
+	if err = insertAuditLog(ctx, tx, model.AuditLogActionDeleteSCIMToken, model.AuditData{"id": id.String()}); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// requestProvider returns the SSO provider of the SCIM token that authenticated the request. An identity provider
+// can only see and change the users and groups provisioned within the scope of its token's SSO provider.
+func requestProvider(ctx context.Context) (sql.NullInt32, error) {
+	scimToken, isSCIMRequest := services.TokenFromContext(ctx)
+	if !isSCIMRequest {
+		return sql.NullInt32{}, services.ErrInvalidToken
+	}
+
+	return scimToken.SSOProviderID, nil
+}
+
+// syncUserRoles reconciles the role assignments SCIM created for the given users with the roles mapped to the SCIM
+// groups they are members of. Only assignments recorded in scim_user_roles are ever revoked, so roles assigned
+// locally are left untouched, and a role a user already holds locally is not claimed by SCIM. The change is rejected
+// with services.ErrLastAdministrator when it would leave no enabled administrator.
+func syncUserRoles(ctx context.Context, querier queryExecer, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	var (
+		mappedBuilder  = sqlbuilder.PostgreSQL.NewSelectBuilder()
+		grantedBuilder = sqlbuilder.PostgreSQL.NewSelectBuilder()
+		mapped         []userRole
+		granted        []userRole
+		err            error
+	)
+
+	mappedBuilder.Select("m.user_id", "gr.role_id").Distinct()
+	mappedBuilder.From(tableSCIMGroupMembers + " m")
+	mappedBuilder.Join(tableSCIMGroupRoles+" gr", "gr.group_id = m.group_id")
+	mappedBuilder.Where(mappedBuilder.In("m.user_id", sqlbuilder.List(userIDs)))
+
+	if mapped, err = selectUserRoles(ctx, querier, mappedBuilder); err != nil {
+		return err
+	}
+
+	grantedBuilder.Select("user_id", "role_id")
+	grantedBuilder.From(tableSCIMUserRoles)
+	grantedBuilder.Where(grantedBuilder.In("user_id", sqlbuilder.List(userIDs)))
+
+	if granted, err = selectUserRoles(ctx, querier, grantedBuilder); err != nil {
+		return err
+	}
+
+	for _, grant := range granted {
+		if slices.Contains(mapped, grant) {
+			continue
+		}
+
+		// The scim_user_roles record is removed along with the assignment by its foreign key
+		deleteBuilder := sqlbuilder.PostgreSQL.NewDeleteBuilder()
+		deleteBuilder.DeleteFrom(tableUsersRoles)
+		deleteBuilder.Where(deleteBuilder.Equal("user_id", grant.UserID), deleteBuilder.Equal("role_id", grant.RoleID))
+
+		sqlQuery, args := deleteBuilder.Build()
+		if _, err = querier.Exec(ctx, sqlQuery, args...); err != nil {
+			return fmt.Errorf("revoking group role: %w", err)
+		}
+	}
+
+	for _, grant := range mapped {
+		if slices.Contains(granted, grant) {
+			continue
+		} else if created, err := grantUserRole(ctx, querier, grant); err != nil {
+			return err
+		} else if !created {
+			// The user already holds the role through a local assignment which SCIM must not take ownership of
+			continue
+		}
+
+		insertBuilder := sqlbuilder.PostgreSQL.NewInsertBuilder()
+		insertBuilder.InsertInto(tableSCIMUserRoles)
+		insertBuilder.Cols("user_id", "role_id")
+		insertBuilder.Values(grant.UserID, grant.RoleID)
+
+		sqlQuery, args := insertBuilder.Build()
+		if _, err = querier.Exec(ctx, sqlQuery, args...); err != nil {
+			return fmt.Errorf("recording group role: %w", err)
+		}
+	}
+
+	return ensureAdministratorRemains(ctx, querier)
+}
+
+func selectUserRoles(ctx context.Context, querier queryExecer, sb *sqlbuilder.SelectBuilder) ([]userRole, error) {
+	sqlQuery, args := sb.Build()
+
+	rows, err := querier.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying user roles: %w", err)
+	}
+	userRoles, err := pgx.CollectRows(rows, pgx.RowToStructByName[userRole])
+	if err != nil {
+		return nil, fmt.Errorf("collecting user roles: %w", err)
+	}
+
+	return userRoles, nil
+}
+
+// grantUserRole assigns the role to the user and reports whether the assignment was created. It is not created when
+// the user already holds the role.
+func grantUserRole(ctx context.Context, querier queryExecer, grant userRole) (bool, error) {
+	var insertBuilder = sqlbuilder.PostgreSQL.NewInsertBuilder()
+
+	insertBuilder.InsertInto(tableUsersRoles)
+	insertBuilder.Cols("user_id", "role_id")
+	insertBuilder.Values(grant.UserID, grant.RoleID)
+	insertBuilder.SQL("ON CONFLICT DO NOTHING")
+
+	sqlQuery, args := insertBuilder.Build()
+	if commandTag, err := querier.Exec(ctx, sqlQuery, args...); err != nil {
+		return false, fmt.Errorf("granting group role: %w", err)
+	} else {
+		return commandTag.RowsAffected() > 0, nil
+	}
+}
+
+// ensureAdministratorRemains returns services.ErrLastAdministrator when no enabled user holds a role granting
+// auth:ManageUsers.
+func ensureAdministratorRemains(ctx context.Context, querier queryExecer) error {
+	if count, err := administrators.CountEnabled(ctx, querier); err != nil {
+		return err
+	} else if count == 0 {
+		return services.ErrLastAdministrator
+	}
+
+	return nil
+}
+
+// revokeUserAccess ends the sessions of a user and deletes their API tokens.
+func revokeUserAccess(ctx context.Context, querier queryExecer, userID string) error {
+	for _, table := range []string{tableUserSessions, tableAuthTokens} {
+		deleteBuilder := sqlbuilder.PostgreSQL.NewDeleteBuilder()
+		deleteBuilder.DeleteFrom(table)
+		deleteBuilder.Where(deleteBuilder.Equal("user_id", userID))
+
+		sqlQuery, args := deleteBuilder.Build()
+		if _, err := querier.Exec(ctx, sqlQuery, args...); err != nil {
+			return fmt.Errorf("revoking access from %s: %w", table, err)
+		}
+	}
+
+	return nil
+}
+
+func mapWriteError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch {
+		case pgErr.Code == pgUniqueViolation && pgErr.ConstraintName == usersPrincipalNameUniqueConstraint:
+			return services.ErrUserNameConflict
+		case pgErr.Code == pgUniqueViolation && pgErr.ConstraintName == scimGroupsDisplayNameUniqueConstraint:
+			return services.ErrDisplayNameConflict
+		case pgErr.Code == pgForeignKeyViolation && pgErr.ConstraintName == scimGroupMembersUserForeignKey:
+			return services.ErrUnknownMember
+		case pgErr.Code == pgForeignKeyViolation && pgErr.ConstraintName == scimTokensSSOProviderForeignConstraint:
+			return services.ErrUnknownSSOProvider
+		case pgErr.Code == pgForeignKeyViolation && pgErr.ConstraintName == scimGroupRolesRoleForeignKey:
+			return services.ErrUnknownRole
+		}
+	}
+
+	return fmt.Errorf("writing SCIM resource: %w", err)
+}
+
+// auditActor returns the actor recorded in audit log entries. Changes pushed by an identity provider are attributed
+// to the SCIM token that authenticated the request, token management to the authenticated user.
+func auditActor(ctx context.Context) (string, string, string, error) {
+	if scimToken, isSCIMRequest := services.TokenFromContext(ctx); isSCIMRequest {
+		return scimToken.ID.String(), scimActorNamePrefix + scimToken.Name, "", nil
+	} else if user, isUser := auth.GetUserFromAuthCtx(bhctx.Get(ctx).AuthCtx); isUser {
+		return user.ID.String(), user.PrincipalName, user.EmailAddress.ValueOrZero(), nil
+	}
+
+	return "", "", "", fmt.Errorf("no authenticated actor on context")
+}
+
+func insertAuditLog(ctx context.Context, querier queryExecer, action model.AuditLogAction, auditData model.AuditData) error {
+	var (
+		commitID, err = uuid.NewV4()
+		bheCtx        = bhctx.Get(ctx)
+	)
+	if err != nil {
+		return fmt.Errorf("generating commit id: %w", err)
+	}
+
+	actorID, actorName, actorEmail, err := auditActor(ctx)
+	if err != nil {
+		return err
+	}
+
+	fields, err := json.Marshal(auditData)
+	if err != nil {
+		return fmt.Errorf("marshalling audit fields: %w", err)
+	}
+
+	insertBuilder := sqlbuilder.PostgreSQL.NewInsertBuilder()
+	insertBuilder.InsertInto(tableAuditLogs)
+	insertBuilder.Cols(
+		"created_at", "actor_id", "actor_name", "actor_email",
+		"action", "fields", "request_id", "source_ip_address",
+		"status", "commit_id",
+	)
+	insertBuilder.Values(
+		time.Now().UTC(),
+		actorID,
+		actorName,
+		actorEmail,
+		string(action),
+		string(fields),
+		bheCtx.RequestID,
+		bheCtx.RequestIP,
+		string(model.AuditLogStatusSuccess),
+		commitID.String(),
+	)
+
+	sqlQuery, args := insertBuilder.Build()
+	_, err = querier.Exec(ctx, sqlQuery, args...)
+	return err
+}
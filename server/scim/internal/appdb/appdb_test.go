@@ -0,0 +1,206 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package appdb_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/specterops/bloodhound/server/scim/internal/appdb"
+	"github.com/specterops/bloodhound/server/scim/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Literal SQL strings expected by the Store. These are compared via
+// pgxmock.QueryMatcherEqual, which whitespace-normalises both sides.
+const (
+	expectedUseTokenSQL        = `UPDATE scim_tokens SET last_used_at = $1 WHERE digest = $2 RETURNING id, name, sso_provider_id, last_used_at, created_at, updated_at`
+	expectedDeleteTokenSQL     = `DELETE FROM scim_tokens WHERE id = $1`
+	expectedDeleteGroupRoleSQL = `DELETE FROM scim_group_roles WHERE group_id = $1`
+	expectedCountUsersSQL      = `SELECT count(*) FROM users u JOIN scim_users su ON su.user_id = u.id WHERE u.support_account IS NOT TRUE AND su.sso_provider_id IS NOT DISTINCT FROM $1 AND COALESCE(starts_with(lower(NULLIF(u.principal_name, '')), lower($2)), false)`
+	expectedListUsersSQL       = `SELECT u.id, u.principal_name, COALESCE(u.first_name, '') AS first_name, COALESCE(u.last_name, '') AS last_name, COALESCE(u.email_address, '') AS email_address, COALESCE(u.is_disabled, false) AS is_disabled, COALESCE(su.external_id, '') AS external_id, u.created_at, u.updated_at FROM users u JOIN scim_users su ON su.user_id = u.id WHERE u.support_account IS NOT TRUE AND su.sso_provider_id IS NOT DISTINCT FROM $1 AND COALESCE(starts_with(lower(NULLIF(u.principal_name, '')), lower($2)), false) ORDER BY u.principal_name LIMIT $3 OFFSET $4`
+	expectedListUserGroupsSQL  = `SELECT m.user_id, g.id AS group_id, g.display_name FROM scim_group_members m JOIN scim_groups g ON g.id = m.group_id WHERE m.user_id IN ($1) ORDER BY g.display_name`
+)
+
+func newTestStore(t *testing.T) (*appdb.Store, pgxmock.PgxPoolIface) {
+	t.Helper()
+	pool, err := pgxmock.NewPool(pgxmock.QueryMatcherOption(pgxmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+	return appdb.NewStore(pool), pool
+}
+
+func TestStore_UseToken(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		dbErr     = errors.New("connection refused")
+		tokenID   = uuid.Must(uuid.NewV4())
+		createdAt = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+		lastUsed  = time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+		expected  = services.Token{
+			ID:            tokenID,
+			Name:          "Okta",
+			SSOProviderID: sql.NullInt32{Int32: 2, Valid: true},
+			LastUsedAt:    sql.NullTime{Time: lastUsed, Valid: true},
+			CreatedAt:     createdAt,
+			UpdatedAt:     createdAt,
+		}
+	)
+
+	tests := []struct {
+		name            string
+		expectations    func(pool pgxmock.PgxPoolIface)
+		wantResult      services.Token
+		wantErr         error
+		wantErrContains string
+	}{
+		{
+			name: "returns the token matching the digest",
+			expectations: func(pool pgxmock.PgxPoolIface) {
+				pool.ExpectQuery(expectedUseTokenSQL).WithArgs(pgxmock.AnyArg(), "digest").WillReturnRows(
+					pool.NewRows([]string{"id", "name", "sso_provider_id", "last_used_at", "created_at", "updated_at"}).
+						AddRow(tokenID.String(), expected.Name, expected.SSOProviderID, expected.LastUsedAt, createdAt, createdAt),
+				)
+			},
+			wantResult: expected,
+		},
+		{
+			name: "returns ErrTokenNotFound when no token matches",
+			expectations: func(pool pgxmock.PgxPoolIface) {
+				pool.ExpectQuery(expectedUseTokenSQL).WithArgs(pgxmock.AnyArg(), "digest").WillReturnRows(
+					pool.NewRows([]string{"id", "name", "sso_provider_id", "last_used_at", "created_at", "updated_at"}),
+				)
+			},
+			wantErr: services.ErrTokenNotFound,
+		},
+		{
+			name: "propagates query errors",
+			expectations: func(pool pgxmock.PgxPoolIface) {
+				pool.ExpectQuery(expectedUseTokenSQL).WithArgs(pgxmock.AnyArg(), "digest").WillReturnError(dbErr)
+			},
+			wantErr: dbErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, pool := newTestStore(t)
+			tt.expectations(pool)
+
+			result, err := store.UseToken(ctx, "digest")
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantResult, result)
+			}
+
+			assert.NoError(t, pool.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestStore_DeleteToken(t *testing.T) {
+	t.Run("returns ErrTokenNotFound and rolls back when no token was deleted", func(t *testing.T) {
+		var (
+			ctx         = context.Background()
+			tokenID     = uuid.Must(uuid.NewV4())
+			store, pool = newTestStore(t)
+		)
+
+		pool.ExpectBegin()
+		pool.ExpectExec(expectedDeleteTokenSQL).WithArgs(tokenID.String()).WillReturnResult(pgxmock.NewResult("DELETE", 0))
+		pool.ExpectRollback()
+
+		err := store.DeleteToken(ctx, tokenID)
+		assert.ErrorIs(t, err, services.ErrTokenNotFound)
+		assert.NoError(t, pool.ExpectationsWereMet())
+	})
+}
+
+func TestStore_DeleteGroupRole(t *testing.T) {
+	t.Run("returns ErrGroupNotFound and rolls back when the group has no role mapping", func(t *testing.T) {
+		var (
+			ctx         = context.Background()
+			groupID     = uuid.Must(uuid.NewV4())
+			store, pool = newTestStore(t)
+		)
+
+		pool.ExpectBegin()
+		pool.ExpectExec(expectedDeleteGroupRoleSQL).WithArgs(groupID.String()).WillReturnResult(pgxmock.NewResult("DELETE", 0))
+		pool.ExpectRollback()
+
+		err := store.DeleteGroupRole(ctx, groupID)
+		assert.ErrorIs(t, err, services.ErrGroupNotFound)
+		assert.NoError(t, pool.ExpectationsWereMet())
+	})
+}
+
+func TestStore_ListUsers(t *testing.T) {
+	var (
+		provider  = sql.NullInt32{Int32: 2, Valid: true}
+		ctx       = services.ContextWithToken(context.Background(), services.Token{SSOProviderID: provider})
+		userID    = uuid.Must(uuid.NewV4())
+		groupID   = uuid.Must(uuid.NewV4())
+		createdAt = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	)
+
+	filter, err := services.ParseFilter(`userName sw "alice"`)
+	require.NoError(t, err)
+
+	t.Run("filters and pages in the database and loads only the groups of the page", func(t *testing.T) {
+		store, pool := newTestStore(t)
+
+		pool.ExpectQuery(expectedCountUsersSQL).WithArgs(provider, "alice").WillReturnRows(pool.NewRows([]string{"count"}).AddRow(3))
+		pool.ExpectQuery(expectedListUsersSQL).WithArgs(provider, "alice", 1, 1).WillReturnRows(
+			pool.NewRows([]string{"id", "principal_name", "first_name", "last_name", "email_address", "is_disabled", "external_id", "created_at", "updated_at"}).
+				AddRow(userID.String(), "alice.b@example.com", "Alice", "B", "alice.b@example.com", false, "00u2", createdAt, createdAt),
+		)
+		pool.ExpectQuery(expectedListUserGroupsSQL).WithArgs(userID.String()).WillReturnRows(
+			pool.NewRows([]string{"user_id", "group_id", "display_name"}).AddRow(userID.String(), groupID.String(), "Admins"),
+		)
+
+		users, total, err := store.ListUsers(ctx, services.ListOptions{Filter: filter, Offset: 1, Limit: 1})
+		require.NoError(t, err)
+		assert.Equal(t, 3, total)
+		require.Len(t, users, 1)
+		assert.Equal(t, "alice.b@example.com", users[0].UserName)
+		assert.Equal(t, []services.GroupReference{{ID: groupID, DisplayName: "Admins"}}, users[0].Groups)
+		assert.NoError(t, pool.ExpectationsWereMet())
+	})
+
+	t.Run("skips the group lookup for an empty page", func(t *testing.T) {
+		store, pool := newTestStore(t)
+
+		pool.ExpectQuery(expectedCountUsersSQL).WithArgs(provider, "alice").WillReturnRows(pool.NewRows([]string{"count"}).AddRow(3))
+		pool.ExpectQuery(expectedListUsersSQL).WithArgs(provider, "alice", 1, 10).WillReturnRows(
+			pool.NewRows([]string{"id", "principal_name", "first_name", "last_name", "email_address", "is_disabled", "external_id", "created_at", "updated_at"}),
+		)
+
+		users, total, err := store.ListUsers(ctx, services.ListOptions{Filter: filter, Offset: 10, Limit: 1})
+		require.NoError(t, err)
+		assert.Equal(t, 3, total)
+		assert.Empty(t, users)
+		assert.NoError(t, pool.ExpectationsWereMet())
+	})
+}
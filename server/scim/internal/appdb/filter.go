@@ -0,0 +1,236 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package appdb
+
+import (
+	"strings"
+
+	"github.com/huandu/go-sqlbuilder"
+	"github.com/specterops/bloodhound/server/scim/internal/services"
+)
+
+// filterAttributes maps the lower cased SCIM attribute paths of a resource onto SQL expressions so that list filters
+// are evaluated by the database. A single-valued expression that evaluates to NULL or an empty string is an attribute
+// the resource does not have.
+type filterAttributes struct {
+	values      map[string]string
+	multiValued map[string]multiValuedAttribute
+}
+
+// multiValuedAttribute is a multi-valued complex attribute whose elements are the rows of a subquery correlated with
+// the resource through where. The "value" sub-attribute also answers for the attribute path itself.
+type multiValuedAttribute struct {
+	from     string
+	where    string
+	elements filterAttributes
+}
+
+// timestampAttribute formats a timestamp column as an RFC 3339 UTC string, the form SCIM filters compare against.
+func timestampAttribute(column string) string {
+	return "to_char(" + column + ` AT TIME ZONE 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS"Z"')`
+}
+
+var userFilterAttributes = filterAttributes{
+	values: map[string]string{
+		"id":                "u.id",
+		"externalid":        "su.external_id",
+		"username":          "u.principal_name",
+		"name.givenname":    "u.first_name",
+		"name.familyname":   "u.last_name",
+		"active":            "CASE WHEN u.is_disabled IS TRUE THEN 'false' ELSE 'true' END",
+		"meta.created":      timestampAttribute("u.created_at"),
+		"meta.lastmodified": timestampAttribute("u.updated_at"),
+	},
+	multiValued: map[string]multiValuedAttribute{
+		"emails": {
+			where: "NULLIF(u.email_address, '') IS NOT NULL",
+			elements: filterAttributes{values: map[string]string{
+				"value":   "u.email_address",
+				"type":    "'work'",
+				"primary": "'true'",
+			}},
+		},
+		"groups": {
+			from:  tableSCIMGroupMembers + " fm JOIN " + tableSCIMGroups + " fg ON fg.id = fm.group_id",
+			where: "fm.user_id = u.id",
+			elements: filterAttributes{values: map[string]string{
+				"value":   "fg.id",
+				"display": "fg.display_name",
+			}},
+		},
+	},
+}
+
+var groupFilterAttributes = filterAttributes{
+	values: map[string]string{
+		"id":                "g.id",
+		"externalid":        "g.external_id",
+		"displayname":       "g.display_name",
+		"meta.created":      timestampAttribute("g.created_at"),
+		"meta.lastmodified": timestampAttribute("g.updated_at"),
+	},
+	multiValued: map[string]multiValuedAttribute{
+		"members": {
+			from:  tableSCIMGroupMembers + " fm JOIN " + tableUsers + " fu ON fu.id = fm.user_id",
+			where: "fm.group_id = g.id",
+			elements: filterAttributes{values: map[string]string{
+				"value":   "fm.user_id",
+				"display": "fu.principal_name",
+			}},
+		},
+	},
+}
+
+// whereFilter restricts the select builder to the resources matching the filter.
+func whereFilter(sb *sqlbuilder.SelectBuilder, filter services.Filter, attributes filterAttributes) {
+	if condition := filter.Translate(filterTranslator{cond: &sb.Cond, attributes: attributes}); condition != "" {
+		sb.Where(condition)
+	}
+}
+
+// filterTranslator renders a SCIM filter as a SQL condition with the semantics of services.Filter.Matches.
+type filterTranslator struct {
+	cond       *sqlbuilder.Cond
+	attributes filterAttributes
+}
+
+func (s filterTranslator) And(left, right string) string {
+	return "(" + left + " AND " + right + ")"
+}
+
+func (s filterTranslator) Or(left, right string) string {
+	return "(" + left + " OR " + right + ")"
+}
+
+func (s filterTranslator) Not(inner string) string {
+	return "NOT (" + inner + ")"
+}
+
+func (s filterTranslator) Compare(path, operator, value string) string {
+	if expression, found := s.attributes.values[path]; found {
+		return compareValue(s.cond, expression, operator, value)
+	}
+
+	attributePath, subAttribute, _ := strings.Cut(path, ".")
+	if subAttribute == "" {
+		subAttribute = "value"
+	}
+
+	if attribute, found := s.attributes.multiValued[attributePath]; found {
+		if expression, found := attribute.elements.values[subAttribute]; found {
+			return compareElements(s.cond, attribute, expression, operator, value)
+		}
+	}
+
+	// Attributes the resource does not have compare as absent
+	return compareValue(s.cond, "NULL", operator, value)
+}
+
+func (s filterTranslator) ValuePath(path string, inner services.Filter) string {
+	attribute, found := s.attributes.multiValued[path]
+	if !found {
+		return "FALSE"
+	}
+
+	var sb = newElementSelectBuilder(attribute)
+
+	sb.Where(inner.Translate(filterTranslator{cond: &sb.Cond, attributes: attribute.elements}))
+
+	return s.cond.Exists(sb)
+}
+
+func newElementSelectBuilder(attribute multiValuedAttribute) *sqlbuilder.SelectBuilder {
+	var sb = sqlbuilder.PostgreSQL.NewSelectBuilder()
+
+	sb.Select("1")
+	if attribute.from != "" {
+		sb.From(attribute.from)
+	}
+	sb.Where(attribute.where)
+
+	return sb
+}
+
+// compareValue compares a single-valued attribute. Absent attributes only match presence tests negated or against
+// null, and never match any other comparison, so each comparison is coalesced to false before it can be negated.
+func compareValue(cond *sqlbuilder.Cond, expression, operator, value string) string {
+	var present = "NULLIF(" + expression + ", '') IS NOT NULL"
+
+	switch {
+	case operator == services.FilterOperatorPresent:
+		return present
+	case value == services.FilterValueNull && operator == services.FilterOperatorEqual:
+		return "NOT (" + present + ")"
+	case value == services.FilterValueNull && operator == services.FilterOperatorNotEqual:
+		return present
+	case operator == services.FilterOperatorNotEqual:
+		return "NOT COALESCE(" + comparison(cond, expression, services.FilterOperatorEqual, value) + ", false)"
+	default:
+		return "COALESCE(" + comparison(cond, expression, operator, value) + ", false)"
+	}
+}
+
+// compareElements compares the sub-attribute of every element of a multi-valued attribute. The comparison matches
+// when any element matches, except for ne which matches when no element is equal.
+func compareElements(cond *sqlbuilder.Cond, attribute multiValuedAttribute, expression, operator, value string) string {
+	var sb = newElementSelectBuilder(attribute)
+
+	switch {
+	case operator == services.FilterOperatorPresent, value == services.FilterValueNull && operator == services.FilterOperatorNotEqual:
+		sb.Where("NULLIF(" + expression + ", '') IS NOT NULL")
+		return cond.Exists(sb)
+	case value == services.FilterValueNull && operator == services.FilterOperatorEqual:
+		sb.Where("NULLIF(" + expression + ", '') IS NOT NULL")
+		return cond.NotExists(sb)
+	case operator == services.FilterOperatorNotEqual:
+		sb.Where(comparison(&sb.Cond, expression, services.FilterOperatorEqual, value))
+		return cond.NotExists(sb)
+	default:
+		sb.Where(comparison(&sb.Cond, expression, operator, value))
+		return cond.Exists(sb)
+	}
+}
+
+// comparison compares the lower cased expression against the lower cased value. It evaluates to NULL when the
+// attribute is absent. Ordering comparisons use the C collation to compare bytes, as strings are compared in Go.
+func comparison(cond *sqlbuilder.Cond, expression, operator, value string) string {
+	var (
+		attribute = "lower(NULLIF(" + expression + ", ''))"
+		expected  = "lower(" + cond.Var(value) + ")"
+	)
+
+	switch operator {
+	case services.FilterOperatorEqual:
+		return attribute + " = " + expected
+	case services.FilterOperatorContains:
+		return "strpos(" + attribute + ", " + expected + ") > 0"
+	case services.FilterOperatorStartsWith:
+		return "starts_with(" + attribute + ", " + expected + ")"
+	case services.FilterOperatorEndsWith:
+		return "right(" + attribute + ", length(" + expected + ")) = " + expected
+	case services.FilterOperatorGreaterThan:
+		return attribute + ` COLLATE "C" > ` + expected
+	case services.FilterOperatorGreaterOrEqual:
+		return attribute + ` COLLATE "C" >= ` + expected
+	case services.FilterOperatorLessThan:
+		return attribute + ` COLLATE "C" < ` + expected
+	case services.FilterOperatorLessOrEqual:
+		return attribute + ` COLLATE "C" <= ` + expected
+	default:
+		return "FALSE"
+	}
+}
@@ -0,0 +1,220 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/huandu/go-sqlbuilder"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/server/scim/internal/services"
+)
+
+var validGroupRoleColumns = []string{
+	"g.id AS group_id",
+	"g.display_name",
+	"g.sso_provider_id",
+	"gr.role_id",
+	"COALESCE(gr.updated_at, g.updated_at) AS updated_at",
+}
+
+type groupRole struct {
+	GroupID       string        `db:"group_id"`
+	DisplayName   string        `db:"display_name"`
+	SSOProviderID sql.NullInt32 `db:"sso_provider_id"`
+	RoleID        sql.NullInt32 `db:"role_id"`
+	UpdatedAt     time.Time     `db:"updated_at"`
+}
+
+func toGroupRole(row groupRole) services.GroupRole {
+	return services.GroupRole{
+		GroupID:       uuid.FromStringOrNil(row.GroupID),
+		DisplayName:   row.DisplayName,
+		SSOProviderID: row.SSOProviderID,
+		RoleID:        row.RoleID,
+		UpdatedAt:     row.UpdatedAt,
+	}
+}
+
+func newGroupRoleSelectBuilder() *sqlbuilder.SelectBuilder {
+	var sb = sqlbuilder.PostgreSQL.NewSelectBuilder()
+
+	sb.Select(validGroupRoleColumns...)
+	sb.From(tableSCIMGroups + " g")
+	sb.JoinWithOption(sqlbuilder.LeftJoin, tableSCIMGroupRoles+" gr", "gr.group_id = g.id")
+
+	return sb
+}
+
+// ListGroupRoles returns every SCIM group, regardless of the identity provider that provisioned it, along with the
+// role an administrator mapped to it.
+func (s *Store) ListGroupRoles(ctx context.Context) ([]services.GroupRole, error) {
+	var sb = newGroupRoleSelectBuilder()
+
+	sb.OrderBy("g.display_name")
+
+	sqlQuery, args := sb.Build()
+
+	rows, err := s.db.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	groupRoleRows, err := pgx.CollectRows(rows, pgx.RowToStructByName[groupRole])
+	if err != nil {
+		return nil, fmt.Errorf("collecting group roles: %w", err)
+	}
+
+	result := make([]services.GroupRole, 0, len(groupRoleRows))
+	for _, groupRoleRow := range groupRoleRows {
+		result = append(result, toGroupRole(groupRoleRow))
+	}
+
+	return result, nil
+}
+
+func selectGroupRole(ctx context.Context, querier queryExecer, groupID string) (services.GroupRole, error) {
+	var sb = newGroupRoleSelectBuilder()
+
+	sb.Where(sb.Equal("g.id", groupID)).Limit(1)
+
+	sqlQuery, args := sb.Build()
+
+	rows, err := querier.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return services.GroupRole{}, err
+	}
+	groupRoleRow, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[groupRole])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return services.GroupRole{}, services.ErrGroupNotFound
+	}
+	if err != nil {
+		return services.GroupRole{}, fmt.Errorf("finding group role: %w", err)
+	}
+
+	return toGroupRole(groupRoleRow), nil
+}
+
+// SetGroupRole maps a SCIM group to a role and grants the role to the current members of the group in a single
+// transaction. The roles the previous mapping granted are revoked unless another group grants them.
+func (s *Store) SetGroupRole(ctx context.Context, groupID uuid.UUID, roleID int32) (services.GroupRole, error) {
+	var (
+		insertBuilder = sqlbuilder.PostgreSQL.NewInsertBuilder()
+		now           = time.Now().UTC()
+		updated       services.GroupRole
+		tx            pgx.Tx
+		err           error
+	)
+
+	tx, err = s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return services.GroupRole{}, fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer func() {
+		// Rollback is a no-op once the transaction has been committed.
+		_ = tx.Rollback(ctx)
+	}()
+
+	if _, err = selectGroupRole(ctx, tx, groupID.String()); err != nil {
+		return services.GroupRole{}, err
+	}
+
+	insertBuilder.InsertInto(tableSCIMGroupRoles)
+	insertBuilder.Cols("group_id", "role_id", "created_at", "updated_at")
+	insertBuilder.Values(groupID.String(), roleID, now, now)
+	insertBuilder.SQL("ON CONFLICT (group_id) DO UPDATE SET role_id = EXCLUDED.role_id, updated_at = EXCLUDED.updated_at")
+
+	sqlQuery, args := insertBuilder.Build()
+	if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+		return services.GroupRole{}, mapWriteError(err)
+	}
+
+	if err = syncGroupMemberRoles(ctx, tx, groupID.String()); err != nil {
+		return services.GroupRole{}, err
+	}
+
+	if updated, err = selectGroupRole(ctx, tx, groupID.String()); err != nil {
+		return services.GroupRole{}, err
+	}
+
+	if err = insertAuditLog(ctx, tx, model.AuditLogActionSetSCIMGroupRole, model.AuditData{"group_id": groupID.String(), "role_id": roleID}); err != nil {
+		return services.GroupRole{}, err
+	}
+
+	return updated, tx.Commit(ctx)
+}
+
+// DeleteGroupRole removes the role mapping of a SCIM group. The members of the group lose the role in the same
+// transaction unless another group grants it.
+func (s *Store) DeleteGroupRole(ctx context.Context, groupID uuid.UUID) error {
+	var (
+		deleteBuilder = sqlbuilder.PostgreSQL.NewDeleteBuilder()
+		commandTag    pgconn.CommandTag
+		tx            pgx.Tx
+		err           error
+	)
+
+	tx, err = s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer func() {
+		// Rollback is a no-op once the transaction has been committed.
+		_ = tx.Rollback(ctx)
+	}()
+
+	deleteBuilder.DeleteFrom(tableSCIMGroupRoles)
+	deleteBuilder.Where(deleteBuilder.Equal("group_id", groupID.String()))
+
+	sqlQuery, args := deleteBuilder.Build()
+	if commandTag, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("deleting group role: %w", err)
+	} else if commandTag.RowsAffected() == 0 {
+		return services.ErrGroupNotFound
+	}
+
+	if err = syncGroupMemberRoles(ctx, tx, groupID.String()); err != nil {
+		return err
+	}
+
+	if err = insertAuditLog(ctx, tx, model.AuditLogActionDeleteSCIMGroupRole, model.AuditData{"group_id": groupID.String()}); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// syncGroupMemberRoles recomputes the SCIM role assignments of every member of the group.
+func syncGroupMemberRoles(ctx context.Context, querier queryExecer, groupID string) error {
+	membersByGroup, err := getGroupMembers(ctx, querier, []string{groupID})
+	if err != nil {
+		return err
+	}
+
+	userIDs := make([]string, 0, len(membersByGroup[groupID]))
+	for _, member := range membersByGroup[groupID] {
+		userIDs = append(userIDs, member.UserID)
+	}
+
+	return syncUserRoles(ctx, querier, userIDs)
+}
@@ -0,0 +1,477 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/huandu/go-sqlbuilder"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/server/scim/internal/services"
+)
+
+var validGroupColumns = []string{
+	"g.id",
+	"g.display_name",
+	"COALESCE(g.external_id, '') AS external_id",
+	"gr.role_id",
+	"g.created_at",
+	"g.updated_at",
+}
+
+type group struct {
+	ID          string        `db:"id"`
+	DisplayName string        `db:"display_name"`
+	ExternalID  string        `db:"external_id"`
+	RoleID      sql.NullInt32 `db:"role_id"`
+	CreatedAt   time.Time     `db:"created_at"`
+	UpdatedAt   time.Time     `db:"updated_at"`
+}
+
+type groupMember struct {
+	GroupID       string `db:"group_id"`
+	UserID        string `db:"user_id"`
+	PrincipalName string `db:"principal_name"`
+}
+
+func toGroup(row group, memberRows []groupMember) services.Group {
+	members := make([]services.MemberReference, 0, len(memberRows))
+	for _, memberRow := range memberRows {
+		members = append(members, services.MemberReference{ID: uuid.FromStringOrNil(memberRow.UserID), UserName: memberRow.PrincipalName})
+	}
+
+	return services.Group{
+		ID:          uuid.FromStringOrNil(row.ID),
+		ExternalID:  row.ExternalID,
+		DisplayName: row.DisplayName,
+		RoleID:      row.RoleID,
+		Members:     members,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+}
+
+// newGroupSelectBuilder selects the groups provisioned within the scope of the given SSO provider along with the
+// role an administrator mapped to each group.
+func newGroupSelectBuilder(provider sql.NullInt32) *sqlbuilder.SelectBuilder {
+	var sb = sqlbuilder.PostgreSQL.NewSelectBuilder()
+
+	sb.Select(validGroupColumns...)
+	sb.From(tableSCIMGroups + " g")
+	sb.JoinWithOption(sqlbuilder.LeftJoin, tableSCIMGroupRoles+" gr", "gr.group_id = g.id")
+	sb.Where(sb.IsNotDistinctFrom("g.sso_provider_id", provider))
+
+	return sb
+}
+
+// ListGroups returns the page of groups provisioned by the requesting identity provider that match the filter, ordered
+// by display name, along with the number of matching groups.
+func (s *Store) ListGroups(ctx context.Context, options services.ListOptions) ([]services.Group, int, error) {
+	provider, err := requestProvider(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var (
+		countBuilder = newGroupSelectBuilder(provider)
+		sb           = newGroupSelectBuilder(provider)
+		total        int
+	)
+
+	countBuilder.Select("count(*)")
+	whereFilter(countBuilder, options.Filter, groupFilterAttributes)
+
+	sqlQuery, args := countBuilder.Build()
+
+	rows, err := s.db.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("counting groups: %w", err)
+	}
+	if total, err = pgx.CollectExactlyOneRow(rows, pgx.RowTo[int]); err != nil {
+		return nil, 0, fmt.Errorf("counting groups: %w", err)
+	}
+
+	whereFilter(sb, options.Filter, groupFilterAttributes)
+	sb.OrderBy("g.display_name").Offset(options.Offset).Limit(options.Limit)
+
+	sqlQuery, args = sb.Build()
+
+	rows, err = s.db.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	groupRows, err := pgx.CollectRows(rows, pgx.RowToStructByName[group])
+	if err != nil {
+		return nil, 0, fmt.Errorf("collecting groups: %w", err)
+	}
+
+	var groupIDs = make([]string, 0, len(groupRows))
+	for _, groupRow := range groupRows {
+		groupIDs = append(groupIDs, groupRow.ID)
+	}
+
+	membersByGroup, err := getGroupMembers(ctx, s.db, groupIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	result := make([]services.Group, 0, len(groupRows))
+	for _, groupRow := range groupRows {
+		result = append(result, toGroup(groupRow, membersByGroup[groupRow.ID]))
+	}
+
+	return result, total, nil
+}
+
+func (s *Store) GetGroup(ctx context.Context, id uuid.UUID) (services.Group, error) {
+	if provider, err := requestProvider(ctx); err != nil {
+		return services.Group{}, err
+	} else {
+		return selectGroup(ctx, s.db, id.String(), provider)
+	}
+}
+
+// selectGroup loads a group and its members through the given querier so that it can be read either directly from
+// the pool or from within a transaction that has just written the group.
+func selectGroup(ctx context.Context, querier queryExecer, id string, provider sql.NullInt32) (services.Group, error) {
+	var sb = newGroupSelectBuilder(provider)
+
+	sb.Where(sb.Equal("g.id", id)).Limit(1)
+
+	sqlQuery, args := sb.Build()
+
+	rows, err := querier.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return services.Group{}, err
+	}
+	groupRow, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[group])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return services.Group{}, services.ErrGroupNotFound
+	}
+	if err != nil {
+		return services.Group{}, fmt.Errorf("finding group: %w", err)
+	}
+
+	membersByGroup, err := getGroupMembers(ctx, querier, []string{id})
+	if err != nil {
+		return services.Group{}, err
+	}
+
+	return toGroup(groupRow, membersByGroup[id]), nil
+}
+
+// getGroupMembers retrieves the members of the given groups grouped by group id.
+func getGroupMembers(ctx context.Context, querier queryExecer, groupIDs []string) (map[string][]groupMember, error) {
+	var (
+		sb             = sqlbuilder.PostgreSQL.NewSelectBuilder()
+		membersByGroup = make(map[string][]groupMember)
+	)
+
+	if len(groupIDs) == 0 {
+		return membersByGroup, nil
+	}
+
+	sb.Select("m.group_id", "m.user_id", "u.principal_name")
+	sb.From(tableSCIMGroupMembers + " m")
+	sb.Join(tableUsers+" u", "u.id = m.user_id")
+	sb.Where(sb.In("m.group_id", sqlbuilder.List(groupIDs)))
+	sb.OrderBy("u.principal_name")
+
+	sqlQuery, args := sb.Build()
+
+	rows, err := querier.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying group members: %w", err)
+	}
+	memberRows, err := pgx.CollectRows(rows, pgx.RowToStructByName[groupMember])
+	if err != nil {
+		return nil, fmt.Errorf("collecting group members: %w", err)
+	}
+
+	for _, memberRow := range memberRows {
+		membersByGroup[memberRow.GroupID] = append(membersByGroup[memberRow.GroupID], memberRow)
+	}
+
+	return membersByGroup, nil
+}
+
+// CreateGroup inserts a group and its members together with an audit log entry in a single transaction. A new group
+// is not mapped to a role so its members gain no roles until an administrator maps it.
+func (s *Store) CreateGroup(ctx context.Context, newGroup services.Group) (services.Group, error) {
+	var (
+		insertBuilder = sqlbuilder.PostgreSQL.NewInsertBuilder()
+		now           = time.Now().UTC()
+		groupID, err  = uuid.NewV4()
+		provider      sql.NullInt32
+		created       services.Group
+		tx            pgx.Tx
+	)
+	if err != nil {
+		return services.Group{}, fmt.Errorf("generating group id: %w", err)
+	} else if provider, err = requestProvider(ctx); err != nil {
+		return services.Group{}, err
+	}
+
+	tx, err = s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return services.Group{}, fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer func() {
+		// Rollback is a no-op once the transaction has been committed.
+		_ = tx.Rollback(ctx)
+	}()
+
+	insertBuilder.InsertInto(tableSCIMGroups)
+	insertBuilder.Cols("id", "sso_provider_id", "display_name", "external_id", "created_at", "updated_at")
+	insertBuilder.Values(groupID.String(), provider, newGroup.DisplayName, nullString(newGroup.ExternalID), now, now)
+
+	sqlQuery, args := insertBuilder.Build()
+	if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+		return services.Group{}, mapWriteError(err)
+	}
+
+	if err = insertGroupMembers(ctx, tx, groupID.String(), newGroup.Members, provider); err != nil {
+		return services.Group{}, err
+	}
+
+	if created, err = selectGroup(ctx, tx, groupID.String(), provider); err != nil {
+		return services.Group{}, err
+	}
+
+	if err = insertAuditLog(ctx, tx, model.AuditLogActionSCIMCreateGroup, groupAuditData(created)); err != nil {
+		return services.Group{}, err
+	}
+
+	return created, tx.Commit(ctx)
+}
+
+// UpdateGroup replaces the attributes and members of a group provisioned by the requesting identity provider. The
+// roles of users affected by the change are recomputed from their remaining group memberships in the same
+// transaction. The role mapping of the group is not changed.
+func (s *Store) UpdateGroup(ctx context.Context, scimGroup services.Group) (services.Group, error) {
+	var (
+		updateBuilder = sqlbuilder.PostgreSQL.NewUpdateBuilder()
+		deleteBuilder = sqlbuilder.PostgreSQL.NewDeleteBuilder()
+		groupID       = scimGroup.ID.String()
+		previous      services.Group
+		updated       services.Group
+		tx            pgx.Tx
+	)
+
+	provider, err := requestProvider(ctx)
+	if err != nil {
+		return services.Group{}, err
+	}
+
+	tx, err = s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return services.Group{}, fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer func() {
+		// Rollback is a no-op once the transaction has been committed.
+		_ = tx.Rollback(ctx)
+	}()
+
+	if previous, err = selectGroup(ctx, tx, groupID, provider); err != nil {
+		return services.Group{}, err
+	}
+
+	updateBuilder.Update(tableSCIMGroups)
+	updateBuilder.Set(
+		updateBuilder.Assign("display_name", scimGroup.DisplayName),
+		updateBuilder.Assign("external_id", nullString(scimGroup.ExternalID)),
+		updateBuilder.Assign("updated_at", time.Now().UTC()),
+	)
+	updateBuilder.Where(updateBuilder.Equal("id", groupID))
+
+	sqlQuery, args := updateBuilder.Build()
+	if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+		return services.Group{}, mapWriteError(err)
+	}
+
+	deleteBuilder.DeleteFrom(tableSCIMGroupMembers)
+	deleteBuilder.Where(deleteBuilder.Equal("group_id", groupID))
+
+	sqlQuery, args = deleteBuilder.Build()
+	if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+		return services.Group{}, fmt.Errorf("removing group members: %w", err)
+	}
+
+	if err = insertGroupMembers(ctx, tx, groupID, scimGroup.Members, provider); err != nil {
+		return services.Group{}, err
+	}
+
+	if updated, err = selectGroup(ctx, tx, groupID, provider); err != nil {
+		return services.Group{}, err
+	}
+
+	if err = syncUserRoles(ctx, tx, affectedMembers(previous, updated)); err != nil {
+		return services.Group{}, err
+	}
+
+	if err = insertAuditLog(ctx, tx, model.AuditLogActionSCIMUpdateGroup, groupAuditData(updated)); err != nil {
+		return services.Group{}, err
+	}
+
+	return updated, tx.Commit(ctx)
+}
+
+// DeleteGroup removes a group provisioned by the requesting identity provider. Its members lose the role mapped to the
+// group unless another of their groups grants it.
+func (s *Store) DeleteGroup(ctx context.Context, id uuid.UUID) error {
+	var (
+		deleteBuilder = sqlbuilder.PostgreSQL.NewDeleteBuilder()
+		groupID       = id.String()
+		previous      services.Group
+		commandTag    pgconn.CommandTag
+		tx            pgx.Tx
+	)
+
+	provider, err := requestProvider(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err = s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer func() {
+		// Rollback is a no-op once the transaction has been committed.
+		_ = tx.Rollback(ctx)
+	}()
+
+	if previous, err = selectGroup(ctx, tx, groupID, provider); err != nil {
+		return err
+	}
+
+	deleteBuilder.DeleteFrom(tableSCIMGroups)
+	deleteBuilder.Where(deleteBuilder.Equal("id", groupID))
+
+	sqlQuery, args := deleteBuilder.Build()
+	if commandTag, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("deleting group: %w", err)
+	} else if commandTag.RowsAffected() == 0 {
+		return services.ErrGroupNotFound
+	}
+
+	if err = syncUserRoles(ctx, tx, affectedMembers(previous, services.Group{})); err != nil {
+		return err
+	}
+
+	if err = insertAuditLog(ctx, tx, model.AuditLogActionSCIMDeleteGroup, groupAuditData(previous)); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// insertGroupMembers adds the members to a group. Every member must be a user provisioned within the scope of the
+// given SSO provider, otherwise services.ErrUnknownMember is returned.
+func insertGroupMembers(ctx context.Context, querier queryExecer, groupID string, members []services.MemberReference, provider sql.NullInt32) error {
+	if len(members) == 0 {
+		return nil
+	}
+
+	var (
+		insertBuilder = sqlbuilder.PostgreSQL.NewInsertBuilder()
+		selectBuilder = sqlbuilder.PostgreSQL.NewSelectBuilder()
+	)
+
+	selectBuilder.Select(selectBuilder.Var(groupID), "su.user_id")
+	selectBuilder.From(tableSCIMUsers + " su")
+	selectBuilder.Where(
+		selectBuilder.In("su.user_id", sqlbuilder.List(memberIDs(services.Group{Members: members}))),
+		selectBuilder.IsNotDistinctFrom("su.sso_provider_id", provider),
+	)
+
+	insertBuilder.InsertInto(tableSCIMGroupMembers)
+	insertBuilder.Cols("group_id", "user_id")
+	insertBuilder.SQL(insertBuilder.Var(selectBuilder))
+
+	sqlQuery, args := insertBuilder.Build()
+	if commandTag, err := querier.Exec(ctx, sqlQuery, args...); err != nil {
+		return mapWriteError(err)
+	} else if commandTag.RowsAffected() != int64(len(members)) {
+		return services.ErrUnknownMember
+	}
+
+	return nil
+}
+
+// affectedMembers returns the users whose roles change when a group changes from previous to updated. When the
+// group's role mapping is unchanged only members that joined or left are affected, otherwise all former and current
+// members are. Groups that are not mapped to a role never affect roles.
+func affectedMembers(previous, updated services.Group) []string {
+	var (
+		previousIDs = memberIDs(previous)
+		updatedIDs  = memberIDs(updated)
+		affected    []string
+	)
+
+	if !previous.RoleID.Valid && !updated.RoleID.Valid {
+		return nil
+	} else if previous.RoleID != updated.RoleID {
+		affected = slices.Concat(previousIDs, updatedIDs)
+	} else {
+		for _, userID := range previousIDs {
+			if !slices.Contains(updatedIDs, userID) {
+				affected = append(affected, userID)
+			}
+		}
+		for _, userID := range updatedIDs {
+			if !slices.Contains(previousIDs, userID) {
+				affected = append(affected, userID)
+			}
+		}
+	}
+
+	slices.Sort(affected)
+	return slices.Compact(affected)
+}
+
+func memberIDs(scimGroup services.Group) []string {
+	userIDs := make([]string, 0, len(scimGroup.Members))
+	for _, member := range scimGroup.Members {
+		userIDs = append(userIDs, member.ID.String())
+	}
+
+	return userIDs
+}
+
+// groupAuditData returns the fields recorded in the audit log for a group change.
+func groupAuditData(scimGroup services.Group) model.AuditData {
+	auditData := model.AuditData{
+		"id":           scimGroup.ID.String(),
+		"display_name": scimGroup.DisplayName,
+		"external_id":  scimGroup.ExternalID,
+		"members":      memberIDs(scimGroup),
+	}
+	if scimGroup.RoleID.Valid {
+		auditData["role_id"] = scimGroup.RoleID.Int32
+	}
+
+	return auditData
+}
@@ -0,0 +1,471 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package appdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/huandu/go-sqlbuilder"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/specterops/bloodhound/cmd/api/src/model"
+	"github.com/specterops/bloodhound/server/scim/internal/services"
+)
+
+var validUserColumns = []string{
+	"u.id",
+	"u.principal_name",
+	"COALESCE(u.first_name, '') AS first_name",
+	"COALESCE(u.last_name, '') AS last_name",
+	"COALESCE(u.email_address, '') AS email_address",
+	"COALESCE(u.is_disabled, false) AS is_disabled",
+	"COALESCE(su.external_id, '') AS external_id",
+	"u.created_at",
+	"u.updated_at",
+}
+
+type user struct {
+	ID            string    `db:"id"`
+	PrincipalName string    `db:"principal_name"`
+	FirstName     string    `db:"first_name"`
+	LastName      string    `db:"last_name"`
+	EmailAddress  string    `db:"email_address"`
+	IsDisabled    bool      `db:"is_disabled"`
+	ExternalID    string    `db:"external_id"`
+	CreatedAt     time.Time `db:"created_at"`
+	UpdatedAt     time.Time `db:"updated_at"`
+}
+
+type userGroup struct {
+	UserID      string `db:"user_id"`
+	GroupID     string `db:"group_id"`
+	DisplayName string `db:"display_name"`
+}
+
+func toUser(row user, groupRows []userGroup) services.User {
+	groups := make([]services.GroupReference, 0, len(groupRows))
+	for _, groupRow := range groupRows {
+		groups = append(groups, services.GroupReference{ID: uuid.FromStringOrNil(groupRow.GroupID), DisplayName: groupRow.DisplayName})
+	}
+
+	return services.User{
+		ID:         uuid.FromStringOrNil(row.ID),
+		ExternalID: row.ExternalID,
+		UserName:   row.PrincipalName,
+		GivenName:  row.FirstName,
+		FamilyName: row.LastName,
+		Email:      row.EmailAddress,
+		Active:     !row.IsDisabled,
+		Groups:     groups,
+		CreatedAt:  row.CreatedAt,
+		UpdatedAt:  row.UpdatedAt,
+	}
+}
+
+// newUserSelectBuilder selects the users provisioned within the scope of the given SSO provider along with their SCIM
+// external id. Users that were not provisioned through SCIM, or were provisioned by another identity provider, and
+// support accounts are never exposed.
+func newUserSelectBuilder(provider sql.NullInt32) *sqlbuilder.SelectBuilder {
+	var sb = sqlbuilder.PostgreSQL.NewSelectBuilder()
+
+	sb.Select(validUserColumns...)
+	sb.From(tableUsers + " u")
+	sb.Join(tableSCIMUsers+" su", "su.user_id = u.id")
+	sb.Where("u.support_account IS NOT TRUE", sb.IsNotDistinctFrom("su.sso_provider_id", provider))
+
+	return sb
+}
+
+// provisionedUserCondition matches rows of the users table that were provisioned within the scope of the given SSO
+// provider. It guards writes the same way newUserSelectBuilder guards reads.
+func provisionedUserCondition(cond *sqlbuilder.Cond, provider sql.NullInt32) string {
+	var sb = sqlbuilder.PostgreSQL.NewSelectBuilder()
+
+	sb.Select("1")
+	sb.From(tableSCIMUsers + " su")
+	sb.Where("su.user_id = "+tableUsers+".id", sb.IsNotDistinctFrom("su.sso_provider_id", provider))
+
+	return cond.Exists(sb)
+}
+
+// ListUsers returns the page of users provisioned by the requesting identity provider that match the filter, ordered
+// by principal name, along with the number of matching users.
+func (s *Store) ListUsers(ctx context.Context, options services.ListOptions) ([]services.User, int, error) {
+	provider, err := requestProvider(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var (
+		countBuilder = newUserSelectBuilder(provider)
+		sb           = newUserSelectBuilder(provider)
+		total        int
+	)
+
+	countBuilder.Select("count(*)")
+	whereFilter(countBuilder, options.Filter, userFilterAttributes)
+
+	sqlQuery, args := countBuilder.Build()
+
+	rows, err := s.db.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("counting users: %w", err)
+	}
+	if total, err = pgx.CollectExactlyOneRow(rows, pgx.RowTo[int]); err != nil {
+		return nil, 0, fmt.Errorf("counting users: %w", err)
+	}
+
+	whereFilter(sb, options.Filter, userFilterAttributes)
+	sb.OrderBy("u.principal_name").Offset(options.Offset).Limit(options.Limit)
+
+	sqlQuery, args = sb.Build()
+
+	rows, err = s.db.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	userRows, err := pgx.CollectRows(rows, pgx.RowToStructByName[user])
+	if err != nil {
+		return nil, 0, fmt.Errorf("collecting users: %w", err)
+	}
+
+	var userIDs = make([]string, 0, len(userRows))
+	for _, userRow := range userRows {
+		userIDs = append(userIDs, userRow.ID)
+	}
+
+	groupsByUser, err := getUserGroups(ctx, s.db, userIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	result := make([]services.User, 0, len(userRows))
+	for _, userRow := range userRows {
+		result = append(result, toUser(userRow, groupsByUser[userRow.ID]))
+	}
+
+	return result, total, nil
+}
+
+func (s *Store) GetUser(ctx context.Context, id uuid.UUID) (services.User, error) {
+	if provider, err := requestProvider(ctx); err != nil {
+		return services.User{}, err
+	} else {
+		return selectUser(ctx, s.db, id.String(), provider)
+	}
+}
+
+// selectUser loads a user and its group memberships through the given querier so that it can be read either
+// directly from the pool or from within a transaction that has just written the user.
+func selectUser(ctx context.Context, querier queryExecer, id string, provider sql.NullInt32) (services.User, error) {
+	var sb = newUserSelectBuilder(provider)
+
+	sb.Where(sb.Equal("u.id", id)).Limit(1)
+
+	sqlQuery, args := sb.Build()
+
+	rows, err := querier.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return services.User{}, err
+	}
+	userRow, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[user])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return services.User{}, services.ErrUserNotFound
+	}
+	if err != nil {
+		return services.User{}, fmt.Errorf("finding user: %w", err)
+	}
+
+	groupsByUser, err := getUserGroups(ctx, querier, []string{id})
+	if err != nil {
+		return services.User{}, err
+	}
+
+	return toUser(userRow, groupsByUser[id]), nil
+}
+
+// getUserGroups retrieves the SCIM groups of the given users grouped by user id.
+func getUserGroups(ctx context.Context, querier queryExecer, userIDs []string) (map[string][]userGroup, error) {
+	var (
+		sb           = sqlbuilder.PostgreSQL.NewSelectBuilder()
+		groupsByUser = make(map[string][]userGroup)
+	)
+
+	if len(userIDs) == 0 {
+		return groupsByUser, nil
+	}
+
+	sb.Select("m.user_id", "g.id AS group_id", "g.display_name")
+	sb.From(tableSCIMGroupMembers + " m")
+	sb.Join(tableSCIMGroups+" g", "g.id = m.group_id")
+	sb.Where(sb.In("m.user_id", sqlbuilder.List(userIDs)))
+	sb.OrderBy("g.display_name")
+
+	sqlQuery, args := sb.Build()
+
+	rows, err := querier.Query(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying user groups: %w", err)
+	}
+	groupRows, err := pgx.CollectRows(rows, pgx.RowToStructByName[userGroup])
+	if err != nil {
+		return nil, fmt.Errorf("collecting user groups: %w", err)
+	}
+
+	for _, groupRow := range groupRows {
+		groupsByUser[groupRow.UserID] = append(groupsByUser[groupRow.UserID], groupRow)
+	}
+
+	return groupsByUser, nil
+}
+
+// CreateUser inserts a BloodHound user and its SCIM external id together with an audit log entry in a single
+// transaction. The user is provisioned within the scope of the given SSO provider. As with users created just in time
+// at SSO login the EULA is considered accepted.
+func (s *Store) CreateUser(ctx context.Context, newUser services.User, ssoProviderID sql.NullInt32) (services.User, error) {
+	var (
+		insertBuilder = sqlbuilder.PostgreSQL.NewInsertBuilder()
+		now           = time.Now().UTC()
+		userID, err   = uuid.NewV4()
+		created       services.User
+		tx            pgx.Tx
+	)
+	if err != nil {
+		return services.User{}, fmt.Errorf("generating user id: %w", err)
+	}
+
+	tx, err = s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return services.User{}, fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer func() {
+		// Rollback is a no-op once the transaction has been committed.
+		_ = tx.Rollback(ctx)
+	}()
+
+	insertBuilder.InsertInto(tableUsers)
+	insertBuilder.Cols(
+		"id", "principal_name", "first_name", "last_name", "email_address",
+		"is_disabled", "eula_accepted", "sso_provider_id", "created_at", "updated_at",
+	)
+	insertBuilder.Values(
+		userID.String(),
+		newUser.UserName,
+		nullString(newUser.GivenName),
+		nullString(newUser.FamilyName),
+		nullString(newUser.Email),
+		!newUser.Active,
+		true,
+		ssoProviderID,
+		now,
+		now,
+	)
+
+	sqlQuery, args := insertBuilder.Build()
+	if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+		return services.User{}, mapWriteError(err)
+	}
+
+	if err = upsertSCIMUser(ctx, tx, userID.String(), newUser.ExternalID, ssoProviderID, now); err != nil {
+		return services.User{}, err
+	}
+
+	if created, err = selectUser(ctx, tx, userID.String(), ssoProviderID); err != nil {
+		return services.User{}, err
+	}
+
+	if err = insertAuditLog(ctx, tx, model.AuditLogActionSCIMCreateUser, userAuditData(created)); err != nil {
+		return services.User{}, err
+	}
+
+	return created, tx.Commit(ctx)
+}
+
+// UpdateUser replaces the SCIM attributes of a user provisioned by the requesting identity provider. An inactive user
+// is disabled and their sessions and API tokens are revoked in the same transaction.
+func (s *Store) UpdateUser(ctx context.Context, scimUser services.User) (services.User, error) {
+	var (
+		updateBuilder = sqlbuilder.PostgreSQL.NewUpdateBuilder()
+		now           = time.Now().UTC()
+		userID        = scimUser.ID.String()
+		updated       services.User
+		commandTag    pgconn.CommandTag
+		tx            pgx.Tx
+	)
+
+	provider, err := requestProvider(ctx)
+	if err != nil {
+		return services.User{}, err
+	}
+
+	tx, err = s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return services.User{}, fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer func() {
+		// Rollback is a no-op once the transaction has been committed.
+		_ = tx.Rollback(ctx)
+	}()
+
+	updateBuilder.Update(tableUsers)
+	updateBuilder.Set(
+		updateBuilder.Assign("principal_name", scimUser.UserName),
+		updateBuilder.Assign("first_name", nullString(scimUser.GivenName)),
+		updateBuilder.Assign("last_name", nullString(scimUser.FamilyName)),
+		updateBuilder.Assign("email_address", nullString(scimUser.Email)),
+		updateBuilder.Assign("is_disabled", !scimUser.Active),
+		updateBuilder.Assign("updated_at", now),
+	)
+	updateBuilder.Where(
+		updateBuilder.Equal("id", userID),
+		"support_account IS NOT TRUE",
+		provisionedUserCondition(&updateBuilder.Cond, provider),
+	)
+
+	sqlQuery, args := updateBuilder.Build()
+	if commandTag, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+		return services.User{}, mapWriteError(err)
+	} else if commandTag.RowsAffected() == 0 {
+		return services.User{}, services.ErrUserNotFound
+	}
+
+	if err = upsertSCIMUser(ctx, tx, userID, scimUser.ExternalID, provider, now); err != nil {
+		return services.User{}, err
+	}
+
+	if !scimUser.Active {
+		if err = revokeUserAccess(ctx, tx, userID); err != nil {
+			return services.User{}, err
+		} else if err = ensureAdministratorRemains(ctx, tx); err != nil {
+			return services.User{}, err
+		}
+	}
+
+	if updated, err = selectUser(ctx, tx, userID, provider); err != nil {
+		return services.User{}, err
+	}
+
+	if err = insertAuditLog(ctx, tx, model.AuditLogActionSCIMUpdateUser, userAuditData(updated)); err != nil {
+		return services.User{}, err
+	}
+
+	return updated, tx.Commit(ctx)
+}
+
+// DeprovisionUser disables a user provisioned by the requesting identity provider, revokes their sessions and API
+// tokens and removes them from all SCIM groups. The user keeps no roles granted through SCIM groups; roles assigned
+// locally are retained.
+func (s *Store) DeprovisionUser(ctx context.Context, id uuid.UUID) error {
+	var (
+		updateBuilder = sqlbuilder.PostgreSQL.NewUpdateBuilder()
+		deleteBuilder = sqlbuilder.PostgreSQL.NewDeleteBuilder()
+		userID        = id.String()
+		commandTag    pgconn.CommandTag
+		tx            pgx.Tx
+	)
+
+	provider, err := requestProvider(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err = s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+	defer func() {
+		// Rollback is a no-op once the transaction has been committed.
+		_ = tx.Rollback(ctx)
+	}()
+
+	updateBuilder.Update(tableUsers)
+	updateBuilder.Set(updateBuilder.Assign("is_disabled", true), updateBuilder.Assign("updated_at", time.Now().UTC()))
+	updateBuilder.Where(
+		updateBuilder.Equal("id", userID),
+		"support_account IS NOT TRUE",
+		provisionedUserCondition(&updateBuilder.Cond, provider),
+	)
+
+	sqlQuery, args := updateBuilder.Build()
+	if commandTag, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("disabling user: %w", err)
+	} else if commandTag.RowsAffected() == 0 {
+		return services.ErrUserNotFound
+	}
+
+	if err = revokeUserAccess(ctx, tx, userID); err != nil {
+		return err
+	}
+
+	deleteBuilder.DeleteFrom(tableSCIMGroupMembers)
+	deleteBuilder.Where(deleteBuilder.Equal("user_id", userID))
+
+	sqlQuery, args = deleteBuilder.Build()
+	if _, err = tx.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("removing group memberships: %w", err)
+	}
+
+	if err = syncUserRoles(ctx, tx, []string{userID}); err != nil {
+		return err
+	}
+
+	if err = insertAuditLog(ctx, tx, model.AuditLogActionSCIMDeprovisionUser, model.AuditData{"id": userID}); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// upsertSCIMUser records that the user is provisioned through SCIM. The SSO provider scope of an existing SCIM user
+// is never changed.
+func upsertSCIMUser(ctx context.Context, querier queryExecer, userID, externalID string, provider sql.NullInt32, now time.Time) error {
+	var insertBuilder = sqlbuilder.PostgreSQL.NewInsertBuilder()
+
+	insertBuilder.InsertInto(tableSCIMUsers)
+	insertBuilder.Cols("user_id", "sso_provider_id", "external_id", "created_at", "updated_at")
+	insertBuilder.Values(userID, provider, nullString(externalID), now, now)
+	insertBuilder.SQL("ON CONFLICT (user_id) DO UPDATE SET external_id = EXCLUDED.external_id, updated_at = EXCLUDED.updated_at")
+
+	sqlQuery, args := insertBuilder.Build()
+	if _, err := querier.Exec(ctx, sqlQuery, args...); err != nil {
+		return fmt.Errorf("writing SCIM user: %w", err)
+	}
+
+	return nil
+}
+
+// userAuditData returns the fields recorded in the audit log for a user change.
+func userAuditData(scimUser services.User) model.AuditData {
+	return model.AuditData{
+		"id":             scimUser.ID.String(),
+		"principal_name": scimUser.UserName,
+		"first_name":     scimUser.GivenName,
+		"last_name":      scimUser.FamilyName,
+		"email_address":  scimUser.Email,
+		"is_disabled":    !scimUser.Active,
+		"external_id":    scimUser.ExternalID,
+	}
+}
+
+func nullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
@@ -0,0 +1,624 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+//go:generate go tool mockery
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	"github.com/specterops/bloodhound/cmd/api/src/api/stream"
+	"github.com/specterops/bloodhound/cmd/api/src/utils"
+	"github.com/specterops/bloodhound/packages/go/bhlog/attr"
+	"github.com/specterops/bloodhound/packages/go/headers"
+	"github.com/specterops/bloodhound/packages/go/mediatypes"
+	"github.com/specterops/bloodhound/packages/go/responses"
+	"github.com/specterops/bloodhound/server/scim/internal/services"
+)
+
+const (
+	queryParameterFilter     = "filter"
+	queryParameterStartIndex = "startIndex"
+	queryParameterCount      = "count"
+
+	scimTypeInvalidFilter = "invalidFilter"
+	scimTypeInvalidPath   = "invalidPath"
+	scimTypeInvalidSyntax = "invalidSyntax"
+	scimTypeInvalidValue  = "invalidValue"
+	scimTypeUniqueness    = "uniqueness"
+)
+
+// SCIM defines the SCIM service boundary for the SCIM handlers package.
+type SCIM interface {
+	Authenticate(ctx context.Context, secret string) (services.Token, error)
+	ListTokens(ctx context.Context) ([]services.Token, error)
+	CreateToken(ctx context.Context, template services.TokenTemplate) (services.IssuedToken, error)
+	DeleteToken(ctx context.Context, id uuid.UUID) error
+	ListUsers(ctx context.Context, query services.ListQuery) (services.UserPage, error)
+	GetUser(ctx context.Context, id uuid.UUID) (services.User, error)
+	CreateUser(ctx context.Context, user services.User) (services.User, error)
+	ReplaceUser(ctx context.Context, id uuid.UUID, user services.User) (services.User, error)
+	PatchUser(ctx context.Context, id uuid.UUID, operations []services.PatchOperation) (services.User, error)
+	DeleteUser(ctx context.Context, id uuid.UUID) error
+	ListGroups(ctx context.Context, query services.ListQuery) (services.GroupPage, error)
+	GetGroup(ctx context.Context, id uuid.UUID) (services.Group, error)
+	CreateGroup(ctx context.Context, group services.Group) (services.Group, error)
+	ReplaceGroup(ctx context.Context, id uuid.UUID, group services.Group) (services.Group, error)
+	PatchGroup(ctx context.Context, id uuid.UUID, operations []services.PatchOperation) (services.Group, error)
+	DeleteGroup(ctx context.Context, id uuid.UUID) error
+	ListGroupRoles(ctx context.Context) ([]services.GroupRole, error)
+	SetGroupRole(ctx context.Context, groupID uuid.UUID, roleID int32) (services.GroupRole, error)
+	DeleteGroupRole(ctx context.Context, groupID uuid.UUID) error
+}
+
+// Handlers is a dependency injection container for SCIM handlers.
+type Handlers struct {
+	scim SCIM
+}
+
+// NewHandlersContainer initializes the Handlers dependency injection container
+func NewHandlersContainer(scim SCIM) *Handlers {
+	return &Handlers{
+		scim: scim,
+	}
+}
+
+// Authenticate is a middleware that validates the SCIM bearer token presented by an identity provider and attaches
+// the token to the request context. Requests without a valid token are rejected with a SCIM error.
+func (s *Handlers) Authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+		var (
+			ctx                   = request.Context()
+			scheme, secret, found = strings.Cut(request.Header.Get(headers.Authorization.String()), " ")
+		)
+
+		if !found || !strings.EqualFold(scheme, api.AuthorizationSchemeBearer) {
+			writeUnauthorized(ctx, response)
+			return
+		}
+
+		token, err := s.scim.Authenticate(ctx, strings.TrimSpace(secret))
+		if errors.Is(err, services.ErrInvalidToken) {
+			writeUnauthorized(ctx, response)
+			return
+		} else if err != nil {
+			handleSCIMError(ctx, response, err)
+			return
+		}
+
+		next.ServeHTTP(response, request.WithContext(services.ContextWithToken(ctx, token)))
+	})
+}
+
+// GetServiceProviderConfig returns the SCIM features supported by BloodHound.
+func (s *Handlers) GetServiceProviderConfig(response http.ResponseWriter, request *http.Request) {
+	writeSCIM(request.Context(), BuildServiceProviderConfigView(), http.StatusOK, response)
+}
+
+// ListUsers returns the users matching the SCIM filter and pagination query parameters.
+func (s *Handlers) ListUsers(response http.ResponseWriter, request *http.Request) {
+	var ctx = request.Context()
+
+	query, err := parseListQuery(request)
+	if err != nil {
+		handleSCIMError(ctx, response, err)
+		return
+	}
+
+	page, err := s.scim.ListUsers(ctx, query)
+	if err != nil {
+		handleSCIMError(ctx, response, err)
+		return
+	}
+
+	writeSCIM(ctx, BuildUserListView(page), http.StatusOK, response)
+}
+
+func (s *Handlers) GetUser(response http.ResponseWriter, request *http.Request) {
+	var ctx = request.Context()
+
+	userID, err := parseResourceID(request)
+	if err != nil {
+		handleSCIMError(ctx, response, services.ErrUserNotFound)
+		return
+	}
+
+	user, err := s.scim.GetUser(ctx, userID)
+	if err != nil {
+		handleSCIMError(ctx, response, err)
+		return
+	}
+
+	writeSCIM(ctx, BuildUserView(user), http.StatusOK, response)
+}
+
+func (s *Handlers) CreateUser(response http.ResponseWriter, request *http.Request) {
+	var (
+		ctx         = request.Context()
+		userRequest UserRequest
+	)
+
+	if err := readSCIMPayload(request, &userRequest); err != nil {
+		handleSCIMError(ctx, response, err)
+		return
+	}
+
+	user, err := s.scim.CreateUser(ctx, userRequest.User())
+	if err != nil {
+		handleSCIMError(ctx, response, err)
+		return
+	}
+
+	view := BuildUserView(user)
+	response.Header().Set(headers.Location.String(), view.Meta.Location)
+	writeSCIM(ctx, view, http.StatusCreated, response)
+}
+
+func (s *Handlers) ReplaceUser(response http.ResponseWriter, request *http.Request) {
+	var (
+		ctx         = request.Context()
+		userRequest UserRequest
+	)
+
+	userID, err := parseResourceID(request)
+	if err != nil {
+		handleSCIMError(ctx, response, services.ErrUserNotFound)
+		return
+	}
+
+	if err := readSCIMPayload(request, &userRequest); err != nil {
+		handleSCIMError(ctx, response, err)
+		return
+	}
+
+	user, err := s.scim.ReplaceUser(ctx, userID, userRequest.User())
+	if err != nil {
+		handleSCIMError(ctx, response, err)
+		return
+	}
+
+	writeSCIM(ctx, BuildUserView(user), http.StatusOK, response)
+}
+
+func (s *Handlers) PatchUser(response http.ResponseWriter, request *http.Request) {
+	var (
+		ctx          = request.Context()
+		patchRequest PatchRequest
+	)
+
+	userID, err := parseResourceID(request)
+	if err != nil {
+		handleSCIMError(ctx, response, services.ErrUserNotFound)
+		return
+	}
+
+	if err := readSCIMPayload(request, &patchRequest); err != nil {
+		handleSCIMError(ctx, response, err)
+		return
+	}
+
+	user, err := s.scim.PatchUser(ctx, userID, patchRequest.PatchOperations())
+	if err != nil {
+		handleSCIMError(ctx, response, err)
+		return
+	}
+
+	writeSCIM(ctx, BuildUserView(user), http.StatusOK, response)
+}
+
+// DeleteUser deprovisions the user by disabling it and revoking its sessions and API tokens.
+func (s *Handlers) DeleteUser(response http.ResponseWriter, request *http.Request) {
+	var ctx = request.Context()
+
+	userID, err := parseResourceID(request)
+	if err != nil {
+		handleSCIMError(ctx, response, services.ErrUserNotFound)
+		return
+	}
+
+	if err := s.scim.DeleteUser(ctx, userID); err != nil {
+		handleSCIMError(ctx, response, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusNoContent)
+}
+
+// ListGroups returns the groups matching the SCIM filter and pagination query parameters.
+func (s *Handlers) ListGroups(response http.ResponseWriter, request *http.Request) {
+	var ctx = request.Context()
+
+	query, err := parseListQuery(request)
+	if err != nil {
+		handleSCIMError(ctx, response, err)
+		return
+	}
+
+	page, err := s.scim.ListGroups(ctx, query)
+	if err != nil {
+		handleSCIMError(ctx, response, err)
+		return
+	}
+
+	writeSCIM(ctx, BuildGroupListView(page), http.StatusOK, response)
+}
+
+func (s *Handlers) GetGroup(response http.ResponseWriter, request *http.Request) {
+	var ctx = request.Context()
+
+	groupID, err := parseResourceID(request)
+	if err != nil {
+		handleSCIMError(ctx, response, services.ErrGroupNotFound)
+		return
+	}
+
+	group, err := s.scim.GetGroup(ctx, groupID)
+	if err != nil {
+		handleSCIMError(ctx, response, err)
+		return
+	}
+
+	writeSCIM(ctx, BuildGroupView(group), http.StatusOK, response)
+}
+
+func (s *Handlers) CreateGroup(response http.ResponseWriter, request *http.Request) {
+	var (
+		ctx          = request.Context()
+		groupRequest GroupRequest
+	)
+
+	if err := readSCIMPayload(request, &groupRequest); err != nil {
+		handleSCIMError(ctx, response, err)
+		return
+	}
+
+	template, err := groupRequest.Group()
+	if err != nil {
+		handleSCIMError(ctx, response, err)
+		return
+	}
+
+	group, err := s.scim.CreateGroup(ctx, template)
+	if err != nil {
+		handleSCIMError(ctx, response, err)
+		return
+	}
+
+	view := BuildGroupView(group)
+	response.Header().Set(headers.Location.String(), view.Meta.Location)
+	writeSCIM(ctx, view, http.StatusCreated, response)
+}
+
+func (s *Handlers) ReplaceGroup(response http.ResponseWriter, request *http.Request) {
+	var (
+		ctx          = request.Context()
+		groupRequest GroupRequest
+	)
+
+	groupID, err := parseResourceID(request)
+	if err != nil {
+		handleSCIMError(ctx, response, services.ErrGroupNotFound)
+		return
+	}
+
+	if err := readSCIMPayload(request, &groupRequest); err != nil {
+		handleSCIMError(ctx, response, err)
+		return
+	}
+
+	template, err := groupRequest.Group()
+	if err != nil {
+		handleSCIMError(ctx, response, err)
+		return
+	}
+
+	group, err := s.scim.ReplaceGroup(ctx, groupID, template)
+	if err != nil {
+		handleSCIMError(ctx, response, err)
+		return
+	}
+
+	writeSCIM(ctx, BuildGroupView(group), http.StatusOK, response)
+}
+
+func (s *Handlers) PatchGroup(response http.ResponseWriter, request *http.Request) {
+	var (
+		ctx          = request.Context()
+		patchRequest PatchRequest
+	)
+
+	groupID, err := parseResourceID(request)
+	if err != nil {
+		handleSCIMError(ctx, response, services.ErrGroupNotFound)
+		return
+	}
+
+	if err := readSCIMPayload(request, &patchRequest); err != nil {
+		handleSCIMError(ctx, response, err)
+		return
+	}
+
+	group, err := s.scim.PatchGroup(ctx, groupID, patchRequest.PatchOperations())
+	if err != nil {
+		handleSCIMError(ctx, response, err)
+		return
+	}
+
+	writeSCIM(ctx, BuildGroupView(group), http.StatusOK, response)
+}
+
+func (s *Handlers) DeleteGroup(response http.ResponseWriter, request *http.Request) {
+	var ctx = request.Context()
+
+	groupID, err := parseResourceID(request)
+	if err != nil {
+		handleSCIMError(ctx, response, services.ErrGroupNotFound)
+		return
+	}
+
+	if err := s.scim.DeleteGroup(ctx, groupID); err != nil {
+		handleSCIMError(ctx, response, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusNoContent)
+}
+
+// ListTokens returns the SCIM tokens without their secrets.
+func (s *Handlers) ListTokens(response http.ResponseWriter, request *http.Request) {
+	var ctx = request.Context()
+
+	tokens, err := s.scim.ListTokens(ctx)
+	if err != nil {
+		handleTokenError(ctx, response, err)
+		return
+	}
+
+	responses.WriteBasic(ctx, BuildTokenListView(tokens), http.StatusOK, response)
+}
+
+// CreateToken creates a SCIM token. The response is the only time the token secret is returned.
+func (s *Handlers) CreateToken(response http.ResponseWriter, request *http.Request) {
+	var (
+		ctx          = request.Context()
+		tokenRequest TokenRequest
+	)
+
+	if err := api.ReadJSONRequestPayloadLimited(&tokenRequest, request); err != nil {
+		responses.WriteError(ctx, http.StatusBadRequest, api.ErrorResponsePayloadUnmarshalError, response)
+		return
+	}
+
+	issuedToken, err := s.scim.CreateToken(ctx, tokenRequest.TokenTemplate())
+	if err != nil {
+		handleTokenError(ctx, response, err)
+		return
+	}
+
+	view := BuildTokenView(issuedToken.Token)
+	view.Token = issuedToken.Secret
+
+	responses.WriteBasic(ctx, view, http.StatusCreated, response)
+}
+
+func (s *Handlers) DeleteToken(response http.ResponseWriter, request *http.Request) {
+	var ctx = request.Context()
+
+	tokenID, err := uuid.FromString(mux.Vars(request)[api.URIPathVariableSCIMTokenID])
+	if err != nil {
+		responses.WriteError(ctx, http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, response)
+		return
+	}
+
+	if err := s.scim.DeleteToken(ctx, tokenID); err != nil {
+		handleTokenError(ctx, response, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusNoContent)
+}
+
+// ListGroupRoles returns every SCIM group along with the role it is mapped to.
+func (s *Handlers) ListGroupRoles(response http.ResponseWriter, request *http.Request) {
+	var ctx = request.Context()
+
+	groupRoles, err := s.scim.ListGroupRoles(ctx)
+	if err != nil {
+		handleGroupRoleError(ctx, response, err)
+		return
+	}
+
+	responses.WriteBasic(ctx, BuildGroupRoleListView(groupRoles), http.StatusOK, response)
+}
+
+// SetGroupRole maps a SCIM group to a role. Members of the group are granted the role.
+func (s *Handlers) SetGroupRole(response http.ResponseWriter, request *http.Request) {
+	var (
+		ctx              = request.Context()
+		groupRoleRequest GroupRoleRequest
+	)
+
+	groupID, err := parseResourceID(request)
+	if err != nil {
+		responses.WriteError(ctx, http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, response)
+		return
+	}
+
+	if err := api.ReadJSONRequestPayloadLimited(&groupRoleRequest, request); err != nil {
+		responses.WriteError(ctx, http.StatusBadRequest, api.ErrorResponsePayloadUnmarshalError, response)
+		return
+	}
+
+	groupRole, err := s.scim.SetGroupRole(ctx, groupID, groupRoleRequest.RoleID)
+	if err != nil {
+		handleGroupRoleError(ctx, response, err)
+		return
+	}
+
+	responses.WriteBasic(ctx, BuildGroupRoleView(groupRole), http.StatusOK, response)
+}
+
+// DeleteGroupRole removes the role mapping of a SCIM group.
+func (s *Handlers) DeleteGroupRole(response http.ResponseWriter, request *http.Request) {
+	var ctx = request.Context()
+
+	groupID, err := parseResourceID(request)
+	if err != nil {
+		responses.WriteError(ctx, http.StatusBadRequest, api.ErrorResponseDetailsIDMalformed, response)
+		return
+	}
+
+	if err := s.scim.DeleteGroupRole(ctx, groupID); err != nil {
+		handleGroupRoleError(ctx, response, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusNoContent)
+}
+
+func parseResourceID(request *http.Request) (uuid.UUID, error) {
+	return uuid.FromString(mux.Vars(request)[api.URIPathVariableSCIMResourceID])
+}
+
+// parseListQuery reads the SCIM filter and pagination query parameters. When count is omitted a full page is
+// returned.
+func parseListQuery(request *http.Request) (services.ListQuery, error) {
+	var (
+		queryParameters = request.URL.Query()
+		query           = services.ListQuery{
+			Filter:     queryParameters.Get(queryParameterFilter),
+			StartIndex: 1,
+			Count:      services.MaxPageSize,
+		}
+		err error
+	)
+
+	if rawStartIndex := queryParameters.Get(queryParameterStartIndex); rawStartIndex != "" {
+		if query.StartIndex, err = strconv.Atoi(rawStartIndex); err != nil {
+			return services.ListQuery{}, fmt.Errorf("%w: startIndex must be an integer", services.ErrInvalidValue)
+		}
+	}
+
+	if rawCount := queryParameters.Get(queryParameterCount); rawCount != "" {
+		if query.Count, err = strconv.Atoi(rawCount); err != nil {
+			return services.ListQuery{}, fmt.Errorf("%w: count must be an integer", services.ErrInvalidValue)
+		}
+	}
+
+	return query, nil
+}
+
+// readSCIMPayload decodes a request body sent as either application/scim+json or application/json.
+func readSCIMPayload(request *http.Request, value any) error {
+	if !utils.HeaderMatches(request.Header, headers.ContentType.String(), mediatypes.ApplicationScimJson.String(), mediatypes.ApplicationJson.String()) {
+		return fmt.Errorf("%w: %w", services.ErrInvalidSyntax, api.ErrContentTypeJson)
+	} else if request.Body == nil {
+		return fmt.Errorf("%w: %w", services.ErrInvalidSyntax, api.ErrNoRequestBody)
+	} else if err := json.NewDecoder(stream.NewLimitedReader(api.DefaultAPIPayloadReadLimitBytes, request.Body)).Decode(value); err != nil {
+		return fmt.Errorf("%w: %w", services.ErrInvalidSyntax, err)
+	}
+
+	return nil
+}
+
+// writeSCIM writes a SCIM resource or message with the application/scim+json media type required by RFC 7644.
+func writeSCIM(ctx context.Context, data responses.JSONViewer, statusCode int, response http.ResponseWriter) {
+	rawData, err := data.JSONView()
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed marshaling data for SCIM response", attr.Error(err))
+		response.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	response.Header().Set(headers.ContentType.String(), mediatypes.ApplicationScimJson.String())
+	response.WriteHeader(statusCode)
+	if _, err := response.Write(rawData); err != nil {
+		slog.ErrorContext(ctx, "Failed to write SCIM response body", attr.Error(err))
+	}
+}
+
+func writeSCIMError(ctx context.Context, response http.ResponseWriter, statusCode int, scimType, detail string) {
+	writeSCIM(ctx, ErrorView{
+		Schemas:  []string{SchemaError},
+		Status:   strconv.Itoa(statusCode),
+		SCIMType: scimType,
+		Detail:   detail,
+	}, statusCode, response)
+}
+
+func writeUnauthorized(ctx context.Context, response http.ResponseWriter) {
+	response.Header().Set(headers.WWWAuthenticate.String(), "Bearer")
+	writeSCIMError(ctx, response, http.StatusUnauthorized, "", services.ErrInvalidToken.Error())
+}
+
+func handleSCIMError(ctx context.Context, response http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, services.ErrUserNotFound) || errors.Is(err, services.ErrGroupNotFound):
+		writeSCIMError(ctx, response, http.StatusNotFound, "", err.Error())
+	case errors.Is(err, services.ErrUserNameConflict) || errors.Is(err, services.ErrDisplayNameConflict):
+		writeSCIMError(ctx, response, http.StatusConflict, scimTypeUniqueness, err.Error())
+	case errors.Is(err, services.ErrLastAdministrator):
+		writeSCIMError(ctx, response, http.StatusConflict, "", err.Error())
+	case errors.Is(err, services.ErrInvalidFilter):
+		writeSCIMError(ctx, response, http.StatusBadRequest, scimTypeInvalidFilter, err.Error())
+	case errors.Is(err, services.ErrInvalidPath):
+		writeSCIMError(ctx, response, http.StatusBadRequest, scimTypeInvalidPath, err.Error())
+	case errors.Is(err, services.ErrInvalidSyntax):
+		writeSCIMError(ctx, response, http.StatusBadRequest, scimTypeInvalidSyntax, err.Error())
+	case errors.Is(err, services.ErrInvalidValue) || errors.Is(err, services.ErrUnknownMember):
+		writeSCIMError(ctx, response, http.StatusBadRequest, scimTypeInvalidValue, err.Error())
+	default:
+		slog.ErrorContext(ctx, "Unexpected SCIM service error", attr.Error(err))
+		writeSCIMError(ctx, response, http.StatusInternalServerError, "", "an internal error has occurred that is preventing the service from servicing this request")
+	}
+}
+
+func handleTokenError(ctx context.Context, response http.ResponseWriter, err error) {
+	if errors.Is(err, services.ErrTokenNotFound) {
+		responses.WriteError(ctx, http.StatusNotFound, api.ErrorResponseDetailsResourceNotFound, response)
+	} else if errors.Is(err, services.ErrInvalidTokenName) || errors.Is(err, services.ErrUnknownSSOProvider) {
+		responses.WriteError(ctx, http.StatusBadRequest, err.Error(), response)
+	} else if errors.Is(err, context.DeadlineExceeded) {
+		responses.WriteError(ctx, http.StatusInternalServerError, api.ErrorResponseRequestTimeout, response)
+	} else {
+		responses.WriteInternalServerError(ctx, err, response)
+	}
+}
+
+func handleGroupRoleError(ctx context.Context, response http.ResponseWriter, err error) {
+	if errors.Is(err, services.ErrGroupNotFound) {
+		responses.WriteError(ctx, http.StatusNotFound, api.ErrorResponseDetailsResourceNotFound, response)
+	} else if errors.Is(err, services.ErrUnknownRole) {
+		responses.WriteError(ctx, http.StatusBadRequest, err.Error(), response)
+	} else if errors.Is(err, services.ErrLastAdministrator) {
+		responses.WriteError(ctx, http.StatusConflict, err.Error(), response)
+	} else if errors.Is(err, context.DeadlineExceeded) {
+		responses.WriteError(ctx, http.StatusInternalServerError, api.ErrorResponseRequestTimeout, response)
+	} else {
+		responses.WriteInternalServerError(ctx, err, response)
+	}
+}
@@ -0,0 +1,466 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/server/scim/internal/handlers"
+	"github.com/specterops/bloodhound/server/scim/internal/handlers/mocks"
+	"github.com/specterops/bloodhound/server/scim/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newSCIMRequest(t *testing.T, method, target, body string, vars map[string]string) *http.Request {
+	t.Helper()
+	req, err := http.NewRequest(method, target, strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/scim+json")
+	return mux.SetURLVars(req, vars)
+}
+
+func TestHandlers_Authenticate(t *testing.T) {
+	var token = services.Token{ID: uuid.Must(uuid.NewV4()), Name: "Okta"}
+
+	tests := []struct {
+		name          string
+		authorization string
+		expect        func(m *mocks.MockSCIM)
+		wantStatus    int
+		wantNext      bool
+	}{
+		{
+			name:          "passes the token to the next handler",
+			authorization: "Bearer secret",
+			expect: func(m *mocks.MockSCIM) {
+				m.EXPECT().Authenticate(mock.Anything, "secret").Return(token, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantNext:   true,
+		},
+		{
+			name:          "accepts a lower case scheme",
+			authorization: "bearer secret",
+			expect: func(m *mocks.MockSCIM) {
+				m.EXPECT().Authenticate(mock.Anything, "secret").Return(token, nil)
+			},
+			wantStatus: http.StatusOK,
+			wantNext:   true,
+		},
+		{
+			name:       "returns 401 without an authorization header",
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:          "returns 401 for other schemes",
+			authorization: "Basic c2VjcmV0",
+			wantStatus:    http.StatusUnauthorized,
+		},
+		{
+			name:          "returns 401 for unknown tokens",
+			authorization: "Bearer secret",
+			expect: func(m *mocks.MockSCIM) {
+				m.EXPECT().Authenticate(mock.Anything, "secret").Return(services.Token{}, services.ErrInvalidToken)
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:          "returns 500 on unexpected service error",
+			authorization: "Bearer secret",
+			expect: func(m *mocks.MockSCIM) {
+				m.EXPECT().Authenticate(mock.Anything, "secret").Return(services.Token{}, errors.New("connection refused"))
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				scimMock   = mocks.NewMockSCIM(t)
+				handlerSet = handlers.NewHandlersContainer(scimMock)
+				recorder   = httptest.NewRecorder()
+				request    = newSCIMRequest(t, http.MethodGet, "/scim/v2/Users", "", nil)
+				nextCalled bool
+			)
+
+			if tt.expect != nil {
+				tt.expect(scimMock)
+			}
+
+			if tt.authorization != "" {
+				request.Header.Set("Authorization", tt.authorization)
+			}
+
+			handlerSet.Authenticate(http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+				nextCalled = true
+
+				actual, ok := services.TokenFromContext(request.Context())
+				assert.True(t, ok)
+				assert.Equal(t, token, actual)
+			})).ServeHTTP(recorder, request)
+
+			assert.Equal(t, tt.wantStatus, recorder.Code)
+			assert.Equal(t, tt.wantNext, nextCalled)
+
+			if recorder.Code == http.StatusUnauthorized {
+				assert.Equal(t, "Bearer", recorder.Header().Get("WWW-Authenticate"))
+				assert.Equal(t, "application/scim+json", recorder.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
+
+func TestHandlers_ListUsers(t *testing.T) {
+	var user = services.User{ID: uuid.Must(uuid.NewV4()), UserName: "alice@example.com", Email: "alice@example.com", Active: true}
+
+	tests := []struct {
+		name         string
+		query        string
+		expect       func(m *mocks.MockSCIM)
+		wantStatus   int
+		wantSCIMType string
+	}{
+		{
+			name:  "returns a list response for the query",
+			query: "?filter=" + `userName+eq+"alice@example.com"` + "&startIndex=1&count=10",
+			expect: func(m *mocks.MockSCIM) {
+				m.EXPECT().ListUsers(mock.Anything, services.ListQuery{Filter: `userName eq "alice@example.com"`, StartIndex: 1, Count: 10}).Return(services.UserPage{Users: []services.User{user}, TotalResults: 1, StartIndex: 1}, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:  "defaults to the first full page",
+			query: "",
+			expect: func(m *mocks.MockSCIM) {
+				m.EXPECT().ListUsers(mock.Anything, services.ListQuery{StartIndex: 1, Count: services.MaxPageSize}).Return(services.UserPage{Users: []services.User{user}, TotalResults: 1, StartIndex: 1}, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:         "returns 400 for a non-numeric count",
+			query:        "?count=ten",
+			wantStatus:   http.StatusBadRequest,
+			wantSCIMType: "invalidValue",
+		},
+		{
+			name:  "returns 400 for an invalid filter",
+			query: "?filter=userName+eq",
+			expect: func(m *mocks.MockSCIM) {
+				m.EXPECT().ListUsers(mock.Anything, mock.Anything).Return(services.UserPage{}, services.ErrInvalidFilter)
+			},
+			wantStatus:   http.StatusBadRequest,
+			wantSCIMType: "invalidFilter",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				scimMock   = mocks.NewMockSCIM(t)
+				handlerSet = handlers.NewHandlersContainer(scimMock)
+				recorder   = httptest.NewRecorder()
+				request    = newSCIMRequest(t, http.MethodGet, "/scim/v2/Users"+tt.query, "", nil)
+			)
+
+			if tt.expect != nil {
+				tt.expect(scimMock)
+			}
+
+			handlerSet.ListUsers(recorder, request)
+
+			assert.Equal(t, tt.wantStatus, recorder.Code)
+			assert.Equal(t, "application/scim+json", recorder.Header().Get("Content-Type"))
+
+			if tt.wantStatus == http.StatusOK {
+				var listResponse struct {
+					Schemas      []string            `json:"schemas"`
+					TotalResults int                 `json:"totalResults"`
+					Resources    []handlers.UserView `json:"Resources"`
+				}
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &listResponse))
+				assert.Equal(t, []string{handlers.SchemaListResponse}, listResponse.Schemas)
+				assert.Equal(t, 1, listResponse.TotalResults)
+				require.Len(t, listResponse.Resources, 1)
+				assert.Equal(t, user.UserName, listResponse.Resources[0].UserName)
+			} else {
+				var errorResponse handlers.ErrorView
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &errorResponse))
+				assert.Equal(t, tt.wantSCIMType, errorResponse.SCIMType)
+			}
+		})
+	}
+}
+
+func TestHandlers_CreateUser(t *testing.T) {
+	var created = services.User{ID: uuid.Must(uuid.NewV4()), UserName: "alice@example.com", GivenName: "Alice", Email: "alice@example.com", Active: true}
+
+	tests := []struct {
+		name         string
+		contentType  string
+		body         string
+		expect       func(m *mocks.MockSCIM)
+		wantStatus   int
+		wantSCIMType string
+	}{
+		{
+			name:        "returns 201 with the location of the created user",
+			contentType: "application/scim+json",
+			body:        `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],"userName":"alice@example.com","name":{"givenName":"Alice"},"emails":[{"value":"alice@example.com","primary":true}]}`,
+			expect: func(m *mocks.MockSCIM) {
+				m.EXPECT().CreateUser(mock.Anything, services.User{UserName: "alice@example.com", GivenName: "Alice", Email: "alice@example.com", Active: true}).Return(created, nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:        "accepts application/json",
+			contentType: "application/json",
+			body:        `{"userName":"alice@example.com","active":false}`,
+			expect: func(m *mocks.MockSCIM) {
+				m.EXPECT().CreateUser(mock.Anything, services.User{UserName: "alice@example.com"}).Return(created, nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:         "returns 400 for a malformed body",
+			contentType:  "application/scim+json",
+			body:         `{"userName":`,
+			wantStatus:   http.StatusBadRequest,
+			wantSCIMType: "invalidSyntax",
+		},
+		{
+			name:         "returns 400 for other content types",
+			contentType:  "text/plain",
+			body:         `{"userName":"alice@example.com"}`,
+			wantStatus:   http.StatusBadRequest,
+			wantSCIMType: "invalidSyntax",
+		},
+		{
+			name:        "returns 409 when the userName is taken",
+			contentType: "application/scim+json",
+			body:        `{"userName":"alice@example.com"}`,
+			expect: func(m *mocks.MockSCIM) {
+				m.EXPECT().CreateUser(mock.Anything, mock.Anything).Return(services.User{}, services.ErrUserNameConflict)
+			},
+			wantStatus:   http.StatusConflict,
+			wantSCIMType: "uniqueness",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				scimMock   = mocks.NewMockSCIM(t)
+				handlerSet = handlers.NewHandlersContainer(scimMock)
+				recorder   = httptest.NewRecorder()
+				request    = newSCIMRequest(t, http.MethodPost, "/scim/v2/Users", tt.body, nil)
+			)
+
+			if tt.expect != nil {
+				tt.expect(scimMock)
+			}
+
+			request.Header.Set("Content-Type", tt.contentType)
+			handlerSet.CreateUser(recorder, request)
+
+			assert.Equal(t, tt.wantStatus, recorder.Code)
+			if tt.wantStatus == http.StatusCreated {
+				var view handlers.UserView
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &view))
+				assert.Equal(t, created.ID.String(), view.ID)
+				assert.Equal(t, "/scim/v2/Users/"+created.ID.String(), recorder.Header().Get("Location"))
+				assert.Equal(t, view.Meta.Location, recorder.Header().Get("Location"))
+			} else {
+				var errorResponse handlers.ErrorView
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &errorResponse))
+				assert.Equal(t, tt.wantSCIMType, errorResponse.SCIMType)
+			}
+		})
+	}
+}
+
+func TestHandlers_PatchGroup(t *testing.T) {
+	var (
+		groupID = uuid.Must(uuid.NewV4())
+		userID  = uuid.Must(uuid.NewV4())
+		patched = services.Group{ID: groupID, DisplayName: "Engineering", Members: []services.MemberReference{{ID: userID, UserName: "alice@example.com"}}}
+	)
+
+	tests := []struct {
+		name       string
+		rawID      string
+		body       string
+		expect     func(m *mocks.MockSCIM)
+		wantStatus int
+	}{
+		{
+			name:  "returns 200 with the patched group",
+			rawID: groupID.String(),
+			body:  `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"add","path":"members","value":[{"value":"` + userID.String() + `"}]}]}`,
+			expect: func(m *mocks.MockSCIM) {
+				m.EXPECT().PatchGroup(mock.Anything, groupID, []services.PatchOperation{{Op: "add", Path: "members", Value: json.RawMessage(`[{"value":"` + userID.String() + `"}]`)}}).Return(patched, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "returns 404 for a malformed group ID",
+			rawID:      "not-a-uuid",
+			body:       `{"Operations":[]}`,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:  "returns 404 when the group does not exist",
+			rawID: groupID.String(),
+			body:  `{"Operations":[]}`,
+			expect: func(m *mocks.MockSCIM) {
+				m.EXPECT().PatchGroup(mock.Anything, groupID, []services.PatchOperation{}).Return(services.Group{}, services.ErrGroupNotFound)
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				scimMock   = mocks.NewMockSCIM(t)
+				handlerSet = handlers.NewHandlersContainer(scimMock)
+				recorder   = httptest.NewRecorder()
+				request    = newSCIMRequest(t, http.MethodPatch, "/scim/v2/Groups/"+tt.rawID, tt.body, map[string]string{"scim_resource_id": tt.rawID})
+			)
+
+			if tt.expect != nil {
+				tt.expect(scimMock)
+			}
+
+			handlerSet.PatchGroup(recorder, request)
+
+			assert.Equal(t, tt.wantStatus, recorder.Code)
+			if tt.wantStatus == http.StatusOK {
+				var view handlers.GroupView
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &view))
+				assert.Equal(t, groupID.String(), view.ID)
+				require.Len(t, view.Members, 1)
+				assert.Equal(t, userID.String(), view.Members[0].Value)
+				assert.Equal(t, "alice@example.com", view.Members[0].Display)
+			}
+		})
+	}
+}
+
+func TestHandlers_DeleteUser(t *testing.T) {
+	var userID = uuid.Must(uuid.NewV4())
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{name: "returns 204 on success", wantStatus: http.StatusNoContent},
+		{name: "returns 404 when the user does not exist", err: services.ErrUserNotFound, wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				scimMock   = mocks.NewMockSCIM(t)
+				handlerSet = handlers.NewHandlersContainer(scimMock)
+				recorder   = httptest.NewRecorder()
+				request    = newSCIMRequest(t, http.MethodDelete, "/scim/v2/Users/"+userID.String(), "", map[string]string{"scim_resource_id": userID.String()})
+			)
+
+			scimMock.EXPECT().DeleteUser(mock.Anything, userID).Return(tt.err)
+
+			handlerSet.DeleteUser(recorder, request)
+
+			assert.Equal(t, tt.wantStatus, recorder.Code)
+		})
+	}
+}
+
+func TestHandlers_CreateToken(t *testing.T) {
+	var issued = services.IssuedToken{
+		Token:  services.Token{ID: uuid.Must(uuid.NewV4()), Name: "Okta"},
+		Secret: "secret",
+	}
+
+	tests := []struct {
+		name       string
+		body       string
+		expect     func(m *mocks.MockSCIM, ctx context.Context)
+		wantStatus int
+	}{
+		{
+			name: "returns 201 with the token secret",
+			body: `{"name":"Okta"}`,
+			expect: func(m *mocks.MockSCIM, ctx context.Context) {
+				m.EXPECT().CreateToken(ctx, services.TokenTemplate{Name: "Okta"}).Return(issued, nil)
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name: "returns 400 for an empty name",
+			body: `{"name":""}`,
+			expect: func(m *mocks.MockSCIM, ctx context.Context) {
+				m.EXPECT().CreateToken(ctx, services.TokenTemplate{}).Return(services.IssuedToken{}, services.ErrInvalidTokenName)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "returns 400 for a malformed body",
+			body:       `{"name":`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				scimMock   = mocks.NewMockSCIM(t)
+				handlerSet = handlers.NewHandlersContainer(scimMock)
+				recorder   = httptest.NewRecorder()
+				request    = newSCIMRequest(t, http.MethodPost, "/api/v2/scim/tokens", tt.body, nil)
+			)
+
+			if tt.expect != nil {
+				tt.expect(scimMock, request.Context())
+			}
+
+			request.Header.Set("Content-Type", "application/json")
+			handlerSet.CreateToken(recorder, request)
+
+			assert.Equal(t, tt.wantStatus, recorder.Code)
+			if tt.wantStatus == http.StatusCreated {
+				var envelope struct {
+					Data handlers.TokenView `json:"data"`
+				}
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &envelope))
+				assert.Equal(t, issued.ID, envelope.Data.ID)
+				assert.Equal(t, issued.Secret, envelope.Data.Token)
+			}
+		})
+	}
+}
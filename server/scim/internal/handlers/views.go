@@ -0,0 +1,469 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package handlers
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	"github.com/specterops/bloodhound/cmd/api/src/database/types/null"
+	"github.com/specterops/bloodhound/server/scim/internal/services"
+)
+
+// SCIM schema URNs defined by RFC 7643 and RFC 7644.
+const (
+	SchemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SchemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	SchemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SchemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SchemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+
+	resourceTypeUser  = "User"
+	resourceTypeGroup = "Group"
+	emailTypeWork     = "work"
+)
+
+// MetaView is the SCIM meta attribute common to all resources.
+type MetaView struct {
+	ResourceType string    `json:"resourceType"`
+	Created      time.Time `json:"created"`
+	LastModified time.Time `json:"lastModified"`
+	Location     string    `json:"location"`
+}
+
+// ReferenceView is an element of the groups attribute of a user or the members attribute of a group.
+type ReferenceView struct {
+	Value   string `json:"value"`
+	Ref     string `json:"$ref"`
+	Display string `json:"display,omitempty"`
+}
+
+// NameView is the SCIM name attribute of a user.
+type NameView struct {
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+// EmailView is an element of the SCIM emails attribute of a user. BloodHound stores a single email address which is
+// reported as the primary work address.
+type EmailView struct {
+	Value   string `json:"value"`
+	Type    string `json:"type"`
+	Primary bool   `json:"primary"`
+}
+
+// UserView is the SCIM representation of a BloodHound user.
+type UserView struct {
+	Schemas    []string        `json:"schemas"`
+	ID         string          `json:"id"`
+	ExternalID string          `json:"externalId,omitempty"`
+	UserName   string          `json:"userName"`
+	Name       NameView        `json:"name"`
+	Emails     []EmailView     `json:"emails,omitempty"`
+	Active     bool            `json:"active"`
+	Groups     []ReferenceView `json:"groups,omitempty"`
+	Meta       MetaView        `json:"meta"`
+}
+
+// BuildUserView projects a services.User into its SCIM representation.
+func BuildUserView(user services.User) UserView {
+	var (
+		location = userLocation(user.ID)
+		groups   = make([]ReferenceView, 0, len(user.Groups))
+		emails   []EmailView
+	)
+
+	for _, group := range user.Groups {
+		groups = append(groups, ReferenceView{Value: group.ID.String(), Ref: groupLocation(group.ID), Display: group.DisplayName})
+	}
+
+	if user.Email != "" {
+		emails = []EmailView{{Value: user.Email, Type: emailTypeWork, Primary: true}}
+	}
+
+	return UserView{
+		Schemas:    []string{SchemaUser},
+		ID:         user.ID.String(),
+		ExternalID: user.ExternalID,
+		UserName:   user.UserName,
+		Name:       NameView{GivenName: user.GivenName, FamilyName: user.FamilyName},
+		Emails:     emails,
+		Active:     user.Active,
+		Groups:     groups,
+		Meta: MetaView{
+			ResourceType: resourceTypeUser,
+			Created:      user.CreatedAt,
+			LastModified: user.UpdatedAt,
+			Location:     location,
+		},
+	}
+}
+
+// JSONView marshals the view to the byte slice expected by responses.WriteJSON, satisfying the responses.JSONViewer
+// contract.
+func (s UserView) JSONView() ([]byte, error) {
+	return json.Marshal(s)
+}
+
+// GroupView is the SCIM representation of a SCIM group.
+type GroupView struct {
+	Schemas     []string        `json:"schemas"`
+	ID          string          `json:"id"`
+	ExternalID  string          `json:"externalId,omitempty"`
+	DisplayName string          `json:"displayName"`
+	Members     []ReferenceView `json:"members"`
+	Meta        MetaView        `json:"meta"`
+}
+
+// BuildGroupView projects a services.Group into its SCIM representation.
+func BuildGroupView(group services.Group) GroupView {
+	var members = make([]ReferenceView, 0, len(group.Members))
+	for _, member := range group.Members {
+		members = append(members, ReferenceView{Value: member.ID.String(), Ref: userLocation(member.ID), Display: member.UserName})
+	}
+
+	return GroupView{
+		Schemas:     []string{SchemaGroup},
+		ID:          group.ID.String(),
+		ExternalID:  group.ExternalID,
+		DisplayName: group.DisplayName,
+		Members:     members,
+		Meta: MetaView{
+			ResourceType: resourceTypeGroup,
+			Created:      group.CreatedAt,
+			LastModified: group.UpdatedAt,
+			Location:     groupLocation(group.ID),
+		},
+	}
+}
+
+// JSONView marshals the view to the byte slice expected by responses.WriteJSON, satisfying the responses.JSONViewer
+// contract.
+func (s GroupView) JSONView() ([]byte, error) {
+	return json.Marshal(s)
+}
+
+// ListResponseView is the SCIM ListResponse message returned by the list endpoints.
+type ListResponseView struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    any      `json:"Resources"`
+}
+
+// BuildUserListView projects a page of users into a SCIM ListResponse.
+func BuildUserListView(page services.UserPage) ListResponseView {
+	var resources = make([]UserView, 0, len(page.Users))
+	for _, user := range page.Users {
+		resources = append(resources, BuildUserView(user))
+	}
+
+	return ListResponseView{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: page.TotalResults,
+		StartIndex:   page.StartIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}
+}
+
+// BuildGroupListView projects a page of groups into a SCIM ListResponse.
+func BuildGroupListView(page services.GroupPage) ListResponseView {
+	var resources = make([]GroupView, 0, len(page.Groups))
+	for _, group := range page.Groups {
+		resources = append(resources, BuildGroupView(group))
+	}
+
+	return ListResponseView{
+		Schemas:      []string{SchemaListResponse},
+		TotalResults: page.TotalResults,
+		StartIndex:   page.StartIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}
+}
+
+// JSONView marshals the view to the byte slice expected by responses.WriteJSON, satisfying the responses.JSONViewer
+// contract.
+func (s ListResponseView) JSONView() ([]byte, error) {
+	return json.Marshal(s)
+}
+
+// ErrorView is the SCIM error message. The status is a string as required by RFC 7644.
+type ErrorView struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	SCIMType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail"`
+}
+
+// JSONView marshals the view to the byte slice expected by responses.WriteJSON, satisfying the responses.JSONViewer
+// contract.
+func (s ErrorView) JSONView() ([]byte, error) {
+	return json.Marshal(s)
+}
+
+type supportedView struct {
+	Supported bool `json:"supported"`
+}
+
+type filterSupportView struct {
+	Supported  bool `json:"supported"`
+	MaxResults int  `json:"maxResults"`
+}
+
+type bulkSupportView struct {
+	Supported      bool `json:"supported"`
+	MaxOperations  int  `json:"maxOperations"`
+	MaxPayloadSize int  `json:"maxPayloadSize"`
+}
+
+type authenticationSchemeView struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// ServiceProviderConfigView advertises the SCIM features supported by BloodHound.
+type ServiceProviderConfigView struct {
+	Schemas               []string                   `json:"schemas"`
+	Patch                 supportedView              `json:"patch"`
+	Bulk                  bulkSupportView            `json:"bulk"`
+	Filter                filterSupportView          `json:"filter"`
+	ChangePassword        supportedView              `json:"changePassword"`
+	Sort                  supportedView              `json:"sort"`
+	ETag                  supportedView              `json:"etag"`
+	AuthenticationSchemes []authenticationSchemeView `json:"authenticationSchemes"`
+}
+
+// BuildServiceProviderConfigView returns the service provider configuration of the SCIM endpoints.
+func BuildServiceProviderConfigView() ServiceProviderConfigView {
+	return ServiceProviderConfigView{
+		Schemas: []string{SchemaServiceProviderConfig},
+		Patch:   supportedView{Supported: true},
+		Filter:  filterSupportView{Supported: true, MaxResults: services.MaxPageSize},
+		AuthenticationSchemes: []authenticationSchemeView{{
+			Type:        "oauthbearertoken",
+			Name:        "OAuth Bearer Token",
+			Description: "Authentication using a SCIM token created by a BloodHound administrator",
+		}},
+	}
+}
+
+// JSONView marshals the view to the byte slice expected by responses.WriteJSON, satisfying the responses.JSONViewer
+// contract.
+func (s ServiceProviderConfigView) JSONView() ([]byte, error) {
+	return json.Marshal(s)
+}
+
+// UserRequest is the SCIM user accepted when creating or replacing a user. Attributes BloodHound does not store are
+// ignored.
+type UserRequest struct {
+	ExternalID string                          `json:"externalId"`
+	UserName   string                          `json:"userName"`
+	Name       NameView                        `json:"name"`
+	Emails     []services.MultiValuedAttribute `json:"emails"`
+	Active     *bool                           `json:"active"`
+}
+
+// User converts the request into the services-layer user. Users are active unless stated otherwise.
+func (s UserRequest) User() services.User {
+	return services.User{
+		ExternalID: s.ExternalID,
+		UserName:   s.UserName,
+		GivenName:  s.Name.GivenName,
+		FamilyName: s.Name.FamilyName,
+		Email:      services.PrimaryValue(s.Emails),
+		Active:     s.Active == nil || *s.Active,
+	}
+}
+
+// GroupRequest is the SCIM group accepted when creating or replacing a group.
+type GroupRequest struct {
+	ExternalID  string                          `json:"externalId"`
+	DisplayName string                          `json:"displayName"`
+	Members     []services.MultiValuedAttribute `json:"members"`
+}
+
+// Group converts the request into the services-layer group.
+func (s GroupRequest) Group() (services.Group, error) {
+	members, err := services.ParseMembers(s.Members)
+	if err != nil {
+		return services.Group{}, err
+	}
+
+	return services.Group{
+		ExternalID:  s.ExternalID,
+		DisplayName: s.DisplayName,
+		Members:     members,
+	}, nil
+}
+
+// PatchOperationRequest is a single operation of a SCIM PatchOp message.
+type PatchOperationRequest struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+// PatchRequest is the SCIM PatchOp message accepted by the PATCH endpoints.
+type PatchRequest struct {
+	Schemas    []string                `json:"schemas"`
+	Operations []PatchOperationRequest `json:"Operations"`
+}
+
+// PatchOperations converts the request into services-layer patch operations.
+func (s PatchRequest) PatchOperations() []services.PatchOperation {
+	var operations = make([]services.PatchOperation, 0, len(s.Operations))
+	for _, operation := range s.Operations {
+		operations = append(operations, services.PatchOperation{Op: operation.Op, Path: operation.Path, Value: operation.Value})
+	}
+
+	return operations
+}
+
+// TokenView is the JSON shape returned by the SCIM token management endpoints. The secret is only present in the
+// response to token creation.
+type TokenView struct {
+	ID            uuid.UUID  `json:"id"`
+	Name          string     `json:"name"`
+	SSOProviderID null.Int32 `json:"sso_provider_id"`
+	Token         string     `json:"token,omitempty"`
+	LastUsedAt    null.Time  `json:"last_used_at"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// BuildTokenView projects a services.Token into the view type the token management handlers return in their JSON
+// envelope.
+func BuildTokenView(token services.Token) TokenView {
+	return TokenView{
+		ID:            token.ID,
+		Name:          token.Name,
+		SSOProviderID: null.Int32{NullInt32: token.SSOProviderID},
+		LastUsedAt:    null.Time{NullTime: token.LastUsedAt},
+		CreatedAt:     token.CreatedAt,
+		UpdatedAt:     token.UpdatedAt,
+	}
+}
+
+// JSONView marshals the view to the byte slice expected by responses.WriteBasic, satisfying the responses.JSONViewer
+// contract.
+func (s TokenView) JSONView() ([]byte, error) {
+	return json.Marshal(s)
+}
+
+// TokenListView wraps the SCIM tokens under a "tokens" key.
+type TokenListView struct {
+	Tokens []TokenView `json:"tokens"`
+}
+
+// BuildTokenListView projects a slice of services.Token into the list view the token management handlers return.
+func BuildTokenListView(tokens []services.Token) TokenListView {
+	var views = make([]TokenView, 0, len(tokens))
+	for _, token := range tokens {
+		views = append(views, BuildTokenView(token))
+	}
+
+	return TokenListView{Tokens: views}
+}
+
+// JSONView marshals the view to the byte slice expected by responses.WriteBasic, satisfying the responses.JSONViewer
+// contract.
+func (s TokenListView) JSONView() ([]byte, error) {
+	return json.Marshal(s)
+}
+
+// TokenRequest is the JSON body accepted when creating a SCIM token.
+type TokenRequest struct {
+	Name          string     `json:"name"`
+	SSOProviderID null.Int32 `json:"sso_provider_id"`
+}
+
+// TokenTemplate converts the request body into the services-layer template.
+func (s TokenRequest) TokenTemplate() services.TokenTemplate {
+	return services.TokenTemplate{
+		Name:          s.Name,
+		SSOProviderID: s.SSOProviderID.NullInt32,
+	}
+}
+
+// GroupRoleView is the JSON shape returned by the SCIM group role mapping endpoints.
+type GroupRoleView struct {
+	GroupID       uuid.UUID  `json:"group_id"`
+	DisplayName   string     `json:"display_name"`
+	SSOProviderID null.Int32 `json:"sso_provider_id"`
+	RoleID        null.Int32 `json:"role_id"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// BuildGroupRoleView projects a services.GroupRole into the view type the group role mapping handlers return in
+// their JSON envelope.
+func BuildGroupRoleView(groupRole services.GroupRole) GroupRoleView {
+	return GroupRoleView{
+		GroupID:       groupRole.GroupID,
+		DisplayName:   groupRole.DisplayName,
+		SSOProviderID: null.Int32{NullInt32: groupRole.SSOProviderID},
+		RoleID:        null.Int32{NullInt32: groupRole.RoleID},
+		UpdatedAt:     groupRole.UpdatedAt,
+	}
+}
+
+// JSONView marshals the view to the byte slice expected by responses.WriteBasic, satisfying the responses.JSONViewer
+// contract.
+func (s GroupRoleView) JSONView() ([]byte, error) {
+	return json.Marshal(s)
+}
+
+// GroupRoleListView wraps the SCIM group role mappings under a "groups" key.
+type GroupRoleListView struct {
+	Groups []GroupRoleView `json:"groups"`
+}
+
+// BuildGroupRoleListView projects a slice of services.GroupRole into the list view the group role mapping handlers
+// return.
+func BuildGroupRoleListView(groupRoles []services.GroupRole) GroupRoleListView {
+	var views = make([]GroupRoleView, 0, len(groupRoles))
+	for _, groupRole := range groupRoles {
+		views = append(views, BuildGroupRoleView(groupRole))
+	}
+
+	return GroupRoleListView{Groups: views}
+}
+
+// JSONView marshals the view to the byte slice expected by responses.WriteBasic, satisfying the responses.JSONViewer
+// contract.
+func (s GroupRoleListView) JSONView() ([]byte, error) {
+	return json.Marshal(s)
+}
+
+// GroupRoleRequest is the JSON body accepted when mapping a SCIM group to a role.
+type GroupRoleRequest struct {
+	RoleID int32 `json:"role_id"`
+}
+
+func userLocation(id uuid.UUID) string {
+	return api.SCIMBasePath + "/Users/" + id.String()
+}
+
+func groupLocation(id uuid.UUID) string {
+	return api.SCIMBasePath + "/Groups/" + id.String()
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package routes
+
+import (
+	"fmt"
+
+	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/cmd/api/src/api"
+	"github.com/specterops/bloodhound/cmd/api/src/api/router"
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/server/scim/internal/handlers"
+)
+
+// Register attaches the SCIM endpoints to the given router instance.
+//
+// The SCIM protocol endpoints are authenticated by their own bearer tokens in place of the router's BloodHound session
+// and API token authentication. Rate limiting is applied first so that requests with invalid SCIM tokens count against
+// the per-IP limit.
+func Register(routerInst *router.Router, handler *handlers.Handlers, rateLimit func() mux.MiddlewareFunc) {
+	var (
+		permissions   = auth.Permissions()
+		userPath      = fmt.Sprintf("%s/Users/{%s}", api.SCIMBasePath, api.URIPathVariableSCIMResourceID)
+		groupPath     = fmt.Sprintf("%s/Groups/{%s}", api.SCIMBasePath, api.URIPathVariableSCIMResourceID)
+		groupRolePath = fmt.Sprintf("/api/v2/scim/groups/{%s}/role", api.URIPathVariableSCIMResourceID)
+		scimEndpoints = []*router.Route{
+			routerInst.GET(api.SCIMBasePath+"/ServiceProviderConfig", handler.GetServiceProviderConfig),
+			routerInst.GET(api.SCIMBasePath+"/Users", handler.ListUsers),
+			routerInst.POST(api.SCIMBasePath+"/Users", handler.CreateUser),
+			routerInst.GET(userPath, handler.GetUser),
+			routerInst.PUT(userPath, handler.ReplaceUser),
+			routerInst.PATCH(userPath, handler.PatchUser),
+			routerInst.DELETE(userPath, handler.DeleteUser),
+			routerInst.GET(api.SCIMBasePath+"/Groups", handler.ListGroups),
+			routerInst.POST(api.SCIMBasePath+"/Groups", handler.CreateGroup),
+			routerInst.GET(groupPath, handler.GetGroup),
+			routerInst.PUT(groupPath, handler.ReplaceGroup),
+			routerInst.PATCH(groupPath, handler.PatchGroup),
+			routerInst.DELETE(groupPath, handler.DeleteGroup),
+		}
+	)
+
+	router.With(rateLimit, scimEndpoints...)
+	for _, endpoint := range scimEndpoints {
+		endpoint.AuthenticateWith(handler.Authenticate)
+	}
+
+	routerInst.GET("/api/v2/scim/tokens", handler.ListTokens).RequirePermissions(permissions.AuthManageUsers)
+	routerInst.POST("/api/v2/scim/tokens", handler.CreateToken).RequirePermissions(permissions.AuthManageUsers)
+	routerInst.DELETE(fmt.Sprintf("/api/v2/scim/tokens/{%s}", api.URIPathVariableSCIMTokenID), handler.DeleteToken).RequirePermissions(permissions.AuthManageUsers)
+
+	routerInst.GET("/api/v2/scim/groups", handler.ListGroupRoles).RequirePermissions(permissions.AuthManageUsers)
+	routerInst.PUT(groupRolePath, handler.SetGroupRole).RequirePermissions(permissions.AuthManageUsers)
+	routerInst.DELETE(groupRolePath, handler.DeleteGroupRole).RequirePermissions(permissions.AuthManageUsers)
+}
@@ -0,0 +1,160 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package routes_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/specterops/bloodhound/cmd/api/src/api/router"
+	"github.com/specterops/bloodhound/cmd/api/src/auth"
+	"github.com/specterops/bloodhound/cmd/api/src/config"
+	"github.com/specterops/bloodhound/server/scim/internal/handlers"
+	"github.com/specterops/bloodhound/server/scim/internal/handlers/mocks"
+	"github.com/specterops/bloodhound/server/scim/internal/routes"
+	"github.com/specterops/bloodhound/server/scim/internal/services"
+	"github.com/stretchr/testify/assert"
+	testifymock "github.com/stretchr/testify/mock"
+)
+
+const resourceID = "0b0e8b4e-5f57-4a5b-9a43-7b8f3fbc1d53"
+
+func noopRateLimit() mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler { return next }
+}
+
+var registeredRoutes = []struct {
+	method string
+	path   string
+}{
+	{http.MethodGet, "/scim/v2/ServiceProviderConfig"},
+	{http.MethodGet, "/scim/v2/Users"},
+	{http.MethodPost, "/scim/v2/Users"},
+	{http.MethodGet, "/scim/v2/Users/" + resourceID},
+	{http.MethodPut, "/scim/v2/Users/" + resourceID},
+	{http.MethodPatch, "/scim/v2/Users/" + resourceID},
+	{http.MethodDelete, "/scim/v2/Users/" + resourceID},
+	{http.MethodGet, "/scim/v2/Groups"},
+	{http.MethodPost, "/scim/v2/Groups"},
+	{http.MethodGet, "/scim/v2/Groups/" + resourceID},
+	{http.MethodPut, "/scim/v2/Groups/" + resourceID},
+	{http.MethodPatch, "/scim/v2/Groups/" + resourceID},
+	{http.MethodDelete, "/scim/v2/Groups/" + resourceID},
+	{http.MethodGet, "/api/v2/scim/tokens"},
+	{http.MethodPost, "/api/v2/scim/tokens"},
+	{http.MethodDelete, "/api/v2/scim/tokens/" + resourceID},
+	{http.MethodGet, "/api/v2/scim/groups"},
+	{http.MethodPut, "/api/v2/scim/groups/" + resourceID + "/role"},
+	{http.MethodDelete, "/api/v2/scim/groups/" + resourceID + "/role"},
+}
+
+func TestRegister(t *testing.T) {
+	var (
+		cfg        = config.Configuration{}
+		authorizer = auth.NewAuthorizer(nil)
+		routerInst = router.NewRouter(cfg, authorizer, "")
+		mock       = mocks.NewMockSCIM(t)
+		handlerSet = handlers.NewHandlersContainer(mock)
+	)
+
+	routes.Register(&routerInst, handlerSet, noopRateLimit)
+
+	muxRouter := routerInst.MuxRouter()
+
+	for _, tc := range registeredRoutes {
+		req := httptest.NewRequest(tc.method, tc.path, nil)
+		var match mux.RouteMatch
+		assert.True(t, muxRouter.Match(req, &match), "%s %s route should be registered", tc.method, tc.path)
+	}
+}
+
+// TestRegister_RoutesRequireAuthentication verifies that SCIM endpoints reject requests without a SCIM bearer token
+// and that the token management endpoints are guarded by BloodHound authentication.
+func TestRegister_RoutesRequireAuthentication(t *testing.T) {
+	var (
+		cfg        = config.Configuration{}
+		authorizer = auth.NewAuthorizer(nil)
+		routerInst = router.NewRouter(cfg, authorizer, "")
+		mock       = mocks.NewMockSCIM(t)
+		handlerSet = handlers.NewHandlersContainer(mock)
+	)
+
+	routes.Register(&routerInst, handlerSet, noopRateLimit)
+	handler := routerInst.Handler()
+
+	for _, tc := range registeredRoutes {
+		t.Run(tc.method+" "+tc.path, func(t *testing.T) {
+			var (
+				request  = httptest.NewRequest(tc.method, tc.path, nil)
+				recorder = httptest.NewRecorder()
+			)
+
+			handler.ServeHTTP(recorder, request)
+
+			assert.Equal(t, http.StatusUnauthorized, recorder.Code,
+				"unauthenticated %s %s must be rejected by middleware before reaching the handler", tc.method, tc.path)
+		})
+	}
+}
+
+// TestRegister_SCIMRoutesBypassRouterAuthentication verifies that SCIM endpoints are authenticated by the SCIM
+// bearer token alone while every other route remains behind the router's authentication middleware.
+func TestRegister_SCIMRoutesBypassRouterAuthentication(t *testing.T) {
+	var (
+		cfg        = config.Configuration{}
+		authorizer = auth.NewAuthorizer(nil)
+		routerInst = router.NewRouter(cfg, authorizer, "")
+		mock       = mocks.NewMockSCIM(t)
+		handlerSet = handlers.NewHandlersContainer(mock)
+	)
+
+	routerInst.UseAuthentication(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(response http.ResponseWriter, request *http.Request) {
+			response.WriteHeader(http.StatusForbidden)
+		})
+	})
+	routes.Register(&routerInst, handlerSet, noopRateLimit)
+	handler := routerInst.Handler()
+
+	mock.EXPECT().Authenticate(testifymock.Anything, "secret").Return(services.Token{Name: "idp"}, nil)
+
+	t.Run("SCIM endpoint", func(t *testing.T) {
+		var (
+			request  = httptest.NewRequest(http.MethodGet, "/scim/v2/ServiceProviderConfig", nil)
+			recorder = httptest.NewRecorder()
+		)
+
+		request.Header.Set("Authorization", "Bearer secret")
+		handler.ServeHTTP(recorder, request)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("token management endpoint", func(t *testing.T) {
+		var (
+			request  = httptest.NewRequest(http.MethodGet, "/api/v2/scim/tokens", nil)
+			recorder = httptest.NewRecorder()
+		)
+
+		request.Header.Set("Authorization", "Bearer secret")
+		handler.ServeHTTP(recorder, request)
+
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+}
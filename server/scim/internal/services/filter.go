@@ -0,0 +1,481 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Filter operators and the null value as defined in RFC 7644 section 3.4.2.2. Operators and unquoted values are lower
+// cased by ParseFilter.
+const (
+	FilterOperatorPresent        = "pr"
+	FilterOperatorEqual          = "eq"
+	FilterOperatorNotEqual       = "ne"
+	FilterOperatorContains       = "co"
+	FilterOperatorStartsWith     = "sw"
+	FilterOperatorEndsWith       = "ew"
+	FilterOperatorGreaterThan    = "gt"
+	FilterOperatorGreaterOrEqual = "ge"
+	FilterOperatorLessThan       = "lt"
+	FilterOperatorLessOrEqual    = "le"
+
+	FilterValueNull = "null"
+)
+
+const filterDelimiters = " \t\n\r()[]\""
+
+var filterComparisonOperators = []string{
+	FilterOperatorEqual,
+	FilterOperatorNotEqual,
+	FilterOperatorContains,
+	FilterOperatorStartsWith,
+	FilterOperatorEndsWith,
+	FilterOperatorGreaterThan,
+	FilterOperatorGreaterOrEqual,
+	FilterOperatorLessThan,
+	FilterOperatorLessOrEqual,
+}
+
+// Attributes is the flattened view of a resource that filters are evaluated against. Attribute paths are stored
+// lower cased so that matching is case-insensitive, as required for attribute names by RFC 7643.
+type Attributes struct {
+	values  map[string][]string
+	complex map[string][]Attributes
+}
+
+func newAttributes() Attributes {
+	return Attributes{
+		values:  map[string][]string{},
+		complex: map[string][]Attributes{},
+	}
+}
+
+func (s Attributes) add(path string, values ...string) {
+	for _, value := range values {
+		if value != "" {
+			s.values[strings.ToLower(path)] = append(s.values[strings.ToLower(path)], value)
+		}
+	}
+}
+
+// Filter is a parsed SCIM filter expression as defined in RFC 7644 section 3.4.2.2. All comparisons are
+// case-insensitive. Attributes that a resource does not have never match a comparison.
+type Filter struct {
+	root filterNode
+}
+
+// Matches reports whether the resource attributes satisfy the filter. An empty filter matches every resource.
+func (s Filter) Matches(attributes Attributes) bool {
+	return s.root == nil || s.root.matches(attributes)
+}
+
+// Translate renders the filter with the translator, for instance as a SQL condition, so that it can be evaluated where
+// the resources are stored. An empty filter renders as an empty string.
+func (s Filter) Translate(translator FilterTranslator) string {
+	if s.root == nil {
+		return ""
+	}
+
+	return s.root.translate(translator)
+}
+
+// FilterTranslator renders the nodes of a parsed filter. Attribute paths are lower cased with any schema URN prefix
+// removed. Translations must keep the semantics of Filter.Matches: comparisons are case-insensitive and an attribute
+// a resource does not have never matches a comparison.
+type FilterTranslator interface {
+	And(left, right string) string
+	Or(left, right string) string
+	Not(inner string) string
+	Compare(path, operator, value string) string
+
+	// ValuePath renders `path[inner]`, which matches when a single element of the multi-valued complex attribute
+	// satisfies the inner filter.
+	ValuePath(path string, inner Filter) string
+}
+
+// ParseFilter parses a SCIM filter expression. An empty expression yields a filter that matches every resource.
+func ParseFilter(expression string) (Filter, error) {
+	if strings.TrimSpace(expression) == "" {
+		return Filter{}, nil
+	}
+
+	tokens, err := tokenizeFilter(expression)
+	if err != nil {
+		return Filter{}, err
+	}
+
+	parser := &filterParser{tokens: tokens}
+
+	root, err := parser.parseOr()
+	if err != nil {
+		return Filter{}, err
+	} else if parser.position < len(parser.tokens) {
+		return Filter{}, fmt.Errorf("%w: unexpected %q", ErrInvalidFilter, parser.tokens[parser.position].text)
+	}
+
+	return Filter{root: root}, nil
+}
+
+type filterNode interface {
+	matches(attributes Attributes) bool
+	translate(translator FilterTranslator) string
+}
+
+type logicalFilter struct {
+	conjunction bool
+	left        filterNode
+	right       filterNode
+}
+
+func (s logicalFilter) matches(attributes Attributes) bool {
+	if s.conjunction {
+		return s.left.matches(attributes) && s.right.matches(attributes)
+	}
+
+	return s.left.matches(attributes) || s.right.matches(attributes)
+}
+
+func (s logicalFilter) translate(translator FilterTranslator) string {
+	if s.conjunction {
+		return translator.And(s.left.translate(translator), s.right.translate(translator))
+	}
+
+	return translator.Or(s.left.translate(translator), s.right.translate(translator))
+}
+
+type notFilter struct {
+	inner filterNode
+}
+
+func (s notFilter) matches(attributes Attributes) bool {
+	return !s.inner.matches(attributes)
+}
+
+func (s notFilter) translate(translator FilterTranslator) string {
+	return translator.Not(s.inner.translate(translator))
+}
+
+// valuePathFilter matches when at least one element of a multi-valued complex attribute satisfies the inner filter,
+// e.g. `emails[type eq "work" and value co "@example.com"]`.
+type valuePathFilter struct {
+	path  string
+	inner filterNode
+}
+
+func (s valuePathFilter) matches(attributes Attributes) bool {
+	return slices.ContainsFunc(attributes.complex[s.path], s.inner.matches)
+}
+
+func (s valuePathFilter) translate(translator FilterTranslator) string {
+	return translator.ValuePath(s.path, Filter{root: s.inner})
+}
+
+type comparisonFilter struct {
+	path     string
+	operator string
+	value    string
+}
+
+func (s comparisonFilter) matches(attributes Attributes) bool {
+	var values = attributes.values[s.path]
+
+	switch {
+	case s.operator == FilterOperatorPresent:
+		return len(values) > 0
+	case s.value == FilterValueNull && s.operator == FilterOperatorEqual:
+		return len(values) == 0
+	case s.value == FilterValueNull && s.operator == FilterOperatorNotEqual:
+		return len(values) > 0
+	case s.operator == FilterOperatorNotEqual:
+		return !slices.ContainsFunc(values, func(value string) bool { return strings.EqualFold(value, s.value) })
+	default:
+		return slices.ContainsFunc(values, s.compare)
+	}
+}
+
+func (s comparisonFilter) translate(translator FilterTranslator) string {
+	return translator.Compare(s.path, s.operator, s.value)
+}
+
+func (s comparisonFilter) compare(rawValue string) bool {
+	var (
+		value    = strings.ToLower(rawValue)
+		expected = strings.ToLower(s.value)
+	)
+
+	switch s.operator {
+	case FilterOperatorEqual:
+		return value == expected
+	case FilterOperatorContains:
+		return strings.Contains(value, expected)
+	case FilterOperatorStartsWith:
+		return strings.HasPrefix(value, expected)
+	case FilterOperatorEndsWith:
+		return strings.HasSuffix(value, expected)
+	case FilterOperatorGreaterThan:
+		return value > expected
+	case FilterOperatorGreaterOrEqual:
+		return value >= expected
+	case FilterOperatorLessThan:
+		return value < expected
+	case FilterOperatorLessOrEqual:
+		return value <= expected
+	default:
+		return false
+	}
+}
+
+type filterTokenKind int
+
+const (
+	filterTokenWord filterTokenKind = iota
+	filterTokenString
+	filterTokenOpenParen
+	filterTokenCloseParen
+	filterTokenOpenBracket
+	filterTokenCloseBracket
+)
+
+type filterToken struct {
+	kind filterTokenKind
+	text string
+}
+
+func tokenizeFilter(expression string) ([]filterToken, error) {
+	var tokens []filterToken
+
+	for position := 0; position < len(expression); {
+		switch character := expression[position]; character {
+		case ' ', '\t', '\n', '\r':
+			position++
+		case '(':
+			tokens = append(tokens, filterToken{kind: filterTokenOpenParen, text: "("})
+			position++
+		case ')':
+			tokens = append(tokens, filterToken{kind: filterTokenCloseParen, text: ")"})
+			position++
+		case '[':
+			tokens = append(tokens, filterToken{kind: filterTokenOpenBracket, text: "["})
+			position++
+		case ']':
+			tokens = append(tokens, filterToken{kind: filterTokenCloseBracket, text: "]"})
+			position++
+		case '"':
+			end := position + 1
+			for ; end < len(expression) && expression[end] != '"'; end++ {
+				if expression[end] == '\\' {
+					end++
+				}
+			}
+			if end >= len(expression) {
+				return nil, fmt.Errorf("%w: unterminated string", ErrInvalidFilter)
+			}
+
+			var value string
+			if err := json.Unmarshal([]byte(expression[position:end+1]), &value); err != nil {
+				return nil, fmt.Errorf("%w: malformed string %s", ErrInvalidFilter, expression[position:end+1])
+			}
+
+			tokens = append(tokens, filterToken{kind: filterTokenString, text: value})
+			position = end + 1
+		default:
+			end := len(expression)
+			if offset := strings.IndexAny(expression[position:], filterDelimiters); offset >= 0 {
+				end = position + offset
+			}
+
+			tokens = append(tokens, filterToken{kind: filterTokenWord, text: expression[position:end]})
+			position = end
+		}
+	}
+
+	return tokens, nil
+}
+
+// filterParser is a recursive descent parser over filter tokens. Precedence from lowest to highest is "or", "and",
+// then "not", grouping and attribute expressions.
+type filterParser struct {
+	tokens   []filterToken
+	position int
+}
+
+func (s *filterParser) peekKeyword(keyword string) bool {
+	return s.position < len(s.tokens) && s.tokens[s.position].kind == filterTokenWord && strings.EqualFold(s.tokens[s.position].text, keyword)
+}
+
+func (s *filterParser) next() (filterToken, error) {
+	if s.position >= len(s.tokens) {
+		return filterToken{}, fmt.Errorf("%w: unexpected end of filter", ErrInvalidFilter)
+	}
+
+	token := s.tokens[s.position]
+	s.position++
+
+	return token, nil
+}
+
+func (s *filterParser) expect(kind filterTokenKind, text string) error {
+	if token, err := s.next(); err != nil {
+		return err
+	} else if token.kind != kind {
+		return fmt.Errorf("%w: expected %q but found %q", ErrInvalidFilter, text, token.text)
+	}
+
+	return nil
+}
+
+func (s *filterParser) parseOr() (filterNode, error) {
+	left, err := s.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for s.peekKeyword("or") {
+		s.position++
+
+		right, err := s.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		left = logicalFilter{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (s *filterParser) parseAnd() (filterNode, error) {
+	left, err := s.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for s.peekKeyword("and") {
+		s.position++
+
+		right, err := s.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		left = logicalFilter{conjunction: true, left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (s *filterParser) parseUnary() (filterNode, error) {
+	if s.peekKeyword("not") {
+		s.position++
+
+		if err := s.expect(filterTokenOpenParen, "("); err != nil {
+			return nil, err
+		}
+
+		return s.parseGroup(func(inner filterNode) filterNode { return notFilter{inner: inner} })
+	}
+
+	token, err := s.next()
+	if err != nil {
+		return nil, err
+	}
+
+	switch token.kind {
+	case filterTokenOpenParen:
+		return s.parseGroup(func(inner filterNode) filterNode { return inner })
+	case filterTokenWord:
+		return s.parseAttributeExpression(normalizeAttributePath(token.text))
+	default:
+		return nil, fmt.Errorf("%w: unexpected %q", ErrInvalidFilter, token.text)
+	}
+}
+
+// parseGroup parses a parenthesized filter whose opening parenthesis has already been consumed.
+func (s *filterParser) parseGroup(wrap func(inner filterNode) filterNode) (filterNode, error) {
+	inner, err := s.parseOr()
+	if err != nil {
+		return nil, err
+	} else if err := s.expect(filterTokenCloseParen, ")"); err != nil {
+		return nil, err
+	}
+
+	return wrap(inner), nil
+}
+
+func (s *filterParser) parseAttributeExpression(path string) (filterNode, error) {
+	if s.position < len(s.tokens) && s.tokens[s.position].kind == filterTokenOpenBracket {
+		s.position++
+
+		inner, err := s.parseOr()
+		if err != nil {
+			return nil, err
+		} else if err := s.expect(filterTokenCloseBracket, "]"); err != nil {
+			return nil, err
+		}
+
+		return valuePathFilter{path: path, inner: inner}, nil
+	}
+
+	operatorToken, err := s.next()
+	if err != nil {
+		return nil, err
+	} else if operatorToken.kind != filterTokenWord {
+		return nil, fmt.Errorf("%w: expected an operator after %s", ErrInvalidFilter, path)
+	}
+
+	operator := strings.ToLower(operatorToken.text)
+	if operator == FilterOperatorPresent {
+		return comparisonFilter{path: path, operator: operator}, nil
+	} else if !slices.Contains(filterComparisonOperators, operator) {
+		return nil, fmt.Errorf("%w: unsupported operator %q", ErrInvalidFilter, operatorToken.text)
+	}
+
+	valueToken, err := s.next()
+	if err != nil {
+		return nil, err
+	}
+
+	switch valueToken.kind {
+	case filterTokenString:
+		return comparisonFilter{path: path, operator: operator, value: valueToken.text}, nil
+	case filterTokenWord:
+		// Unquoted values are booleans, null or numbers
+		return comparisonFilter{path: path, operator: operator, value: strings.ToLower(valueToken.text)}, nil
+	default:
+		return nil, fmt.Errorf("%w: expected a value after %s %s", ErrInvalidFilter, path, operator)
+	}
+}
+
+// normalizeAttributePath lower cases an attribute path and strips a schema URN prefix, e.g.
+// "urn:ietf:params:scim:schemas:core:2.0:User:userName" becomes "username".
+func normalizeAttributePath(path string) string {
+	var normalized = strings.ToLower(path)
+
+	if strings.HasPrefix(normalized, "urn:") {
+		normalized = normalized[strings.LastIndex(normalized, ":")+1:]
+	}
+
+	return normalized
+}
+
+func memberAttributes(member MemberReference) map[string]string {
+	return map[string]string{"value": member.ID.String(), "display": member.UserName}
+}
@@ -0,0 +1,355 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gofrs/uuid"
+)
+
+const (
+	patchOperationAdd     = "add"
+	patchOperationReplace = "replace"
+	patchOperationRemove  = "remove"
+)
+
+// PatchOperation is a single operation of a SCIM PATCH request as defined in RFC 7644 section 3.5.2. Operation names
+// are matched case-insensitively as some identity providers send "Replace" rather than "replace".
+type PatchOperation struct {
+	Op    string
+	Path  string
+	Value json.RawMessage
+}
+
+// patchPath is a parsed PATCH path such as `name.givenName`, `emails[type eq "work"].value` or
+// `members[value eq "2819c223-7f76-453a-919d-413861904646"]`.
+type patchPath struct {
+	attribute string
+	filter    *Filter
+}
+
+func parsePatchPath(path string) (patchPath, error) {
+	openIndex := strings.Index(path, "[")
+	if openIndex < 0 {
+		return patchPath{attribute: normalizeAttributePath(path)}, nil
+	}
+
+	closeIndex := strings.LastIndex(path, "]")
+	if closeIndex < openIndex {
+		return patchPath{}, fmt.Errorf("%w: malformed path %s", ErrInvalidPath, path)
+	}
+
+	filter, err := ParseFilter(path[openIndex+1 : closeIndex])
+	if err != nil {
+		return patchPath{}, fmt.Errorf("%w: %w", ErrInvalidPath, err)
+	}
+
+	return patchPath{
+		attribute: normalizeAttributePath(path[:openIndex] + path[closeIndex+1:]),
+		filter:    &filter,
+	}, nil
+}
+
+// MultiValuedAttribute is an element of a multi-valued SCIM attribute such as emails or members as it appears in
+// request payloads.
+type MultiValuedAttribute struct {
+	Value   string `json:"value"`
+	Primary bool   `json:"primary"`
+}
+
+type nameAttribute struct {
+	GivenName  *string `json:"givenName"`
+	FamilyName *string `json:"familyName"`
+}
+
+// applyUserPatch applies PATCH operations to a user. Attributes BloodHound does not store are ignored so that
+// identity providers with broader attribute mappings can still provision users.
+func applyUserPatch(user User, operations []PatchOperation) (User, error) {
+	for _, operation := range operations {
+		err := applyPatchOperation(operation,
+			func(path patchPath, value json.RawMessage) error { return setUserAttribute(&user, path, value) },
+			func(path patchPath, _ json.RawMessage) error { return removeUserAttribute(&user, path) },
+		)
+		if err != nil {
+			return User{}, err
+		}
+	}
+
+	return user, nil
+}
+
+// applyGroupPatch applies PATCH operations to a group. Members are added, replaced or removed by user ID.
+func applyGroupPatch(group Group, operations []PatchOperation) (Group, error) {
+	// Removing members edits the slice in place so the caller's members are cloned first
+	group.Members = slices.Clone(group.Members)
+
+	for _, operation := range operations {
+		var (
+			isAdd = strings.EqualFold(operation.Op, patchOperationAdd)
+			err   = applyPatchOperation(operation,
+				func(path patchPath, value json.RawMessage) error {
+					return setGroupAttribute(&group, path, value, isAdd)
+				},
+				func(path patchPath, value json.RawMessage) error { return removeGroupAttribute(&group, path, value) },
+			)
+		)
+		if err != nil {
+			return Group{}, err
+		}
+	}
+
+	return group, nil
+}
+
+// applyPatchOperation dispatches a single operation to the set or remove callback. Operations without a path carry an
+// object whose members are each applied as if they were separate operations targeting the member name.
+func applyPatchOperation(operation PatchOperation, set func(patchPath, json.RawMessage) error, remove func(patchPath, json.RawMessage) error) error {
+	var op = strings.ToLower(operation.Op)
+
+	if op != patchOperationAdd && op != patchOperationReplace && op != patchOperationRemove {
+		return fmt.Errorf("%w: unsupported operation %q", ErrInvalidSyntax, operation.Op)
+	}
+
+	if operation.Path == "" {
+		var values map[string]json.RawMessage
+
+		if op == patchOperationRemove {
+			return fmt.Errorf("%w: remove operations require a path", ErrInvalidPath)
+		} else if err := json.Unmarshal(operation.Value, &values); err != nil {
+			return fmt.Errorf("%w: operations without a path require an object value", ErrInvalidValue)
+		}
+
+		for rawPath, value := range values {
+			if path, err := parsePatchPath(rawPath); err != nil {
+				return err
+			} else if err := set(path, value); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	path, err := parsePatchPath(operation.Path)
+	if err != nil {
+		return err
+	} else if op == patchOperationRemove {
+		return remove(path, operation.Value)
+	}
+
+	return set(path, operation.Value)
+}
+
+func setUserAttribute(user *User, path patchPath, value json.RawMessage) error {
+	var err error
+
+	switch path.attribute {
+	case "username":
+		user.UserName, err = decodeString(path.attribute, value)
+	case "externalid":
+		user.ExternalID, err = decodeString(path.attribute, value)
+	case "name.givenname":
+		user.GivenName, err = decodeString(path.attribute, value)
+	case "name.familyname":
+		user.FamilyName, err = decodeString(path.attribute, value)
+	case "name":
+		var name nameAttribute
+		if err = json.Unmarshal(value, &name); err != nil {
+			return fmt.Errorf("%w: name must be an object", ErrInvalidValue)
+		}
+		if name.GivenName != nil {
+			user.GivenName = *name.GivenName
+		}
+		if name.FamilyName != nil {
+			user.FamilyName = *name.FamilyName
+		}
+	case "active":
+		user.Active, err = decodeBool(path.attribute, value)
+	case "emails.value":
+		user.Email, err = decodeString(path.attribute, value)
+	case "emails":
+		var emails []MultiValuedAttribute
+		if err = json.Unmarshal(value, &emails); err != nil {
+			return fmt.Errorf("%w: emails must be an array of objects", ErrInvalidValue)
+		}
+		user.Email = PrimaryValue(emails)
+	}
+
+	return err
+}
+
+func removeUserAttribute(user *User, path patchPath) error {
+	switch path.attribute {
+	case "username":
+		return fmt.Errorf("%w: userName is required", ErrInvalidValue)
+	case "active":
+		return fmt.Errorf("%w: active cannot be removed", ErrInvalidPath)
+	case "externalid":
+		user.ExternalID = ""
+	case "name.givenname":
+		user.GivenName = ""
+	case "name.familyname":
+		user.FamilyName = ""
+	case "name":
+		user.GivenName, user.FamilyName = "", ""
+	case "emails", "emails.value":
+		user.Email = ""
+	}
+
+	return nil
+}
+
+func setGroupAttribute(group *Group, path patchPath, value json.RawMessage, isAdd bool) error {
+	var err error
+
+	switch path.attribute {
+	case "displayname":
+		group.DisplayName, err = decodeString(path.attribute, value)
+	case "externalid":
+		group.ExternalID, err = decodeString(path.attribute, value)
+	case "members":
+		if path.filter != nil {
+			return fmt.Errorf("%w: members can only be filtered when removing them", ErrInvalidPath)
+		}
+
+		var members []MemberReference
+		if members, err = decodeMembers(value); err != nil {
+			return err
+		} else if isAdd {
+			group.Members = append(group.Members, members...)
+		} else {
+			group.Members = members
+		}
+	}
+
+	return err
+}
+
+func removeGroupAttribute(group *Group, path patchPath, value json.RawMessage) error {
+	switch path.attribute {
+	case "displayname":
+		return fmt.Errorf("%w: displayName is required", ErrInvalidValue)
+	case "externalid":
+		group.ExternalID = ""
+	case "members":
+		if path.filter != nil {
+			group.Members = slices.DeleteFunc(group.Members, func(member MemberReference) bool {
+				attributes := newAttributes()
+				for subAttribute, subValue := range memberAttributes(member) {
+					attributes.add(subAttribute, subValue)
+				}
+
+				return path.filter.Matches(attributes)
+			})
+		} else if isNullOrEmpty(value) {
+			group.Members = nil
+		} else if removed, err := decodeMembers(value); err != nil {
+			return err
+		} else {
+			group.Members = slices.DeleteFunc(group.Members, func(member MemberReference) bool {
+				return slices.ContainsFunc(removed, func(candidate MemberReference) bool { return candidate.ID == member.ID })
+			})
+		}
+	}
+
+	return nil
+}
+
+// PrimaryValue returns the value of the primary element of a multi-valued attribute, falling back to the first
+// element when none is marked primary.
+func PrimaryValue(values []MultiValuedAttribute) string {
+	if primaryIndex := slices.IndexFunc(values, func(value MultiValuedAttribute) bool { return value.Primary }); primaryIndex >= 0 {
+		return values[primaryIndex].Value
+	} else if len(values) > 0 {
+		return values[0].Value
+	}
+
+	return ""
+}
+
+func decodeString(attribute string, value json.RawMessage) (string, error) {
+	var decoded *string
+
+	if err := json.Unmarshal(value, &decoded); err != nil {
+		return "", fmt.Errorf("%w: %s must be a string", ErrInvalidValue, attribute)
+	} else if decoded == nil {
+		return "", nil
+	}
+
+	return *decoded, nil
+}
+
+// decodeBool accepts both JSON booleans and their string form as sent by some identity providers, e.g. "False".
+func decodeBool(attribute string, value json.RawMessage) (bool, error) {
+	var (
+		decoded    bool
+		decodedStr string
+	)
+
+	if err := json.Unmarshal(value, &decoded); err == nil {
+		return decoded, nil
+	} else if err := json.Unmarshal(value, &decodedStr); err == nil {
+		if parsed, err := strconv.ParseBool(strings.ToLower(decodedStr)); err == nil {
+			return parsed, nil
+		}
+	}
+
+	return false, fmt.Errorf("%w: %s must be a boolean", ErrInvalidValue, attribute)
+}
+
+// decodeMembers decodes either an array of member objects or a single member object.
+func decodeMembers(value json.RawMessage) ([]MemberReference, error) {
+	var rawMembers []MultiValuedAttribute
+
+	if trimmed := bytes.TrimSpace(value); len(trimmed) > 0 && trimmed[0] == '{' {
+		var rawMember MultiValuedAttribute
+		if err := json.Unmarshal(trimmed, &rawMember); err != nil {
+			return nil, fmt.Errorf("%w: members must be an array of objects", ErrInvalidValue)
+		}
+		rawMembers = append(rawMembers, rawMember)
+	} else if err := json.Unmarshal(value, &rawMembers); err != nil {
+		return nil, fmt.Errorf("%w: members must be an array of objects", ErrInvalidValue)
+	}
+
+	return ParseMembers(rawMembers)
+}
+
+// ParseMembers converts the values of a members attribute into member references.
+func ParseMembers(rawMembers []MultiValuedAttribute) ([]MemberReference, error) {
+	var members = make([]MemberReference, 0, len(rawMembers))
+
+	for _, rawMember := range rawMembers {
+		if memberID, err := uuid.FromString(rawMember.Value); err != nil {
+			return nil, fmt.Errorf("%w: member %q is not a user ID", ErrInvalidValue, rawMember.Value)
+		} else {
+			members = append(members, MemberReference{ID: memberID})
+		}
+	}
+
+	return members, nil
+}
+
+func isNullOrEmpty(value json.RawMessage) bool {
+	trimmed := bytes.TrimSpace(value)
+	return len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null"))
+}
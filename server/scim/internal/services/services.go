@@ -0,0 +1,488 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package services
+
+//go:generate go tool mockery
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+const (
+	// MaxPageSize is the largest number of resources returned by a single list request. It is advertised to
+	// identity providers through the service provider configuration.
+	MaxPageSize = 1000
+
+	tokenSecretByteLength = 32
+)
+
+// Token is a bearer token an identity provider presents to the SCIM endpoints. The secret itself is never stored.
+// Users and groups are scoped to the SSO provider of the token that provisioned them; tokens without an SSO provider
+// share a scope of their own.
+type Token struct {
+	ID            uuid.UUID
+	Name          string
+	SSOProviderID sql.NullInt32
+	LastUsedAt    sql.NullTime
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// TokenTemplate carries the caller-supplied attributes of a new SCIM token. Users provisioned with the token are
+// bound to the SSO provider, if one is set, so that they can log in without further configuration.
+type TokenTemplate struct {
+	Name          string
+	SSOProviderID sql.NullInt32
+}
+
+// IssuedToken is returned once when a token is created and is the only place the plaintext secret is available.
+type IssuedToken struct {
+	Token
+	Secret string
+}
+
+// User is a BloodHound user as seen through SCIM. UserName maps to the BloodHound principal name and Active is the
+// inverse of the BloodHound disabled flag.
+type User struct {
+	ID         uuid.UUID
+	ExternalID string
+	UserName   string
+	GivenName  string
+	FamilyName string
+	Email      string
+	Active     bool
+	Groups     []GroupReference
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// GroupReference identifies a SCIM group a user is a member of.
+type GroupReference struct {
+	ID          uuid.UUID
+	DisplayName string
+}
+
+// Group is a SCIM group. RoleID is the role an administrator mapped to the group, if any, and cannot be set by an
+// identity provider.
+type Group struct {
+	ID          uuid.UUID
+	ExternalID  string
+	DisplayName string
+	RoleID      sql.NullInt32
+	Members     []MemberReference
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// MemberReference identifies a user that is a member of a SCIM group.
+type MemberReference struct {
+	ID       uuid.UUID
+	UserName string
+}
+
+// GroupRole is the role mapping of a SCIM group as configured by a BloodHound administrator. Members of the group are
+// granted the role while they remain in the group.
+type GroupRole struct {
+	GroupID       uuid.UUID
+	DisplayName   string
+	SSOProviderID sql.NullInt32
+	RoleID        sql.NullInt32
+	UpdatedAt     time.Time
+}
+
+// ListQuery describes a SCIM list request. StartIndex is 1-based as defined by RFC 7644.
+type ListQuery struct {
+	Filter     string
+	StartIndex int
+	Count      int
+}
+
+// ListOptions selects a page of the resources matching Filter. Offset is 0-based.
+type ListOptions struct {
+	Filter Filter
+	Offset int
+	Limit  int
+}
+
+// UserPage is a single page of users matching a ListQuery.
+type UserPage struct {
+	Users        []User
+	TotalResults int
+	StartIndex   int
+}
+
+// GroupPage is a single page of groups matching a ListQuery.
+type GroupPage struct {
+	Groups       []Group
+	TotalResults int
+	StartIndex   int
+}
+
+var (
+	// ErrInvalidToken indicates that the presented SCIM bearer token is missing or unknown.
+	ErrInvalidToken = errors.New("invalid SCIM token")
+	// ErrTokenNotFound indicates that no SCIM token with the given ID or digest was found.
+	ErrTokenNotFound = errors.New("no SCIM token was found")
+	// ErrInvalidTokenName indicates that the supplied token name is empty.
+	ErrInvalidTokenName = errors.New("token name must not be empty")
+	// ErrUnknownSSOProvider indicates that a token template references an SSO provider that does not exist.
+	ErrUnknownSSOProvider = errors.New("token references an unknown SSO provider")
+	// ErrUserNotFound indicates that no user with the given ID was found.
+	ErrUserNotFound = errors.New("no user was found")
+	// ErrGroupNotFound indicates that no SCIM group with the given ID was found.
+	ErrGroupNotFound = errors.New("no group was found")
+	// ErrUserNameConflict indicates that another user already uses the supplied userName.
+	ErrUserNameConflict = errors.New("a user with this userName already exists")
+	// ErrDisplayNameConflict indicates that another group already uses the supplied displayName.
+	ErrDisplayNameConflict = errors.New("a group with this displayName already exists")
+	// ErrUnknownMember indicates that a group references a user that does not exist or was not provisioned by the
+	// identity provider.
+	ErrUnknownMember = errors.New("group references an unknown user")
+	// ErrUnknownRole indicates that a group role mapping references a role that does not exist.
+	ErrUnknownRole = errors.New("group role mapping references an unknown role")
+	// ErrLastAdministrator indicates that the change would leave no enabled user able to manage users and roles.
+	ErrLastAdministrator = errors.New("change would remove the last administrator")
+	// ErrInvalidFilter indicates that a list filter could not be parsed.
+	ErrInvalidFilter = errors.New("invalid filter")
+	// ErrInvalidPath indicates that a PATCH operation targets an attribute that cannot be modified.
+	ErrInvalidPath = errors.New("invalid path")
+	// ErrInvalidValue indicates that a required attribute is missing or an attribute value has the wrong type.
+	ErrInvalidValue = errors.New("invalid value")
+	// ErrInvalidSyntax indicates that a PATCH operation is not one of add, replace or remove.
+	ErrInvalidSyntax = errors.New("invalid syntax")
+)
+
+type Database interface {
+	UseToken(ctx context.Context, digest string) (Token, error)
+	ListTokens(ctx context.Context) ([]Token, error)
+	CreateToken(ctx context.Context, template TokenTemplate, digest string) (Token, error)
+	DeleteToken(ctx context.Context, id uuid.UUID) error
+	ListUsers(ctx context.Context, options ListOptions) ([]User, int, error)
+	GetUser(ctx context.Context, id uuid.UUID) (User, error)
+	CreateUser(ctx context.Context, user User, ssoProviderID sql.NullInt32) (User, error)
+	UpdateUser(ctx context.Context, user User) (User, error)
+	DeprovisionUser(ctx context.Context, id uuid.UUID) error
+	ListGroups(ctx context.Context, options ListOptions) ([]Group, int, error)
+	GetGroup(ctx context.Context, id uuid.UUID) (Group, error)
+	CreateGroup(ctx context.Context, group Group) (Group, error)
+	UpdateGroup(ctx context.Context, group Group) (Group, error)
+	DeleteGroup(ctx context.Context, id uuid.UUID) error
+	ListGroupRoles(ctx context.Context) ([]GroupRole, error)
+	SetGroupRole(ctx context.Context, groupID uuid.UUID, roleID int32) (GroupRole, error)
+	DeleteGroupRole(ctx context.Context, groupID uuid.UUID) error
+}
+
+type Service struct {
+	db Database
+}
+
+func NewService(databaseInterface Database) *Service {
+	return &Service{db: databaseInterface}
+}
+
+type tokenContextKey struct{}
+
+// ContextWithToken returns a copy of ctx carrying the SCIM token that authenticated the request. Changes made through
+// SCIM are attributed to this token in the audit log.
+func ContextWithToken(ctx context.Context, token Token) context.Context {
+	return context.WithValue(ctx, tokenContextKey{}, token)
+}
+
+// TokenFromContext returns the SCIM token that authenticated the request, if any.
+func TokenFromContext(ctx context.Context) (Token, bool) {
+	token, ok := ctx.Value(tokenContextKey{}).(Token)
+	return token, ok
+}
+
+// Authenticate resolves the token for a bearer secret presented by an identity provider and records its use.
+func (s *Service) Authenticate(ctx context.Context, secret string) (Token, error) {
+	if secret == "" {
+		return Token{}, ErrInvalidToken
+	}
+
+	token, err := s.db.UseToken(ctx, tokenDigest(secret))
+	if errors.Is(err, ErrTokenNotFound) {
+		return Token{}, ErrInvalidToken
+	}
+
+	return token, err
+}
+
+func (s *Service) ListTokens(ctx context.Context) ([]Token, error) {
+	return s.db.ListTokens(ctx)
+}
+
+// CreateToken generates a new random secret and persists its digest. The secret is returned to the caller once.
+func (s *Service) CreateToken(ctx context.Context, template TokenTemplate) (IssuedToken, error) {
+	var secretBytes = make([]byte, tokenSecretByteLength)
+
+	template.Name = strings.TrimSpace(template.Name)
+	if template.Name == "" {
+		return IssuedToken{}, ErrInvalidTokenName
+	}
+
+	if _, err := rand.Read(secretBytes); err != nil {
+		return IssuedToken{}, fmt.Errorf("generating token secret: %w", err)
+	}
+
+	secret := base64.RawURLEncoding.EncodeToString(secretBytes)
+
+	token, err := s.db.CreateToken(ctx, template, tokenDigest(secret))
+	if err != nil {
+		return IssuedToken{}, err
+	}
+
+	return IssuedToken{Token: token, Secret: secret}, nil
+}
+
+func (s *Service) DeleteToken(ctx context.Context, id uuid.UUID) error {
+	return s.db.DeleteToken(ctx, id)
+}
+
+// ListUsers returns the page of users matching the query filter.
+func (s *Service) ListUsers(ctx context.Context, query ListQuery) (UserPage, error) {
+	filter, err := ParseFilter(query.Filter)
+	if err != nil {
+		return UserPage{}, err
+	}
+
+	startIndex, options := listOptions(query, filter)
+
+	users, total, err := s.db.ListUsers(ctx, options)
+	if err != nil {
+		return UserPage{}, err
+	}
+
+	return UserPage{Users: users, TotalResults: total, StartIndex: startIndex}, nil
+}
+
+func (s *Service) GetUser(ctx context.Context, id uuid.UUID) (User, error) {
+	return s.db.GetUser(ctx, id)
+}
+
+// CreateUser provisions a new BloodHound user. The user is bound to the SSO provider of the authenticating token and
+// receives roles only through membership of SCIM groups an administrator mapped to roles.
+func (s *Service) CreateUser(ctx context.Context, user User) (User, error) {
+	token, ok := TokenFromContext(ctx)
+	if !ok {
+		return User{}, ErrInvalidToken
+	}
+
+	normalized, err := normalizeUser(user)
+	if err != nil {
+		return User{}, err
+	}
+
+	return s.db.CreateUser(ctx, normalized, token.SSOProviderID)
+}
+
+// ReplaceUser replaces the SCIM attributes of an existing user. Deactivating a user disables the account and revokes
+// its sessions and API tokens.
+func (s *Service) ReplaceUser(ctx context.Context, id uuid.UUID, user User) (User, error) {
+	if _, err := s.db.GetUser(ctx, id); err != nil {
+		return User{}, err
+	}
+
+	user.ID = id
+
+	normalized, err := normalizeUser(user)
+	if err != nil {
+		return User{}, err
+	}
+
+	return s.db.UpdateUser(ctx, normalized)
+}
+
+// PatchUser applies the PATCH operations to an existing user.
+func (s *Service) PatchUser(ctx context.Context, id uuid.UUID, operations []PatchOperation) (User, error) {
+	existing, err := s.db.GetUser(ctx, id)
+	if err != nil {
+		return User{}, err
+	}
+
+	patched, err := applyUserPatch(existing, operations)
+	if err != nil {
+		return User{}, err
+	}
+
+	normalized, err := normalizeUser(patched)
+	if err != nil {
+		return User{}, err
+	}
+
+	return s.db.UpdateUser(ctx, normalized)
+}
+
+// DeleteUser deprovisions a user. BloodHound retains the account so that its audit history stays attributable, but
+// disables it, revokes its sessions and API tokens and removes it from all SCIM groups.
+func (s *Service) DeleteUser(ctx context.Context, id uuid.UUID) error {
+	return s.db.DeprovisionUser(ctx, id)
+}
+
+// ListGroups returns the page of groups matching the query filter.
+func (s *Service) ListGroups(ctx context.Context, query ListQuery) (GroupPage, error) {
+	filter, err := ParseFilter(query.Filter)
+	if err != nil {
+		return GroupPage{}, err
+	}
+
+	startIndex, options := listOptions(query, filter)
+
+	groups, total, err := s.db.ListGroups(ctx, options)
+	if err != nil {
+		return GroupPage{}, err
+	}
+
+	return GroupPage{Groups: groups, TotalResults: total, StartIndex: startIndex}, nil
+}
+
+func (s *Service) GetGroup(ctx context.Context, id uuid.UUID) (Group, error) {
+	return s.db.GetGroup(ctx, id)
+}
+
+// CreateGroup creates a SCIM group. The group grants no role until an administrator maps it to one.
+func (s *Service) CreateGroup(ctx context.Context, group Group) (Group, error) {
+	normalized, err := normalizeGroup(group)
+	if err != nil {
+		return Group{}, err
+	}
+
+	return s.db.CreateGroup(ctx, normalized)
+}
+
+// ReplaceGroup replaces the display name and members of an existing group. The roles of members that join or leave
+// the group are recomputed from their remaining group memberships.
+func (s *Service) ReplaceGroup(ctx context.Context, id uuid.UUID, group Group) (Group, error) {
+	if _, err := s.db.GetGroup(ctx, id); err != nil {
+		return Group{}, err
+	}
+
+	group.ID = id
+
+	normalized, err := normalizeGroup(group)
+	if err != nil {
+		return Group{}, err
+	}
+
+	return s.db.UpdateGroup(ctx, normalized)
+}
+
+// PatchGroup applies the PATCH operations to an existing group.
+func (s *Service) PatchGroup(ctx context.Context, id uuid.UUID, operations []PatchOperation) (Group, error) {
+	existing, err := s.db.GetGroup(ctx, id)
+	if err != nil {
+		return Group{}, err
+	}
+
+	patched, err := applyGroupPatch(existing, operations)
+	if err != nil {
+		return Group{}, err
+	}
+
+	normalized, err := normalizeGroup(patched)
+	if err != nil {
+		return Group{}, err
+	}
+
+	return s.db.UpdateGroup(ctx, normalized)
+}
+
+// DeleteGroup removes a group. Members lose the role granted by the group unless another of their groups grants it.
+func (s *Service) DeleteGroup(ctx context.Context, id uuid.UUID) error {
+	return s.db.DeleteGroup(ctx, id)
+}
+
+// ListGroupRoles returns every SCIM group along with the role an administrator mapped to it, if any.
+func (s *Service) ListGroupRoles(ctx context.Context) ([]GroupRole, error) {
+	return s.db.ListGroupRoles(ctx)
+}
+
+// SetGroupRole maps a SCIM group to a role. Current and future members of the group are granted the role.
+func (s *Service) SetGroupRole(ctx context.Context, groupID uuid.UUID, roleID int32) (GroupRole, error) {
+	return s.db.SetGroupRole(ctx, groupID, roleID)
+}
+
+// DeleteGroupRole removes the role mapping of a SCIM group. Members lose the role unless another of their groups or a
+// local assignment grants it.
+func (s *Service) DeleteGroupRole(ctx context.Context, groupID uuid.UUID) error {
+	return s.db.DeleteGroupRole(ctx, groupID)
+}
+
+func normalizeGroup(group Group) (Group, error) {
+	group.DisplayName = strings.TrimSpace(group.DisplayName)
+	if group.DisplayName == "" {
+		return Group{}, fmt.Errorf("%w: displayName is required", ErrInvalidValue)
+	}
+
+	group.Members = uniqueMembers(group.Members)
+
+	return group, nil
+}
+
+func normalizeUser(user User) (User, error) {
+	user.UserName = strings.TrimSpace(user.UserName)
+	if user.UserName == "" {
+		return User{}, fmt.Errorf("%w: userName is required", ErrInvalidValue)
+	}
+
+	user.ExternalID = strings.TrimSpace(user.ExternalID)
+	user.GivenName = strings.TrimSpace(user.GivenName)
+	user.FamilyName = strings.TrimSpace(user.FamilyName)
+	user.Email = strings.TrimSpace(user.Email)
+
+	return user, nil
+}
+
+func uniqueMembers(members []MemberReference) []MemberReference {
+	var unique = make([]MemberReference, 0, len(members))
+	for _, member := range members {
+		if !slices.ContainsFunc(unique, func(existing MemberReference) bool { return existing.ID == member.ID }) {
+			unique = append(unique, member)
+		}
+	}
+
+	return unique
+}
+
+// listOptions clamps the 1-based start index and the count of a SCIM list request and converts them to the offset and
+// limit of a database query.
+func listOptions(query ListQuery, filter Filter) (int, ListOptions) {
+	var startIndex = max(query.StartIndex, 1)
+
+	return startIndex, ListOptions{
+		Filter: filter,
+		Offset: startIndex - 1,
+		Limit:  min(max(query.Count, 0), MaxPageSize),
+	}
+}
+
+func tokenDigest(secret string) string {
+	digest := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(digest[:])
+}
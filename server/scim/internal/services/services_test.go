@@ -0,0 +1,479 @@
+// Copyright 2026 Specter Ops, Inc.
+//
+// Licensed under the Apache License, Version 2.0
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package services_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/specterops/bloodhound/server/scim/internal/services"
+	"github.com/specterops/bloodhound/server/scim/internal/services/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Authenticate(t *testing.T) {
+	var (
+		ctx           = context.Background()
+		secret        = "secret"
+		digest        = sha256.Sum256([]byte(secret))
+		unexpectedErr = errors.New("connection refused")
+		expected      = services.Token{ID: uuid.Must(uuid.NewV4()), Name: "Okta"}
+	)
+
+	tests := []struct {
+		name       string
+		secret     string
+		setupMocks func(databaseMock *mocks.MockDatabase)
+		wantResult services.Token
+		wantErr    error
+	}{
+		{
+			name:   "returns the token matching the digest of the secret",
+			secret: secret,
+			setupMocks: func(databaseMock *mocks.MockDatabase) {
+				databaseMock.EXPECT().UseToken(ctx, hex.EncodeToString(digest[:])).Return(expected, nil)
+			},
+			wantResult: expected,
+		},
+		{
+			name:       "rejects an empty secret without querying the database",
+			secret:     "",
+			setupMocks: func(databaseMock *mocks.MockDatabase) {},
+			wantErr:    services.ErrInvalidToken,
+		},
+		{
+			name:   "maps unknown tokens to ErrInvalidToken",
+			secret: secret,
+			setupMocks: func(databaseMock *mocks.MockDatabase) {
+				databaseMock.EXPECT().UseToken(ctx, mock.Anything).Return(services.Token{}, services.ErrTokenNotFound)
+			},
+			wantErr: services.ErrInvalidToken,
+		},
+		{
+			name:   "propagates unexpected database errors",
+			secret: secret,
+			setupMocks: func(databaseMock *mocks.MockDatabase) {
+				databaseMock.EXPECT().UseToken(ctx, mock.Anything).Return(services.Token{}, unexpectedErr)
+			},
+			wantErr: unexpectedErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				databaseMock = mocks.NewMockDatabase(t)
+				svc          = services.NewService(databaseMock)
+			)
+
+			tt.setupMocks(databaseMock)
+
+			result, err := svc.Authenticate(ctx, tt.secret)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantResult, result)
+			}
+		})
+	}
+}
+
+func TestService_CreateToken(t *testing.T) {
+	t.Run("stores the digest of the issued secret", func(t *testing.T) {
+		var (
+			ctx          = context.Background()
+			databaseMock = mocks.NewMockDatabase(t)
+			svc          = services.NewService(databaseMock)
+			storedDigest string
+			created      = services.Token{ID: uuid.Must(uuid.NewV4()), Name: "Okta"}
+		)
+
+		databaseMock.EXPECT().CreateToken(ctx, services.TokenTemplate{Name: "Okta"}, mock.Anything).RunAndReturn(func(_ context.Context, _ services.TokenTemplate, digest string) (services.Token, error) {
+			storedDigest = digest
+			return created, nil
+		})
+
+		issued, err := svc.CreateToken(ctx, services.TokenTemplate{Name: "  Okta  "})
+		require.NoError(t, err)
+
+		secretDigest := sha256.Sum256([]byte(issued.Secret))
+		assert.Equal(t, created, issued.Token)
+		assert.NotEmpty(t, issued.Secret)
+		assert.Equal(t, hex.EncodeToString(secretDigest[:]), storedDigest)
+	})
+
+	t.Run("rejects an empty name", func(t *testing.T) {
+		var svc = services.NewService(mocks.NewMockDatabase(t))
+
+		_, err := svc.CreateToken(context.Background(), services.TokenTemplate{Name: " "})
+		assert.ErrorIs(t, err, services.ErrInvalidTokenName)
+	})
+}
+
+// filterRenderer renders a parsed filter back into a fully parenthesized SCIM expression.
+type filterRenderer struct{}
+
+func (filterRenderer) And(left, right string) string {
+	return "(" + left + " and " + right + ")"
+}
+
+func (filterRenderer) Or(left, right string) string {
+	return "(" + left + " or " + right + ")"
+}
+
+func (filterRenderer) Not(inner string) string {
+	return "not (" + inner + ")"
+}
+
+func (filterRenderer) Compare(path, operator, value string) string {
+	if operator == services.FilterOperatorPresent {
+		return path + " " + operator
+	}
+
+	return path + " " + operator + " " + strconv.Quote(value)
+}
+
+func (s filterRenderer) ValuePath(path string, inner services.Filter) string {
+	return path + "[" + inner.Translate(s) + "]"
+}
+
+func TestFilter_Translate(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		want       string
+	}{
+		{
+			name:       "renders an empty filter as an empty string",
+			expression: " ",
+			want:       "",
+		},
+		{
+			name:       "lower cases attribute paths and strips schema URNs",
+			expression: `urn:ietf:params:scim:schemas:core:2.0:User:userName eq "Alice@example.com"`,
+			want:       `username eq "Alice@example.com"`,
+		},
+		{
+			name:       "binds and tighter than or",
+			expression: `active eq TRUE and userName ew "corp.example.com" or externalId pr`,
+			want:       `((active eq "true" and username ew "corp.example.com") or externalid pr)`,
+		},
+		{
+			name:       "renders negation and value paths",
+			expression: `not (emails[type eq "work" and value sw "alice"])`,
+			want:       `not (emails[(type eq "work" and value sw "alice")])`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := services.ParseFilter(tt.expression)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, filter.Translate(filterRenderer{}))
+		})
+	}
+}
+
+func TestService_ListUsers(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		users = []services.User{
+			{ID: uuid.Must(uuid.NewV4()), UserName: "alice@example.com", Email: "alice@example.com", Active: true},
+		}
+	)
+
+	tests := []struct {
+		name           string
+		query          services.ListQuery
+		wantFilter     string
+		wantOffset     int
+		wantLimit      int
+		wantStartIndex int
+		wantErr        error
+	}{
+		{
+			name:           "lists all users without a filter",
+			query:          services.ListQuery{StartIndex: 1, Count: 10},
+			wantOffset:     0,
+			wantLimit:      10,
+			wantStartIndex: 1,
+		},
+		{
+			name:           "passes the filter and page to the database",
+			query:          services.ListQuery{Filter: `userName eq "ALICE@example.com"`, StartIndex: 3, Count: 2},
+			wantFilter:     `username eq "ALICE@example.com"`,
+			wantOffset:     2,
+			wantLimit:      2,
+			wantStartIndex: 3,
+		},
+		{
+			name:           "clamps the start index and count",
+			query:          services.ListQuery{StartIndex: -4, Count: services.MaxPageSize + 1},
+			wantOffset:     0,
+			wantLimit:      services.MaxPageSize,
+			wantStartIndex: 1,
+		},
+		{
+			name:    "rejects a malformed filter",
+			query:   services.ListQuery{Filter: `userName eq`},
+			wantErr: services.ErrInvalidFilter,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				databaseMock = mocks.NewMockDatabase(t)
+				svc          = services.NewService(databaseMock)
+			)
+
+			if tt.wantErr == nil {
+				databaseMock.EXPECT().ListUsers(ctx, mock.MatchedBy(func(options services.ListOptions) bool {
+					return options.Filter.Translate(filterRenderer{}) == tt.wantFilter && options.Offset == tt.wantOffset && options.Limit == tt.wantLimit
+				})).Return(users, 7, nil)
+			}
+
+			page, err := svc.ListUsers(ctx, tt.query)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, users, page.Users)
+				assert.Equal(t, 7, page.TotalResults)
+				assert.Equal(t, tt.wantStartIndex, page.StartIndex)
+			}
+		})
+	}
+}
+
+func TestService_CreateUser(t *testing.T) {
+	var (
+		ssoProviderID = sql.NullInt32{Int32: 4, Valid: true}
+		token         = services.Token{ID: uuid.Must(uuid.NewV4()), SSOProviderID: ssoProviderID}
+	)
+
+	t.Run("binds the user to the SSO provider of the token", func(t *testing.T) {
+		var (
+			ctx          = services.ContextWithToken(context.Background(), token)
+			databaseMock = mocks.NewMockDatabase(t)
+			svc          = services.NewService(databaseMock)
+			expected     = services.User{UserName: "alice@example.com", GivenName: "Alice", Active: true}
+		)
+
+		databaseMock.EXPECT().CreateUser(ctx, expected, ssoProviderID).Return(expected, nil)
+
+		result, err := svc.CreateUser(ctx, services.User{UserName: " alice@example.com ", GivenName: "Alice ", Active: true})
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("requires a userName", func(t *testing.T) {
+		var (
+			ctx = services.ContextWithToken(context.Background(), token)
+			svc = services.NewService(mocks.NewMockDatabase(t))
+		)
+
+		_, err := svc.CreateUser(ctx, services.User{})
+		assert.ErrorIs(t, err, services.ErrInvalidValue)
+	})
+
+	t.Run("requires an authenticated token", func(t *testing.T) {
+		var svc = services.NewService(mocks.NewMockDatabase(t))
+
+		_, err := svc.CreateUser(context.Background(), services.User{UserName: "alice@example.com"})
+		assert.ErrorIs(t, err, services.ErrInvalidToken)
+	})
+}
+
+func TestService_PatchUser(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		userID   = uuid.Must(uuid.NewV4())
+		existing = services.User{
+			ID:         userID,
+			UserName:   "alice@example.com",
+			GivenName:  "Alice",
+			FamilyName: "Liddell",
+			Email:      "alice@example.com",
+			Active:     true,
+			CreatedAt:  time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		}
+	)
+
+	tests := []struct {
+		name       string
+		operations []services.PatchOperation
+		wantUser   func(user services.User) services.User
+		wantErr    error
+	}{
+		{
+			name:       "deactivates the user",
+			operations: []services.PatchOperation{{Op: "replace", Path: "active", Value: json.RawMessage(`false`)}},
+			wantUser: func(user services.User) services.User {
+				user.Active = false
+				return user
+			},
+		},
+		{
+			name:       "accepts Azure style string booleans and capitalized operations",
+			operations: []services.PatchOperation{{Op: "Replace", Path: "active", Value: json.RawMessage(`"False"`)}},
+			wantUser: func(user services.User) services.User {
+				user.Active = false
+				return user
+			},
+		},
+		{
+			name:       "applies path-less operations to each attribute",
+			operations: []services.PatchOperation{{Op: "replace", Value: json.RawMessage(`{"name.givenName":"Alicia","externalId":"00u1"}`)}},
+			wantUser: func(user services.User) services.User {
+				user.GivenName = "Alicia"
+				user.ExternalID = "00u1"
+				return user
+			},
+		},
+		{
+			name:       "replaces the primary email",
+			operations: []services.PatchOperation{{Op: "replace", Path: "emails", Value: json.RawMessage(`[{"value":"other@example.com"},{"value":"alice@corp.example.com","primary":true}]`)}},
+			wantUser: func(user services.User) services.User {
+				user.Email = "alice@corp.example.com"
+				return user
+			},
+		},
+		{
+			name:       "removes the family name",
+			operations: []services.PatchOperation{{Op: "remove", Path: "name.familyName"}},
+			wantUser: func(user services.User) services.User {
+				user.FamilyName = ""
+				return user
+			},
+		},
+		{
+			name:       "rejects removing the userName",
+			operations: []services.PatchOperation{{Op: "remove", Path: "userName"}},
+			wantErr:    services.ErrInvalidValue,
+		},
+		{
+			name:       "rejects unknown operations",
+			operations: []services.PatchOperation{{Op: "move", Path: "userName"}},
+			wantErr:    services.ErrInvalidSyntax,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				databaseMock = mocks.NewMockDatabase(t)
+				svc          = services.NewService(databaseMock)
+			)
+
+			databaseMock.EXPECT().GetUser(ctx, userID).Return(existing, nil)
+			if tt.wantErr == nil {
+				databaseMock.EXPECT().UpdateUser(ctx, tt.wantUser(existing)).Return(tt.wantUser(existing), nil)
+			}
+
+			result, err := svc.PatchUser(ctx, userID, tt.operations)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantUser(existing), result)
+			}
+		})
+	}
+}
+
+func TestService_PatchGroup(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		groupID  = uuid.Must(uuid.NewV4())
+		alice    = services.MemberReference{ID: uuid.Must(uuid.NewV4())}
+		bob      = services.MemberReference{ID: uuid.Must(uuid.NewV4())}
+		existing = services.Group{
+			ID:          groupID,
+			DisplayName: "Engineering",
+			RoleID:      sql.NullInt32{Int32: 3, Valid: true},
+			Members:     []services.MemberReference{alice},
+		}
+	)
+
+	tests := []struct {
+		name       string
+		operations []services.PatchOperation
+		wantGroup  services.Group
+		wantErr    error
+	}{
+		{
+			name:       "adds members without duplicating existing ones",
+			operations: []services.PatchOperation{{Op: "add", Path: "members", Value: json.RawMessage(`[{"value":"` + alice.ID.String() + `"},{"value":"` + bob.ID.String() + `"}]`)}},
+			wantGroup:  services.Group{ID: groupID, DisplayName: "Engineering", RoleID: existing.RoleID, Members: []services.MemberReference{alice, bob}},
+		},
+		{
+			name:       "removes members matching a value filter",
+			operations: []services.PatchOperation{{Op: "remove", Path: `members[value eq "` + alice.ID.String() + `"]`}},
+			wantGroup:  services.Group{ID: groupID, DisplayName: "Engineering", RoleID: existing.RoleID, Members: []services.MemberReference{}},
+		},
+		{
+			name:       "keeps the administrator mapped role when the group is renamed",
+			operations: []services.PatchOperation{{Op: "replace", Path: "displayName", Value: json.RawMessage(`"bh-administrator"`)}},
+			wantGroup:  services.Group{ID: groupID, DisplayName: "bh-administrator", RoleID: existing.RoleID, Members: []services.MemberReference{alice}},
+		},
+		{
+			name:       "rejects malformed member IDs",
+			operations: []services.PatchOperation{{Op: "add", Path: "members", Value: json.RawMessage(`{"value":"not-a-uuid"}`)}},
+			wantErr:    services.ErrInvalidValue,
+		},
+		{
+			name:       "rejects malformed paths",
+			operations: []services.PatchOperation{{Op: "remove", Path: `members[value eq`}},
+			wantErr:    services.ErrInvalidPath,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				databaseMock = mocks.NewMockDatabase(t)
+				svc          = services.NewService(databaseMock)
+			)
+
+			databaseMock.EXPECT().GetGroup(ctx, groupID).Return(existing, nil)
+			if tt.wantErr == nil {
+				databaseMock.EXPECT().UpdateGroup(ctx, tt.wantGroup).Return(tt.wantGroup, nil)
+			}
+
+			result, err := svc.PatchGroup(ctx, groupID, tt.operations)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantGroup, result)
+			}
+		})
+	}
+}